@@ -21,6 +21,10 @@ func (g *GatewayClient) GetModelList() ([]*aispec.ModelMeta, error) {
 	return aispec.ListChatModels(g.targetUrl, g.BuildHTTPOptions)
 }
 
+func (g *GatewayClient) ChatCompletionsURL() string {
+	return g.targetUrl
+}
+
 func (g *GatewayClient) StructuredStream(s string, function ...any) (chan *aispec.StructuredData, error) {
 	return aispec.StructuredStreamBase(
 		g.targetUrl,
@@ -34,6 +38,7 @@ func (g *GatewayClient) StructuredStream(s string, function ...any) (chan *aispe
 }
 
 var _ aispec.AIClient = (*GatewayClient)(nil)
+var _ aispec.OpenAICompatibleChatter = (*GatewayClient)(nil)
 
 func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
 	return aispec.ChatBase(
@@ -46,6 +51,7 @@ func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 	)
 }
 
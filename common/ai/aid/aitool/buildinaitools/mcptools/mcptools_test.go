@@ -0,0 +1,120 @@
+package mcptools
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/server"
+)
+
+func newTestMCPServer(t *testing.T, calls *[]map[string]any) *server.MCPServer {
+	s := server.NewMCPServer("test-server", "1.0.0", server.WithResourceCapabilities(true, true))
+	s.AddTool(mcp.NewTool("echo",
+		mcp.WithDescription("echo the message"),
+		mcp.WithString("message", mcp.Required(), mcp.Description("the message")),
+	), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls = append(*calls, request.Params.Arguments)
+		if request.Params.Arguments["message"] == "fail" {
+			return &mcp.CallToolResult{IsError: true, Content: []any{mcp.TextContent{Type: "text", Text: "bad message"}}}, nil
+		}
+		return &mcp.CallToolResult{Content: []any{
+			mcp.TextContent{Type: "text", Text: "echo: " + request.Params.Arguments["message"].(string)},
+			mcp.ImageContent{Type: "image", Data: "aGVsbG8=", MIMEType: "image/png"},
+		}}, nil
+	})
+	s.AddResource(mcp.NewResource("memo://readme", "readme", mcp.WithMIMEType("text/plain")),
+		func(ctx context.Context, request mcp.ReadResourceRequest) ([]interface{}, error) {
+			return []interface{}{mcp.TextResourceContents{
+				ResourceContents: mcp.ResourceContents{URI: "memo://readme", MIMEType: "text/plain"},
+				Text:             "hello from resource",
+			}}, nil
+		})
+	return s
+}
+
+func invokeTool(t *testing.T, tools []*aitool.Tool, name string, params aitool.InvokeParams) (any, error) {
+	for _, tool := range tools {
+		if tool.Name == name {
+			var stdout, stderr bytes.Buffer
+			return tool.Callback(context.Background(), params, &stdout, &stderr)
+		}
+	}
+	t.Fatalf("tool %v not found", name)
+	return nil, nil
+}
+
+func TestCreateMCPTools_SSE(t *testing.T) {
+	var calls []map[string]any
+	testServer := server.NewTestServer(newTestMCPServer(t, &calls))
+	defer testServer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s, err := Connect(ctx, NewSSEServerConfig("test server", testServer.URL+"/sse"))
+	require.NoError(t, err)
+	assert.Equal(t, "test_server", s.Name())
+	assert.Equal(t, "test-server", s.ServerInfo().Name)
+
+	tools, err := CreateMCPTools(s)
+	require.NoError(t, err)
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+		// tools of external servers must be reviewed
+		assert.False(t, tool.NoNeedUserReview)
+	}
+	assert.ElementsMatch(t, []string{"mcp_test_server_echo", "mcp_test_server_list_resources", "mcp_test_server_read_resource"}, names)
+
+	result, err := invokeTool(t, tools, "mcp_test_server_echo", aitool.InvokeParams{"message": "hi", "runtime_id": "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, "echo: hi\n[image image/png , base64 size: 8]", result)
+	require.Len(t, calls, 1)
+	assert.Equal(t, map[string]any{"message": "hi"}, calls[0])
+
+	_, err = invokeTool(t, tools, "mcp_test_server_echo", aitool.InvokeParams{"message": "fail"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad message")
+
+	result, err = invokeTool(t, tools, "mcp_test_server_list_resources", aitool.InvokeParams{})
+	require.NoError(t, err)
+	assert.Contains(t, result, "memo://readme")
+	result, err = invokeTool(t, tools, "mcp_test_server_read_resource", aitool.InvokeParams{"uri": "memo://readme"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello from resource", result)
+
+	// the sse session is lost, the next call reconnects
+	testServer.CloseClientConnections()
+	result, err = invokeTool(t, tools, "mcp_test_server_echo", aitool.InvokeParams{"message": "again"})
+	require.NoError(t, err)
+	assert.Equal(t, "echo: again\n[image image/png , base64 size: 8]", result)
+
+	s.Close()
+	_, err = invokeTool(t, tools, "mcp_test_server_echo", aitool.InvokeParams{"message": "closed"})
+	require.Error(t, err)
+}
+
+func TestServerConfig(t *testing.T) {
+	config, err := NewStdioServerConfig("", `npx -y "@modelcontextprotocol/server-filesystem" /tmp`, "A=1")
+	require.NoError(t, err)
+	require.NoError(t, config.check())
+	assert.Equal(t, TypeStdio, config.Type)
+	assert.Equal(t, "npx", config.Command)
+	assert.Equal(t, []string{"-y", "@modelcontextprotocol/server-filesystem", "/tmp"}, config.Args)
+	assert.Equal(t, "npx", config.Name)
+
+	_, err = NewStdioServerConfig("empty", "  ")
+	require.Error(t, err)
+
+	config = &ServerConfig{URL: "http://127.0.0.1:8080/sse"}
+	require.NoError(t, config.check())
+	assert.Equal(t, TypeSSE, config.Type)
+	assert.Equal(t, "http_127_0_0_1_8080_sse", config.Name)
+
+	_, err = Connect(context.Background(), &ServerConfig{Type: TypeSSE, URL: "not-a-url"})
+	require.Error(t, err)
+}
@@ -0,0 +1,340 @@
+package mcptools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/client"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/shlex"
+)
+
+const (
+	TypeStdio = "stdio"
+	TypeSSE   = "sse"
+
+	defaultRequestTimeout = 5 * time.Minute
+	connectTimeout        = 30 * time.Second
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// ServerConfig describes an external MCP server, the server is started by Command (stdio)
+// or connected by URL (sse), Name is used to namespace the tools of the server
+type ServerConfig struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type,omitempty"`
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	Env     []string `json:"env,omitempty"`
+	URL     string   `json:"url,omitempty"`
+
+	// Timeout limits every request to the server, default is 5 minutes
+	Timeout time.Duration `json:"-"`
+}
+
+// NewStdioServerConfig parses the command line (e.g. `npx -y @modelcontextprotocol/server-everything`)
+func NewStdioServerConfig(name string, commandLine string, env ...string) (*ServerConfig, error) {
+	args, err := shlex.Split(commandLine)
+	if err != nil {
+		return nil, utils.Errorf("parse mcp server command failed: %v", err)
+	}
+	if len(args) == 0 {
+		return nil, utils.Error("mcp server command is empty")
+	}
+	return &ServerConfig{Name: name, Type: TypeStdio, Command: args[0], Args: args[1:], Env: env}, nil
+}
+
+func NewSSEServerConfig(name string, url string) *ServerConfig {
+	return &ServerConfig{Name: name, Type: TypeSSE, URL: url}
+}
+
+func (c *ServerConfig) check() error {
+	if c.Type == "" {
+		if c.URL != "" {
+			c.Type = TypeSSE
+		} else {
+			c.Type = TypeStdio
+		}
+	}
+	switch c.Type {
+	case TypeStdio:
+		if c.Command == "" {
+			return utils.Errorf("mcp server %v: command is required", c.Name)
+		}
+	case TypeSSE:
+		if !utils.IsHttpOrHttpsUrl(c.URL) {
+			return utils.Errorf("mcp server %v: invalid url %v", c.Name, c.URL)
+		}
+	default:
+		return utils.Errorf("mcp server %v: unsupported type %v", c.Name, c.Type)
+	}
+	if c.Name == "" {
+		if c.Type == TypeStdio {
+			c.Name = c.Command
+		} else {
+			c.Name = c.URL
+		}
+	}
+	c.Name = strings.Trim(invalidNameChars.ReplaceAllString(c.Name, "_"), "_")
+	if c.Timeout <= 0 {
+		c.Timeout = defaultRequestTimeout
+	}
+	return nil
+}
+
+// Server is a connection to an external MCP server, the connection is re-established
+// when a request fails and the server does not respond to ping
+type Server struct {
+	config *ServerConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu           sync.Mutex
+	client       client.MCPClient
+	capabilities mcp.ServerCapabilities
+	serverInfo   mcp.Implementation
+}
+
+// Connect starts or connects the MCP server and initializes the session,
+// the connection is closed when ctx is done
+func Connect(ctx context.Context, config *ServerConfig) (*Server, error) {
+	if config == nil {
+		return nil, utils.Error("mcp server config is nil")
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Server{config: config, ctx: ctx, cancel: cancel}
+	if _, err := s.getClient(); err != nil {
+		cancel()
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closeClient()
+	}()
+	return s, nil
+}
+
+func (s *Server) Name() string {
+	return s.config.Name
+}
+
+func (s *Server) ServerInfo() mcp.Implementation {
+	return s.serverInfo
+}
+
+func (s *Server) SupportResources() bool {
+	return s.capabilities.Resources != nil
+}
+
+// Close closes the connection, stdio servers are terminated
+func (s *Server) Close() {
+	s.cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeClient()
+}
+
+func (s *Server) closeClient() {
+	if s.client == nil {
+		return
+	}
+	if err := s.client.Close(); err != nil {
+		log.Debugf("close mcp server %v: %v", s.config.Name, err)
+	}
+	s.client = nil
+}
+
+func (s *Server) getClient() (client.MCPClient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		return s.client, nil
+	}
+	if s.ctx.Err() != nil {
+		return nil, utils.Errorf("mcp server %v is closed", s.config.Name)
+	}
+
+	var c client.MCPClient
+	switch s.config.Type {
+	case TypeSSE:
+		sseClient, err := client.NewSSEMCPClient(s.config.URL)
+		if err != nil {
+			return nil, utils.Errorf("create mcp sse client for %v failed: %v", s.config.Name, err)
+		}
+		// the sse stream lives as long as the server, only the handshake is limited
+		startCtx, cancel := context.WithCancel(s.ctx)
+		timer := time.AfterFunc(connectTimeout, cancel)
+		err = sseClient.Start(startCtx)
+		if !timer.Stop() || err != nil {
+			cancel()
+			sseClient.Close()
+			if err == nil {
+				err = utils.Error("timeout")
+			}
+			return nil, utils.Errorf("connect mcp server %v (%v) failed: %v", s.config.Name, s.config.URL, err)
+		}
+		go func() {
+			<-s.ctx.Done()
+			cancel()
+		}()
+		c = sseClient
+	default:
+		stdioClient, err := client.NewStdioMCPClient(s.config.Command, s.config.Env, s.config.Args...)
+		if err != nil {
+			return nil, utils.Errorf("start mcp server %v (%v) failed: %v", s.config.Name, s.config.Command, err)
+		}
+		c = stdioClient
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, connectTimeout)
+	defer cancel()
+	req := mcp.InitializeRequest{}
+	req.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	req.Params.ClientInfo = mcp.Implementation{Name: "yaklang-aid", Version: "1.0.0"}
+	result, err := c.Initialize(ctx, req)
+	if err != nil {
+		c.Close()
+		return nil, utils.Errorf("initialize mcp server %v failed: %v", s.config.Name, err)
+	}
+	s.capabilities = result.Capabilities
+	s.serverInfo = result.ServerInfo
+	s.client = c
+	log.Infof("mcp server %v connected: %v %v", s.config.Name, result.ServerInfo.Name, result.ServerInfo.Version)
+	return c, nil
+}
+
+// do runs the request, if it fails and the server does not respond to ping,
+// the connection is re-established and the request is retried once
+func (s *Server) do(f func(ctx context.Context, c client.MCPClient) error) error {
+	c, err := s.getClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.config.Timeout)
+	defer cancel()
+	err = f(ctx, c)
+	if err == nil || s.ctx.Err() != nil || s.alive(c) {
+		return err
+	}
+
+	log.Warnf("mcp server %v is not responding (%v), reconnecting", s.config.Name, err)
+	s.mu.Lock()
+	if s.client == c {
+		s.closeClient()
+	}
+	s.mu.Unlock()
+	c, err = s.getClient()
+	if err != nil {
+		return err
+	}
+	retryCtx, retryCancel := context.WithTimeout(s.ctx, s.config.Timeout)
+	defer retryCancel()
+	return f(retryCtx, c)
+}
+
+func (s *Server) alive(c client.MCPClient) bool {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+	return c.Ping(ctx) == nil
+}
+
+// ListTools lists the tools of the server, all pages are fetched
+func (s *Server) ListTools() ([]*mcp.Tool, error) {
+	var tools []*mcp.Tool
+	var cursor mcp.Cursor
+	for {
+		err := s.do(func(ctx context.Context, c client.MCPClient) error {
+			req := mcp.ListToolsRequest{}
+			req.Params.Cursor = cursor
+			result, err := c.ListTools(ctx, req)
+			if err != nil {
+				return err
+			}
+			tools = append(tools, result.Tools...)
+			cursor = result.NextCursor
+			return nil
+		})
+		if err != nil {
+			return nil, utils.Errorf("list tools of mcp server %v failed: %v", s.config.Name, err)
+		}
+		if cursor == "" {
+			return tools, nil
+		}
+	}
+}
+
+func (s *Server) CallTool(name string, arguments map[string]any) (*mcp.CallToolResult, error) {
+	var result *mcp.CallToolResult
+	err := s.do(func(ctx context.Context, c client.MCPClient) error {
+		req := mcp.CallToolRequest{}
+		req.Params.Name = name
+		req.Params.Arguments = arguments
+		var err error
+		result, err = c.CallTool(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, utils.Errorf("call tool %v of mcp server %v failed: %v", name, s.config.Name, err)
+	}
+	return result, nil
+}
+
+func (s *Server) ListResources() ([]*mcp.Resource, error) {
+	var resources []*mcp.Resource
+	var cursor mcp.Cursor
+	for {
+		err := s.do(func(ctx context.Context, c client.MCPClient) error {
+			req := mcp.ListResourcesRequest{}
+			req.Params.Cursor = cursor
+			result, err := c.ListResources(ctx, req)
+			if err != nil {
+				return err
+			}
+			resources = append(resources, result.Resources...)
+			cursor = result.NextCursor
+			return nil
+		})
+		if err != nil {
+			return nil, utils.Errorf("list resources of mcp server %v failed: %v", s.config.Name, err)
+		}
+		if cursor == "" {
+			return resources, nil
+		}
+	}
+}
+
+func (s *Server) ReadResource(uri string) (*mcp.ReadResourceResult, error) {
+	var result *mcp.ReadResourceResult
+	err := s.do(func(ctx context.Context, c client.MCPClient) error {
+		req := mcp.ReadResourceRequest{}
+		req.Params.URI = uri
+		var err error
+		result, err = c.ReadResource(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, utils.Errorf("read resource %v of mcp server %v failed: %v", uri, s.config.Name, err)
+	}
+	return result, nil
+}
+
+// ToolName returns the namespaced name of the tool of the server
+func (s *Server) ToolName(name string) string {
+	return fmt.Sprintf("mcp_%s_%s", s.config.Name, name)
+}
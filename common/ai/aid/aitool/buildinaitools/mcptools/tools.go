@@ -0,0 +1,164 @@
+package mcptools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/ai/aid/aitool"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// CreateMCPTools creates ai tools that proxy the tools of the server, the tool names are namespaced
+// as mcp_<server>_<tool>; if the server offers resources, list / read resource tools are created too.
+// the tools always need user review, as they are provided by an external server
+func CreateMCPTools(s *Server) ([]*aitool.Tool, error) {
+	mcpTools, err := s.ListTools()
+	if err != nil {
+		return nil, err
+	}
+
+	var tools []*aitool.Tool
+	for _, mt := range mcpTools {
+		if mt == nil || mt.Name == "" {
+			continue
+		}
+		tool, err := newProxyTool(s, mt)
+		if err != nil {
+			log.Warnf("create mcp tool %v of %v failed: %v", mt.Name, s.Name(), err)
+			continue
+		}
+		tools = append(tools, tool)
+	}
+
+	if s.SupportResources() {
+		resourceTools, err := createResourceTools(s)
+		if err != nil {
+			log.Warnf("create mcp resource tools of %v failed: %v", s.Name(), err)
+		} else {
+			tools = append(tools, resourceTools...)
+		}
+	}
+	return tools, nil
+}
+
+func newProxyTool(s *Server, mt *mcp.Tool) (*aitool.Tool, error) {
+	originName := mt.Name
+	proxied := *mt
+	proxied.Name = s.ToolName(originName)
+	if proxied.InputSchema.Type == "" {
+		proxied.InputSchema.Type = "object"
+	}
+	description := fmt.Sprintf("[mcp:%s] %s", s.Name(), mt.Description)
+
+	return aitool.NewFromMCPTool(
+		&proxied,
+		aitool.WithDescription(description),
+		aitool.WithKeywords([]string{"mcp", s.Name(), originName}),
+		aitool.WithCallback(func(ctx context.Context, params aitool.InvokeParams, stdout io.Writer, stderr io.Writer) (any, error) {
+			arguments := make(map[string]any, len(params))
+			for k, v := range params {
+				// runtime_id is added by aid, it is not an argument of the external tool
+				if _, declared := mt.InputSchema.Properties[k]; k == "runtime_id" && !declared {
+					continue
+				}
+				arguments[k] = v
+			}
+			result, err := s.CallTool(originName, arguments)
+			if err != nil {
+				return nil, err
+			}
+			text := contentToText(result.Content)
+			if result.IsError {
+				stderr.Write([]byte(text))
+				return nil, utils.Errorf("mcp tool %v of %v failed: %v", originName, s.Name(), text)
+			}
+			return text, nil
+		}),
+	)
+}
+
+func createResourceTools(s *Server) ([]*aitool.Tool, error) {
+	factory := aitool.NewFactory()
+	err := factory.RegisterTool(s.ToolName("list_resources"),
+		aitool.WithDescription(fmt.Sprintf("[mcp:%s] list the resources (uri, name, description, mimeType) of mcp server %s", s.Name(), s.Name())),
+		aitool.WithKeywords([]string{"mcp", s.Name(), "resource"}),
+		aitool.WithSimpleCallback(func(params aitool.InvokeParams, stdout io.Writer, stderr io.Writer) (any, error) {
+			resources, err := s.ListResources()
+			if err != nil {
+				return nil, err
+			}
+			raw, err := json.Marshal(resources)
+			if err != nil {
+				return nil, utils.Errorf("marshal resources failed: %v", err)
+			}
+			return string(raw), nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = factory.RegisterTool(s.ToolName("read_resource"),
+		aitool.WithDescription(fmt.Sprintf("[mcp:%s] read the resource of mcp server %s by uri", s.Name(), s.Name())),
+		aitool.WithKeywords([]string{"mcp", s.Name(), "resource"}),
+		aitool.WithStringParam("uri",
+			aitool.WithParam_Required(true),
+			aitool.WithParam_Description("the uri of the resource"),
+			aitool.WithParam_RequireTool(s.ToolName("list_resources")),
+		),
+		aitool.WithSimpleCallback(func(params aitool.InvokeParams, stdout io.Writer, stderr io.Writer) (any, error) {
+			result, err := s.ReadResource(params.GetString("uri"))
+			if err != nil {
+				return nil, err
+			}
+			return contentToText(result.Contents), nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return factory.Tools(), nil
+}
+
+// contentToText flattens the content of tool results and resources, text is kept as-is
+// and binary data (image / blob) is summarized
+func contentToText(contents []any) string {
+	var parts []string
+	for _, content := range contents {
+		raw, err := json.Marshal(content)
+		if err != nil {
+			continue
+		}
+		var item struct {
+			Type     string          `json:"type"`
+			Text     *string         `json:"text"`
+			Data     string          `json:"data"`
+			Blob     string          `json:"blob"`
+			URI      string          `json:"uri"`
+			MIMEType string          `json:"mimeType"`
+			Resource json.RawMessage `json:"resource"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			parts = append(parts, string(raw))
+			continue
+		}
+		switch {
+		case item.Text != nil:
+			parts = append(parts, *item.Text)
+		case len(item.Resource) > 0:
+			var resource any
+			_ = json.Unmarshal(item.Resource, &resource)
+			parts = append(parts, contentToText([]any{resource}))
+		case item.Data != "" || item.Blob != "":
+			size := len(item.Data) + len(item.Blob)
+			parts = append(parts, fmt.Sprintf("[%s %s %s, base64 size: %d]", item.Type, item.MIMEType, item.URI, size))
+		default:
+			parts = append(parts, string(raw))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/yaklang/yaklang/common/ai/aid/aitool"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/fstools"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/mcptools"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/searchtools"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
@@ -661,6 +662,49 @@ func WithOmniSearchTool() Option {
 	}
 }
 
+// WithMCPServers 连接外部 MCP Server，并把其工具以 mcp_<server>_<tool> 的名字注册到工具管理器中，
+// 这些工具与普通工具一样需要经过 review，连接失败的 Server 会被跳过，连接会随 coordinator 结束而关闭
+func WithMCPServers(servers ...*mcptools.ServerConfig) Option {
+	return func(config *Config) error {
+		var tools []*aitool.Tool
+		for _, serverConfig := range servers {
+			server, err := mcptools.Connect(config.ctx, serverConfig)
+			if err != nil {
+				log.Errorf("connect mcp server failed: %v", err)
+				continue
+			}
+			serverTools, err := mcptools.CreateMCPTools(server)
+			if err != nil {
+				log.Errorf("create tools of mcp server %v failed: %v", server.Name(), err)
+				server.Close()
+				continue
+			}
+			log.Infof("mcp server %v provides %d tools", server.Name(), len(serverTools))
+			tools = append(tools, serverTools...)
+		}
+		if len(tools) <= 0 {
+			return nil
+		}
+		return WithTools(tools...)(config)
+	}
+}
+
+// WithMCPServerCommand 通过命令行启动 stdio MCP Server，例如 `npx -y @modelcontextprotocol/server-filesystem /tmp`
+func WithMCPServerCommand(name string, commandLine string, env ...string) Option {
+	return func(config *Config) error {
+		serverConfig, err := mcptools.NewStdioServerConfig(name, commandLine, env...)
+		if err != nil {
+			return err
+		}
+		return WithMCPServers(serverConfig)(config)
+	}
+}
+
+// WithMCPServerURL 通过 SSE 地址连接 MCP Server
+func WithMCPServerURL(name string, url string) Option {
+	return WithMCPServers(mcptools.NewSSEServerConfig(name, url))
+}
+
 func WithAiToolsSearchTool() Option {
 	return func(config *Config) error {
 		config.m.Lock()
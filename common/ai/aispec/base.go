@@ -175,6 +175,7 @@ type ChatBaseContext struct {
 	ReasonStreamHandler func(reader io.Reader)
 	ErrHandler          func(err error)
 	ImageUrls           []*ImageDescription
+	UsageCallback       func(usage *ChatUsage)
 }
 
 type ChatBaseOption func(c *ChatBaseContext)
@@ -209,6 +210,12 @@ func WithChatBase_ReasonStreamHandler(b func(reader io.Reader)) ChatBaseOption {
 	}
 }
 
+func WithChatBase_UsageCallback(b func(usage *ChatUsage)) ChatBaseOption {
+	return func(c *ChatBaseContext) {
+		c.UsageCallback = b
+	}
+}
+
 func WithChatBase_ErrHandler(b func(error)) ChatBaseOption {
 	return func(c *ChatBaseContext) {
 		c.ErrHandler = b
@@ -267,6 +274,9 @@ func ChatBase(url string, model string, msg string, chatOpts ...ChatBaseOption)
 	handleStream := streamHandler != nil
 	if handleStream {
 		msgIns.Stream = true
+		if ctx.UsageCallback != nil {
+			msgIns.StreamOptions = &StreamOptions{IncludeUsage: true}
+		}
 	}
 
 	raw, err := json.Marshal(msgIns)
@@ -279,7 +289,7 @@ func ChatBase(url string, model string, msg string, chatOpts ...ChatBaseOption)
 
 	var pr, reasonPr io.Reader
 	var cancel context.CancelFunc
-	pr, reasonPr, opts, cancel = appendStreamHandlerPoCOptionEx(opts)
+	pr, reasonPr, opts, cancel = appendStreamHandlerPoCOptionEx(opts, ctx.UsageCallback)
 	wg := new(sync.WaitGroup)
 
 	noMerge := false
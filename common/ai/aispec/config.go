@@ -39,6 +39,12 @@ type AIConfig struct {
 	HTTPErrorHandler func(error)
 
 	Images []*ImageDescription
+
+	// ThinkingBudget 为支持扩展思考的模型(如 Anthropic Claude)设置思考 token 预算, 0 表示不开启
+	ThinkingBudget int64
+
+	// UsageCallback 在上游返回 token 用量时调用
+	UsageCallback func(usage *ChatUsage)
 }
 
 func WithNoHTTPS(b bool) AIConfigOption {
@@ -252,6 +258,19 @@ func WithFunctionCallRetryTimes(times int) AIConfigOption {
 	}
 }
 
+func WithThinkingBudget(budget int64) AIConfigOption {
+	return func(c *AIConfig) {
+		c.ThinkingBudget = budget
+	}
+}
+
+// WithUsageCallback 设置获取上游返回的 token 用量的回调，流式请求会要求上游在最后返回用量
+func WithUsageCallback(h func(usage *ChatUsage)) AIConfigOption {
+	return func(c *AIConfig) {
+		c.UsageCallback = h
+	}
+}
+
 func WithHTTPErrorHandler(h func(error)) AIConfigOption {
 	return func(c *AIConfig) {
 		c.HTTPErrorHandler = h
@@ -87,3 +87,9 @@ type AIClient interface {
 type EmbeddingCaller interface {
 	Embedding(string) ([]float64, error)
 }
+
+// OpenAICompatibleChatter is implemented by gateways using the OpenAI compatible chat completions API,
+// the url is built from the loaded config, requests with OpenAI tools / functions can be sent to it as-is
+type OpenAICompatibleChatter interface {
+	ChatCompletionsURL() string
+}
@@ -12,10 +12,16 @@ import (
 )
 
 type ChatMessage struct {
-	Model          string       `json:"model"`
-	Messages       []ChatDetail `json:"messages"`
-	Stream         bool         `json:"stream"`
-	EnableThinking bool         `json:"enable_thinking"`
+	Model          string         `json:"model"`
+	Messages       []ChatDetail   `json:"messages"`
+	Stream         bool           `json:"stream"`
+	EnableThinking bool           `json:"enable_thinking"`
+	StreamOptions  *StreamOptions `json:"stream_options,omitempty"`
+}
+
+// StreamOptions 设置流式响应的选项，IncludeUsage 要求在最后一个 chunk 中返回 token 用量
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
 }
 
 type ChatDetail struct {
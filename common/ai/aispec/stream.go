@@ -3,6 +3,7 @@ package aispec
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/samber/lo"
 	"io"
@@ -38,12 +39,26 @@ func mergeReasonIntoOutputStream(reason io.Reader, out io.Reader) io.Reader {
 }
 
 func appendStreamHandlerPoCOption(opts []poc.PocConfigOption) (io.Reader, []poc.PocConfigOption) {
-	out, reason, opts, _ := appendStreamHandlerPoCOptionEx(opts)
+	out, reason, opts, _ := appendStreamHandlerPoCOptionEx(opts, nil)
 	pr := mergeReasonIntoOutputStream(reason, out)
 	return pr, opts
 }
 
-func appendStreamHandlerPoCOptionEx(opts []poc.PocConfigOption) (io.Reader, io.Reader, []poc.PocConfigOption, func()) {
+// extractChatUsage 提取响应或者流式 chunk 中上游返回的 token 用量，没有用量时返回 nil
+func extractChatUsage(raw string) *ChatUsage {
+	var chunk struct {
+		Usage *ChatUsage `json:"usage"`
+	}
+	if err := json.Unmarshal([]byte(raw), &chunk); err != nil || chunk.Usage == nil {
+		return nil
+	}
+	if chunk.Usage.PromptTokens <= 0 && chunk.Usage.CompletionTokens <= 0 && chunk.Usage.TotalTokens <= 0 {
+		return nil
+	}
+	return chunk.Usage
+}
+
+func appendStreamHandlerPoCOptionEx(opts []poc.PocConfigOption, usageCallback func(usage *ChatUsage)) (io.Reader, io.Reader, []poc.PocConfigOption, func()) {
 	outReader, outWriter := utils.NewBufPipe(nil)
 	reasonReader, reasonWriter := utils.NewBufPipe(nil)
 
@@ -115,6 +130,11 @@ func appendStreamHandlerPoCOptionEx(opts []poc.PocConfigOption) (io.Reader, io.R
 			//log.Infof("chunk read line: %v", lineStr)
 			jsonIdentifiers := jsonextractor.ExtractStandardJSON(lineStr)
 			for _, j := range jsonIdentifiers {
+				if usageCallback != nil {
+					if usage := extractChatUsage(j); usage != nil {
+						usageCallback(usage)
+					}
+				}
 				var reasonDelta string
 				if !reasonFinished {
 					reasonContent := jsonpath.Find(j, `$..choices[*].delta.reasoning_content`)
@@ -0,0 +1,181 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// imageBlock converts an http(s) url or a data:image/...;base64,... uri to an image content block
+func imageBlock(u string) *ContentBlock {
+	u = strings.TrimSpace(u)
+	if strings.HasPrefix(u, "data:") {
+		meta, data, ok := strings.Cut(strings.TrimPrefix(u, "data:"), ",")
+		if !ok || !strings.HasSuffix(meta, ";base64") {
+			log.Warnf("unsupported image data uri: %v", utils.ShrinkString(u, 64))
+			return nil
+		}
+		return &ContentBlock{Type: "image", Source: &ImageSource{
+			Type:      "base64",
+			MediaType: strings.TrimSuffix(meta, ";base64"),
+			Data:      data,
+		}}
+	}
+	if utils.IsHttpOrHttpsUrl(u) {
+		return &ContentBlock{Type: "image", Source: &ImageSource{Type: "url", URL: u}}
+	}
+	log.Warnf("unsupported image: %v", utils.ShrinkString(u, 64))
+	return nil
+}
+
+// contentBlocks converts the content of a chat detail, a string or OpenAI style
+// text / image_url parts, to content blocks
+func contentBlocks(content any) []*ContentBlock {
+	switch ret := content.(type) {
+	case nil:
+		return nil
+	case string:
+		if ret == "" {
+			return nil
+		}
+		return []*ContentBlock{{Type: "text", Text: ret}}
+	}
+
+	var parts []*aispec.ChatContent
+	raw, err := json.Marshal(content)
+	if err == nil {
+		err = json.Unmarshal(raw, &parts)
+	}
+	if err != nil {
+		return []*ContentBlock{{Type: "text", Text: utils.InterfaceToString(content)}}
+	}
+
+	var blocks []*ContentBlock
+	for _, part := range parts {
+		if part == nil {
+			continue
+		}
+		switch part.Type {
+		case "text":
+			if part.Text != "" {
+				blocks = append(blocks, &ContentBlock{Type: "text", Text: part.Text})
+			}
+		case "image_url":
+			u, ok := part.ImageUrl.(string)
+			if !ok {
+				u = utils.MapGetString(utils.InterfaceToGeneralMap(part.ImageUrl), "url")
+			}
+			if block := imageBlock(u); block != nil {
+				blocks = append(blocks, block)
+			}
+		default:
+			log.Warnf("unsupported chat content type: %v", part.Type)
+		}
+	}
+	return blocks
+}
+
+// convertMessages converts OpenAI style chat details to the system blocks and messages,
+// tool calls become tool_use blocks, tool messages become tool_result blocks of a user message,
+// and consecutive messages of the same role are merged as the API requires alternating roles
+func convertMessages(details []aispec.ChatDetail) ([]*ContentBlock, []*Message) {
+	var system []*ContentBlock
+	var messages []*Message
+	appendBlocks := func(role string, blocks ...*ContentBlock) {
+		if len(blocks) == 0 {
+			return
+		}
+		if n := len(messages); n > 0 && messages[n-1].Role == role {
+			messages[n-1].Content = append(messages[n-1].Content, blocks...)
+			return
+		}
+		messages = append(messages, &Message{Role: role, Content: blocks})
+	}
+
+	for _, detail := range details {
+		switch detail.Role {
+		case "system":
+			for _, block := range contentBlocks(detail.Content) {
+				if block.Type == "text" {
+					system = append(system, block)
+				}
+			}
+		case "assistant":
+			blocks := contentBlocks(detail.Content)
+			for _, call := range detail.ToolCalls {
+				if call == nil {
+					continue
+				}
+				blocks = append(blocks, &ContentBlock{
+					Type:  "tool_use",
+					ID:    call.ID,
+					Name:  call.Function.Name,
+					Input: toolInput(call.Function.Arguments),
+				})
+			}
+			appendBlocks("assistant", blocks...)
+		case "tool", "function":
+			result := &ContentBlock{Type: "tool_result", ToolUseID: detail.ToolCallID}
+			if text, ok := detail.Content.(string); ok {
+				result.Content = text
+			} else if blocks := contentBlocks(detail.Content); len(blocks) > 0 {
+				result.Content = blocks
+			}
+			appendBlocks("user", result)
+		default:
+			appendBlocks("user", contentBlocks(detail.Content)...)
+		}
+	}
+	return system, messages
+}
+
+// toolInput keeps the arguments if they are a json object, the input of tool_use must be an object
+func toolInput(arguments string) json.RawMessage {
+	arguments = strings.TrimSpace(arguments)
+	if strings.HasPrefix(arguments, "{") && json.Valid([]byte(arguments)) {
+		return json.RawMessage(arguments)
+	}
+	return json.RawMessage("{}")
+}
+
+// convertTools converts functions to tools, OpenAI tools ({"type":"function","function":{...}}),
+// legacy functions ({"name","description","parameters"}) and native tools ({"input_schema"}) are accepted
+func convertTools(functions []any) []*Tool {
+	var tools []*Tool
+	for _, function := range functions {
+		raw, err := json.Marshal(function)
+		if err != nil {
+			log.Warnf("marshal function failed: %v", err)
+			continue
+		}
+		var m map[string]any
+		if err := json.Unmarshal(raw, &m); err != nil {
+			log.Warnf("unsupported function: %v", utils.ShrinkString(string(raw), 128))
+			continue
+		}
+		if inner, ok := m["function"].(map[string]any); ok {
+			m = inner
+		}
+		name := utils.MapGetString(m, "name")
+		if name == "" {
+			log.Warnf("function without name: %v", utils.ShrinkString(string(raw), 128))
+			continue
+		}
+		schema := m["input_schema"]
+		if schema == nil {
+			schema = m["parameters"]
+		}
+		if schema == nil {
+			schema = map[string]any{"type": "object", "properties": map[string]any{}}
+		}
+		tools = append(tools, &Tool{
+			Name:        name,
+			Description: utils.MapGetString(m, "description"),
+			InputSchema: schema,
+		})
+	}
+	return tools
+}
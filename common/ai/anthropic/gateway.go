@@ -0,0 +1,552 @@
+package anthropic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httputil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/lowhttp/poc"
+)
+
+const (
+	defaultDomain    = "api.anthropic.com"
+	messagesPath     = "/v1/messages"
+	modelsPath       = "/v1/models"
+	apiVersion       = "2023-06-01"
+	defaultModel     = "claude-3-5-haiku-latest"
+	defaultMaxTokens = 8192
+
+	// extractToolName is the forced tool used by ExtractData
+	extractToolName = "extract_data"
+)
+
+// GatewayClient speaks the Anthropic Messages API, system prompts are sent as top-level system blocks,
+// tool calls / results are mapped to tool_use / tool_result content blocks and thinking deltas
+// are streamed to the reason stream handler
+type GatewayClient struct {
+	config *aispec.AIConfig
+
+	targetUrl string
+}
+
+var _ aispec.AIClient = (*GatewayClient)(nil)
+
+// messageResult is the message accumulated from the SSE stream
+type messageResult struct {
+	Model      string
+	Text       string
+	Thinking   string
+	ToolUses   []*ContentBlock
+	StopReason string
+	Usage      Usage
+}
+
+func (g *GatewayClient) LoadOption(opt ...aispec.AIConfigOption) {
+	config := aispec.NewDefaultAIConfig(opt...)
+	g.config = config
+
+	if g.config.Model == "" {
+		g.config.Model = defaultModel
+	}
+
+	if config.BaseURL != "" {
+		g.targetUrl = config.BaseURL
+	} else if config.Domain != "" {
+		if config.NoHttps {
+			g.targetUrl = "http://" + config.Domain + messagesPath
+		} else {
+			g.targetUrl = "https://" + config.Domain + messagesPath
+		}
+	} else {
+		g.targetUrl = "https://" + defaultDomain + messagesPath
+	}
+}
+
+func (g *GatewayClient) CheckValid() error {
+	if g.config.APIKey == "" {
+		return errors.New("APIKey is required")
+	}
+	return nil
+}
+
+func (g *GatewayClient) BuildHTTPOptions() ([]poc.PocConfigOption, error) {
+	opts := []poc.PocConfigOption{
+		poc.WithReplaceAllHttpPacketHeaders(map[string]string{
+			"Content-Type":      "application/json; charset=UTF-8",
+			"x-api-key":         g.config.APIKey,
+			"anthropic-version": apiVersion,
+		}),
+	}
+	if g.config.Proxy != "" {
+		opts = append(opts, poc.WithProxy(g.config.Proxy))
+	}
+	if g.config.Context != nil {
+		opts = append(opts, poc.WithContext(g.config.Context))
+	}
+	if g.config.Timeout > 0 {
+		opts = append(opts, poc.WithConnectTimeout(g.config.Timeout))
+	}
+	opts = append(opts, poc.WithTimeout(600))
+	return opts, nil
+}
+
+func (g *GatewayClient) SupportedStructuredStream() bool {
+	return true
+}
+
+func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
+	result, err := g.send(g.newRequest(nil, []*Message{g.userMessage(s)}, function))
+	if err != nil {
+		return "", err
+	}
+	if result.Text == "" && len(result.ToolUses) > 0 {
+		return string(result.ToolUses[0].Input), nil
+	}
+	return result.Text, nil
+}
+
+func (g *GatewayClient) ChatEx(details []aispec.ChatDetail, function ...any) ([]aispec.ChatChoice, error) {
+	system, messages := convertMessages(details)
+	if len(messages) == 0 {
+		return nil, utils.Error("no user or assistant message to send")
+	}
+	result, err := g.send(g.newRequest(system, messages, function))
+	if err != nil {
+		return nil, err
+	}
+
+	detail := aispec.ChatDetail{Role: "assistant", Content: result.Text}
+	for _, use := range result.ToolUses {
+		detail.ToolCalls = append(detail.ToolCalls, &aispec.ToolCall{
+			ID:   use.ID,
+			Type: "function",
+			Function: aispec.FuncReturn{
+				Name:      use.Name,
+				Arguments: string(use.Input),
+			},
+		})
+	}
+	return []aispec.ChatChoice{
+		{
+			Index:        0,
+			Message:      detail,
+			FinishReason: finishReason(result.StopReason),
+		},
+	}, nil
+}
+
+func (g *GatewayClient) ChatStream(s string) (io.Reader, error) {
+	pr, pw := utils.NewBufPipe(nil)
+	go func() {
+		defer pw.Close()
+		_, reasonWriter, wait := startStreamHandlers(nil, g.config.ReasonStreamHandler)
+		defer wait()
+		_, err := g.createMessage(g.newRequest(nil, []*Message{g.userMessage(s)}, nil), pw, reasonWriter)
+		if err != nil {
+			log.Errorf("anthropic chat stream failed: %v", err)
+		}
+	}()
+	return pr, nil
+}
+
+func (g *GatewayClient) StructuredStream(s string, function ...any) (chan *aispec.StructuredData, error) {
+	ch := make(chan *aispec.StructuredData, 1000)
+	go func() {
+		defer close(ch)
+
+		id := 0
+		m := new(sync.Mutex)
+		textHandler, reasonHandler, wait := startStreamHandlers(g.config.StreamHandler, g.config.ReasonStreamHandler)
+		textWriter := io.MultiWriter(&structuredWriter{id: &id, mutex: m, ch: ch}, textHandler)
+		reasonWriter := io.MultiWriter(&structuredWriter{id: &id, mutex: m, ch: ch, isReason: true}, reasonHandler)
+
+		result, err := g.createMessage(g.newRequest(nil, []*Message{g.userMessage(s)}, function), textWriter, reasonWriter)
+		wait()
+		if err != nil {
+			log.Errorf("structured stream error: %v", err)
+			return
+		}
+		m.Lock()
+		id++
+		ch <- &aispec.StructuredData{
+			Id:        fmt.Sprint(id),
+			Event:     "data",
+			HaveUsage: true,
+			ModelUsage: []aispec.UsageStatsInfo{
+				{Model: result.Model, InputToken: result.Usage.InputTokens, OutputToken: result.Usage.OutputTokens},
+			},
+		}
+		m.Unlock()
+	}()
+	return ch, nil
+}
+
+// ExtractData forces the model to call a tool whose input schema is built from fields,
+// the tool input is the extracted data
+func (g *GatewayClient) ExtractData(msg string, desc string, fields map[string]any) (map[string]any, error) {
+	if len(fields) <= 0 {
+		return nil, utils.Error("no fields config for extract")
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	properties := make(map[string]any, len(fields))
+	for _, k := range keys {
+		properties[k] = map[string]any{"description": fmt.Sprint(fields[k])}
+	}
+
+	prompt := msg
+	if desc != "" {
+		prompt += "\n" + desc
+	}
+	req := g.newRequest(nil, []*Message{g.userMessage(prompt)}, nil)
+	req.Tools = []*Tool{{
+		Name:        extractToolName,
+		Description: "extract the data from the input",
+		InputSchema: map[string]any{"type": "object", "properties": properties, "required": keys},
+	}}
+	if req.Thinking == nil {
+		// forced tool use is not allowed with extended thinking
+		req.ToolChoice = &ToolChoice{Type: "tool", Name: extractToolName}
+	}
+
+	result, err := g.send(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, use := range result.ToolUses {
+		if use.Name != extractToolName {
+			continue
+		}
+		var data map[string]any
+		if err := json.Unmarshal(use.Input, &data); err != nil {
+			return nil, utils.Errorf("unmarshal tool input failed: %v", err)
+		}
+		return data, nil
+	}
+	return aispec.ExtractFromResult(result.Text, fields)
+}
+
+func (g *GatewayClient) GetModelList() ([]*aispec.ModelMeta, error) {
+	opts, err := g.BuildHTTPOptions()
+	if err != nil {
+		return nil, err
+	}
+	modelsUrl := g.modelsUrl()
+	rsp, _, err := poc.DoGET(modelsUrl, opts...)
+	if err != nil {
+		return nil, utils.Errorf("request get to %v: %v", modelsUrl, err)
+	}
+	body := rsp.GetBody()
+	if code := rsp.GetStatusCode(); code >= 300 {
+		return nil, utils.Errorf("anthropic api responded %d: %s", code, parseErrorMessage(body))
+	}
+	var models modelListResponse
+	if err := json.Unmarshal(body, &models); err != nil {
+		return nil, utils.Errorf("unmarshal model list failed: %v", err)
+	}
+	var result []*aispec.ModelMeta
+	for _, model := range models.Data {
+		result = append(result, &aispec.ModelMeta{Id: model.ID, Object: "model", OwnedBy: "anthropic"})
+	}
+	return result, nil
+}
+
+func (g *GatewayClient) modelsUrl() string {
+	if strings.HasSuffix(g.targetUrl, messagesPath) {
+		return strings.TrimSuffix(g.targetUrl, messagesPath) + modelsPath
+	}
+	u, err := utils.ParseStringUrlToUrlInstance(g.targetUrl)
+	if err != nil {
+		return g.targetUrl
+	}
+	return u.Scheme + "://" + u.Host + modelsPath
+}
+
+// userMessage builds a user message with the text and the configured images
+func (g *GatewayClient) userMessage(s string) *Message {
+	var content []*ContentBlock
+	for _, image := range g.config.Images {
+		if block := imageBlock(image.Url); block != nil {
+			content = append(content, block)
+		}
+	}
+	content = append(content, &ContentBlock{Type: "text", Text: s})
+	return &Message{Role: "user", Content: content}
+}
+
+func (g *GatewayClient) newRequest(system []*ContentBlock, messages []*Message, functions []any) *MessageRequest {
+	req := &MessageRequest{
+		Model:     g.config.Model,
+		MaxTokens: defaultMaxTokens,
+		System:    system,
+		Messages:  messages,
+		Tools:     convertTools(functions),
+	}
+	if budget := g.config.ThinkingBudget; budget > 0 {
+		req.Thinking = &Thinking{Type: "enabled", BudgetTokens: budget}
+		if req.MaxTokens <= budget {
+			req.MaxTokens = budget + defaultMaxTokens
+		}
+	}
+	return req
+}
+
+// send creates the message with the configured stream handlers
+func (g *GatewayClient) send(req *MessageRequest) (*messageResult, error) {
+	textWriter, reasonWriter, wait := startStreamHandlers(g.config.StreamHandler, g.config.ReasonStreamHandler)
+	defer wait()
+	result, err := g.createMessage(req, textWriter, reasonWriter)
+	if err == nil && g.config.UsageCallback != nil && (result.Usage.InputTokens > 0 || result.Usage.OutputTokens > 0) {
+		g.config.UsageCallback(&aispec.ChatUsage{
+			PromptTokens:     result.Usage.InputTokens,
+			CompletionTokens: result.Usage.OutputTokens,
+			TotalTokens:      result.Usage.InputTokens + result.Usage.OutputTokens,
+		})
+	}
+	return result, err
+}
+
+// createMessage posts the request as a stream, text deltas are written to textWriter
+// and thinking deltas are written to reasonWriter
+func (g *GatewayClient) createMessage(req *MessageRequest, textWriter io.Writer, reasonWriter io.Writer) (*messageResult, error) {
+	req.Stream = true
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return nil, utils.Errorf("marshal message failed: %v", err)
+	}
+	opts, err := g.BuildHTTPOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &messageResult{Model: req.Model}
+	var streamErr error
+	opts = append(opts,
+		poc.WithReplaceHttpPacketHeader("Accept", "text/event-stream"),
+		poc.WithReplaceHttpPacketBody(raw, false),
+		poc.WithBodyStreamReaderHandler(func(header []byte, closer io.ReadCloser) {
+			defer closer.Close()
+
+			var reader io.Reader = closer
+			if utils.IContains(lowhttp.GetHTTPPacketHeader(header, "Transfer-Encoding"), "chunked") {
+				reader = httputil.NewChunkedReader(reader)
+			}
+			if code := lowhttp.GetStatusCodeFromResponse(header); code >= 300 {
+				body, _ := io.ReadAll(io.LimitReader(reader, 1<<20))
+				streamErr = utils.Errorf("anthropic api responded %d: %s", code, parseErrorMessage(body))
+				return
+			}
+			streamErr = result.readEvents(reader, textWriter, reasonWriter)
+		}),
+	)
+
+	_, _, err = poc.DoPOST(g.targetUrl, opts...)
+	if err != nil {
+		err = utils.Errorf("request post to %v: %v", g.targetUrl, err)
+	} else if streamErr != nil {
+		err = streamErr
+	}
+	if err != nil {
+		if g.config.HTTPErrorHandler != nil {
+			g.config.HTTPErrorHandler(err)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// readEvents reads the server-sent events of the message stream
+func (r *messageResult) readEvents(reader io.Reader, textWriter io.Writer, reasonWriter io.Writer) error {
+	var text, thinking strings.Builder
+	blocks := make(map[int]*ContentBlock)
+	inputs := make(map[int]*strings.Builder)
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("data:")) {
+			continue
+		}
+		var event StreamEvent
+		if err := json.Unmarshal(bytes.TrimSpace(line[len("data:"):]), &event); err != nil {
+			log.Warnf("unmarshal anthropic stream event failed: %v", err)
+			continue
+		}
+
+		switch event.Type {
+		case "message_start":
+			if event.Message == nil {
+				continue
+			}
+			if event.Message.Model != "" {
+				r.Model = event.Message.Model
+			}
+			if event.Message.Usage != nil {
+				r.Usage = *event.Message.Usage
+			}
+		case "content_block_start":
+			block := event.ContentBlock
+			if block == nil {
+				continue
+			}
+			blocks[event.Index] = block
+			switch block.Type {
+			case "tool_use":
+				inputs[event.Index] = new(strings.Builder)
+			case "text":
+				if block.Text != "" {
+					text.WriteString(block.Text)
+					textWriter.Write([]byte(block.Text))
+				}
+			}
+		case "content_block_delta":
+			delta := event.Delta
+			if delta == nil {
+				continue
+			}
+			switch delta.Type {
+			case "text_delta":
+				text.WriteString(delta.Text)
+				textWriter.Write([]byte(delta.Text))
+			case "thinking_delta":
+				thinking.WriteString(delta.Thinking)
+				reasonWriter.Write([]byte(delta.Thinking))
+			case "input_json_delta":
+				if input, ok := inputs[event.Index]; ok {
+					input.WriteString(delta.PartialJSON)
+				}
+			case "signature_delta":
+				if block, ok := blocks[event.Index]; ok {
+					block.Signature += delta.Signature
+				}
+			}
+		case "content_block_stop":
+			block, ok := blocks[event.Index]
+			if !ok || block.Type != "tool_use" {
+				continue
+			}
+			input := strings.TrimSpace(inputs[event.Index].String())
+			if input == "" {
+				input = "{}"
+			}
+			block.Input = json.RawMessage(input)
+			r.ToolUses = append(r.ToolUses, block)
+		case "message_delta":
+			if event.Delta != nil && event.Delta.StopReason != "" {
+				r.StopReason = event.Delta.StopReason
+			}
+			if event.Usage != nil {
+				if event.Usage.InputTokens > 0 {
+					r.Usage.InputTokens = event.Usage.InputTokens
+				}
+				r.Usage.OutputTokens = event.Usage.OutputTokens
+			}
+		case "error":
+			if event.Error != nil {
+				return utils.Errorf("anthropic stream error: %s: %s", event.Error.Type, event.Error.Message)
+			}
+			return utils.Error("anthropic stream error")
+		}
+	}
+	r.Text = text.String()
+	r.Thinking = thinking.String()
+	if err := scanner.Err(); err != nil {
+		return utils.Errorf("read anthropic stream failed: %v", err)
+	}
+	return nil
+}
+
+// startStreamHandlers feeds the text and thinking streams to the handlers, a nil handler discards its stream,
+// wait closes the streams and waits for the handlers to return
+func startStreamHandlers(textHandler, reasonHandler func(io.Reader)) (textWriter io.Writer, reasonWriter io.Writer, wait func()) {
+	wg := new(sync.WaitGroup)
+	var closers []io.Closer
+	start := func(handler func(io.Reader)) io.Writer {
+		if handler == nil {
+			return io.Discard
+		}
+		pr, pw := utils.NewBufPipe(nil)
+		closers = append(closers, pw)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if err := recover(); err != nil {
+					log.Warnf("stream handler panic: %v", err)
+				}
+			}()
+			handler(pr)
+		}()
+		return pw
+	}
+	textWriter = start(textHandler)
+	reasonWriter = start(reasonHandler)
+	return textWriter, reasonWriter, func() {
+		for _, closer := range closers {
+			closer.Close()
+		}
+		wg.Wait()
+	}
+}
+
+type structuredWriter struct {
+	id       *int
+	mutex    *sync.Mutex
+	ch       chan *aispec.StructuredData
+	isReason bool
+}
+
+func (s *structuredWriter) Write(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	*s.id++
+	data := &aispec.StructuredData{Id: fmt.Sprint(*s.id), Event: "data"}
+	if s.isReason {
+		data.OutputReason = string(p)
+	} else {
+		data.OutputText = string(p)
+	}
+	s.ch <- data
+	return len(p), nil
+}
+
+// parseErrorMessage extracts the message of {"type":"error","error":{"type":"...","message":"..."}}
+func parseErrorMessage(body []byte) string {
+	var rsp struct {
+		Error *APIError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &rsp); err == nil && rsp.Error != nil {
+		return rsp.Error.Type + ": " + rsp.Error.Message
+	}
+	return utils.ShrinkString(string(body), 512)
+}
+
+// finishReason maps the stop reason to the OpenAI finish reason
+func finishReason(stopReason string) string {
+	switch stopReason {
+	case "end_turn", "stop_sequence", "":
+		return "stop"
+	case "tool_use":
+		return "tool_calls"
+	case "max_tokens":
+		return "length"
+	default:
+		return stopReason
+	}
+}
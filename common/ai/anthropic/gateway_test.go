@@ -0,0 +1,239 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/ai/aispec"
+)
+
+type mockServer struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	requests []map[string]any
+}
+
+func (m *mockServer) lastRequest() map[string]any {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if len(m.requests) == 0 {
+		return nil
+	}
+	return m.requests[len(m.requests)-1]
+}
+
+// newMockServer serves /v1/messages with the given SSE events and /v1/models with a fixed list
+func newMockServer(t *testing.T, events ...string) *mockServer {
+	m := &mockServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-key", r.Header.Get("x-api-key"))
+		assert.Equal(t, apiVersion, r.Header.Get("anthropic-version"))
+		switch r.URL.Path {
+		case modelsPath:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"data":[{"type":"model","id":"claude-sonnet-4-0","display_name":"Claude Sonnet 4"},{"type":"model","id":"claude-3-5-haiku-latest"}],"has_more":false}`)
+		case messagesPath:
+			var req map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			m.mutex.Lock()
+			m.requests = append(m.requests, req)
+			m.mutex.Unlock()
+
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, event := range events {
+				var typ struct {
+					Type string `json:"type"`
+				}
+				_ = json.Unmarshal([]byte(event), &typ)
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", typ.Type, event)
+				w.(http.Flusher).Flush()
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return m
+}
+
+func newTestClient(server *mockServer, opts ...aispec.AIConfigOption) *GatewayClient {
+	client := &GatewayClient{}
+	client.LoadOption(append([]aispec.AIConfigOption{
+		aispec.WithBaseURL(server.URL + messagesPath),
+		aispec.WithAPIKey("test-key"),
+	}, opts...)...)
+	return client
+}
+
+func readAllAsync(r io.Reader, result *string, wg *sync.WaitGroup) {
+	defer wg.Done()
+	raw, _ := io.ReadAll(r)
+	*result = string(raw)
+}
+
+func TestChat_StreamTextAndThinking(t *testing.T) {
+	server := newMockServer(t,
+		`{"type":"message_start","message":{"id":"msg_1","model":"claude-sonnet-4-0","usage":{"input_tokens":12,"output_tokens":1}}}`,
+		`{"type":"content_block_start","index":0,"content_block":{"type":"thinking","thinking":""}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"thinking_delta","thinking":"Let me "}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"thinking_delta","thinking":"think."}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"signature_delta","signature":"sig"}}`,
+		`{"type":"content_block_stop","index":0}`,
+		`{"type":"content_block_start","index":1,"content_block":{"type":"text","text":""}}`,
+		`{"type":"content_block_delta","index":1,"delta":{"type":"text_delta","text":"Hello"}}`,
+		`{"type":"content_block_delta","index":1,"delta":{"type":"text_delta","text":", world"}}`,
+		`{"type":"content_block_stop","index":1}`,
+		`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":20}}`,
+		`{"type":"message_stop"}`,
+	)
+	defer server.Close()
+
+	var text, reason string
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+	client := newTestClient(server,
+		aispec.WithThinkingBudget(2048),
+		aispec.WithChatImageContent("data:image/png;base64,iVBORw0KGgo=", "https://example.com/a.png"),
+		aispec.WithStreamHandler(func(r io.Reader) { readAllAsync(r, &text, wg) }),
+		aispec.WithReasonStreamHandler(func(r io.Reader) { readAllAsync(r, &reason, wg) }),
+	)
+
+	result, err := client.Chat("hi")
+	require.NoError(t, err)
+	wg.Wait()
+	assert.Equal(t, "Hello, world", result)
+	assert.Equal(t, "Hello, world", text)
+	assert.Equal(t, "Let me think.", reason)
+
+	req := server.lastRequest()
+	assert.Equal(t, true, req["stream"])
+	assert.Equal(t, defaultModel, req["model"])
+	assert.Equal(t, map[string]any{"type": "enabled", "budget_tokens": float64(2048)}, req["thinking"])
+	content := req["messages"].([]any)[0].(map[string]any)["content"].([]any)
+	require.Len(t, content, 3)
+	assert.Equal(t, map[string]any{"type": "base64", "media_type": "image/png", "data": "iVBORw0KGgo="}, content[0].(map[string]any)["source"])
+	assert.Equal(t, map[string]any{"type": "url", "url": "https://example.com/a.png"}, content[1].(map[string]any)["source"])
+	assert.Equal(t, map[string]any{"type": "text", "text": "hi"}, content[2])
+}
+
+func TestChatEx_ToolUse(t *testing.T) {
+	server := newMockServer(t,
+		`{"type":"message_start","message":{"id":"msg_2","model":"claude-sonnet-4-0","usage":{"input_tokens":30}}}`,
+		`{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Checking."}}`,
+		`{"type":"content_block_stop","index":0}`,
+		`{"type":"content_block_start","index":1,"content_block":{"type":"tool_use","id":"toolu_2","name":"get_weather","input":{}}}`,
+		`{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"city\":"}}`,
+		`{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"\"Shanghai\"}"}}`,
+		`{"type":"content_block_stop","index":1}`,
+		`{"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":15}}`,
+		`{"type":"message_stop"}`,
+	)
+	defer server.Close()
+	client := newTestClient(server)
+
+	tool := map[string]any{
+		"type": "function",
+		"function": map[string]any{
+			"name":        "get_weather",
+			"description": "get the weather of a city",
+			"parameters":  map[string]any{"type": "object", "properties": map[string]any{"city": map[string]any{"type": "string"}}},
+		},
+	}
+	choices, err := client.ChatEx([]aispec.ChatDetail{
+		{Role: "system", Content: "you are a weather bot"},
+		{Role: "user", Content: "weather of Beijing?"},
+		{Role: "assistant", ToolCalls: []*aispec.ToolCall{{ID: "toolu_1", Type: "function", Function: aispec.FuncReturn{Name: "get_weather", Arguments: `{"city":"Beijing"}`}}}},
+		{Role: "tool", ToolCallID: "toolu_1", Content: "sunny"},
+		{Role: "user", Content: []*aispec.ChatContent{{Type: "text", Text: "and Shanghai?"}}},
+	}, tool)
+	require.NoError(t, err)
+	require.Len(t, choices, 1)
+	assert.Equal(t, "tool_calls", choices[0].FinishReason)
+	assert.Equal(t, "Checking.", choices[0].Message.Content)
+	require.Len(t, choices[0].Message.ToolCalls, 1)
+	assert.Equal(t, "toolu_2", choices[0].Message.ToolCalls[0].ID)
+	assert.Equal(t, "get_weather", choices[0].Message.ToolCalls[0].Function.Name)
+	assert.Equal(t, `{"city":"Shanghai"}`, choices[0].Message.ToolCalls[0].Function.Arguments)
+
+	req := server.lastRequest()
+	assert.Equal(t, []any{map[string]any{"type": "text", "text": "you are a weather bot"}}, req["system"])
+	tools := req["tools"].([]any)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_weather", tools[0].(map[string]any)["name"])
+	assert.NotNil(t, tools[0].(map[string]any)["input_schema"])
+
+	// tool result and the following user text are merged into one user turn
+	messages := req["messages"].([]any)
+	require.Len(t, messages, 3)
+	assistant := messages[1].(map[string]any)
+	assert.Equal(t, "assistant", assistant["role"])
+	assert.Equal(t, map[string]any{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": map[string]any{"city": "Beijing"}}, assistant["content"].([]any)[0])
+	user := messages[2].(map[string]any)
+	assert.Equal(t, "user", user["role"])
+	assert.Equal(t, []any{
+		map[string]any{"type": "tool_result", "tool_use_id": "toolu_1", "content": "sunny"},
+		map[string]any{"type": "text", "text": "and Shanghai?"},
+	}, user["content"])
+}
+
+func TestExtractData_ForcedToolUse(t *testing.T) {
+	server := newMockServer(t,
+		`{"type":"message_start","message":{"id":"msg_3","model":"claude-3-5-haiku-latest","usage":{"input_tokens":5}}}`,
+		`{"type":"content_block_start","index":0,"content_block":{"type":"tool_use","id":"toolu_3","name":"extract_data","input":{}}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":"{\"name\":\"yaklang\",\"stars\":100}"}}`,
+		`{"type":"content_block_stop","index":0}`,
+		`{"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":8}}`,
+	)
+	defer server.Close()
+	client := newTestClient(server)
+
+	data, err := client.ExtractData("yaklang has 100 stars", "extract the repo info", map[string]any{"name": "repo name", "stars": "star count"})
+	require.NoError(t, err)
+	assert.Equal(t, "yaklang", data["name"])
+	assert.Equal(t, float64(100), data["stars"])
+
+	req := server.lastRequest()
+	assert.Equal(t, map[string]any{"type": "tool", "name": extractToolName}, req["tool_choice"])
+	schema := req["tools"].([]any)[0].(map[string]any)["input_schema"].(map[string]any)
+	assert.Equal(t, []any{"name", "stars"}, schema["required"])
+	assert.Equal(t, map[string]any{"description": "star count"}, schema["properties"].(map[string]any)["stars"])
+}
+
+func TestStructuredStreamAndErrors(t *testing.T) {
+	server := newMockServer(t,
+		`{"type":"message_start","message":{"id":"msg_4","model":"claude-3-5-haiku-latest","usage":{"input_tokens":3}}}`,
+		`{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"ok"}}`,
+		`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":2}}`,
+		`{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+	)
+	defer server.Close()
+	client := newTestClient(server, aispec.WithHTTPErrorHandler(func(err error) {}))
+
+	// an error event in the middle of the stream fails the request
+	_, err := client.Chat("hi")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Overloaded")
+
+	ch, err := client.StructuredStream("hi")
+	require.NoError(t, err)
+	var text string
+	for data := range ch {
+		text += data.OutputText
+		assert.False(t, data.HaveUsage)
+	}
+	assert.Equal(t, "ok", text)
+
+	models, err := client.GetModelList()
+	require.NoError(t, err)
+	require.Len(t, models, 2)
+	assert.Equal(t, "claude-sonnet-4-0", models[0].Id)
+}
@@ -0,0 +1,123 @@
+package anthropic
+
+import "encoding/json"
+
+// Anthropic Messages API, see https://docs.anthropic.com/en/api/messages
+
+// MessageRequest is the request body of POST /v1/messages
+type MessageRequest struct {
+	Model      string          `json:"model"`
+	MaxTokens  int64           `json:"max_tokens"`
+	System     []*ContentBlock `json:"system,omitempty"`
+	Messages   []*Message      `json:"messages"`
+	Tools      []*Tool         `json:"tools,omitempty"`
+	ToolChoice *ToolChoice     `json:"tool_choice,omitempty"`
+	Thinking   *Thinking       `json:"thinking,omitempty"`
+	Stream     bool            `json:"stream"`
+}
+
+// Message is a user or assistant turn, consecutive turns of the same role must be merged
+type Message struct {
+	Role    string          `json:"role"`
+	Content []*ContentBlock `json:"content"`
+}
+
+// ContentBlock is one of text / image / tool_use / tool_result / thinking / redacted_thinking blocks
+type ContentBlock struct {
+	Type string `json:"type"`
+
+	// text
+	Text string `json:"text,omitempty"`
+
+	// image
+	Source *ImageSource `json:"source,omitempty"`
+
+	// tool_use
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Input json.RawMessage `json:"input,omitempty"`
+
+	// tool_result
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	Content   any    `json:"content,omitempty"`
+	IsError   bool   `json:"is_error,omitempty"`
+
+	// thinking / redacted_thinking
+	Thinking  string `json:"thinking,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Data      string `json:"data,omitempty"`
+}
+
+// ImageSource is a base64 encoded image or an image url
+type ImageSource struct {
+	Type      string `json:"type"` // base64 / url
+	MediaType string `json:"media_type,omitempty"`
+	Data      string `json:"data,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// Tool is a client tool, InputSchema is a json schema of the input object
+type Tool struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	InputSchema any    `json:"input_schema"`
+}
+
+// ToolChoice is auto / any / tool / none
+type ToolChoice struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+// Thinking enables extended thinking, BudgetTokens must be less than max_tokens
+type Thinking struct {
+	Type         string `json:"type"`
+	BudgetTokens int64  `json:"budget_tokens"`
+}
+
+type Usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type APIError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// StreamEvent is the data of a server-sent event of the streaming Messages API
+type StreamEvent struct {
+	Type         string        `json:"type"`
+	Index        int           `json:"index"`
+	Message      *MessageInfo  `json:"message,omitempty"`
+	ContentBlock *ContentBlock `json:"content_block,omitempty"`
+	Delta        *StreamDelta  `json:"delta,omitempty"`
+	Usage        *Usage        `json:"usage,omitempty"`
+	Error        *APIError     `json:"error,omitempty"`
+}
+
+type MessageInfo struct {
+	ID    string `json:"id"`
+	Model string `json:"model"`
+	Usage *Usage `json:"usage,omitempty"`
+}
+
+// StreamDelta is the delta of content_block_delta (text_delta / input_json_delta / thinking_delta / signature_delta)
+// or message_delta events
+type StreamDelta struct {
+	Type        string `json:"type"`
+	Text        string `json:"text,omitempty"`
+	PartialJSON string `json:"partial_json,omitempty"`
+	Thinking    string `json:"thinking,omitempty"`
+	Signature   string `json:"signature,omitempty"`
+	StopReason  string `json:"stop_reason,omitempty"`
+}
+
+type modelListResponse struct {
+	Data []struct {
+		ID          string `json:"id"`
+		Type        string `json:"type"`
+		DisplayName string `json:"display_name"`
+		CreatedAt   string `json:"created_at"`
+	} `json:"data"`
+}
@@ -19,6 +19,10 @@ func (g *GLMClient) GetModelList() ([]*aispec.ModelMeta, error) {
 	return aispec.ListChatModels(g.targetUrl, g.BuildHTTPOptions)
 }
 
+func (g *GLMClient) ChatCompletionsURL() string {
+	return g.targetUrl
+}
+
 func (g *GLMClient) SupportedStructuredStream() bool {
 	return true
 }
@@ -36,6 +40,7 @@ func (g *GLMClient) StructuredStream(s string, function ...any) (chan *aispec.St
 }
 
 var _ aispec.AIClient = (*GLMClient)(nil)
+var _ aispec.OpenAICompatibleChatter = (*GLMClient)(nil)
 
 func (g *GLMClient) ChatStream(msg string) (io.Reader, error) {
 	return aispec.ChatWithStream(
@@ -110,6 +115,7 @@ func (g *GLMClient) Chat(s string, f ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 	)
 }
 
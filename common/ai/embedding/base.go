@@ -3,7 +3,7 @@ package embedding
 import (
 	"encoding/json"
 	"fmt"
-	"path/filepath"
+	"strings"
 
 	"github.com/yaklang/yaklang/common/ai/aispec"
 	"github.com/yaklang/yaklang/common/utils"
@@ -38,6 +38,15 @@ type embeddingItem struct {
 
 type embeddingResponse []embeddingItem
 
+// openaiEmbeddingResponse is the response of OpenAI compatible /v1/embeddings
+type openaiEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+	Error *aispec.ChatError `json:"error,omitempty"`
+}
+
 func (c *OpenaiEmbeddingClient) Embedding(text string) ([]float64, error) {
 	// Prepare the request
 	req := embeddingRequest{
@@ -56,7 +65,10 @@ func (c *OpenaiEmbeddingClient) Embedding(text string) ([]float64, error) {
 
 	var targetUrl string
 	if c.config.BaseURL != "" {
-		targetUrl = filepath.Join(c.config.BaseURL, "/embeddings")
+		targetUrl = strings.TrimRight(c.config.BaseURL, "/")
+		if !strings.HasSuffix(targetUrl, "/embeddings") {
+			targetUrl += "/embeddings"
+		}
 	} else if c.config.Domain != "" {
 		if c.config.NoHttps {
 			targetUrl = fmt.Sprintf("http://%s/embeddings", c.config.Domain)
@@ -103,7 +115,27 @@ func (c *OpenaiEmbeddingClient) Embedding(text string) ([]float64, error) {
 	// Get response body
 	body := lowhttp.GetHTTPPacketBody(rspInst.RawPacket)
 
-	// Parse the response
+	return parseEmbeddingResponse(body)
+}
+
+// parseEmbeddingResponse parses both OpenAI compatible responses ({"data": [{"embedding": [...]}]})
+// and llama.cpp server responses ([{"index": 0, "embedding": [[...]]}])
+func parseEmbeddingResponse(body []byte) ([]float64, error) {
+	body = []byte(strings.TrimSpace(string(body)))
+	if strings.HasPrefix(string(body), "{") {
+		var response openaiEmbeddingResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, utils.Errorf("unmarshal response failed: %v", err)
+		}
+		if response.Error != nil {
+			return nil, utils.Errorf("embedding failed: %v", response.Error.Message)
+		}
+		if len(response.Data) == 0 || len(response.Data[0].Embedding) == 0 {
+			return nil, utils.Errorf("no embedding data returned")
+		}
+		return response.Data[0].Embedding, nil
+	}
+
 	var response embeddingResponse
 	if err := json.Unmarshal(body, &response); err != nil {
 		return nil, utils.Errorf("unmarshal response failed: %v", err)
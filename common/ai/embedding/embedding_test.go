@@ -15,3 +15,25 @@ func TestOpenaiEmbeddingClient_Embedding(t *testing.T) {
 	}
 	fmt.Println(embedding)
 }
+
+func TestParseEmbeddingResponse(t *testing.T) {
+	vector, err := parseEmbeddingResponse([]byte(`{"object":"list","data":[{"object":"embedding","index":0,"embedding":[0.1,0.2]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vector) != 2 || vector[1] != 0.2 {
+		t.Fatalf("unexpected embedding: %v", vector)
+	}
+
+	vector, err = parseEmbeddingResponse([]byte(`[{"index":0,"embedding":[[0.3,0.4,0.5]]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vector) != 3 || vector[0] != 0.3 {
+		t.Fatalf("unexpected embedding: %v", vector)
+	}
+
+	if _, err = parseEmbeddingResponse([]byte(`{"error":{"message":"invalid model"}}`)); err == nil {
+		t.Fatal("expect error for error response")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/yaklang/yaklang/common/ai/anthropic"
 	"github.com/yaklang/yaklang/common/ai/dashscopebase"
 	"github.com/yaklang/yaklang/common/ai/deepseek"
 	"github.com/yaklang/yaklang/common/ai/gemini"
@@ -57,6 +58,9 @@ func init() {
 	aispec.Register("gemini", func() aispec.AIClient {
 		return &gemini.Client{}
 	})
+	aispec.Register("anthropic", func() aispec.AIClient {
+		return &anthropic.GatewayClient{}
+	})
 	aispec.Register("yaklang-writer", func() aispec.AIClient {
 		return dashscopebase.CreateDashScopeGateway("a51e9af5a60f40c983dac6ed50dba15b")
 	})
@@ -102,6 +106,7 @@ func (g *Gateway) Chat(s string, f ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.Config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.Config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.Config.Images...),
+		aispec.WithChatBase_UsageCallback(g.Config.UsageCallback),
 	)
 }
 
@@ -418,4 +423,5 @@ var Exports = map[string]any{
 	"debugStream":        aispec.WithDebugStream,
 	"type":               aispec.WithType,
 	"imageFile":          aispec.WithImageFile,
+	"thinkingBudget":     aispec.WithThinkingBudget,
 }
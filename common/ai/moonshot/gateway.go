@@ -15,11 +15,16 @@ type GatewayClient struct {
 }
 
 var _ aispec.AIClient = (*GatewayClient)(nil)
+var _ aispec.OpenAICompatibleChatter = (*GatewayClient)(nil)
 
 func (g *GatewayClient) GetModelList() ([]*aispec.ModelMeta, error) {
 	return aispec.ListChatModels(g.targetUrl, g.BuildHTTPOptions)
 }
 
+func (g *GatewayClient) ChatCompletionsURL() string {
+	return g.targetUrl
+}
+
 func (g *GatewayClient) SupportedStructuredStream() bool {
 	return true
 }
@@ -49,6 +54,7 @@ func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 	)
 }
 
@@ -21,6 +21,14 @@ func (g *GatewayClient) GetModelList() ([]*aispec.ModelMeta, error) {
 	return aispec.ListChatModels(g.targetUrl, g.BuildHTTPOptions)
 }
 
+// ChatCompletionsURL returns the OpenAI compatible chat completions url, empty when the native api is used
+func (g *GatewayClient) ChatCompletionsURL() string {
+	if !g.useOpenAIFormat {
+		return ""
+	}
+	return g.targetUrl
+}
+
 func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
 	return aispec.ChatBase(g.targetUrl, g.config.Model,
 		s,
@@ -30,6 +38,7 @@ func (g *GatewayClient) Chat(s string, function ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 	)
 }
 func (g *GatewayClient) ChatEx(details []aispec.ChatDetail, function ...any) ([]aispec.ChatChoice, error) {
@@ -131,6 +140,7 @@ func (g *GatewayClient) CheckValid() error {
 }
 
 var _ aispec.AIClient = (*GatewayClient)(nil)
+var _ aispec.OpenAICompatibleChatter = (*GatewayClient)(nil)
 
 func (g *GatewayClient) SupportedStructuredStream() bool { return true }
 
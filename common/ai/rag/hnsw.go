@@ -0,0 +1,444 @@
+package rag
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	defaultHNSWM              = 16
+	defaultHNSWEfConstruction = 200
+	defaultHNSWEfSearch       = 64
+)
+
+// hnswNode 是 HNSW 图中的一个节点，向量在插入时已归一化
+type hnswNode struct {
+	id      string
+	vector  []float64
+	level   int
+	friends [][]string
+}
+
+// hnswCandidate 表示搜索过程中的候选节点，distance 为 1 - 余弦相似度
+type hnswCandidate struct {
+	id       string
+	distance float64
+}
+
+// hnswGraph 是一个以文档 ID 为键的 HNSW 近似最近邻图
+// 它本身不是并发安全的，由持有它的向量存储负责加锁
+type hnswGraph struct {
+	m              int
+	mMax0          int
+	efConstruction int
+	efSearch       int
+	levelMult      float64
+
+	dimension int
+	nodes     map[string]*hnswNode
+	entry     string
+	maxLevel  int
+	rand      *rand.Rand
+
+	// dirty 记录自上次持久化以来被修改过的节点，removed 记录被删除的节点
+	dirty   map[string]struct{}
+	removed map[string]struct{}
+}
+
+func newHNSWGraph(m, efConstruction, efSearch int) *hnswGraph {
+	if m <= 1 {
+		m = defaultHNSWM
+	}
+	if efConstruction <= 0 {
+		efConstruction = defaultHNSWEfConstruction
+	}
+	if efSearch <= 0 {
+		efSearch = defaultHNSWEfSearch
+	}
+	return &hnswGraph{
+		m:              m,
+		mMax0:          m * 2,
+		efConstruction: efConstruction,
+		efSearch:       efSearch,
+		levelMult:      1 / math.Log(float64(m)),
+		nodes:          make(map[string]*hnswNode),
+		maxLevel:       -1,
+		rand:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		dirty:          make(map[string]struct{}),
+		removed:        make(map[string]struct{}),
+	}
+}
+
+func normalizeVector(v []float64) []float64 {
+	var sum float64
+	for _, f := range v {
+		sum += f * f
+	}
+	result := make([]float64, len(v))
+	if sum == 0 {
+		return result
+	}
+	norm := math.Sqrt(sum)
+	for i, f := range v {
+		result[i] = f / norm
+	}
+	return result
+}
+
+func hnswDistance(a, b []float64) float64 {
+	var dot float64
+	for i := range a {
+		dot += a[i] * b[i]
+	}
+	return 1 - dot
+}
+
+func (g *hnswGraph) Len() int {
+	return len(g.nodes)
+}
+
+func (g *hnswGraph) Has(id string) bool {
+	_, ok := g.nodes[id]
+	return ok
+}
+
+func (g *hnswGraph) maxFriends(level int) int {
+	if level == 0 {
+		return g.mMax0
+	}
+	return g.m
+}
+
+func (g *hnswGraph) randomLevel() int {
+	return int(math.Floor(-math.Log(1-g.rand.Float64()) * g.levelMult))
+}
+
+func (g *hnswGraph) checkDimension(vector []float64) error {
+	if g.dimension != 0 && len(vector) != g.dimension {
+		return utils.Errorf("vector dimension mismatch: index is %d, got %d", g.dimension, len(vector))
+	}
+	return nil
+}
+
+func (g *hnswGraph) markDirty(id string) {
+	g.dirty[id] = struct{}{}
+	delete(g.removed, id)
+}
+
+// Insert 将向量插入图中，如果 ID 已存在则先删除旧节点
+func (g *hnswGraph) Insert(id string, vector []float64) error {
+	if len(vector) == 0 {
+		return utils.Errorf("document %s must have an embedding vector", id)
+	}
+	if g.Has(id) {
+		g.Delete(id)
+	}
+	if err := g.checkDimension(vector); err != nil {
+		return err
+	}
+	if g.dimension == 0 {
+		g.dimension = len(vector)
+	}
+
+	level := g.randomLevel()
+	node := &hnswNode{
+		id:      id,
+		vector:  normalizeVector(vector),
+		level:   level,
+		friends: make([][]string, level+1),
+	}
+	g.nodes[id] = node
+	g.markDirty(id)
+
+	if g.entry == "" {
+		g.entry = id
+		g.maxLevel = level
+		return nil
+	}
+
+	ep := []hnswCandidate{{id: g.entry, distance: hnswDistance(node.vector, g.nodes[g.entry].vector)}}
+	for lc := g.maxLevel; lc > level; lc-- {
+		ep = g.searchLayer(node.vector, ep, 1, lc)
+	}
+	for lc := min(level, g.maxLevel); lc >= 0; lc-- {
+		candidates := g.searchLayer(node.vector, ep, g.efConstruction, lc)
+		neighbors := g.selectNeighbors(candidates, g.m)
+		for _, neighbor := range neighbors {
+			node.friends[lc] = append(node.friends[lc], neighbor.id)
+			g.link(neighbor.id, id, lc)
+		}
+		ep = candidates
+	}
+
+	if level > g.maxLevel {
+		g.maxLevel = level
+		g.entry = id
+	}
+	return nil
+}
+
+// link 在 lc 层添加一条 from -> to 的边，超出容量时只保留最近的邻居
+func (g *hnswGraph) link(from, to string, lc int) {
+	node, ok := g.nodes[from]
+	if !ok || lc > node.level {
+		return
+	}
+	node.friends[lc] = append(node.friends[lc], to)
+	if len(node.friends[lc]) > g.maxFriends(lc) {
+		node.friends[lc] = g.shrink(node, node.friends[lc], g.maxFriends(lc))
+	}
+	g.markDirty(from)
+}
+
+func (g *hnswGraph) shrink(node *hnswNode, ids []string, limit int) []string {
+	candidates := make([]hnswCandidate, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == node.id {
+			continue
+		}
+		seen[id] = struct{}{}
+		other, ok := g.nodes[id]
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, hnswCandidate{id: id, distance: hnswDistance(node.vector, other.vector)})
+	}
+	selected := g.selectNeighbors(candidates, limit)
+	result := make([]string, len(selected))
+	for i, c := range selected {
+		result[i] = c.id
+	}
+	return result
+}
+
+// selectNeighbors 使用启发式策略选择邻居：优先选择彼此之间更分散的节点，不足时用最近的节点补齐
+func (g *hnswGraph) selectNeighbors(candidates []hnswCandidate, limit int) []hnswCandidate {
+	sorted := make([]hnswCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].distance < sorted[j].distance
+	})
+	if len(sorted) <= limit {
+		return sorted
+	}
+
+	selected := make([]hnswCandidate, 0, limit)
+	var discarded []hnswCandidate
+	for _, c := range sorted {
+		if len(selected) >= limit {
+			break
+		}
+		good := true
+		for _, s := range selected {
+			if hnswDistance(g.nodes[c.id].vector, g.nodes[s.id].vector) < c.distance {
+				good = false
+				break
+			}
+		}
+		if good {
+			selected = append(selected, c)
+		} else {
+			discarded = append(discarded, c)
+		}
+	}
+	for _, c := range discarded {
+		if len(selected) >= limit {
+			break
+		}
+		selected = append(selected, c)
+	}
+	return selected
+}
+
+// searchLayer 在指定层执行贪心搜索，返回按距离升序排列的最多 ef 个候选
+func (g *hnswGraph) searchLayer(query []float64, entryPoints []hnswCandidate, ef int, lc int) []hnswCandidate {
+	visited := make(map[string]struct{}, ef*4)
+	candidates := &candidateHeap{}
+	results := &candidateHeap{max: true}
+	for _, ep := range entryPoints {
+		if _, ok := visited[ep.id]; ok {
+			continue
+		}
+		visited[ep.id] = struct{}{}
+		heap.Push(candidates, ep)
+		heap.Push(results, ep)
+		if results.Len() > ef {
+			heap.Pop(results)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		current := heap.Pop(candidates).(hnswCandidate)
+		if results.Len() >= ef && current.distance > results.items[0].distance {
+			break
+		}
+		node, ok := g.nodes[current.id]
+		if !ok || lc > node.level {
+			continue
+		}
+		for _, friendID := range node.friends[lc] {
+			if _, ok := visited[friendID]; ok {
+				continue
+			}
+			visited[friendID] = struct{}{}
+			friend, ok := g.nodes[friendID]
+			if !ok {
+				continue
+			}
+			distance := hnswDistance(query, friend.vector)
+			if results.Len() < ef || distance < results.items[0].distance {
+				c := hnswCandidate{id: friendID, distance: distance}
+				heap.Push(candidates, c)
+				heap.Push(results, c)
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	sorted := make([]hnswCandidate, len(results.items))
+	copy(sorted, results.items)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].distance < sorted[j].distance
+	})
+	return sorted
+}
+
+// Search 返回与查询向量最接近的 k 个节点
+func (g *hnswGraph) Search(vector []float64, k int) ([]hnswCandidate, error) {
+	if g.entry == "" {
+		return nil, nil
+	}
+	if err := g.checkDimension(vector); err != nil {
+		return nil, err
+	}
+	query := normalizeVector(vector)
+	ef := g.efSearch
+	if k > ef {
+		ef = k
+	}
+
+	ep := []hnswCandidate{{id: g.entry, distance: hnswDistance(query, g.nodes[g.entry].vector)}}
+	for lc := g.maxLevel; lc > 0; lc-- {
+		ep = g.searchLayer(query, ep, 1, lc)
+	}
+	results := g.searchLayer(query, ep, ef, 0)
+	if k > 0 && len(results) > k {
+		results = results[:k]
+	}
+	return results, nil
+}
+
+// Delete 从图中删除节点，并用被删除节点的邻居修复受影响节点的连接
+func (g *hnswGraph) Delete(id string) {
+	node, ok := g.nodes[id]
+	if !ok {
+		return
+	}
+	delete(g.nodes, id)
+	delete(g.dirty, id)
+	g.removed[id] = struct{}{}
+
+	for lc := 0; lc <= node.level; lc++ {
+		for _, friendID := range node.friends[lc] {
+			friend, ok := g.nodes[friendID]
+			if !ok || lc > friend.level {
+				continue
+			}
+			candidates := make([]string, 0, len(friend.friends[lc])+len(node.friends[lc]))
+			for _, c := range friend.friends[lc] {
+				if c != id {
+					candidates = append(candidates, c)
+				}
+			}
+			candidates = append(candidates, node.friends[lc]...)
+			friend.friends[lc] = g.shrink(friend, candidates, g.maxFriends(lc))
+			g.markDirty(friendID)
+		}
+	}
+
+	if g.entry == id {
+		g.entry = ""
+		g.maxLevel = -1
+		for otherID, other := range g.nodes {
+			if other.level > g.maxLevel {
+				g.maxLevel = other.level
+				g.entry = otherID
+			}
+		}
+	}
+	if len(g.nodes) == 0 {
+		g.dimension = 0
+	}
+}
+
+// restore 从持久化数据恢复节点，不会重新计算邻居
+func (g *hnswGraph) restore(id string, vector []float64, level int, friends [][]string) error {
+	if err := g.checkDimension(vector); err != nil {
+		return err
+	}
+	if g.dimension == 0 {
+		g.dimension = len(vector)
+	}
+	layers := make([][]string, level+1)
+	copy(layers, friends)
+	g.nodes[id] = &hnswNode{
+		id:      id,
+		vector:  normalizeVector(vector),
+		level:   level,
+		friends: layers,
+	}
+	if level > g.maxLevel {
+		g.maxLevel = level
+		g.entry = id
+	}
+	return nil
+}
+
+// takeChanges 返回并清空自上次调用以来被修改和删除的节点
+func (g *hnswGraph) takeChanges() (dirty []*hnswNode, removed []string) {
+	for id := range g.dirty {
+		if node, ok := g.nodes[id]; ok {
+			dirty = append(dirty, node)
+		}
+	}
+	for id := range g.removed {
+		removed = append(removed, id)
+	}
+	g.dirty = make(map[string]struct{})
+	g.removed = make(map[string]struct{})
+	return dirty, removed
+}
+
+// candidateHeap 默认是距离最小堆，max 为 true 时是最大堆
+type candidateHeap struct {
+	items []hnswCandidate
+	max   bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.max {
+		return h.items[i].distance > h.items[j].distance
+	}
+	return h.items[i].distance < h.items[j].distance
+}
+
+func (h *candidateHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *candidateHeap) Push(x any) { h.items = append(h.items, x.(hnswCandidate)) }
+
+func (h *candidateHeap) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
@@ -0,0 +1,75 @@
+package rag
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func randomVector(r *rand.Rand, dim int) []float64 {
+	v := make([]float64, dim)
+	for i := range v {
+		v[i] = r.Float64()*2 - 1
+	}
+	return v
+}
+
+func TestHNSWGraphRecall(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	graph := newHNSWGraph(0, 0, 0)
+	vectors := make(map[string][]float64)
+	for i := 0; i < 1000; i++ {
+		id := utils.InterfaceToString(i)
+		vectors[id] = randomVector(r, 32)
+		assert.NoError(t, graph.Insert(id, vectors[id]))
+	}
+	// 删除一部分节点，确保删除后的图仍然可以正常搜索
+	for i := 0; i < 100; i++ {
+		id := utils.InterfaceToString(i * 7)
+		graph.Delete(id)
+		delete(vectors, id)
+	}
+	assert.Equal(t, len(vectors), graph.Len())
+
+	hit, total := 0, 0
+	for q := 0; q < 20; q++ {
+		query := randomVector(r, 32)
+		type scored struct {
+			id    string
+			score float64
+		}
+		var exact []scored
+		for id, v := range vectors {
+			score, err := utils.CosineSimilarity(query, v)
+			assert.NoError(t, err)
+			exact = append(exact, scored{id: id, score: score})
+		}
+		sort.Slice(exact, func(i, j int) bool { return exact[i].score > exact[j].score })
+		truth := make(map[string]struct{})
+		for _, s := range exact[:10] {
+			truth[s.id] = struct{}{}
+		}
+
+		results, err := graph.Search(query, 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 10)
+		for _, res := range results {
+			if _, ok := truth[res.id]; ok {
+				hit++
+			}
+		}
+		total += 10
+	}
+	assert.Greater(t, float64(hit)/float64(total), 0.9)
+}
+
+func TestHNSWGraphDimensionMismatch(t *testing.T) {
+	graph := newHNSWGraph(0, 0, 0)
+	assert.NoError(t, graph.Insert("a", []float64{1, 0, 0}))
+	assert.Error(t, graph.Insert("b", []float64{1, 0}))
+	_, err := graph.Search([]float64{1, 0}, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package rag
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// tokenizeText 将文本切分为检索词：英文和数字按单词切分并转为小写，中日韩字符生成单字和相邻双字
+func tokenizeText(text string) []string {
+	var tokens []string
+	var word []rune
+	var prevHan rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r):
+			flushWord()
+			tokens = append(tokens, string(r))
+			if prevHan != 0 {
+				tokens = append(tokens, string([]rune{prevHan, r}))
+			}
+			prevHan = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			word = append(word, r)
+		default:
+			flushWord()
+		}
+		prevHan = 0
+	}
+	flushWord()
+	return tokens
+}
+
+type keywordDocument struct {
+	length   int
+	terms    map[string]int
+	metadata map[string]any
+}
+
+// keywordIndex 是基于 BM25 的内存倒排索引，它本身不是并发安全的
+type keywordIndex struct {
+	docs        map[string]*keywordDocument
+	postings    map[string]map[string]int
+	totalLength int
+}
+
+// keywordHit 表示一次关键词检索命中的文档
+type keywordHit struct {
+	id    string
+	score float64
+}
+
+func newKeywordIndex() *keywordIndex {
+	return &keywordIndex{
+		docs:     make(map[string]*keywordDocument),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// Add 将文档加入索引，如果 ID 已存在则替换
+func (k *keywordIndex) Add(id string, content string, metadata map[string]any) {
+	k.Remove(id)
+
+	tokens := tokenizeText(content)
+	doc := &keywordDocument{
+		length:   len(tokens),
+		terms:    make(map[string]int),
+		metadata: metadata,
+	}
+	for _, token := range tokens {
+		doc.terms[token]++
+	}
+	for term, freq := range doc.terms {
+		posting, ok := k.postings[term]
+		if !ok {
+			posting = make(map[string]int)
+			k.postings[term] = posting
+		}
+		posting[id] = freq
+	}
+	k.docs[id] = doc
+	k.totalLength += doc.length
+}
+
+// Remove 从索引中删除文档
+func (k *keywordIndex) Remove(id string) {
+	doc, ok := k.docs[id]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		posting := k.postings[term]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(k.postings, term)
+		}
+	}
+	k.totalLength -= doc.length
+	delete(k.docs, id)
+}
+
+// Search 返回按 BM25 得分降序排列的命中文档，filter 不为空时只保留元数据满足条件的文档
+func (k *keywordIndex) Search(query string, limit int, filter MetadataFilter) []keywordHit {
+	if len(k.docs) == 0 {
+		return nil
+	}
+
+	terms := make(map[string]struct{})
+	for _, token := range tokenizeText(query) {
+		terms[token] = struct{}{}
+	}
+
+	n := float64(len(k.docs))
+	avgLength := float64(k.totalLength) / n
+	if avgLength == 0 {
+		avgLength = 1
+	}
+
+	scores := make(map[string]float64)
+	for term := range terms {
+		posting, ok := k.postings[term]
+		if !ok {
+			continue
+		}
+		df := float64(len(posting))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, freq := range posting {
+			tf := float64(freq)
+			docLength := float64(k.docs[id].length)
+			scores[id] += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*docLength/avgLength))
+		}
+	}
+
+	hits := make([]keywordHit, 0, len(scores))
+	for id, score := range scores {
+		if filter != nil && !filter(k.docs[id].metadata) {
+			continue
+		}
+		hits = append(hits, keywordHit{id: id, score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score == hits[j].score {
+			return hits[i].id < hits[j].id
+		}
+		return hits[i].score > hits[j].score
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
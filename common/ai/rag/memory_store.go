@@ -11,6 +11,7 @@ import (
 type MemoryVectorStore struct {
 	documents map[string]Document // 文档存储，以 ID 为键
 	embedder  EmbeddingClient     // 用于生成查询的嵌入向量
+	keywords  *keywordIndex       // 文档内容的关键词索引
 	mu        sync.RWMutex        // 用于并发安全的互斥锁
 }
 
@@ -19,6 +20,7 @@ func NewMemoryVectorStore(embedder EmbeddingClient) *MemoryVectorStore {
 	return &MemoryVectorStore{
 		documents: make(map[string]Document),
 		embedder:  embedder,
+		keywords:  newKeywordIndex(),
 	}
 }
 
@@ -40,6 +42,7 @@ func (m *MemoryVectorStore) Add(docs ...Document) error {
 
 		// 存储文档
 		m.documents[doc.ID] = doc
+		m.keywords.Add(doc.ID, doc.Content, doc.Metadata)
 	}
 
 	return nil
@@ -47,6 +50,11 @@ func (m *MemoryVectorStore) Add(docs ...Document) error {
 
 // Search 根据查询文本检索相关文档
 func (m *MemoryVectorStore) Search(query string, limit int) ([]SearchResult, error) {
+	return m.SearchWithFilter(query, limit, nil)
+}
+
+// SearchWithFilter 根据查询文本检索元数据满足 filter 的相关文档
+func (m *MemoryVectorStore) SearchWithFilter(query string, limit int, filter MetadataFilter) ([]SearchResult, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -64,6 +72,10 @@ func (m *MemoryVectorStore) Search(query string, limit int) ([]SearchResult, err
 	// 计算所有文档与查询的相似度
 	var results []SearchResult
 	for _, doc := range m.documents {
+		if filter != nil && !filter(doc.Metadata) {
+			continue
+		}
+
 		// 计算余弦相似度
 		similarity, err := utils.CosineSimilarity(queryEmbedding, doc.Embedding)
 		if err != nil {
@@ -92,6 +104,22 @@ func (m *MemoryVectorStore) Search(query string, limit int) ([]SearchResult, err
 	return results, nil
 }
 
+// KeywordSearch 使用 BM25 对文档内容做关键词检索
+func (m *MemoryVectorStore) KeywordSearch(query string, limit int, filter MetadataFilter) ([]SearchResult, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	hits := m.keywords.Search(query, limit, filter)
+	results := make([]SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		results = append(results, SearchResult{
+			Document: m.documents[hit.id],
+			Score:    hit.score,
+		})
+	}
+	return results, nil
+}
+
 // Delete 根据 ID 删除文档
 func (m *MemoryVectorStore) Delete(ids ...string) error {
 	m.mu.Lock()
@@ -99,6 +127,7 @@ func (m *MemoryVectorStore) Delete(ids ...string) error {
 
 	for _, id := range ids {
 		delete(m.documents, id)
+		m.keywords.Remove(id)
 	}
 
 	return nil
@@ -133,3 +162,6 @@ func (m *MemoryVectorStore) Count() (int, error) {
 
 	return len(m.documents), nil
 }
+
+// 确保 MemoryVectorStore 实现了 HybridVectorStore 接口
+var _ HybridVectorStore = (*MemoryVectorStore)(nil)
@@ -79,6 +79,62 @@ func (r *RAGSystem) Query(query string, limit int) ([]SearchResult, error) {
 	return r.VectorStore.Search(query, limit)
 }
 
+// SearchWithOptions 支持元数据过滤的检索，同时启用向量检索和关键词检索时使用倒数排名融合合并两路结果
+func (r *RAGSystem) SearchWithOptions(query string, opts ...SearchOption) ([]SearchResult, error) {
+	options := NewSearchOptions(opts...)
+	hybrid, isHybrid := r.VectorStore.(HybridVectorStore)
+	if !isHybrid && !options.keywordSet {
+		options.EnableKeyword = false
+	}
+	if !options.EnableVector && !options.EnableKeyword {
+		return nil, utils.Errorf("at least one of vector search and keyword search must be enabled")
+	}
+
+	if options.EnableKeyword && !isHybrid {
+		return nil, utils.Errorf("vector store %T does not support keyword search", r.VectorStore)
+	}
+
+	candidateLimit := options.CandidateLimit
+	if !(options.EnableVector && options.EnableKeyword) {
+		candidateLimit = options.Limit
+	}
+
+	var resultLists [][]SearchResult
+	if options.EnableVector {
+		var results []SearchResult
+		var err error
+		if isHybrid {
+			results, err = hybrid.SearchWithFilter(query, candidateLimit, options.Filter)
+		} else if options.Filter != nil {
+			results, err = r.VectorStore.Search(query, 0)
+			results = filterSearchResults(results, options.Filter)
+		} else {
+			results, err = r.VectorStore.Search(query, candidateLimit)
+		}
+		if err != nil {
+			return nil, utils.Errorf("vector search failed: %v", err)
+		}
+		resultLists = append(resultLists, results)
+	}
+	if options.EnableKeyword {
+		results, err := hybrid.KeywordSearch(query, candidateLimit, options.Filter)
+		if err != nil {
+			return nil, utils.Errorf("keyword search failed: %v", err)
+		}
+		resultLists = append(resultLists, results)
+	}
+
+	// 只有一路检索时保留原始得分
+	if len(resultLists) == 1 {
+		results := resultLists[0]
+		if options.Limit > 0 && len(results) > options.Limit {
+			results = results[:options.Limit]
+		}
+		return results, nil
+	}
+	return ReciprocalRankFusion(options.RRFConstant, options.Limit, resultLists...), nil
+}
+
 // DeleteDocuments 删除文档
 func (r *RAGSystem) DeleteDocuments(ids ...string) error {
 	return r.VectorStore.Delete(ids...)
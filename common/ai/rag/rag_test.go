@@ -168,3 +168,71 @@ func TestFilterResults(t *testing.T) {
 	assert.Equal(t, 0.9, filtered[0].Score)
 	assert.Equal(t, 0.7, filtered[1].Score)
 }
+
+// 测试元数据过滤与混合检索
+func TestRAGSystemSearchWithOptions(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+	store := NewMemoryVectorStore(mockEmbed)
+	ragSystem := NewRAGSystem(mockEmbed, store)
+
+	err := store.Add(
+		Document{
+			ID:        "yak",
+			Content:   "Yaklang是一种安全研究编程语言",
+			Metadata:  map[string]any{"type": "lang"},
+			Embedding: []float64{1.0, 0.0, 0.0},
+		},
+		Document{
+			ID:        "mitm",
+			Content:   "MITM plugin hijacks http request",
+			Metadata:  map[string]any{"type": "mitm"},
+			Embedding: []float64{0.9, 0.1, 0.0},
+		},
+		Document{
+			ID:        "rag",
+			Content:   "RAG是一种结合检索和生成的AI技术",
+			Metadata:  map[string]any{"type": "ai"},
+			Embedding: []float64{0.0, 1.0, 0.0},
+		},
+	)
+	assert.NoError(t, err)
+
+	// 元数据过滤
+	results, err := ragSystem.SearchWithOptions("什么是Yaklang", WithVectorSearch(true), WithKeywordSearch(false), WithMetadataEquals("type", "mitm"))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "mitm", results[0].Document.ID)
+
+	// 关键词检索
+	results, err = ragSystem.SearchWithOptions("http plugin", WithVectorSearch(false))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "mitm", results[0].Document.ID)
+
+	// 混合检索：关键词 "AI技术" 只命中 rag 文档，向量检索更偏向 yak 文档
+	results, err = ragSystem.SearchWithOptions("AI技术", WithSearchLimit(2))
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "rag", results[0].Document.ID)
+
+	results, err = ragSystem.SearchWithOptions("AI技术", WithMetadataFilter(MetadataIn("type", "lang", "mitm")))
+	assert.NoError(t, err)
+	for _, result := range results {
+		assert.NotEqual(t, "rag", result.Document.ID)
+	}
+
+	// 删除后不再被关键词命中
+	assert.NoError(t, store.Delete("mitm"))
+	results, err = ragSystem.SearchWithOptions("http plugin", WithVectorSearch(false))
+	assert.NoError(t, err)
+	assert.Len(t, results, 0)
+}
+
+func TestReciprocalRankFusion(t *testing.T) {
+	a := []SearchResult{{Document: Document{ID: "1"}}, {Document: Document{ID: "2"}}}
+	b := []SearchResult{{Document: Document{ID: "2"}}, {Document: Document{ID: "3"}}}
+	fused := ReciprocalRankFusion(60, 0, a, b)
+	assert.Len(t, fused, 3)
+	assert.Equal(t, "2", fused[0].Document.ID)
+	assert.InDelta(t, 1.0/62+1.0/61, fused[0].Score, 1e-9)
+}
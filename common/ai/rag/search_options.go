@@ -0,0 +1,229 @@
+package rag
+
+import (
+	"sort"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const defaultRRFConstant = 60
+
+// MetadataFilter 是文档元数据的过滤条件，返回 true 表示保留该文档
+type MetadataFilter func(metadata map[string]any) bool
+
+// MetadataEquals 要求元数据中 key 对应的值等于 value，数值和字符串按字符串形式比较
+func MetadataEquals(key string, value any) MetadataFilter {
+	expected := utils.InterfaceToString(value)
+	return func(metadata map[string]any) bool {
+		actual, ok := metadata[key]
+		return ok && utils.InterfaceToString(actual) == expected
+	}
+}
+
+// MetadataIn 要求元数据中 key 对应的值是 values 之一
+func MetadataIn(key string, values ...any) MetadataFilter {
+	expected := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		expected[utils.InterfaceToString(value)] = struct{}{}
+	}
+	return func(metadata map[string]any) bool {
+		actual, ok := metadata[key]
+		if !ok {
+			return false
+		}
+		_, ok = expected[utils.InterfaceToString(actual)]
+		return ok
+	}
+}
+
+// MetadataExists 要求元数据中存在 key
+func MetadataExists(key string) MetadataFilter {
+	return func(metadata map[string]any) bool {
+		_, ok := metadata[key]
+		return ok
+	}
+}
+
+// MetadataAnd 要求所有条件同时满足
+func MetadataAnd(filters ...MetadataFilter) MetadataFilter {
+	return func(metadata map[string]any) bool {
+		for _, filter := range filters {
+			if filter != nil && !filter(metadata) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MetadataOr 要求至少满足一个条件
+func MetadataOr(filters ...MetadataFilter) MetadataFilter {
+	return func(metadata map[string]any) bool {
+		for _, filter := range filters {
+			if filter != nil && filter(metadata) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MetadataNot 对条件取反
+func MetadataNot(filter MetadataFilter) MetadataFilter {
+	return func(metadata map[string]any) bool {
+		return !filter(metadata)
+	}
+}
+
+// HybridVectorStore 是支持元数据过滤和关键词检索的向量存储
+type HybridVectorStore interface {
+	VectorStore
+
+	// SearchWithFilter 根据查询文本做向量检索，只返回元数据满足 filter 的文档
+	SearchWithFilter(query string, limit int, filter MetadataFilter) ([]SearchResult, error)
+
+	// KeywordSearch 使用 BM25 对文档内容做关键词检索，只返回元数据满足 filter 的文档
+	KeywordSearch(query string, limit int, filter MetadataFilter) ([]SearchResult, error)
+}
+
+// SearchOptions 是 RAGSystem.SearchWithOptions 的检索配置
+type SearchOptions struct {
+	Limit         int
+	Filter        MetadataFilter
+	EnableVector  bool
+	EnableKeyword bool
+	// RRFConstant 是倒数排名融合中的常数 k，得分为 sum(1 / (k + rank))
+	RRFConstant int
+	// CandidateLimit 是融合前每一路检索召回的数量
+	CandidateLimit int
+
+	// keywordSet 表示是否显式设置了关键词检索，未设置时只在存储支持关键词检索时启用
+	keywordSet bool
+}
+
+// SearchOption 是检索配置选项
+type SearchOption func(*SearchOptions)
+
+// WithSearchLimit 设置返回结果的数量
+func WithSearchLimit(limit int) SearchOption {
+	return func(o *SearchOptions) {
+		o.Limit = limit
+	}
+}
+
+// WithMetadataFilter 添加元数据过滤条件，多次调用时条件之间是“与”的关系
+func WithMetadataFilter(filter MetadataFilter) SearchOption {
+	return func(o *SearchOptions) {
+		if o.Filter == nil {
+			o.Filter = filter
+			return
+		}
+		o.Filter = MetadataAnd(o.Filter, filter)
+	}
+}
+
+// WithMetadataEquals 只检索元数据中 key 等于 value 的文档
+func WithMetadataEquals(key string, value any) SearchOption {
+	return WithMetadataFilter(MetadataEquals(key, value))
+}
+
+// WithVectorSearch 设置是否启用向量检索
+func WithVectorSearch(enable bool) SearchOption {
+	return func(o *SearchOptions) {
+		o.EnableVector = enable
+	}
+}
+
+// WithKeywordSearch 设置是否启用关键词检索
+func WithKeywordSearch(enable bool) SearchOption {
+	return func(o *SearchOptions) {
+		o.EnableKeyword = enable
+		o.keywordSet = true
+	}
+}
+
+// WithRRFConstant 设置倒数排名融合的常数 k
+func WithRRFConstant(k int) SearchOption {
+	return func(o *SearchOptions) {
+		o.RRFConstant = k
+	}
+}
+
+// WithCandidateLimit 设置融合前每一路检索召回的数量
+func WithCandidateLimit(limit int) SearchOption {
+	return func(o *SearchOptions) {
+		o.CandidateLimit = limit
+	}
+}
+
+// NewSearchOptions 创建默认的检索配置：同时启用向量检索和关键词检索，返回 5 条结果，
+// 存储不支持关键词检索（未实现 HybridVectorStore）且没有通过 WithKeywordSearch 显式开启时只使用向量检索
+func NewSearchOptions(opts ...SearchOption) *SearchOptions {
+	options := &SearchOptions{
+		Limit:         5,
+		EnableVector:  true,
+		EnableKeyword: true,
+		RRFConstant:   defaultRRFConstant,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.RRFConstant <= 0 {
+		options.RRFConstant = defaultRRFConstant
+	}
+	if options.CandidateLimit <= 0 {
+		options.CandidateLimit = options.Limit * 4
+		if options.CandidateLimit < 20 {
+			options.CandidateLimit = 20
+		}
+	}
+	return options
+}
+
+// ReciprocalRankFusion 使用倒数排名融合合并多路检索结果，融合后的得分写入 Score
+func ReciprocalRankFusion(k int, limit int, resultLists ...[]SearchResult) []SearchResult {
+	if k <= 0 {
+		k = defaultRRFConstant
+	}
+	fused := make(map[string]*SearchResult)
+	var order []string
+	for _, results := range resultLists {
+		for rank, result := range results {
+			score := 1 / float64(k+rank+1)
+			if existing, ok := fused[result.Document.ID]; ok {
+				existing.Score += score
+				continue
+			}
+			r := result
+			r.Score = score
+			fused[result.Document.ID] = &r
+			order = append(order, result.Document.ID)
+		}
+	}
+
+	merged := make([]SearchResult, 0, len(order))
+	for _, id := range order {
+		merged = append(merged, *fused[id])
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Score > merged[j].Score
+	})
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
+
+// filterSearchResults 用元数据条件过滤不支持过滤的向量存储返回的结果
+func filterSearchResults(results []SearchResult, filter MetadataFilter) []SearchResult {
+	if filter == nil {
+		return results
+	}
+	filtered := make([]SearchResult, 0, len(results))
+	for _, result := range results {
+		if filter(result.Document.Metadata) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,111 @@
+package rag
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// loadIndex 从数据库加载集合的 HNSW 索引，索引与文档不一致时（例如旧集合没有索引）重新构建
+func (s *SQLiteVectorStore) loadIndex() error {
+	var docs []*schema.VectorStoreDocument
+	if err := s.db.Select("document_id, embedding").Where("collection_id = ?", s.collectionID).Find(&docs).Error; err != nil {
+		return utils.Errorf("查询文档失败: %v", err)
+	}
+	var nodes []*schema.VectorStoreHNSWNode
+	if err := s.db.Where("collection_id = ?", s.collectionID).Find(&nodes).Error; err != nil {
+		return utils.Errorf("查询 HNSW 索引失败: %v", err)
+	}
+
+	embeddings := make(map[string][]float64, len(docs))
+	for _, doc := range docs {
+		embeddings[doc.DocumentID] = doc.Embedding
+	}
+
+	consistent := len(nodes) == len(docs)
+	if consistent {
+		for _, node := range nodes {
+			if _, ok := embeddings[node.DocumentID]; !ok {
+				consistent = false
+				break
+			}
+		}
+	}
+
+	index := newHNSWGraph(s.hnswM, s.hnswEfConstruct, s.hnswEfSearch)
+	if consistent {
+		for _, node := range nodes {
+			if err := index.restore(node.DocumentID, embeddings[node.DocumentID], node.Level, node.Neighbors); err != nil {
+				consistent = false
+				break
+			}
+		}
+		if consistent {
+			index.takeChanges()
+			s.index = index
+			return nil
+		}
+		index = newHNSWGraph(s.hnswM, s.hnswEfConstruct, s.hnswEfSearch)
+	}
+
+	log.Infof("rebuilding hnsw index for collection %s (%d documents)", s.collectionName, len(docs))
+	for _, doc := range docs {
+		if err := index.Insert(doc.DocumentID, doc.Embedding); err != nil {
+			log.Warnf("skip document %s when building hnsw index: %v", doc.DocumentID, err)
+		}
+	}
+	err := utils.GormTransaction(s.db, func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", s.collectionID).Unscoped().Delete(&schema.VectorStoreHNSWNode{}).Error; err != nil {
+			return err
+		}
+		return s.saveIndexNodes(tx, index)
+	})
+	if err != nil {
+		return err
+	}
+	s.index = index
+	return nil
+}
+
+// reloadIndex 在数据库事务失败后重新同步内存中的索引，关键词索引会在下次检索时重新构建
+func (s *SQLiteVectorStore) reloadIndex() {
+	s.keywords = nil
+	if s.index == nil {
+		return
+	}
+	if err := s.loadIndex(); err != nil {
+		log.Errorf("reload hnsw index for collection %s failed: %v", s.collectionName, err)
+	}
+}
+
+// persistIndex 将索引中自上次保存以来的变更写入数据库
+func (s *SQLiteVectorStore) persistIndex(tx *gorm.DB) error {
+	if s.index == nil {
+		return nil
+	}
+	return s.saveIndexNodes(tx, s.index)
+}
+
+func (s *SQLiteVectorStore) saveIndexNodes(tx *gorm.DB, index *hnswGraph) error {
+	dirty, removed := index.takeChanges()
+	stale := append(removed, lo.Map(dirty, func(node *hnswNode, _ int) string { return node.id })...)
+	for _, chunk := range lo.Chunk(stale, 500) {
+		if err := tx.Where("collection_id = ? AND document_id IN (?)", s.collectionID, chunk).Unscoped().Delete(&schema.VectorStoreHNSWNode{}).Error; err != nil {
+			return utils.Errorf("删除 HNSW 节点失败: %v", err)
+		}
+	}
+	for _, node := range dirty {
+		record := &schema.VectorStoreHNSWNode{
+			CollectionID: s.collectionID,
+			DocumentID:   node.id,
+			Level:        node.level,
+			Neighbors:    schema.HNSWNeighbors(node.friends),
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return utils.Errorf("保存 HNSW 节点失败: %v", err)
+		}
+	}
+	return nil
+}
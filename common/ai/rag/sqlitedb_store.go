@@ -6,6 +6,7 @@ import (
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/samber/lo"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
@@ -20,10 +21,40 @@ type SQLiteVectorStore struct {
 	// 集合信息
 	collectionName string
 	collectionID   uint
+
+	// HNSW 近似最近邻索引，disableANN 为 true 时不构建索引，Search 退化为精确搜索
+	index           *hnswGraph
+	disableANN      bool
+	hnswM           int
+	hnswEfConstruct int
+	hnswEfSearch    int
+
+	// 文档内容的关键词索引，在第一次关键词检索时从数据库构建
+	keywords   *keywordIndex
+	keywordsMu sync.Mutex
+}
+
+// SQLiteVectorStoreOption 是 SQLiteVectorStore 的配置选项
+type SQLiteVectorStoreOption func(*SQLiteVectorStore)
+
+// WithHNSWParameters 设置 HNSW 索引的参数：每层邻居数 m、构建时的候选集大小 efConstruction、搜索时的候选集大小 efSearch
+func WithHNSWParameters(m, efConstruction, efSearch int) SQLiteVectorStoreOption {
+	return func(s *SQLiteVectorStore) {
+		s.hnswM = m
+		s.hnswEfConstruct = efConstruction
+		s.hnswEfSearch = efSearch
+	}
+}
+
+// WithExactSearch 禁用 HNSW 索引，Search 将对集合中所有文档计算相似度
+func WithExactSearch(exact bool) SQLiteVectorStoreOption {
+	return func(s *SQLiteVectorStore) {
+		s.disableANN = exact
+	}
 }
 
 // NewSQLiteVectorStore 创建一个新的 SQLite 向量存储
-func NewSQLiteVectorStore(db *gorm.DB, collectionName string, modelName string, dimension int, embedder EmbeddingClient) (*SQLiteVectorStore, error) {
+func NewSQLiteVectorStore(db *gorm.DB, collectionName string, modelName string, dimension int, embedder EmbeddingClient, opts ...SQLiteVectorStoreOption) (*SQLiteVectorStore, error) {
 	// 创建或获取集合
 	var collections []*schema.VectorStoreCollection
 	dbErr := db.Where("name = ?", collectionName).Find(&collections)
@@ -46,12 +77,22 @@ func NewSQLiteVectorStore(db *gorm.DB, collectionName string, modelName string,
 		collection = collections[0]
 	}
 
-	return &SQLiteVectorStore{
+	store := &SQLiteVectorStore{
 		db:             db,
 		embedder:       embedder,
 		collectionName: collectionName,
 		collectionID:   collection.ID,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	if !store.disableANN {
+		if err := store.loadIndex(); err != nil {
+			return nil, utils.Errorf("加载 HNSW 索引失败: %v", err)
+		}
+	}
+	return store, nil
 }
 
 func (s *SQLiteVectorStore) Remove() {
@@ -59,6 +100,9 @@ func (s *SQLiteVectorStore) Remove() {
 		if err := tx.Model(&schema.VectorStoreDocument{}).Where("collection_id = ?", s.collectionID).Unscoped().Delete(&schema.VectorStoreDocument{}).Error; err != nil {
 			return err
 		}
+		if err := tx.Model(&schema.VectorStoreHNSWNode{}).Where("collection_id = ?", s.collectionID).Unscoped().Delete(&schema.VectorStoreHNSWNode{}).Error; err != nil {
+			return err
+		}
 		if err := tx.Model(&schema.VectorStoreCollection{}).Where("id = ?", s.collectionID).Unscoped().Delete(&schema.VectorStoreCollection{}).Error; err != nil {
 			return err
 		}
@@ -70,6 +114,7 @@ func (s *SQLiteVectorStore) Remove() {
 func (s *SQLiteVectorStore) toDocument(doc *schema.VectorStoreDocument) Document {
 	return Document{
 		ID:        doc.DocumentID,
+		Content:   doc.Content,
 		Metadata:  map[string]any(doc.Metadata),
 		Embedding: []float64(doc.Embedding),
 	}
@@ -80,6 +125,7 @@ func (s *SQLiteVectorStore) toSchemaDocument(doc Document) *schema.VectorStoreDo
 	return &schema.VectorStoreDocument{
 		DocumentID:   doc.ID,
 		CollectionID: s.collectionID,
+		Content:      doc.Content,
 		Metadata:     schema.MetadataMap(doc.Metadata),
 		Embedding:    schema.FloatArray(doc.Embedding),
 	}
@@ -113,38 +159,69 @@ func (s *SQLiteVectorStore) Add(docs ...Document) error {
 
 		// 检查文档是否已存在
 		var existingDoc schema.VectorStoreDocument
-		result := tx.Where("document_id = ?", doc.ID).First(&existingDoc)
+		result := tx.Where("collection_id = ? AND document_id = ?", s.collectionID, doc.ID).First(&existingDoc)
 
 		schemaDoc := s.toSchemaDocument(doc)
 
 		if result.Error == nil {
 			// 更新现有文档
+			existingDoc.Content = schemaDoc.Content
 			existingDoc.Metadata = schemaDoc.Metadata
 			existingDoc.Embedding = schemaDoc.Embedding
 
 			if err := tx.Save(&existingDoc).Error; err != nil {
 				tx.Rollback()
+				s.reloadIndex()
 				return utils.Errorf("更新文档失败: %v", err)
 			}
 		} else if result.Error == gorm.ErrRecordNotFound {
 			// 创建新文档
 			if err := tx.Create(schemaDoc).Error; err != nil {
 				tx.Rollback()
+				s.reloadIndex()
 				return utils.Errorf("创建文档失败: %v", err)
 			}
 		} else {
 			// 其他错误
 			tx.Rollback()
+			s.reloadIndex()
 			return utils.Errorf("查询文档失败: %v", result.Error)
 		}
+
+		if s.keywords != nil {
+			s.keywords.Add(doc.ID, doc.Content, doc.Metadata)
+		}
+		if s.index != nil {
+			if err := s.index.Insert(doc.ID, doc.Embedding); err != nil {
+				tx.Rollback()
+				s.reloadIndex()
+				return utils.Errorf("更新 HNSW 索引失败: %v", err)
+			}
+		}
+	}
+
+	if err := s.persistIndex(tx); err != nil {
+		tx.Rollback()
+		s.reloadIndex()
+		return utils.Errorf("保存 HNSW 索引失败: %v", err)
 	}
 
 	// 提交事务
-	return tx.Commit().Error
+	if err := tx.Commit().Error; err != nil {
+		s.reloadIndex()
+		return err
+	}
+	return nil
 }
 
-// Search 根据查询文本检索相关文档
+// Search 根据查询文本检索相关文档，启用 HNSW 索引时返回近似的 top-k 结果
 func (s *SQLiteVectorStore) Search(query string, limit int) ([]SearchResult, error) {
+	return s.SearchWithFilter(query, limit, nil)
+}
+
+// SearchWithFilter 根据查询文本检索元数据满足 filter 的相关文档
+// 启用 HNSW 索引时先扩大候选集做近似搜索再过滤，满足条件的结果不足时退化为精确搜索
+func (s *SQLiteVectorStore) SearchWithFilter(query string, limit int, filter MetadataFilter) ([]SearchResult, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -154,6 +231,146 @@ func (s *SQLiteVectorStore) Search(query string, limit int) ([]SearchResult, err
 		return nil, utils.Errorf("为查询生成嵌入向量失败: %v", err)
 	}
 
+	// 不限制数量时近似搜索没有意义，直接精确搜索
+	if s.index == nil || limit <= 0 {
+		return s.exactSearch(queryEmbedding, limit, filter)
+	}
+	if filter == nil {
+		return s.annSearch(queryEmbedding, limit, nil)
+	}
+
+	candidateLimit := limit * 10
+	if candidateLimit < defaultHNSWEfSearch {
+		candidateLimit = defaultHNSWEfSearch
+	}
+	results, err := s.annSearch(queryEmbedding, candidateLimit, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) >= limit {
+		return results[:limit], nil
+	}
+	if candidateLimit >= s.index.Len() {
+		return results, nil
+	}
+	return s.exactSearch(queryEmbedding, limit, filter)
+}
+
+// KeywordSearch 使用 BM25 对文档内容做关键词检索
+func (s *SQLiteVectorStore) KeywordSearch(query string, limit int, filter MetadataFilter) ([]SearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.keywordsMu.Lock()
+	if s.keywords == nil {
+		if err := s.loadKeywordIndex(); err != nil {
+			s.keywordsMu.Unlock()
+			return nil, err
+		}
+	}
+	hits := s.keywords.Search(query, limit, filter)
+	s.keywordsMu.Unlock()
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.id
+	}
+	docMap, err := s.fetchDocuments(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		doc, ok := docMap[hit.id]
+		if !ok {
+			continue
+		}
+		results = append(results, SearchResult{
+			Document: s.toDocument(doc),
+			Score:    hit.score,
+		})
+	}
+	return results, nil
+}
+
+func (s *SQLiteVectorStore) loadKeywordIndex() error {
+	var docs []*schema.VectorStoreDocument
+	if err := s.db.Select("document_id, content, metadata").Where("collection_id = ?", s.collectionID).Find(&docs).Error; err != nil {
+		return utils.Errorf("查询文档失败: %v", err)
+	}
+	index := newKeywordIndex()
+	for _, doc := range docs {
+		index.Add(doc.DocumentID, doc.Content, doc.Metadata)
+	}
+	s.keywords = index
+	return nil
+}
+
+// fetchDocuments 根据文档 ID 批量查询集合中的文档
+func (s *SQLiteVectorStore) fetchDocuments(ids []string) (map[string]*schema.VectorStoreDocument, error) {
+	docMap := make(map[string]*schema.VectorStoreDocument, len(ids))
+	for _, chunk := range lo.Chunk(ids, 500) {
+		var docs []*schema.VectorStoreDocument
+		if err := s.db.Where("collection_id = ? AND document_id IN (?)", s.collectionID, chunk).Find(&docs).Error; err != nil {
+			return nil, utils.Errorf("查询文档失败: %v", err)
+		}
+		for _, doc := range docs {
+			docMap[doc.DocumentID] = doc
+		}
+	}
+	return docMap, nil
+}
+
+// SearchExact 对集合中的所有文档计算相似度，可用于验证近似搜索的结果
+func (s *SQLiteVectorStore) SearchExact(query string, limit int) ([]SearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	queryEmbedding, err := s.embedder.Embedding(query)
+	if err != nil {
+		return nil, utils.Errorf("为查询生成嵌入向量失败: %v", err)
+	}
+	return s.exactSearch(queryEmbedding, limit, nil)
+}
+
+func (s *SQLiteVectorStore) annSearch(queryEmbedding []float64, limit int, filter MetadataFilter) ([]SearchResult, error) {
+	candidates, err := s.index.Search(queryEmbedding, limit)
+	if err != nil {
+		return nil, utils.Errorf("HNSW 索引搜索失败: %v", err)
+	}
+	if len(candidates) == 0 {
+		return []SearchResult{}, nil
+	}
+
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.id
+	}
+	docMap, err := s.fetchDocuments(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, 0, len(candidates))
+	for _, c := range candidates {
+		doc, ok := docMap[c.id]
+		if !ok {
+			continue
+		}
+		if filter != nil && !filter(doc.Metadata) {
+			continue
+		}
+		results = append(results, SearchResult{
+			Document: s.toDocument(doc),
+			Score:    1 - c.distance,
+			Distance: c.distance,
+		})
+	}
+	return results, nil
+}
+
+func (s *SQLiteVectorStore) exactSearch(queryEmbedding []float64, limit int, filter MetadataFilter) ([]SearchResult, error) {
 	// 获取所有文档
 	var docs []schema.VectorStoreDocument
 	if err := s.db.Where("collection_id = ?", s.collectionID).Find(&docs).Error; err != nil {
@@ -167,6 +384,9 @@ func (s *SQLiteVectorStore) Search(query string, limit int) ([]SearchResult, err
 	// 计算相似度并排序
 	var results []SearchResult
 	for _, doc := range docs {
+		if filter != nil && !filter(doc.Metadata) {
+			continue
+		}
 		embedding := []float64(doc.Embedding)
 
 		// 计算余弦相似度
@@ -208,13 +428,29 @@ func (s *SQLiteVectorStore) Delete(ids ...string) error {
 	}()
 
 	for _, id := range ids {
-		if err := tx.Where("document_id = ?", id).Delete(&schema.VectorStoreDocument{}).Error; err != nil {
+		if err := tx.Where("collection_id = ? AND document_id = ?", s.collectionID, id).Delete(&schema.VectorStoreDocument{}).Error; err != nil {
 			tx.Rollback()
 			return utils.Errorf("删除文档 %s 失败: %v", id, err)
 		}
+		if s.index != nil {
+			s.index.Delete(id)
+		}
+		if s.keywords != nil {
+			s.keywords.Remove(id)
+		}
 	}
 
-	return tx.Commit().Error
+	if err := s.persistIndex(tx); err != nil {
+		tx.Rollback()
+		s.reloadIndex()
+		return utils.Errorf("保存 HNSW 索引失败: %v", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.reloadIndex()
+		return err
+	}
+	return nil
 }
 
 // Get 根据 ID 获取文档
@@ -223,7 +459,7 @@ func (s *SQLiteVectorStore) Get(id string) (Document, bool, error) {
 	defer s.mu.RUnlock()
 
 	var doc schema.VectorStoreDocument
-	result := s.db.Where("document_id = ?", id).First(&doc)
+	result := s.db.Where("collection_id = ? AND document_id = ?", s.collectionID, id).First(&doc)
 
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
@@ -266,5 +502,5 @@ func (s *SQLiteVectorStore) Count() (int, error) {
 	return count, nil
 }
 
-// 确保 SQLiteVectorStore 实现了 VectorStore 接口
-var _ VectorStore = (*SQLiteVectorStore)(nil)
+// 确保 SQLiteVectorStore 实现了 HybridVectorStore 接口
+var _ HybridVectorStore = (*SQLiteVectorStore)(nil)
@@ -1,10 +1,13 @@
 package rag
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
 )
 
 // 测试 SQLiteVectorStore
@@ -70,3 +73,176 @@ func TestSQLiteVectorStore(t *testing.T) {
 	assert.Equal(t, 1, len(docs))
 	assert.Equal(t, "doc2", docs[0].ID)
 }
+
+// 测试 HNSW 索引的持久化以及与精确搜索结果的一致性
+func TestSQLiteVectorStoreHNSWIndex(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+	db := consts.GetGormProfileDatabase()
+	collectionName := "test_hnsw_" + utils.RandStringBytes(8)
+
+	store, err := NewSQLiteVectorStore(db, collectionName, "mock", 3, mockEmbed)
+	assert.NoError(t, err)
+	defer store.Remove()
+
+	var docs []Document
+	for i := 0; i < 50; i++ {
+		docs = append(docs, Document{
+			ID:        fmt.Sprintf("%s_doc_%d", collectionName, i),
+			Embedding: []float64{float64(i), float64(50 - i), 1},
+		})
+	}
+	docs = append(docs, Document{
+		ID:        collectionName + "_yaklang",
+		Embedding: []float64{1.0, 0.0, 0.0},
+	})
+	assert.NoError(t, store.Add(docs...))
+	assert.NoError(t, store.Delete(docs[10].ID, docs[20].ID))
+
+	var nodeCount int
+	assert.NoError(t, db.Model(&schema.VectorStoreHNSWNode{}).Where("collection_id = ?", store.collectionID).Count(&nodeCount).Error)
+	assert.Equal(t, 49, nodeCount)
+
+	// 其他集合不能覆盖或删除本集合的文档
+	other, err := NewSQLiteVectorStore(db, collectionName+"_other", "mock", 3, mockEmbed)
+	assert.NoError(t, err)
+	defer other.Remove()
+	assert.Error(t, other.Add(Document{ID: docs[0].ID, Content: "other", Embedding: []float64{0, 0, 1}}))
+	assert.NoError(t, other.Delete(docs[0].ID))
+	_, exists, err := other.Get(docs[0].ID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	doc, exists, err := store.Get(docs[0].ID)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Empty(t, doc.Content)
+
+	results, err := store.Search("什么是Yaklang", 5)
+	assert.NoError(t, err)
+	exact, err := store.SearchExact("什么是Yaklang", 5)
+	assert.NoError(t, err)
+	assert.Len(t, results, 5)
+	assert.Equal(t, exact[0].Document.ID, results[0].Document.ID)
+	assert.Equal(t, collectionName+"_yaklang", results[0].Document.ID)
+
+	// 重新打开集合，索引应从数据库恢复而不是重建
+	reopened, err := NewSQLiteVectorStore(db, collectionName, "mock", 3, mockEmbed)
+	assert.NoError(t, err)
+	assert.Equal(t, 49, reopened.index.Len())
+	results, err = reopened.Search("什么是Yaklang", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, collectionName+"_yaklang", results[0].Document.ID)
+
+	// 禁用索引时直接精确搜索
+	exactStore, err := NewSQLiteVectorStore(db, collectionName, "mock", 3, mockEmbed, WithExactSearch(true))
+	assert.NoError(t, err)
+	assert.Nil(t, exactStore.index)
+	results, err = exactStore.Search("什么是Yaklang", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, collectionName+"_yaklang", results[0].Document.ID)
+}
+
+// plainVectorStore 只暴露 VectorStore 接口，用于模拟不支持关键词检索的存储
+type plainVectorStore struct {
+	VectorStore
+}
+
+// 测试默认检索配置在不支持关键词检索的存储上只使用向量检索
+func TestSQLiteVectorStorePlainSearchWithOptions(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+	db := consts.GetGormProfileDatabase()
+	collectionName := "test_plain_" + utils.RandStringBytes(8)
+
+	store, err := NewSQLiteVectorStore(db, collectionName, "mock", 3, mockEmbed)
+	assert.NoError(t, err)
+	defer store.Remove()
+	ragSystem := NewRAGSystem(mockEmbed, &plainVectorStore{store})
+
+	assert.NoError(t, store.Add(
+		Document{
+			ID:        collectionName + "_yaklang",
+			Content:   "Yaklang是一种安全研究编程语言",
+			Metadata:  map[string]any{"type": "lang"},
+			Embedding: []float64{1.0, 0.0, 0.0},
+		},
+		Document{
+			ID:        collectionName + "_mitm",
+			Content:   "MITM plugin hijacks http request",
+			Metadata:  map[string]any{"type": "mitm"},
+			Embedding: []float64{0.0, 1.0, 0.0},
+		},
+	))
+
+	results, err := ragSystem.SearchWithOptions("什么是Yaklang")
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, collectionName+"_yaklang", results[0].Document.ID)
+
+	results, err = ragSystem.SearchWithOptions("什么是Yaklang", WithMetadataEquals("type", "mitm"))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, collectionName+"_mitm", results[0].Document.ID)
+
+	// 显式开启关键词检索时仍然报错
+	_, err = ragSystem.SearchWithOptions("http", WithKeywordSearch(true))
+	assert.Error(t, err)
+}
+
+// 测试 SQLiteVectorStore 的元数据过滤与关键词检索
+func TestSQLiteVectorStoreHybridSearch(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+	db := consts.GetGormProfileDatabase()
+	collectionName := "test_hybrid_" + utils.RandStringBytes(8)
+
+	store, err := NewSQLiteVectorStore(db, collectionName, "mock", 3, mockEmbed)
+	assert.NoError(t, err)
+	defer store.Remove()
+	ragSystem := NewRAGSystem(mockEmbed, store)
+
+	var docs []Document
+	for i := 0; i < 30; i++ {
+		docs = append(docs, Document{
+			ID:        fmt.Sprintf("%s_doc_%d", collectionName, i),
+			Content:   fmt.Sprintf("port scan plugin number %d", i),
+			Metadata:  map[string]any{"type": "port-scan", "index": i},
+			Embedding: []float64{1.0, float64(i) / 10, 0},
+		})
+	}
+	docs = append(docs, Document{
+		ID:        collectionName + "_mitm",
+		Content:   "MITM plugin hijacks http request",
+		Metadata:  map[string]any{"type": "mitm"},
+		Embedding: []float64{0.0, 1.0, 0.0},
+	})
+	assert.NoError(t, store.Add(docs...))
+
+	results, err := store.SearchWithFilter("什么是Yaklang", 5, MetadataEquals("type", "mitm"))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, collectionName+"_mitm", results[0].Document.ID)
+	assert.Equal(t, "MITM plugin hijacks http request", results[0].Document.Content)
+
+	results, err = store.SearchWithFilter("什么是Yaklang", 5, MetadataEquals("index", 3))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	results, err = ragSystem.SearchWithOptions("hijacks http", WithVectorSearch(false))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, collectionName+"_mitm", results[0].Document.ID)
+
+	// 关键词索引建立后新增的文档也能被检索到
+	assert.NoError(t, store.Add(Document{
+		ID:        collectionName + "_fuzzer",
+		Content:   "http fuzzer template",
+		Metadata:  map[string]any{"type": "fuzzer"},
+		Embedding: []float64{0.0, 0.0, 1.0},
+	}))
+	results, err = ragSystem.SearchWithOptions("fuzzer", WithVectorSearch(false))
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, collectionName+"_fuzzer", results[0].Document.ID)
+
+	results, err = ragSystem.SearchWithOptions("http", WithSearchLimit(3), WithMetadataFilter(MetadataNot(MetadataEquals("type", "port-scan"))))
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+}
@@ -15,11 +15,16 @@ type GetawayClient struct {
 }
 
 var _ aispec.AIClient = (*GetawayClient)(nil)
+var _ aispec.OpenAICompatibleChatter = (*GetawayClient)(nil)
 
 func (g *GetawayClient) GetModelList() ([]*aispec.ModelMeta, error) {
 	return aispec.ListChatModels(g.targetUrl, g.BuildHTTPOptions)
 }
 
+func (g *GetawayClient) ChatCompletionsURL() string {
+	return g.targetUrl
+}
+
 func (g *GetawayClient) SupportedStructuredStream() bool {
 	return true
 }
@@ -44,6 +49,7 @@ func (g *GetawayClient) Chat(s string, function ...any) (string, error) {
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 	)
 }
 
@@ -167,6 +167,7 @@ func LoadProvidersFromDatabase(config *ServerConfig) error {
 			DomainOrURL: dbProvider.DomainOrURL,
 			APIKey:      dbProvider.APIKey,
 			NoHTTPS:     dbProvider.NoHTTPS,
+			Embedding:   dbProvider.Embedding,
 			DbProvider:  dbProvider, // Set database object directly
 		}
 
@@ -206,6 +207,12 @@ func LoadProvidersFromDatabase(config *ServerConfig) error {
 		return utils.Errorf("Failed to load API keys from database: %v", err)
 	}
 
+	// 3. Load API key quotas
+	err = config.Quotas.LoadFromDB()
+	if err != nil {
+		return utils.Errorf("Failed to load API key quotas from database: %v", err)
+	}
+
 	return nil
 }
 
@@ -17,8 +17,9 @@ type ModelConfig struct {
 }
 
 type KeyConfig struct {
-	Key           string   `yaml:"key" json:"key"`
-	AllowedModels []string `yaml:"allowed_models" json:"allowed_models"`
+	Key           string         `yaml:"key" json:"key"`
+	AllowedModels []string       `yaml:"allowed_models" json:"allowed_models"`
+	Quotas        []*QuotaConfig `yaml:"quotas,omitempty" json:"quotas,omitempty"`
 }
 
 type Config struct {
@@ -77,6 +78,11 @@ func (c *YamlConfig) ToServerConfig() (*ServerConfig, error) {
 		}
 		config.Keys.keys[keyConfig.Key] = key
 		config.KeyAllowedModels.allowedModels[keyConfig.Key] = key.AllowedModels
+		for _, quota := range keyConfig.Quotas {
+			if quota != nil {
+				config.Quotas.SetStaticQuota(keyConfig.Key, quota)
+			}
+		}
 
 		// Set models allowed for this key
 		// Already set up above
@@ -259,3 +259,114 @@ func UpdateAiApiKeyAllowedModels(id uint, allowedModels string) error {
 
 	return nil
 }
+
+// GetAllAiApiKeyQuotas gets all API key quotas
+func GetAllAiApiKeyQuotas() ([]*schema.AiApiKeyQuota, error) {
+	var quotas []*schema.AiApiKeyQuota
+	if err := GetDB().Find(&quotas).Error; err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// SaveAiApiKeyQuota 保存 API Key 的配额，同一个 Key 与模型只保留一条配额
+func SaveAiApiKeyQuota(quota *schema.AiApiKeyQuota) (*schema.AiApiKeyQuota, error) {
+	var existing schema.AiApiKeyQuota
+	err := GetDB().Where("api_key = ? AND model_name = ?", quota.APIKey, quota.ModelName).First(&existing).Error
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return nil, err
+		}
+		if err := GetDB().Create(quota).Error; err != nil {
+			return nil, err
+		}
+		return quota, nil
+	}
+
+	existing.RequestsPerMinute = quota.RequestsPerMinute
+	existing.TokensPerDay = quota.TokensPerDay
+	existing.MaxConcurrentStreams = quota.MaxConcurrentStreams
+	if err := GetDB().Save(&existing).Error; err != nil {
+		return nil, err
+	}
+	return &existing, nil
+}
+
+// DeleteAiApiKeyQuotaByID deletes API key quota by ID
+func DeleteAiApiKeyQuotaByID(id uint) error {
+	result := GetDB().Where("id = ?", id).Unscoped().Delete(&schema.AiApiKeyQuota{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// AddAiApiKeyUsage 累加 API Key 在某一天、某个模型上的用量
+func AddAiApiKeyUsage(delta *schema.AiApiKeyUsage) error {
+	db := GetDB()
+	var usage schema.AiApiKeyUsage
+	err := db.Where("api_key = ? AND model_name = ? AND date = ? AND estimated = ?", delta.APIKey, delta.ModelName, delta.Date, delta.Estimated).First(&usage).Error
+	if err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+		return db.Create(&schema.AiApiKeyUsage{
+			APIKey:        delta.APIKey,
+			ModelName:     delta.ModelName,
+			Date:          delta.Date,
+			RequestCount:  delta.RequestCount,
+			SuccessCount:  delta.SuccessCount,
+			FailureCount:  delta.FailureCount,
+			RejectedCount: delta.RejectedCount,
+			InputTokens:   delta.InputTokens,
+			OutputTokens:  delta.OutputTokens,
+			Estimated:     delta.Estimated,
+		}).Error
+	}
+	return db.Model(&usage).UpdateColumns(map[string]any{
+		"request_count":  gorm.Expr("request_count + ?", delta.RequestCount),
+		"success_count":  gorm.Expr("success_count + ?", delta.SuccessCount),
+		"failure_count":  gorm.Expr("failure_count + ?", delta.FailureCount),
+		"rejected_count": gorm.Expr("rejected_count + ?", delta.RejectedCount),
+		"input_tokens":   gorm.Expr("input_tokens + ?", delta.InputTokens),
+		"output_tokens":  gorm.Expr("output_tokens + ?", delta.OutputTokens),
+		"updated_at":     time.Now(),
+	}).Error
+}
+
+// GetAiApiKeyUsages 查询用量记录，apiKey 为空时查询所有 Key，日期格式为 2006-01-02，为空时不限制
+func GetAiApiKeyUsages(apiKey string, from, to string) ([]*schema.AiApiKeyUsage, error) {
+	db := GetDB()
+	if apiKey != "" {
+		db = db.Where("api_key = ?", apiKey)
+	}
+	if from != "" {
+		db = db.Where("date >= ?", from)
+	}
+	if to != "" {
+		db = db.Where("date <= ?", to)
+	}
+	var usages []*schema.AiApiKeyUsage
+	if err := db.Order("date desc, api_key asc, model_name asc").Find(&usages).Error; err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
+
+// GetAiApiKeyTokenUsage 获取 API Key 某一天已经使用的 token 数，modelName 为空时统计所有模型
+func GetAiApiKeyTokenUsage(apiKey string, modelName string, date string) (int64, error) {
+	db := GetDB().Model(&schema.AiApiKeyUsage{}).Where("api_key = ? AND date = ?", apiKey, date)
+	if modelName != "" {
+		db = db.Where("model_name = ?", modelName)
+	}
+	var result struct {
+		Total int64
+	}
+	if err := db.Select("COALESCE(SUM(input_tokens + output_tokens), 0) AS total").Scan(&result).Error; err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
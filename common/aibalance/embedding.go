@@ -0,0 +1,306 @@
+package aibalance
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// healthCheckEmbeddingInput is the input to check the health of embedding providers
+const healthCheckEmbeddingInput = "ping"
+
+// EmbeddingRequest is the request body of OpenAI compatible /v1/embeddings,
+// input can be a string or an array of strings
+type EmbeddingRequest struct {
+	Model          string `json:"model"`
+	Input          any    `json:"input"`
+	EncodingFormat string `json:"encoding_format,omitempty"`
+	User           string `json:"user,omitempty"`
+}
+
+// EmbeddingData is one embedding of the response, Embedding is []float64,
+// or a base64 string of little-endian float32 when encoding_format is base64
+type EmbeddingData struct {
+	Object    string `json:"object"`
+	Index     int    `json:"index"`
+	Embedding any    `json:"embedding"`
+}
+
+type EmbeddingUsage struct {
+	PromptTokens int64 `json:"prompt_tokens"`
+	TotalTokens  int64 `json:"total_tokens"`
+}
+
+// EmbeddingResponse is the response body of OpenAI compatible /v1/embeddings
+type EmbeddingResponse struct {
+	Object string           `json:"object"`
+	Data   []*EmbeddingData `json:"data"`
+	Model  string           `json:"model"`
+	Usage  EmbeddingUsage   `json:"usage"`
+}
+
+// Inputs returns the texts to embed
+func (r *EmbeddingRequest) Inputs() ([]string, error) {
+	switch ret := r.Input.(type) {
+	case string:
+		return []string{ret}, nil
+	case []any:
+		inputs := make([]string, 0, len(ret))
+		for _, item := range ret {
+			text, ok := item.(string)
+			if !ok {
+				return nil, utils.Errorf("unsupported input item type %T, only strings are supported", item)
+			}
+			inputs = append(inputs, text)
+		}
+		if len(inputs) == 0 {
+			return nil, utils.Error("input is empty")
+		}
+		return inputs, nil
+	case nil:
+		return nil, utils.Error("input is required")
+	default:
+		return nil, utils.Errorf("unsupported input type %T", ret)
+	}
+}
+
+// encodeEmbeddingBase64 encodes the embedding as OpenAI does for encoding_format=base64
+func encodeEmbeddingBase64(vector []float64) string {
+	buf := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(float32(v)))
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// PeekOrderedEmbeddingProviders returns the embedding providers of the model, see PeekOrderedProviders
+func (e *Entrypoints) PeekOrderedEmbeddingProviders(model string) []*Provider {
+	var providers []*Provider
+	for _, p := range e.PeekOrderedProviders(model) {
+		if p.IsEmbeddingModel() {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// PeekOrderedChatProviders returns the providers of the model except embedding providers, see PeekOrderedProviders
+func (e *Entrypoints) PeekOrderedChatProviders(model string) []*Provider {
+	var providers []*Provider
+	for _, p := range e.PeekOrderedProviders(model) {
+		if !p.IsEmbeddingModel() {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// writeOpenAIErrorResponse writes an error in the OpenAI error format
+func (c *ServerConfig) writeOpenAIErrorResponse(conn net.Conn, statusCode int, errType string, message string) {
+	c.writeJSONResponse(conn, statusCode, map[string]any{
+		"error": map[string]any{
+			"message": message,
+			"type":    errType,
+			"param":   nil,
+			"code":    nil,
+		},
+	})
+}
+
+// authorizeModelRequest checks the API key in the Authorization header and whether the key can use the model,
+// an error response is written to conn when the request is not authorized
+func (c *ServerConfig) authorizeModelRequest(conn net.Conn, auth string, modelName string) (*Key, bool) {
+	value := strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	if value == "" {
+		c.logError("No valid authentication info provided")
+		c.writeOpenAIErrorResponse(conn, http.StatusUnauthorized, "invalid_request_error", "no API key provided")
+		return nil, false
+	}
+	key, ok := c.Keys.Get(value)
+	if !ok {
+		c.logError("No matching key configuration found: %s", utils.ShrinkString(value, 8))
+		c.writeOpenAIErrorResponse(conn, http.StatusUnauthorized, "invalid_request_error", "invalid API key")
+		return nil, false
+	}
+
+	allowedModels, ok := c.KeyAllowedModels.Get(key.Key)
+	if !ok {
+		c.logError("Key[%v] has no allowed models configured", utils.ShrinkString(key.Key, 8))
+		c.writeOpenAIErrorResponse(conn, http.StatusNotFound, "invalid_request_error", fmt.Sprintf("model %s not found", modelName))
+		return nil, false
+	}
+	isAllowed, ok := allowedModels[modelName]
+	if !ok {
+		c.logError("Key[%v] requested model %s is not in allowed list", utils.ShrinkString(key.Key, 8), modelName)
+		c.writeOpenAIErrorResponse(conn, http.StatusNotFound, "invalid_request_error", fmt.Sprintf("model %s not found", modelName))
+		return nil, false
+	}
+	if !isAllowed {
+		c.logError("Key[%v] requested model %s is not allowed", utils.ShrinkString(key.Key, 8), modelName)
+		c.writeOpenAIErrorResponse(conn, http.StatusForbidden, "invalid_request_error", fmt.Sprintf("model %s is not allowed", modelName))
+		return nil, false
+	}
+	return key, true
+}
+
+// serveEmbeddings handles OpenAI compatible /v1/embeddings requests,
+// the request is forwarded to the embedding providers of the model in order until one succeeds
+func (c *ServerConfig) serveEmbeddings(conn net.Conn, rawPacket []byte) {
+	c.logInfo("Starting to handle new embedding request")
+	auth := lowhttp.GetHTTPPacketHeader(rawPacket, "Authorization")
+	body := lowhttp.GetHTTPPacketBody(rawPacket)
+	if len(body) == 0 {
+		c.logError("Request body is empty")
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", "request body is empty")
+		return
+	}
+
+	var req EmbeddingRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		c.logError("Failed to parse embedding request body: %v", err)
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", "invalid request body: "+err.Error())
+		return
+	}
+	inputs, err := req.Inputs()
+	if err != nil {
+		c.logError("Invalid embedding input: %v", err)
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	switch req.EncodingFormat {
+	case "", "float", "base64":
+	default:
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", "unsupported encoding_format: "+req.EncodingFormat)
+		return
+	}
+
+	modelName := req.Model
+	key, ok := c.authorizeModelRequest(conn, auth, modelName)
+	if !ok {
+		return
+	}
+
+	ticket, quotaErr := c.Quotas.Acquire(key.Key, modelName)
+	if quotaErr != nil {
+		c.logWarn("Key[%v] requesting embedding model %s rejected: %v", utils.ShrinkString(key.Key, 8), modelName, quotaErr)
+		c.writeQuotaExceededResponse(conn, quotaErr)
+		return
+	}
+	defer ticket.Release()
+
+	var inputTokens, inputBytes int64
+	for _, input := range inputs {
+		inputTokens += EstimateTokens(input)
+		inputBytes += int64(len(input))
+	}
+
+	providers := c.Entrypoints.PeekOrderedEmbeddingProviders(modelName)
+	if len(providers) == 0 {
+		c.logWarn("No valid embedding providers found for model %s, trying to reload from database...", modelName)
+		if err := LoadProvidersFromDatabase(c); err != nil {
+			c.logError("Failed to reload providers from database: %v", err)
+		} else {
+			providers = c.Entrypoints.PeekOrderedEmbeddingProviders(modelName)
+		}
+	}
+	if len(providers) == 0 {
+		c.logError("No valid embedding providers found for model %s", modelName)
+		c.Quotas.RecordUsage(key.Key, modelName, 0, 0, false, false)
+		c.writeOpenAIErrorResponse(conn, http.StatusNotFound, "invalid_request_error", fmt.Sprintf("no embedding provider available for model %s", modelName))
+		return
+	}
+
+	c.logInfo("Found %d embedding providers for model %s, embedding %d inputs", len(providers), modelName, len(inputs))
+
+	var lastError error
+	for i, provider := range providers {
+		c.logInfo("Trying embedding provider %d/%d for model %s: %s", i+1, len(providers), modelName, provider.TypeName)
+		client := provider.GetEmbeddingClient()
+
+		start := time.Now()
+		rsp := &EmbeddingResponse{Object: "list", Model: modelName}
+		for index, input := range inputs {
+			vector, err := client.Embedding(input)
+			if err != nil {
+				lastError = err
+				break
+			}
+			data := &EmbeddingData{Object: "embedding", Index: index, Embedding: vector}
+			if req.EncodingFormat == "base64" {
+				data.Embedding = encodeEmbeddingBase64(vector)
+			}
+			rsp.Data = append(rsp.Data, data)
+		}
+		latencyMs := time.Since(start).Milliseconds()
+
+		if len(rsp.Data) != len(inputs) {
+			c.logError("Embedding provider %s failed: %v", provider.TypeName, lastError)
+			go func(p *Provider) {
+				if err := p.UpdateDbProvider(false, latencyMs); err != nil {
+					c.logError("Failed to update failed provider status: %v", err)
+				}
+			}(provider)
+			continue
+		}
+
+		go func(p *Provider) {
+			if err := p.UpdateDbProvider(true, latencyMs); err != nil {
+				c.logError("Failed to update provider status: %v", err)
+			}
+		}(provider)
+		go func() {
+			if err := UpdateAiApiKeyStats(key.Key, inputBytes, 0, true); err != nil {
+				c.logError("Failed to update API key statistics: %v", err)
+			}
+		}()
+
+		rsp.Usage = EmbeddingUsage{PromptTokens: inputTokens, TotalTokens: inputTokens}
+		// the embedding client does not return the usage of the upstream
+		c.Quotas.RecordUsage(key.Key, modelName, inputTokens, 0, true, true)
+		c.logInfo("Embedding provider %s succeeded for model %s in %dms", provider.TypeName, modelName, latencyMs)
+		c.writeJSONResponse(conn, http.StatusOK, rsp)
+		return
+	}
+
+	c.logError("All embedding providers failed for model %s, last error: %v", modelName, lastError)
+	c.Quotas.RecordUsage(key.Key, modelName, 0, 0, false, false)
+	c.writeOpenAIErrorResponse(conn, http.StatusBadGateway, "api_error", fmt.Sprintf("all embedding providers failed for %s, last error: %v", modelName, lastError))
+}
+
+// executeEmbeddingHealthCheck checks embedding providers with an embedding request instead of a chat
+func executeEmbeddingHealthCheck(p *Provider, providerIdentifierForLog string) (isHealthy bool, latencyMs int64, checkErr error) {
+	log.Debugf("Executing embedding health check for provider: %s", providerIdentifierForLog)
+
+	startTime := time.Now()
+	result := make(chan error, 1)
+	go func() {
+		_, err := p.GetEmbeddingClient().Embedding(healthCheckEmbeddingInput)
+		result <- err
+	}()
+
+	select {
+	case checkErr = <-result:
+	case <-time.After(20 * time.Second):
+		checkErr = fmt.Errorf("health check timeout after 20 seconds for %s", providerIdentifierForLog)
+	}
+
+	latencyMs = time.Since(startTime).Milliseconds()
+	if checkErr != nil {
+		checkErr = fmt.Errorf("health check failed for %s: %v", providerIdentifierForLog, checkErr)
+		log.Warnf("Embedding health check failed for %s, Latency: %dms, Error: %v", providerIdentifierForLog, latencyMs, checkErr)
+		return false, latencyMs, checkErr
+	}
+	isHealthy = latencyMs < 10000
+	log.Debugf("Embedding health check finished for %s, Latency: %dms, healthy: %v", providerIdentifierForLog, latencyMs, isHealthy)
+	return isHealthy, latencyMs, nil
+}
@@ -0,0 +1,174 @@
+package aibalance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func newTestServerConfigWithProviders(modelName string, providers ...*Provider) (*ServerConfig, string) {
+	apiKey := "test-" + utils.RandStringBytes(10)
+	cfg := NewServerConfig()
+	cfg.Keys.keys[apiKey] = &Key{Key: apiKey, AllowedModels: map[string]bool{modelName: true}}
+	cfg.KeyAllowedModels.allowedModels[apiKey] = map[string]bool{modelName: true}
+	for _, p := range providers {
+		p.WrapperName = modelName
+	}
+	cfg.Models.models[modelName] = providers
+	cfg.Entrypoints.Add(modelName, providers)
+	return cfg, apiKey
+}
+
+func doTestRequest(t *testing.T, cfg *ServerConfig, path string, apiKey string, body string) *http.Response {
+	client, server := net.Pipe()
+	defer client.Close()
+	go cfg.Serve(server)
+
+	go client.Write([]byte("POST " + path + " HTTP/1.1\r\n" +
+		"Host: localhost\r\n" +
+		"Authorization: Bearer " + apiKey + "\r\n" +
+		"Content-Type: application/json\r\n" +
+		fmt.Sprintf("Content-Length: %d\r\n", len(body)) +
+		"\r\n" + body))
+
+	_ = client.SetReadDeadline(time.Now().Add(20 * time.Second))
+	raw, _ := io.ReadAll(client)
+	rsp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(raw)), nil)
+	require.NoError(t, err, string(raw))
+	return rsp
+}
+
+func TestServeEmbeddings(t *testing.T) {
+	var requests []map[string]any
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/embeddings", r.URL.Path)
+		assert.Equal(t, "Bearer upstream-key", r.Header.Get("Authorization"))
+		var req map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"object":"list","data":[{"object":"embedding","index":0,"embedding":[%d,0.5,-1]}],"model":"bge-m3"}`, len(requests))
+	}))
+	defer upstream.Close()
+
+	cfg, apiKey := newTestServerConfigWithProviders("embed-wrapper",
+		&Provider{ModelName: "bge-m3", TypeName: "openai", DomainOrURL: upstream.URL + "/v1", APIKey: "upstream-key", Embedding: true},
+	)
+
+	rsp := doTestRequest(t, cfg, "/v1/embeddings", apiKey, `{"model":"embed-wrapper","input":["hello","你好"]}`)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	var result EmbeddingResponse
+	require.NoError(t, json.NewDecoder(rsp.Body).Decode(&result))
+	assert.Equal(t, "list", result.Object)
+	assert.Equal(t, "embed-wrapper", result.Model)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, 1, result.Data[1].Index)
+	assert.Equal(t, []any{float64(2), 0.5, float64(-1)}, result.Data[1].Embedding)
+	assert.Equal(t, int64(2+2), result.Usage.PromptTokens)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "bge-m3", requests[0]["model"])
+	assert.Equal(t, "你好", requests[1]["input"])
+
+	// base64 编码与 OpenAI 保持一致: little-endian float32
+	rsp = doTestRequest(t, cfg, "/v1/embeddings", apiKey, `{"model":"embed-wrapper","input":"hello","encoding_format":"base64"}`)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	require.NoError(t, json.NewDecoder(rsp.Body).Decode(&result))
+	assert.Equal(t, "AABAQAAAAD8AAIC/", result.Data[0].Embedding)
+
+	rsp = doTestRequest(t, cfg, "/v1/embeddings", "invalid-key", `{"model":"embed-wrapper","input":"hello"}`)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+}
+
+func TestServeEmbeddings_NoEmbeddingProvider(t *testing.T) {
+	cfg, apiKey := newTestServerConfigWithProviders("chat-only",
+		&Provider{ModelName: "gpt-4o-mini", TypeName: "openai", DomainOrURL: "http://127.0.0.1:1/v1", APIKey: "chat-key"},
+	)
+	rsp := doTestRequest(t, cfg, "/v1/embeddings", apiKey, `{"model":"chat-only","input":"hello"}`)
+	assert.Equal(t, http.StatusNotFound, rsp.StatusCode)
+	body, _ := io.ReadAll(rsp.Body)
+	assert.Contains(t, string(body), "no embedding provider available")
+}
+
+func TestServeChatCompletions_ToolCallPassthrough(t *testing.T) {
+	var upstreamRequest map[string]any
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer upstream-key", r.Header.Get("Authorization"))
+		_ = json.NewDecoder(r.Body).Decode(&upstreamRequest)
+		w.Header().Set("Content-Type", "text/event-stream")
+		chunks := []string{
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"role":"assistant","tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_weather","arguments":""}}]}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"city\":"}}]}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"Beijing\"}"}}]},"finish_reason":"tool_calls"}]}`,
+		}
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer upstream.Close()
+
+	cfg, apiKey := newTestServerConfigWithProviders("tool-wrapper",
+		&Provider{ModelName: "qwen-max", TypeName: "tongyi", DomainOrURL: upstream.URL + "/compatible-mode/v1/chat/completions", APIKey: "upstream-key"},
+	)
+
+	body := `{"model":"tool-wrapper","stream":true,"messages":[{"role":"user","content":"weather of Beijing?"}],` +
+		`"tools":[{"type":"function","function":{"name":"get_weather","parameters":{"type":"object","properties":{"city":{"type":"string"}}}}}],"tool_choice":"auto"}`
+	rsp := doTestRequest(t, cfg, "/v1/chat/completions", apiKey, body)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, "text/event-stream", rsp.Header.Get("Content-Type"))
+	raw, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+
+	// 请求原样转发，只替换模型名称
+	assert.Equal(t, "qwen-max", upstreamRequest["model"])
+	assert.Equal(t, "auto", upstreamRequest["tool_choice"])
+	require.Len(t, upstreamRequest["tools"], 1)
+
+	// 响应中的 tool_calls 原样返回，模型名称替换为对外展示的名称
+	var arguments strings.Builder
+	var events int
+	for _, line := range strings.Split(string(raw), "\n") {
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if !strings.HasPrefix(data, "{") {
+			continue
+		}
+		events++
+		var chunk toolCallChunk
+		require.NoError(t, json.Unmarshal([]byte(data), &chunk))
+		assert.Contains(t, data, `"model":"tool-wrapper"`)
+		for _, call := range chunk.Choices[0].Delta.ToolCalls {
+			arguments.WriteString(call.Function.Arguments)
+		}
+	}
+	assert.Equal(t, 3, events)
+	assert.Equal(t, `{"city":"Beijing"}`, arguments.String())
+	assert.Contains(t, string(raw), "data: [DONE]")
+}
+
+func TestRequiresToolCallPassthrough(t *testing.T) {
+	assert.False(t, requiresToolCallPassthrough([]byte(`{"model":"m","messages":[{"role":"user","content":"hi"}]}`)))
+	assert.True(t, requiresToolCallPassthrough([]byte(`{"model":"m","messages":[{"role":"user","content":"hi"}],"functions":[{"name":"f"}]}`)))
+	assert.True(t, requiresToolCallPassthrough([]byte(`{"model":"m","messages":[{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"f","arguments":"{}"}}]},{"role":"tool","tool_call_id":"call_1","content":"ok"}]}`)))
+
+	url, ok := (&Provider{TypeName: "deepseek"}).ChatCompletionsURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://api.deepseek.com/chat/completions", url)
+	url, ok = (&Provider{TypeName: "ollama", DomainOrURL: "10.0.0.1:11434", NoHTTPS: true}).ChatCompletionsURL()
+	assert.True(t, ok)
+	assert.Equal(t, "http://10.0.0.1:11434/v1/chat/completions", url)
+	_, ok = (&Provider{TypeName: "gemini"}).ChatCompletionsURL()
+	assert.False(t, ok)
+}
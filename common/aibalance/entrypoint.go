@@ -255,6 +255,7 @@ func (e *Entrypoint) InitializeFromDB() error {
 			DomainOrURL: dbProvider.DomainOrURL,
 			APIKey:      dbProvider.APIKey,
 			NoHTTPS:     dbProvider.NoHTTPS,
+			Embedding:   dbProvider.Embedding,
 			DbProvider:  dbProvider, // 直接关联数据库对象
 		}
 		e.AddProvider(dbProvider.WrapperName, provider)
@@ -85,6 +85,9 @@ func (m *HealthCheckManager) RecordCheck(providerID uint) {
 // It does not interact with the database or the HealthCheckResult struct directly.
 // providerIdentifierForLog is used for logging purposes (e.g., wrapper name or model name).
 func ExecuteHealthCheckLogic(p *Provider, providerIdentifierForLog string) (isHealthy bool, latencyMs int64, checkErr error) {
+	if p.IsEmbeddingModel() {
+		return executeEmbeddingHealthCheck(p, providerIdentifierForLog)
+	}
 	log.Debugf("Executing health check logic for provider: %s", providerIdentifierForLog)
 
 	startTime := time.Now()
@@ -257,6 +260,7 @@ func CheckAllProviders(checkManager *HealthCheckManager) ([]*HealthCheckResult,
 				DomainOrURL: dbp.DomainOrURL,
 				APIKey:      dbp.APIKey,
 				NoHTTPS:     dbp.NoHTTPS,
+				Embedding:   dbp.Embedding,
 				DbProvider:  dbp,
 			}
 
@@ -381,6 +385,7 @@ func RunManualHealthCheck() ([]*HealthCheckResult, error) {
 			DomainOrURL: dbProvider.DomainOrURL,
 			APIKey:      dbProvider.APIKey,
 			NoHTTPS:     dbProvider.NoHTTPS,
+			Embedding:   dbProvider.Embedding,
 			DbProvider:  dbProvider,
 		}
 
@@ -600,6 +605,7 @@ func RunSingleProviderHealthCheck(providerID uint) (*HealthCheckResult, error) {
 		DomainOrURL: dbProvider.DomainOrURL,
 		APIKey:      dbProvider.APIKey,
 		NoHTTPS:     dbProvider.NoHTTPS,
+		Embedding:   dbProvider.Embedding,
 		DbProvider:  dbProvider,
 	}
 
@@ -24,7 +24,7 @@ import (
 	"github.com/yaklang/yaklang/common/utils"
 )
 
-//go:embed templates/portal.html templates/login.html templates/index.html
+//go:embed templates/portal.html templates/login.html templates/index.html templates/usage.html
 var templatesFS embed.FS
 
 // formatBytes 将字节大小转换为人类可读的格式（KB、MB、GB等）
@@ -594,6 +594,7 @@ func (c *ServerConfig) processAddProviders(conn net.Conn, request *http.Request)
 	domainOrURL := request.PostForm.Get("domain_or_url")
 	apiKeysStr := request.PostForm.Get("api_keys")
 	noHTTPS := request.PostForm.Get("no_https") == "on" // 获取 NoHTTPS 参数
+	embedding := request.PostForm.Get("embedding") == "on"
 
 	// Validate required fields
 	if wrapperName == "" || modelName == "" || modelType == "" || domainOrURL == "" || apiKeysStr == "" {
@@ -626,6 +627,7 @@ func (c *ServerConfig) processAddProviders(conn net.Conn, request *http.Request)
 		DomainOrURL: domainOrURL,
 		Keys:        apiKeys,
 		NoHTTPS:     noHTTPS, // 设置 NoHTTPS 参数
+		Embedding:   embedding,
 	}
 
 	// Convert to Provider object
@@ -650,6 +652,7 @@ func (c *ServerConfig) processAddProviders(conn net.Conn, request *http.Request)
 			APIKey:                provider.APIKey,
 			WrapperName:           wrapperName, // Use WrapperName from form
 			NoHTTPS:               noHTTPS,     // 设置 NoHTTPS 参数
+			Embedding:             embedding,
 			IsHealthy:             false,       // 修改：新provider默认为不健康，需要通过健康检查
 			IsFirstCheckCompleted: false,       // 修改：明确设置首次检查未完成
 			LastRequestTime:       time.Time{}, // 修改：不设置时间，让健康检查来更新
@@ -986,6 +989,14 @@ func (c *ServerConfig) HandlePortalRequest(conn net.Conn, request *http.Request,
 		c.handleGenerateApiKey(conn, request)
 	} else if uriIns.Path == "/portal/api/health-check" {
 		c.serveHealthCheckAPI(conn, request)
+	} else if uriIns.Path == "/portal/usage" {
+		c.serveUsagePage(conn, uriIns.Query())
+	} else if uriIns.Path == "/portal/api/usage" {
+		c.serveUsageAPI(conn, uriIns.Query())
+	} else if uriIns.Path == "/portal/api/quotas" {
+		c.serveQuotasAPI(conn, request)
+	} else if strings.HasPrefix(uriIns.Path, "/portal/api/quotas/") && request.Method == "DELETE" {
+		c.handleDeleteQuota(conn, request, uriIns.Path)
 	} else if uriIns.Path == "/portal/api/providers" {
 		c.serveProvidersAPI(conn, request)
 	} else if uriIns.Path == "/portal/check-all-health" && request.Method == "POST" {
@@ -1210,6 +1221,7 @@ func (c *ServerConfig) serveProvidersAPI(conn net.Conn, request *http.Request) {
 			"last_latency":   p.LastLatency,
 			"is_healthy":     p.IsHealthy,
 			"no_https":       p.NoHTTPS, // 添加 NoHTTPS 配置
+			"embedding":      p.Embedding,
 		})
 	}
 
@@ -1796,6 +1808,7 @@ func (c *ServerConfig) handleValidateProvider(conn net.Conn, request *http.Reque
 	domainOrURL := request.PostForm.Get("domain_or_url")
 	apiKeyToValidate := request.PostForm.Get("api_key_to_validate")
 	noHTTPS := request.PostForm.Get("no_https") == "on"
+	embedding := request.PostForm.Get("embedding") == "on"
 
 	if wrapperName == "" || modelName == "" || modelType == "" || apiKeyToValidate == "" {
 		c.logWarn("Validation request missing required fields (wrapper_name, model_name, model_type, api_key_to_validate)")
@@ -1815,6 +1828,7 @@ func (c *ServerConfig) handleValidateProvider(conn net.Conn, request *http.Reque
 		APIKey:      apiKeyToValidate,
 		WrapperName: wrapperName,
 		NoHTTPS:     noHTTPS,
+		Embedding:   embedding,
 		// Initialize other fields as necessary for health check logic
 		// For example, if your health check needs a DbProvider, you might need to mock it
 		// or adjust the health check to work without it for this temporary validation.
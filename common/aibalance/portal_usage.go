@@ -0,0 +1,303 @@
+package aibalance
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/schema"
+)
+
+// UsageSummary is the usage of an API key in the report period
+type UsageSummary struct {
+	APIKey        string `json:"api_key"`
+	RequestCount  int64  `json:"request_count"`
+	SuccessCount  int64  `json:"success_count"`
+	FailureCount  int64  `json:"failure_count"`
+	RejectedCount int64  `json:"rejected_count"`
+	InputTokens   int64  `json:"input_tokens"`
+	OutputTokens  int64  `json:"output_tokens"`
+	TotalTokens   int64  `json:"total_tokens"`
+	// EstimatedTokens is the part of TotalTokens that is estimated because the upstream did not report usage
+	EstimatedTokens int64 `json:"estimated_tokens"`
+}
+
+// UsageReport is the usage report of API keys, dates are in 2006-01-02 format
+type UsageReport struct {
+	APIKey    string                  `json:"api_key,omitempty"`
+	From      string                  `json:"from"`
+	To        string                  `json:"to"`
+	Summaries []*UsageSummary         `json:"summaries"`
+	Usages    []*schema.AiApiKeyUsage `json:"usages"`
+	Quotas    []*schema.AiApiKeyQuota `json:"quotas"`
+}
+
+// buildUsageReport builds the usage report, the last 7 days are reported if the period is not set
+func (c *ServerConfig) buildUsageReport(query url.Values) (*UsageReport, error) {
+	report := &UsageReport{
+		APIKey: strings.TrimSpace(query.Get("api_key")),
+		From:   strings.TrimSpace(query.Get("from")),
+		To:     strings.TrimSpace(query.Get("to")),
+	}
+	now := time.Now()
+	if report.To == "" {
+		report.To = now.Format(usageDateLayout)
+	}
+	if report.From == "" {
+		report.From = now.AddDate(0, 0, -6).Format(usageDateLayout)
+	}
+	for _, date := range []string{report.From, report.To} {
+		if _, err := time.Parse(usageDateLayout, date); err != nil {
+			return nil, fmt.Errorf("invalid date %q, use format %s", date, usageDateLayout)
+		}
+	}
+
+	usages, err := GetAiApiKeyUsages(report.APIKey, report.From, report.To)
+	if err != nil {
+		return nil, err
+	}
+	report.Usages = usages
+
+	summaries := make(map[string]*UsageSummary)
+	for _, usage := range usages {
+		summary, ok := summaries[usage.APIKey]
+		if !ok {
+			summary = &UsageSummary{APIKey: usage.APIKey}
+			summaries[usage.APIKey] = summary
+			report.Summaries = append(report.Summaries, summary)
+		}
+		summary.RequestCount += usage.RequestCount
+		summary.SuccessCount += usage.SuccessCount
+		summary.FailureCount += usage.FailureCount
+		summary.RejectedCount += usage.RejectedCount
+		summary.InputTokens += usage.InputTokens
+		summary.OutputTokens += usage.OutputTokens
+		summary.TotalTokens += usage.InputTokens + usage.OutputTokens
+		if usage.Estimated {
+			summary.EstimatedTokens += usage.InputTokens + usage.OutputTokens
+		}
+	}
+	sort.SliceStable(report.Summaries, func(i, j int) bool {
+		return report.Summaries[i].TotalTokens > report.Summaries[j].TotalTokens
+	})
+
+	for _, quota := range c.Quotas.GetQuotas() {
+		if report.APIKey != "" && quota.APIKey != report.APIKey {
+			continue
+		}
+		report.Quotas = append(report.Quotas, quota)
+	}
+	sort.SliceStable(report.Quotas, func(i, j int) bool {
+		if report.Quotas[i].APIKey != report.Quotas[j].APIKey {
+			return report.Quotas[i].APIKey < report.Quotas[j].APIKey
+		}
+		return report.Quotas[i].ModelName < report.Quotas[j].ModelName
+	})
+	return report, nil
+}
+
+// serveUsagePage displays usage reports and quotas of API keys
+func (c *ServerConfig) serveUsagePage(conn net.Conn, query url.Values) {
+	c.logInfo("Serving usage page")
+
+	report, err := c.buildUsageReport(query)
+	if err != nil {
+		c.logError("Failed to build usage report: %v", err)
+		errorResponse := fmt.Sprintf("HTTP/1.1 400 Bad Request\r\n\r\nFailed to build usage report: %v", err)
+		conn.Write([]byte(errorResponse))
+		return
+	}
+
+	data := struct {
+		CurrentTime  string
+		Report       *UsageReport
+		APIKeys      []string
+		AllModelList []string
+	}{
+		CurrentTime: time.Now().Format("2006-01-02 15:04:05"),
+		Report:      report,
+	}
+	if keys, err := GetAllAiApiKeys(); err == nil {
+		for _, key := range keys {
+			data.APIKeys = append(data.APIKeys, key.APIKey)
+		}
+	}
+	if providers, err := GetAllAiProviders(); err == nil {
+		modelSet := make(map[string]bool)
+		for _, p := range providers {
+			if p.WrapperName != "" && !modelSet[p.WrapperName] {
+				modelSet[p.WrapperName] = true
+				data.AllModelList = append(data.AllModelList, p.WrapperName)
+			}
+		}
+		sort.Strings(data.AllModelList)
+	}
+
+	tmpl, err := template.ParseFS(templatesFS, "templates/usage.html")
+	if err != nil {
+		c.logError("Failed to parse usage template: %v", err)
+		errorResponse := fmt.Sprintf("HTTP/1.1 500 Internal Server Error\r\n\r\nFailed to read template: %v", err)
+		conn.Write([]byte(errorResponse))
+		return
+	}
+
+	var htmlBuffer bytes.Buffer
+	err = tmpl.Execute(&htmlBuffer, data)
+	if err != nil {
+		c.logError("Failed to execute usage template: %v", err)
+		errorResponse := fmt.Sprintf("HTTP/1.1 500 Internal Server Error\r\n\r\nFailed to render template: %v", err)
+		conn.Write([]byte(errorResponse))
+		return
+	}
+
+	header := "HTTP/1.1 200 OK\r\n" +
+		"Content-Type: text/html; charset=utf-8\r\n" +
+		"Content-Length: " + fmt.Sprintf("%d", htmlBuffer.Len()) + "\r\n" +
+		"\r\n"
+	conn.Write([]byte(header))
+	conn.Write(htmlBuffer.Bytes())
+}
+
+// serveUsageAPI returns usage reports in JSON, supports api_key / from / to query parameters
+func (c *ServerConfig) serveUsageAPI(conn net.Conn, query url.Values) {
+	c.logInfo("Handling usage API request")
+
+	report, err := c.buildUsageReport(query)
+	if err != nil {
+		c.logError("Failed to build usage report: %v", err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": err.Error(),
+		})
+		return
+	}
+	c.writeJSONResponse(conn, http.StatusOK, report)
+}
+
+// serveQuotasAPI lists quotas (GET) or creates / updates a quota (POST)
+func (c *ServerConfig) serveQuotasAPI(conn net.Conn, request *http.Request) {
+	c.logInfo("Handling quotas API request: %s", request.Method)
+
+	switch request.Method {
+	case http.MethodGet:
+		c.writeJSONResponse(conn, http.StatusOK, map[string]interface{}{
+			"success": true,
+			"quotas":  c.Quotas.GetQuotas(),
+		})
+		return
+	case http.MethodPost:
+	default:
+		c.writeJSONResponse(conn, http.StatusMethodNotAllowed, map[string]string{"error": "Method not allowed, use GET or POST"})
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(request.Body)
+	if err != nil {
+		c.logError("Failed to read request body for saving quota: %v", err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Failed to read request body",
+		})
+		return
+	}
+	defer request.Body.Close()
+
+	var quota schema.AiApiKeyQuota
+	if err := json.Unmarshal(bodyBytes, &quota); err != nil {
+		c.logError("Failed to unmarshal request body for saving quota: %v", err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body format",
+		})
+		return
+	}
+	quota.ID = 0
+	quota.APIKey = strings.TrimSpace(quota.APIKey)
+	quota.ModelName = strings.TrimSpace(quota.ModelName)
+	if quota.APIKey == "" {
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "api_key is required",
+		})
+		return
+	}
+	if quota.RequestsPerMinute < 0 || quota.TokensPerDay < 0 || quota.MaxConcurrentStreams < 0 {
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "quota limits cannot be negative",
+		})
+		return
+	}
+
+	saved, err := SaveAiApiKeyQuota(&quota)
+	if err != nil {
+		c.logError("Failed to save quota for API key %s: %v", quota.APIKey, err)
+		c.writeJSONResponse(conn, http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "Failed to save quota",
+		})
+		return
+	}
+	if err := c.Quotas.LoadFromDB(); err != nil {
+		c.logError("Failed to reload quotas after saving quota (ID: %d): %v", saved.ID, err)
+	}
+
+	c.logInfo("Successfully saved quota (ID: %d) for API key %s, model: %q", saved.ID, saved.APIKey, saved.ModelName)
+	c.writeJSONResponse(conn, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "Quota saved successfully",
+		"quota":   saved,
+	})
+}
+
+// handleDeleteQuota deletes a quota, example path: /portal/api/quotas/123
+func (c *ServerConfig) handleDeleteQuota(conn net.Conn, request *http.Request, path string) {
+	c.logInfo("Processing delete quota request: %s", path)
+
+	idStr := path[strings.LastIndex(path, "/")+1:]
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil || id == 0 {
+		c.logError("Invalid quota ID '%s' for deletion: %v", idStr, err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid quota ID format",
+		})
+		return
+	}
+
+	if err := DeleteAiApiKeyQuotaByID(uint(id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.writeJSONResponse(conn, http.StatusNotFound, map[string]interface{}{
+				"success": false,
+				"message": "Quota not found",
+			})
+			return
+		}
+		c.logError("Failed to delete quota (ID: %d): %v", id, err)
+		c.writeJSONResponse(conn, http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "Failed to delete quota",
+		})
+		return
+	}
+	if err := c.Quotas.LoadFromDB(); err != nil {
+		c.logError("Failed to reload quotas after deleting quota (ID: %d): %v", id, err)
+	}
+
+	c.logInfo("Successfully deleted quota (ID: %d)", id)
+	c.writeJSONResponse(conn, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "Quota deleted successfully",
+	})
+}
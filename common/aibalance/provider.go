@@ -10,6 +10,7 @@ import (
 
 	"github.com/yaklang/yaklang/common/ai"
 	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/ai/embedding"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
@@ -24,6 +25,8 @@ type ConfigProvider struct {
 	Keys        []string `yaml:"keys" json:"keys"`
 	KeyFile     string   `yaml:"key_file" json:"key_file"`
 	NoHTTPS     bool     `yaml:"no_https" json:"no_https"`
+	// Embedding marks the model as an embedding model, it only serves /v1/embeddings
+	Embedding bool `yaml:"embedding,omitempty" json:"embedding,omitempty"`
 	// works for qwen3
 	OptionalAllowReason  string `yaml:"optional_allow_reason,omitempty" json:"optional_allow_reason,omitempty"`
 	OptionalReasonBudget int    `yaml:"optional_reason_budget,omitempty" json:"optional_reason_budget,omitempty"`
@@ -36,6 +39,8 @@ type Provider struct {
 	DomainOrURL string `json:"domain_or_url"`
 	APIKey      string `json:"api_key"`
 	NoHTTPS     bool   `json:"no_https"`
+	// Embedding marks the model as an embedding model
+	Embedding bool `json:"embedding,omitempty"`
 	// works for qwen3
 	OptionalAllowReason  string `json:"optional_allow_reason,omitempty"`
 	OptionalReasonBudget int    `json:"optional_reason_budget,omitempty"`
@@ -69,6 +74,7 @@ func (cp *ConfigProvider) toProvider(apiKey string) *Provider {
 		DomainOrURL:          cp.DomainOrURL,
 		APIKey:               apiKey,
 		NoHTTPS:              cp.NoHTTPS,
+		Embedding:            cp.Embedding,
 		OptionalAllowReason:  cp.OptionalAllowReason,
 		OptionalReasonBudget: cp.OptionalReasonBudget,
 		// WrapperName is initially empty, set by external
@@ -148,7 +154,7 @@ func (cp *ConfigProvider) GetAllKeys() []string {
 	return allKeys
 }
 
-func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, onStream, onReasonStream func(reader io.Reader)) (aispec.AIClient, error) {
+func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, onStream, onReasonStream func(reader io.Reader), extraOpts ...aispec.AIConfigOption) (aispec.AIClient, error) {
 	log.Infof("GetAIClient: type: %s, domain: %s, key: %s, model: %s, no_https: %v", p.TypeName, p.DomainOrURL, utils.ShrinkString(p.APIKey, 8), p.ModelName, p.NoHTTPS)
 
 	var images []any
@@ -185,13 +191,8 @@ func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, on
 		}),
 	)
 
-	if target := strings.TrimSpace(p.DomainOrURL); target != "" {
-		if utils.IsHttpOrHttpsUrl(target) {
-			opts = append(opts, aispec.WithBaseURL(target))
-		} else {
-			opts = append(opts, aispec.WithDomain(target))
-		}
-	}
+	opts = append(opts, p.targetOptions()...)
+	opts = append(opts, extraOpts...)
 	client := ai.GetAI(p.TypeName, opts...)
 	if utils.IsNil(client) || client == nil {
 		return nil, errors.New("failed to get ai client, no such type: " + p.TypeName)
@@ -199,11 +200,50 @@ func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, on
 	return client, nil
 }
 
+// targetOptions returns the domain or base url option of the provider, the gateway default is used when it is empty
+func (p *Provider) targetOptions() []aispec.AIConfigOption {
+	target := strings.TrimSpace(p.DomainOrURL)
+	if target == "" {
+		return nil
+	}
+	if utils.IsHttpOrHttpsUrl(target) {
+		return []aispec.AIConfigOption{aispec.WithBaseURL(target)}
+	}
+	return []aispec.AIConfigOption{aispec.WithDomain(target)}
+}
+
 // GetAIClient gets the AI client
 func (p *Provider) GetAIClient(onStream, onReasonStream func(reader io.Reader)) (aispec.AIClient, error) {
 	return p.GetAIClientWithImages(nil, onStream, onReasonStream)
 }
 
+// IsEmbeddingModel returns whether the provider serves an embedding model,
+// models not marked explicitly are recognized by name, e.g. text-embedding-3-small
+func (p *Provider) IsEmbeddingModel() bool {
+	return p.Embedding || strings.Contains(strings.ToLower(p.ModelName), "embedding")
+}
+
+// GetEmbeddingClient gets the OpenAI compatible embedding client,
+// DomainOrURL can be the base url (https://api.openai.com/v1) or the full embeddings url
+func (p *Provider) GetEmbeddingClient() *embedding.OpenaiEmbeddingClient {
+	log.Infof("GetEmbeddingClient: type: %s, domain: %s, key: %s, model: %s, no_https: %v", p.TypeName, p.DomainOrURL, utils.ShrinkString(p.APIKey, 8), p.ModelName, p.NoHTTPS)
+
+	opts := []aispec.AIConfigOption{
+		aispec.WithTimeout(30),
+		aispec.WithNoHTTPS(p.NoHTTPS),
+		aispec.WithAPIKey(p.APIKey),
+		aispec.WithModel(p.ModelName),
+	}
+	if target := strings.TrimSpace(p.DomainOrURL); target != "" {
+		if utils.IsHttpOrHttpsUrl(target) {
+			opts = append(opts, aispec.WithBaseURL(target))
+		} else {
+			opts = append(opts, aispec.WithDomain(target))
+		}
+	}
+	return embedding.NewOpenaiEmbeddingClient(opts...)
+}
+
 // GetDbProvider gets the associated database AiProvider object
 // If no associated database object exists, try to query or create from database
 func (p *Provider) GetDbProvider() (*schema.AiProvider, error) {
@@ -224,6 +264,7 @@ func (p *Provider) GetDbProvider() (*schema.AiProvider, error) {
 		DomainOrURL: p.DomainOrURL,
 		APIKey:      p.APIKey,
 		NoHTTPS:     p.NoHTTPS,
+		Embedding:   p.Embedding,
 	}
 
 	// Get or create from database
@@ -0,0 +1,361 @@
+package aibalance
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+)
+
+const usageDateLayout = "2006-01-02"
+
+// QuotaConfig is the quota configuration for an API key in the yaml config,
+// an empty Model means the quota applies to all models of the key
+type QuotaConfig struct {
+	Model                string `yaml:"model,omitempty" json:"model,omitempty"`
+	RequestsPerMinute    int64  `yaml:"requests_per_minute,omitempty" json:"requests_per_minute,omitempty"`
+	TokensPerDay         int64  `yaml:"tokens_per_day,omitempty" json:"tokens_per_day,omitempty"`
+	MaxConcurrentStreams int64  `yaml:"max_concurrent_streams,omitempty" json:"max_concurrent_streams,omitempty"`
+}
+
+// QuotaExceededError is returned when a request exceeds one of the quotas of the API key
+type QuotaExceededError struct {
+	APIKey     string
+	Model      string // empty means the key-level quota
+	Limit      string // requests_per_minute / tokens_per_day / max_concurrent_streams
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	scope := "all models"
+	if e.Model != "" {
+		scope = "model " + e.Model
+	}
+	return fmt.Sprintf("quota exceeded: %s of %s, retry after %ds", e.Limit, scope, e.RetryAfterSeconds())
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header, at least 1 second
+func (e *QuotaExceededError) RetryAfterSeconds() int64 {
+	seconds := int64(math.Ceil(e.RetryAfter.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// QuotaTicket is held by an admitted request, Release must be called when the request is finished
+type QuotaTicket struct {
+	once    sync.Once
+	release func()
+}
+
+func (t *QuotaTicket) Release() {
+	if t == nil {
+		return
+	}
+	t.once.Do(t.release)
+}
+
+// QuotaManager enforces per-key and per-model quotas and meters usage,
+// usage is persisted in the aibalance database by day
+type QuotaManager struct {
+	m sync.Mutex
+
+	// static quotas from the yaml config, database quotas with the same key and model take precedence
+	staticQuotas map[string]*schema.AiApiKeyQuota
+	quotas       map[string]*schema.AiApiKeyQuota
+
+	requestWindows map[string][]time.Time
+	streams        map[string]int64
+	// token usage of the day, loaded from the database on first use
+	dailyTokens map[string]int64
+
+	// serialize database writes to avoid creating duplicated usage records
+	persistLock sync.Mutex
+
+	now func() time.Time
+}
+
+func NewQuotaManager() *QuotaManager {
+	return &QuotaManager{
+		staticQuotas:   make(map[string]*schema.AiApiKeyQuota),
+		quotas:         make(map[string]*schema.AiApiKeyQuota),
+		requestWindows: make(map[string][]time.Time),
+		streams:        make(map[string]int64),
+		dailyTokens:    make(map[string]int64),
+		now:            time.Now,
+	}
+}
+
+func quotaScope(apiKey, model string) string {
+	return apiKey + "\x00" + model
+}
+
+func dailyTokensKey(apiKey, model, date string) string {
+	return apiKey + "\x00" + model + "\x00" + date
+}
+
+// SetStaticQuota sets a quota that is not stored in the database, usually from the yaml config
+func (q *QuotaManager) SetStaticQuota(apiKey string, cfg *QuotaConfig) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	quota := &schema.AiApiKeyQuota{
+		APIKey:               apiKey,
+		ModelName:            cfg.Model,
+		RequestsPerMinute:    cfg.RequestsPerMinute,
+		TokensPerDay:         cfg.TokensPerDay,
+		MaxConcurrentStreams: cfg.MaxConcurrentStreams,
+	}
+	scope := quotaScope(apiKey, cfg.Model)
+	q.staticQuotas[scope] = quota
+	if _, ok := q.quotas[scope]; !ok {
+		q.quotas[scope] = quota
+	}
+}
+
+// LoadFromDB reloads quotas from the database
+func (q *QuotaManager) LoadFromDB() error {
+	quotas, err := GetAllAiApiKeyQuotas()
+	if err != nil {
+		return fmt.Errorf("failed to load API key quotas from database: %v", err)
+	}
+
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.quotas = make(map[string]*schema.AiApiKeyQuota, len(q.staticQuotas)+len(quotas))
+	for scope, quota := range q.staticQuotas {
+		q.quotas[scope] = quota
+	}
+	for _, quota := range quotas {
+		q.quotas[quotaScope(quota.APIKey, quota.ModelName)] = quota
+	}
+	log.Infof("Successfully loaded %d API key quotas from database", len(quotas))
+	return nil
+}
+
+// GetQuotas returns the effective quotas of all keys
+func (q *QuotaManager) GetQuotas() []*schema.AiApiKeyQuota {
+	q.m.Lock()
+	defer q.m.Unlock()
+	quotas := make([]*schema.AiApiKeyQuota, 0, len(q.quotas))
+	for _, quota := range q.quotas {
+		quotas = append(quotas, quota)
+	}
+	return quotas
+}
+
+// tokensUsed returns token usage of the day, need to hold the lock
+func (q *QuotaManager) tokensUsed(apiKey, model, date string) int64 {
+	key := dailyTokensKey(apiKey, model, date)
+	if used, ok := q.dailyTokens[key]; ok {
+		return used
+	}
+	used, err := GetAiApiKeyTokenUsage(apiKey, model, date)
+	if err != nil {
+		log.Errorf("failed to get token usage of %s: %v", model, err)
+	}
+	q.dailyTokens[key] = used
+	return used
+}
+
+// Acquire checks the quotas of the key and the model, and admits the request if no quota is exceeded
+func (q *QuotaManager) Acquire(apiKey, model string) (*QuotaTicket, *QuotaExceededError) {
+	q.m.Lock()
+	now := q.now()
+	date := now.Format(usageDateLayout)
+
+	scopes := []string{"", model}
+	for _, m := range scopes {
+		quota, ok := q.quotas[quotaScope(apiKey, m)]
+		if !ok {
+			continue
+		}
+		scope := quotaScope(apiKey, m)
+
+		if quota.TokensPerDay > 0 && q.tokensUsed(apiKey, m, date) >= quota.TokensPerDay {
+			year, month, day := now.Date()
+			tomorrow := time.Date(year, month, day+1, 0, 0, 0, 0, now.Location())
+			q.m.Unlock()
+			return nil, q.reject(apiKey, model, m, "tokens_per_day", tomorrow.Sub(now))
+		}
+
+		if quota.RequestsPerMinute > 0 {
+			window := q.requestWindows[scope]
+			for len(window) > 0 && now.Sub(window[0]) >= time.Minute {
+				window = window[1:]
+			}
+			q.requestWindows[scope] = window
+			if int64(len(window)) >= quota.RequestsPerMinute {
+				retryAfter := window[0].Add(time.Minute).Sub(now)
+				q.m.Unlock()
+				return nil, q.reject(apiKey, model, m, "requests_per_minute", retryAfter)
+			}
+		}
+
+		if quota.MaxConcurrentStreams > 0 && q.streams[scope] >= quota.MaxConcurrentStreams {
+			q.m.Unlock()
+			return nil, q.reject(apiKey, model, m, "max_concurrent_streams", time.Second)
+		}
+	}
+
+	for _, m := range scopes {
+		scope := quotaScope(apiKey, m)
+		if quota, ok := q.quotas[scope]; ok && quota.RequestsPerMinute > 0 {
+			q.requestWindows[scope] = append(q.requestWindows[scope], now)
+		}
+		q.streams[scope]++
+	}
+	q.m.Unlock()
+
+	return &QuotaTicket{release: func() {
+		q.m.Lock()
+		defer q.m.Unlock()
+		for _, m := range scopes {
+			scope := quotaScope(apiKey, m)
+			if q.streams[scope] > 0 {
+				q.streams[scope]--
+			}
+		}
+	}}, nil
+}
+
+func (q *QuotaManager) reject(apiKey, requestModel, quotaModel, limit string, retryAfter time.Duration) *QuotaExceededError {
+	q.persist(&schema.AiApiKeyUsage{
+		APIKey:        apiKey,
+		ModelName:     requestModel,
+		Date:          q.now().Format(usageDateLayout),
+		RejectedCount: 1,
+	})
+	return &QuotaExceededError{
+		APIKey:     apiKey,
+		Model:      quotaModel,
+		Limit:      limit,
+		RetryAfter: retryAfter,
+	}
+}
+
+// RecordUsage meters the tokens of a finished request, estimated means the upstream did not report
+// the usage and the tokens are estimated by EstimateTokens
+func (q *QuotaManager) RecordUsage(apiKey, model string, inputTokens, outputTokens int64, estimated bool, success bool) {
+	date := q.now().Format(usageDateLayout)
+	q.m.Lock()
+	for _, m := range []string{"", model} {
+		key := dailyTokensKey(apiKey, m, date)
+		if _, ok := q.dailyTokens[key]; ok {
+			q.dailyTokens[key] += inputTokens + outputTokens
+		}
+	}
+	q.m.Unlock()
+
+	usage := &schema.AiApiKeyUsage{
+		APIKey:       apiKey,
+		ModelName:    model,
+		Date:         date,
+		RequestCount: 1,
+		InputTokens:  inputTokens,
+		OutputTokens: outputTokens,
+		Estimated:    estimated,
+	}
+	if success {
+		usage.SuccessCount = 1
+	} else {
+		usage.FailureCount = 1
+	}
+	q.persist(usage)
+}
+
+func (q *QuotaManager) persist(usage *schema.AiApiKeyUsage) {
+	q.persistLock.Lock()
+	defer q.persistLock.Unlock()
+	if err := AddAiApiKeyUsage(usage); err != nil {
+		log.Errorf("failed to save API key usage: %v", err)
+	}
+}
+
+// upstreamUsage keeps the token usage reported by the upstream, it may be set from the stream goroutines
+type upstreamUsage struct {
+	m     sync.Mutex
+	usage *aispec.ChatUsage
+}
+
+func (u *upstreamUsage) Set(usage *aispec.ChatUsage) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.usage = usage
+}
+
+// Tokens returns the reported usage, or the estimated tokens if the upstream did not report usage
+func (u *upstreamUsage) Tokens(estimatedInput, estimatedOutput int64) (inputTokens, outputTokens int64, estimated bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if u.usage == nil {
+		return estimatedInput, estimatedOutput, true
+	}
+	inputTokens, outputTokens = int64(u.usage.PromptTokens), int64(u.usage.CompletionTokens)
+	if inputTokens+outputTokens == 0 {
+		// some providers only report total_tokens
+		inputTokens = int64(u.usage.TotalTokens)
+	}
+	return inputTokens, outputTokens, false
+}
+
+// EstimateTokens estimates the token count of text: CJK characters count as one token each,
+// other text counts as one token per 4 bytes
+func EstimateTokens(text string) int64 {
+	cjk, others := countTokenUnits(text)
+	return cjk + (others+3)/4
+}
+
+func countTokenUnits(text string) (cjk int64, others int64) {
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+			unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r) {
+			cjk++
+			continue
+		}
+		others += int64(utf8.RuneLen(r))
+	}
+	return cjk, others
+}
+
+// tokenMeter estimates the tokens written to it, it is used to meter streamed responses
+type tokenMeter struct {
+	m      sync.Mutex
+	cjk    int64
+	others int64
+	// incomplete utf8 sequence between writes
+	pending []byte
+}
+
+func (t *tokenMeter) Write(p []byte) (int, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	data := append(t.pending, p...)
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	cjk, others := countTokenUnits(string(data[:cut]))
+	t.cjk += cjk
+	t.others += others
+	t.pending = append([]byte{}, data[cut:]...)
+	return len(p), nil
+}
+
+func (t *tokenMeter) Tokens() int64 {
+	t.m.Lock()
+	defer t.m.Unlock()
+	others := t.others + int64(len(t.pending))
+	return t.cjk + (others+3)/4
+}
@@ -0,0 +1,197 @@
+package aibalance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestQuotaManager_RequestsPerMinuteAndStreams(t *testing.T) {
+	apiKey := "quota-" + utils.RandStringBytes(10)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.Local)
+	manager := NewQuotaManager()
+	manager.now = func() time.Time { return now }
+	manager.SetStaticQuota(apiKey, &QuotaConfig{RequestsPerMinute: 2})
+	manager.SetStaticQuota(apiKey, &QuotaConfig{Model: "model-a", MaxConcurrentStreams: 1})
+
+	first, err := manager.Acquire(apiKey, "model-a")
+	require.Nil(t, err)
+
+	// 同一模型的并发请求数超出
+	_, err = manager.Acquire(apiKey, "model-a")
+	require.NotNil(t, err)
+	assert.Equal(t, "max_concurrent_streams", err.Limit)
+	assert.Equal(t, "model-a", err.Model)
+
+	// 其他模型不受模型级配额影响，但是受到 Key 级别的每分钟请求数限制
+	second, err := manager.Acquire(apiKey, "model-b")
+	require.Nil(t, err)
+	second.Release()
+
+	now = now.Add(20 * time.Second)
+	first.Release()
+	first.Release()
+	_, err = manager.Acquire(apiKey, "model-a")
+	require.NotNil(t, err)
+	assert.Equal(t, "requests_per_minute", err.Limit)
+	assert.Equal(t, "", err.Model)
+	assert.Equal(t, int64(40), err.RetryAfterSeconds())
+
+	now = now.Add(41 * time.Second)
+	ticket, err := manager.Acquire(apiKey, "model-a")
+	require.Nil(t, err)
+	ticket.Release()
+
+	usages, dbErr := GetAiApiKeyUsages(apiKey, "", "")
+	require.NoError(t, dbErr)
+	var rejected int64
+	for _, usage := range usages {
+		rejected += usage.RejectedCount
+	}
+	assert.Equal(t, int64(2), rejected)
+}
+
+func TestQuotaManager_TokensPerDay(t *testing.T) {
+	apiKey := "quota-" + utils.RandStringBytes(10)
+	now := time.Date(2025, 1, 1, 23, 0, 0, 0, time.Local)
+	manager := NewQuotaManager()
+	manager.now = func() time.Time { return now }
+	_, err := SaveAiApiKeyQuota(&schema.AiApiKeyQuota{APIKey: apiKey, ModelName: "model-a", TokensPerDay: 100})
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadFromDB())
+
+	ticket, quotaErr := manager.Acquire(apiKey, "model-a")
+	require.Nil(t, quotaErr)
+	manager.RecordUsage(apiKey, "model-a", 40, 70, false, true)
+	ticket.Release()
+
+	_, quotaErr = manager.Acquire(apiKey, "model-a")
+	require.NotNil(t, quotaErr)
+	assert.Equal(t, "tokens_per_day", quotaErr.Limit)
+	assert.Equal(t, int64(3600), quotaErr.RetryAfterSeconds())
+
+	// 用量持久化在数据库中，新的 QuotaManager 同样生效
+	reloaded := NewQuotaManager()
+	reloaded.now = manager.now
+	require.NoError(t, reloaded.LoadFromDB())
+	_, quotaErr = reloaded.Acquire(apiKey, "model-a")
+	require.NotNil(t, quotaErr)
+
+	// 第二天重新计算
+	now = now.Add(2 * time.Hour)
+	ticket, quotaErr = manager.Acquire(apiKey, "model-a")
+	require.Nil(t, quotaErr)
+	ticket.Release()
+
+	cfg := NewServerConfig()
+	report, err := cfg.buildUsageReport(url.Values{"api_key": {apiKey}, "from": {"2025-01-01"}, "to": {"2025-01-02"}})
+	require.NoError(t, err)
+	require.Len(t, report.Summaries, 1)
+	assert.Equal(t, int64(1), report.Summaries[0].RequestCount)
+	assert.Equal(t, int64(2), report.Summaries[0].RejectedCount)
+	assert.Equal(t, int64(110), report.Summaries[0].TotalTokens)
+}
+
+func TestTokenMeter(t *testing.T) {
+	text := "hello world, 你好世界"
+	meter := new(tokenMeter)
+	// 逐字节写入，多字节字符被拆分到不同的写入中
+	for _, b := range []byte(text) {
+		_, _ = meter.Write([]byte{b})
+	}
+	assert.Equal(t, EstimateTokens(text), meter.Tokens())
+	assert.Equal(t, int64(4+4), EstimateTokens(text))
+}
+
+func TestServeChatCompletions_QuotaExceeded(t *testing.T) {
+	apiKey := "quota-" + utils.RandStringBytes(10)
+	cfg := NewServerConfig()
+	cfg.Keys.keys[apiKey] = &Key{Key: apiKey, AllowedModels: map[string]bool{"test-model": true}}
+	cfg.KeyAllowedModels.allowedModels[apiKey] = map[string]bool{"test-model": true}
+	cfg.Quotas.SetStaticQuota(apiKey, &QuotaConfig{MaxConcurrentStreams: 1})
+
+	ticket, quotaErr := cfg.Quotas.Acquire(apiKey, "test-model")
+	require.Nil(t, quotaErr)
+	defer ticket.Release()
+
+	client, server := net.Pipe()
+	defer client.Close()
+	go cfg.Serve(server)
+
+	body := `{"model":"test-model","messages":[{"role":"user","content":"test"}]}`
+	go client.Write([]byte("POST /v1/chat/completions HTTP/1.1\r\n" +
+		"Host: localhost\r\n" +
+		"Authorization: Bearer " + apiKey + "\r\n" +
+		"Content-Type: application/json\r\n" +
+		fmt.Sprintf("Content-Length: %d\r\n", len(body)) +
+		"\r\n" + body))
+
+	_ = client.SetReadDeadline(time.Now().Add(10 * time.Second))
+	rsp, _ := io.ReadAll(client)
+	assert.True(t, strings.HasPrefix(string(rsp), "HTTP/1.1 429 Too Many Requests"), string(rsp))
+	assert.Contains(t, string(rsp), "Retry-After: 1\r\n")
+	assert.Contains(t, string(rsp), "rate_limit_exceeded")
+}
+
+func TestServeChatCompletions_UpstreamUsage(t *testing.T) {
+	newUpstream := func(reportUsage bool) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, text := range []string{"Hello", ", ", "world"} {
+				fmt.Fprintf(w, "data: {\"id\":\"1\",\"object\":\"chat.completion.chunk\",\"model\":\"gpt-4o\",\"choices\":[{\"index\":0,\"delta\":{\"content\":%q}}]}\n\n", text)
+				w.(http.Flusher).Flush()
+			}
+			// usage is only returned when stream_options.include_usage is requested
+			if options, ok := req["stream_options"].(map[string]any); reportUsage && ok && options["include_usage"] == true {
+				fmt.Fprint(w, "data: {\"id\":\"1\",\"object\":\"chat.completion.chunk\",\"model\":\"gpt-4o\",\"choices\":[],\"usage\":{\"prompt_tokens\":1234,\"completion_tokens\":567,\"total_tokens\":1801}}\n\n")
+			}
+			fmt.Fprint(w, "data: [DONE]\n\n")
+		}))
+	}
+	usageOf := func(apiKey string) []*schema.AiApiKeyUsage {
+		usages, err := GetAiApiKeyUsages(apiKey, "", "")
+		require.NoError(t, err)
+		return usages
+	}
+	body := `{"model":"usage-wrapper","messages":[{"role":"user","content":"hi"}]}`
+
+	reported := newUpstream(true)
+	defer reported.Close()
+	cfg, apiKey := newTestServerConfigWithProviders("usage-wrapper",
+		&Provider{ModelName: "gpt-4o", TypeName: "openai", DomainOrURL: reported.URL + "/v1/chat/completions", APIKey: "upstream-key"},
+	)
+	rsp := doTestRequest(t, cfg, "/v1/chat/completions", apiKey, body)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	usages := usageOf(apiKey)
+	require.Len(t, usages, 1)
+	assert.False(t, usages[0].Estimated)
+	assert.Equal(t, int64(1234), usages[0].InputTokens)
+	assert.Equal(t, int64(567), usages[0].OutputTokens)
+
+	// 上游没有返回 usage 时使用估算值，并标记为估算
+	estimated := newUpstream(false)
+	defer estimated.Close()
+	cfg, apiKey = newTestServerConfigWithProviders("usage-wrapper",
+		&Provider{ModelName: "gpt-4o", TypeName: "openai", DomainOrURL: estimated.URL + "/v1/chat/completions", APIKey: "upstream-key"},
+	)
+	rsp = doTestRequest(t, cfg, "/v1/chat/completions", apiKey, body)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	usages = usageOf(apiKey)
+	require.Len(t, usages, 1)
+	assert.True(t, usages[0].Estimated)
+	assert.Equal(t, EstimateTokens("Hello, world"), usages[0].OutputTokens)
+}
@@ -0,0 +1,563 @@
+package aibalance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// ResponsesRequest is the request of OpenAI compatible /v1/responses, it is converted to a chat completions request
+type ResponsesRequest struct {
+	Model              string           `json:"model"`
+	Input              json.RawMessage  `json:"input"`
+	Instructions       string           `json:"instructions,omitempty"`
+	Stream             bool             `json:"stream,omitempty"`
+	Tools              []*ResponsesTool `json:"tools,omitempty"`
+	ToolChoice         json.RawMessage  `json:"tool_choice,omitempty"`
+	Temperature        *float64         `json:"temperature,omitempty"`
+	TopP               *float64         `json:"top_p,omitempty"`
+	MaxOutputTokens    *int             `json:"max_output_tokens,omitempty"`
+	PreviousResponseID string           `json:"previous_response_id,omitempty"`
+}
+
+// ResponsesTool is a tool of the responses request, only function tools are supported
+type ResponsesTool struct {
+	Type        string `json:"type"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Parameters  any    `json:"parameters,omitempty"`
+	Strict      *bool  `json:"strict,omitempty"`
+}
+
+// responsesInputItem is an input item of the responses request: a message, a function call or a function call output
+type responsesInputItem struct {
+	Type      string          `json:"type"`
+	Role      string          `json:"role"`
+	Content   json.RawMessage `json:"content"`
+	CallID    string          `json:"call_id"`
+	Name      string          `json:"name"`
+	Arguments string          `json:"arguments"`
+	Output    json.RawMessage `json:"output"`
+}
+
+// responsesContentPart is a content part of the responses input message
+type responsesContentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	ImageURL string `json:"image_url"`
+}
+
+func parseResponsesContentParts(raw json.RawMessage) (string, []*responsesContentPart, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil, nil
+	}
+	if raw[0] == '"' {
+		var text string
+		err := json.Unmarshal(raw, &text)
+		return text, nil, err
+	}
+	var parts []*responsesContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return "", nil, err
+	}
+	return "", parts, nil
+}
+
+// responsesMessageContent converts the content of the responses input message to the chat completions content
+func responsesMessageContent(raw json.RawMessage) (any, error) {
+	text, parts, err := parseResponsesContentParts(raw)
+	if err != nil {
+		return nil, utils.Errorf("invalid message content: %v", err)
+	}
+	if parts == nil {
+		return text, nil
+	}
+	var contents []map[string]any
+	for _, part := range parts {
+		switch part.Type {
+		case "input_text", "output_text", "text":
+			contents = append(contents, map[string]any{"type": "text", "text": part.Text})
+		case "input_image":
+			contents = append(contents, map[string]any{"type": "image_url", "image_url": map[string]any{"url": part.ImageURL}})
+		default:
+			return nil, utils.Errorf("unsupported content type: %s", part.Type)
+		}
+	}
+	return contents, nil
+}
+
+// ChatMessages converts the instructions and input of the responses request to chat completions messages
+func (r *ResponsesRequest) ChatMessages() ([]map[string]any, error) {
+	var messages []map[string]any
+	if r.Instructions != "" {
+		messages = append(messages, map[string]any{"role": "system", "content": r.Instructions})
+	}
+
+	input := bytes.TrimSpace(r.Input)
+	if len(input) == 0 || string(input) == "null" {
+		return nil, utils.Error("input is required")
+	}
+	if input[0] == '"' {
+		var text string
+		if err := json.Unmarshal(input, &text); err != nil {
+			return nil, utils.Errorf("invalid input: %v", err)
+		}
+		return append(messages, map[string]any{"role": "user", "content": text}), nil
+	}
+
+	var items []*responsesInputItem
+	if err := json.Unmarshal(input, &items); err != nil {
+		return nil, utils.Errorf("invalid input: %v", err)
+	}
+	for _, item := range items {
+		switch item.Type {
+		case "", "message":
+			role := item.Role
+			if role == "developer" {
+				role = "system"
+			}
+			if role == "" {
+				return nil, utils.Error("input message role is required")
+			}
+			content, err := responsesMessageContent(item.Content)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, map[string]any{"role": role, "content": content})
+		case "function_call":
+			call := map[string]any{
+				"id":       item.CallID,
+				"type":     "function",
+				"function": map[string]any{"name": item.Name, "arguments": item.Arguments},
+			}
+			// parallel function calls belong to the same assistant message
+			if len(messages) > 0 {
+				if last := messages[len(messages)-1]; last["role"] == "assistant" && last["tool_calls"] != nil {
+					last["tool_calls"] = append(last["tool_calls"].([]any), call)
+					continue
+				}
+			}
+			messages = append(messages, map[string]any{"role": "assistant", "content": nil, "tool_calls": []any{call}})
+		case "function_call_output":
+			text, parts, err := parseResponsesContentParts(item.Output)
+			if err != nil {
+				return nil, utils.Errorf("invalid function call output: %v", err)
+			}
+			for _, part := range parts {
+				text += part.Text
+			}
+			messages = append(messages, map[string]any{"role": "tool", "tool_call_id": item.CallID, "content": text})
+		default:
+			return nil, utils.Errorf("unsupported input item type: %s", item.Type)
+		}
+	}
+	if len(messages) == 0 {
+		return nil, utils.Error("input is required")
+	}
+	return messages, nil
+}
+
+// ChatCompletionsBody builds the chat completions request body of the responses request,
+// the chat completions request is always streamed and converted back to the responses format
+func (r *ResponsesRequest) ChatCompletionsBody() ([]byte, error) {
+	if r.PreviousResponseID != "" {
+		return nil, utils.Error("previous_response_id is not supported, responses are not stored")
+	}
+	messages, err := r.ChatMessages()
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]any{
+		"model":    r.Model,
+		"messages": messages,
+		"stream":   true,
+	}
+	if len(r.Tools) > 0 {
+		var tools []map[string]any
+		for _, tool := range r.Tools {
+			if tool.Type != "function" {
+				return nil, utils.Errorf("unsupported tool type: %s", tool.Type)
+			}
+			function := map[string]any{"name": tool.Name}
+			if tool.Description != "" {
+				function["description"] = tool.Description
+			}
+			if tool.Parameters != nil {
+				function["parameters"] = tool.Parameters
+			}
+			if tool.Strict != nil {
+				function["strict"] = *tool.Strict
+			}
+			tools = append(tools, map[string]any{"type": "function", "function": function})
+		}
+		body["tools"] = tools
+	}
+	if choice := bytes.TrimSpace(r.ToolChoice); len(choice) > 0 && string(choice) != "null" {
+		var value any
+		if err := json.Unmarshal(choice, &value); err != nil {
+			return nil, utils.Errorf("invalid tool_choice: %v", err)
+		}
+		// {"type": "function", "name": "f"} => {"type": "function", "function": {"name": "f"}}
+		if m, ok := value.(map[string]any); ok && utils.MapGetString(m, "type") == "function" {
+			value = map[string]any{"type": "function", "function": map[string]any{"name": utils.MapGetString(m, "name")}}
+		}
+		body["tool_choice"] = value
+	}
+	if r.Temperature != nil {
+		body["temperature"] = *r.Temperature
+	}
+	if r.TopP != nil {
+		body["top_p"] = *r.TopP
+	}
+	if r.MaxOutputTokens != nil {
+		body["max_tokens"] = *r.MaxOutputTokens
+	}
+	return json.Marshal(body)
+}
+
+type responsesChatToolCall struct {
+	Index    *int   `json:"index"`
+	ID       string `json:"id"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+type responsesChatDetail struct {
+	Content   any                      `json:"content"`
+	ToolCalls []*responsesChatToolCall `json:"tool_calls"`
+}
+
+// responsesChatChunk is a chat completion response or stream chunk
+type responsesChatChunk struct {
+	Choices []struct {
+		Delta   *responsesChatDetail `json:"delta"`
+		Message *responsesChatDetail `json:"message"`
+	} `json:"choices"`
+	Usage *aispec.ChatUsage `json:"usage"`
+	Error any               `json:"error"`
+}
+
+type responsesFunctionCall struct {
+	id        string
+	callID    string
+	name      string
+	arguments bytes.Buffer
+}
+
+// responsesBuilder converts chat completions responses (json or SSE chunks) to the responses format,
+// the streaming events are emitted by emit, emit is nil when the response is not streamed
+type responsesBuilder struct {
+	id        string
+	model     string
+	createdAt int64
+	emit      func(event string, data map[string]any)
+	sequence  int
+
+	messageID   string
+	textStarted bool
+	text        bytes.Buffer
+	calls       []*responsesFunctionCall
+	callIndexes map[int]*responsesFunctionCall
+	usage       *aispec.ChatUsage
+	err         string
+}
+
+func newResponsesBuilder(model string) *responsesBuilder {
+	return &responsesBuilder{
+		id:          "resp_" + utils.RandStringBytes(24),
+		model:       model,
+		createdAt:   time.Now().Unix(),
+		callIndexes: make(map[int]*responsesFunctionCall),
+	}
+}
+
+func (b *responsesBuilder) send(event string, data map[string]any) {
+	if b.emit == nil {
+		return
+	}
+	data["type"] = event
+	data["sequence_number"] = b.sequence
+	b.sequence++
+	b.emit(event, data)
+}
+
+func (b *responsesBuilder) messageItem(status string) map[string]any {
+	content := []any{}
+	if status == "completed" {
+		content = append(content, map[string]any{"type": "output_text", "text": b.text.String(), "annotations": []any{}})
+	}
+	return map[string]any{"type": "message", "id": b.messageID, "status": status, "role": "assistant", "content": content}
+}
+
+func (b *responsesBuilder) callItem(call *responsesFunctionCall, status string) map[string]any {
+	arguments := ""
+	if status == "completed" {
+		arguments = call.arguments.String()
+	}
+	return map[string]any{"type": "function_call", "id": call.id, "call_id": call.callID, "name": call.name, "arguments": arguments, "status": status}
+}
+
+func (b *responsesBuilder) response(status string) map[string]any {
+	output := []any{}
+	if status == "completed" {
+		if b.textStarted {
+			output = append(output, b.messageItem(status))
+		}
+		for _, call := range b.calls {
+			output = append(output, b.callItem(call, status))
+		}
+	}
+	rsp := map[string]any{
+		"id":         b.id,
+		"object":     "response",
+		"created_at": b.createdAt,
+		"status":     status,
+		"model":      b.model,
+		"output":     output,
+	}
+	if status == "failed" {
+		rsp["error"] = map[string]any{"code": "server_error", "message": b.err}
+	}
+	if b.usage != nil {
+		rsp["usage"] = map[string]any{
+			"input_tokens":  b.usage.PromptTokens,
+			"output_tokens": b.usage.CompletionTokens,
+			"total_tokens":  b.usage.TotalTokens,
+		}
+	}
+	return rsp
+}
+
+func (b *responsesBuilder) start() {
+	b.send("response.created", map[string]any{"response": b.response("in_progress")})
+	b.send("response.in_progress", map[string]any{"response": b.response("in_progress")})
+}
+
+func (b *responsesBuilder) appendText(text string) {
+	if text == "" {
+		return
+	}
+	if !b.textStarted {
+		b.textStarted = true
+		b.messageID = "msg_" + utils.RandStringBytes(24)
+		b.send("response.output_item.added", map[string]any{"output_index": 0, "item": b.messageItem("in_progress")})
+		b.send("response.content_part.added", map[string]any{
+			"item_id": b.messageID, "output_index": 0, "content_index": 0,
+			"part": map[string]any{"type": "output_text", "text": "", "annotations": []any{}},
+		})
+	}
+	b.text.WriteString(text)
+	b.send("response.output_text.delta", map[string]any{"item_id": b.messageID, "output_index": 0, "content_index": 0, "delta": text})
+}
+
+func (b *responsesBuilder) appendToolCall(position int, call *responsesChatToolCall) {
+	index := position
+	if call.Index != nil {
+		index = *call.Index
+	}
+	item, ok := b.callIndexes[index]
+	if !ok || (call.Index == nil && call.ID != "" && call.ID != item.callID) {
+		item = &responsesFunctionCall{id: "fc_" + utils.RandStringBytes(24), callID: call.ID}
+		b.callIndexes[index] = item
+		b.calls = append(b.calls, item)
+	}
+	if item.callID == "" {
+		item.callID = call.ID
+	}
+	item.name += call.Function.Name
+	item.arguments.WriteString(call.Function.Arguments)
+}
+
+// feed handles a chat completion response or stream chunk
+func (b *responsesBuilder) feed(raw []byte) {
+	var chunk responsesChatChunk
+	if err := json.Unmarshal(raw, &chunk); err != nil {
+		return
+	}
+	if chunk.Error != nil {
+		b.err = utils.InterfaceToString(chunk.Error)
+		if m, ok := chunk.Error.(map[string]any); ok && utils.MapGetString(m, "message") != "" {
+			b.err = utils.MapGetString(m, "message")
+		}
+		return
+	}
+	for _, choice := range chunk.Choices {
+		for _, detail := range []*responsesChatDetail{choice.Delta, choice.Message} {
+			if detail == nil {
+				continue
+			}
+			if content, ok := detail.Content.(string); ok {
+				b.appendText(content)
+			}
+			for i, call := range detail.ToolCalls {
+				if call != nil {
+					b.appendToolCall(i, call)
+				}
+			}
+		}
+	}
+	if chunk.Usage != nil && chunk.Usage.TotalTokens > 0 {
+		b.usage = chunk.Usage
+	}
+}
+
+func (b *responsesBuilder) failed() bool {
+	return b.err != "" && !b.textStarted && len(b.calls) == 0
+}
+
+// finish emits the done events of the output items and returns the final response,
+// the usage is estimated when the upstream does not report it
+func (b *responsesBuilder) finish(inputTokens int64) map[string]any {
+	if b.failed() {
+		rsp := b.response("failed")
+		b.send("response.failed", map[string]any{"response": rsp})
+		return rsp
+	}
+
+	outputIndex := 0
+	if b.textStarted {
+		part := map[string]any{"type": "output_text", "text": b.text.String(), "annotations": []any{}}
+		b.send("response.output_text.done", map[string]any{"item_id": b.messageID, "output_index": 0, "content_index": 0, "text": b.text.String()})
+		b.send("response.content_part.done", map[string]any{"item_id": b.messageID, "output_index": 0, "content_index": 0, "part": part})
+		b.send("response.output_item.done", map[string]any{"output_index": 0, "item": b.messageItem("completed")})
+		outputIndex++
+	}
+	outputTokens := EstimateTokens(b.text.String())
+	for _, call := range b.calls {
+		b.send("response.output_item.added", map[string]any{"output_index": outputIndex, "item": b.callItem(call, "in_progress")})
+		b.send("response.function_call_arguments.delta", map[string]any{"item_id": call.id, "output_index": outputIndex, "delta": call.arguments.String()})
+		b.send("response.function_call_arguments.done", map[string]any{"item_id": call.id, "output_index": outputIndex, "arguments": call.arguments.String()})
+		b.send("response.output_item.done", map[string]any{"output_index": outputIndex, "item": b.callItem(call, "completed")})
+		outputTokens += EstimateTokens(call.name + call.arguments.String())
+		outputIndex++
+	}
+	if b.usage == nil {
+		b.usage = &aispec.ChatUsage{
+			PromptTokens:     int(inputTokens),
+			CompletionTokens: int(outputTokens),
+			TotalTokens:      int(inputTokens + outputTokens),
+		}
+	}
+	rsp := b.response("completed")
+	b.send("response.completed", map[string]any{"response": rsp})
+	return rsp
+}
+
+// serveResponses handles OpenAI compatible /v1/responses requests, the request is converted to a chat completions
+// request and served by serveChatCompletions, so the key, quota and provider selection are the same as
+// /v1/chat/completions, then the chat completions response is converted back to the responses format,
+// responses are not stored and previous_response_id is not supported
+func (c *ServerConfig) serveResponses(conn net.Conn, rawPacket []byte) {
+	c.logInfo("Starting to handle new responses request")
+	body := lowhttp.GetHTTPPacketBody(rawPacket)
+	if len(body) == 0 {
+		c.logError("Request body is empty")
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", "request body is empty")
+		return
+	}
+	var req ResponsesRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		c.logError("Failed to parse responses request body: %v", err)
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", "invalid request body: "+err.Error())
+		return
+	}
+	chatBody, err := req.ChatCompletionsBody()
+	if err != nil {
+		c.logError("Invalid responses request: %v", err)
+		c.writeOpenAIErrorResponse(conn, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	chatConn, pipe := net.Pipe()
+	defer pipe.Close()
+	go func() {
+		defer chatConn.Close()
+		c.serveChatCompletions(chatConn, lowhttp.ReplaceHTTPPacketBody(rawPacket, chatBody, false))
+	}()
+	chatRsp, err := http.ReadResponse(bufio.NewReader(pipe), nil)
+	if err != nil {
+		c.logError("Failed to read chat completions response: %v", err)
+		c.writeOpenAIErrorResponse(conn, http.StatusBadGateway, "api_error", "read chat completions response failed: "+err.Error())
+		return
+	}
+	defer chatRsp.Body.Close()
+	if chatRsp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(io.LimitReader(chatRsp.Body, 4096))
+		var payload map[string]any
+		if json.Unmarshal(errBody, &payload) == nil && payload["error"] != nil {
+			c.writeJSONResponse(conn, chatRsp.StatusCode, payload)
+			return
+		}
+		message := chatRsp.Header.Get("X-Reason")
+		if message == "" {
+			message = chatRsp.Status
+		}
+		c.writeOpenAIErrorResponse(conn, chatRsp.StatusCode, "api_error", message)
+		return
+	}
+
+	builder := newResponsesBuilder(req.Model)
+	var cw io.WriteCloser
+	if req.Stream {
+		header := "HTTP/1.1 200 OK\r\n" +
+			"Content-Type: text/event-stream\r\n" +
+			"Cache-Control: no-cache\r\n" +
+			"Transfer-Encoding: chunked\r\n" +
+			"\r\n"
+		if _, err := conn.Write([]byte(header)); err != nil {
+			c.logError("Failed to send response header: %v", err)
+		}
+		cw = httputil.NewChunkedWriter(conn)
+		builder.emit = func(event string, data map[string]any) {
+			raw, err := json.Marshal(data)
+			if err != nil {
+				c.logError("Failed to marshal responses event %s: %v", event, err)
+				return
+			}
+			fmt.Fprintf(cw, "event: %s\ndata: %s\n\n", event, raw)
+			utils.FlushWriter(conn)
+		}
+	}
+
+	builder.start()
+	reader := bufio.NewReader(chatRsp.Body)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		payload := bytes.TrimSpace(line)
+		payload = bytes.TrimSpace(bytes.TrimPrefix(payload, []byte("data:")))
+		if bytes.HasPrefix(payload, []byte("{")) {
+			builder.feed(payload)
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	result := builder.finish(EstimateTokens(req.Instructions + string(req.Input)))
+	c.logInfo("Responses request of model %s completed, status: %v", req.Model, result["status"])
+
+	if req.Stream {
+		cw.Close()
+		conn.Write([]byte("\r\n"))
+		utils.FlushWriter(conn)
+		return
+	}
+	if builder.failed() {
+		c.writeOpenAIErrorResponse(conn, http.StatusBadGateway, "api_error", strings.TrimSpace(builder.err))
+		return
+	}
+	c.writeJSONResponse(conn, http.StatusOK, result)
+}
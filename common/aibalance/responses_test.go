@@ -0,0 +1,153 @@
+package aibalance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponsesRequestChatCompletionsBody(t *testing.T) {
+	var req ResponsesRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"model": "m",
+		"instructions": "be brief",
+		"input": [
+			{"role": "developer", "content": "use tools"},
+			{"type": "message", "role": "user", "content": [{"type": "input_text", "text": "weather?"}, {"type": "input_image", "image_url": "data:image/png;base64,AAAA"}]},
+			{"type": "function_call", "call_id": "call_1", "name": "get_weather", "arguments": "{\"city\":\"Beijing\"}"},
+			{"type": "function_call", "call_id": "call_2", "name": "get_weather", "arguments": "{\"city\":\"Shanghai\"}"},
+			{"type": "function_call_output", "call_id": "call_1", "output": "sunny"}
+		],
+		"tools": [{"type": "function", "name": "get_weather", "parameters": {"type": "object"}}],
+		"tool_choice": {"type": "function", "name": "get_weather"},
+		"max_output_tokens": 128
+	}`), &req))
+	raw, err := req.ChatCompletionsBody()
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(raw, &body))
+	assert.Equal(t, true, body["stream"])
+	assert.Equal(t, float64(128), body["max_tokens"])
+	assert.Equal(t, map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}, body["tool_choice"])
+	tools := body["tools"].([]any)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_weather", tools[0].(map[string]any)["function"].(map[string]any)["name"])
+
+	messages := body["messages"].([]any)
+	require.Len(t, messages, 5)
+	assert.Equal(t, map[string]any{"role": "system", "content": "be brief"}, messages[0])
+	assert.Equal(t, "system", messages[1].(map[string]any)["role"])
+	content := messages[2].(map[string]any)["content"].([]any)
+	assert.Equal(t, "image_url", content[1].(map[string]any)["type"])
+	// parallel function calls are merged into one assistant message
+	assert.Len(t, messages[3].(map[string]any)["tool_calls"], 2)
+	assert.Equal(t, map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "sunny"}, messages[4])
+	assert.True(t, requiresToolCallPassthrough(raw))
+
+	req = ResponsesRequest{Model: "m", Input: json.RawMessage(`"hello"`), PreviousResponseID: "resp_1"}
+	_, err = req.ChatCompletionsBody()
+	assert.Error(t, err)
+	req = ResponsesRequest{Model: "m", Input: json.RawMessage(`"hello"`), Tools: []*ResponsesTool{{Type: "web_search"}}}
+	_, err = req.ChatCompletionsBody()
+	assert.Error(t, err)
+	req = ResponsesRequest{Model: "m"}
+	_, err = req.ChatCompletionsBody()
+	assert.Error(t, err)
+}
+
+func TestServeResponses_ToolCall(t *testing.T) {
+	var upstreamRequest map[string]any
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&upstreamRequest)
+		w.Header().Set("Content-Type", "text/event-stream")
+		chunks := []string{
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"role":"assistant","tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_weather","arguments":""}}]}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"city\":"}}]}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","model":"qwen-max","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"Beijing\"}"}}]},"finish_reason":"tool_calls"}]}`,
+		}
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer upstream.Close()
+
+	cfg, apiKey := newTestServerConfigWithProviders("tool-wrapper",
+		&Provider{ModelName: "qwen-max", TypeName: "tongyi", DomainOrURL: upstream.URL + "/compatible-mode/v1/chat/completions", APIKey: "upstream-key"},
+	)
+	body := `{"model":"tool-wrapper","input":"weather of Beijing?","tools":[{"type":"function","name":"get_weather","parameters":{"type":"object"}}]}`
+
+	rsp := doTestRequest(t, cfg, "/v1/responses", apiKey, body)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	var result map[string]any
+	require.NoError(t, json.NewDecoder(rsp.Body).Decode(&result))
+	assert.Equal(t, "qwen-max", upstreamRequest["model"])
+	assert.Equal(t, "response", result["object"])
+	assert.Equal(t, "completed", result["status"])
+	assert.Equal(t, "tool-wrapper", result["model"])
+	output := result["output"].([]any)
+	require.Len(t, output, 1)
+	call := output[0].(map[string]any)
+	assert.Equal(t, "function_call", call["type"])
+	assert.Equal(t, "call_1", call["call_id"])
+	assert.Equal(t, "get_weather", call["name"])
+	assert.Equal(t, `{"city":"Beijing"}`, call["arguments"])
+
+	rsp = doTestRequest(t, cfg, "/v1/responses", apiKey, strings.Replace(body, `"input"`, `"stream":true,"input"`, 1))
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, "text/event-stream", rsp.Header.Get("Content-Type"))
+	raw, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	var events []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		if event, ok := strings.CutPrefix(line, "event: "); ok {
+			events = append(events, event)
+		}
+	}
+	assert.Equal(t, []string{
+		"response.created", "response.in_progress",
+		"response.output_item.added", "response.function_call_arguments.delta", "response.function_call_arguments.done", "response.output_item.done",
+		"response.completed",
+	}, events)
+	assert.Contains(t, string(raw), `"arguments":"{\"city\":\"Beijing\"}"`)
+}
+
+func TestServeResponses_Text(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, text := range []string{"Hello", ", ", "world"} {
+			fmt.Fprintf(w, "data: {\"id\":\"1\",\"object\":\"chat.completion.chunk\",\"model\":\"gpt-4o\",\"choices\":[{\"index\":0,\"delta\":{\"content\":%q}}]}\n\n", text)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer upstream.Close()
+
+	cfg, apiKey := newTestServerConfigWithProviders("text-wrapper",
+		&Provider{ModelName: "gpt-4o", TypeName: "openai", DomainOrURL: upstream.URL + "/v1/chat/completions", APIKey: "upstream-key"},
+	)
+	rsp := doTestRequest(t, cfg, "/v1/responses", apiKey, `{"model":"text-wrapper","instructions":"be brief","input":[{"role":"user","content":"hi"}]}`)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	var result map[string]any
+	require.NoError(t, json.NewDecoder(rsp.Body).Decode(&result))
+	output := result["output"].([]any)
+	require.Len(t, output, 1)
+	message := output[0].(map[string]any)
+	assert.Equal(t, "message", message["type"])
+	assert.Equal(t, "Hello, world", message["content"].([]any)[0].(map[string]any)["text"])
+	assert.NotZero(t, result["usage"].(map[string]any)["output_tokens"])
+
+	rsp = doTestRequest(t, cfg, "/v1/responses", "invalid-key", `{"model":"text-wrapper","input":"hi"}`)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	rsp = doTestRequest(t, cfg, "/v1/responses", apiKey, `{"model":"text-wrapper","input":"hi","previous_response_id":"resp_1"}`)
+	assert.Equal(t, http.StatusBadRequest, rsp.StatusCode)
+}
@@ -312,6 +312,7 @@ type ServerConfig struct {
 	Logging          LogLevel
 	AdminPassword    string          // 添加管理员密码配置
 	SessionManager   *SessionManager // 会话管理器
+	Quotas           *QuotaManager   // API Key 配额与用量统计
 	forwardRule      *omap.OrderedMap[string, *aiforwarder.Rule]
 }
 
@@ -330,6 +331,7 @@ func NewServerConfig() *ServerConfig {
 		},
 		AdminPassword:  "admin", // 默认密码
 		SessionManager: NewSessionManager(),
+		Quotas:         NewQuotaManager(),
 		forwardRule:    omap.NewOrderedMap[string, *aiforwarder.Rule](make(map[string]*aiforwarder.Rule)),
 	}
 }
@@ -501,6 +503,15 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 		return
 	}
 
+	ticket, quotaErr := c.Quotas.Acquire(key.Key, modelName)
+	if quotaErr != nil {
+		c.logWarn("Key[%v] requesting model %s rejected: %v", utils.ShrinkString(key.Key, 8), modelName, quotaErr)
+		c.writeQuotaExceededResponse(conn, quotaErr)
+		return
+	}
+	defer ticket.Release()
+	inputTokens := EstimateTokens(prompt.String())
+
 	model, ok := c.Models.Get(modelName)
 	if !ok {
 		c.logError("No model configuration found: %s", modelName)
@@ -512,7 +523,7 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 	_ = model
 
 	// 使用 PeekOrderedProviders 获取按优先级排序的提供者列表
-	providers := c.Entrypoints.PeekOrderedProviders(modelName)
+	providers := c.Entrypoints.PeekOrderedChatProviders(modelName)
 	if len(providers) == 0 {
 		// 如果找不到，尝试从数据库重新加载
 		c.logWarn("No valid providers found for model %s, trying to reload from database...", modelName)
@@ -520,7 +531,7 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 			c.logError("Failed to reload providers from database: %v", err)
 		} else {
 			c.logInfo("Successfully reloaded providers from database, retrying to find providers.")
-			providers = c.Entrypoints.PeekOrderedProviders(modelName)
+			providers = c.Entrypoints.PeekOrderedChatProviders(modelName)
 		}
 	}
 
@@ -532,6 +543,13 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 
 	c.logInfo("Found %d valid providers for model %s, trying in order", len(providers), modelName)
 
+	// tools / function calling cannot be flattened into a prompt, forward them to OpenAI compatible providers
+	if requiresToolCallPassthrough(body) {
+		c.logInfo("Request of model %s uses tool calls, forwarding as-is", modelName)
+		c.serveToolCallPassthrough(conn, key, modelName, body, providers, inputTokens)
+		return
+	}
+
 	// 尝试每个提供者，直到有一个成功
 	var successfulProvider *Provider
 	var lastError error
@@ -557,6 +575,7 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 		rr, rw := utils.NewBufPipe(nil)
 
 		writer := NewChatJSONChunkWriter(conn, key.Key, modelName)
+		usage := new(upstreamUsage)
 		client, err := provider.GetAIClientWithImages(
 			imageContent,
 			func(reader io.Reader) {
@@ -577,6 +596,7 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 				io.Copy(rw, reader)
 				utils.FlushWriter(writer.writerClose)
 			},
+			aispec.WithUsageCallback(usage.Set),
 		)
 		if err != nil {
 			c.logError("Failed to get AI client from provider %s: %v", provider.TypeName, err)
@@ -609,6 +629,7 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 		firstByteDuration := time.Duration(0)
 		fonce := sync.Once{}
 		totalBytes := new(int64)
+		outputTokens := new(tokenMeter)
 
 		go func() {
 			defer func() {
@@ -616,11 +637,11 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 				wg.Done()
 			}()
 			c.logInfo("Start to handle reason mirror stream")
-			n, err := io.Copy(reasonWriter, io.TeeReader(rr, utils.FirstWriter(func(p []byte) {
+			n, err := io.Copy(reasonWriter, io.TeeReader(rr, io.MultiWriter(utils.FirstWriter(func(p []byte) {
 				fonce.Do(func() {
 					firstByteDuration = time.Since(start)
 				})
-			})))
+			}), outputTokens)))
 			if err != nil {
 				c.logError("Failed to copy reason stream: %v", err)
 			}
@@ -635,11 +656,11 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 				wg.Done()
 			}()
 			c.logInfo("Start to handle output mirror stream")
-			n, err := io.Copy(outputWriter, io.TeeReader(pr, utils.FirstWriter(func(p []byte) {
+			n, err := io.Copy(outputWriter, io.TeeReader(pr, io.MultiWriter(utils.FirstWriter(func(p []byte) {
 				fonce.Do(func() {
 					firstByteDuration = time.Since(start)
 				})
-			})))
+			}), outputTokens)))
 			atomic.AddInt64(totalBytes, n)
 			if err != nil {
 				c.logError("Failed to copy output stream: %v", err)
@@ -733,12 +754,17 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 		writer.Close()
 		utils.FlushWriter(conn)
 		writer.Wait()
+
+		// Meter token usage for quotas, the usage reported by the provider takes precedence over the estimation
+		in, out, estimated := usage.Tokens(inputTokens, outputTokens.Tokens())
+		c.Quotas.RecordUsage(key.Key, modelName, in, out, estimated, requestSucceeded)
 		break // 成功处理，退出循环
 	}
 
 	// 如果所有提供者都失败了
 	if successfulProvider == nil {
 		c.logError("All providers failed for model %s, last error: %v", modelName, lastError)
+		c.Quotas.RecordUsage(key.Key, modelName, 0, 0, false, false)
 		errorMsg := fmt.Sprintf("HTTP/1.1 500 Internal Server Error\r\nX-Reason: all providers failed for %v, last error: %v\r\n\r\n", modelName, lastError)
 		conn.Write([]byte(errorMsg))
 		return
@@ -748,6 +774,25 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 	c.logInfo("Connection closed for %s", conn.RemoteAddr())
 }
 
+// writeQuotaExceededResponse 返回 429 以及 Retry-After，响应体与 OpenAI 的错误格式一致
+func (c *ServerConfig) writeQuotaExceededResponse(conn net.Conn, quotaErr *QuotaExceededError) {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": quotaErr.Error(),
+			"type":    "rate_limit_exceeded",
+			"param":   quotaErr.Limit,
+			"code":    "rate_limit_exceeded",
+		},
+	})
+	header := fmt.Sprintf("HTTP/1.1 429 Too Many Requests\r\n"+
+		"Content-Type: application/json; charset=utf-8\r\n"+
+		"Retry-After: %d\r\n"+
+		"Content-Length: %d\r\n"+
+		"\r\n", quotaErr.RetryAfterSeconds(), len(body))
+	conn.Write([]byte(header))
+	conn.Write(body)
+}
+
 // 新增函数: 处理 /v1/models 请求，返回所有可用的 model 列表
 func (c *ServerConfig) serveModels(key *Key, conn net.Conn) {
 	c.logInfo("Serving models list")
@@ -937,6 +982,12 @@ func (c *ServerConfig) Serve(conn net.Conn) {
 	case strings.HasPrefix(uriIns.Path, "/v1/chat/completions"):
 		c.serveChatCompletions(conn, requestRaw)
 		return
+	case strings.HasPrefix(uriIns.Path, "/v1/responses"):
+		c.serveResponses(conn, requestRaw)
+		return
+	case strings.HasPrefix(uriIns.Path, "/v1/embeddings"), uriIns.Path == "/embeddings":
+		c.serveEmbeddings(conn, requestRaw)
+		return
 	case strings.HasPrefix(uriIns.Path, "/v1/models"): // 新增：处理 /v1/models 请求
 		c.logInfo("Processing models list request")
 		key := c.getKeyFromRawRequest(requestRaw)
@@ -0,0 +1,248 @@
+package aibalance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"time"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var responseModelRegexp = regexp.MustCompile(`"model"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// ChatCompletionsURL returns the OpenAI compatible chat completions url of the provider,
+// it is resolved by the registered aispec gateway of the provider type with the provider domain / url,
+// false means the gateway does not use an OpenAI compatible API and does not support OpenAI tools / functions
+func (p *Provider) ChatCompletionsURL() (string, bool) {
+	gateway, ok := aispec.Lookup(p.TypeName)
+	if !ok {
+		return "", false
+	}
+	compatible, ok := gateway.(aispec.OpenAICompatibleChatter)
+	if !ok {
+		return "", false
+	}
+	opts := []aispec.AIConfigOption{aispec.WithNoHTTPS(p.NoHTTPS), aispec.WithModel(p.ModelName)}
+	gateway.LoadOption(append(opts, p.targetOptions()...)...)
+	target := compatible.ChatCompletionsURL()
+	return target, target != ""
+}
+
+// requiresToolCallPassthrough checks whether the chat request uses tools / function calling,
+// these requests cannot be flattened into a prompt and need to be forwarded as-is
+func requiresToolCallPassthrough(body []byte) bool {
+	var req struct {
+		Tools     []any               `json:"tools"`
+		Functions []any               `json:"functions"`
+		Messages  []aispec.ChatDetail `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return false
+	}
+	if len(req.Tools) > 0 || len(req.Functions) > 0 {
+		return true
+	}
+	for _, message := range req.Messages {
+		if message.Role == "tool" || message.Role == "function" || len(message.ToolCalls) > 0 || message.FunctionCall != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// toolCallChunk is a chat completion response or stream chunk, it is used to meter output tokens
+type toolCallChunk struct {
+	Choices []struct {
+		Delta   *aispec.ChatDetail `json:"delta"`
+		Message *aispec.ChatDetail `json:"message"`
+	} `json:"choices"`
+	Usage *aispec.ChatUsage `json:"usage"`
+}
+
+// meterToolCallChunk writes the generated content and tool call arguments of the chunk to the meter,
+// usage is returned if the upstream reports it
+func meterToolCallChunk(raw []byte, meter io.Writer) *aispec.ChatUsage {
+	var chunk toolCallChunk
+	if err := json.Unmarshal(raw, &chunk); err != nil {
+		return nil
+	}
+	for _, choice := range chunk.Choices {
+		for _, detail := range []*aispec.ChatDetail{choice.Delta, choice.Message} {
+			if detail == nil {
+				continue
+			}
+			if content, ok := detail.Content.(string); ok {
+				meter.Write([]byte(content))
+			}
+			for _, call := range detail.ToolCalls {
+				if call == nil {
+					continue
+				}
+				meter.Write([]byte(call.Function.Name))
+				meter.Write([]byte(call.Function.Arguments))
+			}
+			if detail.FunctionCall != nil {
+				meter.Write([]byte(detail.FunctionCall.Name))
+				meter.Write([]byte(detail.FunctionCall.Arguments))
+			}
+		}
+	}
+	if chunk.Usage != nil && chunk.Usage.TotalTokens > 0 {
+		return chunk.Usage
+	}
+	return nil
+}
+
+func newPassthroughHTTPClient() *http.Client {
+	client := utils.NewDefaultHTTPClient()
+	// streamed responses may take minutes, only limit the time to wait for the response header
+	client.Timeout = 0
+	if transport, ok := client.Transport.(*http.Transport); ok {
+		transport.ResponseHeaderTimeout = 60 * time.Second
+	}
+	return client
+}
+
+// serveToolCallPassthrough forwards chat requests with tools / function calling to OpenAI compatible providers,
+// the request body is kept except the model, and the upstream response (json or SSE stream) is relayed
+// with the model replaced by the wrapper name, so tool_calls deltas reach the client unchanged
+func (c *ServerConfig) serveToolCallPassthrough(conn net.Conn, key *Key, modelName string, body []byte, providers []*Provider, inputTokens int64) {
+	var request map[string]any
+	if err := json.Unmarshal(body, &request); err != nil {
+		c.logError("Failed to parse tool call request body: %v", err)
+		conn.Write([]byte("HTTP/1.1 400 Bad Request\r\n\r\n"))
+		return
+	}
+	stream := utils.MapGetBool(request, "stream")
+	wrapperModel, _ := json.Marshal(modelName)
+	client := newPassthroughHTTPClient()
+
+	var lastError error
+	for i, provider := range providers {
+		target, ok := provider.ChatCompletionsURL()
+		if !ok {
+			c.logWarn("Provider %d/%d (%s) does not support tool calls, skipping", i+1, len(providers), provider.TypeName)
+			lastError = utils.Errorf("provider type %s does not support tool calls", provider.TypeName)
+			continue
+		}
+		c.logInfo("Forwarding tool call request to provider %d/%d for model %s: %s", i+1, len(providers), modelName, target)
+
+		request["model"] = provider.ModelName
+		raw, err := json.Marshal(request)
+		if err != nil {
+			lastError = err
+			continue
+		}
+		req, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(raw))
+		if err != nil {
+			lastError = err
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+		if stream {
+			req.Header.Set("Accept", "text/event-stream")
+		} else {
+			req.Header.Set("Accept", "application/json")
+		}
+		if provider.APIKey != "" {
+			req.Header.Set("Authorization", "Bearer "+provider.APIKey)
+		}
+
+		start := time.Now()
+		rsp, err := client.Do(req)
+		latencyMs := time.Since(start).Milliseconds()
+		if err == nil && rsp.StatusCode >= 300 {
+			errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 4096))
+			rsp.Body.Close()
+			err = utils.Errorf("upstream responded %s: %s", rsp.Status, utils.ShrinkString(string(errBody), 512))
+		}
+		if err != nil {
+			c.logError("Provider %s tool call request failed: %v", provider.TypeName, err)
+			lastError = err
+			go func(p *Provider) {
+				if err := p.UpdateDbProvider(false, latencyMs); err != nil {
+					c.logError("Failed to update failed provider status: %v", err)
+				}
+			}(provider)
+			continue
+		}
+
+		contentType := rsp.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		header := "HTTP/1.1 200 OK\r\n" +
+			"Content-Type: " + contentType + "\r\n" +
+			"Cache-Control: no-cache\r\n" +
+			"Transfer-Encoding: chunked\r\n" +
+			"\r\n"
+		if _, err := conn.Write([]byte(header)); err != nil {
+			c.logError("Failed to send response header: %v", err)
+		}
+
+		outputTokens := new(tokenMeter)
+		usage := new(upstreamUsage)
+		var total int64
+		cw := httputil.NewChunkedWriter(conn)
+		reader := bufio.NewReader(rsp.Body)
+		for {
+			line, readErr := reader.ReadBytes('\n')
+			if len(line) > 0 {
+				payload := bytes.TrimSpace(line)
+				payload = bytes.TrimSpace(bytes.TrimPrefix(payload, []byte("data:")))
+				if bytes.HasPrefix(payload, []byte("{")) {
+					if u := meterToolCallChunk(payload, outputTokens); u != nil {
+						usage.Set(u)
+					}
+					line = responseModelRegexp.ReplaceAll(line, append([]byte(`"model":`), wrapperModel...))
+				}
+				n, err := cw.Write(line)
+				total += int64(n)
+				if err != nil {
+					c.logError("Failed to relay tool call response: %v", err)
+					break
+				}
+				utils.FlushWriter(conn)
+			}
+			if readErr != nil {
+				if readErr != io.EOF {
+					c.logError("Failed to read tool call response from %s: %v", provider.TypeName, readErr)
+				}
+				break
+			}
+		}
+		rsp.Body.Close()
+		cw.Close()
+		conn.Write([]byte("\r\n"))
+		utils.FlushWriter(conn)
+
+		requestSucceeded := total > 0
+		go func(p *Provider) {
+			if err := p.UpdateDbProvider(requestSucceeded, latencyMs); err != nil {
+				c.logError("Failed to update provider status: %v", err)
+			}
+		}(provider)
+		go func() {
+			if err := UpdateAiApiKeyStats(key.Key, int64(len(body)), total, requestSucceeded); err != nil {
+				c.logError("Failed to update API key statistics: %v", err)
+			}
+		}()
+
+		in, out, estimated := usage.Tokens(inputTokens, outputTokens.Tokens())
+		c.Quotas.RecordUsage(key.Key, modelName, in, out, estimated, requestSucceeded)
+		c.logInfo("Tool call response relayed from provider %s for model %s, latency: %dms, bytes: %d", provider.TypeName, modelName, latencyMs, total)
+		return
+	}
+
+	c.logError("All providers failed for tool call request of model %s, last error: %v", modelName, lastError)
+	c.Quotas.RecordUsage(key.Key, modelName, 0, 0, false, false)
+	c.writeOpenAIErrorResponse(conn, http.StatusBadGateway, "api_error", fmt.Sprintf("all providers failed for %s, last error: %v", modelName, lastError))
+}
@@ -0,0 +1,121 @@
+package binfuzz
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/bin-parser/parser"
+	"github.com/yaklang/yaklang/common/mutate"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+const dnsPacket = "3066d026811bf84d8991af52080045000055edc10000401134dec0a80316771d1d1dfa0d003500417b4514520100000100000000000011636f70696c6f742d74656c656d657472791167697468756275736572636f6e74656e7403636f6d0000010001"
+
+func decodeHex(t *testing.T, s string) []byte {
+	data, err := codec.DecodeHex(s)
+	require.NoError(t, err)
+	return data
+}
+
+func fieldValue(t *testing.T, data []byte, rule string, path string, keys ...string) any {
+	m, err := NewMutator(data, rule, WithKeys(keys...))
+	require.NoError(t, err)
+	f, ok := m.Field(path)
+	require.True(t, ok, path)
+	return f.Value
+}
+
+func TestMutator_Socks5AuthRequest(t *testing.T) {
+	data := decodeHex(t, "0104010203040401020304")
+	m, err := NewMutator(data, "application-layer.socks5", WithKeys("AuthRequest"))
+	require.NoError(t, err)
+
+	uLen, ok := m.Field("AuthRequest.ULen")
+	require.True(t, ok)
+	require.True(t, uLen.IsLength())
+	uname, ok := m.Field("AuthRequest.UNAME")
+	require.True(t, ok)
+	require.True(t, uname.Variable)
+
+	// 修改 UNAME 后 ULen 被重新计算，PASSWD 保持不变
+	raw, err := m.Set("AuthRequest.UNAME", "admin")
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{0x01, 0x05}, append([]byte("admin"), 0x04, 0x01, 0x02, 0x03, 0x04)...), raw)
+	require.Equal(t, uint64(5), fieldValue(t, raw, "application-layer.socks5", "AuthRequest.ULen", "AuthRequest"))
+	require.Equal(t, "admin", fieldValue(t, raw, "application-layer.socks5", "AuthRequest.UNAME", "AuthRequest"))
+
+	var lengthDesync, grown bool
+	for _, mutation := range m.Mutations() {
+		out := mutation.Bytes()
+		switch {
+		case mutation.Field.Path == "AuthRequest.ULen" && mutation.Strategy == StrategyLength:
+			// 长度字段被修改，数据保持不变
+			require.Len(t, out, len(data))
+			require.Equal(t, data[2:], out[2:])
+			lengthDesync = true
+		case mutation.Field.Path == "AuthRequest.UNAME" && len(out) > 1000:
+			// ULen 只有 8 bit，超出的长度被截断
+			require.Equal(t, byte(len(out)-7), out[1])
+			grown = true
+		}
+	}
+	require.True(t, lengthDesync)
+	require.True(t, grown)
+}
+
+func TestMutator_Enum(t *testing.T) {
+	data := decodeHex(t, "050100030e7777772e676f6f676c652e636f6d0050")
+	results, err := Mutate(data, "application-layer.socks5", WithKeys("Request"), WithFields("*.Command"), WithStrategy(StrategyEnum))
+	require.NoError(t, err)
+	var commands []byte
+	for _, result := range results {
+		require.Len(t, result, len(data))
+		commands = append(commands, result[1])
+	}
+	// 原始值 1 被去掉，4 是枚举之外的值
+	require.Equal(t, []byte{2, 3, 4}, commands)
+}
+
+func TestMutator_NestedLength(t *testing.T) {
+	data := decodeHex(t, dnsPacket)
+	node, err := parser.ParseBinary(bytes.NewReader(data), "ethernet")
+	require.NoError(t, err)
+	m, err := NewMutatorFromNode(node)
+	require.NoError(t, err)
+
+	var lengthFields []string
+	for _, f := range m.Fields() {
+		if f.IsLength() {
+			lengthFields = append(lengthFields, f.Path)
+		}
+	}
+	require.NotEmpty(t, lengthFields)
+
+	// 域名变长后，IP 的 Total Length 同步增加
+	raw, err := m.Set("Ethernet.IP.UDP.DNS.Questions.#0.Name.#0.Data", "copilot-telemetryAAAA")
+	require.NoError(t, err)
+	require.Len(t, raw, len(data)+4)
+	// IP 头在以太网头之后，Total Length 位于第 16、17 字节
+	require.Equal(t, int(data[16])<<8|int(data[17])+4, int(raw[16])<<8|int(raw[17]))
+
+	results, err := Mutate(data, "ethernet", WithConsistent(false), WithFields("*Total Length*"), WithStrategy(StrategyLength))
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	for _, result := range results {
+		require.Len(t, result, len(data))
+	}
+}
+
+func TestFuzzTag(t *testing.T) {
+	results, err := mutate.FuzzTagExec("{{binfuzz(application-layer.socks5:AuthRequest|0104010203040401020304|*UNAME)}}")
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	expected, err := Mutate(decodeHex(t, "0104010203040401020304"), "application-layer.socks5", WithKeys("AuthRequest"), WithFields("*UNAME"))
+	require.NoError(t, err)
+	require.Len(t, results, len(expected))
+	for i, result := range results {
+		require.Equal(t, string(expected[i]), result)
+	}
+}
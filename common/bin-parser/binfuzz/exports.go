@@ -0,0 +1,49 @@
+package binfuzz
+
+import (
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Mutate 使用规则解析数据，返回全部变异后的数据
+func Mutate(data []byte, rule string, opts ...Option) ([][]byte, error) {
+	m, err := NewMutator(data, rule, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var results [][]byte
+	for _, mutation := range m.Mutations() {
+		results = append(results, mutation.Bytes())
+	}
+	return results, nil
+}
+
+// NewMutator 创建变异器，可以通过 Fields 查看字段，通过 Set 修改字段，通过 Mutations 获取变异结果
+// Example:
+// ```
+// m = binfuzz.NewMutator(codec.DecodeHex("0104010203040401020304")~, "application-layer.socks5", binfuzz.keys("AuthRequest"))~
+// for field in m.Fields() { println(field.String()) }
+// dump(m.Set("AuthRequest.UNAME", "admin")~)
+// ```
+func _newMutator(data any, rule string, opts ...Option) (*Mutator, error) {
+	return NewMutator(utils.InterfaceToBytes(data), rule, opts...)
+}
+
+// Mutate 使用规则解析数据，返回全部变异后的数据，长度字段会根据变异后的数据重新计算
+// Example:
+// ```
+// results = binfuzz.Mutate(codec.DecodeHex("050100")~, "application-layer.socks5", binfuzz.keys("ClientNegotiation"))~
+// for result in results { dump(result) }
+// ```
+func _mutate(data any, rule string, opts ...Option) ([][]byte, error) {
+	return Mutate(utils.InterfaceToBytes(data), rule, opts...)
+}
+
+var Exports = map[string]any{
+	"Mutate":     _mutate,
+	"NewMutator": _newMutator,
+
+	"keys":       WithKeys,
+	"fields":     WithFields,
+	"strategy":   WithStrategy,
+	"consistent": WithConsistent,
+}
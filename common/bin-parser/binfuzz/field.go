@@ -0,0 +1,388 @@
+package binfuzz
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/bin-parser/parser/base"
+	"github.com/yaklang/yaklang/common/bin-parser/parser/stream_parser"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	cfgEnum                    = "enum"
+	cfgListLengthFromField     = "list-length-from-field"
+	cfgLengthFromFieldMultiply = "length-from-field-multiply"
+)
+
+// Field 是解析结果中的一个叶子字段，Offset 和 Bits 以 bit 为单位
+type Field struct {
+	Path   string
+	Type   string
+	Offset uint64
+	Bits   uint64
+	Value  any
+
+	// Variable 表示字段长度由其他字段声明（length-from-field），可以改变长度
+	Variable bool
+	// LengthOf 是该字段作为长度（或列表元素个数）描述的节点路径
+	LengthOf []string
+	// Enum 是规则中通过 enum 声明的可选值
+	Enum []uint64
+
+	endian string
+	node   *base.Node
+	index  int
+}
+
+func (f *Field) IsInteger() bool {
+	switch f.Type {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
+func (f *Field) IsSigned() bool {
+	return strings.HasPrefix(f.Type, "int")
+}
+
+// IsLength 表示字段是其他节点的长度或者元素个数
+func (f *Field) IsLength() bool {
+	return len(f.LengthOf) > 0
+}
+
+func (f *Field) String() string {
+	switch ret := f.Value.(type) {
+	case []byte:
+		return fmt.Sprintf("%s(%s,%dbit): %x", f.Path, f.Type, f.Bits, ret)
+	default:
+		return fmt.Sprintf("%s(%s,%dbit): %v", f.Path, f.Type, f.Bits, ret)
+	}
+}
+
+// lengthRelation 描述 target 节点的长度（bit）= 长度字段的值 * factor
+type lengthRelation struct {
+	field  *Field
+	target *base.Node
+	// start 和 end 是 target 覆盖的字段下标 [start, end)
+	start, end int
+	factor     uint64
+	// count 表示长度字段描述的是列表元素个数，元素个数不会被修改，所以不需要同步
+	count bool
+}
+
+// segment 是序列化时的最小单位，字段之间未被解析的数据也会作为 segment 原样保留
+type segment struct {
+	field *Field
+	data  []byte
+	bits  uint64
+}
+
+// extractFields 从解析后的节点中提取全部叶子字段，并按照在数据中的位置排序
+func extractFields(node *base.Node) ([]*Field, error) {
+	var fields []*Field
+	var walk func(n *base.Node)
+	walk = func(n *base.Node) {
+		if stream_parser.NodeHasResult(n) && !hasResultChild(n) {
+			pos := stream_parser.GetNodeResultPos(n)
+			if pos[1] < pos[0] {
+				return
+			}
+			typ := n.Cfg.GetString(stream_parser.CfgType)
+			if typ == "" {
+				typ = "raw"
+			}
+			endian := n.Cfg.GetString(stream_parser.CfgEndian)
+			if endian != "little" {
+				endian = "big"
+			}
+			fields = append(fields, &Field{
+				Path:     stream_parser.GetNodePath(n),
+				Type:     typ,
+				Offset:   pos[0],
+				Bits:     pos[1] - pos[0],
+				Variable: n.Cfg.Has(stream_parser.CfgLengthFromField),
+				Enum:     parseEnum(n.Cfg.GetItem(cfgEnum)),
+				endian:   endian,
+				node:     n,
+			})
+			return
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(node)
+	if len(fields) == 0 {
+		return nil, utils.Error("no field parsed")
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Offset < fields[j].Offset
+	})
+	// 去掉重叠的字段（例如同一段数据被不同类型尝试解析）
+	result := fields[:0]
+	var end uint64
+	for _, f := range fields {
+		if len(result) > 0 && f.Offset < end {
+			continue
+		}
+		f.index = len(result)
+		result = append(result, f)
+		end = f.Offset + f.Bits
+	}
+	return result, nil
+}
+
+func hasResultChild(n *base.Node) bool {
+	for _, child := range n.Children {
+		if stream_parser.NodeHasResult(child) || hasResultChild(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseEnum(i any) []uint64 {
+	if i == nil {
+		return nil
+	}
+	var items []string
+	switch ret := i.(type) {
+	case []any:
+		for _, item := range ret {
+			items = append(items, utils.InterfaceToString(item))
+		}
+	default:
+		items = utils.PrettifyListFromStringSplited(utils.InterfaceToString(i), ",")
+	}
+	var enum []uint64
+	for _, item := range items {
+		v, err := strconv.ParseUint(strings.TrimSpace(item), 0, 64)
+		if err != nil {
+			continue
+		}
+		enum = append(enum, v)
+	}
+	return enum
+}
+
+// readSegments 把原始数据按照字段切分为 segment，字段之间和末尾的数据作为不可变的 segment
+func readSegments(buf []byte, fields []*Field) ([]*segment, error) {
+	reader := base.NewBitReader(bytes.NewReader(buf))
+	total := uint64(len(buf)) * 8
+	var segments []*segment
+	var pos uint64
+	read := func(field *Field, bits uint64) error {
+		data, err := reader.ReadBits(bits)
+		if err != nil {
+			return err
+		}
+		segments = append(segments, &segment{field: field, data: data, bits: bits})
+		pos += bits
+		return nil
+	}
+	for _, f := range fields {
+		if f.Offset+f.Bits > total {
+			return nil, utils.Errorf("field %s out of range", f.Path)
+		}
+		if f.Offset > pos {
+			if err := read(nil, f.Offset-pos); err != nil {
+				return nil, err
+			}
+		}
+		if err := read(f, f.Bits); err != nil {
+			return nil, utils.Wrapf(err, "read field %s failed", f.Path)
+		}
+		f.Value = decodeValue(f, segments[len(segments)-1].data)
+	}
+	if total > pos {
+		if err := read(nil, total-pos); err != nil {
+			return nil, err
+		}
+	}
+	return segments, nil
+}
+
+// resolveRelations 根据规则中的 length-from-field 和 list-length-from-field 找到长度字段与节点的关系
+// 长度单位优先从样本中推断，推断不出时使用规则中的 unit 和 length-from-field-multiply
+func resolveRelations(fields []*Field) []*lengthRelation {
+	byNode := make(map[*base.Node]*Field, len(fields))
+	for _, f := range fields {
+		byNode[f.node] = f
+	}
+	seen := make(map[*base.Node]struct{})
+	var relations []*lengthRelation
+	var walk func(n *base.Node)
+	walk = func(n *base.Node) {
+		for _, key := range []string{stream_parser.CfgLengthFromField, cfgListLengthFromField} {
+			if !n.Cfg.Has(key) {
+				continue
+			}
+			if _, ok := seen[n]; ok {
+				continue
+			}
+			lengthNode := findNode(n, n.Cfg.GetString(key))
+			field, ok := byNode[lengthNode]
+			if !ok || !field.IsInteger() {
+				continue
+			}
+			start, end, ok := nodeFieldRange(n, byNode)
+			if !ok && key != cfgListLengthFromField {
+				continue
+			}
+			seen[n] = struct{}{}
+			relation := &lengthRelation{field: field, target: n, start: start, end: end, count: key == cfgListLengthFromField}
+			if !relation.count {
+				relation.factor = inferFactor(n, field, spanBits(fields[start:end]))
+				if relation.factor == 0 {
+					continue
+				}
+			}
+			field.LengthOf = append(field.LengthOf, stream_parser.GetNodePath(n))
+			relations = append(relations, relation)
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	if len(fields) > 0 {
+		walk(rootOf(fields[0].node))
+	}
+	return relations
+}
+
+func rootOf(n *base.Node) *base.Node {
+	if root, ok := n.Ctx.GetItem("root").(*base.Node); ok {
+		return root
+	}
+	return n
+}
+
+func findNode(n *base.Node, path string) (target *base.Node) {
+	defer func() {
+		if recover() != nil {
+			target = nil
+		}
+	}()
+	return stream_parser.GetNodeByRelativePath(n, path)
+}
+
+// nodeFieldRange 返回节点覆盖的字段下标范围
+func nodeFieldRange(n *base.Node, byNode map[*base.Node]*Field) (int, int, bool) {
+	start, end := -1, -1
+	var walk func(n *base.Node)
+	walk = func(n *base.Node) {
+		if f, ok := byNode[n]; ok {
+			if start < 0 || f.index < start {
+				start = f.index
+			}
+			if f.index+1 > end {
+				end = f.index + 1
+			}
+			return
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(n)
+	if start < 0 {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func spanBits(fields []*Field) uint64 {
+	if len(fields) == 0 {
+		return 0
+	}
+	last := fields[len(fields)-1]
+	return last.Offset + last.Bits - fields[0].Offset
+}
+
+func inferFactor(n *base.Node, lengthField *Field, bits uint64) uint64 {
+	value, ok := base.InterfaceToUint64(lengthField.Value)
+	if ok && value > 0 && bits%value == 0 {
+		return bits / value
+	}
+	factor := uint64(8)
+	if n.Cfg.GetString(stream_parser.CfgUnit) == "bit" {
+		factor = 1
+	}
+	if n.Cfg.Has(cfgLengthFromFieldMultiply) {
+		multiply, err := strconv.ParseUint(utils.InterfaceToString(n.Cfg.GetItem(cfgLengthFromFieldMultiply)), 10, 64)
+		if err != nil || multiply == 0 {
+			return 0
+		}
+		factor *= multiply
+	}
+	if ok && value*factor != bits {
+		// 样本中的长度与规则不一致，无法保证同步后的结果正确
+		return 0
+	}
+	return factor
+}
+
+func decodeValue(f *Field, data []byte) any {
+	if f.IsInteger() {
+		v := bitsToUint(data, f.Bits, f.endian)
+		if f.IsSigned() && f.Bits > 0 && f.Bits < 64 && v&(1<<(f.Bits-1)) != 0 {
+			return int64(v) - int64(1)<<f.Bits
+		}
+		if f.IsSigned() {
+			return int64(v)
+		}
+		return v
+	}
+	if f.Type == "string" {
+		return string(data)
+	}
+	return append([]byte(nil), data...)
+}
+
+// bitsToUint 按照 BitReader.ReadBits 的格式（整字节在前，剩余的 bit 在最后一个字节的低位）还原整数
+func bitsToUint(data []byte, bits uint64, endian string) uint64 {
+	full := int(bits / 8)
+	rest := bits % 8
+	var v uint64
+	if endian == "little" && rest == 0 {
+		for i := full - 1; i >= 0; i-- {
+			v = v<<8 | uint64(data[i])
+		}
+		return v
+	}
+	for i := 0; i < full; i++ {
+		v = v<<8 | uint64(data[i])
+	}
+	if rest > 0 && len(data) > full {
+		v = v<<rest | uint64(data[full])&(1<<rest-1)
+	}
+	return v
+}
+
+// uintToBits 是 bitsToUint 的逆过程，超出宽度的高位会被截断
+func uintToBits(v uint64, bits uint64, endian string) []byte {
+	if bits < 64 {
+		v &= 1<<bits - 1
+	}
+	full := int(bits / 8)
+	rest := bits % 8
+	data := make([]byte, 0, full+1)
+	if endian == "little" && rest == 0 {
+		for i := 0; i < full; i++ {
+			data = append(data, byte(v>>(8*i)))
+		}
+		return data
+	}
+	for i := 0; i < full; i++ {
+		data = append(data, byte(v>>(rest+uint64(8*(full-1-i)))))
+	}
+	if rest > 0 {
+		data = append(data, byte(v&(1<<rest-1)))
+	}
+	return data
+}
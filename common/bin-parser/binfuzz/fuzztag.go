@@ -0,0 +1,53 @@
+package binfuzz
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mutate"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+// parseRuleWithKeys 解析 `application-layer.socks5:AuthRequest` 形式的规则，冒号后为子节点
+func parseRuleWithKeys(rule string) (string, []string) {
+	rule, key, ok := strings.Cut(strings.TrimSpace(rule), ":")
+	if !ok || key == "" {
+		return rule, nil
+	}
+	return rule, strings.Split(key, ".")
+}
+
+func init() {
+	mutate.AddFuzzTagToGlobal(&mutate.FuzzTagDescription{
+		TagName: "binfuzz",
+		Handler: func(s string) []string {
+			splits := strings.SplitN(s, "|", 3)
+			if len(splits) < 2 {
+				return []string{}
+			}
+			rule, keys := parseRuleWithKeys(splits[0])
+			data, err := codec.DecodeHex(strings.TrimSpace(splits[1]))
+			if err != nil {
+				log.Errorf("binfuzz: decode hex data failed: %v", err)
+				return []string{}
+			}
+			opts := []Option{WithKeys(keys...)}
+			if len(splits) > 2 && splits[2] != "" {
+				opts = append(opts, WithFields(strings.Split(splits[2], ",")...))
+			}
+			results, err := Mutate(data, rule, opts...)
+			if err != nil {
+				log.Errorf("binfuzz: %v", err)
+				return []string{}
+			}
+			var ret []string
+			for _, result := range results {
+				ret = append(ret, string(result))
+			}
+			return ret
+		},
+		Description:         "根据 bin-parser 规则解析二进制数据并按照字段类型进行结构化变异（边界整数、长度不一致、枚举翻转等），长度字段会被重新计算，例如 {{binfuzz(application-layer.socks5:AuthRequest|0104010203040401020304|*UNAME)}}",
+		TagNameVerbose:      "二进制结构化变异",
+		ArgumentDescription: "{{string_split(application-layer.socks5:规则)}}{{string_split(050100:十六进制数据)}}{{optional(string(*:字段))}}",
+	})
+}
@@ -0,0 +1,395 @@
+package binfuzz
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/yaklang/yaklang/common/bin-parser/parser"
+	"github.com/yaklang/yaklang/common/bin-parser/parser/base"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	// StrategyBoundary 对整数字段使用边界值
+	StrategyBoundary = "boundary"
+	// StrategyLength 修改长度字段但保持被描述的数据不变，制造长度不一致
+	StrategyLength = "length"
+	// StrategyEnum 使用规则声明的枚举值以及枚举之外的值，未声明枚举的小整数字段按位翻转
+	StrategyEnum = "enum"
+	// StrategyBytes 对字符串、原始数据字段进行填充、截断、扩展
+	StrategyBytes = "bytes"
+)
+
+var defaultStrategies = []string{StrategyBoundary, StrategyLength, StrategyEnum, StrategyBytes}
+
+type config struct {
+	keys       []string
+	fields     []string
+	strategies []string
+	consistent bool
+}
+
+type Option func(c *config)
+
+// WithKeys 指定解析规则中的子节点，例如 socks5 规则中的 AuthRequest
+func WithKeys(keys ...string) Option {
+	return func(c *config) {
+		c.keys = append(c.keys, keys...)
+	}
+}
+
+// WithFields 只变异路径匹配 glob 的字段，例如 `*.UNAME`
+func WithFields(globs ...string) Option {
+	return func(c *config) {
+		c.fields = append(c.fields, globs...)
+	}
+}
+
+// WithStrategy 指定使用的变异策略：boundary、length、enum、bytes，默认全部使用
+func WithStrategy(strategies ...string) Option {
+	return func(c *config) {
+		c.strategies = append(c.strategies, strategies...)
+	}
+}
+
+// WithConsistent 设置变异后是否重新计算长度字段，默认为 true
+func WithConsistent(b bool) Option {
+	return func(c *config) {
+		c.consistent = b
+	}
+}
+
+func newConfig(opts ...Option) *config {
+	c := &config{consistent: true}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(c.strategies) == 0 {
+		c.strategies = defaultStrategies
+	}
+	return c
+}
+
+// Mutation 是对一个字段的一次变异
+type Mutation struct {
+	Field    *Field
+	Strategy string
+	Value    any
+	data     []byte
+}
+
+// Bytes 返回变异后重新序列化的完整数据
+func (m *Mutation) Bytes() []byte {
+	return m.data
+}
+
+// Mutator 根据 bin-parser 规则解析出的字段对数据进行结构化变异
+type Mutator struct {
+	config    *config
+	origin    []byte
+	fields    []*Field
+	segments  []*segment
+	relations []*lengthRelation
+	// fieldSegment 是字段下标到 segment 下标的映射
+	fieldSegment []int
+}
+
+// NewMutator 使用规则解析数据并创建变异器
+func NewMutator(data []byte, rule string, opts ...Option) (*Mutator, error) {
+	c := newConfig(opts...)
+	node, err := parser.ParseBinary(bytes.NewReader(data), rule, c.keys...)
+	if err != nil {
+		return nil, utils.Wrapf(err, "parse binary by rule %s failed", rule)
+	}
+	return newMutator(node, data, c)
+}
+
+// NewMutatorFromNode 使用 parser.ParseBinary 得到的节点创建变异器
+func NewMutatorFromNode(node *base.Node, opts ...Option) (*Mutator, error) {
+	buffer, ok := node.Ctx.GetItem("buffer").(*bytes.Buffer)
+	if !ok {
+		return nil, utils.Error("node has no parse result")
+	}
+	return newMutator(node, buffer.Bytes(), newConfig(opts...))
+}
+
+func newMutator(node *base.Node, data []byte, c *config) (*Mutator, error) {
+	fields, err := extractFields(node)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := readSegments(data, fields)
+	if err != nil {
+		return nil, err
+	}
+	m := &Mutator{
+		config:    c,
+		origin:    data,
+		fields:    fields,
+		segments:  segments,
+		relations: resolveRelations(fields),
+	}
+	m.fieldSegment = make([]int, len(fields))
+	for i, seg := range segments {
+		if seg.field != nil {
+			m.fieldSegment[seg.field.index] = i
+		}
+	}
+	if len(fields) > 0 {
+		// 最后一个字段后面没有其他字段，改变长度不会影响其他字段的解析
+		last := fields[len(fields)-1]
+		if !last.IsInteger() {
+			last.Variable = true
+		}
+	}
+	return m, nil
+}
+
+// Fields 返回解析出的全部字段
+func (m *Mutator) Fields() []*Field {
+	return m.fields
+}
+
+// Field 按照路径查找字段
+func (m *Mutator) Field(path string) (*Field, bool) {
+	for _, f := range m.fields {
+		if f.Path == path {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Set 修改指定字段的值并返回重新序列化的数据，整数字段接受整数，其他字段接受字符串或字节
+// 与 Mutations 不同，Set 允许改变固定长度字段的长度
+func (m *Mutator) Set(path string, value any) ([]byte, error) {
+	f, ok := m.Field(path)
+	if !ok {
+		return nil, utils.Errorf("field %s not found", path)
+	}
+	data, bits, err := encodeValue(f, value, true)
+	if err != nil {
+		return nil, err
+	}
+	return m.serialize(f, data, bits, !f.IsLength())
+}
+
+// Mutations 按照字段和策略生成全部变异结果，结果与原始数据相同或者重复的会被去掉
+func (m *Mutator) Mutations() []*Mutation {
+	var (
+		result []*Mutation
+		seen   = map[string]struct{}{string(m.origin): {}}
+	)
+	for _, f := range m.fields {
+		if len(m.config.fields) > 0 && !utils.MatchAnyOfGlob(f.Path, m.config.fields...) {
+			continue
+		}
+		for _, strategy := range m.config.strategies {
+			for _, value := range m.values(f, strategy) {
+				data, bits, err := encodeValue(f, value, false)
+				if err != nil {
+					continue
+				}
+				// 长度策略只修改长度字段本身，其他策略修改后重新计算长度字段
+				raw, err := m.serialize(f, data, bits, strategy != StrategyLength)
+				if err != nil {
+					continue
+				}
+				if _, ok := seen[string(raw)]; ok {
+					continue
+				}
+				seen[string(raw)] = struct{}{}
+				result = append(result, &Mutation{Field: f, Strategy: strategy, Value: value, data: raw})
+			}
+		}
+	}
+	return result
+}
+
+func (m *Mutator) values(f *Field, strategy string) []any {
+	if f.IsInteger() {
+		v, _ := base.InterfaceToUint64(f.Value)
+		var values []uint64
+		switch strategy {
+		case StrategyBoundary:
+			if f.IsLength() {
+				return nil
+			}
+			values = boundaryValues(f, v)
+		case StrategyLength:
+			if !f.IsLength() {
+				return nil
+			}
+			values = []uint64{0, v - 1, v + 1, v * 2, maxValue(f.Bits)}
+		case StrategyEnum:
+			values = enumValues(f, v)
+		default:
+			return nil
+		}
+		var result []any
+		for _, value := range values {
+			if f.Bits < 64 && value > maxValue(f.Bits) {
+				continue
+			}
+			result = append(result, value)
+		}
+		return result
+	}
+	if strategy != StrategyBytes {
+		return nil
+	}
+	origin := utils.InterfaceToBytes(f.Value)
+	size := len(origin)
+	var result [][]byte
+	if size > 0 {
+		result = append(result,
+			bytes.Repeat([]byte{0x00}, size),
+			bytes.Repeat([]byte{0xff}, size),
+			flipByte(origin, 0),
+			flipByte(origin, size-1),
+		)
+	}
+	if f.Variable && f.Bits%8 == 0 {
+		result = append(result,
+			[]byte{},
+			origin[:size/2],
+			append(append([]byte(nil), origin...), origin...),
+			bytes.Repeat([]byte("A"), 256),
+			bytes.Repeat([]byte("A"), 1024),
+		)
+		if f.Type == "string" {
+			result = append(result,
+				[]byte("%s%s%s%n"),
+				append(append([]byte(nil), origin...), append([]byte{0x00}, origin...)...),
+			)
+		}
+	}
+	var values []any
+	for _, item := range result {
+		values = append(values, item)
+	}
+	return values
+}
+
+func boundaryValues(f *Field, v uint64) []uint64 {
+	max := maxValue(f.Bits)
+	values := []uint64{0, 1, max, max - 1, v + 1, v - 1}
+	if f.IsSigned() && f.Bits > 1 {
+		signBit := uint64(1) << (f.Bits - 1)
+		// 有符号整数的最大值和最小值
+		values = append(values, signBit-1, signBit)
+	}
+	return values
+}
+
+func enumValues(f *Field, v uint64) []uint64 {
+	if len(f.Enum) > 0 {
+		values := append([]uint64(nil), f.Enum...)
+		// 枚举之外的值
+		var outOfRange uint64
+		for _, item := range f.Enum {
+			if item >= outOfRange {
+				outOfRange = item + 1
+			}
+		}
+		if f.Bits < 64 && outOfRange > maxValue(f.Bits) {
+			outOfRange = 0
+			for containsUint(f.Enum, outOfRange) && outOfRange < maxValue(f.Bits) {
+				outOfRange++
+			}
+		}
+		return append(values, outOfRange)
+	}
+	if f.IsLength() || f.Bits > 8 {
+		return nil
+	}
+	var values []uint64
+	for i := uint64(0); i < f.Bits; i++ {
+		values = append(values, v^(1<<i))
+	}
+	return values
+}
+
+func containsUint(list []uint64, v uint64) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func maxValue(bits uint64) uint64 {
+	if bits >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bits - 1
+}
+
+func flipByte(origin []byte, index int) []byte {
+	buf := append([]byte(nil), origin...)
+	buf[index] ^= 0xff
+	return buf
+}
+
+// encodeValue 把值编码为字段的数据，整数字段宽度不变，其他字段按照字节长度计算宽度
+// resize 为 false 时固定长度的字段不允许改变长度
+func encodeValue(f *Field, value any, resize bool) ([]byte, uint64, error) {
+	if f.IsInteger() {
+		v, ok := base.InterfaceToUint64(value)
+		if !ok {
+			return nil, 0, utils.Errorf("field %s expect integer, got %T", f.Path, value)
+		}
+		return uintToBits(v, f.Bits, f.endian), f.Bits, nil
+	}
+	data := utils.InterfaceToBytes(value)
+	if f.Bits%8 != 0 {
+		// 非整字节的字段只能保持原有宽度
+		buf := make([]byte, (f.Bits+7)/8)
+		copy(buf, data)
+		return buf, f.Bits, nil
+	}
+	if !resize && !f.Variable && uint64(len(data))*8 != f.Bits {
+		return nil, 0, utils.Errorf("field %s has fixed length %d bytes", f.Path, f.Bits/8)
+	}
+	return data, uint64(len(data)) * 8, nil
+}
+
+// serialize 替换字段 target 的数据后重新序列化，consistent 为 false 时 target 本身如果是长度字段不会被重新计算
+func (m *Mutator) serialize(target *Field, data []byte, bits uint64, resyncTarget bool) ([]byte, error) {
+	segments := make([]*segment, len(m.segments))
+	copy(segments, m.segments)
+	targetSegment := m.fieldSegment[target.index]
+	segments[targetSegment] = &segment{field: target, data: data, bits: bits}
+
+	if m.config.consistent {
+		for _, relation := range m.relations {
+			if relation.count {
+				continue
+			}
+			lengthField := relation.field
+			if lengthField == target && !resyncTarget {
+				continue
+			}
+			var span uint64
+			for i := m.fieldSegment[relation.start]; i <= m.fieldSegment[relation.end-1]; i++ {
+				span += segments[i].bits
+			}
+			length := (span + relation.factor - 1) / relation.factor
+			idx := m.fieldSegment[lengthField.index]
+			segments[idx] = &segment{field: lengthField, data: uintToBits(length, lengthField.Bits, lengthField.endian), bits: lengthField.Bits}
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := base.NewBitWriter(&buf)
+	for _, seg := range segments {
+		if err := writer.WriteBits(append([]byte(nil), seg.data...), seg.bits); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -132,6 +132,10 @@ func (d *DefParser) Operate(operator *Operator, node *base.Node) error {
 			return fmt.Errorf("new node by type error: %w", err)
 		}
 		*node = *typeNode
+		// 子节点的 parent 仍然指向 typeNode，需要指向替换后的 node，否则计算剩余长度时找不到当前节点
+		for _, child := range node.Children {
+			child.Cfg.SetItem(CfgParent, node)
+		}
 		return d.Operate(operator, node)
 	}
 	if node.Name == "root" {
@@ -231,6 +235,8 @@ func (d *DefParser) Operate(operator *Operator, node *base.Node) error {
 				return err
 			}
 		}
+		// 嵌套的列表结束时恢复外层列表的状态
+		outerInList, hasOuterList := node.Ctx.GetItem(CfgInList), node.Ctx.Has(CfgInList)
 		node.Ctx.SetItem(CfgInList, true)
 		if len(node.Children) == 0 {
 			return errors.New("get node element type error")
@@ -308,7 +314,11 @@ func (d *DefParser) Operate(operator *Operator, node *base.Node) error {
 			return fmt.Errorf("parse list node error: %w", err)
 		}
 		operator.PopBackup()
-		node.Ctx.DeleteItem(CfgInList)
+		if hasOuterList {
+			node.Ctx.SetItem(CfgInList, outerInList)
+		} else {
+			node.Ctx.DeleteItem(CfgInList)
+		}
 		return nil
 	}
 	if node.Cfg.GetBool(CfgIsTerminal) {
@@ -452,6 +452,11 @@ func getNodeByPath(node *base.Node, key string) *base.Node {
 	return targetNode
 }
 
+// GetNodeByRelativePath 按照规则中 length-from-field 等配置的写法查找节点，例如 `../Length`、`@Request.Length`
+func GetNodeByRelativePath(node *base.Node, key string) *base.Node {
+	return getNodeByPath(node, key)
+}
+
 func getNodeAttrByPath(node *base.Node, key string) (*base.Node, string) {
 	splits := strings.Split(key, ".")
 	node = getNodeByPath(node, strings.Join(splits[:len(splits)-1], "."))
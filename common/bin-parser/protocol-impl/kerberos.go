@@ -0,0 +1,1155 @@
+package protocol_impl
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/yaklang/yaklang/common/bin-parser/parser"
+	utils2 "github.com/yaklang/yaklang/common/bin-parser/utils"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Kerberos 消息类型，同时也是消息的 APPLICATION tag（RFC 4120）
+const (
+	KRB_TICKET   = 1
+	KRB_AS_REQ   = 10
+	KRB_AS_REP   = 11
+	KRB_TGS_REQ  = 12
+	KRB_TGS_REP  = 13
+	KRB_AP_REQ   = 14
+	KRB_ERROR    = 30
+	KerberosPVNO = 5
+
+	KRB_NT_PRINCIPAL = 1
+	KRB_NT_SRV_INST  = 2
+
+	PA_TGS_REQ       = 1
+	PA_ENC_TIMESTAMP = 2
+	PA_ETYPE_INFO2   = 19
+	PA_PAC_REQUEST   = 128
+
+	ETYPE_AES256_CTS_HMAC_SHA1_96 = 18
+	ETYPE_AES128_CTS_HMAC_SHA1_96 = 17
+	ETYPE_RC4_HMAC                = 23
+
+	KDC_ERR_C_PRINCIPAL_UNKNOWN = 6
+	KDC_ERR_PREAUTH_FAILED      = 24
+	KDC_ERR_PREAUTH_REQUIRED    = 25
+
+	// forwardable | renewable | canonicalize | renewable-ok
+	KerberosDefaultKDCOptions uint32 = 0x40810010
+)
+
+const kerberosTimeLayout = "20060102150405Z"
+
+// KerberosPrincipalName PrincipalName ::= SEQUENCE { name-type [0] Int32, name-string [1] SEQUENCE OF KerberosString }
+type KerberosPrincipalName struct {
+	NameType   int32
+	NameString []string
+}
+
+// KerberosEncryptedData EncryptedData ::= SEQUENCE { etype [0] Int32, kvno [1] UInt32 OPTIONAL, cipher [2] OCTET STRING }
+type KerberosEncryptedData struct {
+	EType  int32
+	KVNO   *uint32
+	Cipher []byte
+}
+
+// KerberosPAData PA-DATA ::= SEQUENCE { padata-type [1] Int32, padata-value [2] OCTET STRING }
+type KerberosPAData struct {
+	Type  int32
+	Value []byte
+}
+
+// KerberosHostAddress HostAddress ::= SEQUENCE { addr-type [0] Int32, address [1] OCTET STRING }
+type KerberosHostAddress struct {
+	AddrType int32
+	Address  []byte
+}
+
+// KerberosTicket Ticket ::= [APPLICATION 1] SEQUENCE { tkt-vno [0], realm [1], sname [2], enc-part [3] }
+type KerberosTicket struct {
+	TktVNO  int32
+	Realm   string
+	SName   KerberosPrincipalName
+	EncPart KerberosEncryptedData
+}
+
+// KerberosKDCReqBody KDC-REQ-BODY，时间字段为零值时表示不存在
+type KerberosKDCReqBody struct {
+	KDCOptions           uint32
+	CName                *KerberosPrincipalName
+	Realm                string
+	SName                *KerberosPrincipalName
+	From                 time.Time
+	Till                 time.Time
+	RTime                time.Time
+	Nonce                uint32
+	EType                []int32
+	Addresses            []*KerberosHostAddress
+	EncAuthorizationData *KerberosEncryptedData
+	AdditionalTickets    []*KerberosTicket
+}
+
+// KerberosKDCReq 是 AS-REQ（MsgType 10）和 TGS-REQ（MsgType 12）
+type KerberosKDCReq struct {
+	PVNO    int32
+	MsgType int32
+	PAData  []*KerberosPAData
+	ReqBody *KerberosKDCReqBody
+}
+
+// KerberosKDCRep 是 AS-REP（MsgType 11）和 TGS-REP（MsgType 13），EncPart 可以用于 AS-REP Roasting
+type KerberosKDCRep struct {
+	PVNO    int32
+	MsgType int32
+	PAData  []*KerberosPAData
+	CRealm  string
+	CName   KerberosPrincipalName
+	Ticket  *KerberosTicket
+	EncPart KerberosEncryptedData
+}
+
+// KerberosAPReq AP-REQ ::= [APPLICATION 14]，在 TGS-REQ 中作为 PA-TGS-REQ 的值
+type KerberosAPReq struct {
+	PVNO          int32
+	MsgType       int32
+	APOptions     uint32
+	Ticket        *KerberosTicket
+	Authenticator KerberosEncryptedData
+}
+
+// KerberosError KRB-ERROR ::= [APPLICATION 30]
+type KerberosError struct {
+	PVNO      int32
+	MsgType   int32
+	CTime     time.Time
+	CUSec     *int32
+	STime     time.Time
+	SUSec     int32
+	ErrorCode int32
+	CRealm    string
+	CName     *KerberosPrincipalName
+	Realm     string
+	SName     KerberosPrincipalName
+	EText     string
+	EData     []byte
+}
+
+// NewKerberosASReq 生成不带预认证数据的 AS-REQ，可以用于用户枚举和 AS-REP Roasting
+func NewKerberosASReq(realm string, user string, etypes ...int32) *KerberosKDCReq {
+	if len(etypes) == 0 {
+		etypes = []int32{ETYPE_AES256_CTS_HMAC_SHA1_96, ETYPE_AES128_CTS_HMAC_SHA1_96, ETYPE_RC4_HMAC}
+	}
+	till := time.Date(2037, 9, 13, 2, 48, 5, 0, time.UTC)
+	return &KerberosKDCReq{
+		PVNO:    KerberosPVNO,
+		MsgType: KRB_AS_REQ,
+		PAData:  []*KerberosPAData{NewKerberosPACRequest(true)},
+		ReqBody: &KerberosKDCReqBody{
+			KDCOptions: KerberosDefaultKDCOptions,
+			CName:      &KerberosPrincipalName{NameType: KRB_NT_PRINCIPAL, NameString: []string{user}},
+			Realm:      realm,
+			SName:      &KerberosPrincipalName{NameType: KRB_NT_SRV_INST, NameString: []string{"krbtgt", realm}},
+			Till:       till,
+			RTime:      till,
+			Nonce:      randomKerberosNonce(),
+			EType:      etypes,
+		},
+	}
+}
+
+// NewKerberosTGSReq 使用 TGT 和加密后的 Authenticator 生成请求服务票据的 TGS-REQ，service 为 SPN 的各个部分，例如 cifs、dc01.example.com
+func NewKerberosTGSReq(realm string, tgt *KerberosTicket, authenticator KerberosEncryptedData, service ...string) (*KerberosKDCReq, error) {
+	apReq, err := (&KerberosAPReq{
+		PVNO:          KerberosPVNO,
+		MsgType:       KRB_AP_REQ,
+		Ticket:        tgt,
+		Authenticator: authenticator,
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	till := time.Date(2037, 9, 13, 2, 48, 5, 0, time.UTC)
+	return &KerberosKDCReq{
+		PVNO:    KerberosPVNO,
+		MsgType: KRB_TGS_REQ,
+		PAData:  []*KerberosPAData{{Type: PA_TGS_REQ, Value: apReq}},
+		ReqBody: &KerberosKDCReqBody{
+			KDCOptions: KerberosDefaultKDCOptions,
+			Realm:      realm,
+			SName:      &KerberosPrincipalName{NameType: KRB_NT_SRV_INST, NameString: service},
+			Till:       till,
+			Nonce:      randomKerberosNonce(),
+			EType:      []int32{ETYPE_AES256_CTS_HMAC_SHA1_96, ETYPE_AES128_CTS_HMAC_SHA1_96, ETYPE_RC4_HMAC},
+		},
+	}, nil
+}
+
+// NewKerberosPACRequest 生成 PA-PAC-REQUEST
+func NewKerberosPACRequest(includePAC bool) *KerberosPAData {
+	value := byte(0x00)
+	if includePAC {
+		value = 0xff
+	}
+	// KERB-PA-PAC-REQUEST ::= SEQUENCE { include-pac [0] BOOLEAN }
+	return &KerberosPAData{Type: PA_PAC_REQUEST, Value: []byte{0x30, 0x05, 0xa0, 0x03, 0x01, 0x01, value}}
+}
+
+func randomKerberosNonce() uint32 {
+	n, err := rand.Int(rand.Reader, big.NewInt(1<<31))
+	if err != nil {
+		return uint32(time.Now().UnixNano() & 0x7fffffff)
+	}
+	return uint32(n.Int64())
+}
+
+// GetPAData 返回指定类型的 PA-DATA
+func (k *KerberosKDCReq) GetPAData(typ int32) (*KerberosPAData, bool) {
+	return findKerberosPAData(k.PAData, typ)
+}
+
+// GetAPReq 返回 TGS-REQ 中 PA-TGS-REQ 携带的 AP-REQ
+func (k *KerberosKDCReq) GetAPReq() (*KerberosAPReq, error) {
+	pa, ok := k.GetPAData(PA_TGS_REQ)
+	if !ok {
+		return nil, errors.New("PA-TGS-REQ not found")
+	}
+	msg, err := ParseKerberosMessage(pa.Value)
+	if err != nil {
+		return nil, err
+	}
+	apReq, ok := msg.(*KerberosAPReq)
+	if !ok {
+		return nil, utils.Errorf("PA-TGS-REQ contains %T", msg)
+	}
+	return apReq, nil
+}
+
+func (k *KerberosKDCRep) GetPAData(typ int32) (*KerberosPAData, bool) {
+	return findKerberosPAData(k.PAData, typ)
+}
+
+func findKerberosPAData(list []*KerberosPAData, typ int32) (*KerberosPAData, bool) {
+	for _, pa := range list {
+		if pa.Type == typ {
+			return pa, true
+		}
+	}
+	return nil, false
+}
+
+// KerberosMessage 是可以通过 kerberos 规则生成的 Kerberos 消息
+type KerberosMessage interface {
+	Marshal() ([]byte, error)
+	berElement() (*BERElement, error)
+}
+
+func (k *KerberosKDCReq) Marshal() ([]byte, error) { return marshalKerberosMessage(k) }
+func (k *KerberosKDCRep) Marshal() ([]byte, error) { return marshalKerberosMessage(k) }
+func (k *KerberosAPReq) Marshal() ([]byte, error)  { return marshalKerberosMessage(k) }
+func (k *KerberosTicket) Marshal() ([]byte, error) { return marshalKerberosMessage(k) }
+func (k *KerberosError) Marshal() ([]byte, error)  { return marshalKerberosMessage(k) }
+
+// ReadKerberosTCPMessage 读取并解析一个 TCP 传输的 Kerberos 消息（4 字节 Record Mark + 消息）
+func ReadKerberosTCPMessage(r io.Reader) (KerberosMessage, error) {
+	node, err := parser.ParseBinary(r, "application-layer.kerberos", "Record")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := utils2.NodeToData(node).(map[string]any)
+	if !ok {
+		return nil, errors.New("node result data format is invalid")
+	}
+	element, err := berElementFromNodeData(utils.MapGetRaw(res, "Message"))
+	if err != nil {
+		return nil, err
+	}
+	return decodeKerberosMessage(element)
+}
+
+// MarshalKerberosTCP 生成 TCP 传输的 Kerberos 消息，在消息前添加 Record Mark
+func MarshalKerberosTCP(message KerberosMessage) ([]byte, error) {
+	element, err := message.berElement()
+	if err != nil {
+		return nil, err
+	}
+	data, length, err := berElementToNodeData(element)
+	if err != nil {
+		return nil, err
+	}
+	node, err := parser.GenerateBinary(map[string]any{
+		"Length":  uint32(length),
+		"Message": data,
+	}, "application-layer.kerberos", "Record")
+	if err != nil {
+		return nil, err
+	}
+	return utils2.NodeToBytes(node), nil
+}
+
+// ParseKerberosMessage 根据 APPLICATION tag 解析 Kerberos 消息
+// 返回 *KerberosKDCReq、*KerberosKDCRep、*KerberosAPReq、*KerberosError 或 *KerberosTicket
+func ParseKerberosMessage(data []byte) (KerberosMessage, error) {
+	node, err := parser.ParseBinary(bytes.NewReader(data), "application-layer.kerberos", "Message")
+	if err != nil {
+		return nil, err
+	}
+	element, err := berElementFromNodeData(utils2.NodeToData(node))
+	if err != nil {
+		return nil, err
+	}
+	return decodeKerberosMessage(element)
+}
+
+func marshalKerberosMessage(message KerberosMessage) ([]byte, error) {
+	element, err := message.berElement()
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := berElementToNodeData(element)
+	if err != nil {
+		return nil, err
+	}
+	node, err := parser.GenerateBinary(data, "application-layer.kerberos", "Message")
+	if err != nil {
+		return nil, err
+	}
+	return utils2.NodeToBytes(node), nil
+}
+
+func decodeKerberosMessage(element *BERElement) (KerberosMessage, error) {
+	if element.Type.Class != BERApplicationData {
+		return nil, utils.Errorf("invalid kerberos message class: %d", element.Type.Class)
+	}
+	switch element.Type.TagNumber {
+	case KRB_AS_REQ, KRB_TGS_REQ:
+		return decodeKerberosKDCReq(element)
+	case KRB_AS_REP, KRB_TGS_REP:
+		return decodeKerberosKDCRep(element)
+	case KRB_AP_REQ:
+		return decodeKerberosAPReq(element)
+	case KRB_ERROR:
+		return decodeKerberosError(element)
+	case KRB_TICKET:
+		return decodeKerberosTicket(element)
+	default:
+		return nil, utils.Errorf("unsupported kerberos message type: %d", element.Type.TagNumber)
+	}
+}
+
+// berElementFromNodeData 将 kerberos 规则中 Element 节点的解析结果转换为 BERElement，
+// 构造类型元素的 Value 为 []*BERElement，基本类型元素的 Value 为 []byte
+func berElementFromNodeData(d any) (*BERElement, error) {
+	m, ok := d.(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid ber element data")
+	}
+	identifier, ok := utils.MapGetRaw(m, "Identifier").(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid ber element identifier")
+	}
+	element := &BERElement{Type: &BERElementType{
+		Class:       byte(utils.InterfaceToInt(identifier["Class"])),
+		Constructed: utils.InterfaceToInt(identifier["Constructed"]) == 1,
+		TagNumber:   byte(utils.InterfaceToInt(identifier["Tag"])),
+	}}
+	if !element.Type.Constructed {
+		element.Value = utils.InterfaceToBytes(utils.MapGetRaw(m, "Value"))
+		return element, nil
+	}
+	children := []*BERElement{}
+	if list, ok := utils.MapGetRaw(m, "Children").([]any); ok {
+		for _, item := range list {
+			child, err := berElementFromNodeData(item)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+	}
+	element.Value = children
+	return element, nil
+}
+
+// berElementToNodeData 将 BERElement 转换为 kerberos 规则中 Element 节点的数据，长度使用 DER 编码，
+// 同时返回元素编码后的长度
+func berElementToNodeData(element *BERElement) (map[string]any, int, error) {
+	if element.Type == nil {
+		return nil, 0, errors.New("ber element type is nil")
+	}
+	if element.Type.TagNumber >= 0x1f {
+		return nil, 0, utils.Errorf("ber tag number %d is not supported", element.Type.TagNumber)
+	}
+	var constructed uint8
+	data := map[string]any{}
+	contentLength := 0
+	switch ret := element.Value.(type) {
+	case []*BERElement:
+		constructed = 1
+		children := []any{}
+		for _, child := range ret {
+			childData, l, err := berElementToNodeData(child)
+			if err != nil {
+				return nil, 0, err
+			}
+			children = append(children, childData)
+			contentLength += l
+		}
+		data["Children"] = children
+	case []byte:
+		data["Value"] = ret
+		contentLength = len(ret)
+	default:
+		return nil, 0, utils.Errorf("not support ber element value type: %T", element.Value)
+	}
+	data["Identifier"] = map[string]any{
+		"Class":       element.Type.Class,
+		"Constructed": constructed,
+		"Tag":         element.Type.TagNumber,
+	}
+	length := map[string]any{"Form": uint8(contentLength)}
+	lengthSize := 1
+	if contentLength >= 0x80 {
+		var octets []byte
+		for l := contentLength; l > 0; l >>= 8 {
+			octets = append([]byte{byte(l)}, octets...)
+		}
+		length["Form"] = uint8(0x80 | len(octets))
+		length["Octets"] = octets
+		lengthSize += len(octets)
+	}
+	data["Length"] = length
+	return data, 1 + lengthSize + contentLength, nil
+}
+
+func (k *KerberosKDCReq) berElement() (*BERElement, error) {
+	if k.ReqBody == nil {
+		return nil, errors.New("kdc-req body is nil")
+	}
+	fields := []*BERElement{
+		berContext(1, berInteger(int64(k.PVNO))),
+		berContext(2, berInteger(int64(k.MsgType))),
+	}
+	if len(k.PAData) > 0 {
+		fields = append(fields, berContext(3, encodeKerberosPADataList(k.PAData)))
+	}
+	body, err := encodeKerberosKDCReqBody(k.ReqBody)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, berContext(4, body))
+	return berApplication(int(k.MsgType), berSequence(fields...)), nil
+}
+
+func (k *KerberosKDCRep) berElement() (*BERElement, error) {
+	if k.Ticket == nil {
+		return nil, errors.New("kdc-rep ticket is nil")
+	}
+	fields := []*BERElement{
+		berContext(0, berInteger(int64(k.PVNO))),
+		berContext(1, berInteger(int64(k.MsgType))),
+	}
+	if len(k.PAData) > 0 {
+		fields = append(fields, berContext(2, encodeKerberosPADataList(k.PAData)))
+	}
+	fields = append(fields,
+		berContext(3, berGeneralString(k.CRealm)),
+		berContext(4, encodeKerberosPrincipalName(&k.CName)),
+		berContext(5, encodeKerberosTicket(k.Ticket)),
+		berContext(6, encodeKerberosEncryptedData(&k.EncPart)),
+	)
+	return berApplication(int(k.MsgType), berSequence(fields...)), nil
+}
+
+func (k *KerberosAPReq) berElement() (*BERElement, error) {
+	if k.Ticket == nil {
+		return nil, errors.New("ap-req ticket is nil")
+	}
+	return berApplication(KRB_AP_REQ, berSequence(
+		berContext(0, berInteger(int64(k.PVNO))),
+		berContext(1, berInteger(int64(k.MsgType))),
+		berContext(2, berBitString(k.APOptions)),
+		berContext(3, encodeKerberosTicket(k.Ticket)),
+		berContext(4, encodeKerberosEncryptedData(&k.Authenticator)),
+	)), nil
+}
+
+func (k *KerberosTicket) berElement() (*BERElement, error) {
+	return encodeKerberosTicket(k), nil
+}
+
+func (k *KerberosError) berElement() (*BERElement, error) {
+	fields := []*BERElement{
+		berContext(0, berInteger(int64(k.PVNO))),
+		berContext(1, berInteger(int64(k.MsgType))),
+	}
+	if !k.CTime.IsZero() {
+		fields = append(fields, berContext(2, berKerberosTime(k.CTime)))
+	}
+	if k.CUSec != nil {
+		fields = append(fields, berContext(3, berInteger(int64(*k.CUSec))))
+	}
+	fields = append(fields,
+		berContext(4, berKerberosTime(k.STime)),
+		berContext(5, berInteger(int64(k.SUSec))),
+		berContext(6, berInteger(int64(k.ErrorCode))),
+	)
+	if k.CRealm != "" {
+		fields = append(fields, berContext(7, berGeneralString(k.CRealm)))
+	}
+	if k.CName != nil {
+		fields = append(fields, berContext(8, encodeKerberosPrincipalName(k.CName)))
+	}
+	fields = append(fields,
+		berContext(9, berGeneralString(k.Realm)),
+		berContext(10, encodeKerberosPrincipalName(&k.SName)),
+	)
+	if k.EText != "" {
+		fields = append(fields, berContext(11, berGeneralString(k.EText)))
+	}
+	if len(k.EData) > 0 {
+		fields = append(fields, berContext(12, berOctetString(k.EData)))
+	}
+	return berApplication(KRB_ERROR, berSequence(fields...)), nil
+}
+
+func encodeKerberosKDCReqBody(b *KerberosKDCReqBody) (*BERElement, error) {
+	fields := []*BERElement{berContext(0, berBitString(b.KDCOptions))}
+	if b.CName != nil {
+		fields = append(fields, berContext(1, encodeKerberosPrincipalName(b.CName)))
+	}
+	fields = append(fields, berContext(2, berGeneralString(b.Realm)))
+	if b.SName != nil {
+		fields = append(fields, berContext(3, encodeKerberosPrincipalName(b.SName)))
+	}
+	if !b.From.IsZero() {
+		fields = append(fields, berContext(4, berKerberosTime(b.From)))
+	}
+	if b.Till.IsZero() {
+		return nil, errors.New("kdc-req-body till is required")
+	}
+	fields = append(fields, berContext(5, berKerberosTime(b.Till)))
+	if !b.RTime.IsZero() {
+		fields = append(fields, berContext(6, berKerberosTime(b.RTime)))
+	}
+	fields = append(fields, berContext(7, berInteger(int64(b.Nonce))))
+	var etypes []*BERElement
+	for _, etype := range b.EType {
+		etypes = append(etypes, berInteger(int64(etype)))
+	}
+	fields = append(fields, berContext(8, berSequence(etypes...)))
+	if len(b.Addresses) > 0 {
+		var addresses []*BERElement
+		for _, addr := range b.Addresses {
+			addresses = append(addresses, berSequence(
+				berContext(0, berInteger(int64(addr.AddrType))),
+				berContext(1, berOctetString(addr.Address)),
+			))
+		}
+		fields = append(fields, berContext(9, berSequence(addresses...)))
+	}
+	if b.EncAuthorizationData != nil {
+		fields = append(fields, berContext(10, encodeKerberosEncryptedData(b.EncAuthorizationData)))
+	}
+	if len(b.AdditionalTickets) > 0 {
+		var tickets []*BERElement
+		for _, ticket := range b.AdditionalTickets {
+			tickets = append(tickets, encodeKerberosTicket(ticket))
+		}
+		fields = append(fields, berContext(11, berSequence(tickets...)))
+	}
+	return berSequence(fields...), nil
+}
+
+func encodeKerberosPADataList(list []*KerberosPAData) *BERElement {
+	var items []*BERElement
+	for _, pa := range list {
+		items = append(items, berSequence(
+			berContext(1, berInteger(int64(pa.Type))),
+			berContext(2, berOctetString(pa.Value)),
+		))
+	}
+	return berSequence(items...)
+}
+
+func encodeKerberosPrincipalName(p *KerberosPrincipalName) *BERElement {
+	var names []*BERElement
+	for _, name := range p.NameString {
+		names = append(names, berGeneralString(name))
+	}
+	return berSequence(
+		berContext(0, berInteger(int64(p.NameType))),
+		berContext(1, berSequence(names...)),
+	)
+}
+
+func encodeKerberosEncryptedData(e *KerberosEncryptedData) *BERElement {
+	fields := []*BERElement{berContext(0, berInteger(int64(e.EType)))}
+	if e.KVNO != nil {
+		fields = append(fields, berContext(1, berInteger(int64(*e.KVNO))))
+	}
+	fields = append(fields, berContext(2, berOctetString(e.Cipher)))
+	return berSequence(fields...)
+}
+
+func encodeKerberosTicket(t *KerberosTicket) *BERElement {
+	return berApplication(KRB_TICKET, berSequence(
+		berContext(0, berInteger(int64(t.TktVNO))),
+		berContext(1, berGeneralString(t.Realm)),
+		berContext(2, encodeKerberosPrincipalName(&t.SName)),
+		berContext(3, encodeKerberosEncryptedData(&t.EncPart)),
+	))
+}
+
+func decodeKerberosKDCReq(element *BERElement) (*KerberosKDCReq, error) {
+	seq, err := berUnwrapSequence(element)
+	if err != nil {
+		return nil, err
+	}
+	req := &KerberosKDCReq{}
+	if req.PVNO, err = berContextInt32(seq, 1); err != nil {
+		return nil, utils.Wrap(err, "pvno")
+	}
+	if req.MsgType, err = berContextInt32(seq, 2); err != nil {
+		return nil, utils.Wrap(err, "msg-type")
+	}
+	if padata, ok := berContextField(seq, 3); ok {
+		if req.PAData, err = decodeKerberosPADataList(padata); err != nil {
+			return nil, err
+		}
+	}
+	body, ok := berContextField(seq, 4)
+	if !ok {
+		return nil, errors.New("kdc-req body not found")
+	}
+	if req.ReqBody, err = decodeKerberosKDCReqBody(body); err != nil {
+		return nil, utils.Wrap(err, "req-body")
+	}
+	return req, nil
+}
+
+func decodeKerberosKDCReqBody(element *BERElement) (*KerberosKDCReqBody, error) {
+	seq, err := berChildren(element)
+	if err != nil {
+		return nil, err
+	}
+	body := &KerberosKDCReqBody{}
+	options, ok := berContextField(seq, 0)
+	if !ok {
+		return nil, errors.New("kdc-options not found")
+	}
+	if body.KDCOptions, err = berBitStringValue(options); err != nil {
+		return nil, err
+	}
+	if cname, ok := berContextField(seq, 1); ok {
+		if body.CName, err = decodeKerberosPrincipalName(cname); err != nil {
+			return nil, err
+		}
+	}
+	if body.Realm, err = berContextString(seq, 2); err != nil {
+		return nil, utils.Wrap(err, "realm")
+	}
+	if sname, ok := berContextField(seq, 3); ok {
+		if body.SName, err = decodeKerberosPrincipalName(sname); err != nil {
+			return nil, err
+		}
+	}
+	for tag, t := range map[int]*time.Time{4: &body.From, 5: &body.Till, 6: &body.RTime} {
+		if v, ok := berContextField(seq, tag); ok {
+			if *t, err = berKerberosTimeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	nonce, err := berContextInt(seq, 7)
+	if err != nil {
+		return nil, utils.Wrap(err, "nonce")
+	}
+	body.Nonce = uint32(nonce)
+	etypes, ok := berContextField(seq, 8)
+	if !ok {
+		return nil, errors.New("etype not found")
+	}
+	items, err := berChildren(etypes)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		v, err := berIntegerValue(item)
+		if err != nil {
+			return nil, err
+		}
+		body.EType = append(body.EType, int32(v))
+	}
+	if addresses, ok := berContextField(seq, 9); ok {
+		items, err := berChildren(addresses)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			fields, err := berChildren(item)
+			if err != nil {
+				return nil, err
+			}
+			addr := &KerberosHostAddress{}
+			if addr.AddrType, err = berContextInt32(fields, 0); err != nil {
+				return nil, err
+			}
+			if addr.Address, err = berContextBytes(fields, 1); err != nil {
+				return nil, err
+			}
+			body.Addresses = append(body.Addresses, addr)
+		}
+	}
+	if authData, ok := berContextField(seq, 10); ok {
+		if body.EncAuthorizationData, err = decodeKerberosEncryptedData(authData); err != nil {
+			return nil, err
+		}
+	}
+	if tickets, ok := berContextField(seq, 11); ok {
+		items, err := berChildren(tickets)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			ticket, err := decodeKerberosTicket(item)
+			if err != nil {
+				return nil, err
+			}
+			body.AdditionalTickets = append(body.AdditionalTickets, ticket)
+		}
+	}
+	return body, nil
+}
+
+func decodeKerberosKDCRep(element *BERElement) (*KerberosKDCRep, error) {
+	seq, err := berUnwrapSequence(element)
+	if err != nil {
+		return nil, err
+	}
+	rep := &KerberosKDCRep{}
+	if rep.PVNO, err = berContextInt32(seq, 0); err != nil {
+		return nil, utils.Wrap(err, "pvno")
+	}
+	if rep.MsgType, err = berContextInt32(seq, 1); err != nil {
+		return nil, utils.Wrap(err, "msg-type")
+	}
+	if padata, ok := berContextField(seq, 2); ok {
+		if rep.PAData, err = decodeKerberosPADataList(padata); err != nil {
+			return nil, err
+		}
+	}
+	if rep.CRealm, err = berContextString(seq, 3); err != nil {
+		return nil, utils.Wrap(err, "crealm")
+	}
+	cname, ok := berContextField(seq, 4)
+	if !ok {
+		return nil, errors.New("cname not found")
+	}
+	name, err := decodeKerberosPrincipalName(cname)
+	if err != nil {
+		return nil, err
+	}
+	rep.CName = *name
+	ticket, ok := berContextField(seq, 5)
+	if !ok {
+		return nil, errors.New("ticket not found")
+	}
+	if rep.Ticket, err = decodeKerberosTicket(ticket); err != nil {
+		return nil, err
+	}
+	encPart, ok := berContextField(seq, 6)
+	if !ok {
+		return nil, errors.New("enc-part not found")
+	}
+	data, err := decodeKerberosEncryptedData(encPart)
+	if err != nil {
+		return nil, err
+	}
+	rep.EncPart = *data
+	return rep, nil
+}
+
+func decodeKerberosAPReq(element *BERElement) (*KerberosAPReq, error) {
+	seq, err := berUnwrapSequence(element)
+	if err != nil {
+		return nil, err
+	}
+	req := &KerberosAPReq{}
+	if req.PVNO, err = berContextInt32(seq, 0); err != nil {
+		return nil, err
+	}
+	if req.MsgType, err = berContextInt32(seq, 1); err != nil {
+		return nil, err
+	}
+	options, ok := berContextField(seq, 2)
+	if !ok {
+		return nil, errors.New("ap-options not found")
+	}
+	if req.APOptions, err = berBitStringValue(options); err != nil {
+		return nil, err
+	}
+	ticket, ok := berContextField(seq, 3)
+	if !ok {
+		return nil, errors.New("ticket not found")
+	}
+	if req.Ticket, err = decodeKerberosTicket(ticket); err != nil {
+		return nil, err
+	}
+	authenticator, ok := berContextField(seq, 4)
+	if !ok {
+		return nil, errors.New("authenticator not found")
+	}
+	data, err := decodeKerberosEncryptedData(authenticator)
+	if err != nil {
+		return nil, err
+	}
+	req.Authenticator = *data
+	return req, nil
+}
+
+func decodeKerberosError(element *BERElement) (*KerberosError, error) {
+	seq, err := berUnwrapSequence(element)
+	if err != nil {
+		return nil, err
+	}
+	krbErr := &KerberosError{}
+	if krbErr.PVNO, err = berContextInt32(seq, 0); err != nil {
+		return nil, err
+	}
+	if krbErr.MsgType, err = berContextInt32(seq, 1); err != nil {
+		return nil, err
+	}
+	if v, ok := berContextField(seq, 2); ok {
+		if krbErr.CTime, err = berKerberosTimeValue(v); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := berContextField(seq, 3); ok {
+		cusec, err := berContextInt32(seq, 3)
+		if err != nil {
+			return nil, err
+		}
+		krbErr.CUSec = &cusec
+	}
+	stime, ok := berContextField(seq, 4)
+	if !ok {
+		return nil, errors.New("stime not found")
+	}
+	if krbErr.STime, err = berKerberosTimeValue(stime); err != nil {
+		return nil, err
+	}
+	if krbErr.SUSec, err = berContextInt32(seq, 5); err != nil {
+		return nil, err
+	}
+	if krbErr.ErrorCode, err = berContextInt32(seq, 6); err != nil {
+		return nil, err
+	}
+	if _, ok := berContextField(seq, 7); ok {
+		if krbErr.CRealm, err = berContextString(seq, 7); err != nil {
+			return nil, err
+		}
+	}
+	if cname, ok := berContextField(seq, 8); ok {
+		if krbErr.CName, err = decodeKerberosPrincipalName(cname); err != nil {
+			return nil, err
+		}
+	}
+	if krbErr.Realm, err = berContextString(seq, 9); err != nil {
+		return nil, err
+	}
+	sname, ok := berContextField(seq, 10)
+	if !ok {
+		return nil, errors.New("sname not found")
+	}
+	name, err := decodeKerberosPrincipalName(sname)
+	if err != nil {
+		return nil, err
+	}
+	krbErr.SName = *name
+	if _, ok := berContextField(seq, 11); ok {
+		if krbErr.EText, err = berContextString(seq, 11); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := berContextField(seq, 12); ok {
+		if krbErr.EData, err = berContextBytes(seq, 12); err != nil {
+			return nil, err
+		}
+	}
+	return krbErr, nil
+}
+
+func decodeKerberosTicket(element *BERElement) (*KerberosTicket, error) {
+	if element.Type.Class != BERApplicationData || element.Type.TagNumber != KRB_TICKET {
+		return nil, errors.New("invalid ticket tag")
+	}
+	seq, err := berUnwrapSequence(element)
+	if err != nil {
+		return nil, err
+	}
+	ticket := &KerberosTicket{}
+	if ticket.TktVNO, err = berContextInt32(seq, 0); err != nil {
+		return nil, err
+	}
+	if ticket.Realm, err = berContextString(seq, 1); err != nil {
+		return nil, err
+	}
+	sname, ok := berContextField(seq, 2)
+	if !ok {
+		return nil, errors.New("ticket sname not found")
+	}
+	name, err := decodeKerberosPrincipalName(sname)
+	if err != nil {
+		return nil, err
+	}
+	ticket.SName = *name
+	encPart, ok := berContextField(seq, 3)
+	if !ok {
+		return nil, errors.New("ticket enc-part not found")
+	}
+	data, err := decodeKerberosEncryptedData(encPart)
+	if err != nil {
+		return nil, err
+	}
+	ticket.EncPart = *data
+	return ticket, nil
+}
+
+func decodeKerberosPADataList(element *BERElement) ([]*KerberosPAData, error) {
+	items, err := berChildren(element)
+	if err != nil {
+		return nil, err
+	}
+	var list []*KerberosPAData
+	for _, item := range items {
+		fields, err := berChildren(item)
+		if err != nil {
+			return nil, err
+		}
+		pa := &KerberosPAData{}
+		if pa.Type, err = berContextInt32(fields, 1); err != nil {
+			return nil, err
+		}
+		if pa.Value, err = berContextBytes(fields, 2); err != nil {
+			return nil, err
+		}
+		list = append(list, pa)
+	}
+	return list, nil
+}
+
+func decodeKerberosPrincipalName(element *BERElement) (*KerberosPrincipalName, error) {
+	fields, err := berChildren(element)
+	if err != nil {
+		return nil, err
+	}
+	name := &KerberosPrincipalName{}
+	if name.NameType, err = berContextInt32(fields, 0); err != nil {
+		return nil, err
+	}
+	names, ok := berContextField(fields, 1)
+	if !ok {
+		return nil, errors.New("name-string not found")
+	}
+	items, err := berChildren(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		s, err := berBytesValue(item)
+		if err != nil {
+			return nil, err
+		}
+		name.NameString = append(name.NameString, string(s))
+	}
+	return name, nil
+}
+
+func decodeKerberosEncryptedData(element *BERElement) (*KerberosEncryptedData, error) {
+	fields, err := berChildren(element)
+	if err != nil {
+		return nil, err
+	}
+	data := &KerberosEncryptedData{}
+	if data.EType, err = berContextInt32(fields, 0); err != nil {
+		return nil, err
+	}
+	if _, ok := berContextField(fields, 1); ok {
+		kvno, err := berContextInt(fields, 1)
+		if err != nil {
+			return nil, err
+		}
+		v := uint32(kvno)
+		data.KVNO = &v
+	}
+	if data.Cipher, err = berContextBytes(fields, 2); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func berType(class byte, constructed bool, tag int) *BERElementType {
+	return &BERElementType{Class: class, Constructed: constructed, TagNumber: byte(tag)}
+}
+
+func berSequence(children ...*BERElement) *BERElement {
+	if children == nil {
+		children = []*BERElement{}
+	}
+	return &BERElement{Type: berType(BERUniversalData, true, 0x10), Value: children}
+}
+
+func berContext(tag int, child *BERElement) *BERElement {
+	return &BERElement{Type: berType(BERContextSpecificData, true, tag), Value: []*BERElement{child}}
+}
+
+func berApplication(tag int, child *BERElement) *BERElement {
+	return &BERElement{Type: berType(BERApplicationData, true, tag), Value: []*BERElement{child}}
+}
+
+func berInteger(v int64) *BERElement {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(v))
+	// 去掉多余的符号位字节，得到最短的补码表示
+	for len(buf) > 1 && ((buf[0] == 0x00 && buf[1]&0x80 == 0) || (buf[0] == 0xff && buf[1]&0x80 != 0)) {
+		buf = buf[1:]
+	}
+	return &BERElement{Type: berType(BERUniversalData, false, BERInteger), Value: buf}
+}
+
+func berGeneralString(s string) *BERElement {
+	return &BERElement{Type: berType(BERUniversalData, false, BERString), Value: []byte(s)}
+}
+
+func berOctetString(b []byte) *BERElement {
+	if b == nil {
+		b = []byte{}
+	}
+	return &BERElement{Type: berType(BERUniversalData, false, 0x04), Value: b}
+}
+
+// berBitString 生成 Kerberos 中使用的 32 位 BIT STRING（KDCOptions、APOptions）
+func berBitString(v uint32) *BERElement {
+	buf := make([]byte, 5)
+	binary.BigEndian.PutUint32(buf[1:], v)
+	return &BERElement{Type: berType(BERUniversalData, false, 0x03), Value: buf}
+}
+
+func berKerberosTime(t time.Time) *BERElement {
+	return &BERElement{Type: berType(BERUniversalData, false, 0x18), Value: []byte(t.UTC().Format(kerberosTimeLayout))}
+}
+
+func berChildren(element *BERElement) ([]*BERElement, error) {
+	children, ok := element.Value.([]*BERElement)
+	if !ok {
+		return nil, errors.New("ber element is not constructed")
+	}
+	return children, nil
+}
+
+// berUnwrapSequence 返回 APPLICATION 或 context tag 包装的 SEQUENCE 中的字段
+func berUnwrapSequence(element *BERElement) ([]*BERElement, error) {
+	children, err := berChildren(element)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) != 1 {
+		return nil, errors.New("invalid explicit tagged element")
+	}
+	return berChildren(children[0])
+}
+
+// berContextField 查找 SEQUENCE 中 context tag 为 tag 的字段，返回其中的元素
+func berContextField(fields []*BERElement, tag int) (*BERElement, bool) {
+	for _, field := range fields {
+		if field.Type.Class != BERContextSpecificData || int(field.Type.TagNumber) != tag {
+			continue
+		}
+		children, ok := field.Value.([]*BERElement)
+		if !ok || len(children) != 1 {
+			return nil, false
+		}
+		return children[0], true
+	}
+	return nil, false
+}
+
+func berBytesValue(element *BERElement) ([]byte, error) {
+	v, ok := element.Value.([]byte)
+	if !ok {
+		return nil, errors.New("ber element is not primitive")
+	}
+	return v, nil
+}
+
+func berIntegerValue(element *BERElement) (int64, error) {
+	buf, err := berBytesValue(element)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 0 || len(buf) > 8 {
+		return 0, errors.New("invalid ber integer")
+	}
+	var v int64
+	if buf[0]&0x80 != 0 {
+		v = -1
+	}
+	for _, b := range buf {
+		v = v<<8 | int64(b)
+	}
+	return v, nil
+}
+
+func berBitStringValue(element *BERElement) (uint32, error) {
+	buf, err := berBytesValue(element)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) < 1 {
+		return 0, errors.New("invalid ber bit string")
+	}
+	bits := make([]byte, 4)
+	copy(bits, buf[1:])
+	return binary.BigEndian.Uint32(bits), nil
+}
+
+func berKerberosTimeValue(element *BERElement) (time.Time, error) {
+	buf, err := berBytesValue(element)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(kerberosTimeLayout, string(buf))
+}
+
+func berContextInt(fields []*BERElement, tag int) (int64, error) {
+	element, ok := berContextField(fields, tag)
+	if !ok {
+		return 0, utils.Errorf("field [%d] not found", tag)
+	}
+	return berIntegerValue(element)
+}
+
+func berContextInt32(fields []*BERElement, tag int) (int32, error) {
+	v, err := berContextInt(fields, tag)
+	return int32(v), err
+}
+
+func berContextBytes(fields []*BERElement, tag int) ([]byte, error) {
+	element, ok := berContextField(fields, tag)
+	if !ok {
+		return nil, utils.Errorf("field [%d] not found", tag)
+	}
+	return berBytesValue(element)
+}
+
+func berContextString(fields []*BERElement, tag int) (string, error) {
+	v, err := berContextBytes(fields, tag)
+	return string(v), err
+}
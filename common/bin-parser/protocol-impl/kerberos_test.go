@@ -0,0 +1,83 @@
+package protocol_impl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKerberosPcapRoundTrip(t *testing.T) {
+	payloads, _ := readTCPPayloads(t, "testdata/kerberos_as_tgs.pcap", 88)
+	require.Len(t, payloads, 6)
+	var messages []KerberosMessage
+	for i, payload := range payloads {
+		msg, err := ReadKerberosTCPMessage(bytes.NewReader(payload))
+		require.NoError(t, err)
+		framed, err := MarshalKerberosTCP(msg)
+		require.NoError(t, err)
+		require.Equal(t, payload, framed, "message %d", i)
+		messages = append(messages, msg)
+	}
+	raw, err := messages[0].Marshal()
+	require.NoError(t, err)
+	require.Equal(t, payloads[0][4:], raw)
+	msg, err := ParseKerberosMessage(raw)
+	require.NoError(t, err)
+	require.Equal(t, messages[0], msg)
+
+	asReq := messages[0].(*KerberosKDCReq)
+	require.Equal(t, int32(KRB_AS_REQ), asReq.MsgType)
+	require.Equal(t, []string{"alice"}, asReq.ReqBody.CName.NameString)
+	require.Equal(t, []string{"krbtgt", "CORP.EXAMPLE.COM"}, asReq.ReqBody.SName.NameString)
+	require.Equal(t, []int32{18, 17, 23, 24, -135, 3}, asReq.ReqBody.EType)
+	require.Equal(t, KerberosDefaultKDCOptions, asReq.ReqBody.KDCOptions)
+	_, ok := asReq.GetPAData(PA_PAC_REQUEST)
+	require.True(t, ok)
+
+	krbErr := messages[1].(*KerberosError)
+	require.Equal(t, int32(KDC_ERR_PREAUTH_REQUIRED), krbErr.ErrorCode)
+	require.Equal(t, "CORP.EXAMPLE.COM", krbErr.Realm)
+
+	asRep := messages[3].(*KerberosKDCRep)
+	require.Equal(t, int32(KRB_AS_REP), asRep.MsgType)
+	require.Equal(t, int32(ETYPE_AES256_CTS_HMAC_SHA1_96), asRep.EncPart.EType)
+	require.Equal(t, uint32(3), *asRep.EncPart.KVNO)
+	require.Equal(t, "CORP.EXAMPLE.COM", asRep.Ticket.Realm)
+
+	tgsReq := messages[4].(*KerberosKDCReq)
+	require.Equal(t, int32(KRB_TGS_REQ), tgsReq.MsgType)
+	require.Nil(t, tgsReq.ReqBody.CName)
+	apReq, err := tgsReq.GetAPReq()
+	require.NoError(t, err)
+	require.Equal(t, asRep.Ticket, apReq.Ticket)
+
+	tgsRep := messages[5].(*KerberosKDCRep)
+	require.Equal(t, []string{"cifs", "dc01.corp.example.com"}, tgsRep.Ticket.SName.NameString)
+	require.Nil(t, tgsRep.EncPart.KVNO)
+}
+
+func TestKerberosGenerate(t *testing.T) {
+	asReq := NewKerberosASReq("EXAMPLE.COM", "bob")
+	raw, err := asReq.Marshal()
+	require.NoError(t, err)
+	msg, err := ParseKerberosMessage(raw)
+	require.NoError(t, err)
+	require.Equal(t, asReq, msg)
+
+	tgt := &KerberosTicket{
+		TktVNO:  KerberosPVNO,
+		Realm:   "EXAMPLE.COM",
+		SName:   KerberosPrincipalName{NameType: KRB_NT_SRV_INST, NameString: []string{"krbtgt", "EXAMPLE.COM"}},
+		EncPart: KerberosEncryptedData{EType: ETYPE_RC4_HMAC, Cipher: []byte{1, 2, 3}},
+	}
+	tgsReq, err := NewKerberosTGSReq("EXAMPLE.COM", tgt, KerberosEncryptedData{EType: ETYPE_RC4_HMAC, Cipher: []byte{4, 5, 6}}, "cifs", "fs.example.com")
+	require.NoError(t, err)
+	raw, err = tgsReq.Marshal()
+	require.NoError(t, err)
+	msg, err = ParseKerberosMessage(raw)
+	require.NoError(t, err)
+	apReq, err := msg.(*KerberosKDCReq).GetAPReq()
+	require.NoError(t, err)
+	require.Equal(t, tgt, apReq.Ticket)
+}
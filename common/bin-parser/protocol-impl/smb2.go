@@ -0,0 +1,541 @@
+package protocol_impl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"unicode/utf16"
+
+	"github.com/yaklang/yaklang/common/bin-parser/parser"
+	utils2 "github.com/yaklang/yaklang/common/bin-parser/utils"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	SMB2_NEGOTIATE     uint16 = 0x0000
+	SMB2_SESSION_SETUP uint16 = 0x0001
+	SMB2_LOGOFF        uint16 = 0x0002
+	SMB2_TREE_CONNECT  uint16 = 0x0003
+
+	SMB2_FLAGS_SERVER_TO_REDIR uint32 = 0x00000001
+	SMB2_FLAGS_SIGNED          uint32 = 0x00000008
+
+	SMB2_DIALECT_202 uint16 = 0x0202
+	SMB2_DIALECT_210 uint16 = 0x0210
+	SMB2_DIALECT_300 uint16 = 0x0300
+	SMB2_DIALECT_302 uint16 = 0x0302
+	SMB2_DIALECT_311 uint16 = 0x0311
+
+	SMB2_NEGOTIATE_SIGNING_ENABLED  uint16 = 0x0001
+	SMB2_NEGOTIATE_SIGNING_REQUIRED uint16 = 0x0002
+
+	STATUS_SUCCESS                  uint32 = 0x00000000
+	STATUS_MORE_PROCESSING_REQUIRED uint32 = 0xC0000016
+	STATUS_LOGON_FAILURE            uint32 = 0xC000006D
+	STATUS_ACCESS_DENIED            uint32 = 0xC0000022
+	STATUS_BAD_NETWORK_NAME         uint32 = 0xC00000CC
+
+	smb2HeaderSize = 64
+)
+
+var SMB2ProtocolId = [4]byte{0xfe, 'S', 'M', 'B'}
+
+// SMB2Header 是 SMB2 同步消息头（MS-SMB2 2.2.1.2）
+type SMB2Header struct {
+	ProtocolId    [4]byte
+	StructureSize uint16
+	CreditCharge  uint16
+	Status        uint32
+	Command       uint16
+	CreditRequest uint16
+	Flags         uint32
+	NextCommand   uint32
+	MessageId     uint64
+	ProcessId     uint32
+	TreeId        uint32
+	SessionId     uint64
+	Signature     [16]byte
+}
+
+func NewSMB2Header(command uint16, messageId uint64) *SMB2Header {
+	return &SMB2Header{
+		ProtocolId:    SMB2ProtocolId,
+		StructureSize: smb2HeaderSize,
+		Command:       command,
+		CreditRequest: 1,
+		MessageId:     messageId,
+	}
+}
+
+// IsResponse 表示消息是服务端发出的响应
+func (h *SMB2Header) IsResponse() bool {
+	return h.Flags&SMB2_FLAGS_SERVER_TO_REDIR != 0
+}
+
+// smb2Buffer 是 SMB2 消息中通过 offset 和 length 引用的变长数据，offset 相对于 SMB2 消息头
+type smb2Buffer struct {
+	payload *[]byte
+	base    uint32
+}
+
+func (b smb2Buffer) slice(offset uint32, length uint32) []byte {
+	if b.payload == nil || length == 0 || offset < b.base {
+		return nil
+	}
+	start := offset - b.base
+	if uint64(start)+uint64(length) > uint64(len(*b.payload)) {
+		return nil
+	}
+	return (*b.payload)[start : start+length]
+}
+
+// SMB2NegotiateRequest MS-SMB2 2.2.3，Dialects 是小端序的 uint16 数组，使用 GetDialects 和 SetDialects 访问
+// Payload 是 Dialects 之后的数据（SMB 3.1.1 的填充和 NegotiateContext）
+type SMB2NegotiateRequest struct {
+	StructureSize          uint16
+	DialectCount           uint16
+	SecurityMode           uint16
+	Reserved               uint16
+	Capabilities           uint32
+	ClientGuid             [16]byte
+	NegotiateContextOffset uint32
+	NegotiateContextCount  uint16
+	Reserved2              uint16
+	Dialects               []byte
+	Payload                []byte
+}
+
+func NewSMB2NegotiateRequest(dialects ...uint16) *SMB2NegotiateRequest {
+	req := &SMB2NegotiateRequest{
+		StructureSize: 36,
+		SecurityMode:  SMB2_NEGOTIATE_SIGNING_ENABLED,
+	}
+	req.SetDialects(dialects...)
+	return req
+}
+
+func (n *SMB2NegotiateRequest) GetDialects() []uint16 {
+	var dialects []uint16
+	for i := 0; i+1 < len(n.Dialects); i += 2 {
+		dialects = append(dialects, binary.LittleEndian.Uint16(n.Dialects[i:]))
+	}
+	return dialects
+}
+
+func (n *SMB2NegotiateRequest) SetDialects(dialects ...uint16) {
+	n.Dialects = make([]byte, 2*len(dialects))
+	for i, dialect := range dialects {
+		binary.LittleEndian.PutUint16(n.Dialects[2*i:], dialect)
+	}
+	n.DialectCount = uint16(len(dialects))
+}
+
+// GetNegotiateContexts 解析 SMB 3.1.1 的 NegotiateContextList
+func (n *SMB2NegotiateRequest) GetNegotiateContexts() ([]*SMB2NegotiateContext, error) {
+	base := smb2HeaderSize + 36 + uint32(len(n.Dialects))
+	return parseSMB2NegotiateContexts(n.Payload, base, n.NegotiateContextOffset, n.NegotiateContextCount)
+}
+
+// SMB2NegotiateResponse MS-SMB2 2.2.4，SecurityBuffer 中通常是 SPNEGO 的 NegTokenInit
+type SMB2NegotiateResponse struct {
+	StructureSize          uint16
+	SecurityMode           uint16
+	DialectRevision        uint16
+	NegotiateContextCount  uint16
+	ServerGuid             [16]byte
+	Capabilities           uint32
+	MaxTransactSize        uint32
+	MaxReadSize            uint32
+	MaxWriteSize           uint32
+	SystemTime             uint64
+	ServerStartTime        uint64
+	SecurityBufferOffset   uint16
+	SecurityBufferLength   uint16
+	NegotiateContextOffset uint32
+	Payload                []byte
+}
+
+func (n *SMB2NegotiateResponse) buffer() smb2Buffer {
+	return smb2Buffer{payload: &n.Payload, base: smb2HeaderSize + 64}
+}
+
+func (n *SMB2NegotiateResponse) GetSecurityBuffer() []byte {
+	return n.buffer().slice(uint32(n.SecurityBufferOffset), uint32(n.SecurityBufferLength))
+}
+
+func (n *SMB2NegotiateResponse) SetSecurityBuffer(data []byte) {
+	n.Payload = data
+	n.SecurityBufferOffset = smb2HeaderSize + 64
+	n.SecurityBufferLength = uint16(len(data))
+}
+
+func (n *SMB2NegotiateResponse) GetNegotiateContexts() ([]*SMB2NegotiateContext, error) {
+	return parseSMB2NegotiateContexts(n.Payload, smb2HeaderSize+64, n.NegotiateContextOffset, n.NegotiateContextCount)
+}
+
+// SMB2SessionSetupRequest MS-SMB2 2.2.5，SecurityBuffer 中是 SPNEGO 包装的 NTLM 或 Kerberos 认证数据
+type SMB2SessionSetupRequest struct {
+	StructureSize        uint16
+	Flags                uint8
+	SecurityMode         uint8
+	Capabilities         uint32
+	Channel              uint32
+	SecurityBufferOffset uint16
+	SecurityBufferLength uint16
+	PreviousSessionId    uint64
+	Payload              []byte
+}
+
+func NewSMB2SessionSetupRequest(securityBuffer []byte) *SMB2SessionSetupRequest {
+	req := &SMB2SessionSetupRequest{
+		StructureSize: 25,
+		SecurityMode:  uint8(SMB2_NEGOTIATE_SIGNING_ENABLED),
+	}
+	req.SetSecurityBuffer(securityBuffer)
+	return req
+}
+
+func (s *SMB2SessionSetupRequest) buffer() smb2Buffer {
+	return smb2Buffer{payload: &s.Payload, base: smb2HeaderSize + 24}
+}
+
+func (s *SMB2SessionSetupRequest) GetSecurityBuffer() []byte {
+	return s.buffer().slice(uint32(s.SecurityBufferOffset), uint32(s.SecurityBufferLength))
+}
+
+func (s *SMB2SessionSetupRequest) SetSecurityBuffer(data []byte) {
+	s.Payload = data
+	s.SecurityBufferOffset = smb2HeaderSize + 24
+	s.SecurityBufferLength = uint16(len(data))
+}
+
+// SMB2SessionSetupResponse MS-SMB2 2.2.6
+type SMB2SessionSetupResponse struct {
+	StructureSize        uint16
+	SessionFlags         uint16
+	SecurityBufferOffset uint16
+	SecurityBufferLength uint16
+	Payload              []byte
+}
+
+func (s *SMB2SessionSetupResponse) buffer() smb2Buffer {
+	return smb2Buffer{payload: &s.Payload, base: smb2HeaderSize + 8}
+}
+
+func (s *SMB2SessionSetupResponse) GetSecurityBuffer() []byte {
+	return s.buffer().slice(uint32(s.SecurityBufferOffset), uint32(s.SecurityBufferLength))
+}
+
+func (s *SMB2SessionSetupResponse) SetSecurityBuffer(data []byte) {
+	s.Payload = data
+	s.SecurityBufferOffset = smb2HeaderSize + 8
+	s.SecurityBufferLength = uint16(len(data))
+}
+
+// SMB2TreeConnectRequest MS-SMB2 2.2.9，Path 是 UTF-16LE 编码的共享路径，例如 `\\server\IPC$`
+type SMB2TreeConnectRequest struct {
+	StructureSize uint16
+	Flags         uint16
+	PathOffset    uint16
+	PathLength    uint16
+	Payload       []byte
+}
+
+func NewSMB2TreeConnectRequest(path string) *SMB2TreeConnectRequest {
+	req := &SMB2TreeConnectRequest{StructureSize: 9}
+	req.SetPath(path)
+	return req
+}
+
+func (t *SMB2TreeConnectRequest) GetPath() string {
+	buf := smb2Buffer{payload: &t.Payload, base: smb2HeaderSize + 8}
+	return decodeUTF16LE(buf.slice(uint32(t.PathOffset), uint32(t.PathLength)))
+}
+
+func (t *SMB2TreeConnectRequest) SetPath(path string) {
+	t.Payload = UnicodeEncode(path)
+	t.PathOffset = smb2HeaderSize + 8
+	t.PathLength = uint16(len(t.Payload))
+}
+
+// SMB2TreeConnectResponse MS-SMB2 2.2.10
+type SMB2TreeConnectResponse struct {
+	StructureSize uint16
+	ShareType     uint8
+	Reserved      uint8
+	ShareFlags    uint32
+	Capabilities  uint32
+	MaximalAccess uint32
+}
+
+// SMB2ErrorResponse MS-SMB2 2.2.2，Payload 是 ErrorData
+type SMB2ErrorResponse struct {
+	StructureSize     uint16
+	ErrorContextCount uint8
+	Reserved          uint8
+	ByteCount         uint32
+	Payload           []byte
+}
+
+// SMB2RawBody 是暂不支持解析的命令
+type SMB2RawBody struct {
+	Payload []byte
+}
+
+// SMB2NegotiateContext MS-SMB2 2.2.3.1
+type SMB2NegotiateContext struct {
+	ContextType uint16
+	DataLength  uint16
+	Reserved    uint32
+	Data        []byte
+}
+
+func parseSMB2NegotiateContexts(payload []byte, base uint32, offset uint32, count uint16) ([]*SMB2NegotiateContext, error) {
+	if count == 0 {
+		return nil, nil
+	}
+	if offset < base || int(offset-base) > len(payload) {
+		return nil, utils.Errorf("invalid negotiate context offset: %d", offset)
+	}
+	data := payload[offset-base:]
+	var contexts []*SMB2NegotiateContext
+	for i := 0; i < int(count); i++ {
+		ctx := &SMB2NegotiateContext{}
+		if err := parseSMB2Struct(bytes.NewReader(data), ctx, "NegotiateContext"); err != nil {
+			return nil, err
+		}
+		contexts = append(contexts, ctx)
+		// 每个 context 按照 8 字节对齐
+		size := (8 + int(ctx.DataLength) + 7) &^ 7
+		if size > len(data) {
+			size = len(data)
+		}
+		data = data[size:]
+	}
+	return contexts, nil
+}
+
+// SMB2Message 是一个完整的 SMB2 消息，Body 是 SMB2NegotiateRequest 等结构体的指针
+type SMB2Message struct {
+	Header *SMB2Header
+	Body   any
+}
+
+func NewSMB2Message(header *SMB2Header, body any) *SMB2Message {
+	return &SMB2Message{Header: header, Body: body}
+}
+
+// ParseSMB2Message 解析不包含 NetBIOS 会话头的 SMB2 消息
+func ParseSMB2Message(data []byte) (*SMB2Message, error) {
+	if len(data) < smb2HeaderSize {
+		return nil, utils.Errorf("smb2 message too short: %d", len(data))
+	}
+	header := &SMB2Header{}
+	if err := parseSMB2Struct(bytes.NewReader(data), header, "Header"); err != nil {
+		return nil, utils.Wrap(err, "parse smb2 header failed")
+	}
+	if header.ProtocolId != SMB2ProtocolId {
+		return nil, utils.Errorf("invalid smb2 protocol id: %x", header.ProtocolId)
+	}
+	body, err := parseSMB2Body(header, data[smb2HeaderSize:])
+	if err != nil {
+		return nil, utils.Wrapf(err, "parse smb2 command %d failed", header.Command)
+	}
+	return &SMB2Message{Header: header, Body: body}, nil
+}
+
+// ReadSMB2Message 从连接中读取一个带 NetBIOS 会话头（Direct TCP）的 SMB2 消息
+func ReadSMB2Message(r io.Reader) (*SMB2Message, error) {
+	message, err := ReadNetBIOSMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSMB2Message(message)
+}
+
+// ReadNetBIOSMessage 读取一个 NetBIOS 会话消息，返回其中的数据
+func ReadNetBIOSMessage(r io.Reader) ([]byte, error) {
+	node, err := parser.ParseBinary(r, "application-layer.smb2", "NetBIOS")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := utils2.NodeToData(node).(map[string]any)
+	if !ok {
+		return nil, errors.New("node result data format is invalid")
+	}
+	return utils.InterfaceToBytes(utils.MapGetRaw(res, "Message")), nil
+}
+
+// MarshalNetBIOS 为数据添加 NetBIOS 会话头
+func MarshalNetBIOS(message []byte) ([]byte, error) {
+	node, err := parser.GenerateBinary(map[string]any{
+		"Type":    uint8(0),
+		"Length":  uint32(len(message)),
+		"Message": message,
+	}, "application-layer.smb2", "NetBIOS")
+	if err != nil {
+		return nil, err
+	}
+	return utils2.NodeToBytes(node), nil
+}
+
+func parseSMB2Body(header *SMB2Header, data []byte) (any, error) {
+	var body any
+	if header.IsResponse() && header.Status != STATUS_SUCCESS && header.Status != STATUS_MORE_PROCESSING_REQUIRED &&
+		len(data) >= 2 && binary.LittleEndian.Uint16(data) == 9 {
+		body = &SMB2ErrorResponse{}
+	} else {
+		switch header.Command {
+		case SMB2_NEGOTIATE:
+			if header.IsResponse() {
+				body = &SMB2NegotiateResponse{}
+			} else {
+				body = &SMB2NegotiateRequest{}
+			}
+		case SMB2_SESSION_SETUP:
+			if header.IsResponse() {
+				body = &SMB2SessionSetupResponse{}
+			} else {
+				body = &SMB2SessionSetupRequest{}
+			}
+		case SMB2_TREE_CONNECT:
+			if header.IsResponse() {
+				body = &SMB2TreeConnectResponse{}
+			} else {
+				body = &SMB2TreeConnectRequest{}
+			}
+		default:
+			return &SMB2RawBody{Payload: data}, nil
+		}
+	}
+	reader := bytes.NewReader(data)
+	if err := parseSMB2Struct(reader, body, smb2BodyKey(body)); err != nil {
+		return nil, err
+	}
+	payload := data[len(data)-reader.Len():]
+	switch ret := body.(type) {
+	case *SMB2NegotiateRequest:
+		ret.Payload = payload
+	case *SMB2NegotiateResponse:
+		ret.Payload = payload
+	case *SMB2SessionSetupRequest:
+		ret.Payload = payload
+	case *SMB2SessionSetupResponse:
+		ret.Payload = payload
+	case *SMB2TreeConnectRequest:
+		ret.Payload = payload
+	case *SMB2ErrorResponse:
+		ret.Payload = payload
+	}
+	return body, nil
+}
+
+func smb2BodyKey(body any) string {
+	switch body.(type) {
+	case *SMB2NegotiateRequest:
+		return "NegotiateRequest"
+	case *SMB2NegotiateResponse:
+		return "NegotiateResponse"
+	case *SMB2SessionSetupRequest:
+		return "SessionSetupRequest"
+	case *SMB2SessionSetupResponse:
+		return "SessionSetupResponse"
+	case *SMB2TreeConnectRequest:
+		return "TreeConnectRequest"
+	case *SMB2TreeConnectResponse:
+		return "TreeConnectResponse"
+	case *SMB2ErrorResponse:
+		return "ErrorResponse"
+	}
+	return ""
+}
+
+// Marshal 生成不包含 NetBIOS 会话头的 SMB2 消息
+func (m *SMB2Message) Marshal() ([]byte, error) {
+	if m.Header == nil {
+		return nil, errors.New("smb2 header is nil")
+	}
+	header, err := genSMB2Struct(m.Header, "Header")
+	if err != nil {
+		return nil, utils.Wrap(err, "generate smb2 header failed")
+	}
+	var body, payload []byte
+	switch ret := m.Body.(type) {
+	case *SMB2RawBody:
+		payload = ret.Payload
+	case nil:
+		return nil, errors.New("smb2 body is nil")
+	default:
+		key := smb2BodyKey(ret)
+		if key == "" {
+			return nil, utils.Errorf("unsupported smb2 body: %T", ret)
+		}
+		if req, ok := ret.(*SMB2NegotiateRequest); ok {
+			req.DialectCount = uint16(len(req.Dialects) / 2)
+		}
+		body, err = genSMB2Struct(ret, key)
+		if err != nil {
+			return nil, utils.Wrapf(err, "generate smb2 %s failed", key)
+		}
+		payload = smb2BodyPayload(ret)
+	}
+	res := append(header, body...)
+	return append(res, payload...), nil
+}
+
+func smb2BodyPayload(body any) []byte {
+	switch ret := body.(type) {
+	case *SMB2NegotiateRequest:
+		return ret.Payload
+	case *SMB2NegotiateResponse:
+		return ret.Payload
+	case *SMB2SessionSetupRequest:
+		return ret.Payload
+	case *SMB2SessionSetupResponse:
+		return ret.Payload
+	case *SMB2TreeConnectRequest:
+		return ret.Payload
+	case *SMB2ErrorResponse:
+		return ret.Payload
+	}
+	return nil
+}
+
+// WriteTo 写入带 NetBIOS 会话头的 SMB2 消息
+func (m *SMB2Message) WriteTo(writer io.Writer) (int64, error) {
+	res, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	res, err = MarshalNetBIOS(res)
+	if err != nil {
+		return 0, err
+	}
+	n, err := writer.Write(res)
+	return int64(n), err
+}
+
+func parseSMB2Struct(reader io.Reader, v any, key string) error {
+	node, err := parser.ParseBinary(reader, "application-layer.smb2", key)
+	if err != nil {
+		return err
+	}
+	return utils2.NodeToStruct(node, v)
+}
+
+func genSMB2Struct(data any, key string) ([]byte, error) {
+	node, err := parser.GenerateBinary(data, "application-layer.smb2", key)
+	if err != nil {
+		return nil, err
+	}
+	return utils2.NodeToBytes(node), nil
+}
+
+func decodeUTF16LE(data []byte) string {
+	runes := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		runes = append(runes, binary.LittleEndian.Uint16(data[i:]))
+	}
+	return string(utf16.Decode(runes))
+}
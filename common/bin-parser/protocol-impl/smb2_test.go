@@ -0,0 +1,106 @@
+package protocol_impl
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/stretchr/testify/require"
+)
+
+// readTCPPayloads 按顺序返回 pcap 中与 port 相关的 TCP 载荷，fromClient 表示目的端口是 port
+func readTCPPayloads(t *testing.T, file string, port layers.TCPPort) (payloads [][]byte, fromClient []bool) {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	reader, err := pcapgo.NewReader(f)
+	require.NoError(t, err)
+	for {
+		data, _, err := reader.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		packet := gopacket.NewPacket(data, layers.LayerTypeEthernet, gopacket.Default)
+		tcp, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+		if !ok || len(tcp.Payload) == 0 || (tcp.SrcPort != port && tcp.DstPort != port) {
+			continue
+		}
+		payloads = append(payloads, tcp.Payload)
+		fromClient = append(fromClient, tcp.DstPort == port)
+	}
+	return
+}
+
+func TestSMB2PcapRoundTrip(t *testing.T) {
+	payloads, fromClient := readTCPPayloads(t, "testdata/smb2_session.pcap", 445)
+	require.Len(t, payloads, 10)
+	var messages []*SMB2Message
+	for i, payload := range payloads {
+		msg, err := ReadSMB2Message(bytes.NewReader(payload))
+		require.NoError(t, err)
+		require.Equal(t, !fromClient[i], msg.Header.IsResponse())
+		raw, err := msg.Marshal()
+		require.NoError(t, err)
+		framed, err := MarshalNetBIOS(raw)
+		require.NoError(t, err)
+		require.Equal(t, payload, framed, "message %d", i)
+		messages = append(messages, msg)
+	}
+
+	negReq := messages[0].Body.(*SMB2NegotiateRequest)
+	require.Equal(t, []uint16{SMB2_DIALECT_202, SMB2_DIALECT_210, SMB2_DIALECT_300, SMB2_DIALECT_302, SMB2_DIALECT_311}, negReq.GetDialects())
+	contexts, err := negReq.GetNegotiateContexts()
+	require.NoError(t, err)
+	require.Len(t, contexts, 2)
+	require.Equal(t, uint16(1), contexts[0].ContextType)
+	require.Equal(t, uint16(2), contexts[1].ContextType)
+
+	negResp := messages[1].Body.(*SMB2NegotiateResponse)
+	require.Equal(t, SMB2_DIALECT_311, negResp.DialectRevision)
+	require.Equal(t, byte(0x60), negResp.GetSecurityBuffer()[0])
+	contexts, err = negResp.GetNegotiateContexts()
+	require.NoError(t, err)
+	require.Len(t, contexts, 2)
+
+	setupReq := messages[2].Body.(*SMB2SessionSetupRequest)
+	require.True(t, bytes.Contains(setupReq.GetSecurityBuffer(), []byte("NTLMSSP\x00\x01")))
+	setupResp := messages[3].Body.(*SMB2SessionSetupResponse)
+	require.Equal(t, STATUS_MORE_PROCESSING_REQUIRED, messages[3].Header.Status)
+	require.True(t, bytes.Contains(setupResp.GetSecurityBuffer(), []byte("NTLMSSP\x00\x02")))
+	require.Equal(t, messages[3].Header.SessionId, messages[4].Header.SessionId)
+
+	require.Equal(t, `\\192.168.1.10\IPC$`, messages[6].Body.(*SMB2TreeConnectRequest).GetPath())
+	require.Equal(t, uint32(1), messages[7].Header.TreeId)
+	require.Equal(t, uint8(2), messages[7].Body.(*SMB2TreeConnectResponse).ShareType)
+	require.Equal(t, STATUS_BAD_NETWORK_NAME, messages[9].Header.Status)
+	require.IsType(t, &SMB2ErrorResponse{}, messages[9].Body)
+}
+
+func TestSMB2Generate(t *testing.T) {
+	negReq := NewSMB2NegotiateRequest(SMB2_DIALECT_202, SMB2_DIALECT_210)
+	raw, err := NewSMB2Message(NewSMB2Header(SMB2_NEGOTIATE, 0), negReq).Marshal()
+	require.NoError(t, err)
+	require.Len(t, raw, smb2HeaderSize+36+4)
+	msg, err := ParseSMB2Message(raw)
+	require.NoError(t, err)
+	require.Equal(t, []uint16{SMB2_DIALECT_202, SMB2_DIALECT_210}, msg.Body.(*SMB2NegotiateRequest).GetDialects())
+
+	secBuf := []byte("NTLMSSP\x00\x01\x00\x00\x00")
+	raw, err = NewSMB2Message(NewSMB2Header(SMB2_SESSION_SETUP, 1), NewSMB2SessionSetupRequest(secBuf)).Marshal()
+	require.NoError(t, err)
+	msg, err = ParseSMB2Message(raw)
+	require.NoError(t, err)
+	require.Equal(t, secBuf, msg.Body.(*SMB2SessionSetupRequest).GetSecurityBuffer())
+
+	var buf bytes.Buffer
+	_, err = NewSMB2Message(NewSMB2Header(SMB2_TREE_CONNECT, 2), NewSMB2TreeConnectRequest(`\\host\share`)).WriteTo(&buf)
+	require.NoError(t, err)
+	msg, err = ReadSMB2Message(&buf)
+	require.NoError(t, err)
+	require.Equal(t, `\\host\share`, msg.Body.(*SMB2TreeConnectRequest).GetPath())
+}
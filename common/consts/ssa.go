@@ -26,11 +26,13 @@ const (
 	JAVA    Language = "java"
 	GO      Language = "golang"
 	TS      Language = "ts"
+	PYTHON  Language = "python"
+	C       Language = "c"
 	General Language = "general"
 )
 
 func GetAllSupportedLanguages() []Language {
-	return []Language{Yak, JS, PHP, JAVA, GO}
+	return []Language{Yak, JS, PHP, JAVA, GO, PYTHON, C}
 }
 
 func ValidateLanguage(language string) (Language, error) {
@@ -45,6 +47,10 @@ func ValidateLanguage(language string) (Language, error) {
 		return JS, nil
 	case "go", "golang":
 		return GO, nil
+	case "python", "py", "python3":
+		return PYTHON, nil
+	case "c":
+		return C, nil
 	}
 	return "", errors.Errorf("unsupported language: %s", language)
 }
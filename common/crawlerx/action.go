@@ -90,6 +90,9 @@ func (starter *BrowserStarter) eventActionOnPage(page *rod.Page) error {
 }
 
 func (starter *BrowserStarter) ActionOnPage(page *rod.Page) error {
+	if starter.stateExplore {
+		return starter.stateActionOnPage(page)
+	}
 	if starter.vue {
 		log.Debug("determined vue.")
 		return starter.eventActionOnPage(page)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/crawlerx/preaction"
 	"github.com/yaklang/yaklang/common/crawlerx/tools"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
@@ -65,6 +66,12 @@ type BaseConfig struct {
 
 	aiInputUrl  string
 	aiInputInfo string
+
+	loginActions   []*preaction.PreAction
+	sessionExpired *sessionExpiredCheck
+
+	stateExplore bool
+	maxStates    int
 }
 
 type BrowserConfig struct {
@@ -700,3 +707,98 @@ func WithAIInputInf(info string) ConfigOpt {
 		config.baseConfig.aiInputInfo = info
 	}
 }
+
+// loginSequence 是一个请求选项 用于设置爬虫开始前执行的登录流程，参数为 json 格式的动作列表
+// 支持的动作包括 navigate(打开params中的url) fill/input(向selector输入params) click select hover setFile
+// wait(等待selector出现，params为超时秒数) sleep(params为秒数) totp(根据params中的密钥生成验证码输入selector，密钥以base32:开头时视为base32种子)
+// 爬虫过程中发现登录态失效时会自动重新执行登录流程，失效判断见 sessionExpired
+//
+// Examples:
+// ```
+//
+//	targetUrl = "http://testphp.vulnweb.com/"
+//	sequence = [
+//	   {"action": "navigate", "params": "http://testphp.vulnweb.com/login.php"},
+//	   {"action": "fill", "selector": "input[name=uname]", "params": "test"},
+//	   {"action": "fill", "selector": "input[name=pass]", "params": "test"},
+//	   {"action": "click", "selector": "input[type=submit]"},
+//	   {"action": "wait", "selector": "#otp", "params": "10"},
+//	   {"action": "totp", "selector": "#otp", "params": "base32:JBSWY3DPEHPK3PXP"},
+//	   {"action": "click", "selector": "#verify"},
+//	]
+//	ch, err = crawlerx.StartCrawler(targetUrl, crawlerx.loginSequence(json.dumps(sequence)))
+//	...
+//
+// ```
+func WithLoginSequence(data string) ConfigOpt {
+	actions, err := preaction.ParseLoginActions(data)
+	if err != nil {
+		log.Errorf("login sequence %s error: %s", data, err)
+		return func(*Config) {}
+	}
+	return func(config *Config) {
+		config.baseConfig.loginActions = append(config.baseConfig.loginActions, actions...)
+	}
+}
+
+// sessionExpired 是一个请求选项 用于设置登录态失效的判断条件，命中后重新执行登录流程
+// 未设置时默认为状态码 401/419/440 或跳转到登录流程中第一个 navigate 地址
+//
+// Examples:
+// ```
+//
+//	targetUrl = "http://testphp.vulnweb.com/"
+//	expired = {
+//	   "status_codes": [401, 403],		// 命中状态码
+//	   "login_url": "login\\.php",		// 跳转到匹配该正则的地址
+//	   "keywords": ["you must login"],	// 页面中出现关键字
+//	   "cooldown": 10,					// 两次重新登录的最小间隔秒数
+//	}
+//	ch, err = crawlerx.StartCrawler(targetUrl, crawlerx.loginSequence(sequence), crawlerx.sessionExpired(json.dumps(expired)))
+//	...
+//
+// ```
+func WithSessionExpired(data string) ConfigOpt {
+	check, err := newSessionExpiredCheck(data)
+	if err != nil {
+		log.Errorf("session expired check error: %s", err)
+		return func(*Config) {}
+	}
+	return func(config *Config) {
+		config.baseConfig.sessionExpired = check
+	}
+}
+
+// stateExplore 是一个请求选项 用于设置是否以页面状态图的方式探索单页应用
+// 开启后按DOM结构计算页面状态指纹 记录状态之间由哪个元素点击转换 优先探索未探索过的状态
+// 每个状态中观察到的XHR/fetch请求都会输出 可以通过结果的State()获取对应的状态指纹
+//
+// Examples:
+// ```
+//
+//	targetUrl = "http://testphp.vulnweb.com/"
+//	ch, err = crawlerx.StartCrawler(targetUrl, crawlerx.stateExplore(true), crawlerx.maxStates(200))
+//	...
+//
+// ```
+func WithStateExplore(explore bool) ConfigOpt {
+	return func(config *Config) {
+		config.baseConfig.stateExplore = explore
+	}
+}
+
+// maxStates 是一个请求选项 用于设置状态探索时最多记录的页面状态数量 默认为100
+//
+// Examples:
+// ```
+//
+//	targetUrl = "http://testphp.vulnweb.com/"
+//	ch, err = crawlerx.StartCrawler(targetUrl, crawlerx.stateExplore(true), crawlerx.maxStates(200))
+//	...
+//
+// ```
+func WithMaxStates(maxStates int) ConfigOpt {
+	return func(config *Config) {
+		config.baseConfig.maxStates = maxStates
+	}
+}
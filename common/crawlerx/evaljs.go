@@ -137,6 +137,98 @@ function getSelector(e){
     clickSelectors
 `
 
+// getDomStructure 获取页面可见部分的 DOM 结构，忽略文本与属性值，连续重复的兄弟节点只保留一个，用于计算页面状态指纹
+const getDomStructure = `
+(function () {
+	const skip = ["SCRIPT", "STYLE", "NOSCRIPT", "TEMPLATE", "META", "LINK", "SVG"];
+	function walk(e) {
+		if (skip.includes(e.tagName.toUpperCase())) {
+			return "";
+		}
+		let style = window.getComputedStyle(e);
+		if (style.display === "none" || style.visibility === "hidden") {
+			return "";
+		}
+		let sig = e.tagName.toLowerCase();
+		if (e.type && (sig === "input" || sig === "button")) {
+			sig += "[" + e.type + "]";
+		}
+		if (e.getAttribute("role")) {
+			sig += "@" + e.getAttribute("role");
+		}
+		let children = [];
+		let last = null;
+		for (let i = 0; i < e.children.length; i++) {
+			let child = walk(e.children[i]);
+			if (child === "" || child === last) {
+				continue;
+			}
+			children.push(child);
+			last = child;
+		}
+		return children.length > 0 ? sig + "(" + children.join(",") + ")" : sig;
+	}
+	return document.body ? walk(document.body) : "";
+})()
+`
+
+// getStateActionElements 获取可能改变页面状态的可见元素：点击事件、按钮、无实际跳转的链接以及常见的交互角色
+const getStateActionElements = `
+(function () {
+	function getSelector(e){
+		let domPath = Array();
+		if (e.getAttribute("id")) {
+			domPath.unshift('#'+CSS.escape(e.getAttribute("id")));
+		} else {
+			while (e.nodeName.toLowerCase() !== "html") {
+				if(e.id){
+					domPath.unshift('#'+CSS.escape(e.getAttribute("id")));
+					break;
+				}else if(e.tagName.toLocaleLowerCase() == "body") {
+					domPath.unshift(e.tagName.toLocaleLowerCase());
+				}else{
+					for (let i = 0; i < e.parentNode.childElementCount; i++) {
+						if (e.parentNode.children[i] == e) {
+							domPath.unshift(e.tagName.toLocaleLowerCase() + ':nth-child(' + (i + 1) + ')');
+						}
+					}
+				}
+				e = e.parentNode;
+			}
+		}
+		return domPath.join('>');
+	}
+	function visible(e) {
+		let rect = e.getBoundingClientRect();
+		return rect.width > 0 && rect.height > 0 && window.getComputedStyle(e).visibility !== "hidden";
+	}
+	let selectors = [];
+	let elements = document.querySelectorAll("*");
+	for (let i = 0; i < elements.length; i++) {
+		let e = elements[i];
+		let tag = e.tagName.toLowerCase();
+		let clickable = false;
+		if (tag === "button" || (tag === "input" && ["submit", "button", "image"].includes(e.type))) {
+			clickable = true;
+		} else if (tag === "a") {
+			let href = (e.getAttribute("href") || "").trim();
+			clickable = href === "" || href.startsWith("#") || href.toLowerCase().startsWith("javascript:");
+		} else if (["button", "tab", "menuitem", "option", "treeitem", "switch"].includes(e.getAttribute("role"))) {
+			clickable = true;
+		} else if (e.onclick !== null && e.onclick !== undefined) {
+			clickable = true;
+		}
+		if (clickable && visible(e)) {
+			let selector = getSelector(e);
+			if (selector !== "" && !selectors.includes(selector)) {
+				selectors.push(selector);
+			}
+		}
+	}
+	return selectors;
+})()
+`
+
 type JSEval struct {
 	targetUrl *regexp.Regexp
 	js        []string
@@ -6,6 +6,9 @@ var CrawlerXExports = map[string]interface{}{
 	"StartCrawler":   StartCrawler,
 	"PageScreenShot": NewPageScreenShot,
 
+	"NewSessionRefresher": NewSessionRefresher,
+	"RecordLoginSequence": RecordLoginSequence,
+
 	"browserInfo":       WithBrowserInfo,
 	"saveToDB":          WithSaveToDB,
 	"runtimeId":         WithRuntimeID,
@@ -42,6 +45,10 @@ var CrawlerXExports = map[string]interface{}{
 	"urlCheck":          WithUrlCheck,
 	"aiInputUrl":        WithAIInputUrl,
 	"aiInputInfo":       WithAIInputInf,
+	"loginSequence":     WithLoginSequence,
+	"sessionExpired":    WithSessionExpired,
+	"stateExplore":      WithStateExplore,
+	"maxStates":         WithMaxStates,
 
 	"UnLimitRepeat":      unlimited,
 	"LowRepeatLevel":     lowLevel,
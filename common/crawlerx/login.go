@@ -0,0 +1,220 @@
+package crawlerx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/crawlerx/preaction"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var defaultSessionExpiredStatusCodes = []int{401, 419, 440}
+
+const defaultReloginCooldown = 10 * time.Second
+
+type SessionExpiredJson struct {
+	StatusCodes []int    `json:"status_codes,omitempty"`
+	LoginUrl    string   `json:"login_url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Cooldown    int      `json:"cooldown,omitempty"`
+}
+
+// sessionExpiredCheck 判断响应是否意味着登录态失效：状态码命中、跳转到登录页或页面中出现关键字
+type sessionExpiredCheck struct {
+	statusCodes []int
+	loginUrl    *regexp.Regexp
+	keywords    []string
+	cooldown    time.Duration
+}
+
+func newSessionExpiredCheck(data string) (*sessionExpiredCheck, error) {
+	var jsonData SessionExpiredJson
+	err := json.Unmarshal([]byte(data), &jsonData)
+	if err != nil {
+		return nil, utils.Errorf("unmarshal session expired data %s error: %v", data, err)
+	}
+	check := &sessionExpiredCheck{
+		statusCodes: jsonData.StatusCodes,
+		keywords:    jsonData.Keywords,
+		cooldown:    time.Duration(jsonData.Cooldown) * time.Second,
+	}
+	if jsonData.LoginUrl != "" {
+		check.loginUrl, err = regexp.Compile(jsonData.LoginUrl)
+		if err != nil {
+			return nil, utils.Errorf("login url regexp %s compile error: %v", jsonData.LoginUrl, err)
+		}
+	}
+	if check.cooldown <= 0 {
+		check.cooldown = defaultReloginCooldown
+	}
+	return check, nil
+}
+
+// defaultSessionExpiredCheck 未配置失效判断时使用：常见的未授权状态码，以及跳转到登录流程中第一个 navigate 地址
+func defaultSessionExpiredCheck(actions []*preaction.PreAction) *sessionExpiredCheck {
+	check := &sessionExpiredCheck{
+		statusCodes: defaultSessionExpiredStatusCodes,
+		cooldown:    defaultReloginCooldown,
+	}
+	for _, action := range actions {
+		if action.Action != preaction.NavigateAction {
+			continue
+		}
+		loginUrl, err := url.Parse(action.Params)
+		if err != nil || loginUrl.Path == "" || loginUrl.Path == "/" {
+			break
+		}
+		check.loginUrl = regexp.MustCompile(regexp.QuoteMeta(loginUrl.Path))
+		break
+	}
+	return check
+}
+
+func (check *sessionExpiredCheck) match(urlStr string, statusCode int, header http.Header, body string) bool {
+	for _, code := range check.statusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	if check.loginUrl != nil && statusCode >= 300 && statusCode < 400 {
+		location := header.Get("Location")
+		if location != "" {
+			if base, err := url.Parse(urlStr); err == nil && urlStr != "" {
+				if next, err := base.Parse(location); err == nil {
+					location = next.String()
+				}
+			}
+			if check.loginUrl.MatchString(location) && (urlStr == "" || !check.loginUrl.MatchString(urlStr)) {
+				return true
+			}
+		}
+	}
+	for _, keyword := range check.keywords {
+		if keyword != "" && strings.Contains(body, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoginSession 是登录流程执行完毕后的会话信息
+type LoginSession struct {
+	// 登录流程结束时页面所在的地址
+	Url          string
+	Cookies      map[string]string
+	LocalStorage map[string]string
+	Time         time.Time
+}
+
+// CookieString 返回可以直接用于 Cookie 请求头的字符串
+func (session *LoginSession) CookieString() string {
+	cookies := make([]string, 0, len(session.Cookies))
+	for key, value := range session.Cookies {
+		cookies = append(cookies, key+"="+value)
+	}
+	return strings.Join(cookies, "; ")
+}
+
+func (starter *BrowserStarter) isLoginTarget(targetID proto.TargetTargetID) bool {
+	starter.loginTargetLock.Lock()
+	defer starter.loginTargetLock.Unlock()
+	return starter.loginTargets[targetID]
+}
+
+// login 在新的页面中执行登录流程，页面不会被当作爬虫页面处理
+func (starter *BrowserStarter) login() (*LoginSession, error) {
+	log.Debugf(`do login sequence on %s`, starter.baseUrl)
+	// 持有锁直到记录 targetID，避免页面创建事件先于记录被处理
+	starter.loginTargetLock.Lock()
+	page, err := starter.browser.Page(proto.TargetCreateTarget{URL: "about:blank"})
+	if err == nil {
+		starter.loginTargets[page.TargetID] = true
+	}
+	starter.loginTargetLock.Unlock()
+	if err != nil {
+		return nil, utils.Errorf("login create page error: %v", err)
+	}
+	defer func() {
+		_ = page.Close()
+	}()
+	if starter.baseConfig.pageTimeout != 0 {
+		page = page.Timeout(time.Duration(starter.baseConfig.pageTimeout*len(starter.loginActions)) * time.Second)
+	}
+	if starter.loginActions[0].Action != preaction.NavigateAction {
+		err = preaction.LoginAct(page, &preaction.PreAction{Action: preaction.NavigateAction, Params: starter.baseUrl})
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = preaction.LoginActs(page, starter.loginActions)
+	if err != nil {
+		return nil, err
+	}
+	starter.lastLogin = time.Now()
+
+	session := &LoginSession{
+		Cookies:      make(map[string]string),
+		LocalStorage: make(map[string]string),
+		Time:         starter.lastLogin,
+	}
+	session.Url, _ = getCurrentUrl(page)
+	cookies, err := page.Cookies([]string{starter.baseUrl})
+	if err != nil {
+		return nil, utils.Errorf("login get cookies error: %v", err)
+	}
+	for _, cookie := range cookies {
+		session.Cookies[cookie.Name] = cookie.Value
+	}
+	storage, err := page.Eval(`()=>JSON.stringify(window.localStorage)`)
+	if err == nil {
+		_ = json.Unmarshal([]byte(storage.Value.Str()), &session.LocalStorage)
+	}
+	return session, nil
+}
+
+// checkSessionExpired 在爬虫过程中检查响应，发现登录态失效时重新执行登录流程
+// 触发失效的页面在重新登录后会再次加入爬取队列，每个页面只会重试一次
+func (starter *BrowserStarter) checkSessionExpired(hijack *CrawlerHijack) {
+	if len(starter.loginActions) == 0 || starter.sessionExpired == nil || starter.relogging.Load() {
+		return
+	}
+	urlStr := hijack.Request.URL().String()
+	if !starter.scanRange(urlStr) {
+		return
+	}
+	document := hijack.Request.Type() == proto.NetworkResourceTypeDocument
+	body := ""
+	if document {
+		body = hijack.Response.Body()
+	}
+	if !starter.sessionExpired.match(urlStr, hijack.Response.Payload().ResponseCode, hijack.Response.Headers(), body) {
+		return
+	}
+	starter.loginLock.Lock()
+	defer starter.loginLock.Unlock()
+	if time.Since(starter.lastLogin) >= starter.sessionExpired.cooldown {
+		log.Infof(`session expired on %s, login again`, urlStr)
+		starter.relogging.Store(true)
+		_, err := starter.login()
+		starter.relogging.Store(false)
+		if err != nil {
+			log.Errorf(`login again error: %v`, err)
+			return
+		}
+	}
+	if !document || starter.stopSignal || starter.reloginRetried[urlStr] {
+		return
+	}
+	starter.reloginRetried[urlStr] = true
+	select {
+	case <-starter.ctx.Done():
+	default:
+		starter.uChan.In <- urlStr
+	}
+}
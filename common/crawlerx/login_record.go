@@ -0,0 +1,205 @@
+package crawlerx
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/crawlerx/preaction"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/ysmood/gson"
+)
+
+// recordScript 在页面中监听用户操作，通过 __crawlerxRecord 回传动作、选择器与当前页面地址
+const recordScript = `() => {
+	if (window.__crawlerxRecording) return;
+	window.__crawlerxRecording = true;
+	const cssPath = (el) => {
+		if (el.id) return "#" + CSS.escape(el.id);
+		const tag = el.tagName.toLowerCase();
+		const name = el.getAttribute("name");
+		if (name && document.querySelectorAll(tag + "[name=\"" + name + "\"]").length === 1) {
+			return tag + "[name=\"" + name + "\"]";
+		}
+		const parts = [];
+		while (el && el.nodeType === 1 && el !== document.body) {
+			let part = el.tagName.toLowerCase();
+			if (el.id) {
+				parts.unshift("#" + CSS.escape(el.id));
+				break;
+			}
+			const parent = el.parentElement;
+			if (parent) {
+				const same = Array.from(parent.children).filter((c) => c.tagName === el.tagName);
+				if (same.length > 1) part += ":nth-of-type(" + (same.indexOf(el) + 1) + ")";
+			}
+			parts.unshift(part);
+			el = parent;
+		}
+		return parts.join(" > ");
+	};
+	const send = (action, el, params) => {
+		try {
+			window.__crawlerxRecord({action: action, selector: cssPath(el), params: params || "", url: location.href});
+		} catch (e) {}
+	};
+	document.addEventListener("change", (e) => {
+		const el = e.target;
+		if (!el || !el.tagName) return;
+		const tag = el.tagName.toLowerCase();
+		if (tag === "select") {
+			send("select", el, el.options[el.selectedIndex] ? el.options[el.selectedIndex].text : "");
+		} else if (tag === "textarea" || (tag === "input" && !["checkbox", "radio", "file", "submit", "button"].includes(el.type))) {
+			send("input", el, el.value);
+		}
+	}, true);
+	document.addEventListener("click", (e) => {
+		const el = e.target && e.target.closest ? e.target.closest("a,button,input,label,[role=button],[onclick]") : null;
+		if (!el) return;
+		if (el.tagName.toLowerCase() === "input" && !["checkbox", "radio", "submit", "button", "image"].includes(el.type)) return;
+		send("click", el, "");
+	}, true);
+}`
+
+type recordedAction struct {
+	Action   string `json:"action"`
+	Selector string `json:"selector"`
+	Params   string `json:"params"`
+	Url      string `json:"url"`
+}
+
+// RecordLoginSequence 打开一个可见的浏览器录制登录流程，录制在关闭页面或达到 fullTimeout 时结束，返回可以用于 loginSequence 的 json
+// 页面跳转后的第一个动作前会自动加入 wait 动作；动态验证码输入会被录制为普通输入，需要手动改为 totp 动作
+//
+// Examples:
+// ```
+//
+//	sequence, err = crawlerx.RecordLoginSequence("http://testphp.vulnweb.com/login.php", crawlerx.fullTimeout(300))
+//	die(err)
+//	ch, err = crawlerx.StartCrawler("http://testphp.vulnweb.com/", crawlerx.loginSequence(sequence))
+//	...
+//
+// ```
+func RecordLoginSequence(targetUrl string, opts ...ConfigOpt) (string, error) {
+	config := NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	launch := launcher.New().Headless(false)
+	if len(config.browsers) > 0 {
+		browserConfig := config.browsers[0]
+		if browserConfig.exePath == "" && browserConfig.wsAddress != "" {
+			return "", utils.Error("record login sequence need local browser")
+		}
+		if browserConfig.exePath != "" {
+			launch = launch.Bin(browserConfig.exePath)
+		}
+		if browserConfig.proxyAddress != nil {
+			launch = launch.Proxy(browserConfig.proxyAddress.String())
+		}
+	}
+	if (config.baseConfig.leakless == "default" && strings.Contains(runtime.GOOS, "windows")) ||
+		config.baseConfig.leakless == "false" {
+		launch = launch.Leakless(false)
+	}
+	controlUrl, err := launch.Launch()
+	if err != nil {
+		return "", utils.Errorf(`Launcher launch error: %s`, err)
+	}
+	defer launch.Kill()
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if config.baseConfig.fullTimeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*time.Duration(config.baseConfig.fullTimeout))
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	defer cancel()
+	browser := rod.New().ControlURL(controlUrl).Context(ctx)
+	if err = browser.Connect(); err != nil {
+		return "", utils.Errorf(`browser connect error: %s`, err)
+	}
+	defer func() {
+		_ = browser.Close()
+	}()
+	_ = browser.IgnoreCertErrors(true)
+	if len(config.baseConfig.cookies) > 0 {
+		if err = browser.SetCookies(config.baseConfig.cookies); err != nil {
+			return "", utils.Errorf(`browser set cookies error: %v`, err)
+		}
+	}
+	page, err := browser.Page(proto.TargetCreateTarget{URL: "about:blank"})
+	if err != nil {
+		return "", utils.Errorf("record create page error: %v", err)
+	}
+
+	var lock sync.Mutex
+	var records []*recordedAction
+	_, err = page.Expose("__crawlerxRecord", func(data gson.JSON) (interface{}, error) {
+		var record recordedAction
+		if err := data.Unmarshal(&record); err != nil {
+			return nil, err
+		}
+		lock.Lock()
+		records = append(records, &record)
+		lock.Unlock()
+		return nil, nil
+	})
+	if err != nil {
+		return "", utils.Errorf("record expose function error: %v", err)
+	}
+	if _, err = page.EvalOnNewDocument("(" + recordScript + ")()"); err != nil {
+		return "", utils.Errorf("record script error: %v", err)
+	}
+	if err = page.Navigate(targetUrl); err != nil {
+		return "", utils.Errorf("page navigate %s error: %s", targetUrl, err)
+	}
+
+	closed := make(chan struct{})
+	go browser.EachEvent(func(e *proto.TargetTargetDestroyed) bool {
+		if e.TargetID == page.TargetID {
+			close(closed)
+			return true
+		}
+		return false
+	})()
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		log.Infof("record login sequence timeout")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	return preaction.MarshalActions(recordedToActions(targetUrl, records))
+}
+
+// recordedToActions 将录制结果转换为登录流程：合并同一元素的连续输入，页面地址变化后先等待元素出现
+func recordedToActions(targetUrl string, records []*recordedAction) []*preaction.PreAction {
+	actions := []*preaction.PreAction{{Action: preaction.NavigateAction, Params: targetUrl}}
+	currentUrl := targetUrl
+	for _, record := range records {
+		actionType, err := preaction.ParseActionType(record.Action)
+		if err != nil || record.Selector == "" {
+			continue
+		}
+		last := actions[len(actions)-1]
+		if actionType == preaction.InputAction && last.Action == preaction.InputAction && last.Selector == record.Selector {
+			last.Params = record.Params
+			continue
+		}
+		if record.Url != "" && record.Url != currentUrl {
+			actions = append(actions, &preaction.PreAction{Action: preaction.WaitAction, Selector: record.Selector})
+			currentUrl = record.Url
+		}
+		actions = append(actions, &preaction.PreAction{Action: actionType, Selector: record.Selector, Params: record.Params})
+	}
+	return actions
+}
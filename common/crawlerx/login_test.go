@@ -0,0 +1,77 @@
+package crawlerx
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/crawlerx/preaction"
+)
+
+func TestSessionExpiredCheck(t *testing.T) {
+	check, err := newSessionExpiredCheck(`{"status_codes":[403],"login_url":"/sso/login","keywords":["session timeout"]}`)
+	require.NoError(t, err)
+	assert.Equal(t, defaultReloginCooldown, check.cooldown)
+
+	redirect := http.Header{}
+	redirect.Set("Location", "/sso/login?next=/admin")
+	assert.True(t, check.match("http://example.com/admin", 403, http.Header{}, ""))
+	assert.True(t, check.match("http://example.com/admin", 302, redirect, ""))
+	assert.True(t, check.match("http://example.com/admin", 200, http.Header{}, "<p>session timeout</p>"))
+	assert.False(t, check.match("http://example.com/admin", 200, redirect, "<p>welcome</p>"))
+	// 登录页自身的跳转不视为失效
+	assert.False(t, check.match("http://example.com/sso/login", 302, redirect, ""))
+
+	_, err = newSessionExpiredCheck(`{"login_url":"("}`)
+	assert.Error(t, err)
+}
+
+func TestDefaultSessionExpiredCheck(t *testing.T) {
+	actions, err := preaction.ParseLoginActions(`[
+		{"action":"navigate","params":"http://example.com/user/login.php"},
+		{"action":"fill","selector":"#user","params":"admin"},
+		{"action":"click","selector":"#submit"}
+	]`)
+	require.NoError(t, err)
+	check := defaultSessionExpiredCheck(actions)
+
+	redirect := http.Header{}
+	redirect.Set("Location", "http://example.com/user/login.php")
+	assert.True(t, check.match("http://example.com/index.php", 401, http.Header{}, ""))
+	assert.True(t, check.match("http://example.com/index.php", 302, redirect, ""))
+	assert.False(t, check.match("http://example.com/index.php", 200, http.Header{}, ""))
+}
+
+func TestRecordedToActions(t *testing.T) {
+	actions := recordedToActions("http://example.com/login", []*recordedAction{
+		{Action: "input", Selector: "#user", Params: "a", Url: "http://example.com/login"},
+		{Action: "input", Selector: "#user", Params: "admin", Url: "http://example.com/login"},
+		{Action: "click", Selector: "#submit", Url: "http://example.com/login"},
+		{Action: "input", Selector: "#otp", Params: "123456", Url: "http://example.com/otp"},
+		{Action: "unknown", Selector: "#x", Url: "http://example.com/otp"},
+	})
+	raw, err := preaction.MarshalActions(actions)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"action":"navigate","params":"http://example.com/login"},
+		{"action":"input","selector":"#user","params":"admin"},
+		{"action":"click","selector":"#submit"},
+		{"action":"wait","selector":"#otp"},
+		{"action":"input","selector":"#otp","params":"123456"}
+	]`, raw)
+}
+
+func TestSessionRefresherIsExpired(t *testing.T) {
+	_, err := NewSessionRefresher("http://example.com/")
+	assert.Error(t, err)
+
+	refresher, err := NewSessionRefresher("http://example.com/",
+		WithLoginSequence(`[{"action":"navigate","params":"http://example.com/login"}]`),
+		WithSessionExpired(`{"login_url":"/login","keywords":["please login"]}`),
+	)
+	require.NoError(t, err)
+	assert.True(t, refresher.IsExpired("HTTP/1.1 302 Found\r\nLocation: /login\r\n\r\n"))
+	assert.True(t, refresher.IsExpired("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\nplease login first"))
+	assert.False(t, refresher.IsExpired("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\nhello"))
+}
@@ -0,0 +1,192 @@
+package preaction
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/yaklang/yaklang/common/twofa"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// 登录流程中额外支持的动作，其余动作（hover/input/click/select/setFile）与 PreAct 一致
+const (
+	NavigateAction ActionType = "navigate"
+	WaitAction     ActionType = "wait"
+	TOTPAction     ActionType = "totp"
+	SleepAction    ActionType = "sleep"
+)
+
+const defaultWaitTimeout = 10 * time.Second
+
+var actionAlias = map[string]ActionType{
+	"hover":        HoverAction,
+	"input":        InputAction,
+	"fill":         InputAction,
+	"click":        ClickAction,
+	"select":       SelectAction,
+	"setfile":      SetFileAction,
+	"navigate":     NavigateAction,
+	"goto":         NavigateAction,
+	"wait":         WaitAction,
+	"waitselector": WaitAction,
+	"totp":         TOTPAction,
+	"sleep":        SleepAction,
+}
+
+// ParseActionType 将字符串转换为动作类型，忽略大小写、下划线与中划线，如 fill、wait-for-selector 等
+func ParseActionType(action string) (ActionType, error) {
+	name := strings.ToLower(strings.TrimSpace(action))
+	name = strings.NewReplacer("_", "", "-", "", "for", "").Replace(name)
+	if t, ok := actionAlias[name]; ok {
+		return t, nil
+	}
+	return "", utils.Errorf("invalid pre action type: %v", action)
+}
+
+// ParseLoginActions 解析 json 格式的登录流程，格式为 PreActionJson 数组
+//
+//	[
+//	  {"action": "navigate", "params": "http://example.com/login"},
+//	  {"action": "fill", "selector": "#username", "params": "admin"},
+//	  {"action": "fill", "selector": "#password", "params": "password"},
+//	  {"action": "click", "selector": "#submit"},
+//	  {"action": "wait", "selector": "#otp", "params": "10"},
+//	  {"action": "totp", "selector": "#otp", "params": "secret"},
+//	  {"action": "click", "selector": "#verify"}
+//	]
+func ParseLoginActions(data string) ([]*PreAction, error) {
+	var items []PreActionJson
+	if err := json.Unmarshal([]byte(data), &items); err != nil {
+		return nil, utils.Errorf("unmarshal login actions error: %v", err)
+	}
+	actions := make([]*PreAction, 0, len(items))
+	for index, item := range items {
+		actionType, err := ParseActionType(item.Action)
+		if err != nil {
+			return nil, utils.Errorf("login action %d error: %v", index, err)
+		}
+		action := &PreAction{Action: actionType, Selector: item.Selector, Params: item.Params}
+		if err = checkLoginAction(action); err != nil {
+			return nil, utils.Errorf("login action %d error: %v", index, err)
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func checkLoginAction(action *PreAction) error {
+	switch action.Action {
+	case NavigateAction:
+		if action.Params == "" {
+			return utils.Error("navigate action need url params")
+		}
+	case SleepAction:
+		if _, err := parseSeconds(action.Params, 0); err != nil {
+			return err
+		}
+	case WaitAction:
+		if action.Selector == "" {
+			return utils.Error("wait action need selector")
+		}
+		if _, err := parseSeconds(action.Params, defaultWaitTimeout); err != nil {
+			return err
+		}
+	case TOTPAction:
+		if action.Selector == "" || action.Params == "" {
+			return utils.Error("totp action need selector and secret params")
+		}
+	default:
+		if action.Selector == "" {
+			return utils.Errorf("%v action need selector", action.Action)
+		}
+	}
+	return nil
+}
+
+func parseSeconds(raw string, defaultValue time.Duration) (time.Duration, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return defaultValue, nil
+	}
+	seconds, err := strconv.ParseFloat(raw, 64)
+	if err != nil || seconds < 0 {
+		return 0, utils.Errorf("invalid seconds params: %v", raw)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// TOTPCode 根据密钥生成当前的 TOTP 验证码，密钥规则与 twofa.GetUTCCode 一致
+// 以 base32: 开头时视为身份验证器中常见的 base32 种子
+func TOTPCode(secret string) string {
+	if seed, ok := strings.CutPrefix(secret, "base32:"); ok {
+		seed = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(seed), " ", ""))
+		if remainder := len(seed) % 8; remainder != 0 {
+			seed += strings.Repeat("=", 8-remainder)
+		}
+		config := twofa.NewTOTPConfig("")
+		config.Secret = seed
+		return config.GetToptUTCCodeString()
+	}
+	return twofa.GetUTCCode(secret)
+}
+
+// LoginAct 执行登录流程中的单个动作，navigate/wait/totp/sleep 以外的动作交由 PreAct 处理
+func LoginAct(page *rod.Page, action *PreAction) error {
+	switch action.Action {
+	case NavigateAction:
+		if err := page.Navigate(action.Params); err != nil {
+			return utils.Errorf("login action %s error: %v", action, err)
+		}
+		if err := page.WaitLoad(); err != nil {
+			return utils.Errorf("login action %s page wait load error: %v", action, err)
+		}
+		return nil
+	case SleepAction:
+		duration, err := parseSeconds(action.Params, 0)
+		if err != nil {
+			return err
+		}
+		time.Sleep(duration)
+		return nil
+	case WaitAction:
+		timeout, err := parseSeconds(action.Params, defaultWaitTimeout)
+		if err != nil {
+			return err
+		}
+		if _, err = page.Timeout(timeout).Element(action.Selector); err != nil {
+			return utils.Errorf("login action %s error: %v", action, err)
+		}
+		return nil
+	case TOTPAction:
+		return PreAct(page, &PreAction{Action: InputAction, Selector: action.Selector, Params: TOTPCode(action.Params)})
+	default:
+		return PreAct(page, action)
+	}
+}
+
+// LoginActs 依次执行登录流程
+func LoginActs(page *rod.Page, actions []*PreAction) error {
+	for _, action := range actions {
+		err := LoginAct(page, action)
+		if err != nil {
+			return utils.Errorf("login actions error: %v", err)
+		}
+	}
+	return nil
+}
+
+// MarshalActions 将动作列表导出为 json，可以由 ParseLoginActions 重新载入
+func MarshalActions(actions []*PreAction) (string, error) {
+	items := make([]PreActionJson, 0, len(actions))
+	for _, action := range actions {
+		items = append(items, PreActionJson{Action: string(action.Action), Selector: action.Selector, Params: action.Params})
+	}
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return "", utils.Errorf("marshal login actions error: %v", err)
+	}
+	return string(raw), nil
+}
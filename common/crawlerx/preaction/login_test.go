@@ -0,0 +1,45 @@
+package preaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/twofa"
+)
+
+func TestParseLoginActions(t *testing.T) {
+	actions, err := ParseLoginActions(`[
+		{"action":"goto","params":"http://example.com/login"},
+		{"action":"fill","selector":"#user","params":"admin"},
+		{"action":"wait-for-selector","selector":"#otp","params":"5"},
+		{"action":"TOTP","selector":"#otp","params":"secret"},
+		{"action":"sleep","params":"0.5"},
+		{"action":"click","selector":"#verify"}
+	]`)
+	require.NoError(t, err)
+	var types []ActionType
+	for _, action := range actions {
+		types = append(types, action.Action)
+	}
+	assert.Equal(t, []ActionType{NavigateAction, InputAction, WaitAction, TOTPAction, SleepAction, ClickAction}, types)
+
+	for _, data := range []string{
+		`[{"action":"navigate"}]`,
+		`[{"action":"click"}]`,
+		`[{"action":"totp","selector":"#otp"}]`,
+		`[{"action":"sleep","params":"abc"}]`,
+		`[{"action":"drag","selector":"#a"}]`,
+		`{}`,
+	} {
+		_, err = ParseLoginActions(data)
+		assert.Error(t, err, data)
+	}
+}
+
+func TestTOTPCode(t *testing.T) {
+	assert.Equal(t, twofa.GetUTCCode("secret"), TOTPCode("secret"))
+	// "secret" 的 base32 编码为 ONSWG4TFOQ======，种子省略填充时同样可用
+	assert.Equal(t, twofa.GetUTCCode("secret"), TOTPCode("base32:onswg4tfoq"))
+	assert.Len(t, TOTPCode("base32:JBSWY3DPEHPK3PXP"), 6)
+}
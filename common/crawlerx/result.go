@@ -26,6 +26,8 @@ type ReqInfo interface {
 	Screenshot() string
 
 	From() string
+	// State 开启状态探索时为发出 XHR/fetch 请求的页面状态指纹
+	State() string
 }
 
 type RequestResult struct {
@@ -35,6 +37,7 @@ type RequestResult struct {
 	request  HijackRequest
 	response HijackResponse
 	from     string
+	state    string
 }
 
 func (result *RequestResult) Url() string {
@@ -111,6 +114,10 @@ func (result *RequestResult) From() string {
 	return result.from
 }
 
+func (result *RequestResult) State() string {
+	return result.state
+}
+
 type SimpleResult struct {
 	url        string
 	screenshot string
@@ -182,3 +189,75 @@ func (*SimpleResult) StatusCode() int {
 func (simpleResult *SimpleResult) From() string {
 	return simpleResult.from
 }
+
+func (*SimpleResult) State() string {
+	return ""
+}
+
+// StateTransitionResult 是状态探索中记录的一次状态转换：在 FromState 状态下点击 Action 元素后进入 State 状态
+type StateTransitionResult struct {
+	url       string
+	from      string
+	fromState string
+	toState   string
+	action    string
+}
+
+func (result *StateTransitionResult) Type() string {
+	return "state transition"
+}
+
+func (result *StateTransitionResult) Url() string {
+	return result.url
+}
+
+func (*StateTransitionResult) Method() string {
+	return "STATE"
+}
+
+func (*StateTransitionResult) RequestHeaders() map[string]string {
+	return nil
+}
+
+func (*StateTransitionResult) RequestBody() string {
+	return ""
+}
+
+func (*StateTransitionResult) RequestRaw() ([]byte, error) {
+	return nil, nil
+}
+
+func (*StateTransitionResult) StatusCode() int {
+	return 0
+}
+
+func (*StateTransitionResult) ResponseHeaders() map[string]string {
+	return nil
+}
+
+func (*StateTransitionResult) ResponseBody() string {
+	return ""
+}
+
+func (*StateTransitionResult) Screenshot() string {
+	return ""
+}
+
+func (result *StateTransitionResult) From() string {
+	return result.from
+}
+
+// State 转换后的页面状态指纹
+func (result *StateTransitionResult) State() string {
+	return result.toState
+}
+
+// FromState 转换前的页面状态指纹
+func (result *StateTransitionResult) FromState() string {
+	return result.fromState
+}
+
+// Action 触发转换的元素选择器
+func (result *StateTransitionResult) Action() string {
+	return result.action
+}
@@ -0,0 +1,154 @@
+package crawlerx
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// SessionRefresher 使用无头浏览器执行登录流程获取会话，供 web fuzzer 等不经过浏览器的请求复用
+type SessionRefresher struct {
+	targetUrl string
+	config    *Config
+
+	lock    sync.Mutex
+	session *LoginSession
+}
+
+// NewSessionRefresher 创建会话刷新器，第一个参数为目标url，选项与 StartCrawler 一致，必须设置 loginSequence
+// 配合 web fuzzer 热加载使用时，可以在 beforeRequest 中使用 Apply 设置 Cookie，在 retryHandler 中使用 RefreshIfExpired 判断并刷新会话
+//
+// Examples:
+// ```
+//
+//	refresher, err = crawlerx.NewSessionRefresher("http://testphp.vulnweb.com/", crawlerx.loginSequence(json.dumps(sequence)))
+//	die(err)
+//	beforeRequest = func(req) {
+//	    return refresher.Apply(req)
+//	}
+//	retryHandler = func(https, req, rsp) {
+//	    return refresher.RefreshIfExpired(rsp)
+//	}
+//
+// ```
+func NewSessionRefresher(targetUrl string, opts ...ConfigOpt) (*SessionRefresher, error) {
+	config := NewConfig()
+	WithTargetUrl(targetUrl)(config)
+	for _, opt := range opts {
+		opt(config)
+	}
+	if len(config.baseConfig.loginActions) == 0 {
+		return nil, utils.Error("session refresher need login sequence")
+	}
+	return &SessionRefresher{
+		targetUrl: targetUrl,
+		config:    config,
+	}, nil
+}
+
+// Refresh 启动浏览器重新执行登录流程并返回新的会话
+func (refresher *SessionRefresher) Refresh() (*LoginSession, error) {
+	refresher.lock.Lock()
+	defer refresher.lock.Unlock()
+	return refresher.refresh()
+}
+
+func (refresher *SessionRefresher) refresh() (*LoginSession, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	WithContext(ctx)(refresher.config)
+	browserConfig := &BrowserConfig{}
+	if len(refresher.config.browsers) > 0 {
+		browserConfig = refresher.config.browsers[0]
+	}
+	starter := NewBrowserStarter(browserConfig, refresher.config.baseConfig)
+	defer starter.cancel()
+	err := starter.baseBrowserStarter()
+	if err != nil {
+		return nil, utils.Errorf("session refresher start browser error: %v", err)
+	}
+	defer func() {
+		_ = starter.browser.Close()
+	}()
+	session, err := starter.login()
+	if err != nil {
+		return nil, utils.Errorf("session refresher login error: %v", err)
+	}
+	refresher.session = session
+	return session, nil
+}
+
+// Session 返回当前会话，尚未登录时先执行登录流程
+func (refresher *SessionRefresher) Session() (*LoginSession, error) {
+	refresher.lock.Lock()
+	defer refresher.lock.Unlock()
+	if refresher.session != nil {
+		return refresher.session, nil
+	}
+	return refresher.refresh()
+}
+
+// Cookie 返回当前会话的 Cookie 字符串，登录失败时返回空字符串
+func (refresher *SessionRefresher) Cookie() string {
+	session, err := refresher.Session()
+	if err != nil {
+		return ""
+	}
+	return session.CookieString()
+}
+
+// Apply 将当前会话的 Cookie 写入请求报文，已有的同名 Cookie 会被替换，登录失败时返回原始报文
+func (refresher *SessionRefresher) Apply(packet any) []byte {
+	raw := utils.InterfaceToBytes(packet)
+	session, err := refresher.Session()
+	if err != nil {
+		return raw
+	}
+	for key, value := range session.Cookies {
+		raw = lowhttp.ReplaceHTTPPacketCookie(raw, key, value)
+	}
+	return raw
+}
+
+// IsExpired 根据 sessionExpired 设置的条件判断响应报文是否意味着登录态失效
+func (refresher *SessionRefresher) IsExpired(response any) bool {
+	check := refresher.config.baseConfig.sessionExpired
+	if check == nil {
+		check = defaultSessionExpiredCheck(refresher.config.baseConfig.loginActions)
+	}
+	raw := utils.InterfaceToBytes(response)
+	header := make(http.Header)
+	for key, values := range lowhttp.GetHTTPPacketHeadersFull(raw) {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	_, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(raw)
+	return check.match("", lowhttp.GetStatusCodeFromResponse(raw), header, string(body))
+}
+
+// RefreshIfExpired 响应报文意味着登录态失效时刷新会话，刷新成功返回 true，可以直接作为 retryHandler 的返回值
+// 并发请求同时失效时，在重新登录的间隔内只会刷新一次
+func (refresher *SessionRefresher) RefreshIfExpired(response any) bool {
+	if !refresher.IsExpired(response) {
+		return false
+	}
+	refresher.lock.Lock()
+	defer refresher.lock.Unlock()
+	if refresher.session != nil && time.Since(refresher.session.Time) < refresher.cooldown() {
+		return true
+	}
+	_, err := refresher.refresh()
+	return err == nil
+}
+
+func (refresher *SessionRefresher) cooldown() time.Duration {
+	if check := refresher.config.baseConfig.sessionExpired; check != nil {
+		return check.cooldown
+	}
+	return defaultReloginCooldown
+}
@@ -16,9 +16,12 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-rod/rod"
+	"github.com/yaklang/yaklang/common/crawlerx/preaction"
 	"github.com/yaklang/yaklang/common/crawlerx/tools"
 	"github.com/yaklang/yaklang/common/utils"
 )
@@ -89,6 +92,19 @@ type BrowserStarter struct {
 
 	aiInputUrl  string
 	aiInputInfo string
+
+	loginActions    []*preaction.PreAction
+	sessionExpired  *sessionExpiredCheck
+	loginLock       sync.Mutex
+	loginTargetLock sync.Mutex
+	loginTargets    map[proto.TargetTargetID]bool
+	reloginRetried  map[string]bool
+	lastLogin       time.Time
+	relogging       atomic.Bool
+
+	stateExplore bool
+	stateGraph   *stateGraph
+	pageStates   sync.Map
 }
 
 func NewBrowserStarter(browserConfig *BrowserConfig, baseConfig *BaseConfig) *BrowserStarter {
@@ -134,6 +150,13 @@ func NewBrowserStarter(browserConfig *BrowserConfig, baseConfig *BaseConfig) *Br
 
 		aiInputUrl:  baseConfig.aiInputUrl,
 		aiInputInfo: baseConfig.aiInputInfo,
+
+		loginActions:   baseConfig.loginActions,
+		sessionExpired: baseConfig.sessionExpired,
+		loginTargets:   make(map[proto.TargetTargetID]bool),
+		reloginRetried: make(map[string]bool),
+
+		stateExplore: baseConfig.stateExplore,
 	}
 	var ctx context.Context
 	var cancel context.CancelFunc
@@ -170,6 +193,12 @@ func NewBrowserStarter(browserConfig *BrowserConfig, baseConfig *BaseConfig) *Br
 		starter.evalJs = append(starter.evalJs, e)
 	}
 	starter.jsResultSend = starter.baseConfig.jsResultSave
+	if starter.stateExplore {
+		starter.stateGraph = newStateGraph(baseConfig.maxStates)
+	}
+	if len(starter.loginActions) > 0 && starter.sessionExpired == nil {
+		starter.sessionExpired = defaultSessionExpiredCheck(starter.loginActions)
+	}
 	return &starter
 }
 
@@ -184,6 +213,12 @@ func (starter *BrowserStarter) startBrowser() error {
 			return utils.Errorf("do local storage error: %v", err)
 		}
 	}
+	if len(starter.loginActions) > 0 {
+		_, err = starter.login()
+		if err != nil {
+			return utils.Errorf("do login sequence error: %v", err)
+		}
+	}
 	err = starter.createBrowserHijack(starter.browser)
 	if err != nil {
 		return utils.Errorf(`create browser error: %v`, err)
@@ -309,6 +344,9 @@ func (starter *BrowserStarter) pageDetectEventGenerator() {
 }
 
 func (starter *BrowserStarter) scanCreatedTarget(targetID proto.TargetTargetID) {
+	if starter.isLoginTarget(targetID) {
+		return
+	}
 	starter.counter.Add()
 	defer starter.counter.Minus()
 	page, err := starter.browser.PageFromTarget(targetID)
@@ -459,6 +497,7 @@ func (starter *BrowserStarter) createBrowserHijack(browser *rod.Browser) error {
 			hijack.Response.SetBody("")
 			return
 		}
+		starter.checkSessionExpired(hijack)
 		if starter.stopSignal {
 			return
 		}
@@ -474,6 +513,14 @@ func (starter *BrowserStarter) createBrowserHijack(browser *rod.Browser) error {
 				afterRepeatUrl = starter.urlAfterRepeat(afterRepeatUrl)
 			}
 		}
+		// 状态探索时同一个 XHR/fetch 请求在不同的页面状态中分别输出
+		state := ""
+		if starter.stateExplore && isStateRequest(resourceType) {
+			state = starter.getRequestState(hijack)
+			if state != "" {
+				afterRepeatUrl += " state:" + state
+			}
+		}
 		if !starter.resultSent(afterRepeatUrl) {
 			return
 		}
@@ -517,6 +564,7 @@ func (starter *BrowserStarter) createBrowserHijack(browser *rod.Browser) error {
 		result := RequestResult{}
 		result.request = hijack.Request
 		result.response = hijack.Response
+		result.state = state
 		//result.from = pageUrl
 		select {
 		case <-starter.ctx.Done():
@@ -0,0 +1,151 @@
+package crawlerx
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	defaultMaxStates      = 100
+	defaultMaxStateAction = 30
+)
+
+// pageState 是单页应用中的一个页面状态，由 DOM 结构哈希标识
+type pageState struct {
+	Hash string
+	Url  string
+	// 状态所属的入口页面，恢复状态时从入口页面开始依次点击 Path 中的元素
+	Entry string
+	Path  []string
+	Depth int
+
+	actions  []string
+	explored map[string]bool
+}
+
+func (state *pageState) unexplored() int {
+	return len(state.actions) - len(state.explored)
+}
+
+type stateTransition struct {
+	From   string
+	Action string
+	To     string
+}
+
+// stateGraph 记录页面状态以及状态之间由哪个动作转换，优先探索尚未探索过的状态
+type stateGraph struct {
+	lock sync.Mutex
+
+	states   map[string]*pageState
+	transSet map[stateTransition]bool
+
+	maxStates int
+	maxAction int
+}
+
+func newStateGraph(maxStates int) *stateGraph {
+	if maxStates <= 0 {
+		maxStates = defaultMaxStates
+	}
+	return &stateGraph{
+		states:    make(map[string]*pageState),
+		transSet:  make(map[stateTransition]bool),
+		maxStates: maxStates,
+		maxAction: defaultMaxStateAction,
+	}
+}
+
+// addState 记录新的状态，状态已存在时返回已有的状态，达到状态上限时返回 nil
+func (graph *stateGraph) addState(hash, urlStr, entry string, path []string, actions []string) (*pageState, bool) {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+	if state, ok := graph.states[hash]; ok {
+		return state, false
+	}
+	if len(graph.states) >= graph.maxStates {
+		return nil, false
+	}
+	uniqueActions := make([]string, 0, len(actions))
+	for _, action := range actions {
+		if len(uniqueActions) >= graph.maxAction {
+			break
+		}
+		if action != "" && !StringArrayContains(uniqueActions, action) {
+			uniqueActions = append(uniqueActions, action)
+		}
+	}
+	state := &pageState{
+		Hash:     hash,
+		Url:      urlStr,
+		Entry:    entry,
+		Path:     append([]string{}, path...),
+		Depth:    len(path),
+		actions:  uniqueActions,
+		explored: make(map[string]bool),
+	}
+	graph.states[hash] = state
+	return state, true
+}
+
+// addTransition 记录状态转换，返回是否为新的转换
+func (graph *stateGraph) addTransition(from, action, to string) bool {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+	transition := stateTransition{From: from, Action: action, To: to}
+	if graph.transSet[transition] {
+		return false
+	}
+	graph.transSet[transition] = true
+	return true
+}
+
+// nextAction 选择入口页面下一个要执行的动作并标记为已探索
+// 从未探索过的状态优先，其次是层级更浅、剩余动作更多的状态
+func (graph *stateGraph) nextAction(entry string) (*pageState, string, bool) {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+	candidates := make([]*pageState, 0)
+	for _, state := range graph.states {
+		if state.Entry == entry && state.unexplored() > 0 {
+			candidates = append(candidates, state)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, "", false
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (len(a.explored) == 0) != (len(b.explored) == 0) {
+			return len(a.explored) == 0
+		}
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		if a.unexplored() != b.unexplored() {
+			return a.unexplored() > b.unexplored()
+		}
+		return a.Hash < b.Hash
+	})
+	state := candidates[0]
+	for _, action := range state.actions {
+		if !state.explored[action] {
+			state.explored[action] = true
+			return state, action, true
+		}
+	}
+	return nil, "", false
+}
+
+func (graph *stateGraph) State(hash string) (*pageState, bool) {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+	state, ok := graph.states[hash]
+	return state, ok
+}
+
+func (graph *stateGraph) Len() int {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+	return len(graph.states)
+}
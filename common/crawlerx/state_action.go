@@ -0,0 +1,225 @@
+package crawlerx
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+// pageStateHash 计算页面当前状态的指纹
+func pageStateHash(page *rod.Page) (string, error) {
+	structure, err := EvalOnPage(page, getDomStructure)
+	if err != nil {
+		return "", utils.Errorf(`page get dom structure error: %v`, err)
+	}
+	return codec.Sha256(structure.Value.Str())[:16], nil
+}
+
+func (starter *BrowserStarter) getStateActions(page *rod.Page) ([]string, error) {
+	results := make([]string, 0)
+	elementObjs, err := EvalOnPage(page, getStateActionElements)
+	if err != nil {
+		return results, utils.Errorf(`page get state action elements error: %v`, err)
+	}
+	for _, element := range elementObjs.Value.Arr() {
+		results = append(results, element.String())
+	}
+	return results, nil
+}
+
+// setPageState 记录页面当前所在的状态，页面发出的 XHR/fetch 请求会带上该状态
+func (starter *BrowserStarter) setPageState(page *rod.Page, hash string) {
+	starter.pageStates.Store(page.FrameID, hash)
+}
+
+func (starter *BrowserStarter) getRequestState(hijack *CrawlerHijack) string {
+	if hijack.Request.event == nil {
+		return ""
+	}
+	state, ok := starter.pageStates.Load(hijack.Request.event.FrameID)
+	if !ok {
+		return ""
+	}
+	return state.(string)
+}
+
+// recordState 记录页面当前的状态，新状态会先填写表单再收集可交互的元素
+func (starter *BrowserStarter) recordState(page *rod.Page, entry string, path []string) (*pageState, error) {
+	hash, err := pageStateHash(page)
+	if err != nil {
+		return nil, err
+	}
+	starter.setPageState(page, hash)
+	currentUrl, _ := getCurrentUrl(page)
+	if state, ok := starter.stateGraph.State(hash); ok {
+		return state, nil
+	}
+	err = starter.doInput(currentUrl, page)
+	if err != nil {
+		log.Debugf(`state %s do input error: %v`, hash, err)
+	}
+	actions, err := starter.getStateActions(page)
+	if err != nil {
+		return nil, err
+	}
+	state, isNew := starter.stateGraph.addState(hash, currentUrl, entry, path, actions)
+	if isNew {
+		log.Debugf(`new state %s on %s with %d actions`, hash, currentUrl, len(actions))
+	}
+	return state, nil
+}
+
+// restoreState 从入口页面开始依次点击路径中的元素，恢复到指定的状态
+func (starter *BrowserStarter) restoreState(page *rod.Page, state *pageState) error {
+	hash, err := pageStateHash(page)
+	if err == nil && hash == state.Hash {
+		return nil
+	}
+	err = page.Navigate(state.Entry)
+	if err != nil {
+		return utils.Errorf("page navigate %s error: %s", state.Entry, err)
+	}
+	err = page.WaitLoad()
+	if err != nil {
+		return utils.Errorf(`page wait load error: %v`, err)
+	}
+	if starter.extraWaitLoadTime != 0 {
+		time.Sleep(time.Duration(starter.extraWaitLoadTime) * time.Millisecond)
+	}
+	for _, selector := range state.Path {
+		if !starter.clickElementOnPageBySelector(page, selector) {
+			return utils.Errorf(`replay state %s click %s failed`, state.Hash, selector)
+		}
+	}
+	hash, err = pageStateHash(page)
+	if err != nil {
+		return err
+	}
+	if hash != state.Hash {
+		return utils.Errorf(`state %s cannot be restored, got %s`, state.Hash, hash)
+	}
+	return nil
+}
+
+// stateActionOnPage 以 DOM 状态图的方式探索单页应用：记录每个状态可交互的元素，
+// 依次点击未探索的元素并记录转换后的状态，直到没有未探索的动作或达到状态上限
+func (starter *BrowserStarter) stateActionOnPage(page *rod.Page) error {
+	entry, _ := getCurrentUrl(page)
+	if entry == "" {
+		return nil
+	}
+	defer starter.pageStates.Delete(page.FrameID)
+	urls, err := starter.getUrls(page)
+	if err != nil {
+		return utils.Errorf(`Page %s get urls error: %s`, entry, err)
+	}
+	for _, urlStr := range urls {
+		if starter.banList.Exist(urlStr) {
+			continue
+		}
+		err = starter.urlsExploit(entry, urlStr)
+		if err != nil {
+			return utils.Errorf(`Url %v from %v exploit error: %v`, urlStr, entry, err.Error())
+		}
+	}
+	root, err := starter.recordState(page, entry, nil)
+	if err != nil {
+		return err
+	}
+	if root == nil || root.Entry != entry {
+		// 状态已经由其他入口页面探索或达到状态上限
+		return nil
+	}
+	entryUrl, _ := url.Parse(entry)
+	// 页面超时时间作用于每一步探索，而不是整个探索过程
+	timeout := time.Duration(starter.baseConfig.pageTimeout) * time.Second
+	if timeout != 0 {
+		page = page.CancelTimeout()
+	}
+	for !starter.stopSignal {
+		select {
+		case <-starter.ctx.Done():
+			return nil
+		default:
+		}
+		state, action, ok := starter.stateGraph.nextAction(entry)
+		if !ok {
+			break
+		}
+		stepPage := page
+		if timeout != 0 {
+			stepPage = page.Timeout(timeout)
+		}
+		err = starter.stateStep(stepPage, entry, entryUrl, state, action)
+		if timeout != 0 {
+			stepPage.CancelTimeout()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	log.Debugf(`state explore on %s done, %d states recorded`, entry, starter.stateGraph.Len())
+	return nil
+}
+
+// stateStep 恢复到指定状态后点击元素，记录转换后的状态，只有 url 处理失败时返回错误
+func (starter *BrowserStarter) stateStep(page *rod.Page, entry string, entryUrl *url.URL, state *pageState, action string) error {
+	err := starter.restoreState(page, state)
+	if err != nil {
+		log.Debugf(`restore state error: %v`, err)
+		return nil
+	}
+	starter.setPageState(page, state.Hash)
+	if !starter.clickElementOnPageBySelector(page, action) {
+		return nil
+	}
+	if starter.extraWaitLoadTime != 0 {
+		time.Sleep(time.Duration(starter.extraWaitLoadTime) * time.Millisecond)
+	}
+	currentUrl, _ := getCurrentUrl(page)
+	if currentUrl != "" && currentUrl != state.Url {
+		if !starter.urlTree.Has(currentUrl) {
+			starter.urlTree.Add(state.Url, currentUrl)
+		}
+		if !starter.banList.Exist(currentUrl) {
+			err = starter.urlsExploit(state.Url, currentUrl)
+			if err != nil {
+				return utils.Errorf(`Url %v from %v exploit error: %v`, currentUrl, state.Url, err.Error())
+			}
+		}
+		// 跳转到其他站点的页面不再作为状态记录
+		currentUrlObj, err := url.Parse(currentUrl)
+		if err != nil || entryUrl == nil || currentUrlObj.Host != entryUrl.Host {
+			return nil
+		}
+	}
+	path := append(append([]string{}, state.Path...), action)
+	next, err := starter.recordState(page, entry, path)
+	if err != nil {
+		log.Debugf(`record state error: %v`, err)
+		return nil
+	}
+	if next != nil && starter.stateGraph.addTransition(state.Hash, action, next.Hash) {
+		result := StateTransitionResult{
+			url:       next.Url,
+			from:      state.Url,
+			fromState: state.Hash,
+			toState:   next.Hash,
+			action:    action,
+		}
+		select {
+		case <-starter.ctx.Done():
+		case starter.ch <- &result:
+		}
+	}
+	return nil
+}
+
+func isStateRequest(resourceType proto.NetworkResourceType) bool {
+	return resourceType == proto.NetworkResourceTypeXHR || resourceType == proto.NetworkResourceTypeFetch
+}
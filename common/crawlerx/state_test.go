@@ -0,0 +1,65 @@
+package crawlerx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateGraphAddState(t *testing.T) {
+	graph := newStateGraph(2)
+	root, isNew := graph.addState("root", "http://example.com/#/", "http://example.com/", nil, []string{"#a", "#b", "#a", ""})
+	require.True(t, isNew)
+	assert.Equal(t, []string{"#a", "#b"}, root.actions)
+	assert.Equal(t, 0, root.Depth)
+
+	same, isNew := graph.addState("root", "http://example.com/#/other", "http://example.com/other", []string{"#x"}, nil)
+	assert.False(t, isNew)
+	assert.Same(t, root, same)
+
+	child, isNew := graph.addState("child", "http://example.com/#/", "http://example.com/", []string{"#a"}, []string{"#c"})
+	require.True(t, isNew)
+	assert.Equal(t, 1, child.Depth)
+
+	full, isNew := graph.addState("more", "http://example.com/#/", "http://example.com/", []string{"#b"}, nil)
+	assert.Nil(t, full)
+	assert.False(t, isNew)
+	assert.Equal(t, 2, graph.Len())
+
+	assert.True(t, graph.addTransition("root", "#a", "child"))
+	assert.False(t, graph.addTransition("root", "#a", "child"))
+	assert.True(t, graph.addTransition("child", "#c", "root"))
+}
+
+func TestStateGraphNextAction(t *testing.T) {
+	entry := "http://example.com/"
+	graph := newStateGraph(0)
+	graph.addState("root", entry, entry, nil, []string{"#a", "#b"})
+	graph.addState("other", entry, "http://example.com/other", nil, []string{"#z"})
+
+	state, action, ok := graph.nextAction(entry)
+	require.True(t, ok)
+	assert.Equal(t, "root", state.Hash)
+	assert.Equal(t, "#a", action)
+
+	// 新发现的状态尚未探索过，优先于已经部分探索的入口状态
+	graph.addState("child", entry, entry, []string{"#a"}, []string{"#c"})
+	state, action, ok = graph.nextAction(entry)
+	require.True(t, ok)
+	assert.Equal(t, "child", state.Hash)
+	assert.Equal(t, "#c", action)
+
+	state, action, ok = graph.nextAction(entry)
+	require.True(t, ok)
+	assert.Equal(t, "root", state.Hash)
+	assert.Equal(t, "#b", action)
+
+	_, _, ok = graph.nextAction(entry)
+	assert.False(t, ok)
+
+	state, action, ok = graph.nextAction("http://example.com/other")
+	require.True(t, ok)
+	assert.Equal(t, "other", state.Hash)
+	assert.Equal(t, "#z", action)
+}
@@ -3,6 +3,7 @@ package cybertunnel
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"math/rand"
@@ -16,6 +17,7 @@ import (
 	"github.com/yaklang/yaklang/common/facades"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
 )
 
 var DefaultExternalIP *net.IP
@@ -199,6 +201,66 @@ func NewDNSLogServer(domain string, externalIP string) (*DNSLogGRPCServer, error
 	return NewDNSLogServerWithListeningPort(domain, externalIP, 53)
 }
 func NewDNSLogServerWithListeningPort(domain string, externalIP string, port int) (*DNSLogGRPCServer, error) {
+	return NewDNSLogServerEx(domain, externalIP, port)
+}
+
+type dnslogServerConfig struct {
+	dotAddr   string
+	dohAddr   string
+	tlsConfig *tls.Config
+}
+
+type DNSLogServerOption func(c *dnslogServerConfig)
+
+// WithDNSLogDoT 在 addr 上开启 DNS over TLS 监听，例如 0.0.0.0:853
+func WithDNSLogDoT(addr string) DNSLogServerOption {
+	return func(c *dnslogServerConfig) {
+		c.dotAddr = addr
+	}
+}
+
+// WithDNSLogDoH 在 addr 上开启 DNS over HTTPS 监听，例如 0.0.0.0:443，查询路径为 /dns-query
+func WithDNSLogDoH(addr string) DNSLogServerOption {
+	return func(c *dnslogServerConfig) {
+		c.dohAddr = addr
+	}
+}
+
+// WithDNSLogTLSConfig 设置 DoT/DoH 使用的证书，不设置时使用自签名证书
+func WithDNSLogTLSConfig(tlsConfig *tls.Config) DNSLogServerOption {
+	return func(c *dnslogServerConfig) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+func newDNSLogSelfSignedTLSConfig(domains []string) (*tls.Config, error) {
+	host := "dnslog.yaklang.io"
+	if len(domains) > 0 {
+		host = domains[0]
+	}
+	var alternateDNS []string
+	for _, domain := range domains {
+		alternateDNS = append(alternateDNS, domain, "*."+domain)
+	}
+	certPem, keyPem, err := tlsutils.GenerateSelfSignedCertKeyWithCommonName("Yak DNSLog Service", host, nil, alternateDNS)
+	if err != nil {
+		return nil, utils.Errorf("generate self signed cert failed: %s", err)
+	}
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		return nil, utils.Errorf("parse self signed cert failed: %s", err)
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+// NewDNSLogServerEx 创建 DNSLog 服务，除了 UDP/TCP 53 之外，可以通过选项同时开启 DoT 与 DoH，
+// 所有传输方式的查询都按照同样的 token 记录
+func NewDNSLogServerEx(domain string, externalIP string, port int, opts ...DNSLogServerOption) (*DNSLogGRPCServer, error) {
+	config := &dnslogServerConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
 	ip := externalIP
 	if externalIP == "" {
 		ipIns, err := GetExternalIP()
@@ -215,6 +277,26 @@ func NewDNSLogServerWithListeningPort(domain string, externalIP string, port int
 	if err != nil {
 		return nil, err
 	}
+	domains := utils.PrettifyListFromStringSplitEx(domain, ",", "|")
+	if config.dotAddr != "" || config.dohAddr != "" {
+		tlsConfig := config.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig, err = newDNSLogSelfSignedTLSConfig(domains)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if config.dotAddr != "" {
+			if err := coreDNSServer.EnableDoT(config.dotAddr, tlsConfig); err != nil {
+				return nil, err
+			}
+		}
+		if config.dohAddr != "" {
+			if err := coreDNSServer.EnableDoH(config.dohAddr, tlsConfig); err != nil {
+				return nil, err
+			}
+		}
+	}
 	go func() {
 		for {
 			err := coreDNSServer.Serve(context.Background())
@@ -257,7 +339,6 @@ func NewDNSLogServerWithListeningPort(domain string, externalIP string, port int
 		}
 	})
 
-	domains := utils.PrettifyListFromStringSplitEx(domain, ",", "|")
 	grpcServe := &DNSLogGRPCServer{
 		ExternalIP:       externalIP,
 		domain:           domains,
@@ -2,7 +2,14 @@ package cybertunnel
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/yaklang/yaklang/common/cybertunnel/tpb"
 	"github.com/yaklang/yaklang/common/log"
@@ -90,3 +97,75 @@ func TestDNSLogServer(t *testing.T) {
 		t.Fatal("not all dnslogs are checked")
 	}
 }
+
+func TestDNSLogServer_EncryptedTransport(t *testing.T) {
+	rootDomain := strings.ToLower(utils.RandStringBytes(8)) + ".org"
+	port := utils.GetRandomAvailableTCPPort()
+	dotAddr := utils.HostPort("127.0.0.1", utils.GetRandomAvailableTCPPort())
+	dohAddr := utils.HostPort("127.0.0.1", utils.GetRandomAvailableTCPPort())
+	server, err := NewDNSLogServerEx(rootDomain, "127.0.0.1", port, WithDNSLogDoT(dotAddr), WithDNSLogDoH(dohAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, addr := range []string{utils.HostPort("127.0.0.1", port), dotAddr, dohAddr} {
+		if err := utils.WaitConnect(addr, 5); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	requireDomain := func() *tpb.RequireDomainResponse {
+		rsp, err := server.RequireDomain(context.Background(), &tpb.RequireDomainParams{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rsp
+	}
+	checkEvent := func(token string, transport string) {
+		rsp, err := server.QueryExistedDNSLog(context.Background(), &tpb.QueryExistedDNSLogParams{Token: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if assert.Len(t, rsp.GetEvents(), 1, transport) {
+			event := rsp.GetEvents()[0]
+			assert.Equal(t, "A", event.GetType())
+			assert.Equal(t, "127.0.0.1", event.GetRemoteIP())
+			assert.Contains(t, string(event.GetRaw()), "TRANSPORT: "+transport)
+		}
+	}
+	newQuery := func(domain string) *dns.Msg {
+		msg := new(dns.Msg)
+		msg.SetQuestion(dns.Fqdn(domain), dns.TypeA)
+		return msg
+	}
+
+	// DoH，使用 RFC 8484 GET 请求
+	doh := requireDomain()
+	raw, err := newQuery(doh.GetDomain()).Pack()
+	assert.NoError(t, err)
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	rsp, err := httpClient.Get("https://" + dohAddr + "/dns-query?dns=" + base64.RawURLEncoding.EncodeToString(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	reply := new(dns.Msg)
+	if assert.NoError(t, reply.Unpack(body)) && assert.Len(t, reply.Answer, 1) {
+		assert.Equal(t, "127.0.0.1", reply.Answer[0].(*dns.A).A.String())
+	}
+	checkEvent(doh.GetToken(), "DoH")
+
+	// DoT
+	dot := requireDomain()
+	dotClient := &dns.Client{Net: "tcp-tls", TLSConfig: &tls.Config{InsecureSkipVerify: true}, Timeout: 5 * time.Second}
+	_, _, err = dotClient.Exchange(newQuery(dot.GetDomain()), dotAddr)
+	assert.NoError(t, err)
+	checkEvent(dot.GetToken(), "DoT")
+
+	// 普通的 TCP DNS
+	tcp := requireDomain()
+	tcpClient := &dns.Client{Net: "tcp", Timeout: 5 * time.Second}
+	_, _, err = tcpClient.Exchange(newQuery(tcp.GetDomain()), utils.HostPort("127.0.0.1", port))
+	assert.NoError(t, err)
+	checkEvent(tcp.GetToken(), "TCP")
+}
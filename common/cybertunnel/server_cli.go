@@ -2,6 +2,7 @@ package cybertunnel
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"os"
 	"syscall"
@@ -65,6 +66,26 @@ func GetTunnelServerCommandCli() *cli.App {
 			Usage: "Public IP Address: Set the public IP address",
 		},
 
+		cli.StringFlag{
+			Name:  "dot-addr",
+			Usage: "Enable DNS over TLS for DNSLog, e.g. 0.0.0.0:853",
+		},
+
+		cli.StringFlag{
+			Name:  "doh-addr",
+			Usage: "Enable DNS over HTTPS for DNSLog (path: /dns-query), e.g. 0.0.0.0:8443",
+		},
+
+		cli.StringFlag{
+			Name:  "tls-cert",
+			Usage: "TLS certificate (PEM) for DoT/DoH, self-signed certificate will be used if empty",
+		},
+
+		cli.StringFlag{
+			Name:  "tls-key",
+			Usage: "TLS private key (PEM) for DoT/DoH",
+		},
+
 		cli.StringFlag{
 			Name: "secondary-password,x", Hidden: true,
 			EnvVar: "YAK_BRIDGE_SECONDARY_PASSWORD",
@@ -231,7 +252,24 @@ func GetTunnelServerCommandCli() *cli.App {
 			if c.String("domain") == "" {
 				return utils.Error("empty dnslog domain config")
 			}
-			dnslogServer, err := NewDNSLogServer(c.String("domain"), c.String("public-ip"))
+			var opts []DNSLogServerOption
+			if addr := c.String("dot-addr"); addr != "" {
+				opts = append(opts, WithDNSLogDoT(addr))
+			}
+			if addr := c.String("doh-addr"); addr != "" {
+				opts = append(opts, WithDNSLogDoH(addr))
+			}
+			if certFile := c.String("tls-cert"); certFile != "" {
+				cert, err := tls.LoadX509KeyPair(certFile, c.String("tls-key"))
+				if err != nil {
+					return utils.Errorf("load dnslog tls cert failed: %s", err)
+				}
+				opts = append(opts, WithDNSLogTLSConfig(&tls.Config{
+					Certificates: []tls.Certificate{cert},
+					MinVersion:   tls.VersionTLS12,
+				}))
+			}
+			dnslogServer, err := NewDNSLogServerEx(c.String("domain"), c.String("public-ip"), 53, opts...)
 			if err != nil {
 				return utils.Errorf("serve dns log failed: %s", err)
 			}
@@ -2,11 +2,13 @@ package facades
 
 import (
 	"context"
+	"fmt"
 	"github.com/miekg/dns"
 	"github.com/yaklang/yaklang/common/domainextractor"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"net"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -38,6 +40,10 @@ type DNSServer struct {
 	udpCoreServer *dns.Server
 	tcpCoreServer *dns.Server
 
+	// DNS over TLS / DNS over HTTPS
+	dotCoreServer *dns.Server
+	dohCoreServer *http.Server
+
 	hijackCallback func(t string, domain string) string
 	callback       FacadeCallback
 	addrConvertor  func(i string) string
@@ -74,17 +80,17 @@ func NewDNSServer(domain, dnsLogIP, serveIPRaw string, port int) (*DNSServer, er
 	ins.udpCoreServer = &dns.Server{
 		Addr:    addr,
 		Net:     "udp",
-		Handler: ins,
+		Handler: &dnsTransportHandler{server: ins, transport: DNSTransport_UDP},
 	}
 	ins.tcpCoreServer = &dns.Server{
 		Addr:    addr,
 		Net:     "tcp",
-		Handler: ins,
+		Handler: &dnsTransportHandler{server: ins, transport: DNSTransport_TCP},
 	}
 	return ins, nil
 }
 
-func (d *DNSServer) handleQuestion(question dns.Question, w dns.ResponseWriter, r *dns.Msg) {
+func (d *DNSServer) handleQuestion(question dns.Question, w dns.ResponseWriter, r *dns.Msg, transport string) {
 
 	rootDomain := domainextractor.ExtractRootDomain(question.Name)
 	dotDomain := fqdn(rootDomain)
@@ -97,6 +103,9 @@ func (d *DNSServer) handleQuestion(question dns.Question, w dns.ResponseWriter,
 	visitorLog.SetTimestampNow()
 	visitorLog.Set("external-ip", d.ipAddr.String())
 	visitorLog.Set("root-domain", dotDomain)
+	if transport != "" {
+		visitorLog.Set("dns-transport", transport)
+	}
 	if d.addrConvertor != nil {
 		visitorLog.SetRemoteIP(d.addrConvertor(w.RemoteAddr().String()))
 	} else {
@@ -108,6 +117,9 @@ func (d *DNSServer) handleQuestion(question dns.Question, w dns.ResponseWriter,
 	m.Authoritative = true
 
 	requestMsg := r.String()
+	if transport != "" {
+		requestMsg = fmt.Sprintf(";; TRANSPORT: %s\n%s", transport, requestMsg)
+	}
 	//log.Infof("NEW DNS Req: %v", requestMsg)
 	visitorLog.Set("raw", requestMsg)
 	domain := m.Question[0].Name
@@ -297,6 +309,10 @@ func (d *DNSServer) handleQuestion(question dns.Question, w dns.ResponseWriter,
 }
 
 func (d *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	d.serveDNS(w, r, "")
+}
+
+func (d *DNSServer) serveDNS(w dns.ResponseWriter, r *dns.Msg, transport string) {
 	defer func() {
 		if err := recover(); err != nil {
 			log.Errorf("panic serve dns: %s", err)
@@ -308,7 +324,7 @@ func (d *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		return
 	}
 	for _, q := range r.Question {
-		d.handleQuestion(q, w, r)
+		d.handleQuestion(q, w, r, transport)
 	}
 }
 
@@ -325,9 +341,25 @@ func (d *DNSServer) Serve(ctx context.Context) error {
 		if d.tcpCoreServer != nil {
 			go d.tcpCoreServer.Shutdown()
 		}
+
+		if d.dotCoreServer != nil {
+			go d.dotCoreServer.Shutdown()
+		}
+
+		if d.dohCoreServer != nil {
+			go d.dohCoreServer.Close()
+		}
 	}()
 	wg := new(sync.WaitGroup)
-	wg.Add(2)
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		d.serveDoT(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		d.serveDoH(ctx)
+	}()
 	go func() {
 		defer wg.Done()
 
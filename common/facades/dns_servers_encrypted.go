@@ -0,0 +1,312 @@
+package facades
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	DNSTransport_UDP = "UDP"
+	DNSTransport_TCP = "TCP"
+	DNSTransport_DoT = "DoT"
+	DNSTransport_DoH = "DoH"
+)
+
+const (
+	dohMessageContentType = "application/dns-message"
+	dohJSONContentType    = "application/dns-json"
+)
+
+// dnsTransportHandler 记录查询来自哪一种传输方式（UDP/TCP/DoT/DoH）
+type dnsTransportHandler struct {
+	server    *DNSServer
+	transport string
+}
+
+func (h *dnsTransportHandler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	h.server.serveDNS(w, r, h.transport)
+}
+
+// EnableDoT 在 addr 上开启 DNS over TLS 服务（RFC 7858）
+func (d *DNSServer) EnableDoT(addr string, tlsConfig *tls.Config) error {
+	if tlsConfig == nil {
+		return utils.Error("dns over tls need tls config")
+	}
+	d.dotCoreServer = &dns.Server{
+		Addr:      addr,
+		Net:       "tcp-tls",
+		TLSConfig: tlsConfig,
+		Handler:   &dnsTransportHandler{server: d, transport: DNSTransport_DoT},
+	}
+	return nil
+}
+
+// EnableDoH 在 addr 上开启 DNS over HTTPS 服务，路径为 /dns-query 与 /resolve，
+// 同时支持 RFC 8484 的 wire format 与 JSON API，tlsConfig 为空时使用 HTTP
+func (d *DNSServer) EnableDoH(addr string, tlsConfig *tls.Config) error {
+	if addr == "" {
+		return utils.Error("dns over https need listen addr")
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dns-query", d.serveDoHRequest)
+	mux.HandleFunc("/resolve", d.serveDoHRequest)
+	d.dohCoreServer = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return nil
+}
+
+func (d *DNSServer) serveDoT(ctx context.Context) {
+	if d.dotCoreServer == nil {
+		return
+	}
+	for {
+		log.Infof("enable dns over tls dnslog server: %v", d.dotCoreServer.Addr)
+		err := d.dotCoreServer.ListenAndServe()
+		if err != nil {
+			log.Errorf("error failed (dot dnslog server): %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func (d *DNSServer) serveDoH(ctx context.Context) {
+	if d.dohCoreServer == nil {
+		return
+	}
+	for {
+		var err error
+		if d.dohCoreServer.TLSConfig != nil {
+			log.Infof("enable dns over https dnslog server: https://%v/dns-query", d.dohCoreServer.Addr)
+			err = d.dohCoreServer.ListenAndServeTLS("", "")
+		} else {
+			log.Infof("enable dns over https dnslog server: http://%v/dns-query", d.dohCoreServer.Addr)
+			err = d.dohCoreServer.ListenAndServe()
+		}
+		if err != nil {
+			log.Errorf("error failed (doh dnslog server): %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		if err == http.ErrServerClosed {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (d *DNSServer) serveDoHRequest(w http.ResponseWriter, req *http.Request) {
+	var (
+		msg      *dns.Msg
+		jsonMode bool
+		err      error
+	)
+	switch req.Method {
+	case http.MethodGet:
+		query := req.URL.Query()
+		if raw := query.Get("dns"); raw != "" {
+			msg, err = unpackDoHMessage(raw)
+		} else if name := query.Get("name"); name != "" {
+			jsonMode = true
+			msg, err = newDoHJSONQuery(name, query.Get("type"))
+		} else {
+			err = utils.Error("missing dns or name parameter")
+		}
+	case http.MethodPost:
+		if !strings.HasPrefix(req.Header.Get("Content-Type"), dohMessageContentType) {
+			http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+			return
+		}
+		var raw []byte
+		raw, err = io.ReadAll(io.LimitReader(req.Body, dns.MaxMsgSize))
+		if err == nil {
+			msg = new(dns.Msg)
+			err = msg.Unpack(raw)
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writer := &dohResponseWriter{remoteAddr: parseDoHRemoteAddr(req.RemoteAddr)}
+	if d.dohCoreServer != nil {
+		writer.localAddr = parseDoHRemoteAddr(d.dohCoreServer.Addr)
+	}
+	d.serveDNS(writer, msg, DNSTransport_DoH)
+	if writer.msg == nil {
+		// 没有任何问题的查询不会得到回复，返回一个空的回复
+		writer.msg = new(dns.Msg)
+		writer.msg.SetRcode(msg, dns.RcodeFormatError)
+	}
+
+	if jsonMode {
+		w.Header().Set("Content-Type", dohJSONContentType)
+		_ = json.NewEncoder(w).Encode(newDoHJSONResponse(writer.msg))
+		return
+	}
+	raw, err := writer.msg.Pack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", dohMessageContentType)
+	_, _ = w.Write(raw)
+}
+
+func unpackDoHMessage(raw string) (*dns.Msg, error) {
+	// RFC 8484 要求使用不带填充的 base64url，这里兼容带填充的情况
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(raw, "="))
+	if err != nil {
+		return nil, utils.Errorf("decode dns parameter failed: %s", err)
+	}
+	msg := new(dns.Msg)
+	if err := msg.Unpack(data); err != nil {
+		return nil, utils.Errorf("unpack dns message failed: %s", err)
+	}
+	return msg, nil
+}
+
+func newDoHJSONQuery(name string, typeRaw string) (*dns.Msg, error) {
+	qtype := dns.TypeA
+	if typeRaw != "" {
+		if t, err := strconv.Atoi(typeRaw); err == nil {
+			qtype = uint16(t)
+		} else if t, ok := dns.StringToType[strings.ToUpper(typeRaw)]; ok {
+			qtype = t
+		} else {
+			return nil, utils.Errorf("unknown dns type: %v", typeRaw)
+		}
+	}
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), qtype)
+	return msg, nil
+}
+
+type dohJSONQuestion struct {
+	Name string `json:"name"`
+	Type uint16 `json:"type"`
+}
+
+type dohJSONAnswer struct {
+	Name string `json:"name"`
+	Type uint16 `json:"type"`
+	TTL  uint32 `json:"TTL"`
+	Data string `json:"data"`
+}
+
+type dohJSONResponse struct {
+	Status   int               `json:"Status"`
+	TC       bool              `json:"TC"`
+	RD       bool              `json:"RD"`
+	RA       bool              `json:"RA"`
+	AD       bool              `json:"AD"`
+	CD       bool              `json:"CD"`
+	Question []dohJSONQuestion `json:"Question"`
+	Answer   []dohJSONAnswer   `json:"Answer,omitempty"`
+}
+
+func newDoHJSONResponse(msg *dns.Msg) *dohJSONResponse {
+	rsp := &dohJSONResponse{
+		Status: msg.Rcode,
+		TC:     msg.Truncated,
+		RD:     msg.RecursionDesired,
+		RA:     msg.RecursionAvailable,
+		AD:     msg.AuthenticatedData,
+		CD:     msg.CheckingDisabled,
+	}
+	for _, q := range msg.Question {
+		rsp.Question = append(rsp.Question, dohJSONQuestion{Name: q.Name, Type: q.Qtype})
+	}
+	for _, rr := range msg.Answer {
+		header := rr.Header()
+		// 去掉 header 部分，只保留记录的值
+		data := strings.TrimPrefix(rr.String(), header.String())
+		rsp.Answer = append(rsp.Answer, dohJSONAnswer{
+			Name: header.Name,
+			Type: header.Rrtype,
+			TTL:  header.Ttl,
+			Data: strings.TrimSpace(data),
+		})
+	}
+	return rsp
+}
+
+func parseDoHRemoteAddr(addr string) net.Addr {
+	host, port, err := utils.ParseStringToHostPort(addr)
+	if err != nil {
+		return &net.TCPAddr{}
+	}
+	return &net.TCPAddr{IP: net.ParseIP(host), Port: port}
+}
+
+// dohResponseWriter 将 HTTP 请求适配为 dns.ResponseWriter，用于复用 DNSServer 的查询处理
+type dohResponseWriter struct {
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	msg        *dns.Msg
+}
+
+var _ dns.ResponseWriter = (*dohResponseWriter)(nil)
+
+func (w *dohResponseWriter) LocalAddr() net.Addr {
+	if w.localAddr == nil {
+		return &net.TCPAddr{}
+	}
+	return w.localAddr
+}
+
+func (w *dohResponseWriter) RemoteAddr() net.Addr {
+	return w.remoteAddr
+}
+
+func (w *dohResponseWriter) WriteMsg(msg *dns.Msg) error {
+	if w.msg == nil {
+		w.msg = msg
+		return nil
+	}
+	// 多个问题时合并回复
+	w.msg.Answer = append(w.msg.Answer, msg.Answer...)
+	w.msg.Ns = append(w.msg.Ns, msg.Ns...)
+	w.msg.Extra = append(w.msg.Extra, msg.Extra...)
+	return nil
+}
+
+func (w *dohResponseWriter) Write(raw []byte) (int, error) {
+	msg := new(dns.Msg)
+	if err := msg.Unpack(raw); err != nil {
+		return 0, err
+	}
+	return len(raw), w.WriteMsg(msg)
+}
+
+func (w *dohResponseWriter) Close() error        { return nil }
+func (w *dohResponseWriter) TsigStatus() error   { return nil }
+func (w *dohResponseWriter) TsigTimersOnly(bool) {}
+func (w *dohResponseWriter) Hijack()             {}
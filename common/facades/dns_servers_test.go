@@ -1,9 +1,19 @@
 package facades
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 	"math/rand"
 	"strings"
@@ -71,3 +81,86 @@ func TestDNSServer(t *testing.T) {
 	assert.Equal(t, result, randIp)
 	assert.Equal(t, checkToken, anyDomainToken)
 }
+
+func TestDNSServer_EncryptedTransport(t *testing.T) {
+	baseDomain := strings.ToLower(utils.RandSample(10)) + ".com"
+	randIp := utils.Uint32ToIPv4(rand.Uint32()).To4().String()
+	dnsServer, err := NewDNSServer(baseDomain, randIp, "127.0.0.1", utils.GetRandomAvailableTCPPort())
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem, keyPem, err := tlsutils.GenerateSelfSignedCertKey("dns.example.com", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dotAddr := utils.HostPort("127.0.0.1", utils.GetRandomAvailableTCPPort())
+	dohAddr := utils.HostPort("127.0.0.1", utils.GetRandomAvailableTCPPort())
+	assert.NoError(t, dnsServer.EnableDoT(dotAddr, &tls.Config{Certificates: []tls.Certificate{cert}}))
+	// 不设置证书时以 HTTP 提供 DoH 服务，方便使用 netx 的 DoH 客户端验证
+	assert.NoError(t, dnsServer.EnableDoH(dohAddr, nil))
+
+	var lock sync.Mutex
+	transports := make(map[string]string)
+	dnsServer.SetCallback(func(i *VisitorLog) {
+		lock.Lock()
+		defer lock.Unlock()
+		transports[codec.AnyToString(i.Details["token"])] = codec.AnyToString(i.Details["dns-transport"])
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	go dnsServer.Serve(ctx)
+	for _, addr := range []string{dotAddr, dohAddr} {
+		if err := utils.WaitConnect(addr, 5); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// DoH JSON API
+	dohToken := strings.ToLower(utils.RandStringBytes(10))
+	result := netx.LookupFirst(
+		dohToken+"."+baseDomain,
+		netx.WithDNSDisableSystemResolver(true),
+		netx.WithDNSPreferDoH(true),
+		netx.WithDNSNoCache(true),
+		netx.WithDNSSpecificDoH("http://"+dohAddr+"/dns-query"),
+	)
+	assert.Equal(t, randIp, result)
+
+	// DoH wire format (RFC 8484)
+	wireToken := strings.ToLower(utils.RandStringBytes(10))
+	query := new(dns.Msg)
+	query.SetQuestion(dns.Fqdn(wireToken+"."+baseDomain), dns.TypeA)
+	raw, err := query.Pack()
+	assert.NoError(t, err)
+	rsp, err := http.Post("http://"+dohAddr+"/dns-query", "application/dns-message", bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	reply := new(dns.Msg)
+	assert.NoError(t, reply.Unpack(body))
+	if assert.Len(t, reply.Answer, 1) {
+		assert.Equal(t, randIp, reply.Answer[0].(*dns.A).A.String())
+	}
+
+	// DoT
+	dotToken := strings.ToLower(utils.RandStringBytes(10))
+	client := &dns.Client{Net: "tcp-tls", TLSConfig: &tls.Config{InsecureSkipVerify: true}, Timeout: 5 * time.Second}
+	query = new(dns.Msg)
+	query.SetQuestion(dns.Fqdn(dotToken+"."+baseDomain), dns.TypeA)
+	reply, _, err = client.Exchange(query, dotAddr)
+	if assert.NoError(t, err) && assert.Len(t, reply.Answer, 1) {
+		assert.Equal(t, randIp, reply.Answer[0].(*dns.A).A.String())
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, DNSTransport_DoH, transports[dohToken])
+	assert.Equal(t, DNSTransport_DoH, transports[wireToken])
+	assert.Equal(t, DNSTransport_DoT, transports[dotToken])
+}
@@ -0,0 +1,97 @@
+package fingerprint
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/fp/fingerprint/parsers"
+	"github.com/yaklang/yaklang/common/fp/fingerprint/rule"
+	"github.com/yaklang/yaklang/common/fp/webfingerprint"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// ValidateGeneralRules 使用 ParseExpRule 编译通用规则，并使用指纹匹配器在历史样本上按站点验证，
+// 带 web 路径的规则（如 favicon）从同一站点对应路径的样本中获取数据
+func ValidateGeneralRules(rules []*schema.GeneralRule, expectedHosts []string, samples []*webfingerprint.FlowSample) (*webfingerprint.LearnValidation, error) {
+	var fpRules []*rule.FingerPrintRule
+	for _, generalRule := range rules {
+		compiled, err := parsers.ParseExpRule(generalRule)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range compiled {
+			setWebPath(r, generalRule.WebPath)
+		}
+		fpRules = append(fpRules, compiled...)
+	}
+	if len(fpRules) == 0 {
+		return nil, utils.Error("no general rule to validate")
+	}
+
+	hostSamples := make(map[string][]*webfingerprint.FlowSample)
+	var hosts []string
+	for _, sample := range samples {
+		if _, ok := hostSamples[sample.Host]; !ok {
+			hosts = append(hosts, sample.Host)
+		}
+		hostSamples[sample.Host] = append(hostSamples[sample.Host], sample)
+	}
+
+	matched := make(map[string]bool)
+	for _, host := range hosts {
+		items := hostSamples[host]
+		matcher := NewMatcher()
+		matcher.Route = func(ctx context.Context, webPath string) ([]byte, error) {
+			for _, item := range items {
+				if item.Path == webPath {
+					return item.Response, nil
+				}
+			}
+			// 站点没有该路径的样本，按空响应处理
+			return nil, nil
+		}
+		for _, item := range items {
+			if len(matcher.Match(context.Background(), item.Response, fpRules)) > 0 {
+				matched[host] = true
+				break
+			}
+		}
+	}
+	return webfingerprint.NewLearnValidation(matched, expectedHosts, len(samples)), nil
+}
+
+// setWebPath 将通用规则的 web 路径设置到表达式中的每一条子规则，验证时从站点对应路径的样本中获取数据
+func setWebPath(r *rule.FingerPrintRule, webPath string) {
+	if r == nil || webPath == "" {
+		return
+	}
+	r.WebPath = webPath
+	if r.MatchParam == nil {
+		return
+	}
+	for _, sub := range r.MatchParam.SubRules {
+		setWebPath(sub, webPath)
+	}
+}
+
+// ValidateLearnCandidate 验证候选规则转换后将要保存的通用规则 rules：通用规则需要能够编译，
+// 并且在样本上命中的站点与候选规则（webfingerprint 规则）命中的站点一致，验证通过后更新候选规则的验证结果
+func ValidateLearnCandidate(candidate *webfingerprint.LearnCandidate, rules []*schema.GeneralRule, samples []*webfingerprint.FlowSample) (*webfingerprint.LearnValidation, error) {
+	if candidate == nil {
+		return nil, utils.Error("empty candidate")
+	}
+	expected := webfingerprint.ValidateWebRules(candidate.WebRules(), candidate.Hosts, samples)
+	validation, err := ValidateGeneralRules(rules, candidate.Hosts, samples)
+	if err != nil {
+		return nil, utils.Wrap(err, "compile learned general rule failed")
+	}
+	if len(validation.MatchedHosts) == 0 {
+		return nil, utils.Errorf("learned general rule matches no host in %d samples", len(samples))
+	}
+	if strings.Join(validation.MatchedHosts, ",") != strings.Join(expected.MatchedHosts, ",") {
+		return nil, utils.Errorf("learned general rule matches %v, but the validated rule matches %v", validation.MatchedHosts, expected.MatchedHosts)
+	}
+	candidate.Validation = validation
+	return validation, nil
+}
@@ -0,0 +1,95 @@
+package fingerprint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/fp/fingerprint/parsers"
+	"github.com/yaklang/yaklang/common/fp/webfingerprint"
+	"github.com/yaklang/yaklang/common/schema"
+)
+
+func TestLearnedGeneralRuleMatch(t *testing.T) {
+	newSample := func(host string, extraHeader string, body string) *webfingerprint.FlowSample {
+		rsp := fmt.Sprintf("HTTP/1.1 200 OK\r\nServer: nginx\r\n%sContent-Length: %d\r\n\r\n%s", extraHeader, len(body), body)
+		sample, err := webfingerprint.NewFlowSample("http://"+host+"/", []byte(rsp))
+		require.NoError(t, err)
+		return sample
+	}
+	var samples []*webfingerprint.FlowSample
+	for i := 0; i < 3; i++ {
+		samples = append(samples, newSample(fmt.Sprintf("acme%d.example.com", i),
+			"Set-Cookie: ACMESID=1; Path=/\r\n", `<title>Acme "Console"</title>`))
+	}
+	for i := 0; i < 15; i++ {
+		samples = append(samples, newSample(fmt.Sprintf("site%d.example.com", i), "", fmt.Sprintf(`<title>Site %d</title>`, i)))
+	}
+	candidates := webfingerprint.LearnFingerprints(samples)
+	require.Len(t, candidates, 1)
+
+	rules, err := parsers.ParseExpRule(candidates[0].GeneralRules()...)
+	require.NoError(t, err)
+	matcher := NewMatcher()
+	matched := 0
+	for _, sample := range samples {
+		info := matcher.Match(context.Background(), sample.Response, rules)
+		if len(info) > 0 {
+			matched++
+			assert.Contains(t, candidates[0].Hosts, sample.Host)
+			assert.Equal(t, candidates[0].CPE.Product, info[0].Product)
+		} else {
+			assert.NotContains(t, candidates[0].Hosts, sample.Host)
+		}
+	}
+	assert.Equal(t, 3, matched)
+}
+
+func TestValidateLearnCandidate(t *testing.T) {
+	newSample := func(urlStr string, contentType string, body string) *webfingerprint.FlowSample {
+		rsp := fmt.Sprintf("HTTP/1.1 200 OK\r\nServer: nginx\r\nContent-Type: %s\r\nContent-Length: %d\r\n\r\n%s", contentType, len(body), body)
+		sample, err := webfingerprint.NewFlowSample(urlStr, []byte(rsp))
+		require.NoError(t, err)
+		return sample
+	}
+	var samples []*webfingerprint.FlowSample
+	for i := 1; i <= 3; i++ {
+		host := fmt.Sprintf("http://acme%d.example.com", i)
+		samples = append(samples,
+			newSample(host+"/", "text/html", `<html><title>Acme Console</title></html>`),
+			newSample(host+"/favicon.ico", "image/x-icon", "acme-icon"),
+		)
+	}
+	for i := 1; i <= 12; i++ {
+		samples = append(samples, newSample(fmt.Sprintf("https://site%d.example.org/", i), "text/html", fmt.Sprintf(`<html><title>Site %d</title></html>`, i)))
+	}
+	candidates := webfingerprint.LearnFingerprints(samples)
+	require.Len(t, candidates, 1)
+	candidate := candidates[0]
+	candidate.SetProduct("acme", "acme_console")
+	rules := candidate.GeneralRules()
+	require.Len(t, rules, 2)
+
+	// the favicon rule is matched with the favicon sample of the same host
+	validation, err := ValidateGeneralRules(rules[1:], candidate.Hosts, samples)
+	require.NoError(t, err)
+	assert.Equal(t, candidate.Hosts, validation.MatchedHosts)
+	assert.Empty(t, validation.MissedHosts)
+
+	validation, err = ValidateLearnCandidate(candidate, rules, samples)
+	require.NoError(t, err)
+	assert.Equal(t, candidate.Hosts, validation.MatchedHosts)
+	assert.Equal(t, 1.0, validation.Precision)
+
+	// the rule to save differs from the validated candidate
+	changed := *rules[0]
+	changed.MatchExpression = `title = "Site"`
+	_, err = ValidateLearnCandidate(candidate, []*schema.GeneralRule{&changed}, samples)
+	require.Error(t, err)
+
+	changed.MatchExpression = `title = `
+	_, err = ValidateLearnCandidate(candidate, []*schema.GeneralRule{&changed}, samples)
+	require.Error(t, err)
+}
@@ -269,3 +269,62 @@ aaa`)}, nil
 		}
 	}
 }
+
+func TestActiveDataRule(t *testing.T) {
+	collected := map[string]int{}
+	getter := func(path string) (*rule.MatchResource, error) {
+		return &rule.MatchResource{Protocol: "http", Data: rsp, Collect: func(kind string) (string, error) {
+			collected[kind]++
+			switch kind {
+			case rule.ConstIconHash:
+				return "-1277814690", nil
+			case rule.ConstJarm:
+				return "2ad2ad0002ad2ad00042d42d00000069d641f34fe76acdc05c40262f8815e5", nil
+			case rule.ConstCertSubject:
+				return "CN=vpn.example.com,O=Acme Inc", nil
+			case rule.ConstCertIssuer:
+				return "CN=Acme Internal CA", nil
+			case rule.ConstCertDomains:
+				return "vpn.example.com,*.example.com", nil
+			}
+			return "", nil
+		}}, nil
+	}
+	for _, testCase := range [][]any{
+		{`icon_hash == "-1277814690"`, true},
+		{`icon_hash == "123"`, false},
+		{`jarm == "2ad2ad0002ad2ad00042d42d00000069d641f34fe76acdc05c40262f8815e5"`, true},
+		{`cert_subject = "Acme Inc"`, true},
+		{`cert_issuer = "Acme Internal CA" && body = "VIDEO"`, false},
+		{`cert_issuer = "Acme Internal CA" && body = "setup.exe"`, true},
+		{`cert_domains ~= "(^|,)vpn\\.example\\.com(,|$)"`, true},
+		{`title = "aaa" || cert_domains = "other.com"`, false},
+	} {
+		r, err := parsers.ParseExpRule(newTestGenerateRule(testCase[0].(string)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := rule.Execute(getter, r[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if testCase[1].(bool) {
+			assert.Equal(t, "ok", info.Product, testCase[0])
+		} else {
+			assert.Nil(t, info, testCase[0])
+		}
+	}
+	assert.NotZero(t, collected[rule.ConstIconHash])
+	assert.NotZero(t, collected[rule.ConstJarm])
+
+	// 没有收集器时主动数据为空，规则不匹配
+	r, err := parsers.ParseExpRule(newTestGenerateRule(`jarm == "2ad2ad0002ad2ad00042d42d00000069d641f34fe76acdc05c40262f8815e5"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := rule.Execute(resourceGetter, r[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, info)
+}
@@ -44,3 +44,25 @@ func TestCompiler(t *testing.T) {
 	}
 	_ = rules
 }
+
+// 通用规则的 WebPath 不影响编译结果，扫描时的匹配行为保持不变
+func TestCompilerIgnoreWebPath(t *testing.T) {
+	rules, err := ParseExpRule(&schema.GeneralRule{
+		MatchExpression: `title="Acme" || body="acme"`,
+		WebPath:         "/favicon.ico",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("expect 1 rule, got %d", len(rules))
+	}
+	if rules[0].WebPath != "" {
+		t.Fatalf("expect empty web path, got %s", rules[0].WebPath)
+	}
+	for _, sub := range rules[0].MatchParam.SubRules {
+		if sub.WebPath != "" {
+			t.Fatalf("expect empty web path, got %s", sub.WebPath)
+		}
+	}
+}
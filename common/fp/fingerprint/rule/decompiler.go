@@ -63,6 +63,8 @@ func DecompileFingerprintRuleOpCodes(codes []*OpCode) (*schema.GeneralRule, erro
 			default:
 				expStack.Push(code.data[1])
 			}
+		case OpActiveData:
+			expStack.Push(code.data[1])
 		case OpPush:
 			s, ok := code.data[0].(string)
 			if ok {
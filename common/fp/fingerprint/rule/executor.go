@@ -3,6 +3,7 @@ package rule
 import (
 	"errors"
 	"fmt"
+	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
@@ -17,9 +18,11 @@ const (
 	OpInfo        OpFlag = "info"
 	OpData        OpFlag = "data"
 	OpExtractData OpFlag = "extract_data"
-	OpPush        OpFlag = "push"
-	OpOr          OpFlag = "or"
-	OpAnd         OpFlag = "and"
+	// OpActiveData 获取需要主动收集、与路径无关的目标信息，例如 favicon hash、JARM 与 TLS 证书
+	OpActiveData OpFlag = "active_data"
+	OpPush       OpFlag = "push"
+	OpOr         OpFlag = "or"
+	OpAnd        OpFlag = "and"
 
 	OpNot         OpFlag = "not"
 	OpEqual       OpFlag = "equal"
@@ -38,8 +41,22 @@ const (
 	ConstBanner   = "banner"
 	ConstPort     = "port"
 	ConstPath     = "path"
+
+	// 主动收集的目标信息，按 host:port 缓存
+	ConstIconHash    = "icon_hash"
+	ConstJarm        = "jarm"
+	ConstCertSubject = "cert_subject"
+	ConstCertIssuer  = "cert_issuer"
+	ConstCertDomains = "cert_domains"
 )
 
+var activeDataKinds = []string{ConstIconHash, ConstJarm, ConstCertSubject, ConstCertIssuer, ConstCertDomains}
+
+// IsActiveData 判断规则中引用的变量是否需要主动收集
+func IsActiveData(ref string) bool {
+	return utils.StringArrayContains(activeDataKinds, ref)
+}
+
 type OpCode struct {
 	Op   OpFlag
 	data []any
@@ -134,6 +151,21 @@ func Execute(getter func(path string) (*MatchResource, error), rule *FingerPrint
 			default:
 				return nil, fmt.Errorf("not support var: %v", code.data[1])
 			}
+		case OpActiveData:
+			resource, err := getData()
+			if err != nil {
+				return nil, err
+			}
+			kind := utils.InterfaceToString(code.data[1])
+			if resource.Collect == nil {
+				stack.Push("")
+				continue
+			}
+			value, err := resource.Collect(kind)
+			if err != nil {
+				log.Debugf("collect %s failed: %v", kind, err)
+			}
+			stack.Push(value)
 		case OpPush:
 			stack.Push(code.data[0])
 		case OpOr:
@@ -16,6 +16,9 @@ type MatchResource struct {
 	Port     int
 	Path     string
 	Protocol string
+
+	// Collect 主动收集 favicon hash、JARM、证书等与路径无关的目标信息，为空时这些变量均为空字符串
+	Collect func(kind string) (string, error)
 }
 
 func NewHttpResource(data []byte) *MatchResource {
@@ -127,7 +130,10 @@ func (f *FingerPrintRule) preToOpCodes() []*OpCode {
 		}
 		ref := params[0].(string)
 		value := params[1]
-		if strings.HasPrefix(ref, "header_") {
+		if IsActiveData(ref) {
+			pushCode(&OpCode{Op: OpActiveData, data: []any{f.WebPath, ref}})
+			pushCode(&OpCode{Op: OpPush, data: []any{value}})
+		} else if strings.HasPrefix(ref, "header_") {
 			pushCode(&OpCode{Op: OpExtractData, data: []any{f.WebPath, "header_item", strings.TrimLeft(ref, "header_")}})
 			pushCode(&OpCode{Op: OpPush, data: []any{value}})
 		} else {
@@ -0,0 +1,174 @@
+package fp
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/fp/fingerprint/rule"
+	"github.com/yaklang/yaklang/common/netx"
+	utils2 "github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// activeDataCache 按 host:port 缓存主动收集的 favicon hash、JARM 与证书信息，避免同一目标重复探测。
+// 只缓存探测成功的结果，超时等临时错误不会影响之后的规则
+var (
+	activeDataCache = utils2.NewTTLCache[string](10 * time.Minute)
+
+	// activeDataLocks 保证同一目标的同一类信息同时只有一个探测，没有探测在等待时删除对应的锁
+	activeDataLocksMutex sync.Mutex
+	activeDataLocks      = make(map[string]*activeDataLock)
+)
+
+type activeDataLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockActiveData 获取 key 对应的锁，返回的函数释放锁，最后一个持有者释放时从 activeDataLocks 中删除
+func lockActiveData(key string) func() {
+	activeDataLocksMutex.Lock()
+	lock, ok := activeDataLocks[key]
+	if !ok {
+		lock = new(activeDataLock)
+		activeDataLocks[key] = lock
+	}
+	lock.refs++
+	activeDataLocksMutex.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		activeDataLocksMutex.Lock()
+		lock.refs--
+		if lock.refs <= 0 {
+			delete(activeDataLocks, key)
+		}
+		activeDataLocksMutex.Unlock()
+	}
+}
+
+// activeDataCollector 为通用指纹规则中的 icon_hash、jarm、cert_* 变量主动收集目标信息，只有规则引用这些变量时才会发起探测
+type activeDataCollector struct {
+	ctx      context.Context
+	host     string
+	port     int
+	isHttps  bool
+	response []byte
+	timeout  time.Duration
+	proxies  []string
+	route    func(ctx context.Context, webPath string) ([]byte, error)
+	// 已经获取到的 TLS 证书信息，为空时重新获取
+	tlsResults func() []*netx.TLSInspectResult
+}
+
+func (c *activeDataCollector) target() string {
+	return utils2.HostPort(c.host, c.port)
+}
+
+func (c *activeDataCollector) Collect(kind string) (string, error) {
+	key := kind + "|" + c.target()
+	if value, ok := activeDataCache.Get(key); ok {
+		return value, nil
+	}
+	unlock := lockActiveData(key)
+	defer unlock()
+	if value, ok := activeDataCache.Get(key); ok {
+		return value, nil
+	}
+
+	var value string
+	var err error
+	switch kind {
+	case rule.ConstIconHash:
+		value, err = c.iconHash()
+	case rule.ConstJarm:
+		value, err = c.jarm()
+	case rule.ConstCertSubject, rule.ConstCertIssuer, rule.ConstCertDomains:
+		var values map[string]string
+		values, err = c.certificate()
+		if err != nil {
+			return "", err
+		}
+		for _, k := range []string{rule.ConstCertSubject, rule.ConstCertIssuer, rule.ConstCertDomains} {
+			activeDataCache.Set(k+"|"+c.target(), values[k])
+		}
+		return values[kind], nil
+	default:
+		return "", utils2.Errorf("unsupported active data: %s", kind)
+	}
+	if err != nil {
+		return "", err
+	}
+	activeDataCache.Set(key, value)
+	return value, nil
+}
+
+// iconHash 获取首页声明的 favicon（默认 /favicon.ico），返回与 shodan/fofa 一致的 mmh3 hash
+func (c *activeDataCollector) iconHash() (string, error) {
+	if c.route == nil {
+		return "", utils2.Error("no route to fetch favicon")
+	}
+	scheme := "http"
+	if c.isHttps {
+		scheme = "https"
+	}
+	siteURL := fmt.Sprintf("%s://%s", scheme, c.target())
+	path := "/favicon.ico"
+	_, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(c.response)
+	if faviconURL, err := utils2.ExtractFaviconURL(siteURL, body); err == nil {
+		if u, err := url.Parse(faviconURL); err == nil && strings.EqualFold(u.Host, c.target()) && u.Path != "" {
+			path = u.RequestURI()
+		}
+	}
+	rsp, err := c.route(c.ctx, path)
+	if err != nil {
+		return "", err
+	}
+	if code := lowhttp.GetStatusCodeFromResponse(rsp); code != 200 {
+		return "", utils2.Errorf("fetch favicon %s status code: %d", path, code)
+	}
+	_, icon := lowhttp.SplitHTTPHeadersAndBodyFromPacket(rsp)
+	if len(icon) == 0 {
+		return "", utils2.Errorf("empty favicon %s", path)
+	}
+	return utils2.Mmh3Hash32(utils2.StandBase64(icon)), nil
+}
+
+func (c *activeDataCollector) jarm() (string, error) {
+	if !c.isHttps {
+		return "", nil
+	}
+	return netx.JARMContext(c.ctx, c.target(), c.timeout, c.proxies...)
+}
+
+func (c *activeDataCollector) certificate() (map[string]string, error) {
+	var results []*netx.TLSInspectResult
+	if c.tlsResults != nil {
+		results = c.tlsResults()
+	}
+	if len(results) == 0 && c.isHttps {
+		var err error
+		results, err = netx.TLSInspectContext(c.ctx, c.target())
+		if err != nil {
+			return nil, utils2.Errorf("inspect tls failed: %v", err)
+		}
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	cert, err := x509.ParseCertificate(results[0].Raw)
+	if err != nil {
+		return nil, utils2.Errorf("parse certificate failed: %v", err)
+	}
+	return map[string]string{
+		rule.ConstCertSubject: cert.Subject.String(),
+		rule.ConstCertIssuer:  cert.Issuer.String(),
+		rule.ConstCertDomains: strings.Join(cert.DNSNames, ","),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package fp
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/fp/fingerprint/rule"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestLockActiveData_Released(t *testing.T) {
+	var wg sync.WaitGroup
+	counter := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := lockActiveData("jarm|127.0.0.1:443")
+			defer unlock()
+			counter++
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 50, counter)
+
+	// 所有持有者释放后锁被删除，不会随目标数量增长
+	activeDataLocksMutex.Lock()
+	defer activeDataLocksMutex.Unlock()
+	require.Len(t, activeDataLocks, 0)
+}
+
+func TestActiveDataCollector_CacheOnlySuccess(t *testing.T) {
+	failed := true
+	requested := 0
+	collector := &activeDataCollector{
+		ctx:  context.Background(),
+		host: utils.RandStringBytes(8) + ".example.com",
+		port: 80,
+		route: func(ctx context.Context, webPath string) ([]byte, error) {
+			requested++
+			if failed {
+				return nil, utils.Error("i/o timeout")
+			}
+			return []byte("HTTP/1.1 200 OK\r\nContent-Type: image/x-icon\r\nContent-Length: 4\r\n\r\nicon"), nil
+		},
+	}
+
+	// 临时的失败不会被缓存
+	_, err := collector.Collect(rule.ConstIconHash)
+	require.Error(t, err)
+
+	failed = false
+	hash, err := collector.Collect(rule.ConstIconHash)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+	require.Equal(t, 2, requested)
+
+	// 成功的结果被缓存，不会再次请求
+	cached, err := collector.Collect(rule.ConstIconHash)
+	require.NoError(t, err)
+	require.Equal(t, hash, cached)
+	require.Equal(t, 2, requested)
+}
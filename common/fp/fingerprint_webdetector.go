@@ -176,12 +176,7 @@ func (f *Matcher) webDetector(result *MatchResult, ctx context.Context, config *
 		}
 		httpflows = append(httpflows, flow)
 		f.matcher.Route = func(ctx context.Context, webPath string) ([]byte, error) {
-			target := utils2.HostPort(host, port)
-			packet := []byte(fmt.Sprintf(`GET %s HTTP/1.1
-Host: %v
-User-Agent: Mozilla/5.0 (Windows NT 10.0; rv:68.0) Gecko/20100101 Firefox/68.0
-Accept: text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7
-`, target, webPath))
+			packet := webPathRequest(webPath, utils2.HostPort(host, port))
 			f.log("sending request to path: %s", webPath)
 			var ok bool
 			var flow []*lowhttp.RedirectFlow
@@ -196,11 +191,25 @@ Accept: text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/w
 			f := utils2.GetLastElement(flow)
 			return f.Response, nil
 		}
+		collector := &activeDataCollector{
+			ctx:      iotDetectCtx,
+			host:     h,
+			port:     port,
+			isHttps:  info.IsHttps,
+			response: info.Response,
+			timeout:  config.ProbeTimeout,
+			proxies:  config.Proxies,
+			route:    f.matcher.Route,
+			tlsResults: func() []*netx.TLSInspectResult {
+				return inspectResults
+			},
+		}
 		cpes := f.matcher.MatchResource(iotDetectCtx, f.Config.GetWebFingerprintRules(), func(path string) (*rule.MatchResource, error) {
 			res := &rule.MatchResource{
 				Protocol: "http",
 				Port:     port,
 				Path:     path,
+				Collect:  collector.Collect,
 			}
 			cached := map[string][]byte{}
 			if path == "" || path == "/" {
@@ -216,7 +225,9 @@ Accept: text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/w
 				return nil, err
 			}
 			cached[path] = data
-			return rule.NewHttpResource(data), nil
+			res = rule.NewHttpResource(data)
+			res.Collect = collector.Collect
+			return res, nil
 		})
 
 		// 如果检测到指纹信息
@@ -286,6 +297,15 @@ Accept: text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/w
 	return result, nil
 }
 
+// webPathRequest 构造请求 target 上 webPath 的数据包
+func webPathRequest(webPath string, target string) []byte {
+	return []byte(fmt.Sprintf(`GET %s HTTP/1.1
+Host: %v
+User-Agent: Mozilla/5.0 (Windows NT 10.0; rv:68.0) Gecko/20100101 Firefox/68.0
+Accept: text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7
+`, webPath, target))
+}
+
 func (f *Matcher) fetchBannerFromHostPortWithTLSSkipped(checkedTls bool, baseCtx context.Context, packet2 []byte, tlsInspectResults []*netx.TLSInspectResult, host string, port interface{}, bufferSize int64, runtimeId string, proxy ...string) (
 	isPortOpen bool,
 	tlsChecked bool,
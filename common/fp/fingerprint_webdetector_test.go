@@ -0,0 +1,16 @@
+package fp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+func TestWebPathRequest(t *testing.T) {
+	packet := webPathRequest("/favicon.ico", "127.0.0.1:8080")
+	method, uri, _ := lowhttp.GetHTTPPacketFirstLine(packet)
+	assert.Equal(t, "GET", method)
+	assert.Equal(t, "/favicon.ico", uri)
+	assert.Equal(t, "127.0.0.1:8080", lowhttp.GetHTTPPacketHeader(packet, "Host"))
+}
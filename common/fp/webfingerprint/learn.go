@@ -0,0 +1,627 @@
+package webfingerprint
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"gopkg.in/yaml.v3"
+)
+
+// 指纹学习：按 favicon、标题、响应头和静态资源路径对历史流量中的站点聚类，
+// 为每一组站点共有且在其他站点中少见的特征生成候选规则
+
+const (
+	LearnFeatureFavicon = "favicon"
+	LearnFeatureTitle   = "title"
+	LearnFeatureHeader  = "header"
+	LearnFeatureCookie  = "cookie"
+	LearnFeatureStatic  = "static"
+)
+
+// 特征的可信程度，候选规则的特征分数之和需要达到 MinScore
+var learnFeatureScore = map[string]int{
+	LearnFeatureFavicon: 5,
+	LearnFeatureTitle:   3,
+	LearnFeatureHeader:  2,
+	LearnFeatureCookie:  2,
+	LearnFeatureStatic:  1,
+}
+
+// 能直接标识产品的响应头，学习时保留头的值
+var learnIdentityHeaders = map[string]bool{
+	"server":              true,
+	"x-powered-by":        true,
+	"x-aspnet-version":    true,
+	"x-aspnetmvc-version": true,
+	"x-generator":         true,
+}
+
+// 通用响应头，不作为特征
+var learnGenericHeaders = map[string]bool{
+	"date": true, "content-type": true, "content-length": true, "connection": true, "keep-alive": true,
+	"transfer-encoding": true, "content-encoding": true, "cache-control": true, "pragma": true, "expires": true,
+	"etag": true, "last-modified": true, "vary": true, "accept-ranges": true, "age": true, "location": true,
+	"content-language": true, "content-disposition": true, "content-security-policy": true,
+	"content-security-policy-report-only": true, "strict-transport-security": true, "x-frame-options": true,
+	"x-xss-protection": true, "x-content-type-options": true, "referrer-policy": true, "permissions-policy": true,
+	"feature-policy": true, "access-control-allow-origin": true, "access-control-allow-credentials": true,
+	"access-control-allow-methods": true, "access-control-allow-headers": true, "access-control-expose-headers": true,
+	"access-control-max-age": true, "alt-svc": true, "via": true, "x-cache": true, "x-request-id": true,
+	"x-trace-id": true, "p3p": true, "link": true, "www-authenticate": true, "set-cookie": true, "upgrade": true,
+	"cross-origin-opener-policy": true, "cross-origin-embedder-policy": true, "cross-origin-resource-policy": true,
+	"nel": true, "report-to": true, "server-timing": true, "timing-allow-origin": true, "x-ua-compatible": true,
+}
+
+var learnStaticResourceRegexp = regexp.MustCompile(`(?i)(?:src|href)\s*=\s*["']([^"'#?\s]+\.(?:js|css))(?:[?#][^"']*)?["']`)
+
+// FlowSample 是用于学习与验证指纹的一条历史响应
+type FlowSample struct {
+	Url        string
+	Host       string
+	Path       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Response   []byte
+
+	// 响应头在报文中的原始写法，生成表达式时使用
+	rawHeaderNames map[string]string
+}
+
+// NewFlowSample 从 url 与响应报文创建学习样本
+func NewFlowSample(urlStr string, response []byte) (*FlowSample, error) {
+	if len(response) == 0 {
+		return nil, utils.Errorf("empty response for %s", urlStr)
+	}
+	u, err := url.Parse(urlStr)
+	if err != nil || u.Host == "" {
+		return nil, utils.Errorf("parse url %s failed: %v", urlStr, err)
+	}
+	sample := &FlowSample{
+		Url:            urlStr,
+		Host:           strings.ToLower(u.Host),
+		Path:           u.Path,
+		StatusCode:     lowhttp.GetStatusCodeFromResponse(response),
+		Header:         make(http.Header),
+		Response:       response,
+		rawHeaderNames: make(map[string]string),
+	}
+	if sample.Path == "" {
+		sample.Path = "/"
+	}
+	for name, values := range lowhttp.GetHTTPPacketHeadersFull(response) {
+		sample.rawHeaderNames[strings.ToLower(name)] = name
+		for _, value := range values {
+			sample.Header.Add(name, value)
+		}
+	}
+	_, sample.Body = lowhttp.SplitHTTPHeadersAndBodyFromPacket(response)
+	return sample, nil
+}
+
+// NewFlowSampleFromHTTPFlow 从数据库中的 HTTPFlow 创建学习样本
+func NewFlowSampleFromHTTPFlow(flow *schema.HTTPFlow) (*FlowSample, error) {
+	if flow == nil {
+		return nil, utils.Error("empty http flow")
+	}
+	return NewFlowSample(flow.Url, []byte(flow.GetResponse()))
+}
+
+func (s *FlowSample) isFavicon() bool {
+	if strings.HasSuffix(strings.ToLower(s.Path), ".ico") {
+		return true
+	}
+	return strings.Contains(strings.ToLower(s.Header.Get("Content-Type")), "icon")
+}
+
+func (s *FlowSample) headerName(name string) string {
+	if raw, ok := s.rawHeaderNames[strings.ToLower(name)]; ok {
+		return raw
+	}
+	return name
+}
+
+func (s *FlowSample) responseInfo() *HTTPResponseInfo {
+	u, _ := url.Parse(s.Url)
+	return &HTTPResponseInfo{
+		Header:      &s.Header,
+		URL:         u,
+		Body:        s.Body,
+		StatusCode:  s.StatusCode,
+		ResponseRaw: s.Response,
+		IsHttps:     u != nil && u.Scheme == "https",
+	}
+}
+
+// LearnFeature 是从响应中提取的一个特征
+type LearnFeature struct {
+	Kind string
+	// header 为头名称，cookie 为 cookie 名称，favicon 为 mmh3 hash
+	Name string
+	// title 为标题，header 为头的值（为空时只匹配头名称），favicon 为 md5，static 为资源路径
+	Value string
+	// favicon 所在路径
+	Path string
+	// 拥有该特征的站点
+	Hosts []string
+
+	// 生成表达式时使用的原始头名称
+	rawName string
+}
+
+func (f *LearnFeature) key() string {
+	return f.Kind + "\x00" + strings.ToLower(f.Name) + "\x00" + f.Value
+}
+
+func (f *LearnFeature) String() string {
+	switch f.Kind {
+	case LearnFeatureFavicon:
+		return fmt.Sprintf("favicon %s md5:%s mmh3:%s", f.Path, f.Value, f.Name)
+	case LearnFeatureHeader:
+		if f.Value == "" {
+			return fmt.Sprintf("header %s", f.Name)
+		}
+		return fmt.Sprintf("header %s: %s", f.Name, f.Value)
+	case LearnFeatureCookie:
+		return fmt.Sprintf("cookie %s", f.Name)
+	default:
+		return fmt.Sprintf("%s %s", f.Kind, f.Value)
+	}
+}
+
+func extractLearnFeatures(sample *FlowSample, config *LearnConfig) []*LearnFeature {
+	var features []*LearnFeature
+	if sample.isFavicon() {
+		if len(sample.Body) == 0 || sample.StatusCode >= 300 {
+			return nil
+		}
+		sum := md5.Sum(sample.Body)
+		return []*LearnFeature{{
+			Kind:  LearnFeatureFavicon,
+			Name:  utils.Mmh3Hash32(utils.StandBase64(sample.Body)),
+			Value: hex.EncodeToString(sum[:]),
+			Path:  sample.Path,
+		}}
+	}
+
+	for name, values := range sample.Header {
+		lowerName := strings.ToLower(name)
+		rawName := sample.headerName(name)
+		switch {
+		case lowerName == "set-cookie":
+			for _, value := range values {
+				cookieName, _, ok := strings.Cut(value, "=")
+				cookieName = strings.TrimSpace(cookieName)
+				if !ok || cookieName == "" {
+					continue
+				}
+				features = append(features, &LearnFeature{Kind: LearnFeatureCookie, Name: cookieName, rawName: rawName})
+			}
+		case learnIdentityHeaders[lowerName]:
+			for _, value := range values {
+				value = strings.TrimSpace(value)
+				if value == "" {
+					continue
+				}
+				features = append(features, &LearnFeature{Kind: LearnFeatureHeader, Name: name, Value: value, rawName: rawName})
+			}
+		case !learnGenericHeaders[lowerName]:
+			features = append(features, &LearnFeature{Kind: LearnFeatureHeader, Name: name, rawName: rawName})
+		}
+	}
+
+	body := string(sample.Body)
+	if title := strings.TrimSpace(utils.ExtractTitleFromHTMLTitle(body, "")); title != "" && !strings.HasSuffix(title, "...") {
+		features = append(features, &LearnFeature{Kind: LearnFeatureTitle, Value: title})
+	}
+
+	base, _ := url.Parse(sample.Url)
+	static := make(map[string]bool)
+	for _, match := range learnStaticResourceRegexp.FindAllStringSubmatch(body, -1) {
+		if len(static) >= config.MaxStaticPaths {
+			break
+		}
+		path := match[1]
+		if strings.Contains(path, "//") {
+			// 其他站点的资源（例如 CDN）不能作为特征
+			u, err := url.Parse(path)
+			if err != nil || base == nil || (u.Host != "" && !strings.EqualFold(u.Host, base.Host)) {
+				continue
+			}
+			path = u.Path
+		}
+		if path == "" || static[path] {
+			continue
+		}
+		static[path] = true
+		features = append(features, &LearnFeature{Kind: LearnFeatureStatic, Value: path})
+	}
+	return features
+}
+
+// LearnCandidate 是学习得到的一条候选指纹规则
+type LearnCandidate struct {
+	CPE CPE
+	// 聚类中的站点
+	Hosts    []string
+	Features []*LearnFeature
+	Score    int
+	// 在历史流量上的验证结果
+	Validation *LearnValidation
+}
+
+func (c *LearnCandidate) cpe() CPE {
+	cpe := c.CPE
+	if cpe.Part == "" {
+		cpe.Part = "a"
+	}
+	return cpe
+}
+
+// SetProduct 设置候选规则的厂商与产品名，产品名同时作为保存时的规则名
+func (c *LearnCandidate) SetProduct(vendor string, product string) {
+	c.CPE.Vendor = vendor
+	c.CPE.Product = product
+}
+
+// WebRules 生成候选规则对应的 webfingerprint 规则，favicon 特征会生成单独的带路径规则
+func (c *LearnCandidate) WebRules() []*WebRule {
+	cpe := c.cpe()
+	method := &WebMatcherMethods{}
+	var rules []*WebRule
+	for _, feature := range c.Features {
+		switch feature.Kind {
+		case LearnFeatureFavicon:
+			rules = append(rules, &WebRule{
+				Path:    feature.Path,
+				Methods: []*WebMatcherMethods{{MD5s: []*MD5Matcher{{CPE: cpe, MD5: feature.Value}}}},
+			})
+		case LearnFeatureTitle:
+			method.Keywords = append(method.Keywords, &KeywordMatcher{
+				CPE:    cpe,
+				Regexp: `(?is)<title>\s*` + regexp.QuoteMeta(feature.Value) + `\s*</title>`,
+			})
+		case LearnFeatureStatic:
+			method.Keywords = append(method.Keywords, &KeywordMatcher{CPE: cpe, Regexp: regexp.QuoteMeta(feature.Value)})
+		case LearnFeatureHeader:
+			value := ".*"
+			if feature.Value != "" {
+				value = regexp.QuoteMeta(feature.Value)
+			}
+			method.HTTPHeaders = append(method.HTTPHeaders, &HTTPHeaderMatcher{
+				HeaderName:  feature.Name,
+				HeaderValue: KeywordMatcher{CPE: cpe, Regexp: value},
+			})
+		case LearnFeatureCookie:
+			method.HTTPHeaders = append(method.HTTPHeaders, &HTTPHeaderMatcher{
+				HeaderName:  "Set-Cookie",
+				HeaderValue: KeywordMatcher{CPE: cpe, Regexp: "^" + regexp.QuoteMeta(feature.Name) + "="},
+			})
+		}
+	}
+	if len(method.Keywords)+len(method.HTTPHeaders) > 0 {
+		rules = append([]*WebRule{{Methods: []*WebMatcherMethods{method}}}, rules...)
+	}
+	return rules
+}
+
+// YAML 返回候选规则的 webfingerprint YAML
+func (c *LearnCandidate) YAML() (string, error) {
+	raw, err := yaml.Marshal(c.WebRules())
+	if err != nil {
+		return "", utils.Errorf("marshal web rules failed: %s", err)
+	}
+	return string(raw), nil
+}
+
+func quoteLearnExpValue(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// MatchExpression 返回除 favicon 以外特征组成的通用规则表达式，任一特征命中即认为命中
+func (c *LearnCandidate) MatchExpression() string {
+	var items []string
+	for _, feature := range c.Features {
+		switch feature.Kind {
+		case LearnFeatureTitle:
+			items = append(items, "title = "+quoteLearnExpValue(feature.Value))
+		case LearnFeatureStatic:
+			items = append(items, "body = "+quoteLearnExpValue(feature.Value))
+		case LearnFeatureHeader:
+			if feature.Value == "" {
+				items = append(items, "header = "+quoteLearnExpValue(feature.rawName+":"))
+			} else {
+				items = append(items, "header = "+quoteLearnExpValue(feature.rawName+": "+feature.Value))
+			}
+		case LearnFeatureCookie:
+			items = append(items, "header = "+quoteLearnExpValue(feature.rawName+": "+feature.Name+"="))
+		}
+	}
+	return strings.Join(items, " || ")
+}
+
+// GeneralRules 将候选规则转换为可以保存到 fingerprint_general_rule 表的通用规则，favicon 特征会生成带 web 路径的单独规则
+func (c *LearnCandidate) GeneralRules() []*schema.GeneralRule {
+	cpe := c.cpe()
+	newRule := func(name, webPath, exp string) *schema.GeneralRule {
+		return &schema.GeneralRule{
+			CPE: &schema.CPE{
+				Part:     cpe.Part,
+				Vendor:   cpe.Vendor,
+				Product:  cpe.Product,
+				Version:  cpe.Version,
+				Update:   cpe.Update,
+				Edition:  cpe.Edition,
+				Language: cpe.Language,
+			},
+			RuleName:        name,
+			WebPath:         webPath,
+			ExtInfo:         fmt.Sprintf("learned from %d hosts: %s", len(c.Hosts), strings.Join(c.Hosts, ", ")),
+			MatchExpression: exp,
+		}
+	}
+	var rules []*schema.GeneralRule
+	if exp := c.MatchExpression(); exp != "" {
+		rules = append(rules, newRule(cpe.Product, "", exp))
+	}
+	for _, feature := range c.Features {
+		if feature.Kind != LearnFeatureFavicon {
+			continue
+		}
+		rules = append(rules, newRule(cpe.Product+"_favicon", feature.Path, "md5 == "+quoteLearnExpValue(feature.Value)))
+	}
+	return rules
+}
+
+// Validate 在历史样本上验证候选规则
+func (c *LearnCandidate) Validate(samples []*FlowSample) *LearnValidation {
+	c.Validation = ValidateWebRules(c.WebRules(), c.Hosts, samples)
+	return c.Validation
+}
+
+// LearnValidation 是规则在历史样本上的验证结果，以站点为单位统计
+type LearnValidation struct {
+	Samples int
+	// 命中规则的站点
+	MatchedHosts []string
+	// 命中规则但不在期望站点中，可能是误报
+	UnexpectedHosts []string
+	// 期望命中但未命中的站点
+	MissedHosts []string
+	Precision   float64
+	Recall      float64
+}
+
+func (v *LearnValidation) String() string {
+	return fmt.Sprintf("matched: %d unexpected: %d missed: %d precision: %.2f recall: %.2f",
+		len(v.MatchedHosts), len(v.UnexpectedHosts), len(v.MissedHosts), v.Precision, v.Recall)
+}
+
+// ValidateWebRules 使用被动匹配在历史样本上验证规则，expectedHosts 为空时只统计命中的站点
+func ValidateWebRules(rules []*WebRule, expectedHosts []string, samples []*FlowSample) *LearnValidation {
+	config := NewWebFingerprintConfig(WithWebFingerprintRules(rules), WithActiveMode(false))
+	matcher := NewWebFingerprintMatcherWithConfig(config)
+	matched := make(map[string]bool)
+	for _, sample := range samples {
+		if matched[sample.Host] {
+			continue
+		}
+		if len(matcher.matchWithConfig(sample.responseInfo(), config)) > 0 {
+			matched[sample.Host] = true
+		}
+	}
+	return NewLearnValidation(matched, expectedHosts, len(samples))
+}
+
+// NewLearnValidation 根据命中规则的站点与期望命中的站点统计验证结果，expectedHosts 为空时只统计命中的站点
+func NewLearnValidation(matched map[string]bool, expectedHosts []string, samples int) *LearnValidation {
+	result := &LearnValidation{Samples: samples}
+	expected := make(map[string]bool)
+	for _, host := range expectedHosts {
+		expected[host] = true
+		if !matched[host] {
+			result.MissedHosts = append(result.MissedHosts, host)
+		}
+	}
+	for host := range matched {
+		result.MatchedHosts = append(result.MatchedHosts, host)
+		if len(expected) > 0 && !expected[host] {
+			result.UnexpectedHosts = append(result.UnexpectedHosts, host)
+		}
+	}
+	sort.Strings(result.MatchedHosts)
+	sort.Strings(result.UnexpectedHosts)
+	sort.Strings(result.MissedHosts)
+	if len(expected) > 0 {
+		if len(matched) > 0 {
+			result.Precision = float64(len(matched)-len(result.UnexpectedHosts)) / float64(len(matched))
+		}
+		result.Recall = float64(len(expected)-len(result.MissedHosts)) / float64(len(expected))
+	}
+	return result
+}
+
+// LearnFingerprints 对样本中的站点聚类并生成候选规则：
+// 特征需要出现在至少 MinHosts 个站点，且不超过全部站点的 MaxRatio，拥有相同站点集合的特征组成一条候选规则
+func LearnFingerprints(samples []*FlowSample, opts ...LearnOption) []*LearnCandidate {
+	config := NewLearnConfig(opts...)
+
+	prototypes := make(map[string]*LearnFeature)
+	featureHosts := make(map[string]map[string]bool)
+	allHosts := make(map[string]bool)
+	for _, sample := range samples {
+		allHosts[sample.Host] = true
+		for _, feature := range extractLearnFeatures(sample, config) {
+			key := feature.key()
+			if _, ok := prototypes[key]; !ok {
+				prototypes[key] = feature
+				featureHosts[key] = make(map[string]bool)
+			}
+			featureHosts[key][sample.Host] = true
+		}
+	}
+
+	maxHosts := int(config.MaxRatio * float64(len(allHosts)))
+	if maxHosts < config.MinHosts {
+		maxHosts = config.MinHosts
+	}
+	clusters := make(map[string]*LearnCandidate)
+	for key, hostSet := range featureHosts {
+		if len(hostSet) < config.MinHosts || len(hostSet) > maxHosts {
+			continue
+		}
+		hosts := make([]string, 0, len(hostSet))
+		for host := range hostSet {
+			hosts = append(hosts, host)
+		}
+		sort.Strings(hosts)
+		feature := prototypes[key]
+		feature.Hosts = hosts
+		signature := strings.Join(hosts, ",")
+		candidate, ok := clusters[signature]
+		if !ok {
+			candidate = &LearnCandidate{Hosts: hosts}
+			clusters[signature] = candidate
+		}
+		candidate.Features = append(candidate.Features, feature)
+		candidate.Score += learnFeatureScore[feature.Kind]
+	}
+
+	var candidates []*LearnCandidate
+	for signature, candidate := range clusters {
+		if candidate.Score < config.MinScore {
+			continue
+		}
+		sort.Slice(candidate.Features, func(i, j int) bool {
+			a, b := candidate.Features[i], candidate.Features[j]
+			if learnFeatureScore[a.Kind] != learnFeatureScore[b.Kind] {
+				return learnFeatureScore[a.Kind] > learnFeatureScore[b.Kind]
+			}
+			return a.key() < b.key()
+		})
+		if len(candidate.Features) > config.MaxFeatures {
+			candidate.Features = candidate.Features[:config.MaxFeatures]
+		}
+		candidate.CPE = CPE{Part: "a", Product: learnProductName(candidate, signature)}
+		candidate.Validate(samples)
+		candidates = append(candidates, candidate)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if len(a.Hosts) != len(b.Hosts) {
+			return len(a.Hosts) > len(b.Hosts)
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.CPE.Product < b.CPE.Product
+	})
+	return candidates
+}
+
+var learnProductNameRegexp = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// learnProductName 使用标题或标识头的值作为默认产品名，都没有时使用站点集合的 hash
+func learnProductName(candidate *LearnCandidate, signature string) string {
+	for _, kind := range []string{LearnFeatureTitle, LearnFeatureHeader} {
+		for _, feature := range candidate.Features {
+			if feature.Kind != kind || feature.Value == "" {
+				continue
+			}
+			name := strings.Trim(learnProductNameRegexp.ReplaceAllString(strings.ToLower(feature.Value), "_"), "_")
+			if runes := []rune(name); len(runes) > 40 {
+				name = string(runes[:40])
+			}
+			if name != "" {
+				return "learned_" + name
+			}
+		}
+	}
+	sum := md5.Sum([]byte(signature))
+	return "learned_" + hex.EncodeToString(sum[:4])
+}
+
+// LearnConfig 是指纹学习的配置
+type LearnConfig struct {
+	// 特征至少出现在多少个站点
+	MinHosts int
+	// 特征最多出现在全部站点中的比例
+	MaxRatio float64
+	// 候选规则的最低特征分数
+	MinScore int
+	// 每条候选规则最多保留的特征数
+	MaxFeatures int
+	// 每个响应最多提取的静态资源路径数
+	MaxStaticPaths int
+	// 从数据库读取流量时的条件与数量上限
+	HostKeyword string
+	MaxFlows    int
+}
+
+type LearnOption func(config *LearnConfig)
+
+func NewLearnConfig(opts ...LearnOption) *LearnConfig {
+	config := &LearnConfig{
+		MinHosts:       2,
+		MaxRatio:       0.2,
+		MinScore:       2,
+		MaxFeatures:    8,
+		MaxStaticPaths: 20,
+		MaxFlows:       10000,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.MinHosts < 1 {
+		config.MinHosts = 1
+	}
+	return config
+}
+
+func WithLearnMinHosts(n int) LearnOption {
+	return func(config *LearnConfig) {
+		config.MinHosts = n
+	}
+}
+
+func WithLearnMaxRatio(ratio float64) LearnOption {
+	return func(config *LearnConfig) {
+		config.MaxRatio = ratio
+	}
+}
+
+func WithLearnMinScore(score int) LearnOption {
+	return func(config *LearnConfig) {
+		config.MinScore = score
+	}
+}
+
+func WithLearnMaxFeatures(n int) LearnOption {
+	return func(config *LearnConfig) {
+		if n > 0 {
+			config.MaxFeatures = n
+		}
+	}
+}
+
+func WithLearnHostKeyword(keyword string) LearnOption {
+	return func(config *LearnConfig) {
+		config.HostKeyword = keyword
+	}
+}
+
+func WithLearnMaxFlows(n int) LearnOption {
+	return func(config *LearnConfig) {
+		config.MaxFlows = n
+	}
+}
@@ -0,0 +1,51 @@
+package webfingerprint
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bizhelper"
+)
+
+// LoadFlowSamples 从项目数据库读取 HTTPFlow 作为学习样本，跳过 websocket 与没有响应的流量
+func LoadFlowSamples(db *gorm.DB, opts ...LearnOption) ([]*FlowSample, error) {
+	if db == nil {
+		return nil, utils.Error("empty database")
+	}
+	config := NewLearnConfig(opts...)
+	db = db.Model(&schema.HTTPFlow{}).Where("is_websocket = ?", false).Where("status_code > 0")
+	if config.HostKeyword != "" {
+		db = db.Where("url LIKE ?", "%"+config.HostKeyword+"%")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var samples []*FlowSample
+	for flow := range bizhelper.YieldModel[*schema.HTTPFlow](ctx, db) {
+		sample, err := NewFlowSampleFromHTTPFlow(flow)
+		if err != nil {
+			log.Debugf("skip http flow %d: %v", flow.ID, err)
+			continue
+		}
+		samples = append(samples, sample)
+		if config.MaxFlows > 0 && len(samples) >= config.MaxFlows {
+			break
+		}
+	}
+	return samples, nil
+}
+
+// LearnFromHTTPFlow 从项目数据库的历史流量中学习候选规则，候选规则已经在同一批流量上完成验证
+func LearnFromHTTPFlow(db *gorm.DB, opts ...LearnOption) ([]*LearnCandidate, []*FlowSample, error) {
+	samples, err := LoadFlowSamples(db, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(samples) == 0 {
+		return nil, nil, utils.Error("no http flow to learn")
+	}
+	return LearnFingerprints(samples, opts...), samples, nil
+}
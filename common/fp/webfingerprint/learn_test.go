@@ -0,0 +1,105 @@
+package webfingerprint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLearnTestSample(t *testing.T, urlStr string, headers []string, body string) *FlowSample {
+	rsp := "HTTP/1.1 200 OK\r\n" + strings.Join(headers, "\r\n")
+	if len(headers) > 0 {
+		rsp += "\r\n"
+	}
+	rsp += fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+	sample, err := NewFlowSample(urlStr, []byte(rsp))
+	require.NoError(t, err)
+	return sample
+}
+
+func learnTestSamples(t *testing.T) []*FlowSample {
+	var samples []*FlowSample
+	for i := 1; i <= 3; i++ {
+		host := fmt.Sprintf("http://acme%d.example.com", i)
+		samples = append(samples,
+			newLearnTestSample(t, host+"/", []string{
+				"Server: nginx",
+				"X-Acme-Node: " + fmt.Sprint(i),
+				"Set-Cookie: ACMESID=" + fmt.Sprint(i*1000) + "; Path=/",
+				"Content-Type: text/html",
+			}, `<html><head><title>Acme Console</title><script src="/acme/static/app.js?v=`+fmt.Sprint(i)+`"></script>`+
+				`<script src="https://cdn.example.net/jquery.js"></script></head></html>`),
+			newLearnTestSample(t, host+"/favicon.ico", []string{"Content-Type: image/x-icon"}, "acme-icon"),
+		)
+	}
+	for i := 1; i <= 12; i++ {
+		samples = append(samples, newLearnTestSample(t, fmt.Sprintf("https://site%d.example.org/", i), []string{
+			"Server: nginx",
+			"Content-Type: text/html",
+		}, fmt.Sprintf(`<html><title>Site %d</title><script src="/js/jquery.js"></script></html>`, i)))
+	}
+	return samples
+}
+
+func TestLearnFingerprints(t *testing.T) {
+	samples := learnTestSamples(t)
+	candidates := LearnFingerprints(samples)
+	require.Len(t, candidates, 1)
+
+	candidate := candidates[0]
+	assert.Equal(t, []string{"acme1.example.com", "acme2.example.com", "acme3.example.com"}, candidate.Hosts)
+	assert.Equal(t, "learned_acme_console", candidate.CPE.Product)
+
+	var features []string
+	for _, feature := range candidate.Features {
+		features = append(features, feature.Kind+":"+feature.Name+":"+feature.Value)
+	}
+	assert.Contains(t, features, "title::Acme Console")
+	assert.Contains(t, features, "cookie:ACMESID:")
+	assert.Contains(t, features, "header:X-Acme-Node:")
+	assert.Contains(t, features, "static::/acme/static/app.js")
+	assert.Equal(t, LearnFeatureFavicon, candidate.Features[0].Kind)
+	for _, feature := range features {
+		assert.NotContains(t, feature, "nginx")
+		assert.NotContains(t, feature, "jquery")
+	}
+
+	require.NotNil(t, candidate.Validation)
+	assert.Equal(t, candidate.Hosts, candidate.Validation.MatchedHosts)
+	assert.Empty(t, candidate.Validation.UnexpectedHosts)
+	assert.Equal(t, 1.0, candidate.Validation.Precision)
+	assert.Equal(t, 1.0, candidate.Validation.Recall)
+}
+
+func TestLearnCandidateRules(t *testing.T) {
+	candidates := LearnFingerprints(learnTestSamples(t))
+	require.Len(t, candidates, 1)
+	candidate := candidates[0]
+	candidate.SetProduct("acme", "acme_console")
+
+	raw, err := candidate.YAML()
+	require.NoError(t, err)
+	rules, err := ParseWebFingerprintRules([]byte(raw))
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "/favicon.ico", rules[1].Path)
+
+	validation := ValidateWebRules(rules, nil, learnTestSamples(t))
+	assert.Len(t, validation.MatchedHosts, 3)
+
+	exp := candidate.MatchExpression()
+	assert.Contains(t, exp, `title = "Acme Console"`)
+	assert.Contains(t, exp, `header = "Set-Cookie: ACMESID="`)
+	assert.Contains(t, exp, `body = "/acme/static/app.js"`)
+
+	generalRules := candidate.GeneralRules()
+	require.Len(t, generalRules, 2)
+	assert.Equal(t, "acme_console", generalRules[0].RuleName)
+	assert.Equal(t, "acme", generalRules[0].CPE.Vendor)
+	assert.Equal(t, "acme_console_favicon", generalRules[1].RuleName)
+	assert.Equal(t, "/favicon.ico", generalRules[1].WebPath)
+	assert.True(t, strings.HasPrefix(generalRules[1].MatchExpression, "md5 == "))
+}
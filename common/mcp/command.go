@@ -21,9 +21,12 @@ var MCPCommand = &cli.Command{
 	Name:  "mcp",
 	Usage: MCPCommandUsage,
 	Flags: []cli.Flag{
-		cli.StringFlag{Name: "transport", Usage: "transport protocol, e.g. sse/stdio", Value: "stdio"},
-		cli.StringFlag{Name: "host", Usage: "if transport is sse, listen host", Value: "localhost"},
-		cli.IntFlag{Name: "port", Usage: "if transport is sse, listen port", Value: 11432},
+		cli.StringFlag{Name: "transport", Usage: "transport protocol, e.g. sse/stdio/streamable-http", Value: "stdio"},
+		cli.StringFlag{Name: "host", Usage: "if transport is sse or streamable-http, listen host", Value: "localhost"},
+		cli.IntFlag{Name: "port", Usage: "if transport is sse or streamable-http, listen port", Value: 11432},
+		cli.StringFlag{Name: "path", Usage: "if transport is streamable-http, the mcp endpoint path", Value: "/mcp"},
+		cli.StringSliceFlag{Name: "token", Usage: "if transport is streamable-http, add an auth token (name:token[:allow1,allow2], the allowlist contains names of tools, resources, prompts, tool sets or resource sets), sent as 'Authorization: Bearer <token>' or 'X-API-Key: <token>'"},
+		cli.BoolFlag{Name: "disable-audit", Usage: "disable the audit log of tool invocations"},
 		cli.StringFlag{Name: "t,tool", Usage: "enable tool sets, split by ','"},
 		cli.StringFlag{Name: "dt,disable-tool", Usage: "disable tool sets, split by ','"},
 		cli.StringFlag{Name: "r,resource", Usage: "enable resource sets, split by ','"},
@@ -73,6 +76,16 @@ var MCPCommand = &cli.Command{
 		if len(script) > 0 {
 			opts = append(opts, WithDynamicScript(script))
 		}
+		for _, token := range c.StringSlice("token") {
+			opt, err := parseAuthToken(token)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, opt)
+		}
+		if c.Bool("disable-audit") {
+			opts = append(opts, WithDisableAuditLog())
+		}
 
 		s, err := NewMCPServer(opts...)
 		if err != nil {
@@ -90,6 +103,17 @@ var MCPCommand = &cli.Command{
 			urlStr := fmt.Sprintf("http://%s", hostPort)
 			log.Infof("start to listen reverse(mcp) on: %s", urlStr)
 			err = s.ServeSSE(hostPort, urlStr)
+		case "streamable-http", "http":
+			if port == 0 {
+				port = utils.GetRandomAvailableTCPPort()
+			}
+			hostPort := utils.HostPort(host, port)
+			path := c.String("path")
+			if !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+			log.Infof("start to listen streamable http mcp on: http://%s%s", hostPort, path)
+			err = s.ServeStreamableHTTP(hostPort, path)
 		default:
 			return utils.Errorf("invalid transport: %v", transport)
 		}
@@ -34,6 +34,28 @@ type PromptHandlerFunc func(ctx context.Context, request mcp.GetPromptRequest) (
 // ToolHandlerFunc handles tool calls with given arguments.
 type ToolHandlerFunc func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
 
+// ToolFilterFunc filters the tools listed to the client of the request
+type ToolFilterFunc func(ctx context.Context, tools []*mcp.Tool) []*mcp.Tool
+
+// ToolHandlerMiddleware wraps the handlers of all tools, e.g. for authorization or auditing
+type ToolHandlerMiddleware func(next ToolHandlerFunc) ToolHandlerFunc
+
+// ResourceFilterFunc filters the resources listed to the client of the request
+type ResourceFilterFunc func(ctx context.Context, resources []*mcp.Resource) []*mcp.Resource
+
+// ResourceTemplateFilterFunc filters the resource templates listed to the client of the request
+type ResourceTemplateFilterFunc func(ctx context.Context, templates []*mcp.ResourceTemplate) []*mcp.ResourceTemplate
+
+// ResourceHandlerMiddleware wraps the handlers of resources/read, name is the name
+// of the resource or resource template matched by the uri
+type ResourceHandlerMiddleware func(name string, next ResourceHandlerFunc) ResourceHandlerFunc
+
+// PromptFilterFunc filters the prompts listed to the client of the request
+type PromptFilterFunc func(ctx context.Context, prompts []mcp.Prompt) []mcp.Prompt
+
+// PromptHandlerMiddleware wraps the handlers of all prompts
+type PromptHandlerMiddleware func(next PromptHandlerFunc) PromptHandlerFunc
+
 // NotificationContext provides client identification for notifications
 type NotificationContext struct {
 	ClientID  string
@@ -65,6 +87,13 @@ type MCPServer struct {
 	notifications        chan ServerNotification
 	currentClient        NotificationContext
 	initialized          bool
+	toolFilters          []ToolFilterFunc
+	toolMiddlewares      []ToolHandlerMiddleware
+	resourceFilters      []ResourceFilterFunc
+	templateFilters      []ResourceTemplateFilterFunc
+	resourceMiddlewares  []ResourceHandlerMiddleware
+	promptFilters        []PromptFilterFunc
+	promptMiddlewares    []PromptHandlerMiddleware
 }
 
 // serverKey is the context key for storing the server instance
@@ -154,6 +183,58 @@ func WithPromptCapabilities(listChanged bool) ServerOption {
 	}
 }
 
+// WithToolFilter adds a filter applied to the result of tools/list
+func WithToolFilter(filter ToolFilterFunc) ServerOption {
+	return func(s *MCPServer) {
+		s.toolFilters = append(s.toolFilters, filter)
+	}
+}
+
+// WithToolHandlerMiddleware adds a middleware applied to every tools/call,
+// the middleware added first is the outermost one
+func WithToolHandlerMiddleware(middleware ToolHandlerMiddleware) ServerOption {
+	return func(s *MCPServer) {
+		s.toolMiddlewares = append(s.toolMiddlewares, middleware)
+	}
+}
+
+// WithResourceFilter adds a filter applied to the result of resources/list
+func WithResourceFilter(filter ResourceFilterFunc) ServerOption {
+	return func(s *MCPServer) {
+		s.resourceFilters = append(s.resourceFilters, filter)
+	}
+}
+
+// WithResourceTemplateFilter adds a filter applied to the result of resources/templates/list
+func WithResourceTemplateFilter(filter ResourceTemplateFilterFunc) ServerOption {
+	return func(s *MCPServer) {
+		s.templateFilters = append(s.templateFilters, filter)
+	}
+}
+
+// WithResourceHandlerMiddleware adds a middleware applied to every resources/read,
+// the middleware added first is the outermost one
+func WithResourceHandlerMiddleware(middleware ResourceHandlerMiddleware) ServerOption {
+	return func(s *MCPServer) {
+		s.resourceMiddlewares = append(s.resourceMiddlewares, middleware)
+	}
+}
+
+// WithPromptFilter adds a filter applied to the result of prompts/list
+func WithPromptFilter(filter PromptFilterFunc) ServerOption {
+	return func(s *MCPServer) {
+		s.promptFilters = append(s.promptFilters, filter)
+	}
+}
+
+// WithPromptHandlerMiddleware adds a middleware applied to every prompts/get,
+// the middleware added first is the outermost one
+func WithPromptHandlerMiddleware(middleware PromptHandlerMiddleware) ServerOption {
+	return func(s *MCPServer) {
+		s.promptMiddlewares = append(s.promptMiddlewares, middleware)
+	}
+}
+
 // WithLogging enables logging capabilities for the server
 func WithLogging() ServerOption {
 	return func(s *MCPServer) {
@@ -498,6 +579,9 @@ func (s *MCPServer) handleListResources(
 	for _, entry := range s.resources {
 		resources = append(resources, entry.resource)
 	}
+	for _, filter := range s.resourceFilters {
+		resources = filter(ctx, resources)
+	}
 
 	result := mcp.ListResourcesResult{
 		Resources: resources,
@@ -517,6 +601,9 @@ func (s *MCPServer) handleListResourceTemplates(
 	for _, entry := range s.resourceTemplates {
 		templates = append(templates, entry.template)
 	}
+	for _, filter := range s.templateFilters {
+		templates = filter(ctx, templates)
+	}
 
 	result := mcp.ListResourceTemplatesResult{
 		ResourceTemplates: templates,
@@ -534,7 +621,7 @@ func (s *MCPServer) handleReadResource(
 ) mcp.JSONRPCMessage {
 	// First try direct resource handlers
 	if entry, ok := s.resources[request.Params.URI]; ok {
-		contents, err := entry.handler(ctx, request)
+		contents, err := s.wrapResourceHandler(entry.resource.Name, entry.handler)(ctx, request)
 		if err != nil {
 			return createErrorResponse(id, mcp.INTERNAL_ERROR, err.Error())
 		}
@@ -544,7 +631,7 @@ func (s *MCPServer) handleReadResource(
 	// If no direct handler found, try matching against templates
 	for uriTemplate, entry := range s.resourceTemplates {
 		if matchesTemplate(request.Params.URI, uriTemplate) {
-			contents, err := entry.handler(ctx, request)
+			contents, err := s.wrapResourceHandler(entry.template.Name, entry.handler)(ctx, request)
 			if err != nil {
 				return createErrorResponse(id, mcp.INTERNAL_ERROR, err.Error())
 			}
@@ -565,6 +652,13 @@ func (s *MCPServer) handleReadResource(
 	)
 }
 
+func (s *MCPServer) wrapResourceHandler(name string, handler ResourceHandlerFunc) ResourceHandlerFunc {
+	for i := len(s.resourceMiddlewares) - 1; i >= 0; i-- {
+		handler = s.resourceMiddlewares[i](name, handler)
+	}
+	return handler
+}
+
 // matchesTemplate checks if a URI matches a URI template pattern
 func matchesTemplate(uri string, template string) bool {
 	// Convert template into a regex pattern
@@ -588,6 +682,9 @@ func (s *MCPServer) handleListPrompts(
 	for _, prompt := range s.prompts {
 		prompts = append(prompts, prompt)
 	}
+	for _, filter := range s.promptFilters {
+		prompts = filter(ctx, prompts)
+	}
 
 	result := mcp.ListPromptsResult{
 		Prompts: prompts,
@@ -611,6 +708,9 @@ func (s *MCPServer) handleGetPrompt(
 			fmt.Sprintf("Prompt not found: %s", request.Params.Name),
 		)
 	}
+	for i := len(s.promptMiddlewares) - 1; i >= 0; i-- {
+		handler = s.promptMiddlewares[i](handler)
+	}
 
 	result, err := handler(ctx, request)
 	if err != nil {
@@ -629,6 +729,9 @@ func (s *MCPServer) handleListTools(
 	for name := range s.tools {
 		tools = append(tools, s.tools[name])
 	}
+	for _, filter := range s.toolFilters {
+		tools = filter(ctx, tools)
+	}
 
 	result := mcp.ListToolsResult{
 		Tools: tools,
@@ -653,6 +756,10 @@ func (s *MCPServer) handleToolCall(
 		)
 	}
 
+	for i := len(s.toolMiddlewares) - 1; i >= 0; i-- {
+		handler = s.toolMiddlewares[i](handler)
+	}
+
 	result, err := handler(ctx, request)
 	if err != nil {
 		return createErrorResponse(id, mcp.INTERNAL_ERROR, err.Error())
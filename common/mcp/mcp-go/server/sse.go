@@ -167,7 +167,8 @@ func (s *SSEServer) handleMessage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Set the client context in the server before handling the message
-	ctx := s.server.WithContext(r.Context(), NotificationContext{
+	ctx := context.WithValue(r.Context(), sessionIDKey{}, sessionID)
+	ctx = s.server.WithContext(ctx, NotificationContext{
 		ClientID:  sessionID,
 		SessionID: sessionID,
 	})
@@ -0,0 +1,430 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+)
+
+const (
+	// SessionIDHeader is the header carrying the session id of the streamable http transport
+	SessionIDHeader = "Mcp-Session-Id"
+
+	defaultStreamableHTTPPath = "/mcp"
+	defaultSessionIdleTimeout = 30 * time.Minute
+	streamKeepAliveInterval   = 25 * time.Second
+	maxRequestBodySize        = 10 << 20
+)
+
+// HTTPContextFunc derives the context of the JSON-RPC messages from the http request
+type HTTPContextFunc func(ctx context.Context, r *http.Request) context.Context
+
+// SessionOwnerFunc identifies the owner of a session, a session can only be used by its owner
+type SessionOwnerFunc func(r *http.Request) string
+
+// StreamableHTTPOption configures a StreamableHTTPServer
+type StreamableHTTPOption func(*StreamableHTTPServer)
+
+// WithEndpointPath sets the path of the mcp endpoint, default is /mcp
+func WithEndpointPath(path string) StreamableHTTPOption {
+	return func(s *StreamableHTTPServer) {
+		if path != "" && !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		if path != "" {
+			s.endpointPath = path
+		}
+	}
+}
+
+// WithSessionIdleTimeout sets how long an inactive session is kept, default is 30 minutes
+func WithSessionIdleTimeout(timeout time.Duration) StreamableHTTPOption {
+	return func(s *StreamableHTTPServer) {
+		s.idleTimeout = timeout
+	}
+}
+
+// WithHTTPContextFunc sets the function deriving the message context from the http request
+func WithHTTPContextFunc(fn HTTPContextFunc) StreamableHTTPOption {
+	return func(s *StreamableHTTPServer) {
+		s.contextFunc = fn
+	}
+}
+
+// WithSessionOwnerFunc binds sessions to the owner identified by fn
+func WithSessionOwnerFunc(fn SessionOwnerFunc) StreamableHTTPOption {
+	return func(s *StreamableHTTPServer) {
+		s.ownerFunc = fn
+	}
+}
+
+// StreamableHTTPServer implements the Streamable HTTP transport of MCP.
+// The client POSTs JSON-RPC messages to a single endpoint, the session is
+// created by initialize and identified by the Mcp-Session-Id header, GET opens
+// a SSE stream for server notifications and DELETE terminates the session.
+type StreamableHTTPServer struct {
+	server       *MCPServer
+	endpointPath string
+	idleTimeout  time.Duration
+	contextFunc  HTTPContextFunc
+	ownerFunc    SessionOwnerFunc
+	sessions     sync.Map
+
+	mu  sync.Mutex
+	srv *http.Server
+}
+
+// streamableSession represents a session of the streamable http transport.
+type streamableSession struct {
+	id         string
+	owner      string
+	lastActive int64
+	streaming  int32
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+func (s *streamableSession) touch() {
+	atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+}
+
+func (s *streamableSession) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// NewStreamableHTTPServer creates a new streamable http server instance with the given MCP server.
+func NewStreamableHTTPServer(server *MCPServer, opts ...StreamableHTTPOption) *StreamableHTTPServer {
+	s := &StreamableHTTPServer{
+		server:       server,
+		endpointPath: defaultStreamableHTTPPath,
+		idleTimeout:  defaultSessionIdleTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// EndpointPath returns the path of the mcp endpoint
+func (s *StreamableHTTPServer) EndpointPath() string {
+	return s.endpointPath
+}
+
+// Start begins serving the mcp endpoint on the specified address.
+func (s *StreamableHTTPServer) Start(addr string) error {
+	return s.StartWithHandler(addr, s)
+}
+
+// StartWithHandler serves the mcp endpoint with handler, which is usually s wrapped by middlewares.
+func (s *StreamableHTTPServer) StartWithHandler(addr string, handler http.Handler) error {
+	mux := http.NewServeMux()
+	mux.Handle(s.endpointPath, handler)
+
+	s.mu.Lock()
+	s.srv = &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+	srv := s.srv
+	s.mu.Unlock()
+
+	return srv.ListenAndServe()
+}
+
+// Shutdown gracefully stops the server, closing all active sessions
+// and shutting down the HTTP server.
+func (s *StreamableHTTPServer) Shutdown(ctx context.Context) error {
+	s.sessions.Range(func(key, value interface{}) bool {
+		value.(*streamableSession).close()
+		s.sessions.Delete(key)
+		return true
+	})
+
+	s.mu.Lock()
+	srv := s.srv
+	s.mu.Unlock()
+	if srv != nil {
+		return srv.Shutdown(ctx)
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler, so the endpoint can be mounted on any mux.
+func (s *StreamableHTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		s.handlePost(w, r)
+	case http.MethodGet:
+		s.handleGet(w, r)
+	case http.MethodDelete:
+		s.handleDelete(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST, DELETE")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handlePost processes JSON-RPC messages (single or batch) and replies with JSON.
+func (s *StreamableHTTPServer) handlePost(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.PARSE_ERROR, "Parse error")
+		return
+	}
+
+	var messages []json.RawMessage
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+	if isBatch {
+		if err := json.Unmarshal(body, &messages); err != nil || len(messages) == 0 {
+			s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.PARSE_ERROR, "Parse error")
+			return
+		}
+	} else {
+		if !json.Valid(body) {
+			s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.PARSE_ERROR, "Parse error")
+			return
+		}
+		messages = []json.RawMessage{body}
+	}
+
+	isInitialize := false
+	for _, message := range messages {
+		var base struct {
+			Method string `json:"method"`
+		}
+		_ = json.Unmarshal(message, &base)
+		if base.Method == "initialize" {
+			isInitialize = true
+		}
+	}
+
+	var session *streamableSession
+	if isInitialize {
+		if len(messages) > 1 {
+			s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.INVALID_REQUEST, "Initialize request must not be batched")
+			return
+		}
+		s.removeExpiredSessions()
+		session = &streamableSession{
+			id:    uuid.New().String(),
+			owner: s.owner(r),
+			done:  make(chan struct{}),
+		}
+		session.touch()
+	} else {
+		var ok bool
+		session, ok = s.loadSession(w, r)
+		if !ok {
+			return
+		}
+	}
+
+	ctx := s.messageContext(r, session.id)
+	var responses []mcp.JSONRPCMessage
+	for _, message := range messages {
+		if response := s.server.HandleMessage(ctx, message); response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if isInitialize {
+		if len(responses) == 0 {
+			s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.INVALID_REQUEST, "Invalid initialize request")
+			return
+		}
+		if _, failed := responses[0].(mcp.JSONRPCError); !failed {
+			s.sessions.Store(session.id, session)
+			w.Header().Set(SessionIDHeader, session.id)
+		}
+	}
+
+	// notifications and responses only
+	if len(responses) == 0 {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if isBatch {
+		json.NewEncoder(w).Encode(responses)
+	} else {
+		json.NewEncoder(w).Encode(responses[0])
+	}
+}
+
+// handleGet opens a SSE stream delivering the notifications of the session.
+func (s *StreamableHTTPServer) handleGet(w http.ResponseWriter, r *http.Request) {
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		http.Error(w, "Not Acceptable: client must accept text/event-stream", http.StatusNotAcceptable)
+		return
+	}
+	session, ok := s.loadSession(w, r)
+	if !ok {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&session.streaming, 0, 1) {
+		http.Error(w, "Conflict: only one stream is allowed per session", http.StatusConflict)
+		return
+	}
+	defer atomic.StoreInt32(&session.streaming, 0)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set(SessionIDHeader, session.id)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(streamKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case serverNotification := <-s.server.notifications:
+			// Only forward notifications meant for this session
+			if serverNotification.Context.SessionID != session.id {
+				continue
+			}
+			eventData, err := json.Marshal(serverNotification.Notification)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: message\ndata: %s\n\n", eventData)
+			flusher.Flush()
+			session.touch()
+		case <-ticker.C:
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+			session.touch()
+		case <-session.done:
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// handleDelete terminates the session.
+func (s *StreamableHTTPServer) handleDelete(w http.ResponseWriter, r *http.Request) {
+	session, ok := s.loadSession(w, r)
+	if !ok {
+		return
+	}
+	s.sessions.Delete(session.id)
+	session.close()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadSession finds the session of the request, 400 is replied if the session id is
+// missing and 404 if the session is unknown, expired or owned by others.
+func (s *StreamableHTTPServer) loadSession(w http.ResponseWriter, r *http.Request) (*streamableSession, bool) {
+	sessionID := r.Header.Get(SessionIDHeader)
+	if sessionID == "" {
+		s.writeJSONRPCError(w, http.StatusBadRequest, nil, mcp.INVALID_REQUEST, "Missing session ID")
+		return nil, false
+	}
+	sessionI, ok := s.sessions.Load(sessionID)
+	if ok {
+		session := sessionI.(*streamableSession)
+		if s.expired(session) {
+			s.sessions.Delete(sessionID)
+			session.close()
+		} else if session.owner == s.owner(r) {
+			session.touch()
+			return session, true
+		}
+	}
+	s.writeJSONRPCError(w, http.StatusNotFound, nil, mcp.INVALID_REQUEST, "Session not found")
+	return nil, false
+}
+
+// SessionCount returns the number of active sessions
+func (s *StreamableHTTPServer) SessionCount() int {
+	count := 0
+	s.sessions.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func (s *StreamableHTTPServer) removeExpiredSessions() {
+	s.sessions.Range(func(key, value interface{}) bool {
+		session := value.(*streamableSession)
+		if s.expired(session) {
+			s.sessions.Delete(key)
+			session.close()
+		}
+		return true
+	})
+}
+
+func (s *StreamableHTTPServer) expired(session *streamableSession) bool {
+	if s.idleTimeout <= 0 || atomic.LoadInt32(&session.streaming) == 1 {
+		return false
+	}
+	return time.Since(time.Unix(0, atomic.LoadInt64(&session.lastActive))) > s.idleTimeout
+}
+
+func (s *StreamableHTTPServer) owner(r *http.Request) string {
+	if s.ownerFunc == nil {
+		return ""
+	}
+	return s.ownerFunc(r)
+}
+
+func (s *StreamableHTTPServer) messageContext(r *http.Request, sessionID string) context.Context {
+	ctx := r.Context()
+	if s.contextFunc != nil {
+		ctx = s.contextFunc(ctx, r)
+	}
+	ctx = context.WithValue(ctx, sessionIDKey{}, sessionID)
+	// Set the client context in the server before handling the message
+	return s.server.WithContext(ctx, NotificationContext{
+		ClientID:  sessionID,
+		SessionID: sessionID,
+	})
+}
+
+// writeJSONRPCError writes a JSON-RPC error response with the given http status.
+func (s *StreamableHTTPServer) writeJSONRPCError(
+	w http.ResponseWriter,
+	status int,
+	id interface{},
+	code int,
+	message string,
+) {
+	response := createErrorResponse(id, code, message)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+// sessionIDKey is the context key for storing the transport session id
+type sessionIDKey struct{}
+
+// SessionIDFromContext retrieves the transport session id from a context
+func SessionIDFromContext(ctx context.Context) string {
+	if sessionID, ok := ctx.Value(sessionIDKey{}).(string); ok {
+		return sessionID
+	}
+	return ""
+}
@@ -0,0 +1,250 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+)
+
+func postMessage(t *testing.T, url, sessionID, body string) (*http.Response, map[string]interface{}) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if sessionID != "" {
+		req.Header.Set(SessionIDHeader, sessionID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	if resp.StatusCode == http.StatusOK && !strings.HasPrefix(strings.TrimSpace(body), "[") {
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+	}
+	return resp, result
+}
+
+const initializeMessage = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","clientInfo":{"name":"test-client","version":"1.0.0"}}}`
+
+func newStreamableTestServer(opts ...StreamableHTTPOption) (*StreamableHTTPServer, *httptest.Server) {
+	mcpServer := NewMCPServer("test", "1.0.0",
+		WithToolFilter(func(ctx context.Context, tools []*mcp.Tool) []*mcp.Tool {
+			var filtered []*mcp.Tool
+			for _, tool := range tools {
+				if tool.Name != "hidden" {
+					filtered = append(filtered, tool)
+				}
+			}
+			return filtered
+		}),
+		WithToolHandlerMiddleware(func(next ToolHandlerFunc) ToolHandlerFunc {
+			return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				if request.Params.Name == "hidden" {
+					return nil, fmt.Errorf("tool %s is denied", request.Params.Name)
+				}
+				return next(ctx, request)
+			}
+		}),
+	)
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{Content: []interface{}{
+			mcp.TextContent{Type: "text", Text: "session: " + SessionIDFromContext(ctx)},
+		}}, nil
+	}
+	mcpServer.AddTool(mcp.NewTool("echo"), handler)
+	mcpServer.AddTool(mcp.NewTool("hidden"), handler)
+
+	streamableServer := NewStreamableHTTPServer(mcpServer, opts...)
+	return streamableServer, httptest.NewServer(streamableServer)
+}
+
+func TestStreamableHTTPServer(t *testing.T) {
+	t.Run("Session lifecycle", func(t *testing.T) {
+		_, testServer := newStreamableTestServer()
+		defer testServer.Close()
+
+		// requests without session are rejected
+		resp, _ := postMessage(t, testServer.URL, "", `{"jsonrpc":"2.0","id":1,"method":"ping"}`)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+		resp, _ = postMessage(t, testServer.URL, "unknown", `{"jsonrpc":"2.0","id":1,"method":"ping"}`)
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", resp.StatusCode)
+		}
+
+		resp, result := postMessage(t, testServer.URL, "", initializeMessage)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+		}
+		sessionID := resp.Header.Get(SessionIDHeader)
+		if sessionID == "" {
+			t.Fatal("Expected session id header")
+		}
+		if result["result"].(map[string]interface{})["serverInfo"].(map[string]interface{})["name"] != "test" {
+			t.Errorf("Unexpected initialize result: %v", result)
+		}
+
+		// notifications are accepted without body
+		resp, _ = postMessage(t, testServer.URL, sessionID, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+		if resp.StatusCode != http.StatusAccepted {
+			t.Errorf("Expected status 202, got %d", resp.StatusCode)
+		}
+
+		resp, result = postMessage(t, testServer.URL, sessionID, `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo"}}`)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+		}
+		content := result["result"].(map[string]interface{})["content"].([]interface{})
+		if text := content[0].(map[string]interface{})["text"]; text != "session: "+sessionID {
+			t.Errorf("Expected the session id in tool context, got %v", text)
+		}
+
+		req, _ := http.NewRequest(http.MethodDelete, testServer.URL, nil)
+		req.Header.Set(SessionIDHeader, sessionID)
+		deleteResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to delete session: %v", err)
+		}
+		deleteResp.Body.Close()
+		if deleteResp.StatusCode != http.StatusNoContent {
+			t.Errorf("Expected status 204, got %d", deleteResp.StatusCode)
+		}
+		resp, _ = postMessage(t, testServer.URL, sessionID, `{"jsonrpc":"2.0","id":3,"method":"ping"}`)
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404 after delete, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Batch, tool filter and middleware", func(t *testing.T) {
+		_, testServer := newStreamableTestServer()
+		defer testServer.Close()
+
+		resp, _ := postMessage(t, testServer.URL, "", initializeMessage)
+		sessionID := resp.Header.Get(SessionIDHeader)
+
+		req, _ := http.NewRequest(http.MethodPost, testServer.URL, strings.NewReader(`[
+			{"jsonrpc":"2.0","id":1,"method":"tools/list"},
+			{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"hidden"}},
+			{"jsonrpc":"2.0","method":"notifications/initialized"}
+		]`))
+		req.Header.Set(SessionIDHeader, sessionID)
+		batchResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to send batch: %v", err)
+		}
+		defer batchResp.Body.Close()
+		var responses []map[string]interface{}
+		if err := json.NewDecoder(batchResp.Body).Decode(&responses); err != nil {
+			t.Fatalf("Failed to decode batch response: %v", err)
+		}
+		if len(responses) != 2 {
+			t.Fatalf("Expected 2 responses, got %d", len(responses))
+		}
+		tools := responses[0]["result"].(map[string]interface{})["tools"].([]interface{})
+		if len(tools) != 1 || tools[0].(map[string]interface{})["name"] != "echo" {
+			t.Errorf("Expected only the echo tool, got %v", tools)
+		}
+		if !strings.Contains(fmt.Sprint(responses[1]["error"]), "denied") {
+			t.Errorf("Expected the hidden tool to be denied, got %v", responses[1])
+		}
+	})
+
+	t.Run("Session owner and idle timeout", func(t *testing.T) {
+		streamableServer, testServer := newStreamableTestServer(
+			WithSessionOwnerFunc(func(r *http.Request) string { return r.Header.Get("X-Owner") }),
+			WithSessionIdleTimeout(200*time.Millisecond),
+		)
+		defer testServer.Close()
+
+		req, _ := http.NewRequest(http.MethodPost, testServer.URL, strings.NewReader(initializeMessage))
+		req.Header.Set("X-Owner", "alice")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to initialize: %v", err)
+		}
+		resp.Body.Close()
+		sessionID := resp.Header.Get(SessionIDHeader)
+
+		// the session can not be used by others
+		resp, _ = postMessage(t, testServer.URL, sessionID, `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404 for other owner, got %d", resp.StatusCode)
+		}
+
+		time.Sleep(300 * time.Millisecond)
+		req, _ = http.NewRequest(http.MethodPost, testServer.URL, strings.NewReader(`{"jsonrpc":"2.0","id":3,"method":"ping"}`))
+		req.Header.Set("X-Owner", "alice")
+		req.Header.Set(SessionIDHeader, sessionID)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to ping: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected status 404 for expired session, got %d", resp.StatusCode)
+		}
+		if streamableServer.SessionCount() != 0 {
+			t.Errorf("Expected expired session to be removed")
+		}
+	})
+
+	t.Run("Notification stream", func(t *testing.T) {
+		streamableServer, testServer := newStreamableTestServer()
+		defer testServer.Close()
+
+		resp, _ := postMessage(t, testServer.URL, "", initializeMessage)
+		sessionID := resp.Header.Get(SessionIDHeader)
+
+		req, _ := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		req.Header.Set("Accept", "text/event-stream")
+		req.Header.Set(SessionIDHeader, sessionID)
+		streamResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to open stream: %v", err)
+		}
+		defer streamResp.Body.Close()
+		if streamResp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", streamResp.StatusCode)
+		}
+
+		// the notification is sent to the client of the latest message
+		streamableServer.server.WithContext(context.Background(), NotificationContext{ClientID: sessionID, SessionID: sessionID})
+		if err := streamableServer.server.SendNotificationToClient("notifications/message", map[string]interface{}{"data": "hello"}); err != nil {
+			t.Fatalf("Failed to send notification: %v", err)
+		}
+
+		reader := bufio.NewReader(streamResp.Body)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatalf("Failed to read stream: %v", err)
+			}
+			if strings.HasPrefix(line, "data: ") {
+				if !strings.Contains(line, "hello") {
+					t.Errorf("Unexpected notification: %s", line)
+				}
+				break
+			}
+		}
+
+		if err := streamableServer.Shutdown(context.Background()); err != nil {
+			t.Errorf("Failed to shutdown: %v", err)
+		}
+	})
+}
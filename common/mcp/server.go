@@ -7,27 +7,39 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
 	"github.com/yaklang/yaklang/common/mcp/mcp-go/server"
+	"github.com/yaklang/yaklang/common/utils"
 )
 
 type MCPServer struct {
-	server     *server.MCPServer
-	sseServer  *server.SSEServer
-	grpcClient YakClientInterface
-	profileDB  *gorm.DB
-	projectDB  *gorm.DB
+	server           *server.MCPServer
+	sseServer        *server.SSEServer
+	streamableServer *server.StreamableHTTPServer
+	grpcClient       YakClientInterface
+	profileDB        *gorm.DB
+	projectDB        *gorm.DB
+
+	transport       string
+	authTokens      []*AuthToken
+	disableAuditLog bool
 
 	sseMu sync.Mutex
 }
 
 func NewMCPServer(opts ...McpServerOption) (*MCPServer, error) {
-	s := &MCPServer{
-		server: server.NewMCPServer(
-			"Yaklang MCP Server",
-			"0.0.2",
-			server.WithResourceCapabilities(true, true),
-			server.WithPromptCapabilities(true),
-		),
-	}
+	s := &MCPServer{}
+	s.server = server.NewMCPServer(
+		"Yaklang MCP Server",
+		"0.0.2",
+		server.WithResourceCapabilities(true, true),
+		server.WithPromptCapabilities(true),
+		server.WithToolFilter(s.filterTools),
+		server.WithToolHandlerMiddleware(s.toolMiddleware),
+		server.WithResourceFilter(s.filterResources),
+		server.WithResourceTemplateFilter(s.filterResourceTemplates),
+		server.WithResourceHandlerMiddleware(s.resourceMiddleware),
+		server.WithPromptFilter(s.filterPrompts),
+		server.WithPromptHandlerMiddleware(s.promptMiddleware),
+	)
 	// tools and resources
 	cfg := NewMCPServerConfig()
 	for _, opt := range opts {
@@ -43,9 +55,13 @@ func NewMCPServer(opts ...McpServerOption) (*MCPServer, error) {
 }
 
 func (s *MCPServer) ServeSSE(addr, baseURL string) (err error) {
+	if len(s.authTokens) > 0 {
+		return utils.Error("auth token is only supported by streamable http transport")
+	}
 	s.sseMu.Lock()
 	sseServer := server.NewSSEServer(s.server, baseURL)
 	s.sseServer = sseServer
+	s.transport = TransportSSE
 	s.sseMu.Unlock()
 
 	s.grpcClient, err = NewLocalClient(true)
@@ -55,7 +71,36 @@ func (s *MCPServer) ServeSSE(addr, baseURL string) (err error) {
 	return sseServer.Start(addr)
 }
 
+// ServeStreamableHTTP serves the Streamable HTTP transport on addr, the mcp endpoint is path (default /mcp).
+// If auth tokens are configured, every request must carry one of them; without tokens only
+// loopback addresses can be listened
+func (s *MCPServer) ServeStreamableHTTP(addr, path string) (err error) {
+	if len(s.authTokens) == 0 && !isLoopbackAddr(addr) {
+		return utils.Errorf("refuse to serve mcp on non-loopback address %v without auth token", addr)
+	}
+	s.sseMu.Lock()
+	streamableServer := server.NewStreamableHTTPServer(
+		s.server,
+		server.WithEndpointPath(path),
+		server.WithHTTPContextFunc(s.httpContext),
+		server.WithSessionOwnerFunc(s.sessionOwner),
+	)
+	s.streamableServer = streamableServer
+	s.transport = TransportStreamableHTTP
+	s.sseMu.Unlock()
+
+	s.grpcClient, err = NewLocalClient(true)
+	if err != nil {
+		return err
+	}
+	return streamableServer.StartWithHandler(addr, s.authMiddleware(streamableServer))
+}
+
 func (s *MCPServer) ServeStdio() (err error) {
+	if len(s.authTokens) > 0 {
+		return utils.Error("auth token is only supported by streamable http transport")
+	}
+	s.transport = TransportStdio
 	s.grpcClient, err = NewLocalClient(true)
 	if err != nil {
 		return err
@@ -64,10 +109,6 @@ func (s *MCPServer) ServeStdio() (err error) {
 }
 
 func (s *MCPServer) Close(ctxs ...context.Context) {
-	if s.sseServer == nil {
-		return
-	}
-
 	s.sseMu.Lock()
 	defer s.sseMu.Unlock()
 
@@ -75,8 +116,14 @@ func (s *MCPServer) Close(ctxs ...context.Context) {
 	if len(ctxs) > 0 {
 		ctx = ctxs[0]
 	}
-	s.sseServer.Shutdown(ctx)
-	s.sseServer = nil
+	if s.sseServer != nil {
+		s.sseServer.Shutdown(ctx)
+		s.sseServer = nil
+	}
+	if s.streamableServer != nil {
+		s.streamableServer.Shutdown(ctx)
+		s.streamableServer = nil
+	}
 }
 
 func (s *MCPServer) handleNotification(
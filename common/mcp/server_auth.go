@@ -0,0 +1,308 @@
+package mcp
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/server"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+const (
+	TransportStdio          = "stdio"
+	TransportSSE            = "sse"
+	TransportStreamableHTTP = "streamable-http"
+
+	maxAuditArgumentsSize = 4096
+)
+
+// AuthToken is a credential of the MCP server, it is sent as `Authorization: Bearer <token>`
+// or `X-API-Key: <token>`. Allowlist limits the tools, resources and prompts the token can
+// access, empty allows all of them
+type AuthToken struct {
+	Name      string
+	Token     string
+	Allowlist []string
+
+	allowed map[string]struct{}
+}
+
+func (t *AuthToken) allow(name string) bool {
+	if len(t.allowed) == 0 {
+		return true
+	}
+	_, ok := t.allowed[name]
+	return ok
+}
+
+// WithAuthToken adds a token for the streamable http transport, the allowlist can contain
+// names of tools, resources and prompts, or names of tool sets and resource sets;
+// the token can access everything if the allowlist is empty
+func WithAuthToken(name, token string, allowlist ...string) McpServerOption {
+	return func(cfg *MCPServerConfig) error {
+		if name == "" || token == "" {
+			return utils.Error("name and token of auth token are required")
+		}
+		for _, exist := range cfg.authTokens {
+			if exist.Name == name {
+				return utils.Errorf("duplicate auth token name: %s", name)
+			}
+			if exist.Token == token {
+				return utils.Errorf("duplicate auth token: %s", name)
+			}
+		}
+
+		authToken := &AuthToken{Name: name, Token: token, Allowlist: allowlist, allowed: make(map[string]struct{})}
+		for _, item := range allowlist {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			// tools of dynamic scripts are not in the global tools, so the name is always kept
+			authToken.allowed[item] = struct{}{}
+			if toolSet, ok := globalToolSets[item]; ok {
+				for toolName := range toolSet.Tools {
+					authToken.allowed[toolName] = struct{}{}
+				}
+			}
+			if resourceSet, ok := globalResourceSets[item]; ok {
+				for resourceName := range resourceSet.Resources {
+					authToken.allowed[resourceName] = struct{}{}
+				}
+			}
+		}
+		cfg.authTokens = append(cfg.authTokens, authToken)
+		return nil
+	}
+}
+
+// WithDisableAuditLog disables the audit log of tool invocations
+func WithDisableAuditLog() McpServerOption {
+	return func(cfg *MCPServerConfig) error {
+		cfg.disableAuditLog = true
+		return nil
+	}
+}
+
+// parseAuthToken parses the token of command line: name:token[:allow1,allow2]
+func parseAuthToken(raw string) (McpServerOption, error) {
+	parts := strings.SplitN(strings.TrimSpace(raw), ":", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, utils.Errorf("invalid auth token %q, expect name:token[:allow1,allow2]", raw)
+	}
+	var allowlist []string
+	if len(parts) == 3 {
+		allowlist = lo.FilterMap(strings.Split(parts[2], ","), func(item string, _ int) (string, bool) {
+			item = strings.TrimSpace(item)
+			return item, item != ""
+		})
+	}
+	return WithAuthToken(parts[0], parts[1], allowlist...), nil
+}
+
+// requestInfo describes the http client of a message
+type requestInfo struct {
+	token      *AuthToken
+	remoteAddr string
+}
+
+type requestInfoKey struct{}
+
+func requestInfoFromContext(ctx context.Context) *requestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		return info
+	}
+	return nil
+}
+
+func (s *MCPServer) authenticate(r *http.Request) (*AuthToken, bool) {
+	if len(s.authTokens) == 0 {
+		return nil, true
+	}
+	token := r.Header.Get("X-API-Key")
+	if auth := r.Header.Get("Authorization"); token == "" && len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		token = strings.TrimSpace(auth[7:])
+	}
+	if token == "" {
+		return nil, false
+	}
+	for _, authToken := range s.authTokens {
+		if subtle.ConstantTimeCompare([]byte(authToken.Token), []byte(token)) == 1 {
+			return authToken, true
+		}
+	}
+	return nil, false
+}
+
+func (s *MCPServer) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.authenticate(r); !ok {
+			log.Warnf("mcp server: unauthorized request from %v", r.RemoteAddr)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="yaklang-mcp"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *MCPServer) httpContext(ctx context.Context, r *http.Request) context.Context {
+	token, _ := s.authenticate(r)
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{token: token, remoteAddr: r.RemoteAddr})
+}
+
+// sessionOwner binds the sessions to the token creating them
+func (s *MCPServer) sessionOwner(r *http.Request) string {
+	if token, _ := s.authenticate(r); token != nil {
+		return token.Name
+	}
+	return ""
+}
+
+// tokenFromContext returns the token of the client, nil if auth is disabled
+func tokenFromContext(ctx context.Context) *AuthToken {
+	if info := requestInfoFromContext(ctx); info != nil {
+		return info.token
+	}
+	return nil
+}
+
+func allowedByContext(ctx context.Context, name string) bool {
+	token := tokenFromContext(ctx)
+	return token == nil || token.allow(name)
+}
+
+// filterTools hides the tools not allowed for the token of the client
+func (s *MCPServer) filterTools(ctx context.Context, tools []*mcp.Tool) []*mcp.Tool {
+	return lo.Filter(tools, func(tool *mcp.Tool, _ int) bool {
+		return allowedByContext(ctx, tool.Name)
+	})
+}
+
+func (s *MCPServer) filterResources(ctx context.Context, resources []*mcp.Resource) []*mcp.Resource {
+	return lo.Filter(resources, func(resource *mcp.Resource, _ int) bool {
+		return allowedByContext(ctx, resource.Name)
+	})
+}
+
+func (s *MCPServer) filterResourceTemplates(ctx context.Context, templates []*mcp.ResourceTemplate) []*mcp.ResourceTemplate {
+	return lo.Filter(templates, func(template *mcp.ResourceTemplate, _ int) bool {
+		return allowedByContext(ctx, template.Name)
+	})
+}
+
+func (s *MCPServer) filterPrompts(ctx context.Context, prompts []mcp.Prompt) []mcp.Prompt {
+	return lo.Filter(prompts, func(prompt mcp.Prompt, _ int) bool {
+		return allowedByContext(ctx, prompt.Name)
+	})
+}
+
+// resourceMiddleware enforces the allowlist of the token on resources/read
+func (s *MCPServer) resourceMiddleware(name string, next server.ResourceHandlerFunc) server.ResourceHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]interface{}, error) {
+		if !allowedByContext(ctx, name) {
+			return nil, utils.Errorf("resource %v is not allowed for token %v", request.Params.URI, tokenFromContext(ctx).Name)
+		}
+		return next(ctx, request)
+	}
+}
+
+// promptMiddleware enforces the allowlist of the token on prompts/get
+func (s *MCPServer) promptMiddleware(next server.PromptHandlerFunc) server.PromptHandlerFunc {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		if !allowedByContext(ctx, request.Params.Name) {
+			return nil, utils.Errorf("prompt %v is not allowed for token %v", request.Params.Name, tokenFromContext(ctx).Name)
+		}
+		return next(ctx, request)
+	}
+}
+
+// toolMiddleware enforces the tool allowlist of the token and records the audit log
+func (s *MCPServer) toolMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		if !allowedByContext(ctx, request.Params.Name) {
+			err := utils.Errorf("tool %v is not allowed for token %v", request.Params.Name, tokenFromContext(ctx).Name)
+			s.audit(ctx, request, nil, err, true, start)
+			return nil, err
+		}
+
+		result, err := next(ctx, request)
+		s.audit(ctx, request, result, err, false, start)
+		return result, err
+	}
+}
+
+func (s *MCPServer) audit(ctx context.Context, request mcp.CallToolRequest, result *mcp.CallToolResult, err error, denied bool, start time.Time) {
+	if s.disableAuditLog {
+		return
+	}
+	db := s.projectDB
+	if db == nil {
+		db = consts.GetGormProjectDatabase()
+	}
+	if db == nil {
+		return
+	}
+
+	auditLog := &schema.MCPToolAuditLog{
+		SessionID:  server.SessionIDFromContext(ctx),
+		Transport:  s.transport,
+		ToolName:   request.Params.Name,
+		Success:    err == nil && (result == nil || !result.IsError),
+		Denied:     denied,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if info := requestInfoFromContext(ctx); info != nil {
+		auditLog.RemoteAddr = info.remoteAddr
+		if info.token != nil {
+			auditLog.TokenName = info.token.Name
+		}
+	}
+	if raw, marshalErr := json.Marshal(request.Params.Arguments); marshalErr == nil {
+		auditLog.Arguments = limitText(string(raw), maxAuditArgumentsSize)
+	}
+	if err != nil {
+		auditLog.Error = err.Error()
+	} else if result != nil && result.IsError {
+		texts := lo.FilterMap(result.Content, func(content any, _ int) (string, bool) {
+			text, ok := content.(mcp.TextContent)
+			return text.Text, ok
+		})
+		auditLog.Error = limitText(strings.Join(texts, "\n"), maxAuditArgumentsSize)
+	}
+	if err := yakit.CreateMCPToolAuditLog(db, auditLog); err != nil {
+		log.Warnf("save mcp tool audit log failed: %v", err)
+	}
+}
+
+func limitText(s string, size int) string {
+	runes := []rune(s)
+	if len(runes) <= size {
+		return s
+	}
+	return string(runes[:size]) + "...(truncated)"
+}
+
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,174 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/server"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+func postStreamableMessage(t *testing.T, url, token, sessionID, body string) (*http.Response, map[string]any) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if sessionID != "" {
+		req.Header.Set(server.SessionIDHeader, sessionID)
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+
+	var result map[string]any
+	if rsp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(rsp.Body).Decode(&result))
+	}
+	return rsp, result
+}
+
+func initializeStreamableSession(t *testing.T, url, token string) string {
+	rsp, _ := postStreamableMessage(t, url, token, "", `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","clientInfo":{"name":"test-client","version":"1.0.0"}}}`)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	sessionID := rsp.Header.Get(server.SessionIDHeader)
+	require.NotEmpty(t, sessionID)
+	return sessionID
+}
+
+func TestMCPServer_StreamableHTTPAuth(t *testing.T) {
+	s, err := NewMCPServer(
+		WithEnableCodecToolSet(),
+		WithAuthToken("admin", "admin-token"),
+		WithAuthToken("codec", "codec-token", "codec"),
+		WithAuthToken("echo", "echo-token", "audit_echo"),
+	)
+	require.NoError(t, err)
+	s.server.AddTool(mcp.NewTool("audit_echo", mcp.WithString("message")), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(fmt.Sprint(request.Params.Arguments["message"])), nil
+	})
+	s.server.AddPrompt(mcp.NewPrompt("audit_prompt"), func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		return &mcp.GetPromptResult{Description: "audit prompt"}, nil
+	})
+
+	// the tools under test do not need the yak grpc client
+	origin := NewLocalClient
+	RegisterNewLocalClient(func(locals ...bool) (YakClientInterface, error) { return nil, nil })
+	defer RegisterNewLocalClient(origin)
+
+	port := utils.GetRandomAvailableTCPPort()
+	go func() {
+		if err := s.ServeStreamableHTTP(fmt.Sprintf("127.0.0.1:%d", port), "/mcp"); err != nil && err != http.ErrServerClosed {
+			t.Logf("serve streamable http failed: %v", err)
+		}
+	}()
+	defer s.Close()
+	require.NoError(t, utils.WaitConnect(fmt.Sprintf("127.0.0.1:%d", port), 5))
+	url := fmt.Sprintf("http://127.0.0.1:%d/mcp", port)
+
+	// unauthorized
+	rsp, _ := postStreamableMessage(t, url, "", "", `{"jsonrpc":"2.0","id":1,"method":"ping"}`)
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	rsp, _ = postStreamableMessage(t, url, "wrong-token", "", `{"jsonrpc":"2.0","id":1,"method":"ping"}`)
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+
+	// the codec token can only see and call the codec tools
+	codecSession := initializeStreamableSession(t, url, "codec-token")
+	_, result := postStreamableMessage(t, url, "codec-token", codecSession, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`)
+	var names []string
+	for _, tool := range result["result"].(map[string]any)["tools"].([]any) {
+		names = append(names, tool.(map[string]any)["name"].(string))
+	}
+	require.ElementsMatch(t, []string{"render_fuzztag", "codec_method_details", "exec_codec"}, names)
+	_, result = postStreamableMessage(t, url, "codec-token", codecSession, `{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"audit_echo","arguments":{"message":"denied"}}}`)
+	require.Contains(t, fmt.Sprint(result["error"]), "not allowed")
+
+	// the allowlist applies to resources and prompts too, "codec" is also a resource set
+	_, result = postStreamableMessage(t, url, "codec-token", codecSession, `{"jsonrpc":"2.0","id":4,"method":"resources/templates/list"}`)
+	require.Len(t, result["result"].(map[string]any)["resourceTemplates"], 1)
+	_, result = postStreamableMessage(t, url, "codec-token", codecSession, `{"jsonrpc":"2.0","id":5,"method":"prompts/list"}`)
+	require.Len(t, result["result"].(map[string]any)["prompts"], 0)
+	_, result = postStreamableMessage(t, url, "codec-token", codecSession, `{"jsonrpc":"2.0","id":6,"method":"prompts/get","params":{"name":"audit_prompt"}}`)
+	require.Contains(t, fmt.Sprint(result["error"]), "not allowed")
+
+	echoSession := initializeStreamableSession(t, url, "echo-token")
+	_, result = postStreamableMessage(t, url, "echo-token", echoSession, `{"jsonrpc":"2.0","id":2,"method":"resources/templates/list"}`)
+	require.Len(t, result["result"].(map[string]any)["resourceTemplates"], 0)
+	_, result = postStreamableMessage(t, url, "echo-token", echoSession, `{"jsonrpc":"2.0","id":3,"method":"resources/read","params":{"uri":"file://doc/codec_methods/Base64Encode"}}`)
+	require.Contains(t, fmt.Sprint(result["error"]), "not allowed")
+
+	// the admin token can call all tools, but not the sessions of others
+	message := utils.RandStringBytes(16)
+	adminSession := initializeStreamableSession(t, url, "admin-token")
+	_, result = postStreamableMessage(t, url, "admin-token", adminSession, fmt.Sprintf(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"audit_echo","arguments":{"message":%q}}}`, message))
+	require.Contains(t, fmt.Sprint(result["result"]), message)
+	rsp, _ = postStreamableMessage(t, url, "codec-token", adminSession, `{"jsonrpc":"2.0","id":3,"method":"ping"}`)
+	require.Equal(t, http.StatusNotFound, rsp.StatusCode)
+	_, result = postStreamableMessage(t, url, "admin-token", adminSession, `{"jsonrpc":"2.0","id":4,"method":"prompts/get","params":{"name":"audit_prompt"}}`)
+	require.Contains(t, fmt.Sprint(result["result"]), "audit prompt")
+
+	// audit log
+	db := consts.GetGormProjectDatabase()
+	defer func() {
+		yakit.DeleteMCPToolAuditLog(db, &yakit.MCPToolAuditLogFilter{SessionID: adminSession})
+		yakit.DeleteMCPToolAuditLog(db, &yakit.MCPToolAuditLogFilter{SessionID: codecSession})
+	}()
+	_, logs, err := yakit.QueryMCPToolAuditLog(db, &yakit.MCPToolAuditLogFilter{SessionID: adminSession}, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, "admin", logs[0].TokenName)
+	require.Equal(t, "audit_echo", logs[0].ToolName)
+	require.Equal(t, TransportStreamableHTTP, logs[0].Transport)
+	require.True(t, logs[0].Success)
+	require.Contains(t, logs[0].Arguments, message)
+
+	_, logs, err = yakit.QueryMCPToolAuditLog(db, &yakit.MCPToolAuditLogFilter{SessionID: codecSession}, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, "codec", logs[0].TokenName)
+	require.True(t, logs[0].Denied)
+	require.False(t, logs[0].Success)
+}
+
+func TestMCPServer_AuthTokenOption(t *testing.T) {
+	opt, err := parseAuthToken("ci:secret:codec, audit_echo")
+	require.NoError(t, err)
+	cfg := NewMCPServerConfig()
+	require.NoError(t, opt(cfg))
+	require.Len(t, cfg.authTokens, 1)
+	token := cfg.authTokens[0]
+	require.Equal(t, "ci", token.Name)
+	require.True(t, token.allow("exec_codec"))
+	require.True(t, token.allow("codec method details, include codec name, description and params, should be used with exec_codec"))
+	require.True(t, token.allow("audit_echo"))
+	require.False(t, token.allow("port_scan"))
+
+	_, err = parseAuthToken("secret")
+	require.Error(t, err)
+	require.Error(t, WithAuthToken("ci", "other")(cfg))
+
+	// auth is not supported by the other transports
+	s, err := NewMCPServer(WithEnableCodecToolSet(), WithAuthToken("ci", "secret"))
+	require.NoError(t, err)
+	require.Error(t, s.ServeSSE("127.0.0.1:0", "http://127.0.0.1"))
+
+	// without tokens the streamable http transport only listens on loopback addresses
+	s, err = NewMCPServer(WithEnableCodecToolSet())
+	require.NoError(t, err)
+	require.Error(t, s.ServeStreamableHTTP("0.0.0.0:0", "/mcp"))
+	require.Error(t, s.ServeStreamableHTTP(":0", "/mcp"))
+	require.True(t, isLoopbackAddr("127.0.0.1:11432"))
+	require.True(t, isLoopbackAddr("[::1]:11432"))
+	require.True(t, isLoopbackAddr("localhost:11432"))
+	require.False(t, isLoopbackAddr("192.168.1.2:11432"))
+}
@@ -19,6 +19,8 @@ type MCPServerConfig struct {
 	enableResources  map[string]*ResourceWithHandler
 	disableResources map[string]*ResourceWithHandler
 	dynamicScript    []string
+	authTokens       []*AuthToken
+	disableAuditLog  bool
 }
 
 func NewMCPServerConfig() *MCPServerConfig {
@@ -31,6 +33,9 @@ func NewMCPServerConfig() *MCPServerConfig {
 }
 
 func (cfg *MCPServerConfig) ApplyConfig(s *MCPServer) {
+	s.authTokens = cfg.authTokens
+	s.disableAuditLog = cfg.disableAuditLog
+
 	tools := cfg.enableTools
 	if len(tools) == 0 {
 		tools = globalTools
@@ -0,0 +1,1046 @@
+package mq
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	amqpFrameMethod    = 1
+	amqpFrameHeader    = 2
+	amqpFrameBody      = 3
+	amqpFrameHeartbeat = 8
+	amqpFrameEnd       = 206
+
+	localBrokerFrameMax  = 131072
+	localBrokerHeartbeat = 10
+)
+
+// LocalBroker 是一个进程内的 AMQP 0-9-1 broker，实现了 mq 包与 amqp091-go 客户端用到的
+// 交换机（direct/topic/fanout）、队列、绑定、消费、发布与 publisher confirm，
+// 用于在没有 RabbitMQ 的环境下本地运行多个分布式节点
+//
+// 未 ack 的消息会在通道关闭、nack/reject 或 recover 时重新入队，不支持持久化与 mandatory 退回
+type LocalBroker struct {
+	listener net.Listener
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	lock      sync.Mutex
+	exchanges map[string]*lbExchange
+	queues    map[string]*lbQueue
+	conns     map[*lbConn]struct{}
+	seq       int
+}
+
+type lbExchange struct {
+	name     string
+	kind     string
+	bindings []*lbBinding
+}
+
+type lbBinding struct {
+	queue string
+	key   string
+}
+
+type lbQueue struct {
+	name        string
+	owner       *lbConn
+	autoDelete  bool
+	hadConsumer bool
+	messages    []*lbMessage
+	consumers   []*lbConsumer
+	next        int
+}
+
+type lbMessage struct {
+	exchange    string
+	key         string
+	header      []byte
+	body        []byte
+	size        uint64
+	redelivered bool
+}
+
+type lbConsumer struct {
+	tag     string
+	queue   string
+	noAck   bool
+	channel *lbChannel
+}
+
+// lbUnacked 是已经投递但还没有 ack 的消息
+type lbUnacked struct {
+	queue string
+	msg   *lbMessage
+}
+
+type lbConn struct {
+	broker   *LocalBroker
+	conn     net.Conn
+	frameMax int
+
+	writeLock sync.Mutex
+	channels  map[uint16]*lbChannel
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+type lbChannel struct {
+	id          uint16
+	conn        *lbConn
+	closing     bool
+	confirm     bool
+	publishSeq  uint64
+	deliveryTag uint64
+	consumers   map[string]*lbConsumer
+	unacked     map[uint64]*lbUnacked
+	pending     *lbMessage
+}
+
+// NewLocalBroker 在 addr 上启动进程内 broker，addr 为空时监听随机的本地端口，ctx 结束或调用 Close 时停止
+func NewLocalBroker(ctx context.Context, addr string) (*LocalBroker, error) {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, utils.Errorf("listen local broker on %v failed: %s", addr, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	b := &LocalBroker{
+		listener:  listener,
+		ctx:       ctx,
+		cancel:    cancel,
+		exchanges: make(map[string]*lbExchange),
+		queues:    make(map[string]*lbQueue),
+		conns:     make(map[*lbConn]struct{}),
+	}
+	for name, kind := range map[string]string{
+		"": "direct", "amq.direct": "direct", "amq.topic": "topic", "amq.fanout": "fanout",
+	} {
+		b.exchanges[name] = &lbExchange{name: name, kind: kind}
+	}
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+		b.lock.Lock()
+		conns := make([]*lbConn, 0, len(b.conns))
+		for c := range b.conns {
+			conns = append(conns, c)
+		}
+		b.lock.Unlock()
+		for _, c := range conns {
+			c.close()
+		}
+	}()
+	go b.serve()
+	return b, nil
+}
+
+// Addr 返回 broker 监听的地址
+func (b *LocalBroker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+// AMQPUrl 返回连接 broker 的 amqp 地址，broker 不校验用户名、密码与 vhost
+func (b *LocalBroker) AMQPUrl() string {
+	return fmt.Sprintf("amqp://guest:guest@%v/", b.Addr())
+}
+
+func (b *LocalBroker) Close() {
+	b.cancel()
+}
+
+func (b *LocalBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			select {
+			case <-b.ctx.Done():
+			default:
+				log.Errorf("local broker accept failed: %s", err)
+			}
+			return
+		}
+		c := &lbConn{
+			broker:   b,
+			conn:     conn,
+			frameMax: localBrokerFrameMax,
+			channels: make(map[uint16]*lbChannel),
+			closed:   make(chan struct{}),
+		}
+		b.lock.Lock()
+		b.conns[c] = struct{}{}
+		b.lock.Unlock()
+		go c.serve()
+	}
+}
+
+func (c *lbConn) serve() {
+	defer c.close()
+
+	protocol := make([]byte, 8)
+	if _, err := io.ReadFull(c.conn, protocol); err != nil {
+		return
+	}
+	if !bytes.HasPrefix(protocol, []byte("AMQP")) {
+		_, _ = c.conn.Write([]byte("AMQP\x00\x00\x09\x01"))
+		return
+	}
+	start := new(amqpBuffer)
+	start.octet(0).octet(9).emptyTable().longstr("PLAIN AMQPLAIN").longstr("en_US")
+	if err := c.writeMethod(0, 10, 10, start); err != nil {
+		return
+	}
+	go c.heartbeat()
+
+	header := make([]byte, 7)
+	for {
+		if _, err := io.ReadFull(c.conn, header); err != nil {
+			return
+		}
+		typ := header[0]
+		channel := binary.BigEndian.Uint16(header[1:3])
+		size := binary.BigEndian.Uint32(header[3:7])
+		payload := make([]byte, int(size)+1)
+		if _, err := io.ReadFull(c.conn, payload); err != nil {
+			return
+		}
+		if payload[size] != amqpFrameEnd {
+			log.Errorf("local broker: bad frame end from %v", c.conn.RemoteAddr())
+			return
+		}
+		payload = payload[:size]
+
+		var err error
+		switch typ {
+		case amqpFrameMethod:
+			err = c.handleMethod(channel, payload)
+		case amqpFrameHeader:
+			c.handleHeader(channel, payload)
+		case amqpFrameBody:
+			c.handleBody(channel, payload)
+		case amqpFrameHeartbeat:
+		}
+		if err != nil {
+			if err != io.EOF {
+				log.Debugf("local broker connection %v closed: %v", c.conn.RemoteAddr(), err)
+			}
+			return
+		}
+	}
+}
+
+func (c *lbConn) heartbeat() {
+	ticker := time.NewTicker(localBrokerHeartbeat * time.Second / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			if err := c.writeFrame(amqpFrameHeartbeat, 0, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *lbConn) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		_ = c.conn.Close()
+
+		b := c.broker
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		delete(b.conns, c)
+		for _, ch := range c.channels {
+			b.cancelChannelConsumers(ch)
+			b.requeueUnacked(ch)
+		}
+		for name, q := range b.queues {
+			if q.owner == c {
+				b.deleteQueue(name)
+			}
+		}
+	})
+}
+
+func (c *lbConn) write(raw []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	_, err := c.conn.Write(raw)
+	if err != nil {
+		go c.close()
+	}
+	return err
+}
+
+func appendFrame(buf *bytes.Buffer, typ byte, channel uint16, payload []byte) {
+	var header [7]byte
+	header[0] = typ
+	binary.BigEndian.PutUint16(header[1:3], channel)
+	binary.BigEndian.PutUint32(header[3:7], uint32(len(payload)))
+	buf.Write(header[:])
+	buf.Write(payload)
+	buf.WriteByte(amqpFrameEnd)
+}
+
+func (c *lbConn) writeFrame(typ byte, channel uint16, payload []byte) error {
+	var buf bytes.Buffer
+	appendFrame(&buf, typ, channel, payload)
+	return c.write(buf.Bytes())
+}
+
+func (c *lbConn) writeMethod(channel uint16, class, method uint16, args *amqpBuffer) error {
+	payload := new(amqpBuffer)
+	payload.short(class).short(method)
+	if args != nil {
+		payload.Write(args.Bytes())
+	}
+	return c.writeFrame(amqpFrameMethod, channel, payload.Bytes())
+}
+
+// writeContent 把 method、header 与 body 帧一次写入，避免与同一连接上的其他帧交错
+func (c *lbConn) writeContent(channel uint16, class, method uint16, args *amqpBuffer, msg *lbMessage) error {
+	var buf bytes.Buffer
+	payload := new(amqpBuffer)
+	payload.short(class).short(method)
+	payload.Write(args.Bytes())
+	appendFrame(&buf, amqpFrameMethod, channel, payload.Bytes())
+	appendFrame(&buf, amqpFrameHeader, channel, msg.header)
+	max := c.frameMax - 8
+	for body := msg.body; len(body) > 0; {
+		n := len(body)
+		if n > max {
+			n = max
+		}
+		appendFrame(&buf, amqpFrameBody, channel, body[:n])
+		body = body[n:]
+	}
+	return c.write(buf.Bytes())
+}
+
+func (c *lbConn) channel(id uint16) *lbChannel {
+	c.broker.lock.Lock()
+	defer c.broker.lock.Unlock()
+	return c.channels[id]
+}
+
+// channelError 以 Channel.Close 关闭通道，与 RabbitMQ 的通道级错误行为一致
+func (c *lbConn) channelError(ch *lbChannel, code uint16, text string, class, method uint16) error {
+	c.broker.lock.Lock()
+	ch.closing = true
+	c.broker.cancelChannelConsumers(ch)
+	c.broker.requeueUnacked(ch)
+	c.broker.lock.Unlock()
+	args := new(amqpBuffer)
+	args.short(code).shortstr(text).short(class).short(method)
+	return c.writeMethod(ch.id, 20, 40, args)
+}
+
+func (c *lbConn) handleMethod(channel uint16, payload []byte) error {
+	args := &amqpArgs{buf: payload}
+	class, method := args.short(), args.short()
+	if args.err != nil {
+		return args.err
+	}
+
+	if class == 10 {
+		return c.handleConnection(method, args)
+	}
+
+	if class == 20 && method == 10 {
+		c.broker.lock.Lock()
+		c.channels[channel] = &lbChannel{
+			id: channel, conn: c,
+			consumers: make(map[string]*lbConsumer),
+			unacked:   make(map[uint64]*lbUnacked),
+		}
+		c.broker.lock.Unlock()
+		return c.writeMethod(channel, 20, 11, new(amqpBuffer).longstr(""))
+	}
+
+	ch := c.channel(channel)
+	if ch == nil {
+		return utils.Errorf("channel %v is not opened", channel)
+	}
+	if ch.closing {
+		if class == 20 && method == 41 {
+			c.broker.lock.Lock()
+			delete(c.channels, channel)
+			c.broker.lock.Unlock()
+		}
+		return nil
+	}
+
+	switch class {
+	case 20:
+		return c.handleChannel(ch, method, args)
+	case 40:
+		return c.handleExchange(ch, method, args)
+	case 50:
+		return c.handleQueue(ch, method, args)
+	case 60:
+		return c.handleBasic(ch, method, args)
+	case 85:
+		nowait := args.octet()&1 != 0
+		ch.confirm = true
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(channel, 85, 11, nil)
+	default:
+		return c.channelError(ch, 540, fmt.Sprintf("NOT_IMPLEMENTED - class %v method %v", class, method), class, method)
+	}
+}
+
+func (c *lbConn) handleConnection(method uint16, args *amqpArgs) error {
+	switch method {
+	case 11: // start-ok
+		tune := new(amqpBuffer)
+		tune.short(2047).long(localBrokerFrameMax).short(localBrokerHeartbeat)
+		return c.writeMethod(0, 10, 30, tune)
+	case 31: // tune-ok
+		args.short()
+		if frameMax := int(args.long()); frameMax > 0 && frameMax < c.frameMax {
+			c.frameMax = frameMax
+		}
+		return nil
+	case 40: // open
+		return c.writeMethod(0, 10, 41, new(amqpBuffer).shortstr(""))
+	case 50: // close
+		_ = c.writeMethod(0, 10, 51, nil)
+		return io.EOF
+	case 51: // close-ok
+		return io.EOF
+	}
+	return nil
+}
+
+func (c *lbConn) handleChannel(ch *lbChannel, method uint16, args *amqpArgs) error {
+	switch method {
+	case 20: // flow
+		active := args.octet()
+		return c.writeMethod(ch.id, 20, 21, new(amqpBuffer).octet(active))
+	case 40: // close
+		c.broker.lock.Lock()
+		c.broker.cancelChannelConsumers(ch)
+		c.broker.requeueUnacked(ch)
+		delete(c.channels, ch.id)
+		c.broker.lock.Unlock()
+		return c.writeMethod(ch.id, 20, 41, nil)
+	}
+	return nil
+}
+
+func (c *lbConn) handleExchange(ch *lbChannel, method uint16, args *amqpArgs) error {
+	b := c.broker
+	args.short()
+	switch method {
+	case 10: // declare
+		name, kind := args.shortstr(), args.shortstr()
+		bits := args.octet()
+		args.table()
+		passive, nowait := bits&1 != 0, bits&(1<<4) != 0
+		b.lock.Lock()
+		_, existed := b.exchanges[name]
+		if !existed && !passive {
+			b.exchanges[name] = &lbExchange{name: name, kind: kind}
+		}
+		b.lock.Unlock()
+		if !existed && passive {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no exchange '%v'", name), 40, 10)
+		}
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 40, 11, nil)
+	case 20: // delete
+		name := args.shortstr()
+		nowait := args.octet()&2 != 0
+		b.lock.Lock()
+		delete(b.exchanges, name)
+		b.lock.Unlock()
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 40, 21, nil)
+	case 30, 40: // exchange 之间的绑定不参与路由
+		args.shortstr()
+		args.shortstr()
+		args.shortstr()
+		nowait := args.octet()&1 != 0
+		if nowait {
+			return nil
+		}
+		if method == 30 {
+			return c.writeMethod(ch.id, 40, 31, nil)
+		}
+		return c.writeMethod(ch.id, 40, 51, nil)
+	}
+	return nil
+}
+
+func (c *lbConn) handleQueue(ch *lbChannel, method uint16, args *amqpArgs) error {
+	b := c.broker
+	args.short()
+	switch method {
+	case 10: // declare
+		name := args.shortstr()
+		bits := args.octet()
+		args.table()
+		passive, exclusive, autoDelete, nowait := bits&1 != 0, bits&(1<<2) != 0, bits&(1<<3) != 0, bits&(1<<4) != 0
+
+		b.lock.Lock()
+		if name == "" {
+			b.seq++
+			name = fmt.Sprintf("amq.gen-%v-%v", time.Now().UnixNano(), b.seq)
+		}
+		q, existed := b.queues[name]
+		if existed && q.owner != nil && q.owner != c {
+			b.lock.Unlock()
+			return c.channelError(ch, 405, fmt.Sprintf("RESOURCE_LOCKED - cannot obtain exclusive access to locked queue '%v'", name), 50, 10)
+		}
+		if !existed && passive {
+			b.lock.Unlock()
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no queue '%v'", name), 50, 10)
+		}
+		if !existed {
+			q = &lbQueue{name: name, autoDelete: autoDelete}
+			if exclusive {
+				q.owner = c
+			}
+			b.queues[name] = q
+			// 所有队列都会以队列名绑定到默认交换机
+			b.exchanges[""].bindings = append(b.exchanges[""].bindings, &lbBinding{queue: name, key: name})
+		}
+		messageCount, consumerCount := len(q.messages), len(q.consumers)
+		b.lock.Unlock()
+
+		if nowait {
+			return nil
+		}
+		ok := new(amqpBuffer)
+		ok.shortstr(name).long(uint32(messageCount)).long(uint32(consumerCount))
+		return c.writeMethod(ch.id, 50, 11, ok)
+	case 20, 50: // bind / unbind
+		queue, exchange, key := args.shortstr(), args.shortstr(), args.shortstr()
+		nowait := false
+		if method == 20 {
+			nowait = args.octet()&1 != 0
+		}
+		args.table()
+
+		b.lock.Lock()
+		ex, exOk := b.exchanges[exchange]
+		_, qOk := b.queues[queue]
+		if exOk && qOk {
+			if method == 20 {
+				existed := false
+				for _, binding := range ex.bindings {
+					if binding.queue == queue && binding.key == key {
+						existed = true
+						break
+					}
+				}
+				if !existed {
+					ex.bindings = append(ex.bindings, &lbBinding{queue: queue, key: key})
+				}
+			} else {
+				ex.removeBinding(func(binding *lbBinding) bool {
+					return binding.queue == queue && binding.key == key
+				})
+			}
+		}
+		b.lock.Unlock()
+
+		if !exOk {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no exchange '%v'", exchange), 50, method)
+		}
+		if !qOk {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no queue '%v'", queue), 50, method)
+		}
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 50, method+1, nil)
+	case 30: // purge
+		name := args.shortstr()
+		nowait := args.octet()&1 != 0
+		count := 0
+		b.lock.Lock()
+		if q, ok := b.queues[name]; ok {
+			count = len(q.messages)
+			q.messages = nil
+		}
+		b.lock.Unlock()
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 50, 31, new(amqpBuffer).long(uint32(count)))
+	case 40: // delete
+		name := args.shortstr()
+		nowait := args.octet()&(1<<2) != 0
+		count := 0
+		b.lock.Lock()
+		if q, ok := b.queues[name]; ok {
+			count = len(q.messages)
+			b.deleteQueue(name)
+		}
+		b.lock.Unlock()
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 50, 41, new(amqpBuffer).long(uint32(count)))
+	}
+	return nil
+}
+
+func (c *lbConn) handleBasic(ch *lbChannel, method uint16, args *amqpArgs) error {
+	b := c.broker
+	switch method {
+	case 10: // qos
+		return c.writeMethod(ch.id, 60, 11, nil)
+	case 20: // consume
+		args.short()
+		queue, tag := args.shortstr(), args.shortstr()
+		bits := args.octet()
+		noAck, nowait := bits&(1<<1) != 0, bits&(1<<3) != 0
+		args.table()
+
+		b.lock.Lock()
+		q, ok := b.queues[queue]
+		if ok {
+			if tag == "" {
+				b.seq++
+				tag = fmt.Sprintf("amq.ctag-%v", b.seq)
+			}
+			consumer := &lbConsumer{tag: tag, queue: queue, noAck: noAck, channel: ch}
+			ch.consumers[tag] = consumer
+			q.consumers = append(q.consumers, consumer)
+			q.hadConsumer = true
+		}
+		b.lock.Unlock()
+		if !ok {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no queue '%v'", queue), 60, 20)
+		}
+		if !nowait {
+			if err := c.writeMethod(ch.id, 60, 21, new(amqpBuffer).shortstr(tag)); err != nil {
+				return err
+			}
+		}
+		b.lock.Lock()
+		b.dispatch(q)
+		b.lock.Unlock()
+		return nil
+	case 30: // cancel
+		tag := args.shortstr()
+		nowait := args.octet()&1 != 0
+		b.lock.Lock()
+		if consumer, ok := ch.consumers[tag]; ok {
+			b.cancelConsumer(consumer)
+		}
+		b.lock.Unlock()
+		if nowait {
+			return nil
+		}
+		return c.writeMethod(ch.id, 60, 31, new(amqpBuffer).shortstr(tag))
+	case 40: // publish
+		args.short()
+		exchange, key := args.shortstr(), args.shortstr()
+		args.octet()
+		b.lock.Lock()
+		_, ok := b.exchanges[exchange]
+		b.lock.Unlock()
+		if !ok {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no exchange '%v'", exchange), 60, 40)
+		}
+		ch.pending = &lbMessage{exchange: exchange, key: key}
+		return nil
+	case 70: // get
+		args.short()
+		queue := args.shortstr()
+		noAck := args.octet()&1 != 0
+		b.lock.Lock()
+		q, ok := b.queues[queue]
+		var msg *lbMessage
+		remain := 0
+		if ok && len(q.messages) > 0 {
+			msg = q.messages[0]
+			q.messages = q.messages[1:]
+			remain = len(q.messages)
+			ch.deliveryTag++
+			if !noAck {
+				ch.unacked[ch.deliveryTag] = &lbUnacked{queue: q.name, msg: msg}
+			}
+		}
+		tag := ch.deliveryTag
+		b.lock.Unlock()
+		if !ok {
+			return c.channelError(ch, 404, fmt.Sprintf("NOT_FOUND - no queue '%v'", queue), 60, 70)
+		}
+		if msg == nil {
+			return c.writeMethod(ch.id, 60, 72, new(amqpBuffer).shortstr(""))
+		}
+		getOk := new(amqpBuffer)
+		getOk.longlong(tag).octet(redeliveredFlag(msg)).shortstr(msg.exchange).shortstr(msg.key).long(uint32(remain))
+		return c.writeContent(ch.id, 60, 71, getOk, msg)
+	case 80: // ack
+		tag := args.longlong()
+		multiple := args.octet()&1 != 0
+		b.lock.Lock()
+		ch.takeUnacked(tag, multiple)
+		b.lock.Unlock()
+		return nil
+	case 90: // reject
+		tag := args.longlong()
+		requeue := args.octet()&1 != 0
+		b.lock.Lock()
+		taken := ch.takeUnacked(tag, false)
+		if requeue {
+			b.requeue(taken)
+		}
+		b.lock.Unlock()
+		return nil
+	case 120: // nack
+		tag := args.longlong()
+		bits := args.octet()
+		b.lock.Lock()
+		taken := ch.takeUnacked(tag, bits&1 != 0)
+		if bits&(1<<1) != 0 {
+			b.requeue(taken)
+		}
+		b.lock.Unlock()
+		return nil
+	case 100, 110: // recover-async / recover，总是重新入队
+		b.lock.Lock()
+		b.requeueUnacked(ch)
+		b.lock.Unlock()
+		if method == 100 {
+			return nil
+		}
+		return c.writeMethod(ch.id, 60, 111, nil)
+	}
+	return nil
+}
+
+func (c *lbConn) handleHeader(channel uint16, payload []byte) {
+	ch := c.channel(channel)
+	if ch == nil || ch.pending == nil || len(payload) < 12 {
+		return
+	}
+	ch.pending.header = payload
+	ch.pending.size = binary.BigEndian.Uint64(payload[4:12])
+	if ch.pending.size == 0 {
+		c.publish(ch)
+	}
+}
+
+func (c *lbConn) handleBody(channel uint16, payload []byte) {
+	ch := c.channel(channel)
+	if ch == nil || ch.pending == nil || ch.pending.header == nil {
+		return
+	}
+	ch.pending.body = append(ch.pending.body, payload...)
+	if uint64(len(ch.pending.body)) >= ch.pending.size {
+		c.publish(ch)
+	}
+}
+
+func (c *lbConn) publish(ch *lbChannel) {
+	msg := ch.pending
+	ch.pending = nil
+	c.broker.route(msg)
+	if ch.confirm {
+		ch.publishSeq++
+		_ = c.writeMethod(ch.id, 60, 80, new(amqpBuffer).longlong(ch.publishSeq).octet(0))
+	}
+}
+
+func (b *LocalBroker) route(msg *lbMessage) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ex, ok := b.exchanges[msg.exchange]
+	if !ok {
+		return
+	}
+	routed := make(map[string]bool)
+	for _, binding := range ex.bindings {
+		if routed[binding.queue] {
+			continue
+		}
+		var match bool
+		switch ex.kind {
+		case "fanout":
+			match = true
+		case "topic":
+			match = matchTopic(strings.Split(binding.key, "."), strings.Split(msg.key, "."))
+		default:
+			match = binding.key == msg.key
+		}
+		if !match {
+			continue
+		}
+		q, ok := b.queues[binding.queue]
+		if !ok {
+			continue
+		}
+		routed[binding.queue] = true
+		q.messages = append(q.messages, msg)
+		b.dispatch(q)
+	}
+}
+
+// dispatch 将队列中的消息轮流投递给消费者，调用时需要持有 b.lock
+func (b *LocalBroker) dispatch(q *lbQueue) {
+	for len(q.messages) > 0 && len(q.consumers) > 0 {
+		msg := q.messages[0]
+		q.messages = q.messages[1:]
+		consumer := q.consumers[q.next%len(q.consumers)]
+		q.next++
+
+		ch := consumer.channel
+		ch.deliveryTag++
+		if !consumer.noAck {
+			ch.unacked[ch.deliveryTag] = &lbUnacked{queue: q.name, msg: msg}
+		}
+		deliver := new(amqpBuffer)
+		deliver.shortstr(consumer.tag).longlong(ch.deliveryTag).octet(redeliveredFlag(msg)).shortstr(msg.exchange).shortstr(msg.key)
+		if err := ch.conn.writeContent(ch.id, 60, 60, deliver, msg); err != nil {
+			log.Debugf("local broker deliver to %v failed: %v", consumer.tag, err)
+		}
+	}
+}
+
+// 以下函数调用时需要持有 b.lock
+func (b *LocalBroker) cancelConsumer(consumer *lbConsumer) {
+	delete(consumer.channel.consumers, consumer.tag)
+	q, ok := b.queues[consumer.queue]
+	if !ok {
+		return
+	}
+	for i, c := range q.consumers {
+		if c == consumer {
+			q.consumers = append(q.consumers[:i], q.consumers[i+1:]...)
+			break
+		}
+	}
+	if q.autoDelete && q.hadConsumer && len(q.consumers) == 0 {
+		b.deleteQueue(q.name)
+	}
+}
+
+func (b *LocalBroker) cancelChannelConsumers(ch *lbChannel) {
+	for _, consumer := range ch.consumers {
+		b.cancelConsumer(consumer)
+	}
+}
+
+// takeUnacked 取出 tag 对应的未 ack 消息，multiple 时取出所有不大于 tag 的消息，tag 为 0 表示全部
+func (ch *lbChannel) takeUnacked(tag uint64, multiple bool) []*lbUnacked {
+	if !multiple {
+		item, ok := ch.unacked[tag]
+		if !ok {
+			return nil
+		}
+		delete(ch.unacked, tag)
+		return []*lbUnacked{item}
+	}
+	var taken []*lbUnacked
+	for _, t := range sortedTags(ch.unacked) {
+		if tag == 0 || t <= tag {
+			taken = append(taken, ch.unacked[t])
+			delete(ch.unacked, t)
+		}
+	}
+	return taken
+}
+
+// requeueUnacked 把通道上所有未 ack 的消息放回原队列
+func (b *LocalBroker) requeueUnacked(ch *lbChannel) {
+	b.requeue(ch.takeUnacked(0, true))
+}
+
+// requeue 把消息标记为重投后放回原队列的头部，队列已经删除的消息被丢弃
+func (b *LocalBroker) requeue(items []*lbUnacked) {
+	touched := make(map[*lbQueue]bool)
+	for i := len(items) - 1; i >= 0; i-- {
+		q, ok := b.queues[items[i].queue]
+		if !ok {
+			continue
+		}
+		msg := *items[i].msg
+		msg.redelivered = true
+		q.messages = append([]*lbMessage{&msg}, q.messages...)
+		touched[q] = true
+	}
+	for q := range touched {
+		b.dispatch(q)
+	}
+}
+
+func (b *LocalBroker) deleteQueue(name string) {
+	q, ok := b.queues[name]
+	if !ok {
+		return
+	}
+	delete(b.queues, name)
+	for _, consumer := range q.consumers {
+		delete(consumer.channel.consumers, consumer.tag)
+	}
+	for _, ex := range b.exchanges {
+		ex.removeBinding(func(binding *lbBinding) bool {
+			return binding.queue == name
+		})
+	}
+}
+
+func (e *lbExchange) removeBinding(match func(binding *lbBinding) bool) {
+	bindings := e.bindings[:0]
+	for _, binding := range e.bindings {
+		if !match(binding) {
+			bindings = append(bindings, binding)
+		}
+	}
+	e.bindings = bindings
+}
+
+func sortedTags(unacked map[uint64]*lbUnacked) []uint64 {
+	tags := make([]uint64, 0, len(unacked))
+	for tag := range unacked {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	return tags
+}
+
+func redeliveredFlag(msg *lbMessage) byte {
+	if msg.redelivered {
+		return 1
+	}
+	return 0
+}
+
+// matchTopic 按 AMQP topic 规则匹配：* 匹配一个单词，# 匹配零个或多个单词
+func matchTopic(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchTopic(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && matchTopic(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchTopic(pattern[1:], words[1:])
+	}
+}
+
+type amqpBuffer struct {
+	bytes.Buffer
+}
+
+func (b *amqpBuffer) octet(v byte) *amqpBuffer {
+	b.WriteByte(v)
+	return b
+}
+
+func (b *amqpBuffer) short(v uint16) *amqpBuffer {
+	_ = binary.Write(&b.Buffer, binary.BigEndian, v)
+	return b
+}
+
+func (b *amqpBuffer) long(v uint32) *amqpBuffer {
+	_ = binary.Write(&b.Buffer, binary.BigEndian, v)
+	return b
+}
+
+func (b *amqpBuffer) longlong(v uint64) *amqpBuffer {
+	_ = binary.Write(&b.Buffer, binary.BigEndian, v)
+	return b
+}
+
+func (b *amqpBuffer) shortstr(s string) *amqpBuffer {
+	if len(s) > 255 {
+		s = s[:255]
+	}
+	b.WriteByte(byte(len(s)))
+	b.WriteString(s)
+	return b
+}
+
+func (b *amqpBuffer) longstr(s string) *amqpBuffer {
+	b.long(uint32(len(s)))
+	b.WriteString(s)
+	return b
+}
+
+func (b *amqpBuffer) emptyTable() *amqpBuffer {
+	return b.long(0)
+}
+
+// amqpArgs 按顺序读取方法帧参数，读取越界后后续读取均返回零值
+type amqpArgs struct {
+	buf []byte
+	err error
+}
+
+func (a *amqpArgs) take(n int) []byte {
+	if a.err != nil || n > len(a.buf) {
+		a.err = io.ErrUnexpectedEOF
+		return make([]byte, n)
+	}
+	ret := a.buf[:n]
+	a.buf = a.buf[n:]
+	return ret
+}
+
+func (a *amqpArgs) octet() byte {
+	return a.take(1)[0]
+}
+
+func (a *amqpArgs) short() uint16 {
+	return binary.BigEndian.Uint16(a.take(2))
+}
+
+func (a *amqpArgs) long() uint32 {
+	return binary.BigEndian.Uint32(a.take(4))
+}
+
+func (a *amqpArgs) longlong() uint64 {
+	return binary.BigEndian.Uint64(a.take(8))
+}
+
+func (a *amqpArgs) shortstr() string {
+	return string(a.take(int(a.octet())))
+}
+
+func (a *amqpArgs) longstr() string {
+	return string(a.take(int(a.long())))
+}
+
+// table 跳过参数表，本地 broker 不使用队列与交换机参数
+func (a *amqpArgs) table() {
+	a.take(int(a.long()))
+}
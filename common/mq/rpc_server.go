@@ -103,6 +103,9 @@ func rpcServerConfig(
 						log.Errorf("repeated request: %v", spew.Sdump(msg))
 						return
 					}
+				} else if msg.Type == RPC_MessageType_Cancel {
+					// 请求已经结束，客户端随后发送的取消消息不能当作新的请求再执行一次
+					return
 				} else {
 					ctx, cancel := context.WithCancel(b.ctx)
 					actualCancel := func() {
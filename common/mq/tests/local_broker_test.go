@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/mq"
+)
+
+func TestLocalBroker_RPC(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	broker, err := mq.NewLocalBroker(ctx, "")
+	require.NoError(t, err)
+	defer broker.Close()
+
+	exchangeName := "rpc-test"
+	declare := mq.WithExchangeDeclare(&mq.ExchangeDeclaringParam{Name: exchangeName, Kind: "direct"})
+
+	var servers []*mq.RPCServer
+	for i := 0; i < 2; i++ {
+		nodeId := fmt.Sprintf("testNode%d", i)
+		server, err := mq.NewRPCServer(ctx, exchangeName, nodeId, mq.WithAMQPUrl(broker.AMQPUrl()), declare)
+		require.NoError(t, err)
+		server.RegisterService("testFunc", func(broker *mq.Broker, ctx context.Context, f, node string, delivery *amqp.Delivery) (message interface{}, e error) {
+			var req InspectNodeRequest
+			if err := json.Unmarshal(delivery.Body, &req); err != nil {
+				return nil, err
+			}
+			return &InspectNodeRequest{NodeId: node + ":" + req.NodeId}, nil
+		})
+		require.NoError(t, server.RunBackground())
+		servers = append(servers, server)
+	}
+
+	client, err := mq.NewRPCClient(ctx, exchangeName, mq.WithAMQPUrl(broker.AMQPUrl()), declare)
+	require.NoError(t, err)
+	require.NoError(t, client.Connect())
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 20; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			node := fmt.Sprintf("testNode%d", i%2)
+			buf, err := client.Call(ctx, "testFunc", node, &InspectNodeRequest{NodeId: fmt.Sprint(i)})
+			if !assert.NoError(t, err) {
+				return
+			}
+			var rsp InspectNodeRequest
+			assert.NoError(t, json.Unmarshal(buf, &rsp))
+			assert.Equal(t, fmt.Sprintf("%v:%v", node, i), rsp.NodeId)
+		}()
+	}
+	wg.Wait()
+
+	// 没有节点消费的请求在发送超时后返回错误
+	client.SetRequestSentTimeout(time.Second)
+	_, err = client.Call(ctx, "testFunc", "missingNode", &InspectNodeRequest{})
+	assert.Error(t, err)
+}
+
+func TestLocalBroker_TopicPublish(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	broker, err := mq.NewLocalBroker(ctx, "")
+	require.NoError(t, err)
+	defer broker.Close()
+
+	received := make(chan string, 10)
+	consumer, err := mq.NewBroker(ctx,
+		mq.WithAMQPUrl(broker.AMQPUrl()),
+		mq.WithExchangeDeclare(&mq.ExchangeDeclaringParam{Name: "topic-test", Kind: "topic"}),
+		mq.WithQueueDeclare(&mq.QueueDeclaringParam{Name: "topic-test-queue", AutoDelete: true, Exclusive: true}),
+		mq.WithQueueBind(&mq.QueueBindingParam{Name: "topic-test-queue", Key: "server.backend.#", Exchange: "topic-test"}),
+		mq.WithConsumingParam(&mq.ConsumingParam{
+			Queue: "topic-test-queue",
+			Handler: func(b *mq.Broker, conn *amqp.Connection, channel *amqp.Channel, msg amqp.Delivery) {
+				received <- msg.RoutingKey + ":" + string(msg.Body)
+			},
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, consumer.RunBackground())
+
+	publisher, err := mq.NewBroker(ctx, mq.WithAMQPUrl(broker.AMQPUrl()))
+	require.NoError(t, err)
+	pub := publisher.GetPublisher()
+	large := make([]byte, 300*1024)
+	for i := range large {
+		large[i] = 'a'
+	}
+	require.NoError(t, pub.PublishTo("topic-test", "server.backend.scanner", amqp.Publishing{Body: []byte("hello")}))
+	require.NoError(t, pub.PublishTo("topic-test", "heartbeat.node", amqp.Publishing{Body: []byte("ignored")}))
+	require.NoError(t, pub.PublishTo("topic-test", "server.backend.http-flow", amqp.Publishing{Body: large}))
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "server.backend.scanner:hello", msg)
+	case <-ctx.Done():
+		t.Fatal("message not received")
+	}
+	select {
+	case msg := <-received:
+		assert.Equal(t, "server.backend.http-flow:"+string(large), msg)
+	case <-ctx.Done():
+		t.Fatal("large message not received")
+	}
+}
+
+func TestLocalBroker_RequeueUnacked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	broker, err := mq.NewLocalBroker(ctx, "")
+	require.NoError(t, err)
+	defer broker.Close()
+
+	conn, err := amqp.Dial(broker.AMQPUrl())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	channel, err := conn.Channel()
+	require.NoError(t, err)
+	_, err = channel.QueueDeclare("requeue-test", false, false, false, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, channel.PublishWithContext(ctx, "", "requeue-test", false, false, amqp.Publishing{Body: []byte("task")}))
+
+	receive := func(deliveries <-chan amqp.Delivery) amqp.Delivery {
+		select {
+		case msg := <-deliveries:
+			return msg
+		case <-ctx.Done():
+			t.Fatal("message not received")
+		}
+		return amqp.Delivery{}
+	}
+
+	// 通道关闭时没有 ack 的消息重新入队
+	deliveries, err := channel.Consume("requeue-test", "", false, false, false, false, nil)
+	require.NoError(t, err)
+	msg := receive(deliveries)
+	assert.False(t, msg.Redelivered)
+	require.NoError(t, channel.Close())
+
+	channel, err = conn.Channel()
+	require.NoError(t, err)
+	deliveries, err = channel.Consume("requeue-test", "", false, false, false, false, nil)
+	require.NoError(t, err)
+	msg = receive(deliveries)
+	assert.Equal(t, "task", string(msg.Body))
+	assert.True(t, msg.Redelivered)
+
+	// nack 时重新入队，ack 之后不再投递
+	require.NoError(t, msg.Nack(false, true))
+	msg = receive(deliveries)
+	assert.True(t, msg.Redelivered)
+	require.NoError(t, msg.Ack(false))
+	require.NoError(t, channel.Close())
+
+	channel, err = conn.Channel()
+	require.NoError(t, err)
+	defer channel.Close()
+	queue, err := channel.QueueDeclarePassive("requeue-test", false, false, false, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, queue.Messages)
+}
@@ -15,6 +15,7 @@ import (
 	_ "time/tzdata"
 
 	"github.com/yaklang/yaklang/common/jsonpath"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/utils/bizhelper"
 	"github.com/yaklang/yaklang/common/utils/filesys"
 
@@ -367,6 +368,47 @@ func init() {
 		ArgumentDescription: "{{string(abc:字符串)}}",
 	})
 
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "protobuf:encode",
+		Handler: func(s string) []string {
+			msg, err := protobufx.ParseText(s)
+			if err != nil {
+				log.Errorf("protobuf encode failed: %s", err)
+				return []string{s}
+			}
+			return []string{
+				string(msg.Encode()),
+			}
+		},
+		Alias:               []string{"protobuf:enc", "protobuf"},
+		Description:         "Protobuf 编码，把标签内的 protobuf 文本（如 1: \"abc\"）编码为 protobuf 消息",
+		TagNameVerbose:      "Protobuf 编码",
+		ArgumentDescription: "{{string(1: \"abc\":protobuf 文本)}}",
+	})
+
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "grpc:encode",
+		Handler: func(s string) []string {
+			body, err := protobufx.ParseBodyText(protobufx.BodyGRPC, "", s)
+			if err != nil {
+				log.Errorf("grpc encode failed: %s", err)
+				return []string{s}
+			}
+			res, err := body.Encode()
+			if err != nil {
+				log.Errorf("grpc encode failed: %s", err)
+				return []string{s}
+			}
+			return []string{
+				string(res),
+			}
+		},
+		Alias:               []string{"grpc:enc", "grpc"},
+		Description:         "gRPC 编码，把标签内的 gRPC 文本（如 message { 1: \"abc\" }）编码为带长度前缀的 gRPC / grpc-web 消息帧",
+		TagNameVerbose:      "gRPC 编码",
+		ArgumentDescription: "{{string(message { 1: \"abc\" }:gRPC 文本)}}",
+	})
+
 	datetimeFuzzFuncGenerator := func(defaultFormat string) func(s string) []string {
 		return func(s string) []string {
 			if s == "" {
@@ -0,0 +1,785 @@
+package mutate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// 这里只实现 GraphQL 可执行文档（操作与片段）的解析，保留参数字面量在原文中的位置，
+// 模糊测试时直接替换原文中的对应片段，不重新序列化整个文档
+
+type graphQLTokenKind int
+
+const (
+	gqlTokenEOF graphQLTokenKind = iota
+	gqlTokenPunct
+	gqlTokenName
+	gqlTokenInt
+	gqlTokenFloat
+	gqlTokenString
+)
+
+type graphQLToken struct {
+	kind       graphQLTokenKind
+	value      string
+	start, end int
+}
+
+func (t *graphQLToken) is(kind graphQLTokenKind, value string) bool {
+	return t.kind == kind && t.value == value
+}
+
+func lexGraphQL(src string) ([]*graphQLToken, error) {
+	var tokens []*graphQLToken
+	pos := 0
+	if strings.HasPrefix(src, "\ufeff") {
+		pos = len("\ufeff")
+	}
+	for {
+		// 空白、逗号与注释在 GraphQL 中都是可忽略的
+		for pos < len(src) {
+			c := src[pos]
+			if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+				pos++
+				continue
+			}
+			if c == '#' {
+				for pos < len(src) && src[pos] != '\n' && src[pos] != '\r' {
+					pos++
+				}
+				continue
+			}
+			break
+		}
+		if pos >= len(src) {
+			tokens = append(tokens, &graphQLToken{kind: gqlTokenEOF, start: pos, end: pos})
+			return tokens, nil
+		}
+
+		start := pos
+		c := src[pos]
+		switch {
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			pos++
+			tokens = append(tokens, &graphQLToken{kind: gqlTokenPunct, value: string(c), start: start, end: pos})
+		case c == '.':
+			if !strings.HasPrefix(src[pos:], "...") {
+				return nil, utils.Errorf("graphql syntax error: unexpected '.' at %d", pos)
+			}
+			pos += 3
+			tokens = append(tokens, &graphQLToken{kind: gqlTokenPunct, value: "...", start: start, end: pos})
+		case c == '_' || isGraphQLLetter(c):
+			for pos < len(src) && (src[pos] == '_' || isGraphQLLetter(src[pos]) || isGraphQLDigit(src[pos])) {
+				pos++
+			}
+			tokens = append(tokens, &graphQLToken{kind: gqlTokenName, value: src[start:pos], start: start, end: pos})
+		case c == '-' || isGraphQLDigit(c):
+			end, isFloat, err := lexGraphQLNumber(src, pos)
+			if err != nil {
+				return nil, err
+			}
+			pos = end
+			kind := gqlTokenInt
+			if isFloat {
+				kind = gqlTokenFloat
+			}
+			tokens = append(tokens, &graphQLToken{kind: kind, value: src[start:pos], start: start, end: pos})
+		case c == '"':
+			var value string
+			var err error
+			if strings.HasPrefix(src[pos:], `"""`) {
+				value, pos, err = lexGraphQLBlockString(src, pos)
+			} else {
+				value, pos, err = lexGraphQLString(src, pos)
+			}
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, &graphQLToken{kind: gqlTokenString, value: value, start: start, end: pos})
+		default:
+			r, _ := utf8.DecodeRuneInString(src[pos:])
+			return nil, utils.Errorf("graphql syntax error: unexpected character %q at %d", r, pos)
+		}
+	}
+}
+
+func isGraphQLLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isGraphQLDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func lexGraphQLNumber(src string, pos int) (int, bool, error) {
+	start := pos
+	isFloat := false
+	if src[pos] == '-' {
+		pos++
+	}
+	digits := func() int {
+		begin := pos
+		for pos < len(src) && isGraphQLDigit(src[pos]) {
+			pos++
+		}
+		return pos - begin
+	}
+	if pos < len(src) && src[pos] == '0' {
+		pos++
+		if pos < len(src) && isGraphQLDigit(src[pos]) {
+			return 0, false, utils.Errorf("graphql syntax error: invalid number %q", src[start:pos+1])
+		}
+	} else if digits() == 0 {
+		return 0, false, utils.Errorf("graphql syntax error: invalid number at %d", start)
+	}
+	if pos < len(src) && src[pos] == '.' {
+		isFloat = true
+		pos++
+		if digits() == 0 {
+			return 0, false, utils.Errorf("graphql syntax error: invalid number %q", src[start:pos])
+		}
+	}
+	if pos < len(src) && (src[pos] == 'e' || src[pos] == 'E') {
+		isFloat = true
+		pos++
+		if pos < len(src) && (src[pos] == '+' || src[pos] == '-') {
+			pos++
+		}
+		if digits() == 0 {
+			return 0, false, utils.Errorf("graphql syntax error: invalid number %q", src[start:pos])
+		}
+	}
+	// 数字后面不能直接跟名字，例如 123abc
+	if pos < len(src) && (src[pos] == '_' || src[pos] == '.' || isGraphQLLetter(src[pos])) {
+		return 0, false, utils.Errorf("graphql syntax error: invalid number %q", src[start:pos+1])
+	}
+	return pos, isFloat, nil
+}
+
+func lexGraphQLString(src string, pos int) (string, int, error) {
+	start := pos
+	pos++
+	var buf strings.Builder
+	for pos < len(src) {
+		c := src[pos]
+		switch c {
+		case '"':
+			return buf.String(), pos + 1, nil
+		case '\n', '\r':
+			return "", 0, utils.Errorf("graphql syntax error: unterminated string at %d", start)
+		case '\\':
+			if pos+1 >= len(src) {
+				return "", 0, utils.Errorf("graphql syntax error: unterminated string at %d", start)
+			}
+			esc := src[pos+1]
+			switch esc {
+			case '"', '\\', '/':
+				buf.WriteByte(esc)
+			case 'b':
+				buf.WriteByte('\b')
+			case 'f':
+				buf.WriteByte('\f')
+			case 'n':
+				buf.WriteByte('\n')
+			case 'r':
+				buf.WriteByte('\r')
+			case 't':
+				buf.WriteByte('\t')
+			case 'u':
+				if pos+6 > len(src) {
+					return "", 0, utils.Errorf("graphql syntax error: invalid unicode escape at %d", pos)
+				}
+				code, err := strconv.ParseUint(src[pos+2:pos+6], 16, 32)
+				if err != nil {
+					return "", 0, utils.Errorf("graphql syntax error: invalid unicode escape at %d", pos)
+				}
+				buf.WriteRune(rune(code))
+				pos += 4
+			default:
+				return "", 0, utils.Errorf("graphql syntax error: invalid escape \\%c at %d", esc, pos)
+			}
+			pos += 2
+		default:
+			buf.WriteByte(c)
+			pos++
+		}
+	}
+	return "", 0, utils.Errorf("graphql syntax error: unterminated string at %d", start)
+}
+
+func lexGraphQLBlockString(src string, pos int) (string, int, error) {
+	start := pos
+	pos += 3
+	var buf strings.Builder
+	for pos < len(src) {
+		if strings.HasPrefix(src[pos:], `\"""`) {
+			buf.WriteString(`"""`)
+			pos += 4
+			continue
+		}
+		if strings.HasPrefix(src[pos:], `"""`) {
+			return strings.TrimSpace(buf.String()), pos + 3, nil
+		}
+		buf.WriteByte(src[pos])
+		pos++
+	}
+	return "", 0, utils.Errorf("graphql syntax error: unterminated block string at %d", start)
+}
+
+type graphQLValueKind int
+
+const (
+	gqlValueVariable graphQLValueKind = iota
+	gqlValueInt
+	gqlValueFloat
+	gqlValueString
+	gqlValueBoolean
+	gqlValueNull
+	gqlValueEnum
+	gqlValueList
+	gqlValueObject
+)
+
+type graphQLValue struct {
+	kind graphQLValueKind
+	// 标量对应的 Go 值，变量为变量名
+	value      any
+	start, end int
+	list       []*graphQLValue
+	fields     []*graphQLArgument
+}
+
+type graphQLArgument struct {
+	name  string
+	value *graphQLValue
+}
+
+type graphQLVariableDefinition struct {
+	name         string
+	typ          string
+	defaultValue *graphQLValue
+}
+
+type graphQLSelection struct {
+	alias, name string
+	arguments   []*graphQLArgument
+	// 片段展开（...Name）时为片段名
+	spread string
+	// 内联片段（... on Type）
+	inline        bool
+	typeCondition string
+	selections    []*graphQLSelection
+}
+
+type graphQLOperation struct {
+	operation  string
+	name       string
+	variables  []*graphQLVariableDefinition
+	selections []*graphQLSelection
+}
+
+type graphQLFragment struct {
+	name          string
+	typeCondition string
+	selections    []*graphQLSelection
+}
+
+type graphQLDocument struct {
+	source     string
+	operations []*graphQLOperation
+	fragments  []*graphQLFragment
+}
+
+type graphQLParser struct {
+	src    string
+	tokens []*graphQLToken
+	pos    int
+}
+
+// parseGraphQLDocument 解析 GraphQL 可执行文档，至少需要包含一个操作
+func parseGraphQLDocument(src string) (*graphQLDocument, error) {
+	tokens, err := lexGraphQL(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &graphQLParser{src: src, tokens: tokens}
+	doc := &graphQLDocument{source: src}
+	for p.peek().kind != gqlTokenEOF {
+		tok := p.peek()
+		switch {
+		case tok.is(gqlTokenPunct, "{"):
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &graphQLOperation{operation: "query", selections: selections})
+		case tok.is(gqlTokenName, "query"), tok.is(gqlTokenName, "mutation"), tok.is(gqlTokenName, "subscription"):
+			op, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case tok.is(gqlTokenName, "fragment"):
+			fragment, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			doc.fragments = append(doc.fragments, fragment)
+		default:
+			return nil, p.unexpected(tok)
+		}
+	}
+	if len(doc.operations) <= 0 {
+		return nil, utils.Error("graphql document has no operation")
+	}
+	return doc, nil
+}
+
+func (p *graphQLParser) peek() *graphQLToken {
+	return p.tokens[p.pos]
+}
+
+func (p *graphQLParser) advance() *graphQLToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != gqlTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *graphQLParser) unexpected(tok *graphQLToken) error {
+	if tok.kind == gqlTokenEOF {
+		return utils.Error("graphql syntax error: unexpected EOF")
+	}
+	return utils.Errorf("graphql syntax error: unexpected %q at %d", p.src[tok.start:tok.end], tok.start)
+}
+
+func (p *graphQLParser) expectPunct(value string) error {
+	tok := p.advance()
+	if !tok.is(gqlTokenPunct, value) {
+		return p.unexpected(tok)
+	}
+	return nil
+}
+
+func (p *graphQLParser) expectName() (string, error) {
+	tok := p.advance()
+	if tok.kind != gqlTokenName {
+		return "", p.unexpected(tok)
+	}
+	return tok.value, nil
+}
+
+func (p *graphQLParser) parseOperation() (*graphQLOperation, error) {
+	op := &graphQLOperation{operation: p.advance().value}
+	if p.peek().kind == gqlTokenName {
+		op.name = p.advance().value
+	}
+	if p.peek().is(gqlTokenPunct, "(") {
+		p.advance()
+		for !p.peek().is(gqlTokenPunct, ")") {
+			def, err := p.parseVariableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.variables = append(op.variables, def)
+		}
+		p.advance()
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+	return op, nil
+}
+
+func (p *graphQLParser) parseVariableDefinition() (*graphQLVariableDefinition, error) {
+	if err := p.expectPunct("$"); err != nil {
+		return nil, err
+	}
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPunct(":"); err != nil {
+		return nil, err
+	}
+	typ, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	def := &graphQLVariableDefinition{name: name, typ: typ}
+	if p.peek().is(gqlTokenPunct, "=") {
+		p.advance()
+		def.defaultValue, err = p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return def, p.parseDirectives()
+}
+
+func (p *graphQLParser) parseType() (string, error) {
+	start := p.peek().start
+	if p.peek().is(gqlTokenPunct, "[") {
+		p.advance()
+		if _, err := p.parseType(); err != nil {
+			return "", err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return "", err
+		}
+	} else if _, err := p.expectName(); err != nil {
+		return "", err
+	}
+	if p.peek().is(gqlTokenPunct, "!") {
+		p.advance()
+	}
+	return strings.Join(strings.Fields(p.src[start:p.tokens[p.pos-1].end]), ""), nil
+}
+
+func (p *graphQLParser) parseFragment() (*graphQLFragment, error) {
+	p.advance()
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if !p.advance().is(gqlTokenName, "on") {
+		return nil, utils.Errorf("graphql syntax error: fragment %v missing type condition", name)
+	}
+	typeCondition, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLFragment{name: name, typeCondition: typeCondition, selections: selections}, nil
+}
+
+func (p *graphQLParser) parseSelectionSet() ([]*graphQLSelection, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var selections []*graphQLSelection
+	for !p.peek().is(gqlTokenPunct, "}") {
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	p.advance()
+	return selections, nil
+}
+
+func (p *graphQLParser) parseSelection() (*graphQLSelection, error) {
+	if p.peek().is(gqlTokenPunct, "...") {
+		p.advance()
+		tok := p.peek()
+		if tok.kind == gqlTokenName && tok.value != "on" {
+			p.advance()
+			return &graphQLSelection{spread: tok.value}, p.parseDirectives()
+		}
+		selection := &graphQLSelection{inline: true}
+		if tok.is(gqlTokenName, "on") {
+			p.advance()
+			typeCondition, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			selection.typeCondition = typeCondition
+		}
+		if err := p.parseDirectives(); err != nil {
+			return nil, err
+		}
+		selections, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		selection.selections = selections
+		return selection, nil
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	selection := &graphQLSelection{name: name}
+	if p.peek().is(gqlTokenPunct, ":") {
+		p.advance()
+		selection.alias = name
+		if selection.name, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	}
+	if selection.arguments, err = p.parseArguments(); err != nil {
+		return nil, err
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	if p.peek().is(gqlTokenPunct, "{") {
+		if selection.selections, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return selection, nil
+}
+
+func (p *graphQLParser) parseArguments() ([]*graphQLArgument, error) {
+	if !p.peek().is(gqlTokenPunct, "(") {
+		return nil, nil
+	}
+	p.advance()
+	var args []*graphQLArgument
+	for !p.peek().is(gqlTokenPunct, ")") {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	p.advance()
+	return args, nil
+}
+
+func (p *graphQLParser) parseArgument() (*graphQLArgument, error) {
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPunct(":"); err != nil {
+		return nil, err
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLArgument{name: name, value: value}, nil
+}
+
+// parseDirectives 解析并丢弃指令，指令中的参数不作为模糊测试对象
+func (p *graphQLParser) parseDirectives() error {
+	for p.peek().is(gqlTokenPunct, "@") {
+		p.advance()
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if _, err := p.parseArguments(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *graphQLParser) parseValue() (*graphQLValue, error) {
+	tok := p.advance()
+	value := &graphQLValue{start: tok.start, end: tok.end}
+	switch tok.kind {
+	case gqlTokenPunct:
+		switch tok.value {
+		case "$":
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			value.kind = gqlValueVariable
+			value.value = name
+		case "[":
+			value.kind = gqlValueList
+			for !p.peek().is(gqlTokenPunct, "]") {
+				item, err := p.parseValue()
+				if err != nil {
+					return nil, err
+				}
+				value.list = append(value.list, item)
+			}
+			p.advance()
+		case "{":
+			value.kind = gqlValueObject
+			for !p.peek().is(gqlTokenPunct, "}") {
+				field, err := p.parseArgument()
+				if err != nil {
+					return nil, err
+				}
+				value.fields = append(value.fields, field)
+			}
+			p.advance()
+		default:
+			return nil, p.unexpected(tok)
+		}
+		value.end = p.tokens[p.pos-1].end
+	case gqlTokenInt:
+		value.kind = gqlValueInt
+		if i, err := strconv.ParseInt(tok.value, 10, 64); err == nil {
+			value.value = i
+		} else {
+			value.value = tok.value
+		}
+	case gqlTokenFloat:
+		value.kind = gqlValueFloat
+		if f, err := strconv.ParseFloat(tok.value, 64); err == nil {
+			value.value = f
+		} else {
+			value.value = tok.value
+		}
+	case gqlTokenString:
+		value.kind = gqlValueString
+		value.value = tok.value
+	case gqlTokenName:
+		switch tok.value {
+		case "true", "false":
+			value.kind = gqlValueBoolean
+			value.value = tok.value == "true"
+		case "null":
+			value.kind = gqlValueNull
+		default:
+			value.kind = gqlValueEnum
+			value.value = tok.value
+		}
+	default:
+		return nil, p.unexpected(tok)
+	}
+	return value, nil
+}
+
+// graphQLArgumentLeaf 是文档中一个可以被替换的参数字面量
+type graphQLArgumentLeaf struct {
+	// 字段路径，例如 GetUser.user(id)、query.createUser(input.name)
+	path string
+	// 参数名，输入对象以 . 连接，列表以 [n] 表示，例如 input.tags[0]
+	name  string
+	value *graphQLValue
+}
+
+func (d *graphQLDocument) argumentLeaves() []*graphQLArgumentLeaf {
+	var leaves []*graphQLArgumentLeaf
+	counter := make(map[string]int)
+	var collectValue func(fieldPath, name string, value *graphQLValue)
+	collectValue = func(fieldPath, name string, value *graphQLValue) {
+		switch value.kind {
+		case gqlValueVariable:
+			// 变量引用由 GraphQL 变量参数负责
+			return
+		case gqlValueList:
+			for i, item := range value.list {
+				collectValue(fieldPath, fmt.Sprintf("%s[%d]", name, i), item)
+			}
+		case gqlValueObject:
+			for _, field := range value.fields {
+				collectValue(fieldPath, name+"."+field.name, field.value)
+			}
+		default:
+			path := fmt.Sprintf("%s(%s)", fieldPath, name)
+			counter[path]++
+			if n := counter[path]; n > 1 {
+				path = fmt.Sprintf("%s#%d", path, n)
+			}
+			leaves = append(leaves, &graphQLArgumentLeaf{path: path, name: name, value: value})
+		}
+	}
+	var walkSelections func(prefix string, selections []*graphQLSelection)
+	walkSelections = func(prefix string, selections []*graphQLSelection) {
+		for _, selection := range selections {
+			if selection.spread != "" {
+				continue
+			}
+			if selection.inline {
+				walkSelections(prefix, selection.selections)
+				continue
+			}
+			fieldPath := prefix + "." + selection.name
+			if selection.alias != "" {
+				fieldPath = prefix + "." + selection.alias
+			}
+			for _, arg := range selection.arguments {
+				collectValue(fieldPath, arg.name, arg.value)
+			}
+			walkSelections(fieldPath, selection.selections)
+		}
+	}
+	for _, op := range d.operations {
+		name := op.name
+		if name == "" {
+			name = op.operation
+		}
+		walkSelections(name, op.selections)
+	}
+	for _, fragment := range d.fragments {
+		walkSelections(fragment.name, fragment.selections)
+	}
+	return leaves
+}
+
+// variableDefinitions 返回文档中所有操作定义的变量，同名变量只保留第一个
+func (d *graphQLDocument) variableDefinitions() []*graphQLVariableDefinition {
+	var defs []*graphQLVariableDefinition
+	seen := make(map[string]struct{})
+	for _, op := range d.operations {
+		for _, def := range op.variables {
+			if _, ok := seen[def.name]; ok {
+				continue
+			}
+			seen[def.name] = struct{}{}
+			defs = append(defs, def)
+		}
+	}
+	return defs
+}
+
+// isGraphQLScalarLiteral 判断 i 是否本身就是一个合法的标量字面量（数字、布尔、null 或枚举）
+func isGraphQLScalarLiteral(i string) bool {
+	tokens, err := lexGraphQL(i)
+	if err != nil || len(tokens) != 2 {
+		return false
+	}
+	switch tokens[0].kind {
+	case gqlTokenInt, gqlTokenFloat, gqlTokenName:
+		return tokens[0].start == 0 && tokens[0].end == len(i)
+	}
+	return false
+}
+
+func quoteGraphQLString(i string) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for _, r := range i {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
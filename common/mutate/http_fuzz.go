@@ -18,6 +18,7 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/tidwall/gjson"
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/http_struct"
@@ -47,6 +48,7 @@ type FuzzHTTPRequest struct {
 	mode                   int
 	mutex                  sync.Mutex
 	fromPlugin             string
+	protobufSchema         *protobufx.Schema
 }
 
 func (f *FuzzHTTPRequest) NoAutoEncode() bool {
@@ -128,6 +130,15 @@ type FuzzHTTPRequestIf interface {
 	// 测试 PostXML 中的数据
 	FuzzPostXMLParams(k, v interface{}) FuzzHTTPRequestIf
 
+	// 测试 GraphQL 文档中字段参数的字面量
+	FuzzGraphQLArgument(k, v any) FuzzHTTPRequestIf
+
+	// 测试 GraphQL variables 中的变量
+	FuzzGraphQLVariable(k, v any) FuzzHTTPRequestIf
+
+	// 测试 gRPC / protobuf 消息中的字段
+	FuzzProtobufField(k, v any) FuzzHTTPRequestIf
+
 	// 测试 Cookie 中的数据
 	FuzzCookieRaw(value interface{}) FuzzHTTPRequestIf
 
@@ -244,6 +255,7 @@ type buildFuzzHTTPRequestConfig struct {
 	Proxy           string
 	Ctx             context.Context
 	FromPlugin      string
+	ProtobufSchema  *protobufx.Schema
 }
 
 type BuildFuzzHTTPRequestOption func(config *buildFuzzHTTPRequestConfig)
@@ -302,6 +314,13 @@ func OptContext(ctx context.Context) BuildFuzzHTTPRequestOption {
 	}
 }
 
+// OptProtobufSchema 设置解码 gRPC / protobuf 请求体时使用的 Schema，用于得到字段名与正确的字段类型
+func OptProtobufSchema(s *protobufx.Schema) BuildFuzzHTTPRequestOption {
+	return func(config *buildFuzzHTTPRequestConfig) {
+		config.ProtobufSchema = s
+	}
+}
+
 func UrlsToHTTPRequests(target ...interface{}) (*FuzzHTTPRequestBatch, error) {
 	var reqs []*http.Request
 	for _, urlBase := range InterfaceToFuzzResults(target) {
@@ -475,6 +494,7 @@ func NewFuzzHTTPRequest(i interface{}, opts ...BuildFuzzHTTPRequestOption) (*Fuz
 		opts:            opts,
 		mode:            packetFuzz,
 		fromPlugin:      config.FromPlugin,
+		protobufSchema:  config.ProtobufSchema,
 	}
 
 	return req, nil
@@ -509,6 +529,10 @@ func (f *FuzzHTTPRequest) GetCurrentOptions() []BuildFuzzHTTPRequestOption {
 		result = append(result, OptQueryParams(f.queryParams))
 	}
 
+	if f.protobufSchema != nil {
+		result = append(result, OptProtobufSchema(f.protobufSchema))
+	}
+
 	return result
 }
 
@@ -614,7 +638,15 @@ func (f *FuzzHTTPRequest) GetGetQueryParams() []*FuzzHTTPRequestParam {
 }
 
 func (f *FuzzHTTPRequest) GetPostCommonParams() []*FuzzHTTPRequestParam {
-	postParams := f.GetPostJsonParams()
+	// protobuf 请求体按字段展开
+	postParams := f.GetProtobufParams()
+	// GraphQL 请求体按文档中的参数与变量展开，而不是作为一整个 JSON 字符串
+	if len(postParams) <= 0 {
+		postParams = f.GetGraphQLParams()
+	}
+	if len(postParams) <= 0 {
+		postParams = f.GetPostJsonParams()
+	}
 	if len(postParams) <= 0 {
 		postParams = f.GetPostXMLParams()
 	}
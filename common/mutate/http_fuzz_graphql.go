@@ -0,0 +1,321 @@
+package mutate
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
+	"github.com/yaklang/yaklang/common/yak/cartesian"
+)
+
+// graphQLBody 是一个 GraphQL 请求体，支持 application/graphql 原始文档与 {"query": ...} 两种形式
+type graphQLBody struct {
+	raw      []byte
+	envelope bool
+	query    string
+	document *graphQLDocument
+}
+
+func parseGraphQLBody(contentType string, body []byte) (*graphQLBody, bool) {
+	body = bytes.TrimSpace(body)
+	if len(body) <= 0 {
+		return nil, false
+	}
+
+	gql := &graphQLBody{raw: body}
+	if body[0] == '{' && gjson.ValidBytes(body) {
+		query := gjson.GetBytes(body, "query")
+		if query.Type != gjson.String || !isGraphQLEnvelope(body) {
+			return nil, false
+		}
+		gql.envelope = true
+		gql.query = query.String()
+	} else if strings.Contains(strings.ToLower(contentType), "graphql") {
+		gql.query = string(body)
+	} else {
+		return nil, false
+	}
+
+	doc, err := parseGraphQLDocument(gql.query)
+	if err != nil {
+		return nil, false
+	}
+	gql.document = doc
+	return gql, true
+}
+
+// isGraphQLEnvelope 判断 JSON 请求体是否为 GraphQL over HTTP 的请求：带有 operationName 或 variables，
+// 或者除 query 外只有 extensions，避免把 {"query": "...", "page": 1} 这类搜索接口当作 GraphQL
+func isGraphQLEnvelope(body []byte) bool {
+	if gjson.GetBytes(body, "operationName").Exists() || gjson.GetBytes(body, "variables").Exists() {
+		return true
+	}
+	onlyQuery := true
+	gjson.ParseBytes(body).ForEach(func(key, _ gjson.Result) bool {
+		if k := key.String(); k != "query" && k != "extensions" {
+			onlyQuery = false
+		}
+		return onlyQuery
+	})
+	return onlyQuery
+}
+
+// withQuery 使用新的 GraphQL 文档重新生成请求体
+func (g *graphQLBody) withQuery(query string) ([]byte, error) {
+	if !g.envelope {
+		return []byte(query), nil
+	}
+	return sjson.SetBytes(g.raw, "query", query)
+}
+
+// withVariable 修改 variables 中 gpath 对应的值，原值为字符串或新值不是合法 JSON 时按字符串写入
+func (g *graphQLBody) withVariable(gpath string, value string) ([]byte, error) {
+	if !g.envelope {
+		return nil, utils.Error("graphql variables require a json request body")
+	}
+	raw := g.raw
+	// variables 缺失或为 null 时先补一个空对象
+	if !gjson.GetBytes(raw, "variables").IsObject() {
+		var err error
+		raw, err = sjson.SetRawBytes(raw, "variables", []byte("{}"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	path := "variables." + gpath
+	origin := gjson.GetBytes(raw, path)
+	if origin.Type == gjson.String || !gjson.Valid(value) {
+		return sjson.SetBytes(raw, path, value)
+	}
+	return sjson.SetRawBytes(raw, path, []byte(value))
+}
+
+var graphQLJsonPathIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// graphQLVariablePath 将变量名或 JSONPath（$.input.name、$.ids[0]）转换为 variables 下的 gjson 路径
+func graphQLVariablePath(i string) string {
+	i = strings.TrimPrefix(strings.TrimPrefix(i, "$"), ".")
+	i = graphQLJsonPathIndex.ReplaceAllString(i, ".$1")
+	return strings.TrimPrefix(i, ".")
+}
+
+// graphQLLiteral 生成替换参数字面量的文本：原值为字符串，或新值不是合法的标量字面量时，按 GraphQL 字符串编码
+func (f *FuzzHTTPRequest) graphQLLiteral(origin *graphQLValue, value string) string {
+	if f.NoAutoEncode() {
+		return value
+	}
+	if origin.kind != gqlValueString && isGraphQLScalarLiteral(value) {
+		return value
+	}
+	return quoteGraphQLString(value)
+}
+
+func (f *FuzzHTTPRequest) getGraphQLBody() (*graphQLBody, *http.Request, error) {
+	req, err := f.GetOriginHTTPRequest()
+	if err != nil {
+		return nil, nil, err
+	}
+	gql, ok := parseGraphQLBody(req.Header.Get("Content-Type"), httpRequestReadBody(req))
+	if !ok {
+		return nil, nil, utils.Error("body is not a graphql request")
+	}
+	return gql, req, nil
+}
+
+// IsBodyGraphQL 判断请求体是否为 GraphQL 请求
+func (f *FuzzHTTPRequest) IsBodyGraphQL() bool {
+	_, _, err := f.getGraphQLBody()
+	return err == nil
+}
+
+// GetGraphQLParams 获取 GraphQL 请求中的参数，包括文档中字段参数的字面量（嵌套的输入对象与列表会展开）以及 variables 中的变量
+// 文档中定义了但 variables 中没有给出的变量也会被列出，值为其默认值
+func (f *FuzzHTTPRequest) GetGraphQLParams() []*FuzzHTTPRequestParam {
+	gql, _, err := f.getGraphQLBody()
+	if err != nil {
+		return nil
+	}
+
+	var fuzzParams []*FuzzHTTPRequestParam
+	for _, leaf := range gql.document.argumentLeaves() {
+		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosPostGraphQLArgument,
+			param:      leaf.name,
+			paramValue: leaf.value.value,
+			raw:        gql.query,
+			path:       leaf.path,
+			origin:     f,
+		})
+	}
+	if !gql.envelope {
+		return fuzzParams
+	}
+
+	variables := gjson.GetBytes(gql.raw, "variables")
+	seen := make(map[string]struct{})
+	if variables.IsObject() {
+		call := func(key, val gjson.Result, gPath, jPath string) {
+			var paramValue interface{}
+			if val.IsObject() || val.IsArray() {
+				paramValue = val.String()
+			} else {
+				paramValue = val.Value()
+			}
+			fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+				position:   lowhttp.PosPostGraphQLVariable,
+				param:      key.String(),
+				paramValue: paramValue,
+				raw:        variables.Raw,
+				path:       jPath,
+				gpath:      gPath,
+				origin:     f,
+			})
+		}
+		walk(variables, "", "$", call)
+		variables.ForEach(func(key, _ gjson.Result) bool {
+			seen[key.String()] = struct{}{}
+			return true
+		})
+	}
+	for _, def := range gql.document.variableDefinitions() {
+		if _, ok := seen[def.name]; ok {
+			continue
+		}
+		var paramValue interface{}
+		if def.defaultValue != nil {
+			paramValue = def.defaultValue.value
+		}
+		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosPostGraphQLVariable,
+			param:      def.name,
+			paramValue: paramValue,
+			raw:        variables.Raw,
+			path:       "$." + def.name,
+			gpath:      def.name,
+			origin:     f,
+		})
+	}
+	return fuzzParams
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLArgument(k, v any) ([]*http.Request, error) {
+	gql, req, err := f.getGraphQLBody()
+	if err != nil {
+		return nil, err
+	}
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if keys == nil || values == nil {
+		return nil, utils.Error("keys or values is empty...")
+	}
+
+	leaves := gql.document.argumentLeaves()
+	origin := httpctx.GetBareRequestBytes(req)
+	var reqs []*http.Request
+	err = cartesian.ProductEx([][]string{keys, values}, func(result []string) error {
+		key, value := result[0], result[1]
+		for _, leaf := range leaves {
+			// 既可以使用 GetGraphQLParams 给出的字段路径，也可以直接使用参数名
+			if leaf.path != key && leaf.name != key {
+				continue
+			}
+			query := gql.query[:leaf.value.start] + f.graphQLLiteral(leaf.value, value) + gql.query[leaf.value.end:]
+			body, err := gql.withQuery(query)
+			if err != nil {
+				return err
+			}
+			reqIns, err := lowhttp.ParseBytesToHttpRequest(lowhttp.ReplaceHTTPPacketBodyFast(origin, body))
+			if err != nil {
+				continue
+			}
+			reqs = append(reqs, reqIns)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLVariable(k, v any) ([]*http.Request, error) {
+	gql, req, err := f.getGraphQLBody()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, key := range InterfaceToFuzzResults(k) {
+		keys = append(keys, graphQLVariablePath(key))
+	}
+	values := InterfaceToFuzzResults(v)
+	if keys == nil || values == nil {
+		return nil, utils.Error("keys or values is empty...")
+	}
+
+	origin := httpctx.GetBareRequestBytes(req)
+	var reqs []*http.Request
+	err = cartesian.ProductEx([][]string{keys, values}, func(result []string) error {
+		body, err := gql.withVariable(result[0], result[1])
+		if err != nil {
+			return err
+		}
+		reqIns, err := lowhttp.ParseBytesToHttpRequest(lowhttp.ReplaceHTTPPacketBodyFast(origin, body))
+		if err != nil {
+			return nil
+		}
+		reqs = append(reqs, reqIns)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+// FuzzGraphQLArgument 模糊测试 GraphQL 文档中字段参数的字面量，k 可以是 GetGraphQLParams 给出的字段路径（如 query.user(id)）或参数名
+func (f *FuzzHTTPRequest) FuzzGraphQLArgument(k, v any) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGraphQLArgument(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+// FuzzGraphQLVariable 模糊测试 GraphQL 请求 variables 中的变量，k 可以是变量名或 variables 下的 JSONPath（如 $.input.name）
+func (f *FuzzHTTPRequest) FuzzGraphQLVariable(k, v any) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGraphQLVariable(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzGraphQLArgument(k, v any) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzGraphQLArgument(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzGraphQLArgument(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzGraphQLVariable(k, v any) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzGraphQLVariable(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzGraphQLVariable(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
@@ -0,0 +1,402 @@
+package mutate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// GraphQLIntrospectionQuery 是标准的 GraphQL 内省查询
+const GraphQLIntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  fields(includeDeprecated: true) {
+    name
+    args { ...InputValue }
+    type { ...TypeRef }
+  }
+  inputFields { ...InputValue }
+  enumValues(includeDeprecated: true) { name }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+          }
+        }
+      }
+    }
+  }
+}`
+
+const (
+	// 生成选择集时展开对象字段的最大深度
+	graphQLSelectionMaxDepth = 3
+	// 生成输入对象样例值时的最大深度
+	graphQLInputMaxDepth = 3
+)
+
+type graphQLTypeRef struct {
+	Kind   string          `json:"kind"`
+	Name   string          `json:"name"`
+	OfType *graphQLTypeRef `json:"ofType"`
+}
+
+type graphQLInputValue struct {
+	Name         string          `json:"name"`
+	Type         *graphQLTypeRef `json:"type"`
+	DefaultValue *string         `json:"defaultValue"`
+}
+
+type graphQLField struct {
+	Name string               `json:"name"`
+	Args []*graphQLInputValue `json:"args"`
+	Type *graphQLTypeRef      `json:"type"`
+}
+
+type graphQLFullType struct {
+	Kind        string               `json:"kind"`
+	Name        string               `json:"name"`
+	Fields      []*graphQLField      `json:"fields"`
+	InputFields []*graphQLInputValue `json:"inputFields"`
+	EnumValues  []struct {
+		Name string `json:"name"`
+	} `json:"enumValues"`
+}
+
+type graphQLSchema struct {
+	QueryType *struct {
+		Name string `json:"name"`
+	} `json:"queryType"`
+	MutationType *struct {
+		Name string `json:"name"`
+	} `json:"mutationType"`
+	Types []*graphQLFullType `json:"types"`
+
+	types map[string]*graphQLFullType
+}
+
+// GraphQLOperation 是根据内省结果为一个根字段生成的操作，字段参数全部以变量的形式传递
+type GraphQLOperation struct {
+	OperationType string
+	OperationName string
+	Field         string
+	Query         string
+	Variables     map[string]any
+}
+
+// JSON 返回 {"query": ..., "variables": ..., "operationName": ...} 形式的请求体
+func (o *GraphQLOperation) JSON() []byte {
+	variables := o.Variables
+	if variables == nil {
+		variables = make(map[string]any)
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(struct {
+		Query         string         `json:"query"`
+		Variables     map[string]any `json:"variables"`
+		OperationName string         `json:"operationName"`
+	}{o.Query, variables, o.OperationName})
+	return bytes.TrimSpace(buf.Bytes())
+}
+
+func parseGraphQLSchema(i any) (*graphQLSchema, error) {
+	raw := bytes.TrimSpace(utils.InterfaceToBytes(i))
+	// 允许直接传入完整的 HTTP 响应
+	if bytes.HasPrefix(raw, []byte("HTTP/")) {
+		raw = bytes.TrimSpace(lowhttp.GetHTTPPacketBody(raw))
+	}
+	if !gjson.ValidBytes(raw) {
+		return nil, utils.Error("graphql introspection result is not valid json")
+	}
+	result := gjson.GetBytes(raw, "data.__schema")
+	if !result.Exists() {
+		result = gjson.GetBytes(raw, "__schema")
+	}
+	if !result.IsObject() {
+		return nil, utils.Error("graphql introspection result has no __schema")
+	}
+
+	schema := &graphQLSchema{}
+	if err := json.Unmarshal([]byte(result.Raw), schema); err != nil {
+		return nil, utils.Wrap(err, "unmarshal graphql schema failed")
+	}
+	schema.types = make(map[string]*graphQLFullType, len(schema.Types))
+	for _, t := range schema.Types {
+		if t != nil {
+			schema.types[t.Name] = t
+		}
+	}
+	return schema, nil
+}
+
+func graphQLNamedType(t *graphQLTypeRef) *graphQLTypeRef {
+	for t != nil && t.OfType != nil && (t.Kind == "NON_NULL" || t.Kind == "LIST") {
+		t = t.OfType
+	}
+	return t
+}
+
+func graphQLTypeString(t *graphQLTypeRef) string {
+	if t == nil {
+		return ""
+	}
+	switch t.Kind {
+	case "NON_NULL":
+		return graphQLTypeString(t.OfType) + "!"
+	case "LIST":
+		return "[" + graphQLTypeString(t.OfType) + "]"
+	default:
+		return t.Name
+	}
+}
+
+// sampleValue 根据类型生成一个样例值，作为变量的初始值
+func (s *graphQLSchema) sampleValue(t *graphQLTypeRef, depth int) any {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind {
+	case "NON_NULL":
+		return s.sampleValue(t.OfType, depth)
+	case "LIST":
+		item := s.sampleValue(t.OfType, depth)
+		if item == nil {
+			return []any{}
+		}
+		return []any{item}
+	case "ENUM":
+		if full, ok := s.types[t.Name]; ok && len(full.EnumValues) > 0 {
+			return full.EnumValues[0].Name
+		}
+		return nil
+	case "INPUT_OBJECT":
+		full, ok := s.types[t.Name]
+		if !ok || depth >= graphQLInputMaxDepth {
+			return nil
+		}
+		object := make(map[string]any)
+		for _, field := range full.InputFields {
+			// 可选字段在超过一层后不再填充，避免循环引用的输入类型无限展开
+			if depth > 0 && field.Type != nil && field.Type.Kind != "NON_NULL" {
+				continue
+			}
+			if value := s.sampleValue(field.Type, depth+1); value != nil {
+				object[field.Name] = value
+			}
+		}
+		return object
+	default:
+		switch t.Name {
+		case "Int":
+			return 1
+		case "Float":
+			return 1.0
+		case "Boolean":
+			return true
+		case "ID":
+			return "1"
+		default:
+			return "yak"
+		}
+	}
+}
+
+// selectionSet 为返回类型生成选择集，标量与枚举字段全部选择，对象字段在深度范围内递归展开
+func (s *graphQLSchema) selectionSet(t *graphQLTypeRef, depth int) string {
+	named := graphQLNamedType(t)
+	if named == nil {
+		return ""
+	}
+	full, ok := s.types[named.Name]
+	if !ok {
+		return ""
+	}
+	switch full.Kind {
+	case "OBJECT", "INTERFACE":
+	case "UNION":
+		return "{ __typename }"
+	default:
+		return ""
+	}
+
+	var fields []string
+	for _, field := range full.Fields {
+		if hasRequiredGraphQLArgs(field.Args) {
+			continue
+		}
+		fieldType := graphQLNamedType(field.Type)
+		if fieldType == nil {
+			continue
+		}
+		switch fieldType.Kind {
+		case "SCALAR", "ENUM":
+			fields = append(fields, field.Name)
+		default:
+			if depth+1 >= graphQLSelectionMaxDepth {
+				continue
+			}
+			if sub := s.selectionSet(field.Type, depth+1); sub != "" {
+				fields = append(fields, field.Name+" "+sub)
+			}
+		}
+	}
+	if len(fields) <= 0 {
+		fields = append(fields, "__typename")
+	}
+	return "{ " + strings.Join(fields, " ") + " }"
+}
+
+func hasRequiredGraphQLArgs(args []*graphQLInputValue) bool {
+	for _, arg := range args {
+		if arg.Type != nil && arg.Type.Kind == "NON_NULL" && arg.DefaultValue == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *graphQLSchema) operations(operationType, typeName string) []*GraphQLOperation {
+	root, ok := s.types[typeName]
+	if !ok {
+		return nil
+	}
+	var ops []*GraphQLOperation
+	for _, field := range root.Fields {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		op := &GraphQLOperation{
+			OperationType: operationType,
+			OperationName: graphQLOperationName(operationType, field.Name),
+			Field:         field.Name,
+			Variables:     make(map[string]any),
+		}
+		var defs, args []string
+		for _, arg := range field.Args {
+			defs = append(defs, fmt.Sprintf("$%s: %s", arg.Name, graphQLTypeString(arg.Type)))
+			args = append(args, fmt.Sprintf("%s: $%s", arg.Name, arg.Name))
+			op.Variables[arg.Name] = s.sampleValue(arg.Type, 0)
+		}
+
+		var query strings.Builder
+		query.WriteString(operationType + " " + op.OperationName)
+		if len(defs) > 0 {
+			query.WriteString("(" + strings.Join(defs, ", ") + ")")
+		}
+		query.WriteString(" { " + field.Name)
+		if len(args) > 0 {
+			query.WriteString("(" + strings.Join(args, ", ") + ")")
+		}
+		if sub := s.selectionSet(field.Type, 0); sub != "" {
+			query.WriteString(" " + sub)
+		}
+		query.WriteString(" }")
+		op.Query = query.String()
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+func graphQLOperationName(operationType, field string) string {
+	if field == "" {
+		return operationType
+	}
+	return operationType + strings.ToUpper(field[:1]) + field[1:]
+}
+
+// GraphQLOperationsFromIntrospection 根据内省结果为 Query 与 Mutation 的每个根字段生成一个操作
+// 参数 schema 可以是内省查询的响应体，也可以是完整的 HTTP 响应
+func GraphQLOperationsFromIntrospection(schema any) ([]*GraphQLOperation, error) {
+	s, err := parseGraphQLSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	var ops []*GraphQLOperation
+	if s.QueryType != nil {
+		ops = append(ops, s.operations("query", s.QueryType.Name)...)
+	}
+	if s.MutationType != nil {
+		ops = append(ops, s.operations("mutation", s.MutationType.Name)...)
+	}
+	if len(ops) <= 0 {
+		return nil, utils.Error("no graphql operation found in introspection result")
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		if ops[i].OperationType != ops[j].OperationType {
+			return ops[i].OperationType == "query"
+		}
+		return ops[i].Field < ops[j].Field
+	})
+	return ops, nil
+}
+
+func (f *FuzzHTTPRequest) withGraphQLBody(body []byte) (*FuzzHTTPRequest, error) {
+	packet := lowhttp.ReplaceHTTPPacketMethod(f.GetBytes(), "POST")
+	packet = lowhttp.ReplaceHTTPPacketHeader(packet, "Content-Type", "application/json")
+	packet = lowhttp.ReplaceHTTPPacketBodyFast(packet, body)
+	return NewFuzzHTTPRequest(packet, f.GetCurrentOptions()...)
+}
+
+// GraphQLIntrospectionRequest 以当前请求为模板，生成一个发送内省查询的请求
+func (f *FuzzHTTPRequest) GraphQLIntrospectionRequest() (*FuzzHTTPRequest, error) {
+	op := &GraphQLOperation{Query: GraphQLIntrospectionQuery, OperationName: "IntrospectionQuery"}
+	return f.withGraphQLBody(op.JSON())
+}
+
+// GraphQLRequestsFromIntrospection 以当前请求为模板，根据内省结果为每个 Query / Mutation 根字段生成一个请求
+// 生成的请求可以继续通过 GetGraphQLParams 获取参数进行模糊测试
+func (f *FuzzHTTPRequest) GraphQLRequestsFromIntrospection(schema any) ([]*FuzzHTTPRequest, error) {
+	ops, err := GraphQLOperationsFromIntrospection(schema)
+	if err != nil {
+		return nil, err
+	}
+	var reqs []*FuzzHTTPRequest
+	for _, op := range ops {
+		req, err := f.withGraphQLBody(op.JSON())
+		if err != nil {
+			return nil, utils.Wrapf(err, "build graphql request for %v failed", op.Field)
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
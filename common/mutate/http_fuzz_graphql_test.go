@@ -0,0 +1,245 @@
+package mutate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+const graphQLJsonRequest = `POST /graphql HTTP/1.1
+Host: example.com
+Content-Type: application/json
+
+{"query":"query GetUser($id: ID!, $limit: Int = 10) { user(id: $id) { name posts(first: 5, filter: {status: PUBLISHED, tags: [\"a\"]}) { title } } }","variables":{"id":"1","opts":{"x":2}},"operationName":"GetUser"}`
+
+func TestGraphQLParse(t *testing.T) {
+	doc, err := parseGraphQLDocument(`
+# comment
+query Q($a: [Int!]! = [1, 2], $b: String) @dir(x: 1) {
+  alias: field(arg: "x\"yA", b: """block "quoted" """, c: -1.5e3, d: null, e: $a) {
+    ... on T { sub(x: ENUM_V) }
+    ...Frag
+  }
+}
+fragment Frag on T { other(id: 7) }
+{ anon(flag: true) }`)
+	require.NoError(t, err)
+	require.Len(t, doc.operations, 2)
+	require.Len(t, doc.fragments, 1)
+	require.Equal(t, "[Int!]!", doc.operations[0].variables[0].typ)
+
+	leaves := make(map[string]*graphQLArgumentLeaf)
+	for _, leaf := range doc.argumentLeaves() {
+		leaves[leaf.path] = leaf
+	}
+	require.Len(t, leaves, 7)
+	require.Equal(t, `x"yA`, leaves["Q.alias(arg)"].value.value)
+	require.Equal(t, `block "quoted"`, leaves["Q.alias(b)"].value.value)
+	require.Equal(t, -1500.0, leaves["Q.alias(c)"].value.value)
+	require.Equal(t, gqlValueNull, leaves["Q.alias(d)"].value.kind)
+	require.Equal(t, "ENUM_V", leaves["Q.alias.sub(x)"].value.value)
+	require.Equal(t, int64(7), leaves["Frag.other(id)"].value.value)
+	require.Equal(t, true, leaves["query.anon(flag)"].value.value)
+
+	for _, bad := range []string{"", "abc", "{ a(x: ) }", "{ a", `{ a(x: "b) }`, "{ a(x: 01) }", "type Query { a: Int }"} {
+		_, err := parseGraphQLDocument(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestGraphQLParams(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(graphQLJsonRequest)
+	require.NoError(t, err)
+	require.True(t, freq.IsBodyGraphQL())
+
+	params := freq.GetGraphQLParams()
+	got := make(map[string]*FuzzHTTPRequestParam)
+	for _, p := range params {
+		got[p.Position()+"|"+p.Path()] = p
+	}
+	require.Len(t, got, 7)
+	require.Contains(t, got, "post-graphql-argument|GetUser.user.posts(first)")
+	require.Equal(t, "filter.status", got["post-graphql-argument|GetUser.user.posts(filter.status)"].Name())
+	require.Contains(t, got, "post-graphql-argument|GetUser.user.posts(filter.tags[0])")
+	require.Equal(t, "1", got["post-graphql-variable|$.id"].Value())
+	require.Contains(t, got, "post-graphql-variable|$.opts")
+	require.Contains(t, got, "post-graphql-variable|$.opts.x")
+	// 文档中定义了但 variables 中没有的变量，值为默认值
+	require.Equal(t, int64(10), got["post-graphql-variable|$.limit"].Value())
+
+	// GraphQL 请求体不再作为普通 JSON 处理
+	require.Equal(t, params, freq.GetPostCommonParams())
+}
+
+func TestGraphQLFuzzArgument(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(graphQLJsonRequest)
+	require.NoError(t, err)
+
+	var query []string
+	for _, p := range freq.GetGraphQLParams() {
+		if p.Path() != "GetUser.user.posts(first)" && p.Path() != "GetUser.user.posts(filter.tags[0])" {
+			continue
+		}
+		reqs, err := p.Fuzz("100", `1' or "1"="1`).Results()
+		require.NoError(t, err)
+		require.Len(t, reqs, 2)
+		for _, req := range reqs {
+			body := gjson.GetBytes(httpRequestReadBody(req), "query").String()
+			_, err := parseGraphQLDocument(body)
+			require.NoError(t, err, body)
+			query = append(query, body)
+		}
+	}
+	require.Len(t, query, 4)
+	require.Contains(t, query[0], `posts(first: 100, filter`)
+	require.Contains(t, query[1], `posts(first: "1' or \"1\"=\"1", filter`)
+	require.Contains(t, query[2], `tags: ["100"]`)
+	require.Contains(t, query[3], `tags: ["1' or \"1\"=\"1"]`)
+
+	// 直接使用参数名
+	reqs, err := freq.FuzzGraphQLArgument("filter.status", "DRAFT").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Contains(t, gjson.GetBytes(httpRequestReadBody(reqs[0]), "query").String(), "status: DRAFT")
+}
+
+func TestGraphQLFuzzVariable(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(graphQLJsonRequest)
+	require.NoError(t, err)
+
+	reqs, err := freq.FuzzGraphQLVariable("id", []string{"2", "' or 1=1--"}).Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	body := httpRequestReadBody(reqs[0])
+	require.Equal(t, "2", gjson.GetBytes(body, "variables.id").Value())
+	require.Equal(t, "' or 1=1--", gjson.GetBytes(httpRequestReadBody(reqs[1]), "variables.id").Value())
+	require.Equal(t, "GetUser", gjson.GetBytes(body, "operationName").String())
+
+	reqs, err = freq.FuzzGraphQLVariable("$.opts.x", "3").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, int64(3), gjson.GetBytes(httpRequestReadBody(reqs[0]), "variables.opts.x").Int())
+
+	for _, p := range freq.GetGraphQLParams() {
+		if p.Name() != "limit" {
+			continue
+		}
+		reqs, err := p.Fuzz("99").Results()
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		require.Equal(t, int64(99), gjson.GetBytes(httpRequestReadBody(reqs[0]), "variables.limit").Int())
+	}
+}
+
+func TestGraphQLRawDocument(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(`POST /graphql HTTP/1.1
+Host: example.com
+Content-Type: application/graphql
+
+{ search(keyword: "yak") { id } }`)
+	require.NoError(t, err)
+
+	params := freq.GetGraphQLParams()
+	require.Len(t, params, 1)
+	require.Equal(t, string(lowhttp.PosPostGraphQLArgument), params[0].Position())
+	reqs, err := params[0].Fuzz(`a"b`).Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, `{ search(keyword: "a\"b") { id } }`, string(httpRequestReadBody(reqs[0])))
+
+	// 普通 JSON 请求不会被识别为 GraphQL
+	freq, err = NewFuzzHTTPRequest(`POST / HTTP/1.1
+Host: example.com
+Content-Type: application/json
+
+{"query":"select * from a"}`)
+	require.NoError(t, err)
+	require.False(t, freq.IsBodyGraphQL())
+	require.Len(t, freq.GetPostCommonParams(), 1)
+}
+
+const graphQLIntrospectionResult = `{"data":{"__schema":{
+"queryType":{"name":"Query"},"mutationType":{"name":"Mutation"},"subscriptionType":null,
+"types":[
+{"kind":"OBJECT","name":"Query","fields":[
+  {"name":"user","args":[{"name":"id","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}},"defaultValue":null}],"type":{"kind":"OBJECT","name":"User","ofType":null}},
+  {"name":"search","args":[{"name":"keyword","type":{"kind":"SCALAR","name":"String","ofType":null},"defaultValue":null},{"name":"role","type":{"kind":"ENUM","name":"Role","ofType":null},"defaultValue":null}],"type":{"kind":"LIST","name":null,"ofType":{"kind":"UNION","name":"Result","ofType":null}}}
+]},
+{"kind":"OBJECT","name":"Mutation","fields":[
+  {"name":"createUser","args":[{"name":"input","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"INPUT_OBJECT","name":"UserInput","ofType":null}},"defaultValue":null}],"type":{"kind":"OBJECT","name":"User","ofType":null}}
+]},
+{"kind":"OBJECT","name":"User","fields":[
+  {"name":"id","args":[],"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}}},
+  {"name":"role","args":[],"type":{"kind":"ENUM","name":"Role","ofType":null}},
+  {"name":"friends","args":[{"name":"first","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"Int","ofType":null}},"defaultValue":null}],"type":{"kind":"LIST","name":null,"ofType":{"kind":"OBJECT","name":"User","ofType":null}}},
+  {"name":"manager","args":[],"type":{"kind":"OBJECT","name":"User","ofType":null}}
+]},
+{"kind":"INPUT_OBJECT","name":"UserInput","inputFields":[
+  {"name":"name","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}},"defaultValue":null},
+  {"name":"age","type":{"kind":"SCALAR","name":"Int","ofType":null},"defaultValue":null},
+  {"name":"role","type":{"kind":"ENUM","name":"Role","ofType":null},"defaultValue":null}
+]},
+{"kind":"ENUM","name":"Role","enumValues":[{"name":"ADMIN"},{"name":"GUEST"}]},
+{"kind":"UNION","name":"Result","possibleTypes":[{"kind":"OBJECT","name":"User","ofType":null}]}
+]}}}`
+
+func TestGraphQLIntrospection(t *testing.T) {
+	ops, err := GraphQLOperationsFromIntrospection(graphQLIntrospectionResult)
+	require.NoError(t, err)
+	require.Len(t, ops, 3)
+
+	require.Equal(t, "search", ops[0].Field)
+	require.Equal(t, "query querySearch($keyword: String, $role: Role) { search(keyword: $keyword, role: $role) { __typename } }", ops[0].Query)
+	require.Equal(t, "ADMIN", ops[0].Variables["role"])
+
+	require.Equal(t, "user", ops[1].Field)
+	require.Equal(t, "query queryUser($id: ID!) { user(id: $id) { id role manager { id role manager { id role } } } }", ops[1].Query)
+
+	require.Equal(t, "mutation", ops[2].OperationType)
+	require.Equal(t, map[string]any{"name": "yak", "age": 1, "role": "ADMIN"}, ops[2].Variables["input"])
+
+	_, err = GraphQLOperationsFromIntrospection(`{"data":null}`)
+	require.Error(t, err)
+
+	freq, err := NewFuzzHTTPRequest(`GET /graphql HTTP/1.1
+Host: example.com
+Authorization: Bearer token
+`)
+	require.NoError(t, err)
+
+	introspection, err := freq.GraphQLIntrospectionRequest()
+	require.NoError(t, err)
+	require.Equal(t, "POST", introspection.GetMethod())
+	require.True(t, introspection.IsBodyGraphQL())
+
+	reqs, err := freq.GraphQLRequestsFromIntrospection("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + graphQLIntrospectionResult)
+	require.NoError(t, err)
+	require.Len(t, reqs, 3)
+	for _, req := range reqs {
+		require.Equal(t, "Bearer token", req.GetHeader("Authorization"))
+		require.NotEmpty(t, req.GetGraphQLParams())
+	}
+	// createUser 的输入对象变量被展开为可以单独测试的参数
+	var names []string
+	for _, p := range reqs[2].GetGraphQLParams() {
+		names = append(names, p.Path())
+	}
+	require.ElementsMatch(t, []string{"$.input", "$.input.name", "$.input.age", "$.input.role"}, names)
+}
+
+func TestGraphQLDetect(t *testing.T) {
+	for body, isGraphQL := range map[string]bool{
+		`{"query":"{ user { name } }"}`:                                      true,
+		`{"query":"{ user { name } }","extensions":{"persistedQuery":{}}}`:   true,
+		`{"query":"{ user { name } }","page":1}`:                             false,
+		`{"query":"query Q { user { name } }","operationName":"Q","page":1}`: true,
+		`{"query":"laptop","variables":{}}`:                                  false,
+		`{"query":"laptop"}`:                                                 false,
+	} {
+		freq, err := NewFuzzHTTPRequest("POST /search HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/json\r\n\r\n" + body)
+		require.NoError(t, err)
+		require.Equal(t, isGraphQL, freq.IsBodyGraphQL(), body)
+	}
+}
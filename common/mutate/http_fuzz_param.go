@@ -44,6 +44,12 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "POST参数(Base64+JSON)"
 	case lowhttp.PosPostJson:
 		return "JSON-Body参数"
+	case lowhttp.PosPostGraphQLArgument:
+		return "GraphQL参数"
+	case lowhttp.PosPostGraphQLVariable:
+		return "GraphQL变量"
+	case lowhttp.PosPostProtobuf:
+		return "Protobuf字段"
 	case lowhttp.PosCookie:
 		return "Cookie参数"
 	case lowhttp.PosCookieBase64:
@@ -72,7 +78,8 @@ type FuzzHTTPRequestParam struct {
 func (p *FuzzHTTPRequestParam) IsPostParams() bool {
 	switch p.position {
 	case lowhttp.PosPostJson, lowhttp.PosPostQuery, lowhttp.PosPostQueryBase64,
-		lowhttp.PosPostQueryJson, lowhttp.PosPostQueryBase64Json, lowhttp.PosPostXML:
+		lowhttp.PosPostQueryJson, lowhttp.PosPostQueryBase64Json, lowhttp.PosPostXML,
+		lowhttp.PosPostGraphQLArgument, lowhttp.PosPostGraphQLVariable, lowhttp.PosPostProtobuf:
 		return true
 	}
 	return false
@@ -280,6 +287,12 @@ func (p *FuzzHTTPRequestParam) Fuzz(i ...interface{}) FuzzHTTPRequestIf {
 		return p.origin.FuzzPostParams(p.param, i)
 	case lowhttp.PosPostXML:
 		return p.origin.FuzzPostXMLParams(p.path, i)
+	case lowhttp.PosPostGraphQLArgument:
+		return p.origin.FuzzGraphQLArgument(p.path, i)
+	case lowhttp.PosPostGraphQLVariable:
+		return p.origin.FuzzGraphQLVariable(p.path, i)
+	case lowhttp.PosPostProtobuf:
+		return p.origin.FuzzProtobufField(p.path, i)
 	case lowhttp.PosPostQueryBase64:
 		return p.origin.FuzzPostBase64Params(p.param, i)
 	case lowhttp.PosPostQueryJson:
@@ -324,8 +337,11 @@ func (p *FuzzHTTPRequestParam) Fuzz(i ...interface{}) FuzzHTTPRequestIf {
 func (p *FuzzHTTPRequestParam) String() string {
 	if p.path != "" {
 		pathName := "JsonPath"
-		if p.position == lowhttp.PosPostXML {
+		switch p.position {
+		case lowhttp.PosPostXML:
 			pathName = "XPath"
+		case lowhttp.PosPostGraphQLArgument, lowhttp.PosPostProtobuf:
+			pathName = "Field"
 		}
 		return fmt.Sprintf("Name:%-20s %s: %-12s Position:[%v(%v)]\n", p.Name(), pathName, p.path, p.PositionVerbose(), p.Position())
 	}
@@ -0,0 +1,124 @@
+package mutate
+
+import (
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/protobufx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak/cartesian"
+)
+
+func (f *FuzzHTTPRequest) getProtobufBody() (*protobufx.Body, error) {
+	var opts []protobufx.Option
+	if f.protobufSchema != nil {
+		opts = append(opts, protobufx.WithSchema(f.protobufSchema))
+	}
+	body, err := protobufx.DecodeHTTPPacket(f.GetBytes(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(body.Messages()) <= 0 {
+		return nil, utils.Error("no protobuf message in body")
+	}
+	return body, nil
+}
+
+// IsBodyProtobuf 判断请求体是否为可以解码的 gRPC / grpc-web / protobuf 消息
+func (f *FuzzHTTPRequest) IsBodyProtobuf() bool {
+	_, err := f.getProtobufBody()
+	return err == nil
+}
+
+// GetProtobufParams 获取 gRPC / grpc-web / protobuf 请求体中的字段，嵌套消息会展开，路径由字段号组成（如 1.3、2[1].1）
+// 通过 OptProtobufSchema 设置 Schema 后参数名为字段名
+func (f *FuzzHTTPRequest) GetProtobufParams() []*FuzzHTTPRequestParam {
+	body, err := f.getProtobufBody()
+	if err != nil {
+		return nil
+	}
+	raw := body.String()
+	var fuzzParams []*FuzzHTTPRequestParam
+	for _, leaf := range body.Leaves() {
+		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosPostProtobuf,
+			param:      leaf.Name(),
+			paramValue: leaf.Value(),
+			raw:        raw,
+			path:       leaf.Path,
+			origin:     f,
+		})
+	}
+	return fuzzParams
+}
+
+func (f *FuzzHTTPRequest) fuzzProtobufField(k, v any) ([]*http.Request, error) {
+	body, err := f.getProtobufBody()
+	if err != nil {
+		return nil, err
+	}
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if keys == nil || values == nil {
+		return nil, utils.Error("keys or values is empty...")
+	}
+
+	leaves := body.Leaves()
+	origin := f.GetBytes()
+	var reqs []*http.Request
+	err = cartesian.ProductEx([][]string{keys, values}, func(result []string) error {
+		key, value := result[0], result[1]
+		for index, leaf := range leaves {
+			// 既可以使用字段号路径，也可以使用字段名路径或字段名
+			if leaf.Path != key && (leaf.Field.Name == "" || (leaf.Field.Name != key && leaf.NamePath != key)) {
+				continue
+			}
+			// 每次修改都基于重新解码的请求体，避免不同的值互相影响
+			fresh, err := f.getProtobufBody()
+			if err != nil {
+				return err
+			}
+			if err := fresh.Leaves()[index].Set(value); err != nil {
+				continue
+			}
+			var packet []byte
+			if f.friendlyDisplay {
+				// 友好显示时请求体为编码 fuzztag 包裹的文本，用于生成 Web Fuzzer 模板
+				packet = lowhttp.ReplaceHTTPPacketBodyFast(lowhttp.DeletePacketEncoding(origin), []byte(fresh.FuzzTagText()))
+			} else if packet, err = protobufx.ReplaceHTTPPacketBody(origin, fresh); err != nil {
+				continue
+			}
+			reqIns, err := lowhttp.ParseBytesToHttpRequest(packet)
+			if err != nil {
+				continue
+			}
+			reqs = append(reqs, reqIns)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+// FuzzProtobufField 模糊测试 gRPC / protobuf 消息中的字段，k 可以是 GetProtobufParams 给出的字段号路径、字段名路径或字段名
+// 修改后重新编码消息，嵌套消息的长度与 gRPC 的长度前缀都会被修正；数值字段设置为非数值时按字符串编码
+func (f *FuzzHTTPRequest) FuzzProtobufField(k, v any) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzProtobufField(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzProtobufField(k, v any) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzProtobufField(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzProtobufField(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
@@ -0,0 +1,131 @@
+package mutate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/protobufx"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func buildGRPCRequest(t *testing.T) []byte {
+	var inner []byte
+	inner = protowire.AppendTag(inner, 1, protowire.BytesType)
+	inner = protowire.AppendString(inner, "hz")
+	var msg []byte
+	msg = protowire.AppendTag(msg, 1, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, 7)
+	msg = protowire.AppendTag(msg, 2, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, inner)
+	frame := append([]byte{0, 0, 0, 0, byte(len(msg))}, msg...)
+	return lowhttp.ReplaceHTTPPacketBodyFast([]byte("POST /demo.UserService/GetUser HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/grpc\r\n\r\n"), frame)
+}
+
+func TestProtobufParams(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(buildGRPCRequest(t))
+	require.NoError(t, err)
+	require.True(t, freq.IsBodyProtobuf())
+
+	params := freq.GetPostCommonParams()
+	require.Len(t, params, 2)
+	require.Equal(t, string(lowhttp.PosPostProtobuf), params[0].Position())
+	require.Equal(t, "1", params[0].Path())
+	require.Equal(t, uint64(7), params[0].Value())
+	require.Equal(t, "2.1", params[1].Path())
+	require.Equal(t, "hz", params[1].Value())
+
+	reqs, err := params[1].Fuzz("<script>alert(1)</script>", "x").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	body, err := protobufx.DecodeBody(protobufx.BodyGRPC, "", httpRequestReadBody(reqs[0]))
+	require.NoError(t, err)
+	require.Len(t, body.Frames, 1)
+	leaf, ok := body.Leaf("2.1")
+	require.True(t, ok)
+	require.Equal(t, "<script>alert(1)</script>", leaf.Value())
+
+	// 数值字段设置为非数值时按字符串编码
+	reqs, err = freq.FuzzProtobufField("1", []string{"8", "' or 1=1"}).Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	body, err = protobufx.DecodeBody(protobufx.BodyGRPC, "", httpRequestReadBody(reqs[0]))
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), body.Frames[0].Message.Fields[0].Value())
+	body, err = protobufx.DecodeBody(protobufx.BodyGRPC, "", httpRequestReadBody(reqs[1]))
+	require.NoError(t, err)
+	require.Equal(t, "' or 1=1", body.Frames[0].Message.Fields[0].Value())
+}
+
+func TestProtobufParamsWithSchema(t *testing.T) {
+	schema, err := protobufx.ParseProto(`
+package demo;
+message Address { string city = 1; }
+message GetUserRequest { int64 id = 1; Address address = 2; }
+service UserService { rpc GetUser(GetUserRequest) returns (Address); }
+`)
+	require.NoError(t, err)
+	freq, err := NewFuzzHTTPRequest(buildGRPCRequest(t), OptProtobufSchema(schema))
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range freq.GetProtobufParams() {
+		names = append(names, p.Name())
+	}
+	require.Equal(t, []string{"id", "city"}, names)
+
+	// 可以直接使用字段名路径，并且 Schema 会传递给后续的请求
+	result := freq.FuzzProtobufField("address.city", "sh")
+	reqs, err := result.Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	reqs, err = result.FuzzProtobufField("id", "9").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	body, err := protobufx.DecodeBody(protobufx.BodyGRPC, "", httpRequestReadBody(reqs[0]), protobufx.WithSchema(schema), protobufx.WithMessageType("demo.GetUserRequest"))
+	require.NoError(t, err)
+	require.Contains(t, body.String(), "1: 9  # id\n  2 {  # address\n    1: \"sh\"  # city")
+
+	// 非 protobuf 请求不受影响
+	freq, err = NewFuzzHTTPRequest("POST / HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/x-protobuf\r\n\r\n")
+	require.NoError(t, err)
+	require.False(t, freq.IsBodyProtobuf() && len(freq.GetProtobufParams()) > 0)
+}
+
+func TestProtobufParamsFriendlyDisplay(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(buildGRPCRequest(t))
+	require.NoError(t, err)
+	params := freq.GetProtobufParams()
+	require.Len(t, params, 2)
+
+	// 友好显示时请求体为 grpc:encode 包裹的文本，渲染 fuzztag 后得到重新编码的 gRPC 消息
+	reqs, err := params[1].FriendlyDisplay().Fuzz("yakflag").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	template := string(httpRequestReadBody(reqs[0]))
+	require.Contains(t, template, "{{grpc:encode(")
+	require.Contains(t, template, `1: "yakflag"`)
+	template = strings.ReplaceAll(template, "yakflag", "{{randstr(5,5,1)}}")
+
+	results, err := FuzzTagExec(template)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	body, err := protobufx.DecodeBody(protobufx.BodyGRPC, "", []byte(results[0]))
+	require.NoError(t, err)
+	leaf, ok := body.Leaf("2.1")
+	require.True(t, ok)
+	require.Len(t, leaf.Value(), 5)
+	leaf, ok = body.Leaf("1")
+	require.True(t, ok)
+	require.Equal(t, uint64(7), leaf.Value())
+
+	results, err = FuzzTagExec(`{{protobuf:encode(1: 7
+2 {
+  1: "hz"
+})}}`)
+	require.NoError(t, err)
+	msg, err := protobufx.Decode([]byte(results[0]))
+	require.NoError(t, err)
+	require.Equal(t, "hz", msg.Leaves()[1].Value())
+}
@@ -0,0 +1,459 @@
+package netx
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// JARM 是一种主动 TLS 服务端指纹：向目标发送 10 个精心构造的 ClientHello，
+// 根据服务端选择的加密套件、版本、ALPN 与扩展顺序计算 62 位的指纹
+// 参考 https://github.com/salesforce/jarm
+
+const jarmEmptyHash = "00000000000000000000000000000000000000000000000000000000000000"
+
+type jarmProbe struct {
+	version        string
+	cipherList     string
+	cipherOrder    string
+	grease         bool
+	rareALPN       bool
+	support        string
+	extensionOrder string
+}
+
+var jarmProbes = []*jarmProbe{
+	{"TLS_1.2", "ALL", "FORWARD", false, false, "1.2_SUPPORT", "REVERSE"},
+	{"TLS_1.2", "ALL", "REVERSE", false, false, "1.2_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "TOP_HALF", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "BOTTOM_HALF", false, true, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "MIDDLE_OUT", true, true, "NO_SUPPORT", "REVERSE"},
+	{"TLS_1.1", "ALL", "FORWARD", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "FORWARD", false, false, "1.3_SUPPORT", "REVERSE"},
+	{"TLS_1.3", "ALL", "REVERSE", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "NO1.3", "FORWARD", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "MIDDLE_OUT", true, false, "1.3_SUPPORT", "REVERSE"},
+}
+
+var jarmAllCiphers = []uint16{
+	0x0016, 0x0033, 0x0067, 0xc09e, 0xc0a2, 0x009e, 0x0039, 0x006b, 0xc09f, 0xc0a3, 0x009f, 0x0045, 0x00be, 0x0088,
+	0x00c4, 0x009a, 0xc008, 0xc009, 0xc023, 0xc0ac, 0xc0ae, 0xc02b, 0xc00a, 0xc024, 0xc0ad, 0xc0af, 0xc02c, 0xc072,
+	0xc073, 0xcca9, 0x1302, 0x1301, 0xcc14, 0xc007, 0xc012, 0xc013, 0xc027, 0xc02f, 0xc014, 0xc028, 0xc030, 0xc060,
+	0xc061, 0xc076, 0xc077, 0xcca8, 0x1305, 0x1304, 0x1303, 0xcc13, 0xc011, 0x000a, 0x002f, 0x003c, 0xc09c, 0xc0a0,
+	0x009c, 0x0035, 0x003d, 0xc09d, 0xc0a1, 0x009d, 0x0041, 0x00ba, 0x0084, 0x00c0, 0x0007, 0x0004, 0x0005,
+}
+
+// jarmHashCiphers 是计算指纹时加密套件的编号顺序
+var jarmHashCiphers = []uint16{
+	0x0004, 0x0005, 0x0007, 0x000a, 0x0016, 0x002f, 0x0033, 0x0035, 0x0039, 0x003c, 0x003d, 0x0041, 0x0045, 0x0067,
+	0x006b, 0x0084, 0x0088, 0x009a, 0x009c, 0x009d, 0x009e, 0x009f, 0x00ba, 0x00be, 0x00c0, 0x00c4, 0xc007, 0xc008,
+	0xc009, 0xc00a, 0xc011, 0xc012, 0xc013, 0xc014, 0xc023, 0xc024, 0xc027, 0xc028, 0xc02b, 0xc02c, 0xc02f, 0xc030,
+	0xc060, 0xc061, 0xc072, 0xc073, 0xc076, 0xc077, 0xc09c, 0xc09d, 0xc09e, 0xc09f, 0xc0a0, 0xc0a1, 0xc0a2, 0xc0a3,
+	0xc0ac, 0xc0ad, 0xc0ae, 0xc0af, 0xcc13, 0xcc14, 0xcca8, 0xcca9, 0x1301, 0x1302, 0x1303, 0x1304, 0x1305,
+}
+
+var jarmALPNs = [][]byte{
+	[]byte("http/0.9"), []byte("http/1.0"), []byte("http/1.1"), []byte("spdy/1"), []byte("spdy/2"), []byte("spdy/3"),
+	[]byte("h2"), []byte("h2c"), []byte("hq"),
+}
+
+var jarmRareALPNs = [][]byte{
+	[]byte("http/0.9"), []byte("http/1.0"), []byte("spdy/1"), []byte("spdy/2"), []byte("spdy/3"), []byte("h2c"), []byte("hq"),
+}
+
+func jarmRandomBytes(n int) []byte {
+	buf := make([]byte, n)
+	_, _ = rand.Read(buf)
+	return buf
+}
+
+func jarmGrease() []byte {
+	n, err := rand.Int(rand.Reader, big.NewInt(16))
+	if err != nil {
+		return []byte{0x0a, 0x0a}
+	}
+	b := byte(n.Int64())<<4 | 0x0a
+	return []byte{b, b}
+}
+
+// jarmMung 按 JARM 的规则调整列表顺序
+func jarmMung[T any](items []T, order string) []T {
+	var output []T
+	length := len(items)
+	switch order {
+	case "REVERSE":
+		for i := length - 1; i >= 0; i-- {
+			output = append(output, items[i])
+		}
+	case "BOTTOM_HALF":
+		if length%2 == 1 {
+			output = append(output, items[length/2+1:]...)
+		} else {
+			output = append(output, items[length/2:]...)
+		}
+	case "TOP_HALF":
+		if length%2 == 1 {
+			output = append(output, items[length/2])
+		}
+		output = append(output, jarmMung(jarmMung(items, "REVERSE"), "BOTTOM_HALF")...)
+	case "MIDDLE_OUT":
+		middle := length / 2
+		if length%2 == 1 {
+			output = append(output, items[middle])
+			for i := 1; i <= middle; i++ {
+				output = append(output, items[middle+i], items[middle-i])
+			}
+		} else {
+			for i := 1; i <= middle; i++ {
+				output = append(output, items[middle-1+i], items[middle-i])
+			}
+		}
+	default:
+		output = append(output, items...)
+	}
+	return output
+}
+
+func jarmUint16(v int) []byte {
+	return []byte{byte(v >> 8), byte(v)}
+}
+
+func (p *jarmProbe) ciphers() []byte {
+	var ciphers []uint16
+	for _, cipher := range jarmAllCiphers {
+		if p.cipherList == "NO1.3" && cipher>>8 == 0x13 {
+			continue
+		}
+		ciphers = append(ciphers, cipher)
+	}
+	ciphers = jarmMung(ciphers, p.cipherOrder)
+	var buf []byte
+	if p.grease {
+		buf = append(buf, jarmGrease()...)
+	}
+	for _, cipher := range ciphers {
+		buf = binary.BigEndian.AppendUint16(buf, cipher)
+	}
+	return buf
+}
+
+func (p *jarmProbe) extensions(host string) []byte {
+	var ext []byte
+	if p.grease {
+		ext = append(ext, jarmGrease()...)
+		ext = append(ext, 0x00, 0x00)
+	}
+	// server_name
+	ext = append(ext, 0x00, 0x00)
+	ext = append(ext, jarmUint16(len(host)+5)...)
+	ext = append(ext, jarmUint16(len(host)+3)...)
+	ext = append(ext, 0x00)
+	ext = append(ext, jarmUint16(len(host))...)
+	ext = append(ext, host...)
+	// extended_master_secret, max_fragment_length, renegotiation_info, supported_groups, ec_point_formats, session_ticket
+	ext = append(ext, 0x00, 0x17, 0x00, 0x00)
+	ext = append(ext, 0x00, 0x01, 0x00, 0x01, 0x01)
+	ext = append(ext, 0xff, 0x01, 0x00, 0x01, 0x00)
+	ext = append(ext, 0x00, 0x0a, 0x00, 0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00, 0x18, 0x00, 0x19)
+	ext = append(ext, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00)
+	ext = append(ext, 0x00, 0x23, 0x00, 0x00)
+	// application_layer_protocol_negotiation
+	alpns := jarmALPNs
+	if p.rareALPN {
+		alpns = jarmRareALPNs
+	}
+	var alpnBuf []byte
+	for _, alpn := range jarmMung(alpns, p.extensionOrder) {
+		alpnBuf = append(alpnBuf, byte(len(alpn)))
+		alpnBuf = append(alpnBuf, alpn...)
+	}
+	ext = append(ext, 0x00, 0x10)
+	ext = append(ext, jarmUint16(len(alpnBuf)+2)...)
+	ext = append(ext, jarmUint16(len(alpnBuf))...)
+	ext = append(ext, alpnBuf...)
+	// signature_algorithms
+	ext = append(ext, 0x00, 0x0d, 0x00, 0x14, 0x00, 0x12, 0x04, 0x03, 0x08, 0x04, 0x04, 0x01, 0x05, 0x03, 0x08, 0x05,
+		0x05, 0x01, 0x08, 0x06, 0x06, 0x01, 0x02, 0x01)
+	// key_share
+	var share []byte
+	if p.grease {
+		share = append(share, jarmGrease()...)
+		share = append(share, 0x00, 0x01, 0x00)
+	}
+	share = append(share, 0x00, 0x1d, 0x00, 0x20)
+	share = append(share, jarmRandomBytes(32)...)
+	ext = append(ext, 0x00, 0x33)
+	ext = append(ext, jarmUint16(len(share)+2)...)
+	ext = append(ext, jarmUint16(len(share))...)
+	ext = append(ext, share...)
+	// psk_key_exchange_modes
+	ext = append(ext, 0x00, 0x2d, 0x00, 0x02, 0x01, 0x01)
+	// supported_versions
+	if p.version == "TLS_1.3" || p.support == "1.2_SUPPORT" {
+		versions := [][]byte{{0x03, 0x01}, {0x03, 0x02}, {0x03, 0x03}}
+		if p.support != "1.2_SUPPORT" {
+			versions = append(versions, []byte{0x03, 0x04})
+		}
+		versions = jarmMung(versions, p.extensionOrder)
+		if p.grease {
+			versions = append([][]byte{jarmGrease()}, versions...)
+		}
+		var versionBuf []byte
+		for _, version := range versions {
+			versionBuf = append(versionBuf, version...)
+		}
+		ext = append(ext, 0x00, 0x2b)
+		ext = append(ext, jarmUint16(len(versionBuf)+1)...)
+		ext = append(ext, byte(len(versionBuf)))
+		ext = append(ext, versionBuf...)
+	}
+	return append(jarmUint16(len(ext)), ext...)
+}
+
+// packet 构造 ClientHello 记录
+func (p *jarmProbe) packet(host string) []byte {
+	recordVersion, helloVersion := []byte{0x03, 0x03}, []byte{0x03, 0x03}
+	switch p.version {
+	case "TLS_1.3":
+		recordVersion = []byte{0x03, 0x01}
+	case "TLS_1.1":
+		recordVersion, helloVersion = []byte{0x03, 0x02}, []byte{0x03, 0x02}
+	}
+	hello := append([]byte{}, helloVersion...)
+	hello = append(hello, jarmRandomBytes(32)...)
+	hello = append(hello, 32)
+	hello = append(hello, jarmRandomBytes(32)...)
+	ciphers := p.ciphers()
+	hello = append(hello, jarmUint16(len(ciphers))...)
+	hello = append(hello, ciphers...)
+	// compression methods
+	hello = append(hello, 0x01, 0x00)
+	hello = append(hello, p.extensions(host)...)
+
+	handshake := []byte{0x01, 0x00}
+	handshake = append(handshake, jarmUint16(len(hello))...)
+	handshake = append(handshake, hello...)
+
+	record := []byte{0x16}
+	record = append(record, recordVersion...)
+	record = append(record, jarmUint16(len(handshake))...)
+	return append(record, handshake...)
+}
+
+// jarmSlice 与 python 的切片行为一致，越界时截断而不是 panic
+func jarmSlice(data []byte, start, end int) []byte {
+	if start > len(data) {
+		start = len(data)
+	}
+	if end > len(data) {
+		end = len(data)
+	}
+	if start > end {
+		return nil
+	}
+	return data[start:end]
+}
+
+func jarmUint16From(data []byte) (int, error) {
+	if len(data) != 2 {
+		return 0, utils.Error("invalid length")
+	}
+	return int(binary.BigEndian.Uint16(data)), nil
+}
+
+// jarmReadServerHello 解析服务端响应，返回 "加密套件|版本|ALPN|扩展列表"
+func jarmReadServerHello(data []byte) (result string) {
+	defer func() {
+		if err := recover(); err != nil {
+			result = "|||"
+		}
+	}()
+	if len(data) == 0 || data[0] == 21 {
+		return "|||"
+	}
+	if data[0] != 22 || data[5] != 2 {
+		return "|||"
+	}
+	helloLength, err := jarmUint16From(jarmSlice(data, 3, 5))
+	if err != nil {
+		return "|||"
+	}
+	counter := int(data[43])
+	cipher := hex.EncodeToString(jarmSlice(data, counter+44, counter+46))
+	version := hex.EncodeToString(jarmSlice(data, 9, 11))
+	extensions, err := jarmExtensionInfo(data, counter, helloLength)
+	if err != nil {
+		return "|||"
+	}
+	return cipher + "|" + version + "|" + extensions
+}
+
+func jarmExtensionInfo(data []byte, counter int, helloLength int) (result string, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			result, err = "|", nil
+		}
+	}()
+	if data[counter+47] == 11 {
+		return "|", nil
+	}
+	if string(jarmSlice(data, counter+50, counter+53)) == "\x0e\xac\x0b" || string(jarmSlice(data, 82, 85)) == "\x0f\xf0\x0b" {
+		return "|", nil
+	}
+	if counter+42 >= helloLength {
+		return "|", nil
+	}
+	count := 49 + counter
+	length, err := jarmUint16From(jarmSlice(data, counter+47, counter+49))
+	if err != nil {
+		return "", err
+	}
+	maximum := length + count - 1
+	var types [][]byte
+	var values [][]byte
+	for count < maximum {
+		types = append(types, jarmSlice(data, count, count+2))
+		extLength, err := jarmUint16From(jarmSlice(data, count+2, count+4))
+		if err != nil {
+			return "", err
+		}
+		if extLength == 0 {
+			values = append(values, nil)
+			count += 4
+		} else {
+			values = append(values, jarmSlice(data, count+4, count+4+extLength))
+			count += extLength + 4
+		}
+	}
+	alpn := ""
+	for i, t := range types {
+		if string(t) == "\x00\x10" {
+			alpn = string(jarmSlice(values[i], 3, len(values[i])))
+			break
+		}
+	}
+	var hexTypes []string
+	for _, t := range types {
+		hexTypes = append(hexTypes, hex.EncodeToString(t))
+	}
+	return alpn + "|" + strings.Join(hexTypes, "-"), nil
+}
+
+func jarmCipherByte(cipher string) string {
+	if cipher == "" {
+		return "00"
+	}
+	count := 1
+	for _, c := range jarmHashCiphers {
+		if fmt.Sprintf("%04x", c) == cipher {
+			break
+		}
+		count++
+	}
+	return fmt.Sprintf("%02x", count)
+}
+
+func jarmVersionByte(version string) string {
+	if len(version) < 4 {
+		return "0"
+	}
+	index := int(version[3] - '0')
+	if index < 0 || index >= len("abcdef") {
+		return "0"
+	}
+	return string("abcdef"[index])
+}
+
+// JARMHash 根据 10 次握手的原始结果计算 JARM 指纹
+func JARMHash(raws []string) string {
+	allEmpty := true
+	for _, raw := range raws {
+		if raw != "|||" {
+			allEmpty = false
+			break
+		}
+	}
+	if allEmpty {
+		return jarmEmptyHash
+	}
+	var fuzzy strings.Builder
+	var alpnAndExtensions strings.Builder
+	for _, raw := range raws {
+		components := strings.SplitN(raw, "|", 4)
+		for len(components) < 4 {
+			components = append(components, "")
+		}
+		fuzzy.WriteString(jarmCipherByte(components[0]))
+		fuzzy.WriteString(jarmVersionByte(components[1]))
+		alpnAndExtensions.WriteString(components[2])
+		alpnAndExtensions.WriteString(components[3])
+	}
+	sum := sha256.Sum256([]byte(alpnAndExtensions.String()))
+	return fuzzy.String() + hex.EncodeToString(sum[:])[:32]
+}
+
+func jarmSendProbe(ctx context.Context, target string, host string, probe *jarmProbe, timeout time.Duration, proxies ...string) string {
+	conn, err := DialTCPTimeout(timeout, target, proxies...)
+	if err != nil {
+		log.Debugf("jarm dial %s failed: %v", target, err)
+		return "|||"
+	}
+	defer conn.Close()
+	deadline := time.Now().Add(timeout)
+	if ddl, ok := ctx.Deadline(); ok && ddl.Before(deadline) {
+		deadline = ddl
+	}
+	_ = conn.SetDeadline(deadline)
+	if _, err = conn.Write(probe.packet(host)); err != nil {
+		return "|||"
+	}
+	buf := make([]byte, 1484)
+	n, err := conn.Read(buf)
+	if n <= 0 {
+		return "|||"
+	}
+	return jarmReadServerHello(buf[:n])
+}
+
+// JARMContext 计算目标 TLS 服务的 JARM 指纹，timeout 为每次握手的超时时间，目标无法建立 TLS 握手时返回全 0 的指纹
+func JARMContext(ctx context.Context, addr string, timeout time.Duration, proxies ...string) (string, error) {
+	host, port, err := utils.ParseStringToHostPort(addr)
+	if err != nil || port <= 0 {
+		return "", utils.Errorf("invalid jarm target %s", addr)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	target := utils.HostPort(host, port)
+	raws := make([]string, 0, len(jarmProbes))
+	for _, probe := range jarmProbes {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+		}
+		raws = append(raws, jarmSendProbe(ctx, target, host, probe, timeout, proxies...))
+	}
+	return JARMHash(raws), nil
+}
+
+// JARM 计算目标 TLS 服务的 JARM 指纹并返回指纹与错误，目标无法建立 TLS 握手时返回全 0 的指纹
+// Example:
+// ```
+// jarm, err = tls.JARM("yaklang.io:443")
+// ```
+func JARM(addr string, proxies ...string) (string, error) {
+	return JARMContext(context.Background(), addr, 5*time.Second, proxies...)
+}
@@ -0,0 +1,55 @@
+package netx
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJARMMung(t *testing.T) {
+	odd := []int{1, 2, 3, 4, 5}
+	even := []int{1, 2, 3, 4}
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, jarmMung(odd, "REVERSE"))
+	assert.Equal(t, []int{4, 5}, jarmMung(odd, "BOTTOM_HALF"))
+	assert.Equal(t, []int{3, 4}, jarmMung(even, "BOTTOM_HALF"))
+	assert.Equal(t, []int{3, 2, 1}, jarmMung(odd, "TOP_HALF"))
+	assert.Equal(t, []int{2, 1}, jarmMung(even, "TOP_HALF"))
+	assert.Equal(t, []int{3, 4, 2, 5, 1}, jarmMung(odd, "MIDDLE_OUT"))
+	assert.Equal(t, []int{3, 2, 4, 1}, jarmMung(even, "MIDDLE_OUT"))
+}
+
+func TestJARMHash(t *testing.T) {
+	var raws []string
+	for range jarmProbes {
+		raws = append(raws, "|||")
+	}
+	assert.Equal(t, jarmEmptyHash, JARMHash(raws))
+
+	raws[0] = "c02f|0303|h2|ff01-0000-0010"
+	hash := JARMHash(raws)
+	require.Len(t, hash, 62)
+	assert.True(t, strings.HasPrefix(hash, "29d000000000000000000000000000"))
+}
+
+func TestJARMLocalServer(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+	addr := strings.TrimPrefix(server.URL, "https://")
+
+	first, err := JARM(addr)
+	require.NoError(t, err)
+	require.Len(t, first, 62)
+	assert.NotEqual(t, jarmEmptyHash, first)
+
+	second, err := JARM(addr)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
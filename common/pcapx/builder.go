@@ -7,6 +7,7 @@ import (
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"net"
+	"runtime"
 )
 
 var (
@@ -48,10 +49,12 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		udpConfig   *layers.UDP
 		tcpConfig   *layers.TCP
 		icmp4Config *layers.ICMPv4
+		icmp6Config *layers.ICMPv6
 
 		// link and network
 		arpConfig      *layers.ARP
 		ip4Config      *layers.IPv4
+		ip6Config      *layers.IPv6
 		ethernetConfig *layers.Ethernet
 		loopbackConfig *layers.Loopback
 	)
@@ -83,6 +86,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set icmp4 config failed: %s", err)
 			}
+		case ICMPv6Option:
+			if icmp6Config == nil {
+				icmp6Config = &layers.ICMPv6{}
+			}
+			err := optFunc(icmp6Config)
+			if err != nil {
+				return nil, utils.Errorf("set icmp6 config failed: %s", err)
+			}
 		case ArpConfig:
 			if arpConfig == nil {
 				arpConfig = &layers.ARP{
@@ -104,6 +115,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set ipv4 config failed: %s", err)
 			}
+		case IPv6Option:
+			if ip6Config == nil {
+				ip6Config = NewDefaultIPv6Layer()
+			}
+			err := optFunc(ip6Config)
+			if err != nil {
+				return nil, utils.Errorf("set ipv6 config failed: %s", err)
+			}
 		case EthernetOption:
 			if ethernetConfig == nil {
 				ethernetConfig = &layers.Ethernet{
@@ -142,6 +161,9 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			loopbackConfig = &layers.Loopback{
 				Family: layers.ProtocolFamilyIPv4,
 			}
+			if ip6Config != nil {
+				loopbackConfig.Family = loopbackIPv6Family()
+			}
 		}
 		linkLayer = loopbackConfig
 	} else if ethernetConfig != nil {
@@ -162,22 +184,16 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 	/*
 		check network layer?
 	*/
-	var fetched = false
-	var networkLayerRaw any
+	var networkLayerCount int
 	for _, l := range []any{
-		arpConfig, ip4Config,
+		arpConfig, ip4Config, ip6Config,
 	} {
-		if funk.IsEmpty(l) {
-			if fetched {
-				return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / arp layer")
-			} else {
-				fetched = true
-				networkLayerRaw = l
-			}
+		if !funk.IsEmpty(l) {
+			networkLayerCount++
 		}
 	}
-	if networkLayerRaw == nil {
-		return nil, utils.Errorf("PacketBuilder: network layer is empty")
+	if networkLayerCount > 1 {
+		return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / ipv6 / arp layer")
 	}
 
 	var networkLayer gopacket.SerializableLayer
@@ -186,39 +202,49 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		ipEnabled = true
 		networkLayer = ip4Config
 		if ip4Config.Version == 6 {
-			linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeIPv6
+			setEthernetType(linkLayer, layers.EthernetTypeIPv6)
 		}
+	} else if !funk.IsEmpty(ip6Config) {
+		ipEnabled = true
+		networkLayer = ip6Config
+		setEthernetType(linkLayer, layers.EthernetTypeIPv6)
 	} else if !funk.IsEmpty(arpConfig) {
 		networkLayer = arpConfig
-		linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeARP
+		setEthernetType(linkLayer, layers.EthernetTypeARP)
 	} else {
 		return nil, utils.Errorf("PacketBuilder: network layer is empty")
 	}
-	_ = networkLayer
 
 	var err error
 	if ipEnabled {
 		// TCP/IP Stack!
 		// TransportLayer can be TCP(Default) / ICMP / IGMP / UDP ...
 		var transportLayer gopacket.SerializableLayer
+		var payload = baseConfig.Payload
 	TRANS:
 		if tcpConfig != nil {
 			tcpLayer := tcpConfig
-			ip4Layer := networkLayer.(*layers.IPv4)
-			err := tcpLayer.SetNetworkLayerForChecksum(ip4Layer)
+			err := tcpLayer.SetNetworkLayerForChecksum(networkLayer.(gopacket.NetworkLayer))
 			if err != nil {
 				return nil, utils.Errorf("TCP checksum failed: %s", err)
 			}
-			ip4Layer.Protocol = layers.IPProtocolTCP
+			setIPProtocol(networkLayer, layers.IPProtocolTCP)
 			transportLayer = tcpLayer
 		} else if icmp4Config != nil {
 			transportLayer = icmp4Config
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolICMPv4
+			setIPProtocol(networkLayer, layers.IPProtocolICMPv4)
+		} else if icmp6Config != nil {
+			err := icmp6Config.SetNetworkLayerForChecksum(networkLayer.(gopacket.NetworkLayer))
+			if err != nil {
+				return nil, utils.Errorf("ICMPv6 checksum failed: %s", err)
+			}
+			setIPProtocol(networkLayer, layers.IPProtocolICMPv6)
+			transportLayer = icmp6Config
+			// ICMPv6 的消息体（NDP / Echo）跟在头部之后
+			payload = append(append([]byte{}, icmp6Config.Payload...), payload...)
 		} else if udpConfig != nil {
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolUDP
-			err := udpConfig.SetNetworkLayerForChecksum(ip4Layer)
+			setIPProtocol(networkLayer, layers.IPProtocolUDP)
+			err := udpConfig.SetNetworkLayerForChecksum(networkLayer.(gopacket.NetworkLayer))
 			if err != nil {
 				return nil, utils.Errorf("UDP checksum failed: %s", err)
 			}
@@ -235,7 +261,7 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		var buf = gopacket.NewSerializeBuffer()
 		err = gopacket.SerializeLayers(
 			buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
-			linkLayer, networkLayer, transportLayer, gopacket.Payload(baseConfig.Payload),
+			linkLayer, networkLayer, transportLayer, gopacket.Payload(payload),
 		)
 		if err != nil {
 			return nil, utils.Errorf(`gopacket.SerializeLayers failed: %s`, err)
@@ -259,3 +285,37 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 	}
 	return buf.Bytes(), nil
 }
+
+func setEthernetType(linkLayer gopacket.SerializableLayer, t layers.EthernetType) {
+	switch ret := linkLayer.(type) {
+	case *layers.Ethernet:
+		ret.EthernetType = t
+	case *layers.Loopback:
+		if t == layers.EthernetTypeIPv6 {
+			ret.Family = loopbackIPv6Family()
+		}
+	}
+}
+
+func setIPProtocol(networkLayer gopacket.SerializableLayer, protocol layers.IPProtocol) {
+	switch ret := networkLayer.(type) {
+	case *layers.IPv4:
+		ret.Protocol = protocol
+	case *layers.IPv6:
+		ret.NextHeader = protocol
+	}
+}
+
+// loopbackIPv6Family 返回 BSD loopback 头部中 IPv6 的协议族，不同系统的 AF_INET6 取值不同
+func loopbackIPv6Family() layers.ProtocolFamily {
+	switch runtime.GOOS {
+	case "darwin", "ios":
+		return layers.ProtocolFamilyIPv6Darwin
+	case "freebsd":
+		return layers.ProtocolFamilyIPv6FreeBSD
+	case "linux":
+		return layers.ProtocolFamilyIPv6Linux
+	default:
+		return layers.ProtocolFamilyIPv6BSD
+	}
+}
@@ -0,0 +1,145 @@
+package pcapx
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/go-funk"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	ICMPV6_NA_FLAG_ROUTER    = 0x80
+	ICMPV6_NA_FLAG_SOLICITED = 0x40
+	ICMPV6_NA_FLAG_OVERRIDE  = 0x20
+)
+
+var icmp6LayerExports = map[string]any{
+	"ICMPV6_TYPE_DEST_UNREACH":           layers.ICMPv6TypeDestinationUnreachable,
+	"ICMPV6_TYPE_PACKET_TOO_BIG":         layers.ICMPv6TypePacketTooBig,
+	"ICMPV6_TYPE_TIME_EXCEEDED":          layers.ICMPv6TypeTimeExceeded,
+	"ICMPV6_TYPE_PARAM_PROBLEM":          layers.ICMPv6TypeParameterProblem,
+	"ICMPV6_TYPE_ECHO_REQUEST":           layers.ICMPv6TypeEchoRequest,
+	"ICMPV6_TYPE_ECHO_REPLY":             layers.ICMPv6TypeEchoReply,
+	"ICMPV6_TYPE_ROUTER_SOLICITATION":    layers.ICMPv6TypeRouterSolicitation,
+	"ICMPV6_TYPE_ROUTER_ADVERTISEMENT":   layers.ICMPv6TypeRouterAdvertisement,
+	"ICMPV6_TYPE_NEIGHBOR_SOLICITATION":  layers.ICMPv6TypeNeighborSolicitation,
+	"ICMPV6_TYPE_NEIGHBOR_ADVERTISEMENT": layers.ICMPv6TypeNeighborAdvertisement,
+	"ICMPV6_CODE_UNREACH_NO_ROUTE":       layers.ICMPv6CodeNoRouteToDst,
+	"ICMPV6_CODE_UNREACH_ADMIN":          layers.ICMPv6CodeAdminProhibited,
+	"ICMPV6_CODE_UNREACH_ADDRESS":        layers.ICMPv6CodeAddressUnreachable,
+	"ICMPV6_CODE_UNREACH_PORT":           layers.ICMPv6CodePortUnreachable,
+	"ICMPV6_NA_FLAG_ROUTER":              ICMPV6_NA_FLAG_ROUTER,
+	"ICMPV6_NA_FLAG_SOLICITED":           ICMPV6_NA_FLAG_SOLICITED,
+	"ICMPV6_NA_FLAG_OVERRIDE":            ICMPV6_NA_FLAG_OVERRIDE,
+
+	"icmp6_type":                  WithICMPv6_Type,
+	"icmp6_echo":                  WithICMPv6_Echo,
+	"icmp6_payload":               WithICMPv6_Payload,
+	"icmp6_neighborSolicitation":  WithICMPv6_NeighborSolicitation,
+	"icmp6_neighborAdvertisement": WithICMPv6_NeighborAdvertisement,
+}
+
+func init() {
+	for k, v := range icmp6LayerExports {
+		Exports[k] = v
+	}
+}
+
+// ICMPv6Option 设置 ICMPv6 头部，类型相关的消息体（Echo、NDP 等）保存在 Payload 中，由 PacketBuilder 写在 ICMPv6 头部之后
+type ICMPv6Option func(pv6 *layers.ICMPv6) error
+
+func WithICMPv6_Type(icmpType any, icmpCode any) ICMPv6Option {
+	return func(pv6 *layers.ICMPv6) error {
+		if funk.IsEmpty(icmpCode) {
+			icmpCode = 0
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(uint8(utils.InterfaceToInt(icmpType)), uint8(utils.InterfaceToInt(icmpCode)))
+		return nil
+	}
+}
+
+func WithICMPv6_Payload(i []byte) ICMPv6Option {
+	return func(pv6 *layers.ICMPv6) error {
+		pv6.Payload = i
+		return nil
+	}
+}
+
+// WithICMPv6_Echo 生成 Echo Request 消息
+func WithICMPv6_Echo(id any, seq any, data []byte) ICMPv6Option {
+	return func(pv6 *layers.ICMPv6) error {
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0)
+		body := make([]byte, 4, 4+len(data))
+		binary.BigEndian.PutUint16(body, uint16(utils.InterfaceToInt(id)))
+		binary.BigEndian.PutUint16(body[2:], uint16(utils.InterfaceToInt(seq)))
+		pv6.Payload = append(body, data...)
+		return nil
+	}
+}
+
+// WithICMPv6_NeighborSolicitation 生成 NDP 邻居请求，srcMac 作为 Source Link-Layer Address 选项携带
+// 目的地址一般是 target 的 solicited-node 组播地址，参考 SolicitedNodeMulticast
+func WithICMPv6_NeighborSolicitation(target any, srcMac any) ICMPv6Option {
+	return func(pv6 *layers.ICMPv6) error {
+		targetIP := net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(target)))
+		if targetIP == nil || targetIP.To4() != nil {
+			return utils.Errorf("invalid ipv6 target: %v", target)
+		}
+		ns := &layers.ICMPv6NeighborSolicitation{TargetAddress: targetIP}
+		if !funk.IsEmpty(srcMac) {
+			mac, err := parseHardwareAddr(srcMac)
+			if err != nil {
+				return err
+			}
+			ns.Options = append(ns.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptSourceAddress, Data: mac})
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0)
+		return serializeICMPv6Body(pv6, ns)
+	}
+}
+
+// WithICMPv6_NeighborAdvertisement 生成 NDP 邻居通告，mac 作为 Target Link-Layer Address 选项携带
+func WithICMPv6_NeighborAdvertisement(target any, mac any, flags any) ICMPv6Option {
+	return func(pv6 *layers.ICMPv6) error {
+		targetIP := net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(target)))
+		if targetIP == nil || targetIP.To4() != nil {
+			return utils.Errorf("invalid ipv6 target: %v", target)
+		}
+		na := &layers.ICMPv6NeighborAdvertisement{
+			Flags:         uint8(utils.InterfaceToInt(flags)),
+			TargetAddress: targetIP,
+		}
+		if !funk.IsEmpty(mac) {
+			hw, err := parseHardwareAddr(mac)
+			if err != nil {
+				return err
+			}
+			na.Options = append(na.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptTargetAddress, Data: hw})
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)
+		return serializeICMPv6Body(pv6, na)
+	}
+}
+
+func serializeICMPv6Body(pv6 *layers.ICMPv6, body gopacket.SerializableLayer) error {
+	buf := gopacket.NewSerializeBuffer()
+	if err := body.SerializeTo(buf, gopacket.SerializeOptions{FixLengths: true}); err != nil {
+		return utils.Errorf("serialize icmpv6 %v failed: %s", body.LayerType(), err)
+	}
+	pv6.Payload = buf.Bytes()
+	return nil
+}
+
+func parseHardwareAddr(i any) (net.HardwareAddr, error) {
+	if mac, ok := i.(net.HardwareAddr); ok {
+		return mac, nil
+	}
+	mac, err := net.ParseMAC(utils.InterfaceToString(i))
+	if err != nil {
+		return nil, utils.Errorf("parse %v to mac failed: %s", i, err)
+	}
+	return mac, nil
+}
@@ -0,0 +1,70 @@
+package pcapx
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmoking_NDP(t *testing.T) {
+	srcMac := net.HardwareAddr{0x00, 0x0c, 0x29, 0x00, 0x00, 0x01}
+	target := net.ParseIP("fe80::20c:29ff:fe12:3456")
+	group := SolicitedNodeMulticast(target)
+	require.Equal(t, "ff02::1:ff12:3456", group.String())
+	require.Equal(t, "33:33:ff:12:34:56", IPv6MulticastMac(group).String())
+
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac(srcMac),
+		WithEthernet_DstMac(IPv6MulticastMac(group)),
+		WithIPv6_SrcIP("fe80::20c:29ff:fe00:1"),
+		WithIPv6_DstIP(group),
+		WithIPv6_HopLimit(255),
+		WithICMPv6_NeighborSolicitation(target, srcMac),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	ns, ok := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	require.True(t, ok)
+	require.True(t, ns.TargetAddress.Equal(target))
+	require.Equal(t, layers.ICMPv6OptSourceAddress, ns.Options[0].Type)
+	require.Equal(t, []byte(srcMac), ns.Options[0].Data)
+
+	packets, err = PacketBuilder(
+		WithEthernet_SrcMac("00:0c:29:12:34:56"),
+		WithEthernet_DstMac(srcMac),
+		WithIPv6_SrcIP(target),
+		WithIPv6_DstIP("fe80::20c:29ff:fe00:1"),
+		WithIPv6_HopLimit(255),
+		WithICMPv6_NeighborAdvertisement(target, "00:0c:29:12:34:56", ICMPV6_NA_FLAG_SOLICITED|ICMPV6_NA_FLAG_OVERRIDE),
+	)
+	require.NoError(t, err)
+	packet = gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	na, ok := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement).(*layers.ICMPv6NeighborAdvertisement)
+	require.True(t, ok)
+	require.True(t, na.Solicited())
+	require.Equal(t, "00:0c:29:12:34:56", net.HardwareAddr(na.Options[0].Data).String())
+}
+
+func TestSmoking_ICMPv6Echo(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:0c:29:00:00:01"),
+		WithEthernet_DstMac("00:0c:29:00:00:02"),
+		WithIPv6_SrcIP("fd00::1"),
+		WithIPv6_DstIP("fd00::2"),
+		WithICMPv6_Echo(1, 2, []byte("ping")),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	echo, ok := packet.Layer(layers.LayerTypeICMPv6Echo).(*layers.ICMPv6Echo)
+	require.True(t, ok)
+	require.Equal(t, uint16(1), echo.Identifier)
+	require.Equal(t, uint16(2), echo.SeqNumber)
+	// gopacket 解析 Echo 时不保留数据部分，从 ICMPv6 的载荷中读取
+	require.Equal(t, []byte("ping"), packet.Layer(layers.LayerTypeICMPv6).LayerPayload()[4:])
+}
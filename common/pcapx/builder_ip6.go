@@ -0,0 +1,149 @@
+package pcapx
+
+import (
+	"net"
+	"strings"
+
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var ipv6LayerExports = map[string]any{
+	"ipv6_trafficClass":      WithIPv6_TrafficClass,
+	"ipv6_flowLabel":         WithIPv6_FlowLabel,
+	"ipv6_nextLayerProtocol": WithIPv6_NextHeader,
+	"ipv6_hopLimit":          WithIPv6_HopLimit,
+	"ipv6_srcIp":             WithIPv6_SrcIP,
+	"ipv6_dstIp":             WithIPv6_DstIP,
+}
+
+func init() {
+	for k, v := range ipv6LayerExports {
+		Exports[k] = v
+	}
+}
+
+type IPv6Option func(pv6 *layers.IPv6) error
+
+func NewDefaultIPv6Layer() *layers.IPv6 {
+	return &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolTCP,
+	}
+}
+
+/*
+// IPv6 is the layer for the IPv6 header.
+type IPv6 struct {
+	BaseLayer
+	Version      uint8
+	TrafficClass uint8
+	FlowLabel    uint32
+	Length       uint16
+	NextHeader   IPProtocol
+	HopLimit     uint8
+	SrcIP        net.IP
+	DstIP        net.IP
+	HopByHop     *IPv6HopByHop
+}
+
+一般来说，不需要操作的字段有：Length
+*/
+
+func WithIPv6_TrafficClass(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.TrafficClass = uint8(utils.InterfaceToInt(i))
+		return nil
+	}
+}
+
+func WithIPv6_FlowLabel(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		// flow label 只有 20 bit
+		pv6.FlowLabel = uint32(utils.InterfaceToInt(i)) & 0xfffff
+		return nil
+	}
+}
+
+func WithIPv6_HopLimit(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.HopLimit = uint8(utils.InterfaceToInt(i))
+		return nil
+	}
+}
+
+func WithIPv6_SrcIP(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.SrcIP = net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(i)))
+		if pv6.SrcIP == nil || pv6.SrcIP.To4() != nil {
+			return utils.Errorf("WithIPv6_SrcIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+func WithIPv6_DstIP(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.DstIP = net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(i)))
+		if pv6.DstIP == nil || pv6.DstIP.To4() != nil {
+			return utils.Errorf("WithIPv6_DstIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+func WithIPv6_NextHeader(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		switch ret := i.(type) {
+		case layers.IPProtocol:
+			pv6.NextHeader = ret
+			return nil
+		}
+		switch strings.ToLower(utils.InterfaceToString(i)) {
+		case "tcp":
+			pv6.NextHeader = layers.IPProtocolTCP
+		case "udp":
+			pv6.NextHeader = layers.IPProtocolUDP
+		case "icmp", "icmp6", "icmpv6", "icmp_v6", "ipv6_icmp":
+			pv6.NextHeader = layers.IPProtocolICMPv6
+		case "ipv6_hop_by_hop":
+			pv6.NextHeader = layers.IPProtocolIPv6HopByHop
+		case "ipv6_routing":
+			pv6.NextHeader = layers.IPProtocolIPv6Routing
+		case "ipv6_fragment":
+			pv6.NextHeader = layers.IPProtocolIPv6Fragment
+		case "ipv6_destination":
+			pv6.NextHeader = layers.IPProtocolIPv6Destination
+		case "no_next_header":
+			pv6.NextHeader = layers.IPProtocolNoNextHeader
+		case "sctp":
+			pv6.NextHeader = layers.IPProtocolSCTP
+		default:
+			if utils.MatchAllOfRegexp(i, `\d+`) {
+				pv6.NextHeader = layers.IPProtocol(utils.InterfaceToInt(i))
+				return nil
+			}
+			return utils.Errorf("unknown parse ipv6 next header: %v", i)
+		}
+		return nil
+	}
+}
+
+// SolicitedNodeMulticast 返回 IPv6 地址对应的 solicited-node 组播地址（ff02::1:ffXX:XXXX），NDP 邻居请求发往该地址
+func SolicitedNodeMulticast(ip net.IP) net.IP {
+	ip = ip.To16()
+	if ip == nil {
+		return nil
+	}
+	return net.IP{0xff, 0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0xff, ip[13], ip[14], ip[15]}
+}
+
+// IPv6MulticastMac 返回 IPv6 组播地址对应的以太网地址（33:33:XX:XX:XX:XX）
+func IPv6MulticastMac(ip net.IP) net.HardwareAddr {
+	ip = ip.To16()
+	if ip == nil {
+		return nil
+	}
+	return net.HardwareAddr{0x33, 0x33, ip[12], ip[13], ip[14], ip[15]}
+}
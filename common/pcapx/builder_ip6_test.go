@@ -0,0 +1,65 @@
+package pcapx
+
+import (
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmoking_IPv6TCP(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:0c:29:00:00:01"),
+		WithEthernet_DstMac("00:0c:29:00:00:02"),
+		WithIPv6_SrcIP("fd00::1"),
+		WithIPv6_DstIP("fd00::2"),
+		WithTCP_SrcPort(40000),
+		WithTCP_DstPort(443),
+		WithTCP_Flags(TCP_FLAG_SYN),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	require.Equal(t, layers.EthernetTypeIPv6, packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).EthernetType)
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, layers.IPProtocolTCP, ip6.NextHeader)
+	require.Equal(t, "fd00::2", ip6.DstIP.String())
+	tcp := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	require.True(t, tcp.SYN)
+	require.Equal(t, layers.TCPPort(443), tcp.DstPort)
+
+	// 重新计算校验和，结果应当一致
+	checksum := tcp.Checksum
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip6))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}, tcp))
+	require.Equal(t, checksum, tcp.Checksum)
+}
+
+func TestSmoking_IPv6UDP(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:0c:29:00:00:01"),
+		WithEthernet_DstMac("00:0c:29:00:00:02"),
+		WithIPv6_SrcIP("fd00::1"),
+		WithIPv6_DstIP("fd00::2"),
+		WithUDP_SrcPort(40000),
+		WithUDP_DstPort(40001),
+		WithPayload([]byte("hello")),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	require.Equal(t, layers.IPProtocolUDP, packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6).NextHeader)
+	require.Equal(t, []byte("hello"), packet.Layer(layers.LayerTypeUDP).(*layers.UDP).Payload)
+}
+
+func TestPacketBuilder_MultiNetworkLayer(t *testing.T) {
+	_, err := PacketBuilder(
+		WithEthernet_SrcMac("00:0c:29:00:00:01"),
+		WithEthernet_DstMac("00:0c:29:00:00:02"),
+		WithIPv4_SrcIP("1.1.1.1"),
+		WithIPv6_DstIP("fd00::2"),
+	)
+	require.Error(t, err)
+}
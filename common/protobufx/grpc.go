@@ -0,0 +1,537 @@
+package protobufx
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// BodyKind 是 HTTP 报文中 protobuf 数据的承载方式
+type BodyKind string
+
+const (
+	// BodyProtobuf 请求体就是一个 protobuf 消息，如 application/x-protobuf
+	BodyProtobuf BodyKind = "protobuf"
+	// BodyGRPC 是 application/grpc，消息使用 5 字节的长度前缀分帧
+	BodyGRPC BodyKind = "grpc"
+	// BodyGRPCWeb 是 application/grpc-web，分帧方式与 gRPC 相同，响应末尾可能带有 trailer 帧
+	BodyGRPCWeb BodyKind = "grpc-web"
+	// BodyGRPCWebText 是 application/grpc-web-text，grpc-web 数据再经过 base64 编码
+	BodyGRPCWebText BodyKind = "grpc-web-text"
+)
+
+const (
+	grpcFlagCompressed = 0x01
+	grpcFlagTrailer    = 0x80
+)
+
+// ProtobufBodyKind 根据 Content-Type 判断请求体是否为 protobuf 数据
+func ProtobufBodyKind(contentType string) (BodyKind, bool) {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = strings.TrimSpace(contentType[:i])
+	}
+	// application/grpc+json 等非 protobuf 编码不处理
+	if i := strings.IndexByte(contentType, '+'); i >= 0 {
+		if contentType[i+1:] != "proto" {
+			return "", false
+		}
+		contentType = contentType[:i]
+	}
+	switch contentType {
+	case "application/grpc":
+		return BodyGRPC, true
+	case "application/grpc-web":
+		return BodyGRPCWeb, true
+	case "application/grpc-web-text":
+		return BodyGRPCWebText, true
+	case "application/x-protobuf", "application/protobuf", "application/x-google-protobuf",
+		"application/vnd.google.protobuf", "application/octet-stream+protobuf":
+		return BodyProtobuf, true
+	}
+	return "", false
+}
+
+// IsProtobufContentType 判断 Content-Type 是否为 gRPC / grpc-web / protobuf
+func IsProtobufContentType(contentType string) bool {
+	_, ok := ProtobufBodyKind(contentType)
+	return ok
+}
+
+// Frame 是 gRPC 中的一个消息帧，protobuf 请求体只有一个帧
+type Frame struct {
+	Compressed bool
+	// grpc-web 响应末尾的 trailer 帧，Data 为 HTTP 头格式的文本
+	Trailer bool
+	// 解压后的数据
+	Data []byte
+	// 成功解码时的消息，为 nil 时编码使用 Data
+	Message *Message
+}
+
+// Body 是解码后的 protobuf 请求体或响应体
+type Body struct {
+	Kind BodyKind
+	// grpc-encoding，压缩帧使用该算法解压与压缩
+	Encoding string
+	Frames   []*Frame
+}
+
+type config struct {
+	schema      *Schema
+	messageType string
+	methodPath  string
+}
+
+// Option 是解码 protobuf 报文时的选项
+type Option func(*config)
+
+// WithSchema 使用 Schema 解码，得到字段名、枚举名以及正确的数值类型
+func WithSchema(s *Schema) Option {
+	return func(c *config) {
+		c.schema = s
+	}
+}
+
+// WithMessageType 指定消息类型，未指定时根据 gRPC 请求路径在 Schema 中查找方法的输入 / 输出类型
+func WithMessageType(name string) Option {
+	return func(c *config) {
+		c.messageType = name
+	}
+}
+
+// WithMethodPath 指定 gRPC 请求路径，用于解码响应时查找方法的输出类型
+func WithMethodPath(path string) Option {
+	return func(c *config) {
+		c.methodPath = path
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// messageTypeFor 返回用于解码的消息类型，isResponse 决定使用方法的输入还是输出类型
+func (c *config) messageTypeFor(isResponse bool) *MessageType {
+	if c.schema == nil {
+		return nil
+	}
+	name := c.messageType
+	if name == "" && c.methodPath != "" {
+		if method, ok := c.schema.Method(c.methodPath); ok {
+			name = method.Input
+			if isResponse {
+				name = method.Output
+			}
+		}
+	}
+	if name == "" {
+		return nil
+	}
+	mt, _ := c.schema.Message(name)
+	return mt
+}
+
+func (c *config) decodeMessage(raw []byte, mt *MessageType) (*Message, error) {
+	msg, err := Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	if mt != nil {
+		c.schema.annotate(msg, mt, 0)
+	}
+	return msg, nil
+}
+
+// DecodeBody 按 kind 拆分并解码 protobuf 数据，无法解码为 protobuf 的帧保留原始数据
+func DecodeBody(kind BodyKind, encoding string, body []byte, opts ...Option) (*Body, error) {
+	return decodeBody(kind, encoding, body, newConfig(opts), false)
+}
+
+func decodeBody(kind BodyKind, encoding string, body []byte, c *config, isResponse bool) (*Body, error) {
+	mt := c.messageTypeFor(isResponse)
+	b := &Body{Kind: kind, Encoding: strings.ToLower(strings.TrimSpace(encoding))}
+	switch kind {
+	case BodyProtobuf:
+		msg, err := c.decodeMessage(body, mt)
+		if err != nil {
+			return nil, err
+		}
+		b.Frames = []*Frame{{Data: body, Message: msg}}
+		return b, nil
+	case BodyGRPCWebText:
+		var err error
+		body, err = decodeGRPCWebText(body)
+		if err != nil {
+			return nil, err
+		}
+	case BodyGRPC, BodyGRPCWeb:
+	default:
+		return nil, utils.Errorf("unsupported protobuf body kind: %v", kind)
+	}
+
+	for len(body) > 0 {
+		if len(body) < 5 {
+			return nil, utils.Errorf("grpc frame header too short: %d bytes", len(body))
+		}
+		flag, size := body[0], binary.BigEndian.Uint32(body[1:5])
+		if uint64(size) > uint64(len(body)-5) {
+			return nil, utils.Errorf("grpc frame length %d exceeds body size %d", size, len(body)-5)
+		}
+		frame := &Frame{
+			Compressed: flag&grpcFlagCompressed != 0,
+			Trailer:    flag&grpcFlagTrailer != 0,
+			Data:       body[5 : 5+size],
+		}
+		body = body[5+size:]
+		if frame.Compressed {
+			data, err := grpcDecompress(b.Encoding, frame.Data)
+			if err != nil {
+				return nil, err
+			}
+			frame.Data = data
+		}
+		if !frame.Trailer {
+			frame.Message, _ = c.decodeMessage(frame.Data, mt)
+		}
+		b.Frames = append(b.Frames, frame)
+	}
+	return b, nil
+}
+
+// decodeGRPCWebText 解码 grpc-web-text，流式响应可能由多段带填充的 base64 拼接而成
+func decodeGRPCWebText(body []byte) ([]byte, error) {
+	text := strings.Map(func(r rune) rune {
+		if r == '\r' || r == '\n' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, string(body))
+	var result []byte
+	for len(text) > 0 {
+		end := strings.IndexByte(text, '=')
+		if end < 0 {
+			end = len(text)
+		} else {
+			for end < len(text) && text[end] == '=' {
+				end++
+			}
+		}
+		chunk, err := base64.StdEncoding.DecodeString(text[:end])
+		if err != nil {
+			return nil, utils.Wrap(err, "decode grpc-web-text failed")
+		}
+		result = append(result, chunk...)
+		text = text[end:]
+	}
+	return result, nil
+}
+
+func grpcDecompress(encoding string, data []byte) ([]byte, error) {
+	var reader io.Reader
+	switch encoding {
+	case "gzip":
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, utils.Wrap(err, "grpc gzip decompress failed")
+		}
+		reader = r
+	case "deflate", "zlib":
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, utils.Wrap(err, "grpc deflate decompress failed")
+		}
+		reader = r
+	case "snappy":
+		data, err := snappy.Decode(nil, data)
+		if err != nil {
+			return nil, utils.Wrap(err, "grpc snappy decompress failed")
+		}
+		return data, nil
+	default:
+		return nil, utils.Errorf("unsupported grpc-encoding %q for compressed frame", encoding)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, utils.Wrapf(err, "grpc %v decompress failed", encoding)
+	}
+	return data, nil
+}
+
+func grpcCompress(encoding string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch encoding {
+	case "gzip":
+		writer = gzip.NewWriter(&buf)
+	case "deflate", "zlib":
+		w, err := zlib.NewWriterLevel(&buf, flate.DefaultCompression)
+		if err != nil {
+			return nil, err
+		}
+		writer = w
+	case "snappy":
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, utils.Errorf("unsupported grpc-encoding %q for compressed frame", encoding)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode 重新编码请求体，gRPC 帧会重新计算长度前缀，压缩帧使用 Encoding 重新压缩
+func (b *Body) Encode() ([]byte, error) {
+	if b.Kind == BodyProtobuf {
+		var buf []byte
+		for _, frame := range b.Frames {
+			buf = append(buf, frame.payload()...)
+		}
+		return buf, nil
+	}
+
+	var buf bytes.Buffer
+	for _, frame := range b.Frames {
+		data := frame.payload()
+		var flag byte
+		if frame.Trailer {
+			flag |= grpcFlagTrailer
+		}
+		if frame.Compressed {
+			flag |= grpcFlagCompressed
+			compressed, err := grpcCompress(b.Encoding, data)
+			if err != nil {
+				return nil, err
+			}
+			data = compressed
+		}
+		var header [5]byte
+		header[0] = flag
+		binary.BigEndian.PutUint32(header[1:], uint32(len(data)))
+		buf.Write(header[:])
+		buf.Write(data)
+	}
+	if b.Kind == BodyGRPCWebText {
+		return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+	}
+	return buf.Bytes(), nil
+}
+
+func (f *Frame) payload() []byte {
+	if f.Message != nil {
+		return f.Message.Encode()
+	}
+	return f.Data
+}
+
+// Messages 返回所有成功解码的消息
+func (b *Body) Messages() []*Message {
+	var messages []*Message
+	for _, frame := range b.Frames {
+		if frame.Message != nil {
+			messages = append(messages, frame.Message)
+		}
+	}
+	return messages
+}
+
+// String 将请求体转换为可编辑的文本，protobuf 请求体即消息本身的文本格式，gRPC 请求体中每个帧为一段：
+//
+//	message {
+//	  1: "yaklang"
+//	}
+//	compressed message { ... }
+//	raw bytes(00ff)
+//	trailer "grpc-status: 0\r\n"
+func (b *Body) String() string {
+	if b.Kind == BodyProtobuf && len(b.Frames) == 1 && b.Frames[0].Message != nil {
+		return b.Frames[0].Message.String()
+	}
+	var buf strings.Builder
+	for _, frame := range b.Frames {
+		if frame.Compressed {
+			buf.WriteString("compressed ")
+		}
+		switch {
+		case frame.Trailer:
+			buf.WriteString("trailer " + strconv.Quote(string(frame.Data)) + "\n")
+		case frame.Message != nil:
+			buf.WriteString("message {")
+			writeComment(&buf, frame.Message.TypeName)
+			writeFields(&buf, frame.Message, "  ")
+			buf.WriteString("}\n")
+		default:
+			buf.WriteString("raw bytes(" + hex.EncodeToString(frame.Data) + ")\n")
+		}
+	}
+	return buf.String()
+}
+
+// FuzzTagText 返回使用编码 fuzztag 包裹的文本，渲染 fuzztag 后得到重新编码的数据，
+// 用于在 Web Fuzzer 与 MITM 劫持中直接编辑消息；文本中的帧不再压缩，因此不依赖 grpc-encoding
+func (b *Body) FuzzTagText() string {
+	if b.Kind == BodyProtobuf {
+		return "{{protobuf:encode(" + b.String() + ")}}"
+	}
+	plain := &Body{Kind: b.Kind, Encoding: b.Encoding}
+	for _, frame := range b.Frames {
+		f := *frame
+		f.Compressed = false
+		plain.Frames = append(plain.Frames, &f)
+	}
+	text := "{{grpc:encode(" + plain.String() + ")}}"
+	if b.Kind == BodyGRPCWebText {
+		text = "{{base64enc(" + text + ")}}"
+	}
+	return text
+}
+
+// ParseBodyText 解析 Body.String 生成的文本，得到可以重新编码的请求体
+func ParseBodyText(kind BodyKind, encoding string, text string, opts ...Option) (*Body, error) {
+	return parseBodyText(kind, encoding, text, newConfig(opts), false)
+}
+
+func parseBodyText(kind BodyKind, encoding string, text string, c *config, isResponse bool) (*Body, error) {
+	mt := c.messageTypeFor(isResponse)
+	b := &Body{Kind: kind, Encoding: strings.ToLower(strings.TrimSpace(encoding))}
+	p, err := newTextParser(text, c.schema)
+	if err != nil {
+		return nil, err
+	}
+	if kind == BodyProtobuf && !p.is("message") && !p.is("raw") {
+		msg, err := p.parseMessage(mt, "")
+		if err != nil {
+			return nil, err
+		}
+		b.Frames = []*Frame{{Message: msg}}
+		return b, nil
+	}
+
+	for !p.eof() {
+		frame := &Frame{}
+		if p.is("compressed") {
+			frame.Compressed = true
+			p.pos++
+		}
+		switch {
+		case p.is("message"):
+			p.pos++
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+			if frame.Message, err = p.parseMessage(mt, "}"); err != nil {
+				return nil, err
+			}
+		case p.is("raw"):
+			p.pos++
+			raw := &Field{}
+			if err := p.parseScalar(raw, nil); err != nil {
+				return nil, err
+			}
+			if raw.Kind != KindBytes && raw.Kind != KindString {
+				return nil, p.errorf("raw frame must be bytes(...) or a string")
+			}
+			frame.Data = raw.Bytes
+		case p.is("trailer"):
+			p.pos++
+			tok := p.next()
+			if !tok.quoted {
+				p.pos--
+				return nil, p.errorf("trailer frame must be a string")
+			}
+			frame.Trailer, frame.Data = true, []byte(tok.text)
+		default:
+			return nil, p.errorf("expect message, raw or trailer but got %q", p.peek().text)
+		}
+		b.Frames = append(b.Frames, frame)
+	}
+	if kind == BodyProtobuf && len(b.Frames) > 1 {
+		return nil, utils.Error("protobuf body can only contain one message")
+	}
+	return b, nil
+}
+
+// httpPacketBody 返回报文中 protobuf 数据的类型、grpc-encoding、去除传输编码后的请求体，以及报文是否为响应
+func httpPacketBody(packet []byte) (BodyKind, string, []byte, bool, error) {
+	kind, ok := ProtobufBodyKind(lowhttp.GetHTTPPacketHeader(packet, "Content-Type"))
+	if !ok {
+		return "", "", nil, false, utils.Error("http packet is not grpc or protobuf")
+	}
+	encoding := lowhttp.GetHTTPPacketHeader(packet, "grpc-encoding")
+	isResponse := bytes.HasPrefix(bytes.TrimSpace(packet), []byte("HTTP/"))
+	return kind, encoding, lowhttp.GetHTTPPacketBody(lowhttp.DeletePacketEncoding(packet)), isResponse, nil
+}
+
+func (c *config) withPacketPath(packet []byte, isResponse bool) {
+	if c.methodPath == "" && !isResponse {
+		c.methodPath = lowhttp.GetHTTPRequestPathWithoutQuery(packet)
+	}
+}
+
+// DecodeHTTPPacket 解码 gRPC / grpc-web / protobuf 请求或响应中的消息
+// 使用 Schema 时，请求根据请求路径查找 gRPC 方法的输入类型，响应需要通过 WithMethodPath 或 WithMessageType 指定类型
+func DecodeHTTPPacket(packet []byte, opts ...Option) (*Body, error) {
+	kind, encoding, body, isResponse, err := httpPacketBody(packet)
+	if err != nil {
+		return nil, err
+	}
+	c := newConfig(opts)
+	c.withPacketPath(packet, isResponse)
+	return decodeBody(kind, encoding, body, c, isResponse)
+}
+
+// EncodeHTTPPacket 将 DecodeHTTPPacket 得到的文本重新编码，替换报文的请求体并修正长度
+func EncodeHTTPPacket(packet []byte, text string, opts ...Option) ([]byte, error) {
+	kind, encoding, _, isResponse, err := httpPacketBody(packet)
+	if err != nil {
+		return nil, err
+	}
+	c := newConfig(opts)
+	c.withPacketPath(packet, isResponse)
+	b, err := parseBodyText(kind, encoding, text, c, isResponse)
+	if err != nil {
+		return nil, err
+	}
+	return ReplaceHTTPPacketBody(packet, b)
+}
+
+// ConvertHTTPPacketToFuzzTag 将 gRPC / grpc-web / protobuf 报文的请求体替换为 FuzzTagText，
+// 报文不是 protobuf 或者没有可以解码的消息时返回错误
+func ConvertHTTPPacketToFuzzTag(packet []byte, opts ...Option) ([]byte, error) {
+	b, err := DecodeHTTPPacket(packet, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.Messages()) <= 0 {
+		return nil, utils.Error("no protobuf message in body")
+	}
+	return lowhttp.ReplaceHTTPPacketBodyFast(lowhttp.DeletePacketEncoding(packet), []byte(b.FuzzTagText())), nil
+}
+
+// ReplaceHTTPPacketBody 使用 Body 重新编码后的数据替换报文的请求体
+func ReplaceHTTPPacketBody(packet []byte, b *Body) ([]byte, error) {
+	raw, err := b.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return lowhttp.ReplaceHTTPPacketBodyFast(lowhttp.DeletePacketEncoding(packet), raw), nil
+}
@@ -0,0 +1,147 @@
+package protobufx
+
+import (
+	"strconv"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Leaf 是消息中的一个标量值，用于逐个字段地修改或模糊测试
+type Leaf struct {
+	// 由字段号组成的路径，如 1.3、2[1].1，多帧的 gRPC 请求体以帧序号开头，如 [0].1
+	Path string
+	// 由字段名组成的路径，只有在 Schema 中能找到全部字段名时才有值
+	NamePath string
+	Field    *Field
+
+	packed bool
+}
+
+// Name 返回叶子节点的字段名，没有 Schema 时为字段号
+func (l *Leaf) Name() string {
+	if l.Field.Name != "" {
+		return l.Field.Name
+	}
+	return strconv.Itoa(int(l.Field.Number))
+}
+
+// Value 返回用于展示的值，string / bytes 字段返回字符串
+func (l *Leaf) Value() any {
+	switch l.Field.Kind {
+	case KindBytes:
+		return string(l.Field.Bytes)
+	case KindEnum:
+		if name := l.Field.enumName(); name != "" {
+			return name
+		}
+	}
+	return l.Field.Value()
+}
+
+// Set 修改叶子节点的值，规则与 Field.SetValue 一致；packed 字段中的元素只能设置为对应类型的数值
+func (l *Leaf) Set(value string) error {
+	if !l.packed {
+		if l.Field.Kind == KindEnum && l.Field.enum != nil {
+			if v, ok := l.Field.enum.byName[value]; ok {
+				l.Field.Varint = uint64(int64(v))
+				return nil
+			}
+		}
+		l.Field.SetValue(value)
+		return nil
+	}
+	if err := l.Field.setScalar(l.Field.Kind, value); err != nil {
+		return utils.Wrapf(err, "set packed field %v failed", l.Path)
+	}
+	return nil
+}
+
+// Leaves 返回消息中所有的标量值，嵌套消息与 group 会展开
+func (m *Message) Leaves() []*Leaf {
+	var leaves []*Leaf
+	m.walkLeaves("", "", true, func(leaf *Leaf) {
+		leaves = append(leaves, leaf)
+	})
+	return leaves
+}
+
+func (m *Message) walkLeaves(path, namePath string, named bool, call func(*Leaf)) {
+	if m == nil {
+		return
+	}
+	counts := make(map[int32]int)
+	for _, f := range m.Fields {
+		counts[int32(f.Number)]++
+	}
+	seen := make(map[int32]int)
+	for _, f := range m.Fields {
+		segment := strconv.Itoa(int(f.Number))
+		nameSegment := f.Name
+		if counts[int32(f.Number)] > 1 {
+			index := "[" + strconv.Itoa(seen[int32(f.Number)]) + "]"
+			segment += index
+			nameSegment += index
+		}
+		seen[int32(f.Number)]++
+
+		fieldPath := joinPath(path, segment)
+		fieldNamed := named && f.Name != ""
+		fieldNamePath := ""
+		if fieldNamed {
+			fieldNamePath = joinPath(namePath, nameSegment)
+		}
+
+		switch f.Kind {
+		case KindMessage, KindGroup:
+			if f.Message != nil {
+				f.Message.walkLeaves(fieldPath, fieldNamePath, fieldNamed, call)
+				continue
+			}
+		case KindPacked:
+			for i, item := range f.Packed {
+				index := "[" + strconv.Itoa(i) + "]"
+				leaf := &Leaf{Path: fieldPath + index, Field: item, packed: true}
+				if fieldNamed {
+					leaf.NamePath = fieldNamePath + index
+				}
+				item.Number, item.Name = f.Number, f.Name
+				call(leaf)
+			}
+			continue
+		}
+		call(&Leaf{Path: fieldPath, NamePath: fieldNamePath, Field: f})
+	}
+}
+
+func joinPath(prefix, segment string) string {
+	if prefix == "" {
+		return segment
+	}
+	return prefix + "." + segment
+}
+
+// Leaves 返回请求体中所有消息的标量值，多于一个消息帧时路径以帧序号开头
+func (b *Body) Leaves() []*Leaf {
+	var leaves []*Leaf
+	messages := b.Messages()
+	for i, msg := range messages {
+		prefix := ""
+		if len(messages) > 1 {
+			prefix = "[" + strconv.Itoa(i) + "]"
+		}
+		msg.walkLeaves(prefix, prefix, true, func(leaf *Leaf) {
+			leaves = append(leaves, leaf)
+		})
+	}
+	return leaves
+}
+
+// Leaf 按字段号路径或字段名路径查找叶子节点
+func (b *Body) Leaf(path string) (*Leaf, bool) {
+	for _, leaf := range b.Leaves() {
+		if leaf.Path == path || (leaf.NamePath != "" && leaf.NamePath == path) {
+			return leaf, true
+		}
+	}
+	return nil, false
+}
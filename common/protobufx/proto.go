@@ -0,0 +1,647 @@
+package protobufx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ParseProto 解析 .proto 源码并生成 Schema，多个文件之间可以互相引用类型
+// 只提取解码需要的信息：消息、字段、枚举、map、oneof、group 与 service，option / import 等语句会被忽略，
+// 找不到定义的类型（例如来自未提供的 import 文件）按消息处理
+func ParseProto(sources ...string) (*Schema, error) {
+	s := NewSchema()
+	var refs []*protoTypeRef
+	for index, source := range sources {
+		p := &protoParser{schema: s}
+		if err := p.tokenize(source); err != nil {
+			return nil, utils.Wrapf(err, "parse proto file #%d failed", index)
+		}
+		if err := p.parseFile(); err != nil {
+			return nil, utils.Wrapf(err, "parse proto file #%d failed", index)
+		}
+		refs = append(refs, p.refs...)
+	}
+	for _, ref := range refs {
+		ref.resolve(s)
+	}
+	return s, nil
+}
+
+var protoScalarTypes = map[string]struct{}{
+	"double": {}, "float": {}, "int32": {}, "int64": {}, "uint32": {}, "uint64": {},
+	"sint32": {}, "sint64": {}, "fixed32": {}, "fixed64": {}, "sfixed32": {}, "sfixed64": {},
+	"bool": {}, "string": {}, "bytes": {},
+}
+
+// protoTypeRef 是一个等待解析的类型引用，所有文件解析完成后按 protobuf 的作用域规则查找
+type protoTypeRef struct {
+	name  string
+	scope string
+	set   func(typ, typeName string)
+}
+
+func (r *protoTypeRef) resolve(s *Schema) {
+	name := r.name
+	var candidates []string
+	if strings.HasPrefix(name, ".") {
+		candidates = []string{strings.TrimPrefix(name, ".")}
+	} else {
+		scope := r.scope
+		for {
+			candidates = append(candidates, joinName(scope, name))
+			if scope == "" {
+				break
+			}
+			if i := strings.LastIndexByte(scope, '.'); i >= 0 {
+				scope = scope[:i]
+			} else {
+				scope = ""
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		if _, ok := s.Messages[candidate]; ok {
+			r.set("message", candidate)
+			return
+		}
+		if _, ok := s.Enums[candidate]; ok {
+			r.set("enum", candidate)
+			return
+		}
+	}
+	r.set("message", strings.TrimPrefix(name, "."))
+}
+
+type protoToken struct {
+	text   string
+	quoted bool
+	line   int
+}
+
+type protoParser struct {
+	schema *Schema
+	tokens []protoToken
+	pos    int
+	pkg    string
+	refs   []*protoTypeRef
+}
+
+func (p *protoParser) tokenize(source string) error {
+	line := 1
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case strings.HasPrefix(source[i:], "//"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "/*"):
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return utils.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(source[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(source) && source[j] != c {
+				if source[j] == '\\' {
+					j++
+				}
+				if j < len(source) && source[j] == '\n' {
+					return utils.Errorf("line %d: unterminated string", line)
+				}
+				j++
+			}
+			if j >= len(source) {
+				return utils.Errorf("line %d: unterminated string", line)
+			}
+			p.tokens = append(p.tokens, protoToken{text: source[i+1 : j], quoted: true, line: line})
+			i = j + 1
+		case c == '_' || c == '.' || c == '-' || c == '+' || c < utf8.RuneSelf && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))):
+			j := i
+			for j < len(source) {
+				d := source[j]
+				if d == '_' || d == '.' || d == '-' || d == '+' || d < utf8.RuneSelf && (unicode.IsLetter(rune(d)) || unicode.IsDigit(rune(d))) {
+					j++
+					continue
+				}
+				break
+			}
+			p.tokens = append(p.tokens, protoToken{text: source[i:j], line: line})
+			i = j
+		default:
+			p.tokens = append(p.tokens, protoToken{text: string(c), line: line})
+			i++
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+// next 返回下一个 token，到达末尾后返回空 token，pos 仍然前进以便调用方统一回退
+func (p *protoParser) next() protoToken {
+	p.pos++
+	if p.pos > len(p.tokens) {
+		return protoToken{}
+	}
+	return p.tokens[p.pos-1]
+}
+
+func (p *protoParser) errorf(format string, args ...any) error {
+	line := 0
+	if p.pos < len(p.tokens) {
+		line = p.tokens[p.pos].line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+	return utils.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *protoParser) expect(text string) error {
+	if tok := p.next(); tok.text != text || tok.quoted {
+		p.pos--
+		return p.errorf("expect %q but got %q", text, tok.text)
+	}
+	return nil
+}
+
+func (p *protoParser) ident() (string, error) {
+	tok := p.next()
+	if tok.text == "" || tok.quoted || !isProtoIdent(tok.text) {
+		p.pos--
+		return "", p.errorf("expect identifier but got %q", tok.text)
+	}
+	return tok.text, nil
+}
+
+func isProtoIdent(s string) bool {
+	for i, r := range s {
+		if r == '_' || r == '.' || unicode.IsLetter(r) || i > 0 && unicode.IsDigit(r) {
+			continue
+		}
+		return false
+	}
+	return s != ""
+}
+
+// skipStatement 跳过到分号为止的语句，遇到代码块时整体跳过
+func (p *protoParser) skipStatement() error {
+	for {
+		switch p.peek() {
+		case "":
+			return p.errorf("unexpected end of file")
+		case ";":
+			p.pos++
+			return nil
+		case "{":
+			return p.skipBlock()
+		case "[":
+			if err := p.skipOptions(); err != nil {
+				return err
+			}
+		default:
+			p.pos++
+		}
+	}
+}
+
+func (p *protoParser) skipBlock() error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		tok := p.next()
+		switch {
+		case tok.text == "" && !tok.quoted:
+			return p.errorf("unexpected end of file, missing }")
+		case tok.quoted:
+		case tok.text == "{":
+			depth++
+		case tok.text == "}":
+			depth--
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) skipOptions() error {
+	if p.peek() != "[" {
+		return nil
+	}
+	for depth := 0; ; {
+		tok := p.next()
+		switch {
+		case tok.text == "" && !tok.quoted:
+			return p.errorf("unexpected end of file, missing ]")
+		case tok.quoted:
+		case tok.text == "[":
+			depth++
+		case tok.text == "]":
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *protoParser) parseFile() error {
+	for p.pos < len(p.tokens) {
+		switch p.peek() {
+		case ";":
+			p.pos++
+		case "package":
+			p.pos++
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			p.pkg = name
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "syntax", "edition", "import", "option":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		case "message":
+			p.pos++
+			if err := p.parseMessage(p.pkg); err != nil {
+				return err
+			}
+		case "enum":
+			p.pos++
+			if err := p.parseEnum(p.pkg); err != nil {
+				return err
+			}
+		case "service":
+			p.pos++
+			if err := p.parseService(); err != nil {
+				return err
+			}
+		case "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			return p.errorf("unexpected %q", p.peek())
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) parseMessage(scope string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	m := &MessageType{Name: joinName(scope, name)}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	if err := p.parseMessageBody(m); err != nil {
+		return err
+	}
+	p.schema.addMessage(m)
+	return nil
+}
+
+// parseMessageBody 解析消息体直到对应的 }，oneof 中的字段直接归属到消息本身
+func (p *protoParser) parseMessageBody(m *MessageType) error {
+	for {
+		switch p.peek() {
+		case "":
+			return p.errorf("unexpected end of file, missing }")
+		case "}":
+			p.pos++
+			return nil
+		case ";":
+			p.pos++
+		case "message":
+			p.pos++
+			if err := p.parseMessage(m.Name); err != nil {
+				return err
+			}
+		case "enum":
+			p.pos++
+			if err := p.parseEnum(m.Name); err != nil {
+				return err
+			}
+		case "option", "reserved", "extensions", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		case "oneof":
+			p.pos++
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+			if err := p.parseMessageBody(m); err != nil {
+				return err
+			}
+		case "map":
+			if err := p.parseMapField(m); err != nil {
+				return err
+			}
+		default:
+			if err := p.parseField(m); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *protoParser) fieldNumber() (protowire.Number, error) {
+	if err := p.expect("="); err != nil {
+		return 0, err
+	}
+	tok := p.next()
+	n, err := strconv.ParseInt(tok.text, 0, 32)
+	if err != nil || tok.quoted || !protowire.Number(n).IsValid() {
+		p.pos--
+		return 0, p.errorf("invalid field number %q", tok.text)
+	}
+	return protowire.Number(n), nil
+}
+
+func (p *protoParser) endField() error {
+	if err := p.skipOptions(); err != nil {
+		return err
+	}
+	return p.expect(";")
+}
+
+func (p *protoParser) addTypeRef(ft *FieldType, typ, scope string) {
+	if _, ok := protoScalarTypes[typ]; ok {
+		ft.Type = typ
+		return
+	}
+	p.refs = append(p.refs, &protoTypeRef{name: typ, scope: scope, set: func(typ, typeName string) {
+		ft.Type, ft.TypeName = typ, typeName
+	}})
+}
+
+func (p *protoParser) parseField(m *MessageType) error {
+	ft := &FieldType{}
+	switch p.peek() {
+	case "repeated":
+		ft.Repeated = true
+		p.pos++
+	case "optional", "required":
+		p.pos++
+	}
+	typ, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	if typ == "group" {
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if ft.Number, err = p.fieldNumber(); err != nil {
+			return err
+		}
+		if err := p.skipOptions(); err != nil {
+			return err
+		}
+		group := &MessageType{Name: joinName(m.Name, name)}
+		if err := p.expect("{"); err != nil {
+			return err
+		}
+		if err := p.parseMessageBody(group); err != nil {
+			return err
+		}
+		p.schema.addMessage(group)
+		ft.Name, ft.Type, ft.TypeName = strings.ToLower(name), "group", group.Name
+		m.Fields = append(m.Fields, ft)
+		return nil
+	}
+
+	if ft.Name, err = p.ident(); err != nil {
+		return err
+	}
+	if ft.Number, err = p.fieldNumber(); err != nil {
+		return err
+	}
+	if err := p.endField(); err != nil {
+		return err
+	}
+	p.addTypeRef(ft, typ, m.Name)
+	m.Fields = append(m.Fields, ft)
+	return nil
+}
+
+// parseMapField 将 map<K, V> 转换为 repeated 的 XxxEntry 消息，与 protoc 生成的描述一致
+func (p *protoParser) parseMapField(m *MessageType) error {
+	p.pos++
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+	keyType, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(","); err != nil {
+		return err
+	}
+	valueType, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(">"); err != nil {
+		return err
+	}
+	ft := &FieldType{Repeated: true, Type: "message"}
+	if ft.Name, err = p.ident(); err != nil {
+		return err
+	}
+	if ft.Number, err = p.fieldNumber(); err != nil {
+		return err
+	}
+	if err := p.endField(); err != nil {
+		return err
+	}
+
+	entry := &MessageType{Name: joinName(m.Name, mapEntryName(ft.Name))}
+	key := &FieldType{Name: "key", Number: 1}
+	value := &FieldType{Name: "value", Number: 2}
+	p.addTypeRef(key, keyType, m.Name)
+	p.addTypeRef(value, valueType, m.Name)
+	entry.Fields = []*FieldType{key, value}
+	p.schema.addMessage(entry)
+
+	ft.TypeName = entry.Name
+	m.Fields = append(m.Fields, ft)
+	return nil
+}
+
+func mapEntryName(field string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range field {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String() + "Entry"
+}
+
+func (p *protoParser) parseEnum(scope string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	e := &EnumType{Name: joinName(scope, name), Values: make(map[int32]string)}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	names := make(map[string]int32)
+	for {
+		switch p.peek() {
+		case "":
+			return p.errorf("unexpected end of file, missing }")
+		case "}":
+			p.pos++
+			p.schema.addEnum(e)
+			for name, v := range names {
+				e.byName[name] = v
+			}
+			return nil
+		case ";":
+			p.pos++
+		case "option", "reserved":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			valueName, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			tok := p.next()
+			v, err := strconv.ParseInt(tok.text, 0, 32)
+			if err != nil || tok.quoted {
+				p.pos--
+				return p.errorf("invalid enum value %q", tok.text)
+			}
+			if err := p.endField(); err != nil {
+				return err
+			}
+			if _, ok := e.Values[int32(v)]; !ok {
+				e.Values[int32(v)] = valueName
+			}
+			names[valueName] = int32(v)
+		}
+	}
+}
+
+func (p *protoParser) parseService() error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	service := joinName(p.pkg, name)
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		switch p.peek() {
+		case "":
+			return p.errorf("unexpected end of file, missing }")
+		case "}":
+			p.pos++
+			return nil
+		case ";":
+			p.pos++
+		case "rpc":
+			p.pos++
+			if err := p.parseRPC(service); err != nil {
+				return err
+			}
+		default:
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *protoParser) parseRPC(service string) error {
+	method := &Method{Service: service}
+	var err error
+	if method.Name, err = p.ident(); err != nil {
+		return err
+	}
+	argument := func() (string, bool, error) {
+		if err := p.expect("("); err != nil {
+			return "", false, err
+		}
+		stream := false
+		if p.peek() == "stream" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text != ")" {
+			stream = true
+			p.pos++
+		}
+		typ, err := p.ident()
+		if err != nil {
+			return "", false, err
+		}
+		return typ, stream, p.expect(")")
+	}
+	input, clientStreaming, err := argument()
+	if err != nil {
+		return err
+	}
+	if err := p.expect("returns"); err != nil {
+		return err
+	}
+	output, serverStreaming, err := argument()
+	if err != nil {
+		return err
+	}
+	method.ClientStreaming, method.ServerStreaming = clientStreaming, serverStreaming
+	p.refs = append(p.refs,
+		&protoTypeRef{name: input, scope: p.pkg, set: func(_, typeName string) { method.Input = typeName }},
+		&protoTypeRef{name: output, scope: p.pkg, set: func(_, typeName string) { method.Output = typeName }},
+	)
+	if p.peek() == "{" {
+		if err := p.skipBlock(); err != nil {
+			return err
+		}
+	} else if err := p.expect(";"); err != nil {
+		return err
+	}
+	p.schema.addMethod(method)
+	return nil
+}
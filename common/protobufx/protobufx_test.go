@@ -0,0 +1,397 @@
+package protobufx
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func buildSample() []byte {
+	var inner []byte
+	inner = protowire.AppendTag(inner, 1, protowire.VarintType)
+	inner = protowire.AppendVarint(inner, 42)
+	inner = protowire.AppendTag(inner, 2, protowire.Fixed32Type)
+	inner = protowire.AppendFixed32(inner, 0x3fc00000) // 1.5
+	inner = protowire.AppendTag(inner, 3, protowire.BytesType)
+	inner = protowire.AppendBytes(inner, []byte{0xff, 0x00, 0x01})
+
+	var packed []byte
+	packed = protowire.AppendVarint(packed, 1)
+	packed = protowire.AppendVarint(packed, 300)
+	packed = protowire.AppendVarint(packed, protowire.EncodeZigZag(-5))
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 150)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "yak\"lang\n")
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, inner)
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendBytes(b, packed)
+	b = protowire.AppendTag(b, 5, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 1<<63)
+	b = protowire.AppendTag(b, 6, protowire.StartGroupType)
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+	b = protowire.AppendTag(b, 6, protowire.EndGroupType)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "second")
+	return b
+}
+
+func TestSchemalessRoundTrip(t *testing.T) {
+	raw := buildSample()
+	msg, err := Decode(raw)
+	require.NoError(t, err)
+	require.Equal(t, raw, msg.Encode())
+	require.Len(t, msg.Fields, 7)
+	require.Equal(t, KindString, msg.Fields[1].Kind)
+	require.Equal(t, KindMessage, msg.Fields[2].Kind)
+	require.Equal(t, KindBytes, msg.Fields[2].Message.Fields[2].Kind)
+	require.Equal(t, KindGroup, msg.Fields[5].Kind)
+
+	text := msg.String()
+	require.Contains(t, text, `2: "yak\"lang\n"`)
+	require.Contains(t, text, "3 {\n  1: 42\n  2: fixed32(1069547520)\n  3: bytes(ff0001)\n}")
+	require.Contains(t, text, "6: group {\n  1: 1\n}")
+
+	parsed, err := ParseText(text)
+	require.NoError(t, err)
+	require.Equal(t, raw, parsed.Encode())
+
+	// 修改嵌套字段后长度前缀自动修正
+	parsed.Fields[2].Message.Fields[0].SetValue("1' or 1=1")
+	again, err := Decode(parsed.Encode())
+	require.NoError(t, err)
+	require.Equal(t, "1' or 1=1", again.Fields[2].Message.Fields[0].Value())
+
+	for _, bad := range [][]byte{{0x08}, {0x0a, 0x05, 0x01}, {0x0b}, {0x0c}, {0x0f}} {
+		_, err := Decode(bad)
+		require.Error(t, err)
+	}
+	for _, bad := range []string{"1: ", "x: 1", "1 {", "0: 1", "1: [\"a\"]", "1: bytes(zz)", "1: foo(1)", "1: \"a"} {
+		_, err := ParseText(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestRecords(t *testing.T) {
+	raw := buildSample()
+	records := RecordsFromBytes(raw)
+	require.NoError(t, records.Error())
+	require.Equal(t, raw, records.ToBytes())
+	require.Equal(t, "group", records.Find(6)[0].Type)
+	require.Equal(t, "endgroup", records.Find(6)[1].Type)
+
+	// Message 与 Records 使用同一套 wire 编码
+	msg, err := Decode(raw)
+	require.NoError(t, err)
+	require.Equal(t, raw, msg.Records().ToBytes())
+
+	// JSON / YAML 中不保存 endgroup，group 之后的字段无法还原，这里只使用 group 之前的字段
+	plain := RecordsFromBytes((&Message{Fields: msg.Fields[:5]}).Encode())
+	for _, text := range []string{plain.ToJSON(), plain.ToYAML()} {
+		var parsed *Records
+		if strings.HasPrefix(text, "[") {
+			parsed = RecordsFromJSON([]byte(text))
+		} else {
+			parsed = RecordsFromYAML([]byte(text))
+		}
+		require.NoError(t, parsed.Error())
+		require.Equal(t, plain.ToBytes(), parsed.ToBytes())
+	}
+
+	results, err := RecordsFromHex("0a0361626310" + "01").FuzzEveryIndex(func(index int, typ string, data interface{}) interface{} {
+		if typ == "string" {
+			return []string{"x", "yy"}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{0x0a, 0x01, 'x', 0x10, 0x01}, {0x0a, 0x02, 'y', 'y', 0x10, 0x01}}, results)
+
+	// 长度不足时返回错误而不是 panic
+	for _, bad := range [][]byte{{0x08}, {0x0a, 0x05, 0x01}, {0x0d, 0x01}, {0x09, 0x01}} {
+		require.Error(t, RecordsFromBytes(bad).Error())
+	}
+}
+
+func TestParseTextLiterals(t *testing.T) {
+	msg, err := ParseText(`
+1: -1  # negative varint
+2: float(2.5), 3: double(-0.25)
+4: sint(-2)
+5: [fixed32(1), fixed32(2)]
+6 { 1: true }
+7: 0x10
+8: 1.5
+`)
+	require.NoError(t, err)
+	decoded, err := Decode(msg.Encode())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<64-1), decoded.Fields[0].Varint)
+	require.Equal(t, protowire.Fixed32Type, decoded.Fields[1].Wire)
+	require.Equal(t, protowire.Fixed64Type, decoded.Fields[2].Wire)
+	require.Equal(t, protowire.EncodeZigZag(-2), decoded.Fields[3].Varint)
+	require.Equal(t, []byte{1, 0, 0, 0, 2, 0, 0, 0}, decoded.Fields[4].Bytes)
+	require.Equal(t, uint64(16), decoded.Fields[6].Varint)
+	require.Equal(t, protowire.Fixed64Type, decoded.Fields[7].Wire)
+}
+
+const sampleProto = `
+syntax = "proto3";
+package demo.v1;
+
+import "google/protobuf/timestamp.proto";
+option go_package = "demo/v1;demo";
+
+/* 用户 */
+message User {
+  int64 id = 1;
+  string name = 2 [json_name = "userName"];
+  Role role = 3;
+  repeated sint32 scores = 4;
+  float ratio = 5;
+  map<string, int32> tags = 6;
+  Address address = 7;
+  repeated Role roles = 8 [packed = true];
+  oneof contact {
+    string email = 9;
+    bytes avatar = 10;
+  }
+  google.protobuf.Timestamp created = 11;
+  reserved 12, 15 to 20;
+  message Address {
+    string city = 1;
+  }
+}
+
+enum Role {
+  option allow_alias = true;
+  ROLE_UNKNOWN = 0;
+  ADMIN = 1;
+  ROOT = 1;
+  GUEST = -1;
+}
+
+message GetUserRequest { int64 id = 1; }
+
+service UserService {
+  rpc GetUser(GetUserRequest) returns (User);
+  rpc Watch(stream GetUserRequest) returns (stream .demo.v1.User) { option deprecated = true; }
+}
+`
+
+func buildUser(t *testing.T) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 7)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "alice")
+	b = protowire.AppendTag(b, 3, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+	var scores []byte
+	scores = protowire.AppendVarint(scores, protowire.EncodeZigZag(-3))
+	scores = protowire.AppendVarint(scores, protowire.EncodeZigZag(4))
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendBytes(b, scores)
+	b = protowire.AppendTag(b, 5, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 0x3f000000) // 0.5
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, "k")
+	entry = protowire.AppendTag(entry, 2, protowire.VarintType)
+	entry = protowire.AppendVarint(entry, 9)
+	b = protowire.AppendTag(b, 6, protowire.BytesType)
+	b = protowire.AppendBytes(b, entry)
+	var address []byte
+	address = protowire.AppendTag(address, 1, protowire.BytesType)
+	address = protowire.AppendString(address, "hz")
+	b = protowire.AppendTag(b, 7, protowire.BytesType)
+	b = protowire.AppendBytes(b, address)
+	return b
+}
+
+func TestParseProtoSchema(t *testing.T) {
+	schema, err := ParseProto(sampleProto)
+	require.NoError(t, err)
+	require.Contains(t, schema.MessageNames(), "demo.v1.User.TagsEntry")
+
+	user, ok := schema.Message("demo.v1.User")
+	require.True(t, ok)
+	role, _ := user.FieldByName("role")
+	require.Equal(t, "enum", role.Type)
+	require.Equal(t, "demo.v1.Role", role.TypeName)
+	address, _ := user.FieldByName("address")
+	require.Equal(t, "demo.v1.User.Address", address.TypeName)
+	created, _ := user.FieldByName("created")
+	require.Equal(t, "google.protobuf.Timestamp", created.TypeName)
+	avatar, _ := user.FieldByName("avatar")
+	require.Equal(t, "bytes", avatar.Type)
+	require.Equal(t, "ADMIN", schema.Enums["demo.v1.Role"].Values[1])
+
+	method, ok := schema.Method("/demo.v1.UserService/Watch?x=1")
+	require.True(t, ok)
+	require.Equal(t, "demo.v1.GetUserRequest", method.Input)
+	require.Equal(t, "demo.v1.User", method.Output)
+	require.True(t, method.ClientStreaming && method.ServerStreaming)
+
+	raw := buildUser(t)
+	msg, err := schema.Decode(raw, "demo.v1.User")
+	require.NoError(t, err)
+	require.Equal(t, raw, msg.Encode())
+	text := msg.String()
+	require.Contains(t, text, "3: 1  # role = ADMIN")
+	require.Contains(t, text, "4: [sint(-3), sint(4)]  # scores")
+	require.Contains(t, text, "5: float(0.5)  # ratio")
+	require.Contains(t, text, "7 {  # address\n  1: \"hz\"  # city")
+
+	parsed, err := schema.ParseText(text, "demo.v1.User")
+	require.NoError(t, err)
+	require.Equal(t, raw, parsed.Encode())
+
+	// 使用字段名与枚举名
+	parsed, err = schema.ParseText(`id: 8, role: GUEST, ratio: 2, scores: [-1], address { city: "sh" }`, "demo.v1.User")
+	require.NoError(t, err)
+	decoded, err := schema.Decode(parsed.Encode(), "demo.v1.User")
+	require.NoError(t, err)
+	values := make(map[string]any)
+	for _, leaf := range decoded.Leaves() {
+		values[leaf.NamePath] = leaf.Value()
+	}
+	require.Equal(t, map[string]any{
+		"id": uint64(8), "role": "GUEST", "ratio": float32(2), "scores[0]": int64(-1), "address.city": "sh",
+	}, values)
+
+	for _, bad := range []string{"message A {", "message A { int32 a = ; }", "message A { int32 a = 1 }", "enum E { A = x; }", "foo bar;"} {
+		_, err := ParseProto(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestLoadDescriptorSet(t *testing.T) {
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(structpb.File_google_protobuf_struct_proto),
+	}}
+	raw, err := proto.Marshal(set)
+	require.NoError(t, err)
+	schema, err := LoadDescriptorSet(raw)
+	require.NoError(t, err)
+
+	value, err := structpb.NewValue(map[string]any{"name": "yak", "ok": true})
+	require.NoError(t, err)
+	encoded, err := proto.Marshal(value)
+	require.NoError(t, err)
+	msg, err := schema.Decode(encoded, ".google.protobuf.Value")
+	require.NoError(t, err)
+	require.Equal(t, encoded, msg.Encode())
+	require.Contains(t, msg.String(), "# struct_value")
+	require.Contains(t, msg.String(), "# bool_value")
+
+	_, err = LoadDescriptorSet([]byte("bad"))
+	require.Error(t, err)
+}
+
+func grpcFrame(flag byte, data []byte) []byte {
+	return append([]byte{flag, byte(len(data) >> 24), byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))}, data...)
+}
+
+func TestGRPCBody(t *testing.T) {
+	raw := buildUser(t)
+	schema, err := ParseProto(sampleProto)
+	require.NoError(t, err)
+
+	gzipped, err := grpcCompress("gzip", raw)
+	require.NoError(t, err)
+	body := append(grpcFrame(0, raw), grpcFrame(1, gzipped)...)
+	body = append(body, grpcFrame(0x80, []byte("grpc-status: 0\r\n"))...)
+
+	b, err := DecodeBody(BodyGRPCWeb, "gzip", body, WithSchema(schema), WithMethodPath("/demo.v1.UserService/GetUser"))
+	require.NoError(t, err)
+	require.Len(t, b.Frames, 3)
+	require.Len(t, b.Messages(), 2)
+	encoded, err := b.Encode()
+	require.NoError(t, err)
+	// 压缩帧重新压缩后内容一致
+	again, err := DecodeBody(BodyGRPCWeb, "gzip", encoded)
+	require.NoError(t, err)
+	require.Equal(t, raw, again.Frames[1].Data)
+
+	text := b.String()
+	require.Contains(t, text, "compressed message {  # demo.v1.GetUserRequest")
+	// fuzztag 文本中的帧不压缩，也不修改原有的帧
+	tagText := b.FuzzTagText()
+	require.True(t, strings.HasPrefix(tagText, "{{grpc:encode(message {"))
+	require.NotContains(t, tagText, "compressed")
+	require.True(t, b.Frames[1].Compressed)
+	require.Contains(t, text, `trailer "grpc-status: 0\r\n"`)
+	parsed, err := ParseBodyText(BodyGRPCWeb, "gzip", text, WithSchema(schema), WithMethodPath("/demo.v1.UserService/GetUser"))
+	require.NoError(t, err)
+	require.Len(t, parsed.Frames, 3)
+	require.True(t, parsed.Frames[1].Compressed)
+
+	leaf, ok := parsed.Leaf("[1].7.1")
+	require.True(t, ok)
+	require.NoError(t, leaf.Set("<script>"))
+	encoded, err = parsed.Encode()
+	require.NoError(t, err)
+	again, err = DecodeBody(BodyGRPCWeb, "gzip", encoded)
+	require.NoError(t, err)
+	require.Equal(t, "<script>", again.Frames[1].Message.Fields[6].Message.Fields[0].Value())
+	require.Equal(t, raw, again.Frames[0].Data)
+
+	// grpc-web-text 由多段 base64 拼接
+	webText := base64.StdEncoding.EncodeToString(grpcFrame(0, raw)) + base64.StdEncoding.EncodeToString(grpcFrame(0x80, []byte("a: b")))
+	b, err = DecodeBody(BodyGRPCWebText, "", []byte(webText))
+	require.NoError(t, err)
+	require.Len(t, b.Frames, 2)
+	require.True(t, b.Frames[1].Trailer)
+
+	for _, bad := range [][]byte{{0, 0, 0}, {0, 0, 0, 0, 9, 1}, grpcFrame(1, []byte("x"))} {
+		_, err := DecodeBody(BodyGRPC, "", bad)
+		require.Error(t, err)
+	}
+}
+
+func TestHTTPPacket(t *testing.T) {
+	raw := buildUser(t)
+	schema, err := ParseProto(sampleProto)
+	require.NoError(t, err)
+
+	packet := lowhttp.ReplaceHTTPPacketBodyFast([]byte("POST /demo.v1.UserService/GetUser HTTP/2\r\nHost: example.com\r\nContent-Type: application/grpc+proto\r\nTE: trailers\r\n\r\n"), grpcFrame(0, raw))
+	b, err := DecodeHTTPPacket(packet, WithSchema(schema), WithMessageType("demo.v1.User"))
+	require.NoError(t, err)
+	require.Equal(t, BodyGRPC, b.Kind)
+	leaf, ok := b.Leaf("name")
+	require.True(t, ok)
+	require.Equal(t, "alice", leaf.Value())
+
+	text := b.String()
+	newPacket, err := EncodeHTTPPacket(packet, text, WithSchema(schema), WithMessageType("demo.v1.User"))
+	require.NoError(t, err)
+	require.Equal(t, lowhttp.GetHTTPPacketBody(packet), lowhttp.GetHTTPPacketBody(newPacket))
+
+	newPacket, err = EncodeHTTPPacket(packet, `message { 2: "bob-with-a-longer-name" }`)
+	require.NoError(t, err)
+	require.Equal(t, grpcFrame(0, append([]byte{0x12, 22}, "bob-with-a-longer-name"...)), lowhttp.GetHTTPPacketBody(newPacket))
+
+	protobufPacket := lowhttp.ReplaceHTTPPacketBodyFast([]byte("HTTP/1.1 200 OK\r\nContent-Type: application/x-protobuf\r\n\r\n"), raw)
+	b, err = DecodeHTTPPacket(protobufPacket)
+	require.NoError(t, err)
+	require.Equal(t, BodyProtobuf, b.Kind)
+	require.Equal(t, b.Frames[0].Message.String(), b.String())
+
+	_, err = DecodeHTTPPacket([]byte("POST / HTTP/1.1\r\nContent-Type: application/json\r\n\r\n{}"))
+	require.Error(t, err)
+	require.False(t, IsProtobufContentType("application/grpc+json"))
+	require.True(t, IsProtobufContentType("application/grpc-web-text+proto; charset=utf-8"))
+}
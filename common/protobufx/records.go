@@ -0,0 +1,506 @@
+package protobufx
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+	"gopkg.in/yaml.v3"
+)
+
+// Record 是 protobuf 数据中的一条记录，group 以 group / endgroup 两条记录表示，
+// 是 Decode / Message.Encode 所使用的 wire 层
+type Record struct {
+	Index protowire.Number `json:"index" yaml:"index"`
+	Type  string           `json:"type" yaml:"type"`
+	Value interface{}      `json:"value,omitempty" yaml:"value,omitempty,flow"`
+}
+
+type yamlRecord struct {
+	Index protowire.Number `json:"index" yaml:"index"`
+	Type  string           `json:"type" yaml:"type"`
+	Value yaml.Node        `json:"value,omitempty" yaml:"value,omitempty,flow"`
+}
+
+func newRecord(index protowire.Number, typ string, value interface{}) *Record {
+	return &Record{
+		Index: index,
+		Type:  typ,
+		Value: value,
+	}
+}
+
+func (r *Record) String() string {
+	if r.Type == "group" {
+		return fmt.Sprintf("%d: (", r.Index)
+	} else if r.Type == "endgroup" {
+		return ")"
+	} else if r.Type == "string" {
+		return fmt.Sprintf("%d: %s: %#v", r.Index, r.Type, r.Value)
+	}
+	return fmt.Sprintf("%d: %s: %v", r.Index, r.Type, r.Value)
+}
+
+func (r *Record) ToBytes() []byte {
+	var b []byte
+	switch r.Type {
+	case "varint":
+		b = protowire.AppendTag(b, r.Index, protowire.VarintType)
+		b = protowire.AppendVarint(b, r.Value.(uint64))
+	case "fixed32":
+		b = protowire.AppendTag(b, r.Index, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, r.Value.(uint32))
+	case "fixed64":
+		b = protowire.AppendTag(b, r.Index, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, r.Value.(uint64))
+	case "string":
+		b = protowire.AppendTag(b, r.Index, protowire.BytesType)
+		b = protowire.AppendBytes(b, []byte(r.Value.(string)))
+	case "bytes":
+		b = protowire.AppendTag(b, r.Index, protowire.BytesType)
+		b = protowire.AppendBytes(b, r.Value.([]byte))
+	case "group":
+		b = protowire.AppendTag(b, r.Index, protowire.StartGroupType)
+	case "endgroup":
+		b = protowire.AppendTag(b, r.Index, protowire.EndGroupType)
+	}
+	return b
+}
+
+// Records 是按出现顺序排列的 protobuf 记录
+type Records struct {
+	Records []*Record
+	err     error `json:"-" yaml:"-"`
+}
+
+func newRecords() *Records {
+	return &Records{
+		Records: make([]*Record, 0),
+	}
+}
+
+// utils
+func (r *Records) Find(index int) []*Record {
+	records := make([]*Record, 0)
+	for _, record := range r.Records {
+		if int(record.Index) == index {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (r *Records) Error() error {
+	return r.err
+}
+
+// marshal / unmarshal
+func (r *Records) MarshalJSON() ([]byte, error) {
+	newRecords := make([]*Record, 0, len(r.Records))
+	for _, record := range r.Records {
+		if record.Type == "endgroup" {
+			continue
+		}
+		newRecords = append(newRecords, record)
+	}
+	return json.Marshal(newRecords)
+}
+
+func (r *Records) UnmarshalJSON(data []byte) error {
+	var (
+		records    []*Record
+		recordList = list.New()
+	)
+	err := json.Unmarshal(data, &records)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.Type == "group" { // add endgroup
+			recordList.PushFront(newRecord(record.Index, "endgroup", nil))
+		} else if record.Type == "bytes" { // recover bytes
+			if bytesString, ok := record.Value.(string); ok {
+				bytes, err := base64.StdEncoding.DecodeString(bytesString)
+				if err != nil {
+					return err
+				}
+				record.Value = bytes
+			}
+		} else if record.Type == "varint" || record.Type == "fixed64" {
+			record.Value = uint64(record.Value.(float64))
+		} else if record.Type == "fixed32" {
+			record.Value = uint32(record.Value.(float64))
+		}
+	}
+
+	// add endgroups
+	for e := recordList.Front(); e != nil; e = e.Next() {
+		records = append(records, e.Value.(*Record))
+	}
+
+	r.Records = records
+	return nil
+}
+
+func (r *Records) MarshalYAML() (interface{}, error) {
+	newRecords := make([]*Record, 0, len(r.Records))
+	for _, record := range r.Records {
+		if record.Type == "endgroup" {
+			continue
+		}
+		newRecords = append(newRecords, record)
+	}
+	return newRecords, nil
+}
+
+func (r *Records) UnmarshalYAML(node *yaml.Node) error {
+	var (
+		records    []*yamlRecord
+		newrecords []*Record
+		recordList = list.New()
+	)
+
+	if err := node.Decode(&records); err != nil {
+		return err
+	}
+	newrecords = make([]*Record, len(records))
+
+	for i, record := range records {
+		newrecords[i] = new(Record)
+		newrecords[i].Index = record.Index
+		newrecords[i].Type = record.Type
+		switch record.Type {
+		case "group":
+			recordList.PushFront(newRecord(record.Index, "endgroup", nil))
+			newrecords[i].Value = nil
+		case "string":
+			newrecords[i].Value = new(string)
+		case "bytes":
+			newrecords[i].Value = new([]byte)
+		case "varint":
+			fallthrough
+		case "fixed64":
+			newrecords[i].Value = new(uint64)
+		case "fixed32":
+			newrecords[i].Value = new(uint32)
+		}
+		if record.Type == "group" {
+			continue
+		}
+
+		if err := record.Value.Decode(newrecords[i].Value); err != nil {
+			return err
+		}
+
+		v := reflect.ValueOf(newrecords[i].Value)
+		switch record.Type {
+		case "varint":
+			fallthrough
+		case "fixed64":
+			newrecords[i].Value = v.Elem().Uint()
+		case "fixed32":
+			newrecords[i].Value = uint32(v.Elem().Uint())
+		case "string":
+			newrecords[i].Value = v.Elem().String()
+		case "bytes":
+			newrecords[i].Value = v.Elem().Bytes()
+		}
+
+	}
+
+	r.Records = newrecords
+	return nil
+}
+
+// protobuf convert
+func (r *Records) String() string {
+	var (
+		builder strings.Builder
+		inGroup int = 0
+	)
+	if r == nil {
+		return ""
+	}
+
+	for _, record := range r.Records {
+		if record.Type == "group" {
+			inGroup += 1
+		} else if record.Type == "endgroup" {
+			inGroup -= 1
+		}
+		builder.WriteString(record.String())
+		if inGroup <= 0 {
+			builder.WriteRune('\n')
+		} else if record.Type != "group" && record.Type != "endgroup" {
+			builder.WriteRune(',')
+		} else {
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.TrimSpace(builder.String())
+}
+
+func (r *Records) ToJSON() string {
+	if r == nil {
+		return ""
+	}
+	if bytes, err := json.MarshalIndent(r, "", "  "); err != nil {
+		return ""
+	} else {
+		return string(bytes)
+	}
+}
+
+func (r *Records) ToYAML() string {
+	if r == nil {
+		return ""
+	}
+	if bytes, err := yaml.Marshal(r); err != nil {
+		return ""
+	} else {
+		return string(bytes)
+	}
+}
+
+func (r *Records) ToBytes() []byte {
+	var buf bytes.Buffer
+
+	if r == nil {
+		return nil
+	}
+
+	for _, record := range r.Records {
+		buf.Write(record.ToBytes())
+	}
+	return buf.Bytes()
+}
+
+func (r *Records) ToHex() string {
+	if r == nil {
+		return ""
+	}
+	return hex.EncodeToString(r.ToBytes())
+}
+
+// protobuf fuzz
+
+func (r *Records) fuzzRecord(record *Record, callback func(index int, typ string, data interface{}) interface{}) ([][]byte, error) {
+	if r == nil {
+		return nil, utils.Error("records is nil")
+	} else if record.Type == "group" || record.Type == "endgroup" {
+		return [][]byte{}, nil
+	}
+
+	oldRecordValue := record.Value
+	defer func() {
+		record.Value = oldRecordValue
+	}()
+
+	value := callback(int(record.Index), record.Type, record.Value)
+	if value == nil {
+		return [][]byte{}, nil
+	}
+	valueSlice := utils.InterfaceToBytesSlice(value)
+
+	result := make([][]byte, 0, len(valueSlice))
+
+	switch record.Type {
+	case "varint":
+		fallthrough
+	case "fixed64":
+		fallthrough
+	case "fixed32":
+		valueIntSlice := make([]int, 0, len(valueSlice))
+		for _, v := range valueSlice {
+			if i, err := strconv.Atoi(string(v)); err != nil {
+				return nil, utils.Wrapf(err, "invalid int: %#v", v)
+			} else {
+				valueIntSlice = append(valueIntSlice, i)
+			}
+		}
+
+		for _, v := range valueIntSlice {
+			if record.Type == "varint" || record.Type == "fixed64" {
+				record.Value = uint64(v)
+			} else {
+				record.Value = uint32(v)
+			}
+			result = append(result, r.ToBytes())
+		}
+
+		return result, nil
+
+	case "bytes":
+		fallthrough
+	case "string":
+		for _, v := range valueSlice {
+			if record.Type == "string" {
+				record.Value = string(v)
+			} else {
+				record.Value = v
+			}
+			result = append(result, r.ToBytes())
+		}
+		return result, nil
+	}
+
+	return nil, utils.Errorf("invalid record type: %s", record.Type)
+}
+
+func (r *Records) FuzzIndex(index int, callback func(index int, typ string, data interface{}) interface{}) ([][]byte, error) {
+	var (
+		err         error
+		tempResults [][]byte
+		results     = make([][]byte, 0)
+	)
+
+	if r == nil {
+		return nil, utils.Error("records is nil")
+	} else if r.err != nil {
+		return nil, r.err
+	}
+
+	records := r.Find(index)
+	if len(records) == 0 {
+		return nil, utils.Errorf("Cannot find record with index %d", index)
+	}
+
+	for _, record := range r.Records {
+		if tempResults, err = r.fuzzRecord(record, callback); err != nil {
+			return nil, err
+		}
+		results = append(results, tempResults...)
+	}
+
+	return results, nil
+}
+
+func (r *Records) FuzzEveryIndex(callback func(index int, typ string, data interface{}) interface{}) ([][]byte, error) {
+	if r == nil {
+		return nil, utils.Error("records is nil")
+	} else if r.err != nil {
+		return nil, r.err
+	}
+
+	var (
+		err         error
+		tempResults [][]byte
+		results     = make([][]byte, 0)
+	)
+	for _, record := range r.Records {
+		if tempResults, err = r.fuzzRecord(record, callback); err != nil {
+			return nil, err
+		}
+		results = append(results, tempResults...)
+	}
+
+	return results, nil
+}
+
+// protobuf parse
+func recordFromBytes(b []byte) ([]byte, *Record, error) {
+	index, typ, n := protowire.ConsumeTag(b)
+	if n < 0 {
+		return nil, nil, utils.Errorf("consume protobuf tag failed: %v", protowire.ParseError(n))
+	}
+	b = b[n:]
+
+	var (
+		record *Record
+		m      int
+	)
+	switch typ {
+	case protowire.VarintType:
+		var v uint64
+		v, m = protowire.ConsumeVarint(b)
+		record = newRecord(index, "varint", v)
+	case protowire.Fixed32Type:
+		var v uint32
+		v, m = protowire.ConsumeFixed32(b)
+		record = newRecord(index, "fixed32", v)
+	case protowire.Fixed64Type:
+		var v uint64
+		v, m = protowire.ConsumeFixed64(b)
+		record = newRecord(index, "fixed64", v)
+	case protowire.BytesType:
+		var v []byte
+		v, m = protowire.ConsumeBytes(b)
+		if strVisible(string(v)) {
+			record = newRecord(index, "string", string(v))
+		} else {
+			record = newRecord(index, "bytes", v)
+		}
+	case protowire.StartGroupType:
+		return b, newRecord(index, "group", nil), nil
+	case protowire.EndGroupType:
+		return b, newRecord(index, "endgroup", nil), nil
+	default:
+		return nil, nil, utils.Errorf("Unknown protobuf type: %d", typ)
+	}
+	if m < 0 {
+		return nil, nil, utils.Errorf("consume protobuf field %d failed: %v", index, protowire.ParseError(m))
+	}
+	return b[m:], record, nil
+}
+
+func strVisible(key string) bool {
+	for _, c := range key {
+		if c != '\n' && c != '\r' && !strconv.IsPrint(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordsFromBytes 将 protobuf 数据拆分为记录，解析失败时通过 Error() 返回错误
+func RecordsFromBytes(b []byte) *Records {
+	records := newRecords()
+	for len(b) > 0 {
+		var (
+			record *Record
+			err    error
+		)
+		b, record, err = recordFromBytes(b)
+		if err != nil {
+			records.err = err
+			break
+		}
+		records.Records = append(records.Records, record)
+	}
+	return records
+}
+
+func RecordsFromHex(s string) *Records {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		records := newRecords()
+		records.err = utils.Wrapf(err, "hex decode error")
+		return records
+	}
+	return RecordsFromBytes(b)
+}
+
+func RecordsFromJSON(b []byte) *Records {
+	records := newRecords()
+	if err := json.Unmarshal(b, records); err != nil {
+		records.err = utils.Wrapf(err, "json unmarshal error")
+	}
+	return records
+}
+
+func RecordsFromYAML(b []byte) *Records {
+	records := newRecords()
+	if err := yaml.Unmarshal(b, records); err != nil {
+		records.err = utils.Wrapf(err, "yaml unmarshal error")
+	}
+	return records
+}
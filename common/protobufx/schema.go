@@ -0,0 +1,351 @@
+package protobufx
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Schema 保存从 .proto 文件或 FileDescriptorSet 中加载的消息、枚举与 gRPC 方法，类型名均为不带前导点的完整名称
+type Schema struct {
+	Messages map[string]*MessageType
+	Enums    map[string]*EnumType
+	// key 为 gRPC 请求路径，如 /helloworld.Greeter/SayHello
+	Methods map[string]*Method
+}
+
+// MessageType 是一个消息类型
+type MessageType struct {
+	Name   string
+	Fields []*FieldType
+
+	byNumber map[protowire.Number]*FieldType
+	byName   map[string]*FieldType
+}
+
+// FieldType 是消息中的一个字段定义
+type FieldType struct {
+	Name   string
+	Number protowire.Number
+	// protobuf 标量类型名，或 message / enum / group
+	Type string
+	// Type 为 message / enum / group 时的完整类型名
+	TypeName string
+	Repeated bool
+}
+
+// EnumType 是一个枚举类型
+type EnumType struct {
+	Name   string
+	Values map[int32]string
+
+	byName map[string]int32
+}
+
+// Method 是一个 gRPC 方法
+type Method struct {
+	Service         string
+	Name            string
+	Input           string
+	Output          string
+	ClientStreaming bool
+	ServerStreaming bool
+}
+
+// NewSchema 创建一个空的 Schema
+func NewSchema() *Schema {
+	return &Schema{
+		Messages: make(map[string]*MessageType),
+		Enums:    make(map[string]*EnumType),
+		Methods:  make(map[string]*Method),
+	}
+}
+
+func (s *Schema) addMessage(m *MessageType) {
+	m.byNumber = make(map[protowire.Number]*FieldType, len(m.Fields))
+	m.byName = make(map[string]*FieldType, len(m.Fields))
+	for _, f := range m.Fields {
+		m.byNumber[f.Number] = f
+		m.byName[f.Name] = f
+	}
+	s.Messages[m.Name] = m
+}
+
+func (s *Schema) addEnum(e *EnumType) {
+	e.byName = make(map[string]int32, len(e.Values))
+	for v, name := range e.Values {
+		e.byName[name] = v
+	}
+	s.Enums[e.Name] = e
+}
+
+func (s *Schema) addMethod(m *Method) {
+	s.Methods["/"+m.Service+"/"+m.Name] = m
+}
+
+// Field 按字段号查找字段定义
+func (m *MessageType) Field(number protowire.Number) (*FieldType, bool) {
+	f, ok := m.byNumber[number]
+	return f, ok
+}
+
+// FieldByName 按字段名查找字段定义
+func (m *MessageType) FieldByName(name string) (*FieldType, bool) {
+	f, ok := m.byName[name]
+	return f, ok
+}
+
+// Message 查找消息类型，类型名可以带前导点
+func (s *Schema) Message(name string) (*MessageType, bool) {
+	if s == nil {
+		return nil, false
+	}
+	m, ok := s.Messages[strings.TrimPrefix(name, ".")]
+	return m, ok
+}
+
+// Method 按 gRPC 请求路径查找方法，路径末尾的查询参数会被忽略
+func (s *Schema) Method(path string) (*Method, bool) {
+	if s == nil {
+		return nil, false
+	}
+	path, _, _ = strings.Cut(path, "?")
+	m, ok := s.Methods["/"+strings.TrimPrefix(path, "/")]
+	return m, ok
+}
+
+// MessageNames 返回所有消息类型名，按字典序排序
+func (s *Schema) MessageNames() []string {
+	names := make([]string, 0, len(s.Messages))
+	for name := range s.Messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Merge 将另一个 Schema 中的类型合并进来，同名类型会被覆盖
+func (s *Schema) Merge(other *Schema) {
+	if other == nil {
+		return
+	}
+	for k, v := range other.Messages {
+		s.Messages[k] = v
+	}
+	for k, v := range other.Enums {
+		s.Enums[k] = v
+	}
+	for k, v := range other.Methods {
+		s.Methods[k] = v
+	}
+}
+
+// LoadDescriptorSet 从序列化的 FileDescriptorSet（protoc --descriptor_set_out 的输出）中加载 Schema
+func LoadDescriptorSet(raw []byte) (*Schema, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(raw, set); err != nil {
+		return nil, utils.Wrap(err, "unmarshal FileDescriptorSet failed")
+	}
+	if len(set.GetFile()) <= 0 {
+		return nil, utils.Error("empty FileDescriptorSet")
+	}
+	s := NewSchema()
+	for _, file := range set.GetFile() {
+		prefix := file.GetPackage()
+		for _, m := range file.GetMessageType() {
+			s.loadDescriptor(prefix, m)
+		}
+		for _, e := range file.GetEnumType() {
+			s.loadEnumDescriptor(prefix, e)
+		}
+		for _, svc := range file.GetService() {
+			service := joinName(prefix, svc.GetName())
+			for _, m := range svc.GetMethod() {
+				s.addMethod(&Method{
+					Service:         service,
+					Name:            m.GetName(),
+					Input:           strings.TrimPrefix(m.GetInputType(), "."),
+					Output:          strings.TrimPrefix(m.GetOutputType(), "."),
+					ClientStreaming: m.GetClientStreaming(),
+					ServerStreaming: m.GetServerStreaming(),
+				})
+			}
+		}
+	}
+	return s, nil
+}
+
+func (s *Schema) loadDescriptor(prefix string, d *descriptorpb.DescriptorProto) {
+	m := &MessageType{Name: joinName(prefix, d.GetName())}
+	for _, f := range d.GetField() {
+		ft := &FieldType{
+			Name:     f.GetName(),
+			Number:   protowire.Number(f.GetNumber()),
+			TypeName: strings.TrimPrefix(f.GetTypeName(), "."),
+			Repeated: f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED,
+		}
+		ft.Type = strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+		m.Fields = append(m.Fields, ft)
+	}
+	s.addMessage(m)
+	for _, nested := range d.GetNestedType() {
+		s.loadDescriptor(m.Name, nested)
+	}
+	for _, e := range d.GetEnumType() {
+		s.loadEnumDescriptor(m.Name, e)
+	}
+}
+
+func (s *Schema) loadEnumDescriptor(prefix string, d *descriptorpb.EnumDescriptorProto) {
+	e := &EnumType{Name: joinName(prefix, d.GetName()), Values: make(map[int32]string)}
+	for _, v := range d.GetValue() {
+		// 存在别名时保留第一个名字
+		if _, ok := e.Values[v.GetNumber()]; !ok {
+			e.Values[v.GetNumber()] = v.GetName()
+		}
+	}
+	s.addEnum(e)
+	for _, v := range d.GetValue() {
+		e.byName[v.GetName()] = v.GetNumber()
+	}
+}
+
+func joinName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// scalarKind 返回标量类型对应的 kind 与 wire type
+func scalarKind(typ string) (FieldKind, protowire.Type, bool) {
+	switch typ {
+	case "int32", "int64", "uint32", "uint64":
+		return KindVarint, protowire.VarintType, true
+	case "sint32", "sint64":
+		return KindSint, protowire.VarintType, true
+	case "bool":
+		return KindBool, protowire.VarintType, true
+	case "enum":
+		return KindEnum, protowire.VarintType, true
+	case "fixed32", "sfixed32":
+		return KindFixed32, protowire.Fixed32Type, true
+	case "float":
+		return KindFloat, protowire.Fixed32Type, true
+	case "fixed64", "sfixed64":
+		return KindFixed64, protowire.Fixed64Type, true
+	case "double":
+		return KindDouble, protowire.Fixed64Type, true
+	}
+	return "", 0, false
+}
+
+// Decode 使用 typeName 对应的消息类型解码 protobuf 数据，Schema 中没有定义的字段按无 Schema 的方式猜测
+func (s *Schema) Decode(raw []byte, typeName string) (*Message, error) {
+	msg, err := Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	mt, ok := s.Message(typeName)
+	if !ok {
+		return nil, utils.Errorf("message type %v not found in schema", typeName)
+	}
+	s.annotate(msg, mt, 0)
+	return msg, nil
+}
+
+// annotate 根据 Schema 修正字段的名字与 kind，wire type 与定义不一致的字段保持原样
+func (s *Schema) annotate(msg *Message, mt *MessageType, depth int) {
+	msg.TypeName = mt.Name
+	if depth > maxDecodeDepth {
+		return
+	}
+	for _, f := range msg.Fields {
+		ft, ok := mt.byNumber[f.Number]
+		if !ok {
+			continue
+		}
+		f.Name, f.TypeName = ft.Name, ft.TypeName
+
+		if kind, wire, ok := scalarKind(ft.Type); ok {
+			if f.Wire == wire {
+				f.Kind, f.Message = kind, nil
+				if kind == KindEnum {
+					f.enum = s.Enums[ft.TypeName]
+				}
+			} else if f.Wire == protowire.BytesType && ft.Repeated {
+				if packed, ok := decodePacked(f.Bytes, kind, wire); ok {
+					f.Kind, f.Message, f.Packed = KindPacked, nil, packed
+					if kind == KindEnum {
+						for _, item := range packed {
+							item.enum = s.Enums[ft.TypeName]
+						}
+					}
+				}
+			}
+			continue
+		}
+
+		switch ft.Type {
+		case "string":
+			if f.Wire == protowire.BytesType && utf8.Valid(f.Bytes) {
+				f.Kind, f.Message = KindString, nil
+			}
+		case "bytes":
+			if f.Wire == protowire.BytesType {
+				f.Kind, f.Message = KindBytes, nil
+			}
+		case "message":
+			if f.Wire != protowire.BytesType {
+				continue
+			}
+			sub := f.Message
+			if f.Kind != KindMessage || sub == nil {
+				decoded, err := Decode(f.Bytes)
+				if err != nil || !bytes.Equal(decoded.Encode(), f.Bytes) {
+					continue
+				}
+				sub = decoded
+			}
+			f.Kind, f.Message = KindMessage, sub
+			if nested, ok := s.Messages[ft.TypeName]; ok {
+				s.annotate(sub, nested, depth+1)
+			}
+		case "group":
+			if f.Wire != protowire.StartGroupType || f.Message == nil {
+				continue
+			}
+			if nested, ok := s.Messages[ft.TypeName]; ok {
+				s.annotate(f.Message, nested, depth+1)
+			}
+		}
+	}
+}
+
+func decodePacked(raw []byte, kind FieldKind, wire protowire.Type) ([]*Field, bool) {
+	var items []*Field
+	for len(raw) > 0 {
+		item := &Field{Wire: wire, Kind: kind}
+		var n int
+		switch wire {
+		case protowire.VarintType:
+			item.Varint, n = protowire.ConsumeVarint(raw)
+		case protowire.Fixed32Type:
+			item.Fixed32, n = protowire.ConsumeFixed32(raw)
+		case protowire.Fixed64Type:
+			item.Fixed64, n = protowire.ConsumeFixed64(raw)
+		}
+		if n <= 0 {
+			return nil, false
+		}
+		items = append(items, item)
+		raw = raw[n:]
+	}
+	return items, true
+}
@@ -0,0 +1,466 @@
+package protobufx
+
+import (
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// 文本格式示例：
+//
+//	1: 150  # id
+//	2: "yaklang"
+//	3 {
+//	  1: fixed32(7)
+//	  2: float(1.5)
+//	}
+//	4: [1, 2, sint(-3)]
+//	5: bytes(00ff)
+//	6: group {
+//	  1: true
+//	}
+//
+// 字段名、枚举名等 Schema 信息以 # 注释的形式给出，解析时会被忽略；使用 Schema 解析时字段可以直接写字段名
+
+// String 将消息转换为可编辑的文本格式，可以通过 ParseText 解析回完全一致的消息
+func (m *Message) String() string {
+	var buf strings.Builder
+	if m != nil && m.TypeName != "" {
+		buf.WriteString("# " + m.TypeName + "\n")
+	}
+	writeFields(&buf, m, "")
+	return buf.String()
+}
+
+func writeFields(buf *strings.Builder, m *Message, indent string) {
+	if m == nil {
+		return
+	}
+	for _, f := range m.Fields {
+		buf.WriteString(indent)
+		buf.WriteString(strconv.Itoa(int(f.Number)))
+		switch {
+		case f.Wire == protowire.StartGroupType:
+			buf.WriteString(": group {")
+			writeComment(buf, f.Name)
+			writeFields(buf, f.Message, indent+"  ")
+			buf.WriteString(indent + "}\n")
+		case f.Wire == protowire.BytesType && f.Kind == KindMessage && f.Message != nil:
+			buf.WriteString(" {")
+			writeComment(buf, f.Name)
+			writeFields(buf, f.Message, indent+"  ")
+			buf.WriteString(indent + "}\n")
+		default:
+			buf.WriteString(": ")
+			buf.WriteString(f.valueText())
+			comment := f.Name
+			if name := f.enumName(); name != "" {
+				comment = strings.TrimSpace(comment + " = " + name)
+			}
+			writeComment(buf, comment)
+		}
+	}
+}
+
+func writeComment(buf *strings.Builder, comment string) {
+	if comment != "" {
+		buf.WriteString("  # " + comment)
+	}
+	buf.WriteString("\n")
+}
+
+func (f *Field) enumName() string {
+	if f.Kind == KindEnum && f.enum != nil {
+		return f.enum.Values[int32(f.Varint)]
+	}
+	if f.Kind == KindPacked {
+		var names []string
+		for _, item := range f.Packed {
+			if name := item.enumName(); name != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			return "[" + strings.Join(names, ", ") + "]"
+		}
+	}
+	return ""
+}
+
+// valueText 返回标量字段的文本，按 wire type 输出，保证解析后可以还原出相同的编码
+func (f *Field) valueText() string {
+	switch f.Wire {
+	case protowire.VarintType:
+		switch f.Kind {
+		case KindSint:
+			return "sint(" + strconv.FormatInt(protowire.DecodeZigZag(f.Varint), 10) + ")"
+		case KindBool:
+			if f.Varint <= 1 {
+				return strconv.FormatBool(f.Varint == 1)
+			}
+		case KindEnum:
+			return strconv.FormatInt(int64(f.Varint), 10)
+		}
+		return strconv.FormatUint(f.Varint, 10)
+	case protowire.Fixed32Type:
+		if f.Kind == KindFloat {
+			v := math.Float32frombits(f.Fixed32)
+			text := strconv.FormatFloat(float64(v), 'g', -1, 32)
+			if bits, ok := parseFixed32(text, true); ok && bits == f.Fixed32 {
+				return "float(" + text + ")"
+			}
+		}
+		return "fixed32(" + strconv.FormatUint(uint64(f.Fixed32), 10) + ")"
+	case protowire.Fixed64Type:
+		if f.Kind == KindDouble {
+			text := strconv.FormatFloat(math.Float64frombits(f.Fixed64), 'g', -1, 64)
+			if bits, ok := parseFixed64(text, true); ok && bits == f.Fixed64 {
+				return "double(" + text + ")"
+			}
+		}
+		return "fixed64(" + strconv.FormatUint(f.Fixed64, 10) + ")"
+	case protowire.BytesType:
+		switch f.Kind {
+		case KindPacked:
+			items := make([]string, 0, len(f.Packed))
+			for _, item := range f.Packed {
+				items = append(items, item.valueText())
+			}
+			return "[" + strings.Join(items, ", ") + "]"
+		case KindString:
+			return strconv.Quote(string(f.Bytes))
+		}
+		return "bytes(" + hex.EncodeToString(f.payload()) + ")"
+	}
+	return ""
+}
+
+// ParseText 解析 Message.String 生成的文本格式
+func ParseText(text string) (*Message, error) {
+	return parseText(text, nil, nil)
+}
+
+// ParseText 使用 typeName 对应的消息类型解析文本格式，字段可以使用字段名，枚举可以使用枚举名，数值按字段类型编码
+func (s *Schema) ParseText(text string, typeName string) (*Message, error) {
+	mt, ok := s.Message(typeName)
+	if !ok {
+		return nil, utils.Errorf("message type %v not found in schema", typeName)
+	}
+	return parseText(text, s, mt)
+}
+
+func parseText(text string, schema *Schema, mt *MessageType) (*Message, error) {
+	p, err := newTextParser(text, schema)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := p.parseMessage(mt, "")
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+type textToken struct {
+	text   string
+	quoted bool
+	line   int
+}
+
+type textParser struct {
+	tokens []textToken
+	pos    int
+	schema *Schema
+	depth  int
+}
+
+func newTextParser(text string, schema *Schema) (*textParser, error) {
+	p := &textParser{schema: schema}
+	line := 1
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(text[i:], "//"):
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			j := i + 1
+			for j < len(text) && text[j] != '"' {
+				if text[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(text) {
+				return nil, utils.Errorf("line %d: unterminated string", line)
+			}
+			value, err := strconv.Unquote(text[i : j+1])
+			if err != nil {
+				return nil, utils.Errorf("line %d: invalid string %s: %v", line, text[i:j+1], err)
+			}
+			p.tokens = append(p.tokens, textToken{text: value, quoted: true, line: line})
+			line += strings.Count(text[i:j+1], "\n")
+			i = j + 1
+		case isTextWordChar(c):
+			j := i
+			for j < len(text) && isTextWordChar(text[j]) {
+				j++
+			}
+			p.tokens = append(p.tokens, textToken{text: text[i:j], line: line})
+			i = j
+		default:
+			p.tokens = append(p.tokens, textToken{text: string(c), line: line})
+			i++
+		}
+	}
+	return p, nil
+}
+
+func isTextWordChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == '+' || c == '$' ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+func (p *textParser) peek() textToken {
+	if p.pos >= len(p.tokens) {
+		return textToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *textParser) next() textToken {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *textParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *textParser) is(text string) bool {
+	tok := p.peek()
+	return !tok.quoted && tok.text == text && !p.eof()
+}
+
+func (p *textParser) errorf(format string, args ...any) error {
+	line := 0
+	if p.pos < len(p.tokens) {
+		line = p.tokens[p.pos].line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+	return utils.Errorf("line %d: "+format, append([]any{line}, args...)...)
+}
+
+func (p *textParser) expect(text string) error {
+	if !p.is(text) {
+		return p.errorf("expect %q but got %q", text, p.peek().text)
+	}
+	p.pos++
+	return nil
+}
+
+// parseMessage 解析字段直到 end（为空时解析到文本结束）
+func (p *textParser) parseMessage(mt *MessageType, end string) (*Message, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDecodeDepth {
+		return nil, p.errorf("message nested too deep")
+	}
+
+	msg := &Message{}
+	if mt != nil {
+		msg.TypeName = mt.Name
+	}
+	for {
+		if p.eof() {
+			if end != "" {
+				return nil, p.errorf("unexpected end of text, missing %q", end)
+			}
+			return msg, nil
+		}
+		if end != "" && p.is(end) {
+			p.pos++
+			return msg, nil
+		}
+		if p.is(",") || p.is(";") {
+			p.pos++
+			continue
+		}
+		field, err := p.parseField(mt)
+		if err != nil {
+			return nil, err
+		}
+		msg.Fields = append(msg.Fields, field)
+	}
+}
+
+func (p *textParser) parseField(mt *MessageType) (*Field, error) {
+	key := p.next()
+	if key.quoted || key.text == "" {
+		p.pos--
+		return nil, p.errorf("expect field number or name but got %q", key.text)
+	}
+	field := &Field{}
+	var ft *FieldType
+	if n, err := strconv.ParseUint(key.text, 10, 32); err == nil {
+		field.Number = protowire.Number(n)
+		if mt != nil {
+			ft = mt.byNumber[field.Number]
+		}
+	} else if mt != nil && mt.byName[key.text] != nil {
+		ft = mt.byName[key.text]
+		field.Number = ft.Number
+	} else {
+		p.pos--
+		return nil, p.errorf("unknown field %q", key.text)
+	}
+	if !field.Number.IsValid() {
+		p.pos--
+		return nil, p.errorf("invalid field number %v", key.text)
+	}
+	if ft != nil {
+		field.Name, field.TypeName = ft.Name, ft.TypeName
+	}
+
+	hasColon := p.is(":")
+	if hasColon {
+		p.pos++
+	}
+	var nested *MessageType
+	if ft != nil && (ft.Type == "message" || ft.Type == "group") {
+		nested = p.schema.Messages[ft.TypeName]
+	}
+	switch {
+	case p.is("{"):
+		p.pos++
+		sub, err := p.parseMessage(nested, "}")
+		if err != nil {
+			return nil, err
+		}
+		if ft != nil && ft.Type == "group" {
+			field.Wire, field.Kind, field.Message = protowire.StartGroupType, KindGroup, sub
+		} else {
+			field.Wire, field.Kind, field.Message = protowire.BytesType, KindMessage, sub
+		}
+		return field, nil
+	case p.is("group") && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "{":
+		p.pos += 2
+		sub, err := p.parseMessage(nested, "}")
+		if err != nil {
+			return nil, err
+		}
+		field.Wire, field.Kind, field.Message = protowire.StartGroupType, KindGroup, sub
+		return field, nil
+	case !hasColon:
+		return nil, p.errorf("expect ':' or '{' after field %v", key.text)
+	case p.is("["):
+		p.pos++
+		field.Wire, field.Kind, field.Packed = protowire.BytesType, KindPacked, []*Field{}
+		for !p.is("]") {
+			if p.eof() {
+				return nil, p.errorf("unexpected end of text, missing ']'")
+			}
+			item := &Field{}
+			if err := p.parseScalar(item, ft); err != nil {
+				return nil, err
+			}
+			if item.Wire == protowire.BytesType {
+				return nil, p.errorf("packed field %v can only contain numbers", key.text)
+			}
+			field.Packed = append(field.Packed, item)
+			if p.is(",") {
+				p.pos++
+			}
+		}
+		p.pos++
+		return field, nil
+	}
+	if err := p.parseScalar(field, ft); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// parseScalar 解析一个值：字符串、true/false、数字、枚举名，或 fixed32(...)、float(...)、sint(...)、bytes(...) 等显式指定编码的写法
+func (p *textParser) parseScalar(field *Field, ft *FieldType) error {
+	tok := p.next()
+	if tok.quoted {
+		field.Wire, field.Kind, field.Bytes = protowire.BytesType, KindString, []byte(tok.text)
+		if ft != nil && ft.Type == "bytes" {
+			field.Kind = KindBytes
+		}
+		return nil
+	}
+	if tok.text == "" {
+		p.pos--
+		return p.errorf("expect value")
+	}
+
+	if p.is("(") {
+		p.pos++
+		var arg string
+		if !p.is(")") {
+			arg = p.next().text
+		}
+		if err := p.expect(")"); err != nil {
+			return err
+		}
+		if tok.text == "bytes" {
+			raw, err := hex.DecodeString(arg)
+			if err != nil {
+				return p.errorf("invalid hex bytes %q", arg)
+			}
+			field.Wire, field.Kind, field.Bytes = protowire.BytesType, KindBytes, raw
+			return nil
+		}
+		kind := FieldKind(tok.text)
+		switch kind {
+		case KindFixed32, KindFixed64, KindFloat, KindDouble, KindSint:
+		default:
+			return p.errorf("unknown value type %v", tok.text)
+		}
+		if err := field.setScalar(kind, arg); err != nil {
+			return p.errorf("%v", err)
+		}
+		return nil
+	}
+
+	kind := KindVarint
+	if ft != nil {
+		if k, _, ok := scalarKind(ft.Type); ok {
+			kind = k
+		}
+	}
+	switch {
+	case tok.text == "true" || tok.text == "false":
+		kind = KindBool
+	case kind == KindEnum:
+		if e, ok := p.schema.Enums[ft.TypeName]; ok {
+			if v, ok := e.byName[tok.text]; ok {
+				field.Wire, field.Kind, field.Varint, field.enum = protowire.VarintType, KindEnum, uint64(int64(v)), e
+				return nil
+			}
+			field.enum = e
+		}
+	case kind == KindVarint && strings.ContainsAny(tok.text, ".eEnN") && !strings.HasPrefix(tok.text, "0x"):
+		// 没有 Schema 时带小数点的数字按 double 编码
+		kind = KindDouble
+	}
+	if err := field.setScalar(kind, tok.text); err != nil {
+		p.pos--
+		return p.errorf("%v", err)
+	}
+	return nil
+}
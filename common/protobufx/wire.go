@@ -0,0 +1,361 @@
+package protobufx
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// FieldKind 描述一个字段的值应当如何展示与编辑，同一种 wire type 可能对应多种 kind
+type FieldKind string
+
+const (
+	KindVarint  FieldKind = "varint"
+	KindSint    FieldKind = "sint"
+	KindBool    FieldKind = "bool"
+	KindEnum    FieldKind = "enum"
+	KindFixed32 FieldKind = "fixed32"
+	KindFixed64 FieldKind = "fixed64"
+	KindFloat   FieldKind = "float"
+	KindDouble  FieldKind = "double"
+	KindString  FieldKind = "string"
+	KindBytes   FieldKind = "bytes"
+	KindMessage FieldKind = "message"
+	KindPacked  FieldKind = "packed"
+	KindGroup   FieldKind = "group"
+)
+
+// Field 是一个 protobuf 字段，保留原始的 wire type，编码时按 wire type 还原
+type Field struct {
+	Number protowire.Number
+	Wire   protowire.Type
+	Kind   FieldKind
+	// 来自 Schema 的字段名与类型名，无 Schema 时为空
+	Name     string
+	TypeName string
+
+	Varint  uint64
+	Fixed32 uint32
+	Fixed64 uint64
+	Bytes   []byte
+	// Kind 为 message / group 时的子消息
+	Message *Message
+	// Kind 为 packed 时的元素，元素只使用 Varint / Fixed32 / Fixed64
+	Packed []*Field
+
+	enum *EnumType
+}
+
+// Message 是一个解码后的 protobuf 消息，字段按出现顺序保存
+type Message struct {
+	// 来自 Schema 的消息类型名
+	TypeName string
+	Fields   []*Field
+}
+
+// Decode 在没有 Schema 的情况下解码 protobuf 数据
+// length-delimited 字段按可打印字符串、嵌套消息、bytes 的顺序猜测类型
+func Decode(raw []byte) (*Message, error) {
+	return decodeMessage(raw, 0)
+}
+
+const maxDecodeDepth = 64
+
+// decodeMessage 使用 RecordsFromBytes 拆分记录，再按 group / endgroup 还原为嵌套的消息
+func decodeMessage(raw []byte, depth int) (*Message, error) {
+	records := RecordsFromBytes(raw)
+	if err := records.Error(); err != nil {
+		return nil, utils.Wrap(err, "protobuf decode failed")
+	}
+
+	msg := &Message{}
+	stack := []*Field{}
+	current := msg
+	for _, record := range records.Records {
+		field := &Field{Number: record.Index}
+		switch record.Type {
+		case "varint":
+			field.Wire, field.Kind, field.Varint = protowire.VarintType, KindVarint, record.Value.(uint64)
+		case "fixed32":
+			field.Wire, field.Kind, field.Fixed32 = protowire.Fixed32Type, KindFixed32, record.Value.(uint32)
+		case "fixed64":
+			field.Wire, field.Kind, field.Fixed64 = protowire.Fixed64Type, KindFixed64, record.Value.(uint64)
+		case "string", "bytes":
+			if v, ok := record.Value.(string); ok {
+				field.Bytes = []byte(v)
+			} else {
+				field.Bytes = record.Value.([]byte)
+			}
+			field.Wire = protowire.BytesType
+			field.Kind, field.Message = guessBytesKind(field.Bytes, depth+len(stack))
+		case "group":
+			if depth+len(stack) >= maxDecodeDepth {
+				return nil, utils.Error("protobuf decode failed: message nested too deep")
+			}
+			field.Wire, field.Kind, field.Message = protowire.StartGroupType, KindGroup, &Message{}
+			current.Fields = append(current.Fields, field)
+			stack = append(stack, field)
+			current = field.Message
+			continue
+		case "endgroup":
+			if len(stack) == 0 || stack[len(stack)-1].Number != record.Index {
+				return nil, utils.Errorf("protobuf decode failed: unexpected end group %d", record.Index)
+			}
+			stack = stack[:len(stack)-1]
+			current = msg
+			if len(stack) > 0 {
+				current = stack[len(stack)-1].Message
+			}
+			continue
+		default:
+			return nil, utils.Errorf("protobuf decode failed: unknown record type %v", record.Type)
+		}
+		current.Fields = append(current.Fields, field)
+	}
+	if len(stack) > 0 {
+		return nil, utils.Errorf("protobuf decode failed: group %d not closed", stack[len(stack)-1].Number)
+	}
+	return msg, nil
+}
+
+func guessBytesKind(v []byte, depth int) (FieldKind, *Message) {
+	if isPrintable(v) {
+		return KindString, nil
+	}
+	if depth < maxDecodeDepth {
+		// 只有重新编码后与原始数据完全一致时才当作嵌套消息，保证修改其他字段时该字段不会变化
+		if sub, err := decodeMessage(v, depth+1); err == nil && len(sub.Fields) > 0 && bytes.Equal(sub.Encode(), v) {
+			return KindMessage, sub
+		}
+	}
+	return KindBytes, nil
+}
+
+func isPrintable(v []byte) bool {
+	if !utf8.Valid(v) {
+		return false
+	}
+	for _, r := range string(v) {
+		if r == '\t' || r == '\n' || r == '\r' {
+			continue
+		}
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Encode 将消息编码为 protobuf wire format
+func (m *Message) Encode() []byte {
+	return m.Records().ToBytes()
+}
+
+// Records 将消息转换为 wire 层的记录，group 展开为 group / endgroup 两条记录
+func (m *Message) Records() *Records {
+	records := newRecords()
+	m.appendRecords(records)
+	return records
+}
+
+func (m *Message) appendRecords(records *Records) {
+	if m == nil {
+		return
+	}
+	for _, f := range m.Fields {
+		switch f.Wire {
+		case protowire.VarintType:
+			records.Records = append(records.Records, newRecord(f.Number, "varint", f.Varint))
+		case protowire.Fixed32Type:
+			records.Records = append(records.Records, newRecord(f.Number, "fixed32", f.Fixed32))
+		case protowire.Fixed64Type:
+			records.Records = append(records.Records, newRecord(f.Number, "fixed64", f.Fixed64))
+		case protowire.BytesType:
+			records.Records = append(records.Records, newRecord(f.Number, "bytes", f.payload()))
+		case protowire.StartGroupType:
+			records.Records = append(records.Records, newRecord(f.Number, "group", nil))
+			f.Message.appendRecords(records)
+			records.Records = append(records.Records, newRecord(f.Number, "endgroup", nil))
+		}
+	}
+}
+
+// payload 返回 length-delimited 字段的内容，嵌套消息与 packed 字段重新编码
+func (f *Field) payload() []byte {
+	switch f.Kind {
+	case KindMessage:
+		if f.Message != nil {
+			return f.Message.Encode()
+		}
+	case KindPacked:
+		var b []byte
+		for _, item := range f.Packed {
+			switch item.Wire {
+			case protowire.Fixed32Type:
+				b = protowire.AppendFixed32(b, item.Fixed32)
+			case protowire.Fixed64Type:
+				b = protowire.AppendFixed64(b, item.Fixed64)
+			default:
+				b = protowire.AppendVarint(b, item.Varint)
+			}
+		}
+		return b
+	}
+	return f.Bytes
+}
+
+// Value 返回字段值对应的 Go 值，嵌套消息返回 *Message
+func (f *Field) Value() any {
+	switch f.Kind {
+	case KindSint:
+		return protowire.DecodeZigZag(f.Varint)
+	case KindBool:
+		return f.Varint != 0
+	case KindEnum:
+		return int32(f.Varint)
+	case KindVarint:
+		return f.Varint
+	case KindFixed32:
+		return f.Fixed32
+	case KindFixed64:
+		return f.Fixed64
+	case KindFloat:
+		return float32FromBits(f.Fixed32)
+	case KindDouble:
+		return float64FromBits(f.Fixed64)
+	case KindString:
+		return string(f.Bytes)
+	case KindBytes:
+		return f.Bytes
+	case KindMessage, KindGroup:
+		return f.Message
+	case KindPacked:
+		var values []any
+		for _, item := range f.Packed {
+			values = append(values, item.Value())
+		}
+		return values
+	}
+	return nil
+}
+
+// SetValue 使用字符串设置标量字段的值：数值字段在无法解析为对应类型时转换为 length-delimited 字符串，
+// 这样模糊测试时可以覆盖类型混淆的情况
+func (f *Field) SetValue(value string) {
+	switch f.Kind {
+	case KindVarint, KindEnum, KindBool, KindSint, KindFixed32, KindFloat, KindFixed64, KindDouble:
+		if f.setScalar(f.Kind, value) == nil {
+			return
+		}
+	case KindBytes:
+		f.Bytes = []byte(value)
+		return
+	}
+	f.Wire, f.Kind = protowire.BytesType, KindString
+	f.Bytes, f.Message, f.Packed = []byte(value), nil, nil
+}
+
+// setScalar 按 kind 解析标量值并设置对应的 wire type
+func (f *Field) setScalar(kind FieldKind, value string) error {
+	switch kind {
+	case KindVarint, KindEnum:
+		v, ok := parseVarint(value)
+		if !ok {
+			return utils.Errorf("invalid %v value: %v", kind, value)
+		}
+		f.Wire, f.Varint = protowire.VarintType, v
+	case KindBool:
+		switch value {
+		case "true":
+			f.Varint = 1
+		case "false":
+			f.Varint = 0
+		default:
+			v, ok := parseVarint(value)
+			if !ok {
+				return utils.Errorf("invalid bool value: %v", value)
+			}
+			f.Varint = v
+		}
+		f.Wire = protowire.VarintType
+	case KindSint:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return utils.Errorf("invalid sint value: %v", value)
+		}
+		f.Wire, f.Varint = protowire.VarintType, protowire.EncodeZigZag(v)
+	case KindFixed32, KindFloat:
+		v, ok := parseFixed32(value, kind == KindFloat)
+		if !ok {
+			return utils.Errorf("invalid %v value: %v", kind, value)
+		}
+		f.Wire, f.Fixed32 = protowire.Fixed32Type, v
+	case KindFixed64, KindDouble:
+		v, ok := parseFixed64(value, kind == KindDouble)
+		if !ok {
+			return utils.Errorf("invalid %v value: %v", kind, value)
+		}
+		f.Wire, f.Fixed64 = protowire.Fixed64Type, v
+	default:
+		return utils.Errorf("%v is not a scalar kind", kind)
+	}
+	f.Kind = kind
+	return nil
+}
+
+// parseVarint 解析十进制或 0x 开头的十六进制整数，负数按 int64 补码保存
+func parseVarint(s string) (uint64, bool) {
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s, base = s[2:], 16
+	}
+	if v, err := strconv.ParseUint(s, base, 64); err == nil {
+		return v, true
+	}
+	if v, err := strconv.ParseInt(s, base, 64); err == nil {
+		return uint64(v), true
+	}
+	return 0, false
+}
+
+// parseFixed32 解析 fixed32 / float 的值，isFloat 为 true 时优先按浮点数解析
+func parseFixed32(s string, isFloat bool) (uint32, bool) {
+	if !isFloat {
+		if v, ok := parseVarint(s); ok && (v <= math.MaxUint32 || (int64(v) < 0 && int64(v) >= math.MinInt32)) {
+			return uint32(v), true
+		}
+	}
+	f, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return 0, false
+	}
+	return math.Float32bits(float32(f)), true
+}
+
+// parseFixed64 解析 fixed64 / double 的值，isFloat 为 true 时优先按浮点数解析
+func parseFixed64(s string, isFloat bool) (uint64, bool) {
+	if !isFloat {
+		if v, ok := parseVarint(s); ok {
+			return v, true
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return math.Float64bits(f), true
+}
+
+func float32FromBits(v uint32) float32 {
+	return math.Float32frombits(v)
+}
+
+func float64FromBits(v uint64) float64 {
+	return math.Float64frombits(v)
+}
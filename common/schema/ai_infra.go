@@ -92,6 +92,8 @@ type AiProvider struct {
 	DomainOrURL string `json:"domain_or_url" gorm:"index"`
 	APIKey      string `json:"api_key" gorm:"index"`
 	NoHTTPS     bool   `json:"no_https"`
+	// 是否为 embedding 模型，embedding 模型只用于 /v1/embeddings
+	Embedding bool `json:"embedding" gorm:"index"`
 
 	// 可用性指标
 	SuccessCount  int64 `json:"success_count"`  // 成功请求总数
@@ -122,6 +124,32 @@ type AiApiKeys struct {
 	Active        bool      `json:"active" gorm:"default:true"` // 新增：API Key 激活状态
 }
 
+// AiApiKeyQuota API Key 的配额，ModelName 为空时对该 Key 请求的所有模型生效，限制为 0 表示不限制
+type AiApiKeyQuota struct {
+	gorm.Model
+	APIKey               string `json:"api_key" gorm:"index"`
+	ModelName            string `json:"model_name" gorm:"index"`
+	RequestsPerMinute    int64  `json:"requests_per_minute"`    // 每分钟请求数
+	TokensPerDay         int64  `json:"tokens_per_day"`         // 每天的 token 数（输入 + 输出）
+	MaxConcurrentStreams int64  `json:"max_concurrent_streams"` // 同时进行的请求数
+}
+
+// AiApiKeyUsage 按天、按模型统计的 API Key 用量
+type AiApiKeyUsage struct {
+	gorm.Model
+	APIKey        string `json:"api_key" gorm:"index"`
+	ModelName     string `json:"model_name" gorm:"index"`
+	Date          string `json:"date" gorm:"index"` // 2006-01-02
+	RequestCount  int64  `json:"request_count"`
+	SuccessCount  int64  `json:"success_count"`
+	FailureCount  int64  `json:"failure_count"`
+	RejectedCount int64  `json:"rejected_count"` // 超出配额被拒绝的请求数
+	InputTokens   int64  `json:"input_tokens"`
+	OutputTokens  int64  `json:"output_tokens"`
+	// 上游没有返回 usage 时 token 数为估算值，估算的用量与上游返回的用量分开记录
+	Estimated bool `json:"estimated" gorm:"index"`
+}
+
 type LoginSession struct {
 	gorm.Model
 
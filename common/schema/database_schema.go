@@ -63,9 +63,11 @@ var ProfileTables = []interface{}{
 	&HotPatchTemplate{},
 	&AIForge{},
 
-	&AiProvider{},   // for aibalance
-	&AiApiKeys{},    // for aibalance
-	&LoginSession{}, // for aibalance
+	&AiProvider{},    // for aibalance
+	&AiApiKeys{},     // for aibalance
+	&AiApiKeyQuota{}, // for aibalance
+	&AiApiKeyUsage{}, // for aibalance
+	&LoginSession{},  // for aibalance
 	&AIYakTool{},
 }
 
@@ -111,6 +113,9 @@ var ProjectTables = []interface{}{
 	// AI
 	&AiCoordinatorRuntime{},
 	&AiCheckpoint{},
+
+	// MCP
+	&MCPToolAuditLog{},
 }
 
 func RegisterDatabaseSchema(key uint8, schema ...any) {
@@ -0,0 +1,21 @@
+package schema
+
+import "github.com/jinzhu/gorm"
+
+// MCPToolAuditLog records a tool invocation of the yak MCP server
+type MCPToolAuditLog struct {
+	gorm.Model
+
+	// TokenName is the name of the auth token used by the client, empty if auth is disabled
+	TokenName  string `json:"token_name" gorm:"index"`
+	SessionID  string `json:"session_id" gorm:"index"`
+	Transport  string `json:"transport"`
+	RemoteAddr string `json:"remote_addr"`
+	ToolName   string `json:"tool_name" gorm:"index"`
+	Arguments  string `json:"arguments"`
+	Success    bool   `json:"success"`
+	// Denied means the tool is not allowed for the token
+	Denied     bool   `json:"denied"`
+	Error      string `json:"error"`
+	DurationMs int64  `json:"duration_ms"`
+}
@@ -42,6 +42,8 @@ func GetAllSFSupportLanguage() []string {
 		"javaScript",
 		"php",
 		"golang",
+		"python",
+		"c",
 		"general", // 通用规则
 	}
 }
@@ -95,6 +95,9 @@ type VectorStoreDocument struct {
 	// 所属集合的ID
 	CollectionID uint `json:"collection_id" gorm:"index"`
 
+	// 文档内容，用于关键词检索
+	Content string `gorm:"type:text" json:"content"`
+
 	// 文档元数据，以JSON格式存储
 	Metadata MetadataMap `gorm:"type:text" json:"metadata"`
 
@@ -102,7 +105,54 @@ type VectorStoreDocument struct {
 	Embedding FloatArray `gorm:"type:text" json:"embedding"`
 }
 
+// HNSWNeighbors 用于存储 HNSW 节点在每一层的邻居文档 ID
+type HNSWNeighbors [][]string
+
+// Value 实现 driver.Valuer 接口
+func (n HNSWNeighbors) Value() (driver.Value, error) {
+	if n == nil {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(n)
+	return string(bytes), err
+}
+
+// Scan 实现 sql.Scanner 接口
+func (n *HNSWNeighbors) Scan(value interface{}) error {
+	if value == nil {
+		*n = nil
+		return nil
+	}
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return utils.Errorf("不支持的类型: %T", value)
+	}
+	return json.Unmarshal(bytes, n)
+}
+
+// VectorStoreHNSWNode 表示集合 HNSW 近似最近邻索引中的一个节点
+type VectorStoreHNSWNode struct {
+	gorm.Model
+
+	// 所属集合的ID
+	CollectionID uint `json:"collection_id" gorm:"index"`
+
+	// 对应的文档ID
+	DocumentID string `json:"document_id" gorm:"index"`
+
+	// 节点所在的最高层
+	Level int `json:"level"`
+
+	// 每一层的邻居，以JSON格式存储
+	Neighbors HNSWNeighbors `gorm:"type:text" json:"neighbors"`
+}
+
 func init() {
 	// 注册到数据库模式中
-	RegisterDatabaseSchema(KEY_SCHEMA_PROFILE_DATABASE, &VectorStoreCollection{}, &VectorStoreDocument{})
+	RegisterDatabaseSchema(KEY_SCHEMA_PROFILE_DATABASE, &VectorStoreCollection{}, &VectorStoreDocument{}, &VectorStoreHNSWNode{})
 }
@@ -39,6 +39,9 @@ const (
 
 	//扫描任务进度
 	ScanResult_Process ScanResultType = "process"
+
+	// schema.Risk 插件发现的风险
+	ScanResult_Risk ScanResultType = "risk"
 )
 
 type ScanResult struct {
@@ -0,0 +1,19 @@
+package spec
+
+// ScanShardReport 是分布式扫描节点通过 API_ReportScanShard 向控制端汇报的分片进度与结果
+type ScanShardReport struct {
+	TaskId   string `json:"task_id"`
+	ShardId  string `json:"shard_id"`
+	NodeId   string `json:"node_id"`
+	Attempt  int    `json:"attempt"`
+	Finished bool   `json:"finished"`
+
+	// 0-1 之间的分片进度
+	Progress float64       `json:"progress"`
+	Results  []*ScanResult `json:"results"`
+}
+
+type ScanShardReportResponse struct {
+	Ok     bool   `json:"ok"`
+	Reason string `json:"reason,omitempty"`
+}
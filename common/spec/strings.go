@@ -47,8 +47,9 @@ const (
 	CommonScanFingerprintTaskKey   = "palm.stream.task.scan-fingerprint"
 	CommonScanFingerprintResultKey = "palm.stream.result.scan-fingerprint"
 
-	API_RegisterNode   = "register-palm-node"
-	API_UnregisterNode = "unregister-palm-node"
+	API_RegisterNode    = "register-palm-node"
+	API_UnregisterNode  = "unregister-palm-node"
+	API_ReportScanShard = "report-scan-shard"
 
 	BackendKey_HTTPFlow                           = "http-flow"
 	BackendKey_Scanner                            = "scanner"
@@ -7,6 +7,7 @@ const (
 	BRUTE
 	SEARCH
 	ZONE_TRANSFER
+	PASSIVE
 )
 
 type SubdomainScannerConfig struct {
@@ -55,6 +56,16 @@ type SubdomainScannerConfig struct {
 	// 进行各种数据源搜索的时候，需要设置的 HTTP 超时时间
 	// 默认 10s
 	TimeoutForEachHTTPSearch time.Duration
+
+	// 被动数据源，例如从离线数据集导入的 PassiveIndex
+	PassiveSources []PassiveSource
+
+	// 被动数据源中没有 IP 的子域名是否需要解析，默认为 false
+	// 离线环境下解析只会等待超时
+	PassiveResolve bool
+
+	// 是否通过 WithModes 指定了发现模式，没有指定时设置被动数据源只使用被动模式
+	modesSet bool
 }
 
 func (s *SubdomainScannerConfig) init() {
@@ -74,11 +85,24 @@ func (s *SubdomainScannerConfig) init() {
 
 type ConfigOption func(s *SubdomainScannerConfig)
 
-// 配置子域名发现模式
+// 配置子域名发现模式，设置了被动数据源时总是会启用被动模式
 func WithModes(modes ...int) ConfigOption {
 	return func(s *SubdomainScannerConfig) {
-		s.Modes = modes
+		s.Modes = append([]int{}, modes...)
+		s.modesSet = true
+		if len(s.PassiveSources) > 0 {
+			s.enablePassive()
+		}
+	}
+}
+
+func (s *SubdomainScannerConfig) enablePassive() {
+	for _, mode := range s.Modes {
+		if mode == PASSIVE {
+			return
+		}
 	}
+	s.Modes = append(s.Modes, PASSIVE)
 }
 
 // recursive 是一个选项参数，设置是否递归扫描子域名，如果不递归扫描，那么只会扫描一层子域名，默认为false
@@ -172,6 +196,37 @@ func WithWildCardToStop(t bool) ConfigOption {
 	}
 }
 
+// passive 是一个选项参数，设置被动数据源并启用被动模式，被动模式只从已经导入的数据中查询子域名
+// 没有通过 modes 指定发现模式时只使用被动模式，不会发送任何探测；指定了 modes 时会在其基础上追加被动模式
+// Example:
+// ```
+// index = subdomain.NewPassiveIndex()
+// index.ImportCTLogFile("/tmp/ct.jsonl")~
+// subdomain.Scan("example.com", subdomain.passive(index)) // 只使用被动模式
+// subdomain.Scan("example.com", subdomain.modes(subdomain.MODE_BRUTE), subdomain.passive(index)) // 爆破与被动模式
+// ```
+func WithPassiveSources(sources ...PassiveSource) ConfigOption {
+	return func(s *SubdomainScannerConfig) {
+		s.PassiveSources = append(s.PassiveSources, sources...)
+		if !s.modesSet {
+			s.Modes = []int{PASSIVE}
+			return
+		}
+		s.enablePassive()
+	}
+}
+
+// passiveResolve 是一个选项参数，设置被动数据源中没有 IP 的子域名是否需要解析，默认为 false
+// Example:
+// ```
+// subdomain.Scan("example.com", subdomain.passive(index), subdomain.passiveResolve(true))
+// ```
+func WithPassiveResolve(b bool) ConfigOption {
+	return func(s *SubdomainScannerConfig) {
+		s.PassiveResolve = b
+	}
+}
+
 func WithTimeoutForEachHTTPSearch(timeout time.Duration) ConfigOption {
 	return func(s *SubdomainScannerConfig) {
 		s.TimeoutForEachHTTPSearch = timeout
@@ -0,0 +1,244 @@
+package subdomain
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// CT 日志中可能保存域名的字段，兼容 certstream、crt.sh 以及常见的 CT 解析工具的输出
+var ctDomainKeys = map[string]bool{
+	"all_domains":       true,
+	"dns_names":         true,
+	"dnsnames":          true,
+	"domains":           true,
+	"name_value":        true,
+	"common_name":       true,
+	"cn":                true,
+	"san":               true,
+	"sans":              true,
+	"subject_alt_names": true,
+	"subjectaltname":    true,
+}
+
+type datasetReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (d *datasetReader) Close() error {
+	for _, c := range d.closers {
+		c.Close()
+	}
+	return nil
+}
+
+// openDataset 打开数据集文件，gzip 压缩的文件会自动解压
+func openDataset(path string) (io.ReadCloser, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, utils.Errorf("open dataset %s failed: %s", path, err)
+	}
+	reader := bufio.NewReader(fp)
+	magic, _ := reader.Peek(2)
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzReader, err := gzip.NewReader(reader)
+		if err != nil {
+			fp.Close()
+			return nil, utils.Errorf("open gzip dataset %s failed: %s", path, err)
+		}
+		return &datasetReader{Reader: gzReader, closers: []io.Closer{gzReader, fp}}, nil
+	}
+	return &datasetReader{Reader: reader, closers: []io.Closer{fp}}, nil
+}
+
+func (p *PassiveIndex) importLines(path string, handler func(line []byte) int) (int, error) {
+	reader, err := openDataset(path)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	return p.importReaderLines(reader, handler)
+}
+
+func (p *PassiveIndex) importReaderLines(r io.Reader, handler func(line []byte) int) (int, error) {
+	reader := bufio.NewReader(r)
+	count := 0
+	for {
+		line, err := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			count += handler(line)
+		}
+		if err != nil {
+			if err == io.EOF {
+				return count, nil
+			}
+			return count, err
+		}
+	}
+}
+
+func collectCTDomains(v any, inDomainKey bool, callback func(string)) {
+	switch ret := v.(type) {
+	case map[string]any:
+		for key, value := range ret {
+			collectCTDomains(value, ctDomainKeys[strings.ToLower(key)], callback)
+		}
+	case []any:
+		for _, value := range ret {
+			collectCTDomains(value, inDomainKey, callback)
+		}
+	case string:
+		if !inDomainKey {
+			return
+		}
+		for _, domain := range strings.FieldsFunc(ret, func(r rune) bool {
+			return r == '\n' || r == ',' || r == ' ' || r == '\t'
+		}) {
+			callback(domain)
+		}
+	}
+}
+
+// ImportCTLogs 导入 JSON Lines 格式的 CT 日志，返回导入的域名数量
+func (p *PassiveIndex) ImportCTLogs(r io.Reader, origin string) (int, error) {
+	return p.importReaderLines(r, func(line []byte) int {
+		var v any
+		if err := json.Unmarshal(line, &v); err != nil {
+			return 0
+		}
+		count := 0
+		collectCTDomains(v, false, func(domain string) {
+			if p.Add(domain, "", PassiveSource_CT, origin) {
+				count++
+			}
+		})
+		return count
+	})
+}
+
+func (p *PassiveIndex) ImportCTLogFile(path string) (int, error) {
+	reader, err := openDataset(path)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	return p.ImportCTLogs(reader, filepath.Base(path))
+}
+
+func (p *PassiveIndex) addFDNSRecord(name, recordType, value, origin string) int {
+	count := 0
+	add := func(domain, ip string) {
+		if p.Add(domain, ip, PassiveSource_FDNS, origin) {
+			count++
+		}
+	}
+	switch strings.ToLower(recordType) {
+	case "a", "aaaa":
+		add(name, value)
+	case "cname", "ns", "mx":
+		add(name, "")
+		// mx 记录的值可能带有优先级
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			add(fields[len(fields)-1], "")
+		}
+	case "ptr":
+		add(value, "")
+	default:
+		add(name, "")
+	}
+	return count
+}
+
+// ImportFDNS 导入 Rapid7 FDNS 格式的数据集，支持 JSON Lines（name/type/value）
+// 与 CSV（timestamp,name,type,value）两种格式，返回导入的记录数量
+func (p *PassiveIndex) ImportFDNS(r io.Reader, origin string) (int, error) {
+	return p.importReaderLines(r, func(line []byte) int {
+		if line[0] == '{' {
+			var record struct {
+				Name  string `json:"name"`
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			}
+			if err := json.Unmarshal(line, &record); err != nil {
+				return 0
+			}
+			return p.addFDNSRecord(record.Name, record.Type, record.Value, origin)
+		}
+		fields := strings.Split(string(line), ",")
+		switch len(fields) {
+		case 4:
+			return p.addFDNSRecord(fields[1], fields[2], fields[3], origin)
+		case 3:
+			return p.addFDNSRecord(fields[0], fields[1], fields[2], origin)
+		}
+		return 0
+	})
+}
+
+func (p *PassiveIndex) ImportFDNSFile(path string) (int, error) {
+	reader, err := openDataset(path)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	return p.ImportFDNS(reader, filepath.Base(path))
+}
+
+// ImportPcapFile 从 pcap 文件中提取 DNS 响应，返回导入的记录数量，
+// 每条记录的来源为响应该查询的 DNS 服务器
+func (p *PassiveIndex) ImportPcapFile(path string) (int, error) {
+	count := 0
+	err := pcaputil.OpenPcapFile(
+		path,
+		pcaputil.WithDisableAssembly(true),
+		pcaputil.WithEveryPacket(func(packet gopacket.Packet) {
+			layer := packet.Layer(layers.LayerTypeDNS)
+			if layer == nil {
+				return
+			}
+			dns, ok := layer.(*layers.DNS)
+			if !ok || !dns.QR || dns.ResponseCode != layers.DNSResponseCodeNoErr {
+				return
+			}
+			origin := filepath.Base(path)
+			if network := packet.NetworkLayer(); network != nil {
+				origin = network.NetworkFlow().Src().String()
+			}
+			for _, answer := range dns.Answers {
+				name := string(answer.Name)
+				switch answer.Type {
+				case layers.DNSTypeA, layers.DNSTypeAAAA:
+					if p.Add(name, answer.IP.String(), PassiveSource_PCAP, origin) {
+						count++
+					}
+				case layers.DNSTypeCNAME:
+					if p.Add(name, "", PassiveSource_PCAP, origin) {
+						count++
+					}
+					if p.Add(string(answer.CNAME), "", PassiveSource_PCAP, origin) {
+						count++
+					}
+				}
+			}
+		}),
+	)
+	if err != nil {
+		return count, utils.Errorf("read pcap %s failed: %s", path, err)
+	}
+	log.Infof("import %d dns answers from %s", count, path)
+	return count, nil
+}
@@ -0,0 +1,296 @@
+package subdomain
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// 同一个泛解析父域名下，至少有多少个子域名解析到同一个 IP 时认为该 IP 是泛解析结果
+const defaultWildcardThreshold = 5
+
+type passiveEntry struct {
+	domain     string
+	ips        []string
+	provenance []*Provenance
+}
+
+func (e *passiveEntry) fromCertificate() bool {
+	for _, p := range e.provenance {
+		if p.Source == PassiveSource_CT {
+			return true
+		}
+	}
+	return false
+}
+
+// PassiveIndex 本地的被动子域名索引，可以从离线的 CT 日志、FDNS 数据集与 pcap 中导入，
+// 查询时会去掉泛解析产生的子域名
+type PassiveIndex struct {
+	lock    sync.RWMutex
+	entries map[string]*passiveEntry
+	// 泛解析记录，key 为父域名
+	wildcards map[string]*passiveEntry
+
+	// 反转后排序的域名，用于按照后缀查询
+	sorted []string
+	dirty  bool
+
+	WildcardThreshold int
+}
+
+func NewPassiveIndex() *PassiveIndex {
+	return &PassiveIndex{
+		entries:           make(map[string]*passiveEntry),
+		wildcards:         make(map[string]*passiveEntry),
+		WildcardThreshold: defaultWildcardThreshold,
+	}
+}
+
+func (p *PassiveIndex) Name() string {
+	return "passive-index"
+}
+
+func normalizePassiveDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimSuffix(domain, ".")
+	return formatDomain(domain)
+}
+
+// reverseDomain www.example.com -> com.example.www
+func reverseDomain(domain string) string {
+	labels := strings.Split(domain, ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
+
+// Add 向索引中添加一条记录，ip 可以为空，以 *. 开头的域名会被记录为泛解析
+func (p *PassiveIndex) Add(domain string, ip string, source string, origin string) bool {
+	domain = normalizePassiveDomain(domain)
+	wildcard := false
+	if strings.HasPrefix(domain, "*.") {
+		wildcard = true
+		domain = domain[2:]
+	}
+	if domain == "" || !strings.Contains(domain, ".") || !utils.IsValidDomain(domain) {
+		return false
+	}
+	ip = strings.TrimSpace(ip)
+	if ip != "" && net.ParseIP(ip) == nil {
+		ip = ""
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	provenance := []*Provenance{{Source: source, Origin: origin}}
+	entry := p.entries[domain]
+	if entry == nil {
+		entry = &passiveEntry{domain: domain}
+		p.entries[domain] = entry
+		p.dirty = true
+	}
+	// 泛解析记录的父域名同样作为子域名记录，泛解析的 IP 只记录在泛解析记录中
+	entry.provenance = mergeProvenance(entry.provenance, provenance)
+	if wildcard {
+		entry = p.wildcards[domain]
+		if entry == nil {
+			entry = &passiveEntry{domain: domain}
+			p.wildcards[domain] = entry
+		}
+		entry.provenance = mergeProvenance(entry.provenance, provenance)
+	}
+	if ip != "" {
+		entry.ips = mergeStrings(entry.ips, []string{ip})
+	}
+	return true
+}
+
+func (p *PassiveIndex) Len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.entries)
+}
+
+// children 返回 target 以及 target 下所有的子域名，需要持有锁
+func (p *PassiveIndex) children(target string) []*passiveEntry {
+	if p.dirty {
+		p.sorted = p.sorted[:0]
+		for domain := range p.entries {
+			p.sorted = append(p.sorted, reverseDomain(domain))
+		}
+		sort.Strings(p.sorted)
+		p.dirty = false
+	}
+
+	prefix := reverseDomain(target)
+	var results []*passiveEntry
+	for i := sort.SearchStrings(p.sorted, prefix); i < len(p.sorted); i++ {
+		reversed := p.sorted[i]
+		if !strings.HasPrefix(reversed, prefix) {
+			break
+		}
+		if len(reversed) > len(prefix) && reversed[len(prefix)] != '.' {
+			continue
+		}
+		results = append(results, p.entries[reverseDomain(reversed)])
+	}
+	return results
+}
+
+// wildcardIPs 计算泛解析父域名对应的 IP，记录中没有 IP 时（例如来自泛域名证书）
+// 根据解析到同一个 IP 的直接子域名数量推断，需要持有锁
+func (p *PassiveIndex) wildcardIPs(parent string, entries []*passiveEntry) map[string]bool {
+	wildcard, ok := p.wildcards[parent]
+	if !ok {
+		return nil
+	}
+	ips := make(map[string]bool)
+	for _, ip := range wildcard.ips {
+		ips[ip] = true
+	}
+	if len(ips) > 0 {
+		return ips
+	}
+
+	threshold := p.WildcardThreshold
+	if threshold <= 0 {
+		threshold = defaultWildcardThreshold
+	}
+	counter := make(map[string]int)
+	for _, entry := range entries {
+		if parentDomain(entry.domain) != parent {
+			continue
+		}
+		for _, ip := range entry.ips {
+			counter[ip]++
+		}
+	}
+	for ip, count := range counter {
+		if count >= threshold {
+			ips[ip] = true
+		}
+	}
+	return ips
+}
+
+func parentDomain(domain string) string {
+	index := strings.Index(domain, ".")
+	if index < 0 {
+		return ""
+	}
+	return domain[index+1:]
+}
+
+// Query 查询 target 下的所有子域名，只由泛解析产生的子域名会被去掉，
+// 出现在证书中的子域名总是保留
+func (p *PassiveIndex) Query(ctx context.Context, target string) ([]*PassiveRecord, error) {
+	target = normalizePassiveDomain(target)
+	if target == "" {
+		return nil, utils.Error("empty target")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	entries := p.children(target)
+	wildcardIPsCache := make(map[string]map[string]bool)
+	var results []*PassiveRecord
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+		parent := parentDomain(entry.domain)
+		ips, ok := wildcardIPsCache[parent]
+		if !ok {
+			ips = p.wildcardIPs(parent, entries)
+			wildcardIPsCache[parent] = ips
+		}
+		if len(ips) > 0 && len(entry.ips) > 0 && !entry.fromCertificate() {
+			allWildcard := true
+			for _, ip := range entry.ips {
+				if !ips[ip] {
+					allWildcard = false
+					break
+				}
+			}
+			if allWildcard {
+				continue
+			}
+		}
+		results = append(results, &PassiveRecord{
+			Domain:     entry.domain,
+			IPs:        append([]string{}, entry.ips...),
+			Provenance: mergeProvenance(nil, entry.provenance),
+		})
+	}
+	return results, nil
+}
+
+// Save 以 JSON Lines 的格式保存索引，可以通过 LoadPassiveIndex 重新加载
+func (p *PassiveIndex) Save(path string) error {
+	fp, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return utils.Errorf("open %s failed: %s", path, err)
+	}
+	defer fp.Close()
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	writer := bufio.NewWriter(fp)
+	encoder := json.NewEncoder(writer)
+	save := func(domain string, entry *passiveEntry) error {
+		return encoder.Encode(&PassiveRecord{Domain: domain, IPs: entry.ips, Provenance: entry.provenance})
+	}
+	for domain, entry := range p.entries {
+		if err := save(domain, entry); err != nil {
+			return err
+		}
+	}
+	for domain, entry := range p.wildcards {
+		if err := save("*."+domain, entry); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// LoadPassiveIndex 加载 Save 保存的索引
+func LoadPassiveIndex(path string) (*PassiveIndex, error) {
+	index := NewPassiveIndex()
+	_, err := index.importLines(path, func(line []byte) int {
+		var record PassiveRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return 0
+		}
+		count := 0
+		for _, provenance := range record.Provenance {
+			if len(record.IPs) <= 0 {
+				if index.Add(record.Domain, "", provenance.Source, provenance.Origin) {
+					count++
+				}
+				continue
+			}
+			for _, ip := range record.IPs {
+				if index.Add(record.Domain, ip, provenance.Source, provenance.Origin) {
+					count++
+				}
+			}
+		}
+		return count
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
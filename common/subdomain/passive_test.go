@@ -0,0 +1,190 @@
+package subdomain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func queryDomains(t *testing.T, index *PassiveIndex, target string) map[string]*PassiveRecord {
+	records, err := index.Query(context.Background(), target)
+	require.NoError(t, err)
+	ret := make(map[string]*PassiveRecord)
+	for _, record := range records {
+		ret[record.Domain] = record
+	}
+	return ret
+}
+
+func TestPassiveIndex_ImportCTLogs(t *testing.T) {
+	ctLogs := strings.Join([]string{
+		`{"message_type":"certificate_update","data":{"leaf_cert":{"all_domains":["www.example.com","*.dev.example.com"],"subject":{"CN":"www.example.com"}}}}`,
+		`{"issuer_name":"C=US, O=Let's Encrypt","common_name":"api.example.com","name_value":"api.example.com\nAPI2.Example.com."}`,
+		`{"dns_names":["mail.other.com"]}`,
+		`not a json line`,
+	}, "\n")
+
+	index := NewPassiveIndex()
+	_, err := index.ImportCTLogs(strings.NewReader(ctLogs), "ct.jsonl")
+	require.NoError(t, err)
+
+	domains := queryDomains(t, index, "example.com")
+	assert.Len(t, domains, 4)
+	for _, domain := range []string{"www.example.com", "dev.example.com", "api.example.com", "api2.example.com"} {
+		require.Contains(t, domains, domain)
+		assert.Equal(t, PassiveSource_CT, domains[domain].Provenance[0].Source)
+		assert.Equal(t, "ct.jsonl", domains[domain].Provenance[0].Origin)
+	}
+	assert.NotContains(t, domains, "mail.other.com")
+	// 只匹配完整的标签，不匹配 notexample.com
+	assert.Len(t, queryDomains(t, index, "ample.com"), 0)
+}
+
+func TestPassiveIndex_FDNSWildcard(t *testing.T) {
+	var lines []string
+	for _, name := range []string{"a1", "b2", "c3", "d4", "e5", "f6"} {
+		lines = append(lines, `{"timestamp":"1700000000","name":"`+name+`.wild.example.com","type":"a","value":"10.0.0.1"}`)
+	}
+	lines = append(lines,
+		`{"timestamp":"1700000000","name":"*.wild.example.com","type":"a","value":""}`,
+		`{"timestamp":"1700000000","name":"real.wild.example.com","type":"a","value":"10.0.0.2"}`,
+		`1700000000,www.example.com,a,10.0.0.3`,
+		`1700000000,cdn.example.com,cname,www.example.com`,
+	)
+
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	_, _ = gz.Write([]byte(strings.Join(lines, "\n")))
+	require.NoError(t, gz.Close())
+	dataset := filepath.Join(t.TempDir(), "fdns_a.json.gz")
+	require.NoError(t, os.WriteFile(dataset, buf.Bytes(), 0o644))
+
+	index := NewPassiveIndex()
+	_, err := index.ImportFDNSFile(dataset)
+	require.NoError(t, err)
+
+	// 在证书中出现的泛解析子域名需要保留
+	_, err = index.ImportCTLogs(strings.NewReader(`{"dns_names":["a1.wild.example.com"]}`), "ct.jsonl")
+	require.NoError(t, err)
+
+	domains := queryDomains(t, index, "example.com")
+	assert.Contains(t, domains, "real.wild.example.com")
+	assert.Contains(t, domains, "a1.wild.example.com")
+	assert.Len(t, domains["a1.wild.example.com"].Provenance, 2)
+	for _, name := range []string{"b2", "c3", "d4", "e5", "f6"} {
+		assert.NotContains(t, domains, name+".wild.example.com")
+	}
+	require.Contains(t, domains, "www.example.com")
+	assert.Equal(t, []string{"10.0.0.3"}, domains["www.example.com"].IPs)
+	assert.Equal(t, "fdns_a.json.gz", domains["www.example.com"].Provenance[0].Origin)
+	assert.Contains(t, domains, "cdn.example.com")
+
+	// 保存后重新加载结果一致
+	saved := filepath.Join(t.TempDir(), "index.jsonl")
+	require.NoError(t, index.Save(saved))
+	loaded, err := LoadPassiveIndex(saved)
+	require.NoError(t, err)
+	assert.Equal(t, index.Len(), loaded.Len())
+	assert.Equal(t, domains, queryDomains(t, loaded, "example.com"))
+}
+
+func TestPassiveIndex_ImportPcap(t *testing.T) {
+	dns := &layers.DNS{
+		ID:           1,
+		QR:           true,
+		ResponseCode: layers.DNSResponseCodeNoErr,
+		Questions:    []layers.DNSQuestion{{Name: []byte("pcap.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN}},
+		Answers: []layers.DNSResourceRecord{
+			{Name: []byte("pcap.example.com"), Type: layers.DNSTypeCNAME, Class: layers.DNSClassIN, TTL: 60, CNAME: []byte("edge.example.com")},
+			{Name: []byte("edge.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 60, IP: net.ParseIP("10.1.1.1").To4()},
+		},
+	}
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.ParseIP("10.0.0.53"), DstIP: net.ParseIP("10.0.0.2")}
+	udp := &layers.UDP{SrcPort: 53, DstPort: 50000}
+	require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip, udp, dns))
+
+	filename := filepath.Join(t.TempDir(), "dns.pcap")
+	fp, err := os.Create(filename)
+	require.NoError(t, err)
+	writer := pcapgo.NewWriter(fp)
+	require.NoError(t, writer.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	require.NoError(t, writer.WritePacket(gopacket.CaptureInfo{Timestamp: time.Now(), CaptureLength: len(buf.Bytes()), Length: len(buf.Bytes())}, buf.Bytes()))
+	require.NoError(t, fp.Close())
+
+	index := NewPassiveIndex()
+	_, err = index.ImportPcapFile(filename)
+	if err != nil {
+		t.Skipf("open pcap file failed: %s", err)
+	}
+	domains := queryDomains(t, index, "example.com")
+	require.Contains(t, domains, "edge.example.com")
+	assert.Equal(t, []string{"10.1.1.1"}, domains["edge.example.com"].IPs)
+	assert.Equal(t, &Provenance{Source: PassiveSource_PCAP, Origin: "10.0.0.53"}, domains["edge.example.com"].Provenance[0])
+	assert.Contains(t, domains, "pcap.example.com")
+}
+
+func TestSubdomainScanner_Passive(t *testing.T) {
+	index := NewPassiveIndex()
+	index.Add("www.example.com", "10.0.0.1", PassiveSource_FDNS, "fdns")
+	index.Add("www.example.com", "", PassiveSource_CT, "ct")
+	index.Add("mail.example.com", "", PassiveSource_CT, "ct")
+
+	scanner, err := NewSubdomainScanner(NewSubdomainScannerConfig(
+		WithModes(),
+		WithPassiveSources(index),
+	), "example.com")
+	require.NoError(t, err)
+
+	var lock sync.Mutex
+	results := make(map[string]*SubdomainResult)
+	scanner.OnResult(func(result *SubdomainResult) {
+		lock.Lock()
+		defer lock.Unlock()
+		results[result.Domain] = result
+	})
+	require.NoError(t, scanner.Run())
+
+	require.Len(t, results, 2)
+	www := results["www.example.com"]
+	assert.Equal(t, "10.0.0.1", www.IP)
+	assert.Equal(t, PASSIVE, www.FromModeRaw)
+	assert.Len(t, www.Provenance, 2)
+	assert.Equal(t, "", results["mail.example.com"].IP)
+}
+
+func TestWithPassiveSources_Modes(t *testing.T) {
+	index := NewPassiveIndex()
+
+	// 没有指定发现模式时只使用被动模式
+	config := NewSubdomainScannerConfig(WithPassiveSources(index))
+	assert.Equal(t, []int{PASSIVE}, config.Modes)
+
+	// 指定了发现模式时追加被动模式，与选项的顺序无关
+	config = NewSubdomainScannerConfig(WithModes(BRUTE), WithPassiveSources(index))
+	assert.Equal(t, []int{BRUTE, PASSIVE}, config.Modes)
+	config = NewSubdomainScannerConfig(WithPassiveSources(index), WithModes(SEARCH))
+	assert.Equal(t, []int{SEARCH, PASSIVE}, config.Modes)
+
+	config = NewSubdomainScannerConfig()
+	assert.Equal(t, []int{BRUTE, SEARCH, ZONE_TRANSFER}, config.Modes)
+}
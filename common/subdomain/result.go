@@ -17,6 +17,9 @@ type SubdomainResult struct {
 	// Tag 用于存储一些其他信息
 	// 比如数据源之类的
 	Tags []string
+
+	// Provenance 被动模式下子域名的来源
+	Provenance []*Provenance
 }
 
 func (s *SubdomainResult) Hash() string {
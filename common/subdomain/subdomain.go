@@ -29,6 +29,7 @@ func removeRepeatedMode(modes ...int) []int {
 		case BRUTE:
 		case SEARCH:
 		case ZONE_TRANSFER:
+		case PASSIVE:
 		default:
 			continue
 		}
@@ -146,7 +147,7 @@ func (s *SubdomainScanner) RunWithContext(ctx context.Context) error {
 			ctx, _ := context.WithTimeout(ctx, s.config.TimeoutForEachTarget)
 
 			// 针对不同模式启动 goroutine 并发
-			wg := utils.NewSizedWaitGroup(3)
+			wg := utils.NewSizedWaitGroup(4)
 			defer wg.Wait()
 			for _, mode := range modes {
 
@@ -176,6 +177,12 @@ func (s *SubdomainScanner) RunWithContext(ctx context.Context) error {
 
 						s.ZoneTransfer(ctx, target)
 					}()
+				case PASSIVE:
+					go func() {
+						defer wg.Done()
+
+						s.Passive(ctx, target)
+					}()
 				default:
 					wg.Done()
 				}
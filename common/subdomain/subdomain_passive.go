@@ -0,0 +1,141 @@
+package subdomain
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+const (
+	PassiveSource_CT   = "ct"
+	PassiveSource_FDNS = "fdns"
+	PassiveSource_PCAP = "pcap"
+)
+
+// Provenance 记录子域名来自哪个被动数据源，Origin 一般为数据集文件名或者 DNS 服务器地址
+type Provenance struct {
+	Source string `json:"source"`
+	Origin string `json:"origin"`
+}
+
+// PassiveRecord 被动数据源中的一条子域名记录
+type PassiveRecord struct {
+	Domain     string        `json:"domain"`
+	IPs        []string      `json:"ips,omitempty"`
+	Provenance []*Provenance `json:"provenance,omitempty"`
+}
+
+// PassiveSource 被动数据源，不需要发送任何探测，只根据已经收集到的数据回答子域名查询
+type PassiveSource interface {
+	Name() string
+	Query(ctx context.Context, target string) ([]*PassiveRecord, error)
+}
+
+func (s *SubdomainScanner) Passive(ctx context.Context, target string) {
+	target = formatDomain(target)
+	if len(s.config.PassiveSources) <= 0 {
+		s.logger.Infof("no passive source for %s", target)
+		return
+	}
+
+	// 多个数据源的结果按照域名合并，IP 与来源取并集
+	merged := make(map[string]*PassiveRecord)
+	for _, source := range s.config.PassiveSources {
+		if ctx.Err() != nil {
+			return
+		}
+		records, err := source.Query(ctx, target)
+		if err != nil {
+			s.logger.Errorf("query passive source %s failed: %s", source.Name(), err)
+			continue
+		}
+		for _, record := range records {
+			if existed, ok := merged[record.Domain]; ok {
+				existed.IPs = mergeStrings(existed.IPs, record.IPs)
+				existed.Provenance = mergeProvenance(existed.Provenance, record.Provenance)
+				continue
+			}
+			merged[record.Domain] = &PassiveRecord{
+				Domain:     record.Domain,
+				IPs:        mergeStrings(nil, record.IPs),
+				Provenance: mergeProvenance(nil, record.Provenance),
+			}
+		}
+	}
+	s.logger.Infof("passive sources found %d subdomains for %s", len(merged), target)
+
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	for _, record := range merged {
+		result := &SubdomainResult{
+			FromTarget:  target,
+			FromModeRaw: PASSIVE,
+			Domain:      record.Domain,
+			Provenance:  record.Provenance,
+		}
+		for _, p := range record.Provenance {
+			result.Tags = append(result.Tags, p.Source+":"+p.Origin)
+		}
+		if len(record.IPs) > 0 {
+			result.IP = record.IPs[0]
+			s.onResult(result)
+			continue
+		}
+		if !s.config.PassiveResolve {
+			s.onResult(result)
+			continue
+		}
+
+		err := s.dnsQuerierSwg.AddWithContext(ctx)
+		if err != nil {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer s.dnsQuerierSwg.Done()
+			defer wg.Done()
+			ip, server, err := s.QueryA(ctx, result.Domain)
+			if err != nil {
+				s.logger.Infof("domain[%s] is found by passive mode but cannot be resolved to IP: %s", result.Domain, err)
+				s.onResolveFailedResult(result)
+				return
+			}
+			result.IP = ip
+			result.FromDNSServer = server
+			s.onResult(result)
+		}()
+	}
+}
+
+func mergeStrings(origin []string, items []string) []string {
+	for _, item := range items {
+		found := false
+		for _, o := range origin {
+			if o == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			origin = append(origin, item)
+		}
+	}
+	sort.Strings(origin)
+	return origin
+}
+
+func mergeProvenance(origin []*Provenance, items []*Provenance) []*Provenance {
+	for _, item := range items {
+		found := false
+		for _, o := range origin {
+			if o.Source == item.Source && o.Origin == item.Origin {
+				found = true
+				break
+			}
+		}
+		if !found {
+			origin = append(origin, &Provenance{Source: item.Source, Origin: item.Origin})
+		}
+	}
+	return origin
+}
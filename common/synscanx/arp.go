@@ -73,7 +73,10 @@ func (s *Scannerx) getInterfaceNetworks() (*net.IPNet, *net.IPNet) {
 		if ipNet.IP.To4() != nil {
 			ifaceIPNetV4 = ipNet
 		} else if ipNet.IP.To16() != nil {
-			ifaceIPNetV6 = ipNet
+			// 优先使用全局地址所在的网段，链路本地地址总是被认为是内网地址
+			if ifaceIPNetV6 == nil || !ipNet.IP.IsLinkLocalUnicast() {
+				ifaceIPNetV6 = ipNet
+			}
 		}
 	}
 
@@ -96,6 +99,9 @@ func (s *Scannerx) isInternalAddress(target string) bool {
 	if targetIP == nil || utils.IsLoopback(target) {
 		return false
 	}
+	if targetIP.To4() == nil && targetIP.IsLinkLocalUnicast() {
+		return true
+	}
 	return (targetIP.To4() != nil && ifaceIPNetV4 != nil && ifaceIPNetV4.Contains(targetIP.To4())) ||
 		(targetIP.To16() != nil && ifaceIPNetV6 != nil && ifaceIPNetV6.Contains(targetIP.To16()))
 }
@@ -108,7 +114,7 @@ func (s *Scannerx) arpScan() {
 			return
 		default:
 			if s.isInternalAddress(target) {
-				s.arp(target)
+				s.neighbor(target)
 			}
 		}
 	}
@@ -36,7 +36,7 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.gatewayMacFor(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -88,25 +88,25 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
+	var ipSrc net.IP
 	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
+		if utils.IsIPv6(host) {
+			ipSrc = net.IPv6loopback
+		} else {
+			ipSrc = net.ParseIP("127.0.0.1")
+		}
+		host = ipSrc.String()
 	} else {
-		ipSrc = s.config.SourceIP.String()
+		ipSrc, err = s.sourceIPFor(host)
+		if err != nil {
+			return nil, err
+		}
 	}
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolTCP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	// IPv4 / IPv6
+	opts = append(opts, networkLayerOptions(ipSrc.String(), host, layers.IPProtocolTCP)...)
 
 	// TCP
 	opts = append(opts,
@@ -149,7 +149,7 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.gatewayMacFor(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -201,26 +201,26 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
+	var ipSrc net.IP
 	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
+		if utils.IsIPv6(host) {
+			ipSrc = net.IPv6loopback
+		} else {
+			ipSrc = net.ParseIP("127.0.0.1")
+		}
+		host = ipSrc.String()
 	} else {
-		ipSrc = s.config.SourceIP.String()
+		ipSrc, err = s.sourceIPFor(host)
+		if err != nil {
+			return nil, err
+		}
 	}
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
 
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolUDP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	// IPv4 / IPv6
+	opts = append(opts, networkLayerOptions(ipSrc.String(), host, layers.IPProtocolUDP)...)
 
 	// UDP
 	opts = append(opts, pcapx.WithUDP_SrcPort(srcPort))
@@ -262,3 +262,32 @@ func (s *Scannerx) assembleArpPacket(host string) ([]byte, error) {
 	}
 	return packetBytes, nil
 }
+
+// gatewayMacFor 外网扫描时，IPv4 目标通过 ARP、IPv6 目标通过 NDP 获取网关的 MAC 地址
+func (s *Scannerx) gatewayMacFor(host string) (net.HardwareAddr, error) {
+	if utils.IsIPv6(host) {
+		return s.getGatewayMacV6()
+	}
+	return s.getGatewayMac()
+}
+
+func networkLayerOptions(src, dst string, protocol layers.IPProtocol) []any {
+	if utils.IsIPv6(dst) {
+		return []any{
+			pcapx.WithIPv6_NextHeader(protocol),
+			pcapx.WithIPv6_HopLimit(64),
+			pcapx.WithIPv6_SrcIP(src),
+			pcapx.WithIPv6_DstIP(dst),
+		}
+	}
+	return []any{
+		pcapx.WithIPv4_Flags(layers.IPv4DontFragment),
+		pcapx.WithIPv4_Version(4),
+		pcapx.WithIPv4_NextProtocol(protocol),
+		pcapx.WithIPv4_TTL(64),
+		pcapx.WithIPv4_ID(40000 + rand.Intn(10000)),
+		pcapx.WithIPv4_SrcIP(src),
+		pcapx.WithIPv4_DstIP(dst),
+		pcapx.WithIPv4_Option(nil, nil),
+	}
+}
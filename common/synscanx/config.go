@@ -39,7 +39,9 @@ type SynxConfig struct {
 	Iface     *net.Interface
 	GatewayIP net.IP
 	SourceIP  net.IP
-	// 内网扫描时，目标机器的 MAC 地址来自 ARP
+	// IPv6 扫描使用的源地址和网关，LinkLocalIPv6 用于链路本地目标
+	GatewayIPv6, SourceIPv6, LinkLocalIPv6 net.IP
+	// 内网扫描时，目标机器的 MAC 地址来自 ARP / NDP
 	// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
 	SourceMac, RemoteMac net.HardwareAddr
 
@@ -7,6 +7,7 @@ const (
 	UDP
 	ICMP
 	ARP
+	NDP
 )
 
 type SynxTarget struct {
@@ -50,7 +50,7 @@ func (s *Scannerx) GetNonExcludedPorts(ports string) []int {
 func (s *Scannerx) GetNonExcludedHosts(targets string) []string {
 	var nonExcludedHosts []string
 
-	for _, host := range utils.ParseStringToHosts(targets) {
+	for _, host := range ParseTargetHosts(targets) {
 		if !utils.IsIPv4(host) && !utils.IsIPv6(host) {
 			for _, _host := range netx.LookupAll(host, netx.WithTimeout(3*time.Second)) {
 				if s.excludedHost(_host) {
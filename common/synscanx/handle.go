@@ -19,6 +19,14 @@ import (
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 )
 
+// tcp[tcpflags] 只能匹配 IPv4，IPv6 的 SYN+ACK 通过固定偏移匹配（不包含扩展头的情况）
+// ip6[6] 是 Next Header，ip6[53] 是 TCP 的 flags，ip6[40] 是 ICMPv6 type（136 为 Neighbor Advertisement）
+const (
+	synxIPv6BPF     = "(ip6 && ip6[6] == 6 && ip6[53] & 0x12 == 0x12) || (icmp6 && ip6[40] == 136)"
+	synxBPF         = "arp || udp || tcp[tcpflags] == tcp-syn|tcp-ack || " + synxIPv6BPF
+	synxLoopbackBPF = "udp || tcp[tcpflags] == tcp-syn|tcp-ack || " + synxIPv6BPF
+)
+
 // windows 的pcap 错误信息是gb18030编码的，需要转换成utf8
 func handleError(err error) error {
 	if err == nil {
@@ -57,11 +65,11 @@ func (s *Scannerx) initHandle() error {
 	var bpf string
 	if s.config.Iface.Flags&net.FlagLoopback == 0 {
 		// Interface is not loopback, set the filter.
-		bpf = fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String())
+		bpf = fmt.Sprintf("ether dst %s && (%s)", s.config.Iface.HardwareAddr.String(), synxBPF)
 	} else {
 		// Interface is loopback, set a different filter.
 		// Replace the following line with the appropriate filter for your use case.
-		bpf = "udp || tcp[tcpflags] == tcp-syn|tcp-ack"
+		bpf = synxLoopbackBPF
 	}
 	err = handle.SetBPFFilter(bpf)
 	if err != nil {
@@ -91,7 +99,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if s.config.Iface != nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: s.config.Iface.Name,
-				BPF:        fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String()),
+				BPF:        fmt.Sprintf("ether dst %s && (%s)", s.config.Iface.HardwareAddr.String(), synxBPF),
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -102,7 +110,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if err == nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: loop.Name,
-				BPF:        "udp || tcp[tcpflags] == tcp-syn|tcp-ack",
+				BPF:        synxLoopbackBPF,
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -249,6 +257,30 @@ func (s *Scannerx) handlePacket(packet gopacket.Packet) {
 		}
 	}
 
+	if naLayer := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement); naLayer != nil {
+		na, ok := naLayer.(*layers.ICMPv6NeighborAdvertisement)
+		if !ok {
+			return
+		}
+		var hw net.HardwareAddr
+		for _, opt := range na.Options {
+			if opt.Type == layers.ICMPv6OptTargetAddress && len(opt.Data) >= 6 {
+				hw = net.HardwareAddr(opt.Data[:6])
+				break
+			}
+		}
+		// 没有 Target Link-Layer Address 选项时，使用以太网源地址
+		if hw == nil {
+			if eth, ok := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet); ok {
+				hw = eth.SrcMAC
+			}
+		}
+		if hw != nil {
+			s.onNDP(na.TargetAddress, hw)
+		}
+		return
+	}
+
 	//if icmpLayer := packet.Layer(layers.LayerTypeICMPv4); icmpLayer != nil {
 	//	icmp := icmpLayer.(*layers.ICMPv4)
 	//
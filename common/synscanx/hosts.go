@@ -0,0 +1,77 @@
+package synscanx
+
+import (
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// maxIPv6HostsExpand IPv6 网段或范围最多展开的地址数量，超过时保留原始字符串
+const maxIPv6HostsExpand = 1 << 16
+
+// ParseTargetHosts 解析扫描目标，在 utils.ParseStringToHosts 的基础上额外展开
+// IPv6 网段（fd00::/120）和范围（fd00::1-fd00::ff、fd00::1-ff）
+func ParseTargetHosts(raw string) []string {
+	var targets []string
+	for _, h := range utils.PrettifyListFromStringSplitEx(raw, ",", "\n") {
+		if hosts, ok := parseIPv6Hosts(h); ok {
+			targets = append(targets, hosts...)
+			continue
+		}
+		targets = append(targets, utils.ParseStringToHosts(h)...)
+	}
+	return targets
+}
+
+// parseIPv6Hosts 展开 IPv6 网段或范围，第二个返回值表示 raw 是否为可以展开的 IPv6 网段或范围
+func parseIPv6Hosts(raw string) ([]string, bool) {
+	var start, end *big.Int
+	if _ip, netBlock, err := net.ParseCIDR(raw); err == nil {
+		if _ip.To4() != nil {
+			return nil, false
+		}
+		ones, bits := netBlock.Mask.Size()
+		if bits-ones > 16 {
+			log.Warnf("ipv6 network %v is too large to expand", raw)
+			return nil, false
+		}
+		start = new(big.Int).SetBytes(netBlock.IP.To16())
+		end = new(big.Int).Add(start, big.NewInt(1<<uint(bits-ones)-1))
+	} else if strings.Count(raw, "-") == 1 {
+		rets := strings.Split(raw, "-")
+		startIP := net.ParseIP(utils.FixForParseIP(strings.TrimSpace(rets[0])))
+		if startIP == nil || startIP.To4() != nil {
+			return nil, false
+		}
+		start = new(big.Int).SetBytes(startIP.To16())
+		endRaw := strings.TrimSpace(rets[1])
+		if endIP := net.ParseIP(utils.FixForParseIP(endRaw)); endIP != nil && endIP.To4() == nil {
+			end = new(big.Int).SetBytes(endIP.To16())
+		} else if last, err := strconv.ParseUint(endRaw, 16, 16); err == nil {
+			// fd00::1-ff 只替换最后一段
+			end = new(big.Int).Lsh(new(big.Int).Rsh(start, 16), 16)
+			end.Or(end, new(big.Int).SetUint64(last))
+		} else {
+			return nil, false
+		}
+	} else {
+		return nil, false
+	}
+
+	size := new(big.Int).Sub(end, start)
+	if size.Sign() < 0 || size.Cmp(big.NewInt(maxIPv6HostsExpand)) >= 0 {
+		log.Warnf("ipv6 range %v is invalid or too large to expand", raw)
+		return nil, false
+	}
+	var hosts []string
+	for i := new(big.Int).Set(start); i.Cmp(end) <= 0; i.Add(i, big.NewInt(1)) {
+		buf := make([]byte, net.IPv6len)
+		i.FillBytes(buf)
+		hosts = append(hosts, net.IP(buf).String())
+	}
+	return hosts, true
+}
@@ -0,0 +1,25 @@
+package synscanx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestParseTargetHosts_IPv6(t *testing.T) {
+	cases := map[string][]string{
+		"fd00::/126":                 {"fd00::", "fd00::1", "fd00::2", "fd00::3"},
+		"fd00::1-fd00::3":            {"fd00::1", "fd00::2", "fd00::3"},
+		"fd00::fe-101":               {"fd00::fe", "fd00::ff", "fd00::100", "fd00::101"},
+		"1.1.1.1,fd00::1,fd00::/127": {"1.1.1.1", "fd00::1", "fd00::", "fd00::1"},
+		"fd00::/64":                  {"fd00::/64"},
+		"192.168.1.1/30":             {"192.168.1.0", "192.168.1.1", "192.168.1.2", "192.168.1.3"},
+	}
+	for input, expected := range cases {
+		assert.Equal(t, expected, ParseTargetHosts(input), input)
+	}
+
+	// 全局的解析函数不展开 IPv6 网段
+	assert.Equal(t, []string{"fd00::/126"}, utils.ParseStringToHosts("fd00::/126"))
+}
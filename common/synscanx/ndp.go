@@ -0,0 +1,124 @@
+package synscanx
+
+import (
+	"net"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// getGatewayMacV6 通过 NDP 协议获取 IPv6 网关的 MAC 地址，用于 IPv6 外网扫描
+func (s *Scannerx) getGatewayMacV6() (net.HardwareAddr, error) {
+	if s.config.GatewayIPv6 == nil {
+		return nil, utils.Errorf("cannot fetch ipv6 gateway for %v", s.config.Iface.Name)
+	}
+	gateway := s.config.GatewayIPv6.String()
+	var retry int
+	for {
+		if dstHw, ok := s.macCacheTable.Load(gateway); ok {
+			if hw, ok := dstHw.(net.HardwareAddr); ok {
+				log.Debugf("use ndp proto to fetch ipv6 gateway's hw address: %s", hw)
+				return hw, nil
+			}
+		}
+		if retry > 2 {
+			return nil, utils.Errorf("cannot fetch hw addr for ipv6 gateway %v[%v]", gateway, s.config.Iface.Name)
+		}
+		s.ndp(gateway)
+		retry++
+		time.Sleep(time.Millisecond * 50)
+	}
+}
+
+// onNDP 处理邻居通告（Neighbor Advertisement），是 onArp 在 IPv6 下的对应实现
+func (s *Scannerx) onNDP(ip net.IP, hw net.HardwareAddr) {
+	if s.MacHandlers != nil {
+		s.MacHandlers(ip, hw)
+	}
+	if s.config.SourceIPv6.Equal(ip) || s.config.GatewayIPv6.Equal(ip) {
+		s.macCacheTable.Store(ip.String(), hw)
+		return
+	}
+
+	if s.FromPing {
+		if !s._hosts.Contains(ip.String()) {
+			return
+		}
+	} else {
+		if !s.hosts.Contains(ip.String()) {
+			return
+		}
+	}
+	log.Debugf("NDP: %s -> %s", ip.String(), hw.String())
+
+	s.macCacheTable.Store(ip.String(), hw)
+}
+
+// neighbor 根据目标地址类型，通过 ARP 或 NDP 解析同网段目标的 MAC 地址
+func (s *Scannerx) neighbor(target string) {
+	if utils.IsIPv6(target) {
+		s.ndp(target)
+		return
+	}
+	s.arp(target)
+}
+
+func (s *Scannerx) ndp(target string) {
+	packet, err := s.assemblePacket(target, 0, NDP)
+	if err != nil {
+		log.Errorf("assemble ndp packet failed: %v", err)
+		return
+	}
+	select {
+	case <-s.ctx.Done():
+		return
+	case s.PacketChan <- packet:
+	}
+}
+
+// assembleNdpPacket 生成发往目标 solicited-node 组播地址的邻居请求（Neighbor Solicitation）
+func (s *Scannerx) assembleNdpPacket(host string) ([]byte, error) {
+	if s.config.SourceIPv6 == nil {
+		return nil, utils.Errorf("iface %v has no ipv6 address", s.config.Iface.Name)
+	}
+	target := net.ParseIP(host)
+	if target == nil || target.To4() != nil {
+		return nil, utils.Errorf("invalid ipv6 target: %v", host)
+	}
+	group := pcapx.SolicitedNodeMulticast(target)
+	srcMac := s.config.SourceMac
+	// 当目标是链路本地地址时，需要使用链路本地地址作为源地址
+	srcIP := s.config.SourceIPv6
+	if target.IsLinkLocalUnicast() && s.config.LinkLocalIPv6 != nil {
+		srcIP = s.config.LinkLocalIPv6
+	}
+	return pcapx.PacketBuilder(
+		pcapx.WithEthernet_SrcMac(srcMac),
+		pcapx.WithEthernet_DstMac(pcapx.IPv6MulticastMac(group)),
+		pcapx.WithIPv6_SrcIP(srcIP),
+		pcapx.WithIPv6_DstIP(group),
+		// RFC 4861 要求 NDP 报文的 Hop Limit 为 255
+		pcapx.WithIPv6_HopLimit(255),
+		pcapx.WithICMPv6_NeighborSolicitation(target, srcMac),
+	)
+}
+
+// sourceIPFor 返回扫描目标使用的源地址，IPv6 目标使用网卡上的 IPv6 地址，
+// 网卡上没有对应协议族的地址时返回错误
+func (s *Scannerx) sourceIPFor(host string) (net.IP, error) {
+	if !utils.IsIPv6(host) {
+		if s.config.SourceIP == nil {
+			return nil, utils.Errorf("iface %v has no ipv4 address for %v", s.config.Iface.Name, host)
+		}
+		return s.config.SourceIP, nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLinkLocalUnicast() && s.config.LinkLocalIPv6 != nil {
+		return s.config.LinkLocalIPv6, nil
+	}
+	if s.config.SourceIPv6 == nil {
+		return nil, utils.Errorf("iface %v has no ipv6 address for %v", s.config.Iface.Name, host)
+	}
+	return s.config.SourceIPv6, nil
+}
@@ -0,0 +1,103 @@
+package synscanx
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/pcapx"
+	"github.com/yaklang/yaklang/common/utils/hostsparser"
+)
+
+func newTestScannerV6(t *testing.T, hosts string) *Scannerx {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	_, ipNet, err := net.ParseCIDR("2001:db8::/64")
+	require.NoError(t, err)
+	return &Scannerx{
+		ctx:    ctx,
+		cancel: cancel,
+		config: &SynxConfig{
+			Iface:         &net.Interface{Name: "veth0"},
+			SourceMac:     net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01},
+			SourceIPv6:    net.ParseIP("2001:db8::1"),
+			LinkLocalIPv6: net.ParseIP("fe80::1"),
+			GatewayIPv6:   net.ParseIP("fe80::ff"),
+		},
+		hosts:         hostsparser.NewHostsParser(ctx, hosts),
+		macCacheTable: new(sync.Map),
+		PacketChan:    make(chan []byte, 16),
+		ifaceIPNetV6:  ipNet,
+		ifaceUpdated:  true,
+	}
+}
+
+func TestNDPNeighborResolve(t *testing.T) {
+	s := newTestScannerV6(t, "2001:db8::2")
+	targetMac := net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}
+
+	// 邻居请求发往目标的 solicited-node 组播地址
+	s.neighbor("2001:db8::2")
+	packet := gopacket.NewPacket(<-s.PacketChan, layers.LayerTypeEthernet, gopacket.Default)
+	require.Equal(t, "33:33:ff:00:00:02", packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).DstMAC.String())
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, "ff02::1:ff00:2", ip6.DstIP.String())
+	require.Equal(t, uint8(255), ip6.HopLimit)
+	ns := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	require.Equal(t, "2001:db8::2", ns.TargetAddress.String())
+
+	// 处理邻居通告后，SYN 包使用目标的 MAC 地址
+	raw, err := pcapx.PacketBuilder(
+		pcapx.WithEthernet_SrcMac(targetMac),
+		pcapx.WithEthernet_DstMac(s.config.SourceMac),
+		pcapx.WithIPv6_SrcIP("2001:db8::2"),
+		pcapx.WithIPv6_DstIP("2001:db8::1"),
+		pcapx.WithIPv6_HopLimit(255),
+		pcapx.WithICMPv6_NeighborAdvertisement("2001:db8::2", targetMac, pcapx.ICMPV6_NA_FLAG_SOLICITED),
+	)
+	require.NoError(t, err)
+	s.handlePacket(gopacket.NewPacket(raw, layers.LayerTypeEthernet, gopacket.Default))
+	mac, ok := s.macCacheTable.Load("2001:db8::2")
+	require.True(t, ok)
+	require.Equal(t, targetMac, mac)
+
+	syn, err := s.assembleSynPacket("2001:db8::2", 22)
+	require.NoError(t, err)
+	packet = gopacket.NewPacket(syn, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	require.Equal(t, targetMac, packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).DstMAC)
+	ip6 = packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, "2001:db8::1", ip6.SrcIP.String())
+	require.Equal(t, "2001:db8::2", ip6.DstIP.String())
+	tcp := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	require.True(t, tcp.SYN)
+	require.Equal(t, layers.TCPPort(22), tcp.DstPort)
+}
+
+func TestNDPIgnoreUnknownHost(t *testing.T) {
+	s := newTestScannerV6(t, "2001:db8::2")
+	s.onNDP(net.ParseIP("2001:db8::3"), net.HardwareAddr{0x02, 0, 0, 0, 0, 0x03})
+	_, ok := s.macCacheTable.Load("2001:db8::3")
+	require.False(t, ok)
+	// 网关的地址总是被记录
+	s.onNDP(net.ParseIP("fe80::ff"), net.HardwareAddr{0x02, 0, 0, 0, 0, 0xff})
+	mac, err := s.getGatewayMacV6()
+	require.NoError(t, err)
+	require.Equal(t, net.HardwareAddr{0x02, 0, 0, 0, 0, 0xff}, mac)
+}
+
+func TestSourceIPFor_IPv6OnlyIface(t *testing.T) {
+	s := newTestScannerV6(t, "2001:db8::2,192.168.1.2")
+
+	ip, err := s.sourceIPFor("2001:db8::2")
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::1", ip.String())
+
+	// 网卡上没有 IPv4 地址时，IPv4 目标返回错误而不是空的源地址
+	_, err = s.sourceIPFor("192.168.1.2")
+	require.Error(t, err)
+}
@@ -74,6 +74,22 @@ func getRoute(sampleIP string) (*net.Interface, net.IP, net.IP, error) {
 	return routeCache.iface, routeCache.gatewayIP, routeCache.srcIP, routeCache.err
 }
 
+var routeCacheV6 struct {
+	iface     *net.Interface
+	gatewayIP net.IP
+	srcIP     net.IP
+	err       error
+	once      sync.Once
+}
+
+// getRouteV6 获取 IPv6 默认路由，用于 IPv6 外网扫描时解析网关的 MAC 地址
+func getRouteV6() (*net.Interface, net.IP, net.IP, error) {
+	routeCacheV6.once.Do(func() {
+		routeCacheV6.iface, routeCacheV6.gatewayIP, routeCacheV6.srcIP, routeCacheV6.err = netutil.Route(time.Second*2, "2001:4860:4860::8888")
+	})
+	return routeCacheV6.iface, routeCacheV6.gatewayIP, routeCacheV6.srcIP, routeCacheV6.err
+}
+
 func NewScannerx(ctx context.Context, sample string, config *SynxConfig) (*Scannerx, error) {
 	limitInterval := time.Duration(config.rateLimitDelayMs * float64(time.Millisecond))
 	if ctx == nil {
@@ -125,19 +141,16 @@ func (s *Scannerx) initEssentialInfo() error {
 				return errors.Errorf("get iface failed: %s", err)
 			}
 		}
-		addrs, err := iface.Addrs()
+		v4, v6, linkLocal, err := interfaceAddrs(iface)
 		if err != nil {
 			return err
 		}
-		for _, addr := range addrs {
-			ip := addr.(*net.IPNet).IP
-			if utils.IsIPv6(ip.String()) {
-				srcIP = ip
-			}
-			if utils.IsIPv4(ip.String()) {
-				srcIP = ip
-				break
-			}
+		srcIP = v4
+		if srcIP == nil {
+			srcIP = v6
+		}
+		if srcIP == nil {
+			srcIP = linkLocal
 		}
 		if srcIP == nil {
 			return utils.Errorf("iface: %s has no addrs", iface.Name)
@@ -150,15 +163,86 @@ func (s *Scannerx) initEssentialInfo() error {
 	}
 
 	s.config.Iface = iface
-	s.config.SourceIP = srcIP
 	s.config.SourceMac = iface.HardwareAddr
-	s.config.GatewayIP = gatewayIP
+	// 双栈：IPv4 和 IPv6 的源地址、网关分开记录
+	if srcIP.To4() != nil {
+		s.config.SourceIP = srcIP
+		s.config.GatewayIP = gatewayIP
+	} else {
+		s.config.SourceIPv6 = srcIP
+		s.config.GatewayIPv6 = gatewayIP
+	}
+	s.initDualStackInfo()
 
 	// 不确定扫描目标中是否存在回环地址，所以这里先初始化一个回环地址的映射表
-	s.loopbackMap["127.0.0.1"] = s.config.SourceIP.String()
+	if s.config.SourceIP != nil {
+		s.loopbackMap["127.0.0.1"] = s.config.SourceIP.String()
+	}
+	if s.config.SourceIPv6 != nil {
+		s.loopbackMap["::1"] = s.config.SourceIPv6.String()
+	}
 	return nil
 }
 
+// initDualStackInfo 补全网卡上另一个协议栈的源地址和 IPv6 网关，获取失败时只影响对应协议栈的扫描
+func (s *Scannerx) initDualStackInfo() {
+	v4, v6, linkLocal, err := interfaceAddrs(s.config.Iface)
+	if err != nil {
+		log.Warnf("get iface %v addrs failed: %v", s.config.Iface.Name, err)
+		return
+	}
+	if s.config.SourceIP == nil {
+		s.config.SourceIP = v4
+	}
+	s.config.LinkLocalIPv6 = linkLocal
+	if s.config.SourceIPv6 == nil {
+		s.config.SourceIPv6 = v6
+		if s.config.SourceIPv6 == nil {
+			s.config.SourceIPv6 = linkLocal
+		}
+	}
+	if s.config.GatewayIPv6 == nil && v6 != nil {
+		gwIface, gateway, _, err := getRouteV6()
+		if err != nil {
+			log.Debugf("get ipv6 gateway failed: %v", err)
+			return
+		}
+		if gwIface != nil && gwIface.Name == s.config.Iface.Name {
+			s.config.GatewayIPv6 = gateway
+		}
+	}
+}
+
+// interfaceAddrs 返回网卡上的 IPv4 地址、全局 IPv6 地址和链路本地 IPv6 地址
+func interfaceAddrs(iface *net.Interface) (v4, v6, linkLocal net.IP, err error) {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet == nil {
+			continue
+		}
+		ip := ipNet.IP
+		switch {
+		case ip.To4() != nil:
+			if v4 == nil {
+				v4 = ip.To4()
+			}
+		case ip.IsLinkLocalUnicast():
+			if linkLocal == nil {
+				linkLocal = ip
+			}
+		case ip.IsGlobalUnicast() || ip.IsLoopback():
+			if v6 == nil {
+				v6 = ip
+			}
+		}
+	}
+	return v4, v6, linkLocal, nil
+}
+
 func (s *Scannerx) rateLimit() {
 	s.limiter.Wait(s.ctx)
 }
@@ -260,7 +344,7 @@ func (s *Scannerx) SubmitTargetFromPing(res chan string, ports string) <-chan *S
 				lock.Unlock()
 
 				if s.isInternalAddress(host) {
-					s.arp(host)
+					s.neighbor(host)
 				}
 				for _, port := range nonExcludedPorts {
 					s.rateLimit()
@@ -470,6 +554,8 @@ func (s *Scannerx) assemblePacket(host string, port int, proto ProtocolType) ([]
 	case ICMP:
 	case ARP:
 		return s.assembleArpPacket(host)
+	case NDP:
+		return s.assembleNdpPacket(host)
 	}
 	return nil, nil
 }
@@ -0,0 +1,268 @@
+package sftest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/sarif"
+)
+
+// Report 汇总多个规则的测试结果，可以输出为 JUnit 或 SARIF
+type Report struct {
+	mu    sync.Mutex
+	Rules []*RuleResult
+}
+
+func NewReport() *Report {
+	return &Report{}
+}
+
+func (r *Report) AddRuleResult(result *RuleResult) {
+	if result == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Rules = append(r.Rules, result)
+}
+
+// Summary 返回规则总数、通过、失败和跳过（没有测试样例）的数量
+func (r *Report) Summary() (total, passed, failed, skipped int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rule := range r.Rules {
+		total++
+		switch {
+		case rule.Skipped():
+			skipped++
+		case rule.Passed():
+			passed++
+		default:
+			failed++
+		}
+	}
+	return
+}
+
+// Passed 判断全部规则是否通过，没有测试样例的规则不影响结果
+func (r *Report) Passed() bool {
+	_, _, failed, _ := r.Summary()
+	return failed == 0
+}
+
+func (r *Report) String() string {
+	total, passed, failed, skipped := r.Summary()
+	return fmt.Sprintf("syntaxflow rule test: total %d, passed %d, failed %d, skipped %d", total, passed, failed, skipped)
+}
+
+// ====================== junit ======================
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Errors   int               `xml:"errors,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Time       string           `xml:"time,attr"`
+	Properties []*junitProperty `xml:"properties>property,omitempty"`
+	Cases      []*junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnit 以 JUnit XML 格式输出测试结果，每个规则是一个 testsuite，每个测试样例是一个 testcase
+func (r *Report) WriteJUnit(w io.Writer) error {
+	r.mu.Lock()
+	rules := append([]*RuleResult(nil), r.Rules...)
+	r.mu.Unlock()
+
+	suites := &junitTestSuites{Name: "syntaxflow"}
+	var total time.Duration
+	for _, rule := range rules {
+		total += rule.Duration
+		suite := &junitTestSuite{
+			Name: rule.RuleName,
+			Time: junitTime(rule.Duration),
+		}
+		if rule.Language != "" {
+			suite.Properties = append(suite.Properties, &junitProperty{Name: "language", Value: rule.Language})
+		}
+		if rule.Title != "" {
+			suite.Properties = append(suite.Properties, &junitProperty{Name: "title", Value: rule.Title})
+		}
+		switch {
+		case rule.Err != nil:
+			suite.Errors++
+			suite.Cases = append(suite.Cases, &junitTestCase{
+				Name:      "compile",
+				ClassName: rule.RuleName,
+				File:      rule.Path,
+				Time:      junitTime(rule.Duration),
+				Error:     &junitMessage{Message: firstLine(rule.Err.Error()), Type: "RuleError", Content: rule.Err.Error()},
+			})
+		case rule.Skipped():
+			suite.Skipped++
+			suite.Cases = append(suite.Cases, &junitTestCase{
+				Name:      "no-sample",
+				ClassName: rule.RuleName,
+				File:      rule.Path,
+				Time:      junitTime(0),
+				Skipped:   &junitMessage{Message: "rule has no file:// sample"},
+			})
+		}
+		for _, c := range rule.Cases {
+			testCase := &junitTestCase{
+				Name:      c.Name,
+				ClassName: rule.RuleName,
+				File:      rule.Path,
+				Time:      junitTime(c.Duration),
+				SystemOut: caseDetail(c),
+			}
+			if c.Err != nil {
+				suite.Failures++
+				testCase.Failure = &junitMessage{Message: firstLine(c.Err.Error()), Type: "AlertMismatch", Content: c.Err.Error()}
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		suite.Tests = len(suite.Cases)
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = junitTime(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ====================== sarif ======================
+
+// WriteSARIF 以 SARIF 格式输出测试结果，每个测试样例是一个 kind 为 pass 或 fail 的 result
+func (r *Report) WriteSARIF(w io.Writer) error {
+	r.mu.Lock()
+	rules := append([]*RuleResult(nil), r.Rules...)
+	r.mu.Unlock()
+
+	report, err := sarif.New(sarif.Version210, false)
+	if err != nil {
+		return err
+	}
+	run := sarif.NewRunWithInformationURI("syntaxflow-test", "https://yaklang.com")
+	for _, rule := range rules {
+		ruleID := rule.RuleName
+		descriptor := run.AddRule(ruleID)
+		if rule.Title != "" {
+			descriptor.WithName(rule.Title)
+		}
+		pb := sarif.NewPropertyBag()
+		pb.AddString("language", rule.Language)
+		descriptor.AttachPropertyBag(pb)
+
+		addResult := func(kind, level, message string, properties map[string]interface{}) {
+			result := run.CreateResultForRule(ruleID).
+				WithKind(kind).
+				WithLevel(level).
+				WithMessage(sarif.NewTextMessage(message))
+			if rule.Path != "" {
+				result.AddLocation(sarif.NewLocationWithPhysicalLocation(
+					sarif.NewPhysicalLocation().WithArtifactLocation(sarif.NewSimpleArtifactLocation(rule.Path)),
+				))
+			}
+			if len(properties) > 0 {
+				pb := sarif.NewPropertyBag()
+				for key, value := range properties {
+					pb.Add(key, value)
+				}
+				result.AttachPropertyBag(pb)
+			}
+		}
+
+		switch {
+		case rule.Err != nil:
+			addResult("fail", "error", rule.Err.Error(), nil)
+		case rule.Skipped():
+			addResult("notApplicable", "none", "rule has no file:// sample", nil)
+		}
+		for _, c := range rule.Cases {
+			properties := map[string]interface{}{
+				"case":     c.Name,
+				"negative": c.Negative,
+				"files":    c.Files,
+				"alerts":   c.Statistics,
+			}
+			if c.Err != nil {
+				addResult("fail", "error", fmt.Sprintf("%s: %v", c.Name, c.Err), properties)
+			} else {
+				addResult("pass", "none", fmt.Sprintf("%s: %s", c.Name, caseDetail(c)), properties)
+			}
+		}
+	}
+	report.AddRun(run)
+	return report.PrettyWrite(w)
+}
+
+func caseDetail(c *CaseResult) string {
+	stat := c.Statistics
+	if stat == nil {
+		return ""
+	}
+	return fmt.Sprintf("files: %s, alerts: %d (high: %d, mid: %d, low: %d)",
+		strings.Join(c.Files, ", "), stat.Total, stat.High, stat.Mid, stat.Low)
+}
+
+func firstLine(s string) string {
+	if idx := strings.IndexByte(s, '\n'); idx >= 0 {
+		return s[:idx]
+	}
+	return s
+}
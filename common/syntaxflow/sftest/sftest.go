@@ -0,0 +1,292 @@
+package sftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+// CaseResult 是规则在一个测试样例（desc 中的一组 file:// 或 safefile:// 文件）上的执行结果
+type CaseResult struct {
+	Name     string
+	Negative bool
+	Files    []string
+
+	Expectation *sfvm.VerifyAlertExpectation
+	Statistics  *sfvm.VerifyAlertStatistics
+
+	Duration time.Duration
+	Err      error
+}
+
+// Passed 判断测试样例是否通过
+func (c *CaseResult) Passed() bool {
+	return c.Err == nil
+}
+
+// RuleResult 是一个规则所有测试样例的执行结果
+type RuleResult struct {
+	RuleName string
+	Title    string
+	Language string
+	// Path 是规则文件的路径，直接测试规则内容时为空
+	Path string
+
+	Cases    []*CaseResult
+	Duration time.Duration
+	// Err 是规则本身的错误，例如规则编译失败
+	Err error
+}
+
+// Skipped 判断规则是否没有声明测试样例
+func (r *RuleResult) Skipped() bool {
+	return r.Err == nil && len(r.Cases) == 0
+}
+
+// Passed 判断规则的全部测试样例是否通过
+func (r *RuleResult) Passed() bool {
+	if r.Err != nil {
+		return false
+	}
+	for _, c := range r.Cases {
+		if !c.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Error 返回规则失败的原因，没有失败时返回 nil
+func (r *RuleResult) Error() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	var errs error
+	for _, c := range r.Cases {
+		if c.Err != nil {
+			errs = utils.JoinErrors(errs, utils.Wrapf(c.Err, "case %s", c.Name))
+		}
+	}
+	return errs
+}
+
+type Config struct {
+	ctx           context.Context
+	compileOption []ssaapi.Option
+	callback      func(*RuleResult)
+	filter        func(path string) bool
+}
+
+type Option func(*Config)
+
+func NewConfig(opts ...Option) *Config {
+	c := &Config{
+		ctx: context.Background(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func WithContext(ctx context.Context) Option {
+	return func(c *Config) {
+		c.ctx = ctx
+	}
+}
+
+// WithCompileOptions 设置编译测试样例时额外使用的 ssaapi 选项
+func WithCompileOptions(opts ...ssaapi.Option) Option {
+	return func(c *Config) {
+		c.compileOption = append(c.compileOption, opts...)
+	}
+}
+
+// WithResultCallback 设置每个规则测试完成后的回调
+func WithResultCallback(callback func(*RuleResult)) Option {
+	return func(c *Config) {
+		c.callback = callback
+	}
+}
+
+// WithRuleFileFilter 设置需要测试的规则文件，只对 RunFileSystem 生效
+func WithRuleFileFilter(filter func(path string) bool) Option {
+	return func(c *Config) {
+		c.filter = filter
+	}
+}
+
+// RunRuleContent 编译规则内容并执行其中声明的全部测试样例
+func RunRuleContent(name, content string, opts ...Option) *RuleResult {
+	return runRuleContent(NewConfig(opts...), name, "", content)
+}
+
+// RunRule 执行规则中声明的全部测试样例
+func RunRule(rule *schema.SyntaxFlowRule, opts ...Option) *RuleResult {
+	return runRuleContent(NewConfig(opts...), rule.RuleName, "", rule.Content)
+}
+
+// RunFileSystem 执行 root 目录下所有 .sf 规则文件中声明的测试样例
+func RunFileSystem(fsys fi.FileSystem, root string, opts ...Option) (*Report, error) {
+	config := NewConfig(opts...)
+	report := NewReport()
+	err := filesys.Recursive(root, filesys.WithFileSystem(fsys), filesys.WithFileStat(func(path string, info fs.FileInfo) error {
+		if config.ctx.Err() != nil {
+			return config.ctx.Err()
+		}
+		if fsys.Ext(path) != ".sf" {
+			return nil
+		}
+		if config.filter != nil && !config.filter(path) {
+			return nil
+		}
+		raw, err := fsys.ReadFile(path)
+		if err != nil {
+			return utils.Wrapf(err, "read rule file %s failed", path)
+		}
+		report.AddRuleResult(runRuleContent(config, info.Name(), path, string(raw)))
+		return nil
+	}))
+	return report, err
+}
+
+func runRuleContent(config *Config, name, path, content string) (ret *RuleResult) {
+	start := time.Now()
+	ret = &RuleResult{
+		RuleName: name,
+		Path:     path,
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			ret.Err = utils.Errorf("test rule %s panic: %v", name, err)
+			utils.PrintCurrentGoroutineRuntimeStack()
+		}
+		ret.Duration = time.Since(start)
+		if config.callback != nil {
+			config.callback(ret)
+		}
+	}()
+
+	frame, err := sfvm.NewSyntaxFlowVirtualMachine().Compile(content)
+	if err != nil {
+		ret.Err = utils.Wrapf(err, "compile rule failed")
+		return
+	}
+	rule := frame.GetRule()
+	if rule.Content == "" {
+		rule.Content = content
+	}
+	if ret.RuleName == "" {
+		ret.RuleName = rule.RuleName
+	}
+	ret.Title = rule.Title
+	if rule.TitleZh != "" && ret.Title == "" {
+		ret.Title = rule.TitleZh
+	}
+
+	language, cases, err := frame.ExtractVerifyTestCases()
+	if err != nil {
+		ret.Err = err
+		return
+	}
+	ret.Language = string(language)
+
+	positive, negative := 0, 0
+	for _, verifyFs := range cases {
+		var caseName string
+		if verifyFs.IsNegative() {
+			negative++
+			caseName = fmt.Sprintf("negative#%d", negative)
+		} else {
+			positive++
+			caseName = fmt.Sprintf("positive#%d", positive)
+		}
+		ret.Cases = append(ret.Cases, runCase(config, caseName, rule, language, verifyFs))
+	}
+	return
+}
+
+func runCase(config *Config, name string, rule *schema.SyntaxFlowRule, language consts.Language, verifyFs *sfvm.VerifyFileSystem) (ret *CaseResult) {
+	start := time.Now()
+	ret = &CaseResult{
+		Name:        name,
+		Negative:    verifyFs.IsNegative(),
+		Files:       verifyFs.GetFileNames(),
+		Expectation: verifyFs.GetAlertExpectation(),
+		Statistics:  &sfvm.VerifyAlertStatistics{},
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			ret.Err = utils.Errorf("panic: %v", err)
+		}
+		ret.Duration = time.Since(start)
+	}()
+
+	opts := append([]ssaapi.Option{
+		ssaapi.WithLanguage(language),
+		ssaapi.WithContext(config.ctx),
+	}, config.compileOption...)
+	programs, err := ssaapi.ParseProjectWithFS(verifyFs.GetVirtualFs(), opts...)
+	if err != nil {
+		ret.Err = utils.Wrapf(err, "compile sample failed")
+		return
+	}
+	if len(programs) == 0 {
+		ret.Err = utils.Error("compile sample failed: no program")
+		return
+	}
+
+	// 使用程序作为初始输入，这样带有 $input 的 lib 规则也可以测试
+	result, err := programs.SyntaxFlowWithError(rule.Content,
+		ssaapi.QueryWithContext(config.ctx),
+		ssaapi.QueryWithInitInputVar(programs[0]),
+	)
+	if err != nil {
+		if !ret.Negative || errors.Is(err, sfvm.CriticalError) {
+			ret.Err = utils.Wrapf(err, "syntaxflow query failed")
+			return
+		}
+		log.Debugf("syntaxflow query negative sample failed: %v", err)
+	}
+	if result == nil {
+		if !ret.Negative {
+			ret.Err = utils.Error("syntaxflow query failed: no result")
+		}
+		return
+	}
+	if errs := result.GetErrors(); len(errs) > 0 {
+		// 反例中规则执行出错说明没有匹配到任何内容
+		if !ret.Negative {
+			ret.Err = utils.Errorf("syntaxflow query failed: %v", strings.Join(errs, "\n"))
+		}
+		return
+	}
+
+	for _, name := range result.GetAlertVariables() {
+		var severity string
+		if info, ok := result.GetAlertInfo(name); ok {
+			severity = string(info.Severity)
+		}
+		ret.Statistics.Add(severity, len(result.GetValues(name)))
+	}
+	if err := verifyFs.Check(ret.Statistics); err != nil {
+		ret.Err = err
+		return
+	}
+	if !ret.Negative && rule.AllowIncluded && len(result.GetValues("output")) <= 0 {
+		ret.Err = utils.Errorf("lib: %v is not exporting output in `alert`", rule.IncludedName)
+	}
+	return
+}
@@ -0,0 +1,161 @@
+package sftest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+)
+
+const passRule = `
+desc(
+	title: "print call",
+	lang: yaklang,
+	alert_min: 1,
+	alert_high: 1,
+	'file://a.yak': <<<CODE
+print("a")
+print("b")
+CODE,
+	'safefile://safe.yak': <<<CODE
+println("a")
+CODE
+)
+
+print(* as $target)
+alert $target for {
+	level: "high",
+}
+`
+
+const failRule = `
+desc(
+	lang: yaklang,
+	alert_exact: 3,
+	'file://a.yak': <<<CODE
+print("a")
+CODE
+)
+
+print(* as $target)
+alert $target
+`
+
+func TestRunRuleContent(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		result := RunRuleContent("print.sf", passRule)
+		require.NoError(t, result.Error())
+		require.True(t, result.Passed())
+		require.Equal(t, "print call", result.Title)
+		require.Len(t, result.Cases, 2)
+
+		positive := result.Cases[0]
+		require.False(t, positive.Negative)
+		require.Equal(t, []string{"a.yak"}, positive.Files)
+		require.Equal(t, 2, positive.Statistics.Total)
+		require.Equal(t, 2, positive.Statistics.High)
+
+		negative := result.Cases[1]
+		require.True(t, negative.Negative)
+		require.Equal(t, 0, negative.Statistics.Total)
+	})
+
+	t.Run("alert count mismatch", func(t *testing.T) {
+		result := RunRuleContent("fail.sf", failRule)
+		require.False(t, result.Passed())
+		require.Len(t, result.Cases, 1)
+		require.Equal(t, 1, result.Cases[0].Statistics.Total)
+		require.ErrorContains(t, result.Cases[0].Err, "alert_exact")
+	})
+
+	t.Run("negative sample alerts", func(t *testing.T) {
+		result := RunRuleContent("negative.sf", `
+desc(
+	lang: yaklang,
+	'safefile://a.yak': <<<CODE
+print("a")
+CODE
+)
+print(* as $target)
+alert $target
+`)
+		require.False(t, result.Passed())
+		require.ErrorContains(t, result.Error(), "negative sample")
+	})
+
+	t.Run("no sample", func(t *testing.T) {
+		result := RunRuleContent("empty.sf", `print(* as $target)`)
+		require.True(t, result.Skipped())
+		require.True(t, result.Passed())
+	})
+
+	t.Run("compile error", func(t *testing.T) {
+		result := RunRuleContent("bad.sf", `print(* as $target`)
+		require.Error(t, result.Err)
+		require.False(t, result.Passed())
+	})
+}
+
+func TestRunFileSystem(t *testing.T) {
+	vfs := filesys.NewVirtualFs()
+	vfs.AddFile("rules/print.sf", passRule)
+	vfs.AddFile("rules/fail.sf", failRule)
+	vfs.AddFile("rules/readme.md", "not a rule")
+
+	var called int
+	report, err := RunFileSystem(vfs, "rules", WithResultCallback(func(*RuleResult) {
+		called++
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 2, called)
+	total, passed, failed, skipped := report.Summary()
+	require.Equal(t, 2, total)
+	require.Equal(t, 1, passed)
+	require.Equal(t, 1, failed)
+	require.Equal(t, 0, skipped)
+	require.False(t, report.Passed())
+
+	t.Run("junit", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJUnit(&buf))
+		var suites junitTestSuites
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+		require.Equal(t, 3, suites.Tests)
+		require.Equal(t, 1, suites.Failures)
+		require.Len(t, suites.Suites, 2)
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteSARIF(&buf))
+		var sarifReport struct {
+			Runs []struct {
+				Tool struct {
+					Driver struct {
+						Rules []struct {
+							ID string `json:"id"`
+						} `json:"rules"`
+					} `json:"driver"`
+				} `json:"tool"`
+				Results []struct {
+					RuleID string `json:"ruleId"`
+					Kind   string `json:"kind"`
+				} `json:"results"`
+			} `json:"runs"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &sarifReport))
+		require.Len(t, sarifReport.Runs, 1)
+		run := sarifReport.Runs[0]
+		require.Len(t, run.Tool.Driver.Rules, 2)
+		kinds := make(map[string][]string)
+		for _, result := range run.Results {
+			kinds[result.RuleID] = append(kinds[result.RuleID], result.Kind)
+		}
+		require.Equal(t, []string{"pass", "pass"}, kinds["print.sf"])
+		require.Equal(t, []string{"fail"}, kinds["fail.sf"])
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -59,7 +60,10 @@ type SFFrame struct {
 
 type VerifyFileSystem struct {
 	vfs       filesys_interface.FileSystem
+	files     []string
 	checkInfo map[string]string
+	// negative 为 true 时表示规则在这些文件上不应该产生告警
+	negative bool
 }
 
 func (s *SFFrame) GetResult() *SFFrameResult {
@@ -151,7 +155,9 @@ func (s *SFFrame) ExtractVerifyFilesystemAndLanguage() (consts.Language, []*Veri
 				}
 			}
 			vfs.AddFile(name, content)
+			verify.files = append(verify.files, name)
 		}
+		sort.Strings(verify.files)
 		verify.vfs = vfs
 		verify.checkInfo = desc.rawDesc
 		result = append(result, verify)
@@ -186,8 +192,11 @@ func (s *SFFrame) ExtractNegativeFilesystemAndLanguage() (consts.Language, []*Ve
 				}
 			}
 			vfs.AddFile(name, content)
+			verify.files = append(verify.files, name)
 		}
+		sort.Strings(verify.files)
 		verify.vfs = vfs
+		verify.negative = true
 		verify.checkInfo = desc.rawDesc
 		result = append(result, verify)
 	}
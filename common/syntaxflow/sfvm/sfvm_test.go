@@ -47,3 +47,23 @@ func TestSFVMCompileRule(t *testing.T) {
 	//require.NoError(t, err)
 	//fmt.Println(rule)
 }
+
+func TestVerifyFsInfoOncePerDescription(t *testing.T) {
+	// the extra desc of a description block is collected once, no matter how many items follow it
+	syntaxFlow := compileSyntaxFlow(`desc(
+	title: "demo",
+	"file://a.java": "class A {}",
+	"file://b.java": "class B {}",
+	"safefile://c.java": "class C {}",
+	"extra": "value",
+	level: "high",
+	lang: "java",
+)
+a as $a
+`)
+	require.Len(t, syntaxFlow.verifyFsInfo, 1)
+	info := syntaxFlow.verifyFsInfo[0]
+	require.Len(t, info.verifyFilesystem, 2)
+	require.Len(t, info.negativeFilesystem, 1)
+	require.Equal(t, "value", info.rawDesc["extra"])
+}
@@ -0,0 +1,128 @@
+package sfvm
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// VerifyAlertExpectation 是规则 desc 中为测试样例声明的告警数量要求，值为 0 表示不限制
+//
+//	desc(
+//		alert_min: 1,
+//		alert_high: 1,
+//		'file://a.java': <<<CODE
+//		...
+//	CODE
+//	)
+type VerifyAlertExpectation struct {
+	Min   int
+	Max   int
+	Exact int
+	High  int
+	Mid   int
+	Low   int
+}
+
+// VerifyAlertStatistics 是规则在测试样例上实际产生的告警数量
+type VerifyAlertStatistics struct {
+	Total int
+	High  int
+	Mid   int
+	Low   int
+}
+
+// Add 按照告警等级累加告警数量
+func (s *VerifyAlertStatistics) Add(severity string, count int) {
+	s.Total += count
+	switch strings.ToLower(severity) {
+	case "mid", "m", "middle":
+		s.Mid += count
+	case "high", "h":
+		s.High += count
+	case "info", "low":
+		s.Low += count
+	}
+}
+
+// GetFileNames 返回测试样例中的文件名
+func (v *VerifyFileSystem) GetFileNames() []string {
+	return v.files
+}
+
+// IsNegative 判断是否为反例，反例中规则不应该产生任何告警
+func (v *VerifyFileSystem) IsNegative() bool {
+	return v.negative
+}
+
+// GetAlertExpectation 返回测试样例中声明的告警数量要求
+func (v *VerifyFileSystem) GetAlertExpectation() *VerifyAlertExpectation {
+	return &VerifyAlertExpectation{
+		Min:   v.GetExtraInfoInt("alert_min", "vuln_min", "alertMin", "vulnMin"),
+		Max:   v.GetExtraInfoInt("alert_max", "vuln_max", "alertMax", "vulnMax"),
+		Exact: v.GetExtraInfoInt("alert_exact", "alertExact", "vulnExact", "alert_num", "vulnNum"),
+		High:  v.GetExtraInfoInt("alert_high", "alertHigh", "vulnHigh"),
+		Mid:   v.GetExtraInfoInt("alert_mid", "alertMid", "vulnMid"),
+		Low:   v.GetExtraInfoInt("alert_low", "alertLow", "vulnLow", "alert_info"),
+	}
+}
+
+// Check 检查实际的告警数量是否满足测试样例的要求：
+// 正例至少要有一个告警并满足 desc 中的数量要求，反例不能有告警
+func (v *VerifyFileSystem) Check(stat *VerifyAlertStatistics) error {
+	if stat == nil {
+		stat = &VerifyAlertStatistics{}
+	}
+	if v.negative {
+		if stat.Total > 0 {
+			return utils.Errorf("alert symbol table not empty, have %v alert(s) in negative sample", stat.Total)
+		}
+		return nil
+	}
+	return v.GetAlertExpectation().Check(stat)
+}
+
+// Check 检查实际的告警数量是否满足要求
+func (e *VerifyAlertExpectation) Check(stat *VerifyAlertStatistics) error {
+	if stat == nil || stat.Total <= 0 {
+		return utils.Errorf("alert symbol table is empty")
+	}
+	if e.Min > 0 && stat.Total < e.Min {
+		return utils.Errorf("alert symbol table is less than alert_min config: %v actual got: %v", e.Min, stat.Total)
+	}
+	if e.Max > 0 && stat.Total > e.Max {
+		return utils.Errorf("alert symbol table is more than alert_max config: %v actual got: %v", e.Max, stat.Total)
+	}
+	if e.Exact > 0 && stat.Total != e.Exact {
+		return utils.Errorf("alert symbol table is not equal alert_exact config: %v, actual got: %v", e.Exact, stat.Total)
+	}
+	if e.High > 0 && stat.High < e.High {
+		return utils.Errorf("alert symbol table is less than alert_high config: %v, actual got: %v", e.High, stat.High)
+	}
+	if e.Mid > 0 && stat.Mid < e.Mid {
+		return utils.Errorf("alert symbol table is less than alert_mid config: %v, actual got: %v", e.Mid, stat.Mid)
+	}
+	if e.Low > 0 && stat.Low < e.Low {
+		return utils.Errorf("alert symbol table is less than alert_low config: %v, actual got: %v", e.Low, stat.Low)
+	}
+	return nil
+}
+
+// ExtractVerifyTestCases 返回规则中声明的全部测试样例，正例在前，反例在后
+func (s *SFFrame) ExtractVerifyTestCases() (consts.Language, []*VerifyFileSystem, error) {
+	language, positive, err := s.ExtractVerifyFilesystemAndLanguage()
+	if err != nil {
+		return language, nil, err
+	}
+	negativeLanguage, negative, err := s.ExtractNegativeFilesystemAndLanguage()
+	if len(negative) > 0 {
+		if err != nil {
+			return language, nil, err
+		}
+		if language == "" {
+			language = negativeLanguage
+		}
+	}
+	return language, append(positive, negative...), nil
+}
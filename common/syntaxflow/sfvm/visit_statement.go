@@ -134,11 +134,11 @@ func (y *SyntaxFlowVisitor) VisitDescriptionStatement(raw sf.IDescriptionStateme
 			}
 			extraDesc.rawDesc[key] = value
 		}
-		if haveDesc {
-			y.verifyFsInfo = append(y.verifyFsInfo, extraDesc)
-		}
 		y.EmitAddDescription(key, value)
 	}
+	if haveDesc {
+		y.verifyFsInfo = append(y.verifyFsInfo, extraDesc)
+	}
 
 	return nil
 }
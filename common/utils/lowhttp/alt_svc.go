@@ -0,0 +1,201 @@
+package lowhttp
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	// altSvcDefaultMaxAge 是 RFC 7838 中 ma 参数的默认值
+	altSvcDefaultMaxAge = 24 * time.Hour
+	// altSvcBrokenDuration 是 HTTP/3 端点连接失败后不再尝试的时间
+	altSvcBrokenDuration = 5 * time.Minute
+)
+
+// DefaultAltSvcCache 是默认的 Alt-Svc 缓存，自动升级 HTTP/3 时使用
+var DefaultAltSvcCache = NewAltSvcCache()
+
+// AltSvc 是 Alt-Svc 响应头中声明的一个替代服务
+//
+//	Alt-Svc: h3=":443"; ma=86400, h3-29=":443"
+type AltSvc struct {
+	Protocol string
+	// Host 为空表示与源站相同
+	Host   string
+	Port   int
+	MaxAge time.Duration
+}
+
+// ParseAltSvc 解析 Alt-Svc 响应头，clear 表示清除之前声明的全部替代服务
+func ParseAltSvc(header string) (services []*AltSvc, clear bool) {
+	header = strings.TrimSpace(header)
+	if strings.EqualFold(header, "clear") {
+		return nil, true
+	}
+	for _, value := range splitAltSvcHeader(header, ',') {
+		params := splitAltSvcHeader(value, ';')
+		if len(params) == 0 {
+			continue
+		}
+		protocol, authority, ok := strings.Cut(params[0], "=")
+		if !ok {
+			continue
+		}
+		authority = strings.Trim(strings.TrimSpace(authority), `"`)
+		host, portStr, err := net.SplitHostPort(authority)
+		if err != nil {
+			continue
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port <= 0 || port > 65535 {
+			continue
+		}
+		service := &AltSvc{
+			Protocol: strings.TrimSpace(protocol),
+			Host:     host,
+			Port:     port,
+			MaxAge:   altSvcDefaultMaxAge,
+		}
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(param, "=")
+			if strings.EqualFold(strings.TrimSpace(key), "ma") {
+				if seconds, err := strconv.Atoi(strings.Trim(strings.TrimSpace(value), `"`)); err == nil && seconds >= 0 {
+					service.MaxAge = time.Duration(seconds) * time.Second
+				}
+			}
+		}
+		services = append(services, service)
+	}
+	return services, false
+}
+
+// splitAltSvcHeader 按分隔符切分，忽略引号内的分隔符
+func splitAltSvcHeader(s string, sep rune) []string {
+	var (
+		parts   []string
+		current strings.Builder
+		quoted  bool
+	)
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == sep && !quoted:
+			if part := strings.TrimSpace(current.String()); part != "" {
+				parts = append(parts, part)
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if part := strings.TrimSpace(current.String()); part != "" {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+type altSvcEntry struct {
+	addr        string
+	expireAt    time.Time
+	brokenUntil time.Time
+}
+
+// AltSvcCache 按源站（https://host:port）缓存服务端通过 Alt-Svc 声明的 HTTP/3 端点
+type AltSvcCache struct {
+	mu      sync.Mutex
+	entries map[string]*altSvcEntry
+}
+
+func NewAltSvcCache() *AltSvcCache {
+	return &AltSvcCache{entries: make(map[string]*altSvcEntry)}
+}
+
+// Get 返回源站可用的 HTTP/3 端点，已过期或者被标记为不可用时返回 false
+func (c *AltSvcCache) Get(origin string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[origin]
+	if !ok {
+		return "", false
+	}
+	now := time.Now()
+	if now.After(entry.expireAt) {
+		delete(c.entries, origin)
+		return "", false
+	}
+	if now.Before(entry.brokenUntil) {
+		return "", false
+	}
+	return entry.addr, true
+}
+
+// Update 根据 Alt-Svc 响应头更新源站的 HTTP/3 端点，originHost 用于补全省略了主机名的端点
+func (c *AltSvcCache) Update(origin string, originHost string, header string) {
+	services, clear := ParseAltSvc(header)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if clear {
+		delete(c.entries, origin)
+		return
+	}
+	for _, service := range services {
+		if service.Protocol != "h3" {
+			continue
+		}
+		if service.MaxAge <= 0 {
+			delete(c.entries, origin)
+			return
+		}
+		host := service.Host
+		if host == "" {
+			host = originHost
+		}
+		addr := utils.HostPort(host, service.Port)
+		entry, ok := c.entries[origin]
+		if !ok || entry.addr != addr {
+			entry = &altSvcEntry{addr: addr}
+			c.entries[origin] = entry
+		}
+		entry.expireAt = time.Now().Add(service.MaxAge)
+		return
+	}
+}
+
+// MarkBroken 标记源站的 HTTP/3 端点暂时不可用，之后的请求会直接使用 TCP
+func (c *AltSvcCache) MarkBroken(origin string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[origin]; ok {
+		entry.brokenUntil = time.Now().Add(altSvcBrokenDuration)
+	}
+}
+
+func (c *AltSvcCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*altSvcEntry)
+}
+
+// updateFromResponse 从响应中的全部 Alt-Svc 头更新缓存
+func (c *AltSvcCache) updateFromResponse(origin string, originHost string, rsp []byte) {
+	if len(rsp) == 0 {
+		return
+	}
+	var values []string
+	SplitHTTPHeadersAndBodyFromPacket(rsp, func(line string) {
+		key, value := SplitHTTPHeader(line)
+		if strings.EqualFold(strings.TrimSpace(key), "Alt-Svc") {
+			values = append(values, strings.TrimSpace(value))
+		}
+	})
+	if len(values) == 0 {
+		return
+	}
+	c.Update(origin, originHost, strings.Join(values, ", "))
+}
@@ -36,6 +36,7 @@ type LowhttpExecConfig struct {
 	//ResponseCallback                 func(response *LowhttpResponse) have same option SaveHTTPFlowHandler
 	Http2                            bool
 	Http3                            bool
+	Http3Auto                        bool
 	GmTLS                            bool
 	GmTLSOnly                        bool
 	GmTLSPrefer                      bool
@@ -68,6 +69,8 @@ type LowhttpExecConfig struct {
 	FromPlugin                       string
 	WithConnPool                     bool
 	ConnPool                         *LowHttpConnPool
+	Http3ConnPool                    *Http3ConnPool
+	AltSvcCache                      *AltSvcCache
 	NativeHTTPRequestInstance        *http.Request
 	Username                         string
 	Password                         string
@@ -123,6 +126,7 @@ type LowhttpResponse struct {
 	Proxy                  string
 	Https                  bool
 	Http2                  bool
+	Http3                  bool
 	RawRequest             []byte
 	Source                 string // 请求源
 	RuntimeId              string
@@ -546,6 +550,26 @@ func WithHttp3(http3 bool) LowhttpOpt {
 	}
 }
 
+// WithHttp3Auto 开启后会记录 https 响应中 Alt-Svc 声明的 HTTP/3 端点，
+// 之后对同一源站的请求自动使用 HTTP/3，QUIC 连接失败时回退到 TCP
+func WithHttp3Auto(b bool) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.Http3Auto = b
+	}
+}
+
+func WithHttp3ConnPool(p *Http3ConnPool) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.Http3ConnPool = p
+	}
+}
+
+func WithAltSvcCache(c *AltSvcCache) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.AltSvcCache = c
+	}
+}
+
 func WithTimeout(timeout time.Duration) LowhttpOpt {
 	return func(o *LowhttpExecConfig) {
 		o.Timeout = timeout
@@ -58,6 +58,17 @@ type LowHttpConnPool struct {
 	idleLRU            connLRU                   // 连接池 LRU
 	keepAliveTimeout   time.Duration
 	ctx                context.Context
+
+	http3Once sync.Once
+	http3Pool *Http3ConnPool // HTTP/3 请求使用的 QUIC 连接池
+}
+
+// Http3Pool 返回这个连接池对应的 HTTP/3 连接池，与 TCP 连接池使用同一个 context
+func (l *LowHttpConnPool) Http3Pool() *Http3ConnPool {
+	l.http3Once.Do(func() {
+		l.http3Pool = NewHttp3ConnPool(l.ctx, l.maxIdleConn)
+	})
+	return l.http3Pool
 }
 
 func (l *LowHttpConnPool) HostConnFull(key *connectKey) bool {
@@ -188,6 +188,9 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 		https                = option.Https
 		forceHttp2           = option.Http2
 		forceHttp3           = option.Http3
+		http3Auto            = option.Http3Auto
+		http3ConnPool        = option.Http3ConnPool
+		altSvcCache          = option.AltSvcCache
 		gmTLS                = option.GmTLS
 		onlyGMTLS            = option.GmTLSOnly
 		preferGMTLS          = option.GmTLSPrefer
@@ -274,6 +277,13 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 	if connPool == nil {
 		connPool = DefaultLowHttpConnPool
 	}
+	if http3ConnPool == nil && withConnPool {
+		// 与 TCP 连接一样，只有开启连接池时才复用 QUIC 连接
+		http3ConnPool = connPool.Http3Pool()
+	}
+	if altSvcCache == nil {
+		altSvcCache = DefaultAltSvcCache
+	}
 
 	// 用于检查 BodyStreamReaderHandler 是否被正常调用
 	bodyStreamReaderHandled := utils.NewAtomicBool()
@@ -663,17 +673,107 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 	if haveNativeHTTPRequestInstance {
 		httpctx.SetRequestHTTPS(reqIns, https)
 	}
+
+	// h3: 强制使用 HTTP/3，或者根据源站之前在 Alt-Svc 中声明的端点自动升级
+	// 自动升级的请求在 QUIC 连接失败时会回退到 TCP
+	http3Addr := originAddr
+	http3SNI := host
+	if sni != nil {
+		http3SNI = *sni
+	}
+	http3Fallback := false
+	altSvcOrigin := "https://" + originAddr
+	if http3Auto && https && !gmTLS {
+		if !enableHttp3 && !enableHttp2 && len(proxy) == 0 {
+			if addr, ok := altSvcCache.Get(altSvcOrigin); ok {
+				enableHttp3 = true
+				http3Fallback = true
+				http3Addr = addr
+			}
+		}
+		defer func() {
+			if response != nil {
+				altSvcCache.updateFromResponse(altSvcOrigin, host, response.RawPacket)
+			}
+		}()
+	}
 RECONNECT:
 	if enableHttp3 {
-		http3Conn, err := getHTTP3Conn(ctx, originAddr, dialopts...)
+		response.Https = true
+		var (
+			firstResponse *http.Response
+			rawBytes      []byte
+		)
+		var (
+			pc     *http3PersistConn
+			reused bool
+			err    error
+		)
+		if http3ConnPool != nil {
+			pc, reused, err = http3ConnPool.getConn(ctx, http3Addr, http3SNI, option.VerifyCertificate, connectTimeout, dialopts...)
+		} else {
+			pc, err = dialHTTP3Conn(ctx, http3Addr, http3SNI, option.VerifyCertificate, connectTimeout, dialopts...)
+		}
+		if err == nil {
+			if option.BeforeDoRequest != nil {
+				requestPacket = option.BeforeDoRequest(requestPacket)
+			}
+			if haveNativeHTTPRequestInstance {
+				httpctx.SetBareRequestBytes(reqIns, requestPacket)
+			}
+			currentRPS.Add(1)
+			serverStart := time.Now()
+			firstResponse, rawBytes, err = doHttp3Request(ctx, pc.client, requestPacket, timeout)
+			traceInfo.ServerTime = time.Since(serverStart)
+			if http3ConnPool == nil {
+				pc.close()
+			} else if err != nil {
+				http3ConnPool.remove(pc)
+			} else {
+				http3ConnPool.release(pc)
+			}
+			if err != nil {
+				if reused {
+					// 连接池中的连接可能已经被对端关闭，重新握手再试一次
+					goto RECONNECT
+				}
+			}
+		}
 		if err != nil {
-			return nil, err
+			if http3Fallback {
+				log.Debugf("http3 request to %v failed, fallback to tcp: %v", http3Addr, err)
+				altSvcCache.MarkBroken(altSvcOrigin)
+				enableHttp3 = false
+				http3Fallback = false
+				goto RECONNECT
+			}
+			return response, err
+		}
+		response.Http3 = true
+		response.RemoteAddr = pc.conn.RemoteAddr().String()
+		if haveNativeHTTPRequestInstance {
+			httpctx.SetRemoteAddr(reqIns, response.RemoteAddr)
 		}
-		_, responsePacket, err := doHttp3Request(ctx, http3Conn, requestPacket)
+		response.PortIsOpen = true
 
-		httpctx.SetBareResponseBytes(reqIns, responsePacket)
-		response.RawPacket = responsePacket
-		return response, nil
+		if option.EnableMaxContentLength && maxContentLength > 0 {
+			if body := GetHTTPPacketBody(rawBytes); len(body) > maxContentLength {
+				rawBytes = ReplaceHTTPPacketBodyRaw(rawBytes, body[:maxContentLength], true)
+			}
+		}
+		if haveNativeHTTPRequestInstance {
+			httpctx.SetBareResponseBytes(reqIns, rawBytes)
+		}
+		if session != nil {
+			cookiejar.SetCookies(urlIns, firstResponse.Cookies())
+		}
+		if retry(response, rawBytes, retryTimes) && (retryTimes < maxRetryTimes || retryHandler != nil) {
+			retryTimes += 1
+			time.Sleep(utils.JitterBackoff(retryWaitTime, retryMaxWaitTime, retryTimes))
+			log.Infof("retry reconnect because [%d / %d]", retryTimes, maxRetryTimes)
+			goto RECONNECT
+		}
+		return fixLowhttpResponse(response, rawBytes, noFixContentLength)
 	} else if withConnPool {
 		conn, err = connPool.getIdleConn(cacheKey, dialopts...)
 	} else {
@@ -1086,13 +1186,17 @@ RECONNECT:
 		goto RECONNECT
 	}
 
+	return fixLowhttpResponse(response, rawBytes, noFixContentLength || isMultiResponses)
+}
+
+func fixLowhttpResponse(response *LowhttpResponse, rawBytes []byte, noFixContentLength bool) (*LowhttpResponse, error) {
 	response.BareResponse = rawBytes
 	/*
 		FixHTTPResponse will be executed when:
 		1. SMUGGLE: noFixContentLength is false
 		2. PIPELINE(multi response)
 	*/
-	if !noFixContentLength && !isMultiResponses {
+	if !noFixContentLength {
 		// return responseRaw.Bytes(), nil
 		header := GetHTTPPacketHeader(rawBytes, "Content-Type")
 		response.OriginContentType = header
@@ -2,25 +2,27 @@ package lowhttp
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"github.com/quic-go/quic-go"
 	"github.com/quic-go/quic-go/http3"
-	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/utils"
 	"io"
 	"net/http"
 	"net/http/httptrace"
 	"net/textproto"
+	"time"
 )
 
 const bodyCopyBufferSize = 8 * 1024
 
-func doHttp3Request(ctx context.Context, clientConn *http3.ClientConn, reqPacket []byte) (*http.Response, []byte, error) {
+func doHttp3Request(ctx context.Context, clientConn *http3.ClientConn, reqPacket []byte, timeout time.Duration) (*http.Response, []byte, error) {
 	stream, err := clientConn.OpenRequestStream(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
+	if timeout > 0 {
+		stream.SetDeadline(time.Now().Add(timeout))
+	}
 
 	req, err := ParseBytesToHttpRequest(reqPacket)
 	if err != nil {
@@ -84,7 +86,13 @@ func doHttp3Request(ctx context.Context, clientConn *http3.ClientConn, reqPacket
 		break
 	}
 	res.Request = req
-	respPacket, _ := utils.DumpHTTPResponse(res, res.Body != nil)
+	respPacket, err := utils.DumpHTTPResponse(res, res.Body != nil)
+	if res.Body != nil {
+		res.Body.Close()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
 	return res, respPacket, nil
 
 }
@@ -116,23 +124,3 @@ func NewHTTP3ClientConn(conn quic.Connection) *http3.ClientConn {
 	tr := &http3.Transport{}
 	return tr.NewClientConn(conn)
 }
-
-func getHTTP3Conn(ctx context.Context, target string, opt ...netx.DialXOption) (*http3.ClientConn, error) {
-	udpConn, remoteAddr, err := netx.DialUdpX(target, append(opt, netx.DialX_WithUdpJustListen(true))...)
-	if err != nil {
-		return nil, err
-	}
-	sni := utils.ExtractHost(target)
-	if sni == "" {
-		return nil, utils.Error("cannot extract sni from target")
-	}
-	conn, err := quic.Dial(ctx, udpConn, remoteAddr, &tls.Config{
-		ServerName: sni,
-		NextProtos: []string{"h3"},
-	}, &quic.Config{})
-	if err != nil {
-		return nil, utils.Wrapf(err, "quic.Dial %v failed", remoteAddr.String())
-	}
-
-	return NewHTTP3ClientConn(conn), nil
-}
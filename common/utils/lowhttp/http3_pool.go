@@ -0,0 +1,314 @@
+package lowhttp
+
+import (
+	"container/list"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	http3KeepAlivePeriod        = 10 * time.Second
+	defaultHttp3MaxConns        = 100
+	defaultHttp3IdleConnTimeout = 90 * time.Second
+)
+
+type http3PersistConn struct {
+	key     string
+	conn    quic.Connection
+	udpConn *net.UDPConn
+	client  *http3.ClientConn
+
+	// 以下字段由连接池的锁保护
+	active    int           // 正在使用连接的请求数
+	idleTimer *time.Timer   // 空闲超时后关闭连接
+	element   *list.Element // 在 LRU 中的位置
+}
+
+func (pc *http3PersistConn) alive() bool {
+	return pc.conn.Context().Err() == nil
+}
+
+func (pc *http3PersistConn) close() {
+	_ = pc.conn.CloseWithError(quic.ApplicationErrorCode(http3.ErrCodeNoError), "")
+	_ = pc.udpConn.Close()
+}
+
+type http3DialCall struct {
+	done chan struct{}
+	pc   *http3PersistConn
+	err  error
+}
+
+// Http3ConnPool 缓存 QUIC 连接，HTTP/3 请求以 stream 的方式在同一个连接上多路复用
+// 空闲超过 idleConnTimeout 的连接会被关闭，连接数超过 maxConns 时关闭最久未使用的空闲连接
+type Http3ConnPool struct {
+	mu              sync.Mutex
+	conns           map[string]*http3PersistConn
+	dialing         map[string]*http3DialCall
+	lru             *list.List // list.Element.Value 为 *http3PersistConn，最近使用的在前
+	maxConns        int
+	idleConnTimeout time.Duration
+	ctx             context.Context
+	cancel          context.CancelFunc
+}
+
+// NewHttp3ConnPool 创建 HTTP/3 连接池，ctx 结束或调用 Close 后关闭全部连接
+func NewHttp3ConnPool(ctx context.Context, maxConns int) *Http3ConnPool {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if maxConns <= 0 {
+		maxConns = defaultHttp3MaxConns
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Http3ConnPool{
+		conns:           make(map[string]*http3PersistConn),
+		dialing:         make(map[string]*http3DialCall),
+		lru:             list.New(),
+		maxConns:        maxConns,
+		idleConnTimeout: defaultHttp3IdleConnTimeout,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+	go func() {
+		<-ctx.Done()
+		p.Clear()
+	}()
+	return p
+}
+
+// SetIdleConnTimeout 设置连接空闲多久之后被关闭
+func (p *Http3ConnPool) SetIdleConnTimeout(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if d > 0 {
+		p.idleConnTimeout = d
+	}
+}
+
+func http3ConnKey(addr string, sni string, verify bool) string {
+	return fmt.Sprintf("%s|%s|%v", addr, sni, verify)
+}
+
+// getConn 获取到 addr 的 QUIC 连接，reused 表示连接来自连接池，使用结束后需要调用 release
+// 并发请求同一个端点时只会进行一次握手
+func (p *Http3ConnPool) getConn(ctx context.Context, addr string, sni string, verify bool, timeout time.Duration, opt ...netx.DialXOption) (pc *http3PersistConn, reused bool, err error) {
+	key := http3ConnKey(addr, sni, verify)
+	p.mu.Lock()
+	if p.ctx.Err() != nil {
+		p.mu.Unlock()
+		return nil, false, utils.Error("http3 conn pool is closed")
+	}
+	if pc, ok := p.conns[key]; ok {
+		if pc.alive() {
+			p.acquireLocked(pc)
+			p.mu.Unlock()
+			return pc, true, nil
+		}
+		p.removeLocked(pc)
+	}
+	if call, ok := p.dialing[key]; ok {
+		p.mu.Unlock()
+		select {
+		case <-call.done:
+			if call.err != nil {
+				return nil, false, call.err
+			}
+			p.mu.Lock()
+			p.acquireLocked(call.pc)
+			p.mu.Unlock()
+			return call.pc, false, nil
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	call := &http3DialCall{done: make(chan struct{})}
+	p.dialing[key] = call
+	p.mu.Unlock()
+
+	call.pc, call.err = dialHTTP3Conn(ctx, addr, sni, verify, timeout, opt...)
+
+	var evicted []*http3PersistConn
+	p.mu.Lock()
+	delete(p.dialing, key)
+	if call.err == nil {
+		call.pc.key = key
+		p.conns[key] = call.pc
+		call.pc.element = p.lru.PushFront(call.pc)
+		p.acquireLocked(call.pc)
+		evicted = p.evictLocked()
+		go p.watch(call.pc)
+	}
+	p.mu.Unlock()
+	close(call.done)
+	for _, old := range evicted {
+		old.close()
+	}
+	return call.pc, false, call.err
+}
+
+// acquireLocked 标记连接正在被使用，需要持有锁
+func (p *Http3ConnPool) acquireLocked(pc *http3PersistConn) {
+	pc.active++
+	if pc.idleTimer != nil {
+		pc.idleTimer.Stop()
+	}
+	if pc.element != nil {
+		p.lru.MoveToFront(pc.element)
+	}
+}
+
+// release 在请求结束后调用，连接没有请求使用时开始计算空闲时间
+func (p *Http3ConnPool) release(pc *http3PersistConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pc.active > 0 {
+		pc.active--
+	}
+	if pc.active > 0 || p.conns[pc.key] != pc {
+		return
+	}
+	if pc.idleTimer != nil {
+		pc.idleTimer.Reset(p.idleConnTimeout)
+		return
+	}
+	pc.idleTimer = time.AfterFunc(p.idleConnTimeout, func() {
+		p.mu.Lock()
+		idle := pc.active == 0 && p.conns[pc.key] == pc
+		if idle {
+			p.removeLocked(pc)
+		}
+		p.mu.Unlock()
+		if idle {
+			log.Debugf("close idle http3 connection: %v", pc.key)
+			pc.close()
+		}
+	})
+}
+
+// evictLocked 连接数超过上限时从 LRU 尾部移出空闲的连接，正在使用的连接不会被关闭，需要持有锁
+func (p *Http3ConnPool) evictLocked() []*http3PersistConn {
+	var evicted []*http3PersistConn
+	for e := p.lru.Back(); e != nil && len(p.conns) > p.maxConns; {
+		prev := e.Prev()
+		pc := e.Value.(*http3PersistConn)
+		if pc.active == 0 {
+			p.removeLocked(pc)
+			evicted = append(evicted, pc)
+		}
+		e = prev
+	}
+	return evicted
+}
+
+// removeLocked 把连接移出连接池，不关闭连接，需要持有锁
+func (p *Http3ConnPool) removeLocked(pc *http3PersistConn) {
+	if p.conns[pc.key] == pc {
+		delete(p.conns, pc.key)
+	}
+	if pc.element != nil {
+		p.lru.Remove(pc.element)
+		pc.element = nil
+	}
+	if pc.idleTimer != nil {
+		pc.idleTimer.Stop()
+	}
+}
+
+// watch 在 QUIC 连接关闭（空闲超时、对端关闭等）后把它移出连接池
+func (p *Http3ConnPool) watch(pc *http3PersistConn) {
+	<-pc.conn.Context().Done()
+	p.mu.Lock()
+	p.removeLocked(pc)
+	p.mu.Unlock()
+	_ = pc.udpConn.Close()
+}
+
+// remove 把不可用的连接移出连接池并关闭
+func (p *Http3ConnPool) remove(pc *http3PersistConn) {
+	p.mu.Lock()
+	p.removeLocked(pc)
+	p.mu.Unlock()
+	pc.close()
+}
+
+// Count 返回连接池中可用的连接数量
+func (p *Http3ConnPool) Count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := 0
+	for _, pc := range p.conns {
+		if pc.alive() {
+			count++
+		}
+	}
+	return count
+}
+
+// Clear 关闭连接池中的全部连接，连接池可以继续使用
+func (p *Http3ConnPool) Clear() {
+	p.mu.Lock()
+	conns := make([]*http3PersistConn, 0, len(p.conns))
+	for _, pc := range p.conns {
+		conns = append(conns, pc)
+	}
+	for _, pc := range conns {
+		p.removeLocked(pc)
+	}
+	p.mu.Unlock()
+	for _, pc := range conns {
+		pc.close()
+	}
+}
+
+// Close 关闭全部连接，之后连接池不再建立新的连接
+func (p *Http3ConnPool) Close() {
+	p.cancel()
+	p.Clear()
+}
+
+func dialHTTP3Conn(ctx context.Context, target string, sni string, verify bool, timeout time.Duration, opt ...netx.DialXOption) (*http3PersistConn, error) {
+	udpConn, remoteAddr, err := netx.DialUdpX(target, append(opt, netx.DialX_WithUdpJustListen(true))...)
+	if err != nil {
+		return nil, err
+	}
+	if sni == "" {
+		sni = utils.ExtractHost(target)
+	}
+	if sni == "" {
+		udpConn.Close()
+		return nil, utils.Error("cannot extract sni from target")
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	conn, err := quic.Dial(ctx, udpConn, remoteAddr, &tls.Config{
+		ServerName:         sni,
+		NextProtos:         []string{http3.NextProtoH3},
+		InsecureSkipVerify: !verify,
+	}, &quic.Config{
+		HandshakeIdleTimeout: timeout,
+		KeepAlivePeriod:      http3KeepAlivePeriod,
+	})
+	if err != nil {
+		udpConn.Close()
+		return nil, utils.Wrapf(err, "quic.Dial %v failed", remoteAddr.String())
+	}
+	log.Debugf("new http3 connection to %v(%v)", target, remoteAddr.String())
+	return &http3PersistConn{
+		conn:    conn,
+		udpConn: udpConn,
+		client:  NewHTTP3ClientConn(conn),
+	}, nil
+}
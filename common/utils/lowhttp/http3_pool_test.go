@@ -0,0 +1,221 @@
+package lowhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+)
+
+// mockHTTP3Server 启动一个本地的 HTTP/3 服务，返回端口以及已经握手的 QUIC 连接数量
+func mockHTTP3Server(t *testing.T) (int, *int64) {
+	t.Helper()
+	certPEM, keyPEM, err := tlsutils.GenerateSelfSignedCertKey("127.0.0.1", []net.IP{net.ParseIP("127.0.0.1")}, nil)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	tlsConfig := http3.ConfigureTLSConfig(&tls.Config{Certificates: []tls.Certificate{cert}})
+	listener, err := quic.ListenAddr("127.0.0.1:0", tlsConfig, &quic.Config{})
+	require.NoError(t, err)
+	server := &http3.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("h3:" + r.URL.Path))
+	})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var handshakes int64
+	go func() {
+		for {
+			conn, err := listener.Accept(ctx)
+			if err != nil {
+				return
+			}
+			atomic.AddInt64(&handshakes, 1)
+			go server.ServeQUICConn(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		server.Close()
+		listener.Close()
+	})
+	return listener.Addr().(*net.UDPAddr).Port, &handshakes
+}
+
+func TestHttp3ConnPool_Reuse(t *testing.T) {
+	port, handshakes := mockHTTP3Server(t)
+	pool := NewHttp3ConnPool(context.Background(), 0)
+	defer pool.Close()
+
+	packet := []byte(fmt.Sprintf("GET /reuse HTTP/1.1\r\nHost: 127.0.0.1:%d\r\n\r\n", port))
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithHttp3ConnPool(pool), WithTimeout(5*time.Second))
+			require.NoError(t, err)
+			require.True(t, rsp.Http3)
+			require.True(t, rsp.Https)
+			require.Equal(t, "h3:/reuse", string(GetHTTPPacketBody(rsp.RawPacket)))
+			proto, _, _ := GetHTTPPacketFirstLine(rsp.RawPacket)
+			require.Equal(t, "HTTP/3.0", proto)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(1), atomic.LoadInt64(handshakes))
+	require.Equal(t, 1, pool.Count())
+
+	// 连接被关闭后重新握手
+	pool.Clear()
+	rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithHttp3ConnPool(pool), WithTimeout(5*time.Second))
+	require.NoError(t, err)
+	require.True(t, rsp.Http3)
+	require.Equal(t, int64(2), atomic.LoadInt64(handshakes))
+}
+
+func TestHttp3ConnPool_FollowConnPoolOption(t *testing.T) {
+	port, handshakes := mockHTTP3Server(t)
+	packet := []byte(fmt.Sprintf("GET /option HTTP/1.1\r\nHost: 127.0.0.1:%d\r\n\r\n", port))
+
+	// 没有开启连接池时，每个请求使用独立的 QUIC 连接
+	for i := 0; i < 2; i++ {
+		rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithTimeout(5*time.Second))
+		require.NoError(t, err)
+		require.True(t, rsp.Http3)
+	}
+	require.Equal(t, int64(2), atomic.LoadInt64(handshakes))
+
+	// 开启连接池时使用调用方传入的连接池
+	ctx, cancel := context.WithCancel(context.Background())
+	connPool := NewHttpConnPool(ctx, 10, 2)
+	for i := 0; i < 2; i++ {
+		rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithConnPool(true), ConnPool(connPool), WithTimeout(5*time.Second))
+		require.NoError(t, err)
+		require.True(t, rsp.Http3)
+	}
+	require.Equal(t, int64(3), atomic.LoadInt64(handshakes))
+	require.Equal(t, 1, connPool.Http3Pool().Count())
+
+	// 连接池的 context 结束后关闭全部连接
+	cancel()
+	require.Eventually(t, func() bool { return connPool.Http3Pool().Count() == 0 }, 3*time.Second, 50*time.Millisecond)
+}
+
+func TestHttp3ConnPool_IdleAndLRU(t *testing.T) {
+	port1, _ := mockHTTP3Server(t)
+	port2, _ := mockHTTP3Server(t)
+	pool := NewHttp3ConnPool(context.Background(), 1)
+	pool.SetIdleConnTimeout(300 * time.Millisecond)
+	defer pool.Close()
+
+	request := func(port int) {
+		packet := []byte(fmt.Sprintf("GET / HTTP/1.1\r\nHost: 127.0.0.1:%d\r\n\r\n", port))
+		rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithHttp3ConnPool(pool), WithTimeout(5*time.Second))
+		require.NoError(t, err)
+		require.True(t, rsp.Http3)
+	}
+
+	// 超过连接数上限时关闭最久未使用的连接
+	request(port1)
+	request(port2)
+	require.Equal(t, 1, pool.Count())
+	pool.mu.Lock()
+	_, ok := pool.conns[http3ConnKey(utils.HostPort("127.0.0.1", port2), "127.0.0.1", false)]
+	pool.mu.Unlock()
+	require.True(t, ok)
+
+	// 空闲超时后关闭连接
+	require.Eventually(t, func() bool { return pool.Count() == 0 }, 3*time.Second, 50*time.Millisecond)
+
+	// 关闭之后不再建立新的连接
+	pool.Close()
+	packet := []byte(fmt.Sprintf("GET / HTTP/1.1\r\nHost: 127.0.0.1:%d\r\n\r\n", port1))
+	_, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttp3(true), WithHttp3ConnPool(pool), WithTimeout(5*time.Second))
+	require.Error(t, err)
+}
+
+func TestHttp3Auto_AltSvcUpgrade(t *testing.T) {
+	h3Port, handshakes := mockHTTP3Server(t)
+	host, port := utils.DebugMockHTTPSEx(func(req []byte) []byte {
+		return []byte(fmt.Sprintf("HTTP/1.1 200 OK\r\nAlt-Svc: h3=\":%d\"; ma=3600\r\nContent-Length: 3\r\n\r\ntcp", h3Port))
+	})
+	pool := NewHttp3ConnPool(context.Background(), 0)
+	defer pool.Close()
+	cache := NewAltSvcCache()
+
+	packet := []byte(fmt.Sprintf("GET /upgrade HTTP/1.1\r\nHost: %s\r\n\r\n", utils.HostPort(host, port)))
+	opts := []LowhttpOpt{WithPacketBytes(packet), WithHttps(true), WithHttp3Auto(true), WithHttp3ConnPool(pool), WithAltSvcCache(cache), WithTimeout(5 * time.Second)}
+
+	rsp, err := HTTPWithoutRedirect(opts...)
+	require.NoError(t, err)
+	require.False(t, rsp.Http3)
+	require.Equal(t, "tcp", string(GetHTTPPacketBody(rsp.RawPacket)))
+	addr, ok := cache.Get("https://" + utils.HostPort(host, port))
+	require.True(t, ok)
+	require.Equal(t, utils.HostPort(host, h3Port), addr)
+
+	for i := 0; i < 3; i++ {
+		rsp, err = HTTPWithoutRedirect(opts...)
+		require.NoError(t, err)
+		require.True(t, rsp.Http3)
+		require.Equal(t, "h3:/upgrade", string(GetHTTPPacketBody(rsp.RawPacket)))
+	}
+	require.Equal(t, int64(1), atomic.LoadInt64(handshakes))
+}
+
+func TestHttp3Auto_FallbackToTCP(t *testing.T) {
+	deadPort := utils.GetRandomAvailableUDPPort()
+	host, port := utils.DebugMockHTTPSEx(func(req []byte) []byte {
+		return []byte(fmt.Sprintf("HTTP/1.1 200 OK\r\nAlt-Svc: h3=\":%d\"\r\nContent-Length: 3\r\n\r\ntcp", deadPort))
+	})
+	cache := NewAltSvcCache()
+	origin := "https://" + utils.HostPort(host, port)
+	cache.Update(origin, host, fmt.Sprintf(`h3=":%d"`, deadPort))
+
+	packet := []byte(fmt.Sprintf("GET / HTTP/1.1\r\nHost: %s\r\n\r\n", utils.HostPort(host, port)))
+	rsp, err := HTTPWithoutRedirect(WithPacketBytes(packet), WithHttps(true), WithHttp3Auto(true), WithHttp3ConnPool(NewHttp3ConnPool(context.Background(), 0)), WithAltSvcCache(cache), WithTimeout(5*time.Second))
+	require.NoError(t, err)
+	require.False(t, rsp.Http3)
+	require.Equal(t, "tcp", string(GetHTTPPacketBody(rsp.RawPacket)))
+
+	// 端点被标记为不可用，在一段时间内不会再尝试 HTTP/3
+	_, ok := cache.Get(origin)
+	require.False(t, ok)
+}
+
+func TestParseAltSvc(t *testing.T) {
+	services, clear := ParseAltSvc(`h3=":443"; ma=86400, h3-29="alt.example.com:8443"; persist=1, h2=":443"`)
+	require.False(t, clear)
+	require.Len(t, services, 3)
+	require.Equal(t, "h3", services[0].Protocol)
+	require.Equal(t, "", services[0].Host)
+	require.Equal(t, 443, services[0].Port)
+	require.Equal(t, 86400, int(services[0].MaxAge.Seconds()))
+	require.Equal(t, "alt.example.com", services[1].Host)
+	require.Equal(t, 8443, services[1].Port)
+
+	_, clear = ParseAltSvc("clear")
+	require.True(t, clear)
+
+	cache := NewAltSvcCache()
+	cache.Update("https://example.com:443", "example.com", `h3-29=":443", h3=":8443"`)
+	addr, ok := cache.Get("https://example.com:443")
+	require.True(t, ok)
+	require.Equal(t, "example.com:8443", addr)
+	cache.Update("https://example.com:443", "example.com", "clear")
+	_, ok = cache.Get("https://example.com:443")
+	require.False(t, ok)
+}
@@ -29,6 +29,9 @@ var (
 	PosPostQueryJson       HttpParamPositionType = "post-query-json"
 	PosPostQueryBase64Json HttpParamPositionType = "post-query-base64-json"
 	PosPostJson            HttpParamPositionType = "post-json"
+	PosPostGraphQLArgument HttpParamPositionType = "post-graphql-argument"
+	PosPostGraphQLVariable HttpParamPositionType = "post-graphql-variable"
+	PosPostProtobuf        HttpParamPositionType = "post-protobuf"
 	PosCookie              HttpParamPositionType = "cookie"
 	PosCookieBase64        HttpParamPositionType = "cookie-base64"
 	PosCookieJson          HttpParamPositionType = "cookie-json"
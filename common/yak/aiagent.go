@@ -165,6 +165,8 @@ var AIAgentExport = map[string]any{
 	"omniSearchTool":               WithOmniSearchTool,
 	"aiToolsSearchTool":            WithAiToolsSearchTool,
 	"aiForgeSearchTool":            WithAiForgeSearchTool,
+	"mcpServerCommand":             WithMCPServerCommand,
+	"mcpServerURL":                 WithMCPServerURL,
 	"debugPrompt":                  WithDebugPrompt,
 	"eventHandler":                 WithEventHandler,
 	"eventInputChan":               WithEventInputChan,
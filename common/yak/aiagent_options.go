@@ -109,6 +109,8 @@ var (
 	WithOmniSearchTool               = aid.WithOmniSearchTool
 	WithAiToolsSearchTool            = aid.WithAiToolsSearchTool
 	WithAiForgeSearchTool            = aid.WithAiForgeSearchTool
+	WithMCPServerCommand             = aid.WithMCPServerCommand
+	WithMCPServerURL                 = aid.WithMCPServerURL
 	WithDebugPrompt                  = aid.WithDebugPrompt
 	WithEventHandler                 = aid.WithEventHandler
 	WithEventInputChan               = aid.WithEventInputChan
@@ -0,0 +1,146 @@
+package c2ssa
+
+import (
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+var binOpTbl = map[string]ssa.BinaryOpcode{
+	"+":  ssa.OpAdd,
+	"-":  ssa.OpSub,
+	"*":  ssa.OpMul,
+	"/":  ssa.OpDiv,
+	"%":  ssa.OpMod,
+	"&":  ssa.OpAnd,
+	"|":  ssa.OpOr,
+	"^":  ssa.OpXor,
+	"<<": ssa.OpShl,
+	">>": ssa.OpShr,
+	"<":  ssa.OpLt,
+	">":  ssa.OpGt,
+	"<=": ssa.OpLtEq,
+	">=": ssa.OpGtEq,
+	"==": ssa.OpEq,
+	"!=": ssa.OpNotEq,
+}
+
+var unaryOpTbl = map[string]ssa.UnaryOpcode{
+	"!": ssa.OpNot,
+	"-": ssa.OpNeg,
+	"+": ssa.OpPlus,
+	"~": ssa.OpBitwiseNot,
+}
+
+// basicTypeSize 是 sizeof 中内置类型的大小，按照 LP64 计算
+var basicTypeSize = map[string]int64{
+	"char": 1, "signed char": 1, "unsigned char": 1, "_Bool": 1, "bool": 1,
+	"short": 2, "unsigned short": 2,
+	"int": 4, "unsigned int": 4, "float": 4,
+	"long": 8, "unsigned long": 8, "long long": 8, "unsigned long long": 8,
+	"double": 8, "long double": 16,
+	"int8_t": 1, "uint8_t": 1, "int16_t": 2, "uint16_t": 2,
+	"int32_t": 4, "uint32_t": 4, "int64_t": 8, "uint64_t": 8,
+	"size_t": 8, "ssize_t": 8,
+}
+
+// SetRange 设置当前构建位置为 node 的范围，返回恢复函数
+func (b *builder) SetRange(node ast.Node) func() {
+	start, end := node.Start(), node.End()
+	return b.SetRangeWithCommonTokenLoc(ssa.NewCommonTokenLoc("", start.Line, start.Col, end.Line, end.Col))
+}
+
+func (b *builder) SwitchFunctionBuilder(s *ssa.StoredFunctionBuilder) func() {
+	t := b.StoreFunctionBuilder()
+	b.LoadBuilder(s)
+	return func() {
+		b.LoadBuilder(t)
+	}
+}
+
+func (b *builder) LoadBuilder(s *ssa.StoredFunctionBuilder) {
+	b.FunctionBuilder = s.Current
+	b.LoadFunctionBuilder(s.Store)
+}
+
+// resolveType 展开 typedef 定义的类型名
+func (b *builder) resolveType(t ast.Type) ast.Type {
+	for i := 0; i < 16; i++ {
+		named, ok := t.(*ast.NamedType)
+		if !ok {
+			return t
+		}
+		next, ok := b.unit.Typedefs[named.Name]
+		if !ok || next == t {
+			return t
+		}
+		t = next
+	}
+	return t
+}
+
+// resolveStruct 返回类型对应的 struct 定义，只引用了标签的 struct 会通过标签查找定义
+func (b *builder) resolveStruct(t ast.Type) *ast.StructType {
+	st, ok := b.resolveType(t).(*ast.StructType)
+	if !ok {
+		return nil
+	}
+	if st.Fields == nil && st.Tag != "" {
+		if def, ok := b.structs[st.Tag]; ok {
+			return def
+		}
+	}
+	return st
+}
+
+// isAggregate 判断类型是否是数组、struct 或 union，这些类型的变量在声明时就是一个对象
+func (b *builder) isAggregate(t ast.Type) bool {
+	switch b.resolveType(t).(type) {
+	case *ast.ArrayType, *ast.StructType:
+		return true
+	}
+	return false
+}
+
+// collectStructs 记录类型中定义的 struct，包括嵌套定义的 struct
+func (b *builder) collectStructs(t ast.Type) {
+	switch t := t.(type) {
+	case *ast.StructType:
+		if t.Fields == nil {
+			return
+		}
+		if t.Tag != "" {
+			b.structs[t.Tag] = t
+		}
+		for _, field := range t.Fields {
+			b.collectStructs(field.Type)
+		}
+	case *ast.PointerType:
+		b.collectStructs(t.Elem)
+	case *ast.ArrayType:
+		b.collectStructs(t.Elem)
+	}
+}
+
+// typeString 返回类型的 C 写法，用于 sizeof、va_arg 等以类型为参数的表达式
+func typeString(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.BasicType:
+		return t.Name
+	case *ast.NamedType:
+		return t.Name
+	case *ast.StructType:
+		if t.Union {
+			return "union " + t.Tag
+		}
+		return "struct " + t.Tag
+	case *ast.EnumType:
+		return "enum " + t.Tag
+	case *ast.PointerType:
+		return typeString(t.Elem) + "*"
+	case *ast.ArrayType:
+		return typeString(t.Elem) + "[]"
+	case *ast.FuncType:
+		return typeString(t.Result) + "()"
+	}
+	return ""
+}
@@ -0,0 +1,222 @@
+package c2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitTranslationUnit(unit *ast.TranslationUnit) {
+	if unit == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(unit)
+	defer recoverRange()
+
+	// C 暂时不需要处理 prehandle 阶段
+	if b.PreHandler() {
+		return
+	}
+	// 头文件中的类型只保留了 typedef，文件中的 struct 和枚举需要在处理函数之前收集
+	for _, typ := range unit.Typedefs {
+		b.collectStructs(typ)
+		b.collectEnums(typ)
+	}
+	for _, decl := range unit.Decls {
+		if stmt, ok := decl.(*ast.DeclStmt); ok {
+			b.collectStructs(stmt.Base)
+			b.collectEnums(stmt.Base)
+		}
+	}
+	b.VisitStatements(unit.Decls)
+}
+
+// collectEnums 记录枚举常量的值，没有指定值的常量比前一个常量大 1
+func (b *builder) collectEnums(t ast.Type) {
+	enum, ok := t.(*ast.EnumType)
+	if !ok {
+		return
+	}
+	next := int64(0)
+	for _, item := range enum.Items {
+		if item.Value != nil {
+			if value, ok := b.evalConst(item.Value); ok {
+				next = value
+			}
+		}
+		b.enums[item.Name] = next
+		next++
+	}
+}
+
+// evalConst 计算常量表达式，表达式中可以引用已知的枚举常量
+func (b *builder) evalConst(expr ast.Expr) (int64, bool) {
+	if ident, ok := expr.(*ast.Ident); ok {
+		value, ok := b.enums[ident.Name]
+		return value, ok
+	}
+	return parser.EvalInt(expr)
+}
+
+// VisitFuncDef 创建函数，函数体在所有文件级别的声明处理完之后再构建，
+// 因此函数中可以调用之后定义的函数以及读取全局变量
+func (b *builder) VisitFuncDef(def *ast.FuncDef) {
+	newFunc := b.NewFunc(def.Name)
+	store := b.StoreFunctionBuilder()
+	newFunc.AddLazyBuilder(func() {
+		switchHandler := b.SwitchFunctionBuilder(store)
+		defer switchHandler()
+		b.FunctionBuilder = b.PushFunction(newFunc)
+		b.labels = make(map[string]*ssa.LabelBuilder)
+
+		b.ProcessParams(def.Type)
+		if def.Body != nil {
+			b.VisitStatements(def.Body.Items)
+		}
+
+		b.Finish()
+		b.FunctionBuilder = b.PopFunction()
+	})
+}
+
+// ProcessParams 创建形参，f(void) 没有参数，定义中省略名字的参数按位置命名
+func (b *builder) ProcessParams(typ *ast.FuncType) {
+	if typ == nil {
+		return
+	}
+	params := typ.Params
+	if len(params) == 1 && params[0].Name == "" {
+		if basic, ok := params[0].Type.(*ast.BasicType); ok && basic.Name == "void" {
+			params = nil
+		}
+	}
+	for index, param := range params {
+		recoverRange := b.SetRange(param)
+		name := param.Name
+		if name == "" {
+			name = fmt.Sprintf("$param_%d", index)
+		}
+		b.NewParam(name)
+		recoverRange()
+	}
+	if typ.Variadic {
+		b.HandlerEllipsis()
+	}
+}
+
+// VisitDeclStmt 处理声明语句，typedef、函数原型和没有初始值的 extern 声明不会产生值
+func (b *builder) VisitDeclStmt(stmt *ast.DeclStmt) {
+	b.collectStructs(stmt.Base)
+	b.collectEnums(stmt.Base)
+	if stmt.Storage == "typedef" {
+		return
+	}
+	for _, decl := range stmt.Decls {
+		if decl.Name == "" {
+			continue
+		}
+		if _, ok := decl.Type.(*ast.FuncType); ok {
+			continue
+		}
+		if stmt.Storage == "extern" && decl.Init == nil {
+			continue
+		}
+		b.VisitDeclarator(decl)
+	}
+}
+
+func (b *builder) VisitDeclarator(decl *ast.Declarator) {
+	recoverRange := b.SetRange(decl)
+	defer recoverRange()
+
+	global := b.Function.IsMain()
+	var value ssa.Value
+	switch {
+	case decl.Init != nil:
+		value = b.visitInitializer(decl.Init, decl.Type)
+	case b.isAggregate(decl.Type):
+		value = b.EmitEmptyContainer()
+	case global:
+		// 全局变量默认初始化为 0
+		value = b.EmitConstInst(0)
+	default:
+		value = b.EmitValueOnlyDeclare(decl.Name)
+	}
+
+	if global {
+		b.assignGlobal(decl.Name, value)
+		return
+	}
+	b.AssignVariable(b.CreateLocalVariable(decl.Name), value)
+}
+
+// visitInitializer 计算变量的初始值，初始化列表会创建对象，
+// struct 的成员按照定义中的成员名作为 key，数组按照下标作为 key
+func (b *builder) visitInitializer(init ast.Expr, typ ast.Type) ssa.Value {
+	list, ok := init.(*ast.InitList)
+	if !ok {
+		return b.VisitExpr(init)
+	}
+	recoverRange := b.SetRange(list)
+	defer recoverRange()
+
+	if len(list.Elems) == 0 {
+		return b.EmitEmptyContainer()
+	}
+
+	var (
+		fields []*ast.Field
+		elem   ast.Type
+	)
+	if st := b.resolveStruct(typ); st != nil {
+		fields = st.Fields
+	} else if array, ok := b.resolveType(typ).(*ast.ArrayType); ok {
+		elem = array.Elem
+	}
+
+	keys := make([]ssa.Value, 0, len(list.Elems))
+	values := make([]ssa.Value, 0, len(list.Elems))
+	position := 0
+	for _, item := range list.Elems {
+		var (
+			key       ssa.Value
+			valueType = elem
+		)
+		if len(item.Designators) > 0 {
+			// 只使用第一层指示符，.a.b = 1 按照 .a = 1 处理
+			designator := item.Designators[0]
+			if designator.Field != "" {
+				key = b.EmitConstInstPlaceholder(designator.Field)
+				for i, field := range fields {
+					if field.Name == designator.Field {
+						position = i
+						valueType = field.Type
+						break
+					}
+				}
+			} else {
+				key = b.VisitExpr(designator.Index)
+				if index, ok := b.evalConst(designator.Index); ok {
+					position = int(index)
+				}
+			}
+		} else if position < len(fields) && fields[position].Name != "" {
+			key = b.EmitConstInstPlaceholder(fields[position].Name)
+			valueType = fields[position].Type
+		} else {
+			key = b.EmitConstInst(position)
+		}
+		position++
+
+		value := b.visitInitializer(item.Value, valueType)
+		if utils.IsNil(key) || utils.IsNil(value) {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	return b.CreateObjectWithMap(keys, values)
+}
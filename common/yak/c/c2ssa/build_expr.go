@@ -0,0 +1,392 @@
+package c2ssa
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// VisitExpr 计算表达式的值，无法处理的表达式返回 undefined 而不是 nil
+func (b *builder) VisitExpr(expr ast.Expr) ssa.Value {
+	if expr == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(expr)
+	defer recoverRange()
+
+	value := b.visitExpr(expr)
+	if utils.IsNil(value) {
+		return b.EmitUndefined("")
+	}
+	return value
+}
+
+func (b *builder) visitExpr(expr ast.Expr) ssa.Value {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return b.readName(e.Name)
+	case *ast.IntLit:
+		value, ok := parser.ParseIntLiteral(e.Value)
+		if !ok {
+			b.NewError(ssa.Error, TAG, InvalidLiteral(e.Value))
+			return nil
+		}
+		return b.EmitConstInst(value)
+	case *ast.FloatLit:
+		value, ok := parser.ParseFloatLiteral(e.Value)
+		if !ok {
+			b.NewError(ssa.Error, TAG, InvalidLiteral(e.Value))
+			return nil
+		}
+		return b.EmitConstInst(value)
+	case *ast.CharLit:
+		return b.EmitConstInst(e.Value)
+	case *ast.StringLit:
+		return b.EmitConstInst(e.Value)
+	case *ast.Binary:
+		return b.VisitBinary(e)
+	case *ast.Assign:
+		return b.VisitAssign(e)
+	case *ast.Unary:
+		return b.VisitUnary(e)
+	case *ast.Postfix:
+		old := b.VisitExpr(e.X)
+		b.assignExpr(e.X, b.emitIncDec(e.Op, old))
+		return old
+	case *ast.Cond:
+		return b.VisitCond(e)
+	case *ast.Call:
+		return b.VisitCall(e)
+	case *ast.Index:
+		obj := b.readPointee(b.VisitExpr(e.X))
+		return b.ReadMemberCallValue(obj, b.VisitExpr(e.Index))
+	case *ast.Member:
+		return b.ReadMemberCallValue(b.visitMemberObject(e), b.EmitConstInstPlaceholder(e.Name))
+	case *ast.Cast:
+		// 类型转换不改变数据流，直接使用被转换的值
+		return b.VisitExpr(e.X)
+	case *ast.Sizeof:
+		return b.VisitSizeof(e)
+	case *ast.TypeExpr:
+		return b.EmitConstInstPlaceholder(typeString(e.Type))
+	case *ast.Comma:
+		var value ssa.Value
+		for _, item := range e.List {
+			value = b.VisitExpr(item)
+		}
+		return value
+	case *ast.InitList:
+		return b.visitInitializer(e, nil)
+	case *ast.CompoundLit:
+		return b.visitInitializer(e.Init, e.Type)
+	case *ast.StmtExpr:
+		return b.VisitStmtExpr(e)
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledExpression(expr))
+		return nil
+	}
+}
+
+// readName 按照 局部变量、枚举常量、全局变量、函数 的顺序查找名字，
+// 都找不到时认为是外部的函数或变量
+func (b *builder) readName(name string) ssa.Value {
+	if value := b.PeekValue(name); !utils.IsNil(value) {
+		return value
+	}
+	if value, ok := b.enums[name]; ok {
+		return b.EmitConstInst(value)
+	}
+	if global := b.GetProgram().GlobalScope; global != nil {
+		if value, ok := global.GetStringMember(name); ok {
+			return value
+		}
+	}
+	if function := b.GetFunc(name, ""); function != nil {
+		return function
+	}
+	return b.ReadValue(name)
+}
+
+// assignName 为名字赋值。函数中对全局变量的赋值只在当前函数之后的读取中可见，
+// 文件级别的赋值会更新全局变量
+func (b *builder) assignName(name string, value ssa.Value) {
+	if b.Function.IsMain() {
+		b.assignGlobal(name, value)
+		return
+	}
+	b.AssignVariable(b.CreateVariable(name), value)
+}
+
+// assignGlobal 全局变量保存为全局容器的成员，其它文件和函数中都可以读取
+func (b *builder) assignGlobal(name string, value ssa.Value) {
+	global := b.GetProgram().GlobalScope
+	if global == nil {
+		b.AssignVariable(b.CreateVariable(name), value)
+		return
+	}
+	b.AssignVariable(b.CreateMemberCallVariable(global, b.EmitConstInstPlaceholder(name)), value)
+}
+
+func isPointer(value ssa.Value) bool {
+	if utils.IsNil(value) || utils.IsNil(value.GetType()) {
+		return false
+	}
+	return value.GetType().GetTypeKind() == ssa.PointerKind
+}
+
+// readPointee 读取指针指向的值，不是通过 & 得到的指针（例如参数）按原值处理
+func (b *builder) readPointee(value ssa.Value) ssa.Value {
+	if isPointer(value) {
+		return b.GetOriginValue(value)
+	}
+	return value
+}
+
+// visitMemberObject 返回成员访问的对象，p->name 会先读取指针指向的对象
+func (b *builder) visitMemberObject(e *ast.Member) ssa.Value {
+	obj := b.VisitExpr(e.X)
+	if e.Arrow {
+		return b.readPointee(obj)
+	}
+	return obj
+}
+
+// assignExpr 为左值表达式赋值
+func (b *builder) assignExpr(target ast.Expr, value ssa.Value) {
+	recoverRange := b.SetRange(target)
+	defer recoverRange()
+
+	switch e := target.(type) {
+	case *ast.Ident:
+		b.assignName(e.Name, value)
+	case *ast.Member:
+		obj := b.visitMemberObject(e)
+		b.AssignVariable(b.CreateMemberCallVariable(obj, b.EmitConstInstPlaceholder(e.Name)), value)
+	case *ast.Index:
+		obj := b.readPointee(b.VisitExpr(e.X))
+		b.AssignVariable(b.CreateMemberCallVariable(obj, b.VisitExpr(e.Index)), value)
+	case *ast.Unary:
+		if e.Op != "*" {
+			b.NewError(ssa.Error, TAG, InvalidAssignTarget(target))
+			return
+		}
+		pointer := b.VisitExpr(e.X)
+		if isPointer(pointer) {
+			b.AssignVariable(b.GetOriginPointer(pointer), value)
+			return
+		}
+		// *p = v 等价于 p[0] = v
+		b.AssignVariable(b.CreateMemberCallVariable(pointer, b.EmitConstInst(0)), value)
+	case *ast.Cast:
+		b.assignExpr(e.X, value)
+	default:
+		b.NewError(ssa.Error, TAG, InvalidAssignTarget(target))
+	}
+}
+
+func (b *builder) VisitBinary(e *ast.Binary) ssa.Value {
+	switch e.Op {
+	case "&&":
+		return b.handlerJumpExpression(
+			func() ssa.Value { return b.VisitExpr(e.X) },
+			func() ssa.Value { return b.VisitExpr(e.Y) },
+			func() ssa.Value { return b.EmitConstInst(false) },
+			ssa.AndExpressionVariable,
+		)
+	case "||":
+		return b.handlerJumpExpression(
+			func() ssa.Value { return b.VisitExpr(e.X) },
+			func() ssa.Value { return b.EmitConstInst(true) },
+			func() ssa.Value { return b.VisitExpr(e.Y) },
+			ssa.OrExpressionVariable,
+		)
+	}
+	op, ok := binOpTbl[e.Op]
+	if !ok {
+		b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(e.Op))
+		return nil
+	}
+	return b.EmitBinOp(op, b.VisitExpr(e.X), b.VisitExpr(e.Y))
+}
+
+// VisitAssign 处理赋值和复合赋值，赋值表达式的值是赋给左值的值
+func (b *builder) VisitAssign(e *ast.Assign) ssa.Value {
+	var value ssa.Value
+	if e.Op == "=" {
+		value = b.visitInitializer(e.Rhs, nil)
+	} else {
+		opName := e.Op[:len(e.Op)-1]
+		op, ok := binOpTbl[opName]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(e.Op))
+			return nil
+		}
+		value = b.EmitBinOp(op, b.VisitExpr(e.Lhs), b.VisitExpr(e.Rhs))
+	}
+	b.assignExpr(e.Lhs, value)
+	return value
+}
+
+func (b *builder) emitIncDec(op string, value ssa.Value) ssa.Value {
+	if op == "++" {
+		return b.EmitBinOp(ssa.OpAdd, value, b.EmitConstInst(1))
+	}
+	return b.EmitBinOp(ssa.OpSub, value, b.EmitConstInst(1))
+}
+
+func (b *builder) VisitUnary(e *ast.Unary) ssa.Value {
+	switch e.Op {
+	case "++", "--":
+		value := b.emitIncDec(e.Op, b.VisitExpr(e.X))
+		b.assignExpr(e.X, value)
+		return value
+	case "&":
+		return b.visitAddress(e.X)
+	case "*":
+		pointer := b.VisitExpr(e.X)
+		if isPointer(pointer) {
+			return b.GetOriginValue(pointer)
+		}
+		// *p 等价于 p[0]
+		return b.ReadMemberCallValue(pointer, b.EmitConstInst(0))
+	}
+	op, ok := unaryOpTbl[e.Op]
+	if !ok {
+		b.NewError(ssa.Error, TAG, UnexpectedUnaryOP(e.Op))
+		return nil
+	}
+	return b.EmitUnOp(op, b.VisitExpr(e.X))
+}
+
+// visitAddress 处理取地址：标量变量会创建指向该变量的指针；
+// 数组、struct 和它们的元素取地址后仍然指向同一个对象，直接使用对象本身
+func (b *builder) visitAddress(target ast.Expr) ssa.Value {
+	switch e := target.(type) {
+	case *ast.Ident:
+		value := b.readName(e.Name)
+		if _, ok := ssa.ToMake(value); ok || value.IsObject() {
+			return value
+		}
+		variable := ssa.ReadVariableFromScopeAndParent(b.CurrentBlock.ScopeTable, e.Name)
+		if variable == nil || utils.IsNil(variable.GetValue()) {
+			return value
+		}
+		return b.EmitConstPointer(variable)
+	case *ast.Index:
+		return b.readPointee(b.VisitExpr(e.X))
+	case *ast.Unary:
+		if e.Op == "*" {
+			// &*p 就是 p
+			return b.VisitExpr(e.X)
+		}
+	}
+	return b.VisitExpr(target)
+}
+
+func (b *builder) VisitCond(e *ast.Cond) ssa.Value {
+	if e.Then == nil {
+		// GNU 扩展 a ?: b 只计算一次 a
+		cond := b.VisitExpr(e.Cond)
+		return b.handlerJumpExpression(
+			func() ssa.Value { return cond },
+			func() ssa.Value { return cond },
+			func() ssa.Value { return b.VisitExpr(e.Else) },
+			ssa.TernaryExpressionVariable,
+		)
+	}
+	return b.handlerJumpExpression(
+		func() ssa.Value { return b.VisitExpr(e.Cond) },
+		func() ssa.Value { return b.VisitExpr(e.Then) },
+		func() ssa.Value { return b.VisitExpr(e.Else) },
+		ssa.TernaryExpressionVariable,
+	)
+}
+
+func (b *builder) VisitCall(call *ast.Call) ssa.Value {
+	callee := b.VisitExpr(call.Func)
+	// 通过函数指针调用：(*fp)(args) 等价于 fp(args)
+	if isPointer(callee) {
+		callee = b.GetOriginValue(callee)
+	}
+	args := make([]ssa.Value, 0, len(call.Args))
+	for _, arg := range call.Args {
+		args = append(args, b.VisitExpr(arg))
+	}
+	return b.EmitCall(b.NewCall(callee, args))
+}
+
+// VisitSizeof 计算内置类型和指针的大小，其它类型的大小依赖于平台和 struct 布局，使用 undefined 表示
+func (b *builder) VisitSizeof(e *ast.Sizeof) ssa.Value {
+	typ := e.Type
+	if typ == nil {
+		// sizeof 不会计算操作数，但 sizeof "abc" 这样的字面量可以确定大小
+		if str, ok := e.X.(*ast.StringLit); ok {
+			return b.EmitConstInst(len(str.Value) + 1)
+		}
+		return b.EmitUndefined("sizeof")
+	}
+	switch t := b.resolveType(typ).(type) {
+	case *ast.BasicType:
+		if size, ok := basicTypeSize[t.Name]; ok {
+			return b.EmitConstInst(size)
+		}
+	case *ast.NamedType:
+		if size, ok := basicTypeSize[t.Name]; ok {
+			return b.EmitConstInst(size)
+		}
+	case *ast.PointerType:
+		return b.EmitConstInst(8)
+	}
+	return b.EmitUndefined("sizeof(" + typeString(typ) + ")")
+}
+
+// VisitStmtExpr 处理 GNU 语句表达式，值是最后一条表达式语句的值
+func (b *builder) VisitStmtExpr(e *ast.StmtExpr) ssa.Value {
+	var value ssa.Value
+	if e.Body == nil {
+		return nil
+	}
+	b.BuildSyntaxBlock(func() {
+		items := e.Body.Items
+		for i, item := range items {
+			if stmt, ok := item.(*ast.ExprStmt); ok && i == len(items)-1 {
+				value = b.VisitExpr(stmt.X)
+				continue
+			}
+			b.VisitStatement(item)
+		}
+	})
+	return value
+}
+
+// handlerJumpExpression 通过条件分支和 Phi 实现短路求值的表达式
+func (b *builder) handlerJumpExpression(
+	cond func() ssa.Value,
+	trueExpr, falseExpr func() ssa.Value,
+	valueName string,
+) ssa.Value {
+	// 为了聚合产生Phi指令
+	id := valueName + "_" + uuid.NewString()
+	variable := b.CreateLocalVariable(id)
+	b.AssignVariable(variable, b.EmitValueOnlyDeclare(id))
+	ifb := b.CreateIfBuilder()
+	ifb.AppendItem(
+		cond,
+		func() {
+			b.AssignVariable(b.CreateVariable(id), trueExpr())
+		},
+	)
+	ifb.SetElse(func() {
+		b.AssignVariable(b.CreateVariable(id), falseExpr())
+	})
+	ifb.Build()
+	// generator phi instruction
+	v := b.ReadValue(id)
+	if b.CurrentRange != nil {
+		v.SetName(b.CurrentRange.GetText())
+	}
+	return v
+}
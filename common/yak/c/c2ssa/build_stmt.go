@@ -0,0 +1,255 @@
+package c2ssa
+
+import (
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitStatements(stmts []ast.Stmt) {
+	for _, stmt := range stmts {
+		if b.IsStop() {
+			return
+		}
+		b.VisitStatement(stmt)
+	}
+}
+
+// VisitStatement 处理单条语句
+func (b *builder) VisitStatement(stmt ast.Stmt) {
+	if stmt == nil || b.IsStop() {
+		return
+	}
+	// goto 的目标标签在 return 等语句之后仍然可达
+	if _, ok := stmt.(*ast.Labeled); !ok && b.IsBlockFinish() {
+		return
+	}
+	recoverRange := b.SetRange(stmt)
+	defer recoverRange()
+	b.AppendBlockRange()
+
+	switch s := stmt.(type) {
+	case *ast.FuncDef:
+		b.VisitFuncDef(s)
+	case *ast.DeclStmt:
+		b.VisitDeclStmt(s)
+	case *ast.Compound:
+		b.BuildSyntaxBlock(func() {
+			b.VisitStatements(s.Items)
+		})
+	case *ast.ExprStmt:
+		b.VisitExpr(s.X)
+	case *ast.If:
+		b.VisitIf(s)
+	case *ast.While:
+		b.VisitWhile(s)
+	case *ast.DoWhile:
+		b.VisitDoWhile(s)
+	case *ast.For:
+		b.VisitFor(s)
+	case *ast.Switch:
+		b.VisitSwitch(s)
+	case *ast.Case:
+		// switch 之外的 case 标签没有意义，只处理其后的语句
+		b.VisitStatement(s.Body)
+	case *ast.Labeled:
+		b.VisitLabeled(s)
+	case *ast.Goto:
+		b.handlerGoto(s.Label)
+	case *ast.Break:
+		if !b.Break() {
+			b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+		}
+	case *ast.Continue:
+		if !b.Continue() {
+			b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+		}
+	case *ast.Return:
+		if s.Value == nil {
+			b.EmitReturn(nil)
+		} else {
+			b.EmitReturn([]ssa.Value{b.VisitExpr(s.Value)})
+		}
+	case *ast.Empty:
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledStatement(stmt))
+	}
+}
+
+func (b *builder) VisitIf(stmt *ast.If) {
+	ifBuilder := b.CreateIfBuilder()
+	ifBuilder.AppendItem(
+		func() ssa.Value {
+			return b.VisitExpr(stmt.Cond)
+		},
+		func() {
+			b.VisitStatement(stmt.Then)
+		},
+	)
+	if stmt.Else != nil {
+		ifBuilder.SetElse(func() {
+			b.VisitStatement(stmt.Else)
+		})
+	}
+	ifBuilder.Build()
+}
+
+func (b *builder) VisitWhile(stmt *ast.While) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		return b.VisitExpr(stmt.Cond)
+	})
+	loop.SetBody(func() {
+		b.VisitStatement(stmt.Body)
+	})
+	loop.Finish()
+}
+
+// VisitDoWhile 先执行一次循环体，循环体末尾条件不成立时跳出循环
+func (b *builder) VisitDoWhile(stmt *ast.DoWhile) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		return b.EmitConstInst(true)
+	})
+	loop.SetBody(func() {
+		b.VisitStatement(stmt.Body)
+		b.CreateIfBuilder().SetCondition(func() ssa.Value {
+			return b.VisitExpr(stmt.Cond)
+		}, func() {}).SetElse(func() {
+			b.Break()
+		}).Build()
+	})
+	loop.Finish()
+}
+
+func (b *builder) VisitFor(stmt *ast.For) {
+	loop := b.CreateLoopBuilder()
+	if stmt.Init != nil {
+		loop.SetFirst(func() []ssa.Value {
+			b.VisitStatement(stmt.Init)
+			return nil
+		})
+	}
+	loop.SetCondition(func() ssa.Value {
+		// for (;;) 没有条件
+		if stmt.Cond == nil {
+			return b.EmitConstInst(true)
+		}
+		return b.VisitExpr(stmt.Cond)
+	})
+	if stmt.Post != nil {
+		loop.SetThird(func() []ssa.Value {
+			return []ssa.Value{b.VisitExpr(stmt.Post)}
+		})
+	}
+	loop.SetBody(func() {
+		b.VisitStatement(stmt.Body)
+	})
+	loop.Finish()
+}
+
+// switchCase 是 switch 中的一个分支，value 为 nil 时是 default
+type switchCase struct {
+	value ast.Expr
+	body  []ast.Stmt
+}
+
+// collectCases 将 switch 的语句体按 case 标签切分为分支，
+// 连续的 case 标签中前面的分支体为空，执行时会贯穿到下一个分支
+func collectCases(body ast.Stmt) []*switchCase {
+	var cases []*switchCase
+	var add func(stmt ast.Stmt)
+	add = func(stmt ast.Stmt) {
+		c, ok := stmt.(*ast.Case)
+		if !ok {
+			// 第一个 case 之前的语句不会被执行
+			if len(cases) > 0 && stmt != nil {
+				last := cases[len(cases)-1]
+				last.body = append(last.body, stmt)
+			}
+			return
+		}
+		cases = append(cases, &switchCase{value: c.Value})
+		add(c.Body)
+	}
+	if compound, ok := body.(*ast.Compound); ok {
+		for _, stmt := range compound.Items {
+			add(stmt)
+		}
+	} else {
+		add(body)
+	}
+	return cases
+}
+
+func (b *builder) VisitSwitch(stmt *ast.Switch) {
+	var cases []*switchCase
+	var defaultCase *switchCase
+	for _, c := range collectCases(stmt.Body) {
+		if c.value == nil {
+			defaultCase = c
+			continue
+		}
+		cases = append(cases, c)
+	}
+
+	switchBuilder := b.BuildSwitch()
+	switchBuilder.AutoBreak = false
+	switchBuilder.BuildCondition(func() ssa.Value {
+		return b.VisitExpr(stmt.Cond)
+	})
+	switchBuilder.BuildCaseSize(len(cases))
+	switchBuilder.SetCase(func(i int) []ssa.Value {
+		return []ssa.Value{b.VisitExpr(cases[i].value)}
+	})
+	switchBuilder.BuildBody(func(i int) {
+		b.VisitStatements(cases[i].body)
+	})
+	if defaultCase != nil {
+		switchBuilder.BuildDefault(func() {
+			b.VisitStatements(defaultCase.body)
+		})
+	}
+	switchBuilder.Finish()
+}
+
+func (b *builder) handlerGoto(labelName string) {
+	gotoBuilder := b.BuildGoto(labelName)
+	if targetBlock := b.GetLabel(labelName); targetBlock != nil {
+		// 目标标签已经构建，直接跳转
+		labelBuilder := b.labels[labelName]
+		gotoBuilder.SetLabel(targetBlock)
+		labelBuilder.SetGotoFinish(gotoBuilder.Finish())
+		return
+	}
+	// 目标标签在之后定义，构建标签时再设置跳转目标
+	labelBuilder := b.labels[labelName]
+	if labelBuilder == nil {
+		labelBuilder = b.BuildLabel(labelName)
+		b.labels[labelName] = labelBuilder
+	}
+	labelBuilder.SetGotoHandler(func(target *ssa.BasicBlock) {
+		gotoBuilder.SetLabel(target)
+		labelBuilder.SetGotoFinish(gotoBuilder.Finish())
+	})
+}
+
+func (b *builder) VisitLabeled(stmt *ast.Labeled) {
+	labelBuilder := b.labels[stmt.Label]
+	if labelBuilder == nil {
+		labelBuilder = b.BuildLabel(stmt.Label)
+		b.labels[stmt.Label] = labelBuilder
+	}
+
+	block := labelBuilder.GetBlock()
+	labelBuilder.Build()
+	b.AddLabel(stmt.Label, block)
+	for _, handler := range labelBuilder.GetGotoHandlers() {
+		handler(block)
+	}
+
+	b.EmitJump(block)
+	b.CurrentBlock = block
+	b.VisitStatement(stmt.Body)
+
+	labelBuilder.Finish()
+}
@@ -0,0 +1,102 @@
+package c2ssa
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	*ssa.PreHandlerInit
+}
+
+type builder struct {
+	*ssa.FunctionBuilder
+	unit *ast.TranslationUnit
+
+	// enums 记录枚举常量的值，枚举常量在整个文件中可见
+	enums map[string]int64
+	// structs 记录文件中定义的 struct 和 union，用于按成员名处理初始化列表
+	structs map[string]*ast.StructType
+	// labels 记录当前函数中的 goto 标签
+	labels map[string]*ssa.LabelBuilder
+}
+
+var Builder ssa.Builder = &SSABuilder{}
+
+func (*SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	opts := []parser.Option{}
+	// 单独编译一段代码时没有文件名，也就没有可以引用的项目文件
+	if editor := b.GetEditor(); editor != nil && editor.GetFilename() != "" {
+		// C++ 的类、命名空间和模板无法按 C 的语法解析，除非忽略语法错误，否则跳过 C++ 源文件
+		if filename := editor.GetFilename(); isCPPSourceFile(filename) && !force {
+			if !b.PreHandler() {
+				log.Warnf("skip c++ source file %s, the c frontend only parses c code, ignore syntax error to build it anyway", filename)
+			}
+			return nil
+		}
+		opts = append(opts,
+			parser.WithFilename(editor.GetFilename()),
+			parser.WithIncludeResolver(includeResolver(b.GetProgram())),
+		)
+	}
+	unit, err := Frontend(src, force, opts...)
+	if err != nil {
+		return err
+	}
+	// C 中的函数都定义在文件级别，不需要处理闭包
+	b.SupportClosure = false
+	build := &builder{
+		FunctionBuilder: b,
+		unit:            unit,
+		enums:           make(map[string]int64),
+		structs:         make(map[string]*ast.StructType),
+		labels:          make(map[string]*ssa.LabelBuilder),
+	}
+	build.buildIncludes(unit.Includes)
+	build.VisitTranslationUnit(unit)
+	return nil
+}
+
+// sourceExtensions 是作为编译单元编译的源文件，C++ 源文件只在忽略语法错误时按 C 的语法解析。
+// 头文件（.h、.hpp 等）只通过 #include 展开到引用它的源文件中，不单独编译，避免其中的定义被重复构建
+var sourceExtensions = map[string]bool{
+	".c": true,
+}
+
+var cppSourceExtensions = map[string]bool{
+	".cc":  true,
+	".cpp": true,
+	".cxx": true,
+	".c++": true,
+}
+
+func isCPPSourceFile(path string) bool {
+	return cppSourceExtensions[strings.ToLower(filepath.Ext(path))]
+}
+
+func isSourceFile(path string) bool {
+	return sourceExtensions[strings.ToLower(filepath.Ext(path))] || isCPPSourceFile(path)
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return isSourceFile(path)
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.C
+}
+
+func Frontend(src string, force bool, opts ...parser.Option) (*ast.TranslationUnit, error) {
+	unit, errs := parser.Parse(src, opts...)
+	if force || len(errs) == 0 {
+		return unit, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error: %v", errs[0])
+}
@@ -0,0 +1,115 @@
+package c2ssa
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/yaklang/yaklang/common/log"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	"github.com/yaklang/yaklang/common/yak/c/frontend/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (s *SSABuilder) Create() ssa.Builder {
+	return &SSABuilder{
+		PreHandlerInit: ssa.NewPreHandlerInit(initHandler).WithLanguageConfigOpts(
+			ssa.WithLanguageConfigBind(true),
+			ssa.WithLanguageBuilder(s),
+		),
+	}
+}
+
+// initHandler 创建保存全局变量的容器，所有文件的全局变量都作为它的成员
+func initHandler(fb *ssa.FunctionBuilder) {
+	container := fb.EmitEmptyContainer()
+	fb.GetProgram().GlobalScope = container
+}
+
+func (*SSABuilder) FilterPreHandlerFile(path string) bool {
+	return isSourceFile(path)
+}
+
+func (*SSABuilder) PreHandlerFile(editor *memedit.MemEditor, builder *ssa.FunctionBuilder) {
+	builder.GetProgram().GetApplication().Build("", editor, builder)
+}
+
+func (s *SSABuilder) PreHandlerProject(fileSystem fi.FileSystem, fb *ssa.FunctionBuilder, path string) error {
+	prog := fb.GetProgram()
+	if prog == nil {
+		log.Errorf("program is nil")
+		return nil
+	}
+	file, err := fileSystem.ReadFile(path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", path, err)
+		return nil
+	}
+	prog.Build(path, memedit.NewMemEditor(string(file)), fb)
+	return nil
+}
+
+// includeResolver 在项目的文件系统中查找头文件：
+// "file" 先在当前文件所在的目录中查找，之后和 <file> 一样在项目的引用路径及其中的 include 目录中查找。
+// 项目之外的系统头文件不会被读取
+func includeResolver(prog *ssa.Program) parser.IncludeResolver {
+	if prog == nil || prog.Loader == nil {
+		return nil
+	}
+	fs := prog.Loader.GetFilesysFileSystem()
+	if fs == nil {
+		return nil
+	}
+	return func(name string, system bool, from string) (string, string, bool) {
+		var dirs []string
+		if !system {
+			dir, _ := fs.PathSplit(from)
+			dirs = append(dirs, dir)
+		}
+		for _, dir := range prog.Loader.GetIncludeFiles() {
+			dirs = append(dirs, dir, fs.Join(dir, "include"))
+		}
+		for _, dir := range lo.Uniq(dirs) {
+			path := fs.Join(dir, name)
+			if info, err := fs.Stat(path); err != nil || info.IsDir() {
+				continue
+			}
+			raw, err := fs.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			return path, string(raw), true
+		}
+		return "", "", false
+	}
+}
+
+// buildIncludes 构建文件引用的项目头文件。头文件不作为单独的文件编译，只在第一次被引用时构建一次，
+// 其中定义的函数和全局变量对整个项目可见
+func (b *builder) buildIncludes(paths []string) {
+	if len(paths) == 0 || b.PreHandler() {
+		return
+	}
+	prog := b.GetProgram()
+	app := prog.GetApplication()
+	if app == nil || app.Build == nil || prog.Loader == nil {
+		return
+	}
+	fs := prog.Loader.GetFilesysFileSystem()
+	if fs == nil {
+		return
+	}
+	for _, path := range paths {
+		// 正在构建以及已经构建过的文件都会记录在 editor 中
+		if _, ok := app.GetEditor(path); ok {
+			continue
+		}
+		raw, err := fs.ReadFile(path)
+		if err != nil {
+			log.Warnf("read header %s error: %v", path, err)
+			continue
+		}
+		if err := app.Build(path, memedit.NewMemEditor(string(raw)), b.FunctionBuilder); err != nil {
+			log.Warnf("build header %s error: %v", path, err)
+		}
+	}
+}
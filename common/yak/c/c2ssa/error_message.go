@@ -0,0 +1,41 @@
+package c2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "C"
+
+func UnhandledStatement(stmt any) string {
+	return fmt.Sprintf("unhandled statement: %T", stmt)
+}
+
+func UnhandledExpression(expr any) string {
+	return fmt.Sprintf("unhandled expression: %T", expr)
+}
+
+func UnexpectedBinaryOP(op string) string {
+	return fmt.Sprintf("unexpected binary operator: %s", op)
+}
+
+func UnexpectedUnaryOP(op string) string {
+	return fmt.Sprintf("unexpected unary operator: %s", op)
+}
+
+func InvalidAssignTarget(target any) string {
+	return fmt.Sprintf("invalid assignment target: %T", target)
+}
+
+func InvalidLiteral(literal string) string {
+	return fmt.Sprintf("invalid literal: %s", literal)
+}
+
+func UnexpectedBreakStmt() string {
+	return "break statement not within loop or switch"
+}
+
+func UnexpectedContinueStmt() string {
+	return "continue statement not within a loop"
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestBasic_Assign(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int a = 1;
+	int b = a + 2;
+	b += 3;
+	println(b);
+}
+`, []string{"6"}, t)
+}
+
+func TestBasic_If(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int a = 1;
+	if (cond) {
+		a = 2;
+	} else if (other)
+		a = 3;
+	println(a);
+}
+`, []string{"phi(a)[2,phi(a)[3,1]]"}, t)
+}
+
+func TestBasic_Loop(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int sum = 0;
+	for (int i = 0; i < 10; i++) {
+		sum += i;
+	}
+	while (sum > 100) sum--;
+	do { sum = sum * 2; } while (sum < 10);
+	println(sum);
+}
+`, []string{"phi(sum)[phi(sum)[phi(sum)[0,add(sum, phi(i)[0,add(i, 1)])],sub(sum, 1)],mul(sum, 2)]"}, t)
+}
+
+func TestBasic_Switch(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int a = 0;
+	switch (c) {
+	case 1:
+	case 2:
+		a = 1;
+		break;
+	case 3:
+		a = 2;
+	default:
+		a = 3;
+	}
+	println(a);
+}
+`, []string{"phi(a)[1,3]"}, t)
+}
+
+func TestBasic_GlobalAndEnum(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+enum color { RED, GREEN = 5, BLUE };
+int counter = 10;
+static char *name;
+
+int add(int x) {
+	counter = counter + x;
+	return counter;
+}
+
+void main() {
+	println(BLUE);
+	println(counter);
+	println(add(1));
+	println(name);
+}
+`, []string{"6", "10", "Function-add(1)", "0"}, t)
+}
+
+func TestBasic_Expression(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int x = 1, y = 2;
+	int a = x > 0 ? x : -x;
+	unsigned long n = sizeof(int) + sizeof(char *);
+	int c = (a++, a);
+	println(c);
+	println(n);
+	println((int) 'A');
+}
+`, []string{"add(phi(a)[1,-1], 1)", "12", "65"}, t)
+}
+
+func TestBasic_Goto(t *testing.T) {
+	ssatest.CheckSyntaxFlow(t, `
+int handle(int fd) {
+	char buf[64];
+	if (recv(fd, buf, sizeof(buf), 0) < 0)
+		goto out;
+	process(buf);
+out:
+	close(fd);
+	return 0;
+}
+`, `close(* as $fd)`, map[string][]string{
+		"fd": {"Parameter-fd"},
+	}, ssaapi.WithLanguage(ssaapi.C))
+}
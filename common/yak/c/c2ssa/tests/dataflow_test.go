@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestDataflow_RecvToStrcpy(t *testing.T) {
+	code := `
+#include <string.h>
+#include <sys/socket.h>
+
+struct session { int fd; char name[32]; };
+
+static char *read_line(int fd) {
+	static char line[256];
+	int n = recv(fd, line, sizeof(line) - 1, 0);
+	if (n <= 0)
+		return NULL;
+	line[n] = 0;
+	return line;
+}
+
+void handle(struct session *s) {
+	char buf[128];
+	char *p = read_line(s->fd);
+	strcpy(buf, p);
+	strcpy(s->name, "guest");
+}
+`
+	ssatest.CheckSyntaxFlowSource(t, code, `
+recv(*<slice(index=1)> as $buf);
+strcpy(*<slice(index=1)> #-> as $src);
+$src & $buf as $sink;
+`, map[string][]string{
+		"sink": {"line[256]"},
+	}, ssaapi.WithLanguage(ssaapi.C))
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestInclude_MacroAndHeader(t *testing.T) {
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("include/config.h", `
+#ifndef CONFIG_H
+#define CONFIG_H
+#define MAX_NAME 32
+#define COPY(dst, src) strcpy(dst, src)
+typedef struct session { int fd; char name[MAX_NAME]; } session_t;
+#endif
+`)
+	vf.AddFile("src/net.h", `
+#include <config.h>
+int read_packet(int fd, char *buf, int size);
+`)
+	vf.AddFile("src/net.c", `
+#include "net.h"
+int read_packet(int fd, char *buf, int size) {
+	return recv(fd, buf, size, 0);
+}
+`)
+	vf.AddFile("src/main.c", `
+#include "net.h"
+#include <string.h>
+
+void handle(session_t *s) {
+	char buf[MAX_NAME * 4];
+	read_packet(s->fd, buf, sizeof(buf));
+	COPY(s->name, buf);
+	println(MAX_NAME);
+}
+`)
+
+	ssatest.CheckSyntaxFlowWithFS(t, vf, `
+strcpy(* as $args);
+recv(*<slice(index=1)> as $buf);
+println(* as $size);
+`, map[string][]string{
+		"args": {"make(any)", "ParameterMember-parameter[0].name"},
+		"buf":  {"Parameter-buf"},
+		"size": {"32"},
+	}, true, ssaapi.WithLanguage(ssaapi.C))
+}
+
+func TestInclude_HeaderOnlyBuiltThroughInclude(t *testing.T) {
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("src/util.hpp", `
+#pragma once
+int counter = source(0);
+static int helper(int x) {
+	return counter + x;
+}
+`)
+	vf.AddFile("src/main.c", `
+#include "util.hpp"
+int main() {
+	return helper(1);
+}
+`)
+	vf.AddFile("src/worker.c", `
+#include "util.hpp"
+int work() {
+	return helper(2);
+}
+`)
+	// 没有被引用的头文件不会编译
+	vf.AddFile("src/unused.h", `
+int unused = source(9);
+`)
+	ssatest.CheckResult(t, vf, `source(* as $arg)`, func(res *ssaapi.SyntaxFlowResult) {
+		// util.hpp 不会单独编译，被多个文件引用时也只构建一次
+		args := res.GetValues("arg")
+		require.Len(t, args, 1)
+		require.Equal(t, "0", args[0].String())
+	}, ssaapi.WithLanguage(ssaapi.C))
+}
+
+func TestInclude_SkipCPPSource(t *testing.T) {
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("src/main.c", `
+int main() {
+	return source(1);
+}
+`)
+	vf.AddFile("src/server.cpp", `
+namespace net {
+template <typename T>
+class Server {
+public:
+	T handle() { return source(2); }
+};
+}
+int run() {
+	return source(3);
+}
+`)
+	// C++ 源文件默认跳过，不会因为语法错误导致编译失败
+	ssatest.CheckResult(t, vf, `source(* as $arg)`, func(res *ssaapi.SyntaxFlowResult) {
+		args := res.GetValues("arg")
+		require.Len(t, args, 1)
+		require.Equal(t, "1", args[0].String())
+	}, ssaapi.WithLanguage(ssaapi.C))
+
+	// 忽略语法错误时按 C 的语法尽量解析 C++ 源文件
+	ssatest.CheckResult(t, vf, `source(* as $arg)`, func(res *ssaapi.SyntaxFlowResult) {
+		args := res.GetValues("arg")
+		require.Contains(t, lo.Map(args, func(v *ssaapi.Value, _ int) string { return v.String() }), "3")
+	}, ssaapi.WithLanguage(ssaapi.C), ssaapi.WithIgnoreSyntaxError(true))
+}
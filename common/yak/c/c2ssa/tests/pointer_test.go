@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestPointer_Struct(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+struct point { int x; int y; };
+typedef struct point point_t;
+
+void main() {
+	point_t p = { 1, .y = 2 };
+	struct point *pp = &p;
+	pp->x = 3;
+	println(p.x);
+	println(pp->y);
+	println(p.y);
+}
+`, []string{"3", "2", "2"}, t)
+}
+
+func TestPointer_Scalar(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int a = 1;
+	int *p = &a;
+	*p = 2;
+	println(*p);
+	println(a);
+}
+`, []string{"2", "2"}, t)
+}
+
+func TestPointer_Array(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+void main() {
+	int arr[4] = {1, 2, 3};
+	arr[3] = 4;
+	int *p = arr;
+	println(arr[3]);
+	println(p[0]);
+	println(*p);
+}
+`, []string{"4", "1", "1"}, t)
+}
@@ -0,0 +1,11 @@
+package tests
+
+import (
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/yak/c/c2ssa"
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func init() {
+	test.SetLanguage(consts.C, c2ssa.Builder)
+}
@@ -0,0 +1,424 @@
+// Package ast 定义 C 源码（预处理之后）的语法树
+package ast
+
+// Position 是源码中的位置，行和列都从 0 开始，列按字符（rune）计数
+type Position struct {
+	Line int
+	Col  int
+}
+
+// Node 是所有语法树节点的公共接口
+type Node interface {
+	Start() Position
+	End() Position
+}
+
+// Loc 记录节点的起止位置，嵌入到每个节点中
+type Loc struct {
+	StartPos Position
+	EndPos   Position
+}
+
+func (l *Loc) Start() Position { return l.StartPos }
+func (l *Loc) End() Position   { return l.EndPos }
+
+// SetLoc 设置节点的起止位置
+func (l *Loc) SetLoc(start, end Position) {
+	l.StartPos = start
+	l.EndPos = end
+}
+
+// Stmt 是语句节点，文件级别的声明和函数定义也是语句
+type Stmt interface {
+	Node
+	stmtNode()
+}
+
+// Expr 是表达式节点
+type Expr interface {
+	Node
+	exprNode()
+}
+
+// Type 是类型，类型不记录位置
+type Type interface {
+	typeNode()
+}
+
+// TranslationUnit 是一个预处理之后的 C 源文件
+type TranslationUnit struct {
+	Loc
+	Decls []Stmt
+	// Typedefs 记录文件（包括其引用的头文件）中定义的类型别名
+	Typedefs map[string]Type
+	// Includes 记录文件引用的项目头文件路径，被嵌套引用的头文件在前
+	Includes []string
+}
+
+// ===================== types =====================
+
+type (
+	// BasicType 是内置类型，Name 是规范化后的类型名，例如 "unsigned long"
+	BasicType struct {
+		Name string
+	}
+
+	// NamedType 是通过 typedef 定义的类型名
+	NamedType struct {
+		Name string
+	}
+
+	// StructType 是 struct 或 union，Fields 为 nil 表示只引用了类型而没有定义
+	StructType struct {
+		Union  bool
+		Tag    string
+		Fields []*Field
+	}
+
+	// EnumType 是枚举，Items 为 nil 表示只引用了类型而没有定义
+	EnumType struct {
+		Tag   string
+		Items []*Enumerator
+	}
+
+	PointerType struct {
+		Elem Type
+	}
+
+	// ArrayType 的 Len 为 nil 表示未指定长度
+	ArrayType struct {
+		Elem Type
+		Len  Expr
+	}
+
+	FuncType struct {
+		Result   Type
+		Params   []*Param
+		Variadic bool
+	}
+
+	Field struct {
+		Loc
+		Name     string
+		Type     Type
+		BitWidth Expr
+	}
+
+	Enumerator struct {
+		Loc
+		Name  string
+		Value Expr
+	}
+
+	Param struct {
+		Loc
+		Name string
+		Type Type
+	}
+)
+
+func (*BasicType) typeNode()   {}
+func (*NamedType) typeNode()   {}
+func (*StructType) typeNode()  {}
+func (*EnumType) typeNode()    {}
+func (*PointerType) typeNode() {}
+func (*ArrayType) typeNode()   {}
+func (*FuncType) typeNode()    {}
+
+// ===================== declarations =====================
+
+type (
+	// FuncDef 是函数定义
+	FuncDef struct {
+		Loc
+		Name    string
+		NameLoc Loc
+		Storage string
+		Type    *FuncType
+		Body    *Compound
+	}
+
+	// DeclStmt 是一条声明语句，例如 int a = 1, *b;
+	// 只定义类型而没有声明变量时（例如 struct a {...};）Decls 为空
+	DeclStmt struct {
+		Loc
+		// Storage 是 typedef、extern、static 等存储类别说明符
+		Storage string
+		Base    Type
+		Decls   []*Declarator
+	}
+
+	// Declarator 是声明中的单个变量，Type 是应用了指针、数组等修饰后的完整类型
+	Declarator struct {
+		Loc
+		Name    string
+		NameLoc Loc
+		Type    Type
+		Init    Expr
+	}
+)
+
+// ===================== statements =====================
+
+type (
+	Compound struct {
+		Loc
+		Items []Stmt
+	}
+
+	ExprStmt struct {
+		Loc
+		X Expr
+	}
+
+	If struct {
+		Loc
+		Cond Expr
+		Then Stmt
+		Else Stmt
+	}
+
+	While struct {
+		Loc
+		Cond Expr
+		Body Stmt
+	}
+
+	DoWhile struct {
+		Loc
+		Body Stmt
+		Cond Expr
+	}
+
+	// For 的 Init 是 *DeclStmt 或 *ExprStmt，可以为 nil
+	For struct {
+		Loc
+		Init Stmt
+		Cond Expr
+		Post Expr
+		Body Stmt
+	}
+
+	Switch struct {
+		Loc
+		Cond Expr
+		Body Stmt
+	}
+
+	// Case 是 case Value: Body，Value 为 nil 时表示 default
+	Case struct {
+		Loc
+		Value Expr
+		Body  Stmt
+	}
+
+	Labeled struct {
+		Loc
+		Label string
+		Body  Stmt
+	}
+
+	Goto struct {
+		Loc
+		Label string
+	}
+
+	Break struct {
+		Loc
+	}
+
+	Continue struct {
+		Loc
+	}
+
+	Return struct {
+		Loc
+		Value Expr
+	}
+
+	Empty struct {
+		Loc
+	}
+)
+
+func (*FuncDef) stmtNode()  {}
+func (*DeclStmt) stmtNode() {}
+func (*Compound) stmtNode() {}
+func (*ExprStmt) stmtNode() {}
+func (*If) stmtNode()       {}
+func (*While) stmtNode()    {}
+func (*DoWhile) stmtNode()  {}
+func (*For) stmtNode()      {}
+func (*Switch) stmtNode()   {}
+func (*Case) stmtNode()     {}
+func (*Labeled) stmtNode()  {}
+func (*Goto) stmtNode()     {}
+func (*Break) stmtNode()    {}
+func (*Continue) stmtNode() {}
+func (*Return) stmtNode()   {}
+func (*Empty) stmtNode()    {}
+
+// ===================== expressions =====================
+
+type (
+	Ident struct {
+		Loc
+		Name string
+	}
+
+	// IntLit 的 Value 保留源码中的写法，包括前缀和后缀
+	IntLit struct {
+		Loc
+		Value string
+	}
+
+	FloatLit struct {
+		Loc
+		Value string
+	}
+
+	// CharLit 的 Value 是字符的编码
+	CharLit struct {
+		Loc
+		Value int64
+	}
+
+	// StringLit 的 Value 是拼接相邻字面量并解码转义之后的内容
+	StringLit struct {
+		Loc
+		Value string
+	}
+
+	Binary struct {
+		Loc
+		Op string
+		X  Expr
+		Y  Expr
+	}
+
+	// Assign 的 Op 是 "=" 或 "+=" 等复合赋值运算符
+	Assign struct {
+		Loc
+		Op  string
+		Lhs Expr
+		Rhs Expr
+	}
+
+	// Unary 是前缀运算：- + ! ~ * & ++ --
+	Unary struct {
+		Loc
+		Op string
+		X  Expr
+	}
+
+	// Postfix 是后缀 ++ 和 --
+	Postfix struct {
+		Loc
+		Op string
+		X  Expr
+	}
+
+	Cond struct {
+		Loc
+		Cond Expr
+		Then Expr
+		Else Expr
+	}
+
+	Call struct {
+		Loc
+		Func Expr
+		Args []Expr
+	}
+
+	Index struct {
+		Loc
+		X     Expr
+		Index Expr
+	}
+
+	// Member 是 X.Name 或 X->Name
+	Member struct {
+		Loc
+		X     Expr
+		Name  string
+		Arrow bool
+	}
+
+	Cast struct {
+		Loc
+		Type Type
+		X    Expr
+	}
+
+	// Sizeof 是 sizeof X 或 sizeof(Type)，二者只有一个不为 nil
+	Sizeof struct {
+		Loc
+		X    Expr
+		Type Type
+	}
+
+	// TypeExpr 是出现在参数位置的类型名，例如 va_arg(ap, int)
+	TypeExpr struct {
+		Loc
+		Type Type
+	}
+
+	Comma struct {
+		Loc
+		List []Expr
+	}
+
+	// InitList 是初始化列表 { .a = 1, [2] = 3, 4 }
+	InitList struct {
+		Loc
+		Elems []*InitElem
+	}
+
+	// CompoundLit 是复合字面量 (Type){ ... }
+	CompoundLit struct {
+		Loc
+		Type Type
+		Init *InitList
+	}
+
+	// StmtExpr 是 GNU 语句表达式 ({ ... })，值是最后一条表达式语句的值
+	StmtExpr struct {
+		Loc
+		Body *Compound
+	}
+)
+
+// InitElem 是初始化列表中的一项，Designators 是 .field 或 [index] 形式的指示符
+type InitElem struct {
+	Loc
+	Designators []*Designator
+	Value       Expr
+}
+
+// Designator 是 .Field 或 [Index]
+type Designator struct {
+	Field string
+	Index Expr
+}
+
+func (*Ident) exprNode()       {}
+func (*IntLit) exprNode()      {}
+func (*FloatLit) exprNode()    {}
+func (*CharLit) exprNode()     {}
+func (*StringLit) exprNode()   {}
+func (*Binary) exprNode()      {}
+func (*Assign) exprNode()      {}
+func (*Unary) exprNode()       {}
+func (*Postfix) exprNode()     {}
+func (*Cond) exprNode()        {}
+func (*Call) exprNode()        {}
+func (*Index) exprNode()       {}
+func (*Member) exprNode()      {}
+func (*Cast) exprNode()        {}
+func (*Sizeof) exprNode()      {}
+func (*TypeExpr) exprNode()    {}
+func (*Comma) exprNode()       {}
+func (*InitList) exprNode()    {}
+func (*CompoundLit) exprNode() {}
+func (*StmtExpr) exprNode()    {}
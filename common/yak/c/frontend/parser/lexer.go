@@ -0,0 +1,334 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+type TokenKind int
+
+const (
+	TokenEOF TokenKind = iota
+	TokenIdent
+	TokenNumber
+	TokenChar
+	TokenString
+	TokenPunct
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenEOF:
+		return "EOF"
+	case TokenIdent:
+		return "IDENT"
+	case TokenNumber:
+		return "NUMBER"
+	case TokenChar:
+		return "CHAR"
+	case TokenString:
+		return "STRING"
+	case TokenPunct:
+		return "PUNCT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Token 是预处理 Token，字符和字符串 Token 的 Value 保留前缀和引号
+type Token struct {
+	Kind  TokenKind
+	Value string
+	Start ast.Position
+	End   ast.Position
+	// LineStart 表示该 Token 是逻辑行中的第一个 Token，用于识别预处理指令
+	LineStart bool
+	// Space 表示该 Token 之前有空白，用于区分函数式宏定义以及字符串化
+	Space bool
+
+	// hide 是宏展开时的 hide set，避免宏递归展开
+	hide []string
+}
+
+func (t Token) String() string {
+	if t.Kind == TokenEOF {
+		return t.Kind.String()
+	}
+	return fmt.Sprintf("%s(%q)", t.Kind, t.Value)
+}
+
+func (t Token) is(value string) bool {
+	return (t.Kind == TokenPunct || t.Kind == TokenIdent) && t.Value == value
+}
+
+func (t Token) hidden(name string) bool {
+	for _, h := range t.hide {
+		if h == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SyntaxError 是带位置的预处理、词法或语法错误
+type SyntaxError struct {
+	Pos     ast.Position
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d:%d %s", e.Pos.Line+1, e.Pos.Col, e.Message)
+}
+
+// 按长度降序排列，保证最长匹配
+var punctuators = []string{
+	"...", "<<=", ">>=",
+	"->", "++", "--", "<<", ">>", "<=", ">=", "==", "!=", "&&", "||",
+	"*=", "/=", "%=", "+=", "-=", "&=", "^=", "|=", "##",
+	"[", "]", "(", ")", "{", "}", ".", "&", "*", "+", "-", "~", "!",
+	"/", "%", "<", ">", "^", "|", "?", ":", ";", "=", ",", "#",
+}
+
+type lexer struct {
+	src  []rune
+	pos  int
+	line int
+	col  int
+
+	lineStart bool
+	space     bool
+
+	tokens []Token
+	errors []*SyntaxError
+}
+
+// Tokenize 将源码切分为预处理 Token 序列，序列总是以 EOF 结尾
+func Tokenize(src string) ([]Token, []*SyntaxError) {
+	l := &lexer{src: []rune(src), lineStart: true}
+	l.run()
+	return l.tokens, l.errors
+}
+
+func (l *lexer) position() ast.Position {
+	return ast.Position{Line: l.line, Col: l.col}
+}
+
+func (l *lexer) peek(offset int) rune {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+	return 0
+}
+
+func (l *lexer) advance() rune {
+	r := l.src[l.pos]
+	l.pos++
+	if r == '\n' {
+		l.line++
+		l.col = 0
+	} else {
+		l.col++
+	}
+	return r
+}
+
+// skipContinuation 跳过续行符 \ 换行，返回是否跳过
+func (l *lexer) skipContinuation() bool {
+	if l.peek(0) != '\\' {
+		return false
+	}
+	switch {
+	case l.peek(1) == '\n':
+		l.advance()
+		l.advance()
+		return true
+	case l.peek(1) == '\r' && l.peek(2) == '\n':
+		l.advance()
+		l.advance()
+		l.advance()
+		return true
+	}
+	return false
+}
+
+func (l *lexer) errorf(pos ast.Position, format string, args ...any) {
+	l.errors = append(l.errors, &SyntaxError{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *lexer) emit(kind TokenKind, value string, start ast.Position) {
+	l.tokens = append(l.tokens, Token{
+		Kind:      kind,
+		Value:     value,
+		Start:     start,
+		End:       l.position(),
+		LineStart: l.lineStart,
+		Space:     l.space,
+	})
+	l.lineStart = false
+	l.space = false
+}
+
+func (l *lexer) run() {
+	for l.pos < len(l.src) {
+		l.next()
+	}
+	l.lineStart = true
+	l.emit(TokenEOF, "", l.position())
+}
+
+func (l *lexer) next() {
+	if l.skipContinuation() {
+		return
+	}
+	r := l.peek(0)
+	start := l.position()
+	switch {
+	case r == '\n':
+		l.advance()
+		l.lineStart = true
+		l.space = true
+	case r == ' ' || r == '\t' || r == '\r' || r == '\f' || r == '\v':
+		l.advance()
+		l.space = true
+	case r == '/' && l.peek(1) == '/':
+		for l.pos < len(l.src) && l.peek(0) != '\n' {
+			if !l.skipContinuation() {
+				l.advance()
+			}
+		}
+		l.space = true
+	case r == '/' && l.peek(1) == '*':
+		l.advance()
+		l.advance()
+		for {
+			if l.pos >= len(l.src) {
+				l.errorf(start, "unterminated comment")
+				break
+			}
+			if l.peek(0) == '*' && l.peek(1) == '/' {
+				l.advance()
+				l.advance()
+				break
+			}
+			l.advance()
+		}
+		l.space = true
+	case r == '"' || r == '\'':
+		l.lexQuoted(start, "")
+	case isIdentStart(r):
+		l.lexIdent(start)
+	case isDigit(r) || (r == '.' && isDigit(l.peek(1))):
+		l.lexNumber(start)
+	default:
+		for _, punct := range punctuators {
+			if l.hasPrefix(punct) {
+				for range punct {
+					l.advance()
+				}
+				l.emit(TokenPunct, punct, start)
+				return
+			}
+		}
+		// 无法识别的字符（例如 @、`）交给解析器报告错误，预处理时被跳过的分支中可能出现任何字符
+		l.advance()
+		l.emit(TokenPunct, string(r), start)
+	}
+}
+
+func (l *lexer) hasPrefix(s string) bool {
+	for i, r := range s {
+		if l.peek(i) != r {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *lexer) lexIdent(start ast.Position) {
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		if l.skipContinuation() {
+			continue
+		}
+		if r := l.peek(0); isIdentStart(r) || isDigit(r) {
+			sb.WriteRune(l.advance())
+			continue
+		}
+		break
+	}
+	name := sb.String()
+	switch name {
+	case "L", "u", "U", "u8":
+		if q := l.peek(0); q == '"' || q == '\'' {
+			l.lexQuoted(start, name)
+			return
+		}
+	}
+	l.emit(TokenIdent, name, start)
+}
+
+// lexNumber 按 pp-number 的规则切分数字，具体的合法性由使用者检查
+func (l *lexer) lexNumber(start ast.Position) {
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		if l.skipContinuation() {
+			continue
+		}
+		r := l.peek(0)
+		if (r == '+' || r == '-') && sb.Len() > 0 {
+			last := sb.String()[sb.Len()-1]
+			if last == 'e' || last == 'E' || last == 'p' || last == 'P' {
+				sb.WriteRune(l.advance())
+				continue
+			}
+			break
+		}
+		if isDigit(r) || isIdentStart(r) || r == '.' || r == '\'' && isDigit(l.peek(1)) {
+			sb.WriteRune(l.advance())
+			continue
+		}
+		break
+	}
+	l.emit(TokenNumber, sb.String(), start)
+}
+
+func (l *lexer) lexQuoted(start ast.Position, prefix string) {
+	quote := l.advance()
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteRune(quote)
+	for {
+		if l.pos >= len(l.src) || l.peek(0) == '\n' {
+			l.errorf(start, "unterminated literal")
+			break
+		}
+		if l.skipContinuation() {
+			continue
+		}
+		r := l.advance()
+		sb.WriteRune(r)
+		if r == '\\' && l.pos < len(l.src) && l.peek(0) != '\n' {
+			sb.WriteRune(l.advance())
+			continue
+		}
+		if r == quote {
+			break
+		}
+	}
+	if quote == '"' {
+		l.emit(TokenString, sb.String(), start)
+	} else {
+		l.emit(TokenChar, sb.String(), start)
+	}
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || (r >= 0x80 && unicode.IsLetter(r))
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
@@ -0,0 +1,246 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+func isFloatLiteral(s string) bool {
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "0x") {
+		return strings.ContainsAny(lower, ".p")
+	}
+	return strings.ContainsAny(lower, ".e")
+}
+
+// ParseIntLiteral 解析整数字面量，支持十六进制、八进制、二进制前缀以及 u、l 等后缀
+func ParseIntLiteral(s string) (int64, bool) {
+	s = strings.ReplaceAll(s, "'", "")
+	s = strings.TrimRight(s, "uUlLzZ")
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(v), true
+}
+
+// ParseFloatLiteral 解析浮点数字面量，忽略 f、l 后缀
+func ParseFloatLiteral(s string) (float64, bool) {
+	lower := strings.ToLower(s)
+	if !strings.HasPrefix(lower, "0x") {
+		s = strings.TrimRight(s, "fFlL")
+	} else {
+		s = strings.TrimRight(s, "lL")
+		if strings.HasSuffix(strings.ToLower(s), "f") && strings.Contains(strings.ToLower(s), "p") {
+			s = s[:len(s)-1]
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// splitQuoted 去掉字面量的前缀（L、u、U、u8）和引号
+func splitQuoted(s string, quote byte) string {
+	if i := strings.IndexByte(s, quote); i >= 0 {
+		s = s[i+1:]
+	}
+	return strings.TrimSuffix(s, string(quote))
+}
+
+func decodeChar(s string) int64 {
+	body := decodeEscapes(splitQuoted(s, '\''))
+	if body == "" {
+		return 0
+	}
+	if r, size := utf8.DecodeRuneInString(body); r != utf8.RuneError || size > 1 {
+		return int64(r)
+	}
+	return int64(body[0])
+}
+
+func decodeString(s string) string {
+	return decodeEscapes(splitQuoted(s, '"'))
+}
+
+func decodeEscapes(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch c = s[i]; c {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'a':
+			sb.WriteByte('\a')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'v':
+			sb.WriteByte('\v')
+		case 'e', 'E':
+			sb.WriteByte(0x1b)
+		case 'x':
+			j := i + 1
+			for j < len(s) && strings.IndexByte("0123456789abcdefABCDEF", s[j]) >= 0 {
+				j++
+			}
+			v, _ := strconv.ParseUint(s[i+1:j], 16, 64)
+			sb.WriteByte(byte(v))
+			i = j - 1
+		case 'u', 'U':
+			size := 4
+			if c == 'U' {
+				size = 8
+			}
+			if i+size < len(s) {
+				if v, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32); err == nil {
+					sb.WriteRune(rune(v))
+					i += size
+					continue
+				}
+			}
+			sb.WriteByte(c)
+		default:
+			if '0' <= c && c <= '7' {
+				j := i
+				for j < len(s) && j < i+3 && '0' <= s[j] && s[j] <= '7' {
+					j++
+				}
+				v, _ := strconv.ParseUint(s[i:j], 8, 64)
+				sb.WriteByte(byte(v))
+				i = j - 1
+				continue
+			}
+			// \\ \' \" \? 以及未知的转义
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// EvalInt 计算整数常量表达式，用于 #if 以及枚举的值，表达式中不能有变量
+func EvalInt(expr ast.Expr) (int64, bool) {
+	switch e := expr.(type) {
+	case *ast.IntLit:
+		return ParseIntLiteral(e.Value)
+	case *ast.CharLit:
+		return e.Value, true
+	case *ast.Cast:
+		return EvalInt(e.X)
+	case *ast.Unary:
+		x, ok := EvalInt(e.X)
+		if !ok {
+			return 0, false
+		}
+		switch e.Op {
+		case "-":
+			return -x, true
+		case "+":
+			return x, true
+		case "!":
+			return boolInt(x == 0), true
+		case "~":
+			return ^x, true
+		}
+	case *ast.Cond:
+		c, ok := EvalInt(e.Cond)
+		if !ok {
+			return 0, false
+		}
+		if c != 0 {
+			return EvalInt(e.Then)
+		}
+		return EvalInt(e.Else)
+	case *ast.Comma:
+		return EvalInt(e.List[len(e.List)-1])
+	case *ast.Binary:
+		x, ok := EvalInt(e.X)
+		if !ok {
+			return 0, false
+		}
+		// 短路求值，#if defined(X) && X > 1 中 X 未定义时右侧可能无法计算
+		switch e.Op {
+		case "&&":
+			if x == 0 {
+				return 0, true
+			}
+		case "||":
+			if x != 0 {
+				return 1, true
+			}
+		}
+		y, ok := EvalInt(e.Y)
+		if !ok {
+			return 0, false
+		}
+		switch e.Op {
+		case "&&", "||":
+			return boolInt(y != 0), true
+		case "+":
+			return x + y, true
+		case "-":
+			return x - y, true
+		case "*":
+			return x * y, true
+		case "/", "%":
+			if y == 0 {
+				return 0, false
+			}
+			if e.Op == "/" {
+				return x / y, true
+			}
+			return x % y, true
+		case "<<":
+			return x << uint64(y), true
+		case ">>":
+			return x >> uint64(y), true
+		case "&":
+			return x & y, true
+		case "|":
+			return x | y, true
+		case "^":
+			return x ^ y, true
+		case "==":
+			return boolInt(x == y), true
+		case "!=":
+			return boolInt(x != y), true
+		case "<":
+			return boolInt(x < y), true
+		case ">":
+			return boolInt(x > y), true
+		case "<=":
+			return boolInt(x <= y), true
+		case ">=":
+			return boolInt(x >= y), true
+		}
+	}
+	return 0, false
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,1043 @@
+// Package parser 是 C 语言（C99/C11 以及常见的 GNU 扩展）的手写递归下降解析器。
+// 解析之前会进行简化的预处理，生成 frontend/ast 中定义的语法树
+package parser
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+var keywords = map[string]struct{}{
+	"auto": {}, "break": {}, "case": {}, "char": {}, "const": {}, "continue": {}, "default": {},
+	"do": {}, "double": {}, "else": {}, "enum": {}, "extern": {}, "float": {}, "for": {}, "goto": {},
+	"if": {}, "inline": {}, "int": {}, "long": {}, "register": {}, "restrict": {}, "return": {},
+	"short": {}, "signed": {}, "sizeof": {}, "static": {}, "struct": {}, "switch": {}, "typedef": {},
+	"union": {}, "unsigned": {}, "void": {}, "volatile": {}, "while": {}, "_Alignas": {},
+	"_Alignof": {}, "_Atomic": {}, "_Bool": {}, "_Complex": {}, "_Generic": {}, "_Imaginary": {},
+	"_Noreturn": {}, "_Static_assert": {}, "_Thread_local": {},
+}
+
+// IsKeyword 判断 name 是否是 C 的保留字
+func IsKeyword(name string) bool {
+	_, ok := keywords[name]
+	return ok
+}
+
+var storageClasses = map[string]struct{}{
+	"typedef": {}, "extern": {}, "static": {}, "auto": {}, "register": {},
+	"_Thread_local": {}, "__thread": {},
+}
+
+// 只修饰类型而不影响分析的说明符
+var qualifiers = map[string]struct{}{
+	"const": {}, "volatile": {}, "restrict": {}, "inline": {}, "_Noreturn": {},
+	"__const": {}, "__const__": {}, "__volatile": {}, "__volatile__": {},
+	"__restrict": {}, "__restrict__": {}, "__inline": {}, "__inline__": {},
+	"__forceinline": {}, "__extension__": {}, "_Complex": {}, "__complex__": {},
+	"__signed": {}, "__signed__": {}, "_Imaginary": {}, "__cdecl": {}, "__stdcall": {},
+	"__fastcall": {}, "__w64": {}, "__ptr64": {}, "__ptr32": {},
+}
+
+var basicTypeWords = map[string]struct{}{
+	"void": {}, "char": {}, "short": {}, "int": {}, "long": {}, "float": {}, "double": {},
+	"signed": {}, "unsigned": {}, "_Bool": {}, "__int128": {}, "__int64": {}, "__int32": {},
+	"__int16": {}, "__int8": {}, "__builtin_va_list": {}, "_Float128": {}, "__float128": {},
+}
+
+// 带括号参数、可以直接跳过的扩展说明符
+var skippedSpecifiers = map[string]struct{}{
+	"__attribute__": {}, "__attribute": {}, "__declspec": {}, "_Alignas": {}, "__asm__": {},
+	"__asm": {}, "asm": {},
+}
+
+// 标准库中常见的类型名。系统头文件通常不在项目中，预先登记这些名字才能正确识别声明和类型转换
+var builtinTypedefs = []string{
+	"size_t", "ssize_t", "ptrdiff_t", "intptr_t", "uintptr_t", "intmax_t", "uintmax_t",
+	"int8_t", "int16_t", "int32_t", "int64_t", "uint8_t", "uint16_t", "uint32_t", "uint64_t",
+	"wchar_t", "wint_t", "bool", "FILE", "DIR", "va_list", "off_t", "off64_t", "pid_t", "uid_t",
+	"gid_t", "mode_t", "time_t", "clock_t", "socklen_t", "sa_family_t", "in_addr_t", "in_port_t",
+	"fd_set", "sig_atomic_t", "sigset_t", "jmp_buf", "pthread_t", "pthread_mutex_t",
+	"pthread_cond_t", "pthread_attr_t", "u_char", "u_short", "u_int", "u_long",
+	"BOOL", "BYTE", "WORD", "DWORD", "HANDLE", "LPSTR", "LPCSTR", "LPVOID", "SOCKET",
+}
+
+// bailout 用于在语法错误时从深层递归中跳出，由语句级别的恢复逻辑捕获
+type bailout struct{}
+
+type parser struct {
+	tokens  []Token
+	pos     int
+	prevEnd ast.Position
+	errors  []*SyntaxError
+
+	typedefs map[string]ast.Type
+}
+
+func newParser(tokens []Token, typedefs map[string]ast.Type) *parser {
+	return &parser{tokens: tokens, typedefs: typedefs}
+}
+
+// Parse 预处理并解析 C 源码，即使存在语法错误也会尽量返回可用的语法树
+func Parse(src string, opts ...Option) (*ast.TranslationUnit, []*SyntaxError) {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	pp := newPreprocessor(cfg)
+	tokens := pp.run(src)
+
+	typedefs := make(map[string]ast.Type)
+	for _, name := range builtinTypedefs {
+		typedefs[name] = &ast.NamedType{Name: name}
+	}
+	// 头文件只用于收集类型名，其中的错误不影响当前文件
+	for _, header := range pp.headers {
+		newParser(header, typedefs).parseTranslationUnit()
+	}
+
+	p := newParser(tokens, typedefs)
+	p.errors = append(p.errors, pp.errors...)
+	unit := p.parseTranslationUnit()
+	unit.Typedefs = typedefs
+	unit.Includes = pp.includes
+	return unit, p.errors
+}
+
+// ParseExpression 解析单个表达式，不进行预处理
+func ParseExpression(src string) (ast.Expr, []*SyntaxError) {
+	tokens, errs := Tokenize(src)
+	expr, parseErrs := parseTokens(tokens)
+	return expr, append(errs, parseErrs...)
+}
+
+// parseTokens 将以 EOF 结尾的 Token 序列解析为单个表达式
+func parseTokens(tokens []Token) (expr ast.Expr, errs []*SyntaxError) {
+	p := newParser(tokens, map[string]ast.Type{})
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(bailout); !ok {
+				panic(r)
+			}
+			expr = nil
+			errs = p.errors
+		}
+	}()
+	expr = p.parseExpr()
+	if !p.at(TokenEOF) {
+		p.errorf(p.cur().Start, "unexpected %v in expression", p.cur())
+	}
+	return expr, p.errors
+}
+
+// ===================== token helpers =====================
+
+func (p *parser) cur() Token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekToken(offset int) Token {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *parser) at(kind TokenKind) bool {
+	return p.cur().Kind == kind
+}
+
+// atOp 判断当前 Token 是否是指定的符号或关键字
+func (p *parser) atOp(value string) bool {
+	return p.cur().is(value)
+}
+
+func (p *parser) advance() Token {
+	t := p.cur()
+	if t.Kind != TokenEOF {
+		p.pos++
+	}
+	p.prevEnd = t.End
+	return t
+}
+
+func (p *parser) accept(value string) bool {
+	if p.atOp(value) {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(value string) Token {
+	if !p.atOp(value) {
+		p.fail("expected %q but got %v", value, p.cur())
+	}
+	return p.advance()
+}
+
+func (p *parser) expectIdent() Token {
+	t := p.cur()
+	if t.Kind != TokenIdent || IsKeyword(t.Value) {
+		p.fail("expected identifier but got %v", t)
+	}
+	return p.advance()
+}
+
+func (p *parser) errorf(pos ast.Position, format string, args ...any) {
+	p.errors = append(p.errors, &SyntaxError{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) fail(format string, args ...any) {
+	p.errorf(p.cur().Start, format, args...)
+	panic(bailout{})
+}
+
+type locatable interface {
+	SetLoc(start, end ast.Position)
+}
+
+// finish 设置节点从 start 到上一个 Token 结束的位置
+func finish[T locatable](p *parser, node T, start ast.Position) T {
+	node.SetLoc(start, p.prevEnd)
+	return node
+}
+
+// skipParens 跳过当前位置开始的一对括号（包括嵌套的括号）
+func (p *parser) skipParens() {
+	if !p.atOp("(") {
+		return
+	}
+	depth := 0
+	for !p.at(TokenEOF) {
+		t := p.advance()
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// skipExtensions 跳过 __attribute__((...))、__declspec(...)、__asm__("...") 等扩展
+func (p *parser) skipExtensions() {
+	for p.at(TokenIdent) {
+		if _, ok := skippedSpecifiers[p.cur().Value]; !ok {
+			return
+		}
+		p.advance()
+		p.skipParens()
+	}
+}
+
+// ===================== translation unit =====================
+
+func (p *parser) parseTranslationUnit() *ast.TranslationUnit {
+	start := p.cur().Start
+	unit := &ast.TranslationUnit{}
+	for !p.at(TokenEOF) {
+		if p.accept(";") {
+			continue
+		}
+		if stmt := p.parseExternalRecover(); stmt != nil {
+			unit.Decls = append(unit.Decls, stmt)
+		}
+	}
+	unit.SetLoc(start, p.cur().End)
+	return unit
+}
+
+func (p *parser) parseExternalRecover() (stmt ast.Stmt) {
+	startPos := p.pos
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(bailout); !ok {
+				panic(r)
+			}
+			stmt = nil
+			p.synchronize(startPos)
+		}
+	}()
+	return p.parseExternal()
+}
+
+// synchronize 跳过出错的声明或语句：到同一层级的 ; 或者闭合当前层级的 } 为止
+func (p *parser) synchronize(startPos int) {
+	if p.pos == startPos && !p.at(TokenEOF) {
+		if p.atOp("}") {
+			return
+		}
+		p.advance()
+	}
+	depth := 0
+	for !p.at(TokenEOF) {
+		t := p.cur()
+		switch {
+		case t.is("{"), t.is("("), t.is("["):
+			depth++
+		case t.is(")"), t.is("]"):
+			if depth > 0 {
+				depth--
+			}
+		case t.is("}"):
+			if depth == 0 {
+				return
+			}
+			depth--
+			if depth == 0 {
+				p.advance()
+				return
+			}
+		case t.is(";"):
+			if depth == 0 {
+				p.advance()
+				return
+			}
+		}
+		p.advance()
+	}
+}
+
+func (p *parser) parseExternal() ast.Stmt {
+	start := p.cur().Start
+	switch {
+	case p.atOp("_Static_assert"):
+		p.advance()
+		p.skipParens()
+		p.accept(";")
+		return finish(p, &ast.Empty{}, start)
+	case p.atOp("asm"), p.atOp("__asm__"), p.atOp("__asm"):
+		p.skipExtensions()
+		p.accept(";")
+		return finish(p, &ast.Empty{}, start)
+	}
+
+	spec := p.parseDeclSpecs(true)
+	if p.accept(";") {
+		return finish(p, &ast.DeclStmt{Storage: spec.storage, Base: spec.base}, start)
+	}
+
+	nameTok, typ, nameLoc := p.parseDeclarator(spec.base, false)
+	if fn, ok := typ.(*ast.FuncType); ok && nameTok != nil && spec.storage != "typedef" && (p.atOp("{") || p.isDeclSpecStart()) {
+		// K&R 风格的参数声明 int f(a, b) int a; char *b; {...}
+		knr := map[string]ast.Type{}
+		for !p.atOp("{") && !p.at(TokenEOF) {
+			decl := p.parseDeclaration(p.cur().Start, p.parseDeclSpecs(false))
+			for _, d := range decl.Decls {
+				knr[d.Name] = d.Type
+			}
+		}
+		for _, param := range fn.Params {
+			if t, ok := knr[param.Name]; ok {
+				param.Type = t
+			}
+		}
+		def := &ast.FuncDef{Name: nameTok.Value, NameLoc: nameLoc, Storage: spec.storage, Type: fn}
+		def.Body = p.parseCompound()
+		return finish(p, def, start)
+	}
+	return p.finishDeclaration(start, spec, nameTok, typ, nameLoc)
+}
+
+// ===================== declarations =====================
+
+type declSpec struct {
+	storage string
+	base    ast.Type
+}
+
+// isTypeName 判断 Token 是否能开始一个类型名
+func (p *parser) isTypeName(t Token) bool {
+	if t.Kind != TokenIdent {
+		return false
+	}
+	switch t.Value {
+	case "struct", "union", "enum", "typeof", "__typeof__", "__typeof", "_Atomic":
+		return true
+	}
+	if _, ok := basicTypeWords[t.Value]; ok {
+		return true
+	}
+	if _, ok := qualifiers[t.Value]; ok {
+		return true
+	}
+	_, ok := p.typedefs[t.Value]
+	return ok
+}
+
+// isDeclSpecStart 判断当前位置是否是声明说明符的开始
+func (p *parser) isDeclSpecStart() bool {
+	t := p.cur()
+	if p.isTypeName(t) {
+		return true
+	}
+	if _, ok := storageClasses[t.Value]; ok && t.Kind == TokenIdent {
+		return true
+	}
+	if _, ok := skippedSpecifiers[t.Value]; ok && t.Kind == TokenIdent {
+		return t.Value != "asm" && t.Value != "__asm__" && t.Value != "__asm"
+	}
+	return false
+}
+
+// looksLikeDeclaration 判断块中的语句是否是声明，
+// 除了已知的类型名外，还会把 T x 与 T *x = ... 这样的形式当作未知类型 T 的声明
+func (p *parser) looksLikeDeclaration() bool {
+	t := p.cur()
+	if t.Kind != TokenIdent || IsKeyword(t.Value) && !p.isDeclSpecStart() {
+		return false
+	}
+	next := p.peekToken(1)
+	if p.isDeclSpecStart() {
+		// 与类型同名的标签 T:
+		return !next.is(":")
+	}
+	if next.Kind == TokenIdent && !IsKeyword(next.Value) {
+		return true
+	}
+	if next.is("*") {
+		i := 2
+		for p.peekToken(i).is("*") {
+			i++
+		}
+		if id := p.peekToken(i); id.Kind == TokenIdent && !IsKeyword(id.Value) {
+			after := p.peekToken(i + 1)
+			return after.is(";") || after.is("=") || after.is(",") || after.is("[") || after.is(")")
+		}
+	}
+	return false
+}
+
+// parseDeclSpecs 解析存储类别、类型限定符和类型说明符。
+// 没有已知类型时，如果后面紧跟标识符或 *，当前的标识符会被当作未知的类型名
+func (p *parser) parseDeclSpecs(allowUnknown bool) declSpec {
+	var spec declSpec
+	var words []string
+	for !p.at(TokenEOF) {
+		t := p.cur()
+		if t.Kind != TokenIdent {
+			break
+		}
+		if _, ok := storageClasses[t.Value]; ok {
+			p.advance()
+			if spec.storage == "" || t.Value == "typedef" {
+				spec.storage = t.Value
+			}
+			continue
+		}
+		if _, ok := skippedSpecifiers[t.Value]; ok {
+			p.advance()
+			p.skipParens()
+			continue
+		}
+		if t.Value == "_Atomic" && p.peekToken(1).is("(") {
+			p.advance()
+			p.advance()
+			spec.base = p.parseTypeName()
+			p.expect(")")
+			continue
+		}
+		if _, ok := qualifiers[t.Value]; ok {
+			p.advance()
+			continue
+		}
+		if _, ok := basicTypeWords[t.Value]; ok {
+			p.advance()
+			words = append(words, t.Value)
+			continue
+		}
+		if spec.base != nil || len(words) > 0 {
+			break
+		}
+		switch t.Value {
+		case "struct", "union":
+			spec.base = p.parseStruct()
+			continue
+		case "enum":
+			spec.base = p.parseEnum()
+			continue
+		case "typeof", "__typeof__", "__typeof":
+			p.advance()
+			p.skipParens()
+			spec.base = &ast.BasicType{Name: "typeof"}
+			continue
+		}
+		if _, ok := p.typedefs[t.Value]; ok {
+			p.advance()
+			spec.base = &ast.NamedType{Name: t.Value}
+			continue
+		}
+		if allowUnknown && !IsKeyword(t.Value) {
+			if next := p.peekToken(1); (next.Kind == TokenIdent && !IsKeyword(next.Value)) || next.is("*") {
+				p.advance()
+				spec.base = &ast.NamedType{Name: t.Value}
+				continue
+			}
+		}
+		break
+	}
+	if len(words) > 0 {
+		spec.base = &ast.BasicType{Name: normalizeBasicType(words)}
+	}
+	if spec.base == nil {
+		// 省略类型时默认为 int，例如 static x; 或者 K&R 风格的 main() {}
+		spec.base = &ast.BasicType{Name: "int"}
+	}
+	return spec
+}
+
+// normalizeBasicType 将 unsigned、long int 等组合规范化为统一的写法
+func normalizeBasicType(words []string) string {
+	signed, unsigned, longs := false, false, 0
+	base := ""
+	for _, w := range words {
+		switch w {
+		case "signed", "__signed", "__signed__":
+			signed = true
+		case "unsigned":
+			unsigned = true
+		case "long":
+			longs++
+		case "int":
+			if base == "" {
+				base = "int"
+			}
+		default:
+			base = w
+		}
+	}
+	switch {
+	case longs == 1 && base == "double":
+		base = "long double"
+	case longs == 1:
+		base = "long"
+	case longs >= 2:
+		base = "long long"
+	case base == "short":
+	case base == "":
+		base = "int"
+	}
+	switch {
+	case unsigned:
+		return "unsigned " + base
+	case signed && base == "char":
+		return "signed char"
+	}
+	return base
+}
+
+func (p *parser) parseStruct() ast.Type {
+	kw := p.advance()
+	st := &ast.StructType{Union: kw.Value == "union"}
+	p.skipExtensions()
+	if p.at(TokenIdent) {
+		st.Tag = p.advance().Value
+	}
+	p.skipExtensions()
+	if !p.accept("{") {
+		return st
+	}
+	st.Fields = []*ast.Field{}
+	for !p.atOp("}") && !p.at(TokenEOF) {
+		if p.accept(";") {
+			continue
+		}
+		if p.atOp("_Static_assert") {
+			p.advance()
+			p.skipParens()
+			p.accept(";")
+			continue
+		}
+		start := p.cur().Start
+		spec := p.parseDeclSpecs(true)
+		if p.accept(";") {
+			// 匿名的 struct 或 union 成员
+			field := &ast.Field{Type: spec.base}
+			st.Fields = append(st.Fields, finish(p, field, start))
+			continue
+		}
+		for {
+			fieldStart := p.cur().Start
+			field := &ast.Field{Type: spec.base}
+			if !p.atOp(":") {
+				name, typ, _ := p.parseDeclarator(spec.base, false)
+				if name != nil {
+					field.Name = name.Value
+				}
+				field.Type = typ
+			}
+			if p.accept(":") {
+				field.BitWidth = p.parseConditional()
+			}
+			p.skipExtensions()
+			st.Fields = append(st.Fields, finish(p, field, fieldStart))
+			if !p.accept(",") {
+				break
+			}
+		}
+		p.expect(";")
+	}
+	p.expect("}")
+	p.skipExtensions()
+	return st
+}
+
+func (p *parser) parseEnum() ast.Type {
+	p.advance()
+	et := &ast.EnumType{}
+	p.skipExtensions()
+	if p.at(TokenIdent) {
+		et.Tag = p.advance().Value
+	}
+	if p.atOp(":") {
+		// C23 中指定枚举的底层类型
+		p.advance()
+		p.parseDeclSpecs(false)
+	}
+	if !p.accept("{") {
+		return et
+	}
+	et.Items = []*ast.Enumerator{}
+	for !p.atOp("}") && !p.at(TokenEOF) {
+		start := p.cur().Start
+		item := &ast.Enumerator{Name: p.expectIdent().Value}
+		p.skipExtensions()
+		if p.accept("=") {
+			item.Value = p.parseConditional()
+		}
+		et.Items = append(et.Items, finish(p, item, start))
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect("}")
+	p.skipExtensions()
+	return et
+}
+
+// parseDeclaration 解析一条完整的声明，spec 已经解析完成
+func (p *parser) parseDeclaration(start ast.Position, spec declSpec) *ast.DeclStmt {
+	if p.accept(";") {
+		return finish(p, &ast.DeclStmt{Storage: spec.storage, Base: spec.base}, start)
+	}
+	name, typ, nameLoc := p.parseDeclarator(spec.base, false)
+	return p.finishDeclaration(start, spec, name, typ, nameLoc)
+}
+
+// finishDeclaration 在解析完第一个声明符之后继续解析初始化器以及后续的声明符
+func (p *parser) finishDeclaration(start ast.Position, spec declSpec, name *Token, typ ast.Type, nameLoc ast.Loc) *ast.DeclStmt {
+	decl := &ast.DeclStmt{Storage: spec.storage, Base: spec.base}
+	for {
+		d := &ast.Declarator{Type: typ, NameLoc: nameLoc}
+		declStart := nameLoc.StartPos
+		if name != nil {
+			d.Name = name.Value
+			if spec.storage == "typedef" {
+				p.typedefs[d.Name] = typ
+			}
+		} else {
+			declStart = p.cur().Start
+		}
+		p.skipExtensions()
+		if p.accept("=") {
+			d.Init = p.parseInitializer()
+		}
+		decl.Decls = append(decl.Decls, finish(p, d, declStart))
+		if !p.accept(",") {
+			break
+		}
+		name, typ, nameLoc = p.parseDeclarator(spec.base, false)
+	}
+	p.expect(";")
+	return finish(p, decl, start)
+}
+
+// parseDeclarator 解析声明符，返回声明的名字（抽象声明符没有名字）、完整类型以及名字的位置
+func (p *parser) parseDeclarator(base ast.Type, abstract bool) (*Token, ast.Type, ast.Loc) {
+	name, wrap := p.parseDeclaratorWrap(abstract)
+	var loc ast.Loc
+	if name != nil {
+		loc.SetLoc(name.Start, name.End)
+	}
+	return name, wrap(base), loc
+}
+
+// parseDeclaratorWrap 解析声明符，返回的 wrap 将声明符中的指针、数组和函数修饰作用到基础类型上
+func (p *parser) parseDeclaratorWrap(abstract bool) (*Token, func(ast.Type) ast.Type) {
+	pointers := 0
+	for {
+		p.skipExtensions()
+		if p.accept("*") || p.accept("^") {
+			pointers++
+			continue
+		}
+		if _, ok := qualifiers[p.cur().Value]; ok && p.at(TokenIdent) {
+			p.advance()
+			continue
+		}
+		if p.atOp("_Atomic") {
+			p.advance()
+			continue
+		}
+		break
+	}
+
+	var name *Token
+	inner := func(t ast.Type) ast.Type { return t }
+	switch {
+	case p.atOp("(") && p.isNestedDeclarator(abstract):
+		p.advance()
+		name, inner = p.parseDeclaratorWrap(abstract)
+		p.expect(")")
+	case p.at(TokenIdent) && !IsKeyword(p.cur().Value) && !(abstract && p.isTypeName(p.cur())):
+		t := p.advance()
+		name = &t
+	}
+
+	var suffixes []func(ast.Type) ast.Type
+	for {
+		p.skipExtensions()
+		if p.accept("[") {
+			for p.atOp("static") || p.atOp("*") && p.peekToken(1).is("]") {
+				p.advance()
+			}
+			for {
+				if _, ok := qualifiers[p.cur().Value]; ok && p.at(TokenIdent) {
+					p.advance()
+					continue
+				}
+				break
+			}
+			var length ast.Expr
+			if !p.atOp("]") {
+				length = p.parseAssignment()
+			}
+			p.expect("]")
+			suffixes = append(suffixes, func(t ast.Type) ast.Type {
+				return &ast.ArrayType{Elem: t, Len: length}
+			})
+			continue
+		}
+		if p.atOp("(") {
+			fn := p.parseParams()
+			suffixes = append(suffixes, func(t ast.Type) ast.Type {
+				fn.Result = t
+				return fn
+			})
+			continue
+		}
+		break
+	}
+	p.skipExtensions()
+
+	return name, func(t ast.Type) ast.Type {
+		for i := 0; i < pointers; i++ {
+			t = &ast.PointerType{Elem: t}
+		}
+		for i := len(suffixes) - 1; i >= 0; i-- {
+			t = suffixes[i](t)
+		}
+		return inner(t)
+	}
+}
+
+// isNestedDeclarator 判断当前的 ( 是嵌套的声明符（例如 (*fp)），还是函数的参数列表
+func (p *parser) isNestedDeclarator(abstract bool) bool {
+	next := p.peekToken(1)
+	switch {
+	case next.is("*"), next.is("^"), next.is("["):
+		return true
+	case next.is("("):
+		return !abstract
+	case next.Kind == TokenIdent:
+		if _, ok := skippedSpecifiers[next.Value]; ok {
+			return true
+		}
+		return !abstract && !p.isTypeName(next) && !IsKeyword(next.Value)
+	}
+	return false
+}
+
+func (p *parser) parseParams() *ast.FuncType {
+	p.expect("(")
+	fn := &ast.FuncType{Params: []*ast.Param{}}
+	if p.atOp("void") && p.peekToken(1).is(")") {
+		p.advance()
+	}
+	for !p.atOp(")") && !p.at(TokenEOF) {
+		if p.accept("...") {
+			fn.Variadic = true
+			break
+		}
+		start := p.cur().Start
+		spec := p.parseDeclSpecs(true)
+		name, typ, _ := p.parseDeclarator(spec.base, true)
+		param := &ast.Param{Type: typ}
+		if name != nil {
+			param.Name = name.Value
+		}
+		fn.Params = append(fn.Params, finish(p, param, start))
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect(")")
+	return fn
+}
+
+// parseTypeName 解析类型名，例如 sizeof 和类型转换中的 unsigned char *
+func (p *parser) parseTypeName() ast.Type {
+	spec := p.parseDeclSpecs(false)
+	_, typ, _ := p.parseDeclarator(spec.base, true)
+	return typ
+}
+
+// parseInitializer 解析初始化器：表达式或者初始化列表
+func (p *parser) parseInitializer() ast.Expr {
+	if p.atOp("{") {
+		return p.parseInitList()
+	}
+	return p.parseAssignment()
+}
+
+func (p *parser) parseInitList() *ast.InitList {
+	start := p.expect("{").Start
+	list := &ast.InitList{}
+	for !p.atOp("}") && !p.at(TokenEOF) {
+		elemStart := p.cur().Start
+		elem := &ast.InitElem{}
+		for {
+			if p.atOp(".") {
+				p.advance()
+				elem.Designators = append(elem.Designators, &ast.Designator{Field: p.expectIdent().Value})
+				continue
+			}
+			if p.atOp("[") {
+				p.advance()
+				index := p.parseConditional()
+				if p.accept("...") {
+					// GNU 扩展 [a ... b]
+					p.parseConditional()
+				}
+				p.expect("]")
+				elem.Designators = append(elem.Designators, &ast.Designator{Index: index})
+				continue
+			}
+			break
+		}
+		if len(elem.Designators) > 0 {
+			p.accept("=")
+		} else if p.at(TokenIdent) && p.peekToken(1).is(":") {
+			// GNU 旧式的指示符 field: value
+			elem.Designators = append(elem.Designators, &ast.Designator{Field: p.advance().Value})
+			p.advance()
+		}
+		elem.Value = p.parseInitializer()
+		list.Elems = append(list.Elems, finish(p, elem, elemStart))
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect("}")
+	return finish(p, list, start)
+}
+
+// ===================== statements =====================
+
+func (p *parser) parseCompound() *ast.Compound {
+	start := p.expect("{").Start
+	block := &ast.Compound{Items: []ast.Stmt{}}
+	for !p.atOp("}") && !p.at(TokenEOF) {
+		if stmt := p.parseStatementRecover(); stmt != nil {
+			block.Items = append(block.Items, stmt)
+		}
+	}
+	p.expect("}")
+	return finish(p, block, start)
+}
+
+func (p *parser) parseStatementRecover() (stmt ast.Stmt) {
+	startPos := p.pos
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(bailout); !ok {
+				panic(r)
+			}
+			stmt = nil
+			p.synchronize(startPos)
+		}
+	}()
+	return p.parseBlockItem()
+}
+
+// parseBlockItem 解析块中的一项：声明或者语句
+func (p *parser) parseBlockItem() ast.Stmt {
+	if p.atOp("_Static_assert") {
+		start := p.advance().Start
+		p.skipParens()
+		p.accept(";")
+		return finish(p, &ast.Empty{}, start)
+	}
+	if p.looksLikeDeclaration() {
+		start := p.cur().Start
+		return p.parseDeclaration(start, p.parseDeclSpecs(true))
+	}
+	return p.parseStatement()
+}
+
+func (p *parser) parseStatement() ast.Stmt {
+	t := p.cur()
+	start := t.Start
+	if t.Kind == TokenIdent {
+		switch t.Value {
+		case "if":
+			p.advance()
+			stmt := &ast.If{}
+			stmt.Cond = p.parseParenExpr()
+			stmt.Then = p.parseStatement()
+			if p.accept("else") {
+				stmt.Else = p.parseStatement()
+			}
+			return finish(p, stmt, start)
+		case "while":
+			p.advance()
+			stmt := &ast.While{}
+			stmt.Cond = p.parseParenExpr()
+			stmt.Body = p.parseStatement()
+			return finish(p, stmt, start)
+		case "do":
+			p.advance()
+			stmt := &ast.DoWhile{}
+			stmt.Body = p.parseStatement()
+			p.expect("while")
+			stmt.Cond = p.parseParenExpr()
+			p.expect(";")
+			return finish(p, stmt, start)
+		case "for":
+			return p.parseFor()
+		case "switch":
+			p.advance()
+			stmt := &ast.Switch{}
+			stmt.Cond = p.parseParenExpr()
+			stmt.Body = p.parseStatement()
+			return finish(p, stmt, start)
+		case "case":
+			p.advance()
+			stmt := &ast.Case{Value: p.parseConditional()}
+			if p.accept("...") {
+				// GNU 扩展 case 1 ... 5:
+				p.parseConditional()
+			}
+			p.expect(":")
+			stmt.Body = p.parseCaseBody()
+			return finish(p, stmt, start)
+		case "default":
+			p.advance()
+			p.expect(":")
+			stmt := &ast.Case{}
+			stmt.Body = p.parseCaseBody()
+			return finish(p, stmt, start)
+		case "goto":
+			p.advance()
+			stmt := &ast.Goto{}
+			if p.accept("*") {
+				// GNU 计算跳转 goto *ptr; 无法确定目标
+				p.parseExpr()
+			} else {
+				stmt.Label = p.expectIdent().Value
+			}
+			p.expect(";")
+			return finish(p, stmt, start)
+		case "break":
+			p.advance()
+			p.expect(";")
+			return finish(p, &ast.Break{}, start)
+		case "continue":
+			p.advance()
+			p.expect(";")
+			return finish(p, &ast.Continue{}, start)
+		case "return":
+			p.advance()
+			stmt := &ast.Return{}
+			if !p.atOp(";") {
+				stmt.Value = p.parseExpr()
+			}
+			p.expect(";")
+			return finish(p, stmt, start)
+		case "asm", "__asm__", "__asm":
+			p.advance()
+			for p.at(TokenIdent) {
+				if _, ok := qualifiers[p.cur().Value]; !ok && p.cur().Value != "goto" {
+					break
+				}
+				p.advance()
+			}
+			p.skipParens()
+			p.expect(";")
+			return finish(p, &ast.Empty{}, start)
+		}
+		if !IsKeyword(t.Value) && p.peekToken(1).is(":") {
+			p.advance()
+			p.advance()
+			stmt := &ast.Labeled{Label: t.Value}
+			p.skipExtensions()
+			stmt.Body = p.parseCaseBody()
+			return finish(p, stmt, start)
+		}
+	}
+	switch {
+	case t.is("{"):
+		return p.parseCompound()
+	case t.is(";"):
+		p.advance()
+		return finish(p, &ast.Empty{}, start)
+	}
+	stmt := &ast.ExprStmt{X: p.parseExpr()}
+	p.expect(";")
+	return finish(p, stmt, start)
+}
+
+// parseCaseBody 解析 case 和标签之后的语句，C23 之前标签之后不能直接是声明，这里一并兼容
+func (p *parser) parseCaseBody() ast.Stmt {
+	if p.atOp("}") {
+		return finish(p, &ast.Empty{}, p.cur().Start)
+	}
+	return p.parseBlockItem()
+}
+
+func (p *parser) parseFor() ast.Stmt {
+	start := p.advance().Start
+	stmt := &ast.For{}
+	p.expect("(")
+	switch {
+	case p.accept(";"):
+	case p.looksLikeDeclaration():
+		stmt.Init = p.parseDeclaration(p.cur().Start, p.parseDeclSpecs(true))
+	default:
+		initStart := p.cur().Start
+		init := &ast.ExprStmt{X: p.parseExpr()}
+		p.expect(";")
+		stmt.Init = finish(p, init, initStart)
+	}
+	if !p.atOp(";") {
+		stmt.Cond = p.parseExpr()
+	}
+	p.expect(";")
+	if !p.atOp(")") {
+		stmt.Post = p.parseExpr()
+	}
+	p.expect(")")
+	stmt.Body = p.parseStatement()
+	return finish(p, stmt, start)
+}
+
+func (p *parser) parseParenExpr() ast.Expr {
+	p.expect("(")
+	expr := p.parseExpr()
+	p.expect(")")
+	return expr
+}
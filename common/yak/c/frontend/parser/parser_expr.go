@@ -0,0 +1,309 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+var assignOps = map[string]struct{}{
+	"=": {}, "+=": {}, "-=": {}, "*=": {}, "/=": {}, "%=": {},
+	"&=": {}, "|=": {}, "^=": {}, "<<=": {}, ">>=": {},
+}
+
+// 二元运算符的优先级，数字越大优先级越高
+var binaryPrecedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"|":  3,
+	"^":  4,
+	"&":  5,
+	"==": 6, "!=": 6,
+	"<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8,
+	"+": 9, "-": 9,
+	"*": 10, "/": 10, "%": 10,
+}
+
+// parseExpr 解析逗号表达式
+func (p *parser) parseExpr() ast.Expr {
+	start := p.cur().Start
+	first := p.parseAssignment()
+	if !p.atOp(",") {
+		return first
+	}
+	list := []ast.Expr{first}
+	for p.accept(",") {
+		list = append(list, p.parseAssignment())
+	}
+	return finish(p, &ast.Comma{List: list}, start)
+}
+
+func (p *parser) parseAssignment() ast.Expr {
+	start := p.cur().Start
+	lhs := p.parseConditional()
+	if t := p.cur(); t.Kind == TokenPunct {
+		if _, ok := assignOps[t.Value]; ok {
+			p.advance()
+			rhs := p.parseAssignment()
+			return finish(p, &ast.Assign{Op: t.Value, Lhs: lhs, Rhs: rhs}, start)
+		}
+	}
+	return lhs
+}
+
+func (p *parser) parseConditional() ast.Expr {
+	start := p.cur().Start
+	cond := p.parseBinary(1)
+	if !p.accept("?") {
+		return cond
+	}
+	expr := &ast.Cond{Cond: cond}
+	if !p.atOp(":") {
+		expr.Then = p.parseExpr()
+	}
+	// GNU 扩展 a ?: b 中省略的部分等于条件本身
+	if expr.Then == nil {
+		expr.Then = cond
+	}
+	p.expect(":")
+	expr.Else = p.parseConditional()
+	return finish(p, expr, start)
+}
+
+func (p *parser) parseBinary(minPrec int) ast.Expr {
+	start := p.cur().Start
+	left := p.parseCast()
+	for {
+		t := p.cur()
+		if t.Kind != TokenPunct {
+			return left
+		}
+		prec, ok := binaryPrecedence[t.Value]
+		if !ok || prec < minPrec {
+			return left
+		}
+		p.advance()
+		right := p.parseBinary(prec + 1)
+		left = finish(p, &ast.Binary{Op: t.Value, X: left, Y: right}, start)
+	}
+}
+
+// isCastStart 判断当前的 ( 是否是类型转换或复合字面量的开始。
+// 未知的类型名通过 (T *) 和 (T) x 的形式识别
+func (p *parser) isCastStart() bool {
+	if !p.atOp("(") {
+		return false
+	}
+	next := p.peekToken(1)
+	if p.isTypeName(next) {
+		return true
+	}
+	if next.Kind != TokenIdent || IsKeyword(next.Value) {
+		return false
+	}
+	i := 2
+	for p.peekToken(i).is("*") {
+		i++
+	}
+	if !p.peekToken(i).is(")") {
+		return false
+	}
+	if i > 2 {
+		return true
+	}
+	after := p.peekToken(i + 1)
+	switch after.Kind {
+	case TokenIdent:
+		return !IsKeyword(after.Value) || after.Value == "sizeof"
+	case TokenNumber, TokenChar, TokenString:
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseCast() ast.Expr {
+	if !p.isCastStart() {
+		return p.parseUnary()
+	}
+	start := p.advance().Start
+	typ := p.parseTypeName()
+	p.expect(")")
+	if p.atOp("{") {
+		lit := &ast.CompoundLit{Type: typ, Init: p.parseInitList()}
+		return p.parsePostfixSuffix(finish(p, lit, start), start)
+	}
+	x := p.parseCast()
+	return finish(p, &ast.Cast{Type: typ, X: x}, start)
+}
+
+func (p *parser) parseUnary() ast.Expr {
+	t := p.cur()
+	start := t.Start
+	if t.Kind == TokenPunct {
+		switch t.Value {
+		case "++", "--":
+			p.advance()
+			x := p.parseUnary()
+			return finish(p, &ast.Unary{Op: t.Value, X: x}, start)
+		case "-", "+", "!", "~", "*", "&":
+			p.advance()
+			x := p.parseCast()
+			return finish(p, &ast.Unary{Op: t.Value, X: x}, start)
+		case "&&":
+			// GNU 扩展：标签的地址 &&label
+			p.advance()
+			label := p.expectIdent()
+			return finish(p, &ast.Ident{Name: label.Value}, start)
+		}
+	}
+	if t.Kind == TokenIdent {
+		switch t.Value {
+		case "sizeof", "_Alignof", "__alignof__", "__alignof", "alignof":
+			p.advance()
+			if p.atOp("(") && p.isTypeName(p.peekToken(1)) {
+				p.advance()
+				typ := p.parseTypeName()
+				p.expect(")")
+				return finish(p, &ast.Sizeof{Type: typ}, start)
+			}
+			x := p.parseUnary()
+			return finish(p, &ast.Sizeof{X: x}, start)
+		case "__extension__", "__real__", "__imag__":
+			p.advance()
+			return p.parseCast()
+		}
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() ast.Expr {
+	start := p.cur().Start
+	return p.parsePostfixSuffix(p.parsePrimary(), start)
+}
+
+func (p *parser) parsePostfixSuffix(x ast.Expr, start ast.Position) ast.Expr {
+	for {
+		t := p.cur()
+		switch {
+		case t.is("["):
+			p.advance()
+			index := p.parseExpr()
+			p.expect("]")
+			x = finish(p, &ast.Index{X: x, Index: index}, start)
+		case t.is("("):
+			x = finish(p, &ast.Call{Func: x, Args: p.parseArguments()}, start)
+		case t.is("."), t.is("->"):
+			p.advance()
+			name := p.expectIdent()
+			x = finish(p, &ast.Member{X: x, Name: name.Value, Arrow: t.Value == "->"}, start)
+		case t.is("++"), t.is("--"):
+			p.advance()
+			x = finish(p, &ast.Postfix{Op: t.Value, X: x}, start)
+		default:
+			return x
+		}
+	}
+}
+
+// parseArguments 解析调用的参数，类型名也可以作为参数，例如 va_arg(ap, int) 和 offsetof(struct s, f)
+func (p *parser) parseArguments() []ast.Expr {
+	p.expect("(")
+	args := []ast.Expr{}
+	for !p.atOp(")") && !p.at(TokenEOF) {
+		start := p.cur().Start
+		if p.isTypeName(p.cur()) && !p.peekToken(1).is("(") {
+			args = append(args, finish(p, &ast.TypeExpr{Type: p.parseTypeName()}, start))
+		} else {
+			args = append(args, p.parseAssignment())
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect(")")
+	return args
+}
+
+func (p *parser) parsePrimary() ast.Expr {
+	t := p.cur()
+	start := t.Start
+	switch t.Kind {
+	case TokenIdent:
+		if t.Value == "_Generic" {
+			// _Generic 的结果取决于类型，这里只保留第一个关联的表达式
+			p.advance()
+			return p.parseGeneric(start)
+		}
+		if IsKeyword(t.Value) {
+			p.fail("unexpected keyword %q", t.Value)
+		}
+		p.advance()
+		return finish(p, &ast.Ident{Name: t.Value}, start)
+	case TokenNumber:
+		p.advance()
+		if isFloatLiteral(t.Value) {
+			return finish(p, &ast.FloatLit{Value: t.Value}, start)
+		}
+		return finish(p, &ast.IntLit{Value: t.Value}, start)
+	case TokenChar:
+		p.advance()
+		return finish(p, &ast.CharLit{Value: decodeChar(t.Value)}, start)
+	case TokenString:
+		return p.parseStrings()
+	case TokenPunct:
+		if t.Value == "(" {
+			p.advance()
+			if p.atOp("{") {
+				body := p.parseCompound()
+				p.expect(")")
+				return finish(p, &ast.StmtExpr{Body: body}, start)
+			}
+			x := p.parseExpr()
+			p.expect(")")
+			return x
+		}
+	}
+	p.fail("unexpected %v", t)
+	return nil
+}
+
+// parseStrings 解析相邻的字符串字面量并拼接。
+// 夹在字符串之间未展开的宏（例如 "%" PRIu64 "\n"）会被忽略
+func (p *parser) parseStrings() ast.Expr {
+	start := p.cur().Start
+	var sb strings.Builder
+	for {
+		if p.at(TokenString) {
+			sb.WriteString(decodeString(p.advance().Value))
+			continue
+		}
+		if p.at(TokenIdent) && !IsKeyword(p.cur().Value) && p.peekToken(1).Kind == TokenString {
+			p.advance()
+			continue
+		}
+		break
+	}
+	return finish(p, &ast.StringLit{Value: sb.String()}, start)
+}
+
+func (p *parser) parseGeneric(start ast.Position) ast.Expr {
+	p.expect("(")
+	p.parseAssignment()
+	var result ast.Expr
+	for p.accept(",") {
+		if !p.accept("default") {
+			p.parseTypeName()
+		}
+		p.expect(":")
+		expr := p.parseAssignment()
+		if result == nil {
+			result = expr
+		}
+	}
+	p.expect(")")
+	if result == nil {
+		return finish(p, &ast.Ident{Name: "_Generic"}, start)
+	}
+	return result
+}
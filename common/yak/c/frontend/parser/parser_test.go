@@ -0,0 +1,302 @@
+package parser
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+func parseOK(t *testing.T, src string, opts ...Option) *ast.TranslationUnit {
+	t.Helper()
+	unit, errs := Parse(src, opts...)
+	require.Empty(t, errs, "source:\n%s", src)
+	return unit
+}
+
+// preprocess 返回预处理之后的 Token 文本
+func preprocess(t *testing.T, src string, opts ...Option) []string {
+	t.Helper()
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	pp := newPreprocessor(cfg)
+	tokens := pp.run(src)
+	require.Empty(t, pp.errors)
+	var values []string
+	for _, tok := range tokens[:len(tokens)-1] {
+		values = append(values, tok.Value)
+	}
+	return values
+}
+
+func mapResolver(files map[string]string) IncludeResolver {
+	return func(name string, system bool, from string) (string, string, bool) {
+		for _, candidate := range []string{path.Join(path.Dir(from), name), path.Join("include", name)} {
+			if content, ok := files[candidate]; ok {
+				return candidate, content, true
+			}
+		}
+		return "", "", false
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tokens, errs := Tokenize("a->b += 0x1fUL; s = L\"x\\\"y\" /* c */ 'c';\n# define X \\\n 1")
+	require.Empty(t, errs)
+	var values []string
+	for _, tok := range tokens {
+		values = append(values, tok.Value)
+	}
+	require.Equal(t, []string{
+		"a", "->", "b", "+=", "0x1fUL", ";", "s", "=", `L"x\"y"`, "'c'", ";",
+		"#", "define", "X", "1", "",
+	}, values)
+	require.True(t, tokens[11].LineStart)
+	// 续行之后的 Token 仍然属于同一个逻辑行
+	require.False(t, tokens[14].LineStart)
+}
+
+func TestPreprocess_Macro(t *testing.T) {
+	src := `#define SIZE 64
+#define MIN(a, b) ((a) < (b) ? (a) : (b))
+#define STR(x) #x
+#define CAT(a, b) a ## b
+#define LOG(fmt, ...) printf(fmt, ## __VA_ARGS__)
+#define SELF SELF + 1
+char buf[SIZE];
+int n = MIN(SIZE, len);
+char *s = STR(a + "b");
+int CAT(var, 1) = SELF;
+LOG("x");
+LOG("%d", n);
+#undef SIZE
+int m = SIZE;
+`
+	require.Equal(t, []string{
+		"char", "buf", "[", "64", "]", ";",
+		"int", "n", "=", "(", "(", "64", ")", "<", "(", "len", ")", "?", "(", "64", ")", ":", "(", "len", ")", ")", ";",
+		"char", "*", "s", "=", `"a + \"b\""`, ";",
+		"int", "var1", "=", "SELF", "+", "1", ";",
+		"printf", "(", `"x"`, ")", ";",
+		"printf", "(", `"%d"`, ",", "n", ")", ";",
+		"int", "m", "=", "SIZE", ";",
+	}, preprocess(t, src))
+}
+
+func TestPreprocess_Conditional(t *testing.T) {
+	src := `#define FEATURE 2
+#if defined(FEATURE) && FEATURE > 1
+a;
+#elif UNKNOWN
+b;
+#else
+c;
+#endif
+#ifdef NOT_DEFINED
+# if 1
+d;
+# endif
+#elif !defined NOT_DEFINED
+e;
+#endif
+#ifndef FEATURE
+f;
+#endif
+`
+	require.Equal(t, []string{"a", ";", "e", ";"}, preprocess(t, src))
+}
+
+func TestPreprocess_Include(t *testing.T) {
+	files := map[string]string{
+		"src/util.h": `#ifndef UTIL_H
+#define UTIL_H
+#include <config.h>
+typedef struct buffer { char *data; } buffer_t;
+#define BUF_SIZE (CONFIG_SIZE * 2)
+#endif
+`,
+		"include/config.h": "#define CONFIG_SIZE 128\ntypedef unsigned int my_uint;\n",
+	}
+	src := `#include "util.h"
+#include "util.h"
+#include <stdio.h>
+int main() {
+	buffer_t *b;
+	my_uint n = BUF_SIZE;
+	(buffer_t *) b;
+	return 0;
+}
+`
+	unit := parseOK(t, src, WithFilename("src/main.c"), WithIncludeResolver(mapResolver(files)))
+	require.Contains(t, unit.Typedefs, "buffer_t")
+	require.Contains(t, unit.Typedefs, "my_uint")
+
+	fn := unit.Decls[0].(*ast.FuncDef)
+	decl := fn.Body.Items[1].(*ast.DeclStmt)
+	require.Equal(t, &ast.NamedType{Name: "my_uint"}, decl.Base)
+	// 宏展开得到的 Token 使用宏调用处的位置
+	init := decl.Decls[0].Init.(*ast.Binary)
+	require.Equal(t, ast.Position{Line: 5, Col: 13}, init.Start())
+	require.Equal(t, ast.Position{Line: 5, Col: 21}, init.End())
+	_, ok := fn.Body.Items[2].(*ast.ExprStmt).X.(*ast.Cast)
+	require.True(t, ok)
+}
+
+func TestParse_Declarations(t *testing.T) {
+	src := `static const char *names[] = {"a", "b"}, *last;
+int (*handler)(int, char **);
+unsigned long long counter = 0;
+struct point { int x, y; struct point *next; unsigned flag : 1; };
+typedef struct point point_t;
+enum color { RED, GREEN = 3, BLUE };
+point_t origin = { .x = 0, .y = 0 };
+int matrix[2][3];
+extern void callback(void (*cb)(void *), void *ctx, ...);
+__attribute__((unused)) static int unused_var;
+`
+	unit := parseOK(t, src)
+	require.Len(t, unit.Decls, 10)
+
+	names := unit.Decls[0].(*ast.DeclStmt)
+	require.Equal(t, "static", names.Storage)
+	require.Equal(t, "names", names.Decls[0].Name)
+	require.Equal(t, &ast.ArrayType{Elem: &ast.PointerType{Elem: &ast.BasicType{Name: "char"}}}, names.Decls[0].Type)
+	require.Equal(t, "last", names.Decls[1].Name)
+
+	handler := unit.Decls[1].(*ast.DeclStmt).Decls[0]
+	ptr, ok := handler.Type.(*ast.PointerType)
+	require.True(t, ok)
+	fn, ok := ptr.Elem.(*ast.FuncType)
+	require.True(t, ok)
+	require.Len(t, fn.Params, 2)
+
+	require.Equal(t, &ast.BasicType{Name: "unsigned long long"}, unit.Decls[2].(*ast.DeclStmt).Base)
+
+	point := unit.Decls[3].(*ast.DeclStmt).Base.(*ast.StructType)
+	require.Equal(t, "point", point.Tag)
+	require.Len(t, point.Fields, 4)
+
+	require.Contains(t, unit.Typedefs, "point_t")
+	require.Len(t, unit.Decls[5].(*ast.DeclStmt).Base.(*ast.EnumType).Items, 3)
+
+	origin := unit.Decls[6].(*ast.DeclStmt).Decls[0].Init.(*ast.InitList)
+	require.Equal(t, "x", origin.Elems[0].Designators[0].Field)
+
+	matrix := unit.Decls[7].(*ast.DeclStmt).Decls[0].Type.(*ast.ArrayType)
+	require.IsType(t, &ast.ArrayType{}, matrix.Elem)
+
+	callback := unit.Decls[8].(*ast.DeclStmt).Decls[0].Type.(*ast.FuncType)
+	require.True(t, callback.Variadic)
+}
+
+func TestParse_Function(t *testing.T) {
+	src := `int handle(int fd, struct request *req) {
+	char buf[256];
+	size_t n = recv(fd, buf, sizeof(buf) - 1, 0);
+	if (n <= 0) {
+		goto out;
+	} else if (req->len > n)
+		return -1;
+	for (int i = 0; i < n; i++) {
+		if (buf[i] == '\n') break;
+		continue;
+	}
+	while (n--) ;
+	do { n++; } while (n < 10);
+	switch (buf[0]) {
+	case 'a':
+	case 'b':
+		req->type = 1;
+		break;
+	default:
+		req->type = (int) n;
+	}
+	strcpy(req->name, buf);
+	*req->data = (struct item){ 1, 2 };
+	n = n > 1 ? n : 1, n <<= 2;
+out:
+	return ({ int r = 0; r; });
+}
+`
+	unit := parseOK(t, src)
+	require.Len(t, unit.Decls, 1)
+	fn := unit.Decls[0].(*ast.FuncDef)
+	require.Equal(t, "handle", fn.Name)
+	require.Len(t, fn.Type.Params, 2)
+	require.Equal(t, "req", fn.Type.Params[1].Name)
+	require.Len(t, fn.Body.Items, 11)
+	require.IsType(t, &ast.DeclStmt{}, fn.Body.Items[1])
+	require.IsType(t, &ast.Switch{}, fn.Body.Items[6])
+	require.IsType(t, &ast.Labeled{}, fn.Body.Items[10])
+
+	call := fn.Body.Items[7].(*ast.ExprStmt).X.(*ast.Call)
+	require.Equal(t, "strcpy", call.Func.(*ast.Ident).Name)
+	member := call.Args[0].(*ast.Member)
+	require.True(t, member.Arrow)
+	require.Equal(t, "name", member.Name)
+}
+
+func TestParse_UnknownTypes(t *testing.T) {
+	// 没有头文件时也能识别常见形式中未知的类型名
+	src := `void f(config_t *cfg) {
+	uint24 value;
+	handle_t *h = open_handle();
+	result = (status_t) value;
+	x = y * z;
+	va_arg(ap, char *);
+}
+K_AND_R(a, b) int a; char *b; { return a; }
+`
+	unit := parseOK(t, src)
+	fn := unit.Decls[0].(*ast.FuncDef)
+	require.Equal(t, &ast.PointerType{Elem: &ast.NamedType{Name: "config_t"}}, fn.Type.Params[0].Type)
+	require.IsType(t, &ast.DeclStmt{}, fn.Body.Items[0])
+	require.IsType(t, &ast.DeclStmt{}, fn.Body.Items[1])
+	require.IsType(t, &ast.Cast{}, fn.Body.Items[2].(*ast.ExprStmt).X.(*ast.Assign).Rhs)
+	require.IsType(t, &ast.Binary{}, fn.Body.Items[3].(*ast.ExprStmt).X.(*ast.Assign).Rhs)
+	require.IsType(t, &ast.TypeExpr{}, fn.Body.Items[4].(*ast.ExprStmt).X.(*ast.Call).Args[1])
+
+	knr := unit.Decls[1].(*ast.FuncDef)
+	require.Equal(t, &ast.PointerType{Elem: &ast.BasicType{Name: "char"}}, knr.Type.Params[1].Type)
+}
+
+func TestParse_Recover(t *testing.T) {
+	src := `int ok1(void) { return 1; }
+int broken(void) { int x = ; return x; }
+int ok2(void) { return 2; }
+`
+	unit, errs := Parse(src)
+	require.NotEmpty(t, errs)
+	var names []string
+	for _, decl := range unit.Decls {
+		if fn, ok := decl.(*ast.FuncDef); ok {
+			names = append(names, fn.Name)
+		}
+	}
+	require.Equal(t, []string{"ok1", "broken", "ok2"}, names)
+}
+
+func TestEvalInt(t *testing.T) {
+	for src, want := range map[string]int64{
+		"1 + 2 * 3":        7,
+		"(1 << 4) | 0x0f":  31,
+		"010 + 0b11":       11,
+		"'a' == 97":        1,
+		"10UL / 3 % 2":     1,
+		"!0 && (2 > 1)":    1,
+		"0 ? 1 : -1":       -1,
+		"0 && 1 / 0":       0,
+		"~0 == -1 || 1/0":  1,
+		"(unsigned char)5": 5,
+	} {
+		expr, errs := ParseExpression(src)
+		require.Empty(t, errs, src)
+		got, ok := EvalInt(expr)
+		require.True(t, ok, src)
+		require.Equal(t, want, got, src)
+	}
+}
@@ -0,0 +1,698 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/yak/c/frontend/ast"
+)
+
+// maxIncludeDepth 限制头文件嵌套引用的深度
+const maxIncludeDepth = 32
+
+// Macro 是 #define 定义的宏，可变参数宏的最后一个参数是 __VA_ARGS__ 或 GNU 风格的具名参数
+type Macro struct {
+	Name     string
+	FuncLike bool
+	Params   []string
+	Variadic bool
+	Body     []Token
+}
+
+// IncludeResolver 查找 #include 引用的头文件。name 是引用的文件名，system 表示 <...> 形式的引用，
+// from 是发起引用的文件。找不到时返回 ok 为 false，该引用会被忽略
+type IncludeResolver func(name string, system bool, from string) (path string, content string, ok bool)
+
+type config struct {
+	filename string
+	resolver IncludeResolver
+	defines  [][2]string
+}
+
+// Option 是 Parse 的配置项
+type Option func(*config)
+
+// WithFilename 设置当前文件的路径，用于 __FILE__ 以及解析相对路径的 #include
+func WithFilename(name string) Option {
+	return func(c *config) {
+		c.filename = name
+	}
+}
+
+// WithIncludeResolver 设置头文件查找函数，未设置时所有 #include 都会被忽略
+func WithIncludeResolver(resolver IncludeResolver) Option {
+	return func(c *config) {
+		c.resolver = resolver
+	}
+}
+
+// WithDefine 预定义一个宏，相当于 -Dname=value
+func WithDefine(name, value string) Option {
+	return func(c *config) {
+		c.defines = append(c.defines, [2]string{name, value})
+	}
+}
+
+// condState 是一层 #if 的状态
+type condState struct {
+	// parent 表示外层是否生效，active 表示当前分支是否生效，taken 表示是否已经有分支生效过
+	parent  bool
+	active  bool
+	taken   bool
+	sawElse bool
+}
+
+// preprocessor 实现了简化的 C 预处理：宏定义与展开、条件编译以及项目内的头文件引用。
+// 头文件中的内容不会合并到当前文件中，只用于收集宏和类型名，头文件中的定义由引用它的文件单独构建
+type preprocessor struct {
+	cfg      *config
+	macros   map[string]*Macro
+	included map[string]struct{}
+	headers  [][]Token
+	// includes 是引用的头文件路径，被嵌套引用的头文件在引用它的头文件之前
+	includes []string
+	errors   []*SyntaxError
+
+	file  string
+	depth int
+}
+
+func newPreprocessor(cfg *config) *preprocessor {
+	pp := &preprocessor{
+		cfg:      cfg,
+		macros:   make(map[string]*Macro),
+		included: make(map[string]struct{}),
+		file:     cfg.filename,
+	}
+	predefined := [][2]string{
+		{"__STDC__", "1"},
+		{"__STDC_VERSION__", "201112L"},
+		{"__STDC_HOSTED__", "1"},
+	}
+	for _, def := range append(predefined, cfg.defines...) {
+		tokens, _ := Tokenize(def[1])
+		pp.macros[def[0]] = &Macro{Name: def[0], Body: tokens[:len(tokens)-1]}
+	}
+	return pp
+}
+
+func (pp *preprocessor) errorf(pos ast.Position, format string, args ...any) {
+	// 头文件中的位置与当前文件无关，不报告头文件中的错误
+	if pp.depth > 0 {
+		return
+	}
+	pp.errors = append(pp.errors, &SyntaxError{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
+
+// run 预处理当前文件，返回以 EOF 结尾的 Token 序列
+func (pp *preprocessor) run(src string) []Token {
+	tokens, errs := Tokenize(src)
+	pp.errors = append(pp.errors, errs...)
+	out := pp.process(tokens)
+	return append(out, tokens[len(tokens)-1])
+}
+
+// process 执行预处理指令并展开宏，返回的序列不包含 EOF
+func (pp *preprocessor) process(tokens []Token) []Token {
+	var out, text []Token
+	var conds []*condState
+	active := func() bool {
+		return len(conds) == 0 || conds[len(conds)-1].active
+	}
+
+	for i := 0; i < len(tokens) && tokens[i].Kind != TokenEOF; {
+		t := tokens[i]
+		if !(t.LineStart && t.is("#")) {
+			if active() {
+				text = append(text, t)
+			}
+			i++
+			continue
+		}
+
+		j := i + 1
+		for tokens[j].Kind != TokenEOF && !tokens[j].LineStart {
+			j++
+		}
+		line := tokens[i+1 : j]
+		i = j
+
+		// 指令可能会修改宏定义，先展开指令之前的代码
+		out = append(out, pp.expand(text)...)
+		text = nil
+		pp.directive(t, line, &conds, active())
+	}
+	out = append(out, pp.expand(text)...)
+	if len(conds) > 0 {
+		pp.errorf(tokens[len(tokens)-1].Start, "unterminated conditional directive")
+	}
+	return out
+}
+
+func (pp *preprocessor) directive(hash Token, line []Token, conds *[]*condState, active bool) {
+	if len(line) == 0 {
+		return
+	}
+	name, args := line[0].Value, line[1:]
+	top := func() *condState {
+		if len(*conds) == 0 {
+			pp.errorf(hash.Start, "#%s without #if", name)
+			return nil
+		}
+		return (*conds)[len(*conds)-1]
+	}
+
+	switch name {
+	case "if", "ifdef", "ifndef":
+		cond := false
+		if active {
+			cond = pp.evalDirectiveCondition(name, args, hash)
+		}
+		*conds = append(*conds, &condState{parent: active, active: active && cond, taken: cond})
+		return
+	case "elif", "elifdef", "elifndef":
+		state := top()
+		if state == nil {
+			return
+		}
+		if state.sawElse {
+			pp.errorf(hash.Start, "#%s after #else", name)
+		}
+		if !state.parent || state.taken {
+			state.active = false
+			return
+		}
+		cond := pp.evalDirectiveCondition(strings.TrimPrefix(name, "el"), args, hash)
+		state.active, state.taken = cond, cond
+		return
+	case "else":
+		if state := top(); state != nil {
+			state.active = state.parent && !state.taken
+			state.taken = true
+			state.sawElse = true
+		}
+		return
+	case "endif":
+		if top() != nil {
+			*conds = (*conds)[:len(*conds)-1]
+		}
+		return
+	}
+
+	if !active {
+		return
+	}
+	switch name {
+	case "define":
+		pp.define(hash, args)
+	case "undef":
+		if len(args) > 0 {
+			delete(pp.macros, args[0].Value)
+		}
+	case "include", "include_next", "import":
+		pp.include(hash, args)
+	case "error", "warning", "pragma", "line", "ident", "sccs", "assert", "unassert":
+	default:
+		if line[0].Kind != TokenNumber {
+			pp.errorf(line[0].Start, "invalid preprocessing directive #%s", name)
+		}
+	}
+}
+
+func (pp *preprocessor) define(hash Token, args []Token) {
+	if len(args) == 0 || args[0].Kind != TokenIdent {
+		pp.errorf(hash.Start, "macro names must be identifiers")
+		return
+	}
+	m := &Macro{Name: args[0].Value}
+	body := args[1:]
+	// 宏名之后紧跟 ( 才是函数式宏
+	if len(body) > 0 && body[0].is("(") && !body[0].Space {
+		m.FuncLike = true
+		i := 1
+		for ; i < len(body) && !body[i].is(")"); i++ {
+			t := body[i]
+			switch {
+			case t.is(","):
+			case t.is("..."):
+				m.Variadic = true
+				m.Params = append(m.Params, "__VA_ARGS__")
+			case t.Kind == TokenIdent:
+				if i+1 < len(body) && body[i+1].is("...") {
+					// GNU 风格的具名可变参数 args...
+					m.Variadic = true
+					i++
+				}
+				m.Params = append(m.Params, t.Value)
+			default:
+				pp.errorf(t.Start, "invalid macro parameter %v", t)
+				return
+			}
+		}
+		if i >= len(body) {
+			pp.errorf(hash.Start, "missing ')' in macro parameter list")
+			return
+		}
+		body = body[i+1:]
+	}
+	m.Body = body
+	pp.macros[m.Name] = m
+}
+
+// ===================== conditions =====================
+
+func (pp *preprocessor) evalDirectiveCondition(kind string, args []Token, hash Token) bool {
+	switch kind {
+	case "ifdef", "ifndef":
+		if len(args) == 0 || args[0].Kind != TokenIdent {
+			pp.errorf(hash.Start, "#%s expects a macro name", kind)
+			return false
+		}
+		_, ok := pp.macros[args[0].Value]
+		return ok == (kind == "ifdef")
+	default:
+		return pp.evalCondition(args, hash)
+	}
+}
+
+// evalCondition 计算 #if 的条件，无法计算时按照 false 处理
+func (pp *preprocessor) evalCondition(args []Token, hash Token) bool {
+	var replaced []Token
+	for i := 0; i < len(args); i++ {
+		t := args[i]
+		if t.Kind != TokenIdent {
+			replaced = append(replaced, t)
+			continue
+		}
+		switch {
+		case t.Value == "defined":
+			var name string
+			if i+1 < len(args) && args[i+1].is("(") {
+				if i+3 < len(args) && args[i+3].is(")") {
+					name = args[i+2].Value
+				}
+				i += 3
+			} else if i+1 < len(args) {
+				name = args[i+1].Value
+				i++
+			}
+			_, ok := pp.macros[name]
+			replaced = append(replaced, boolToken(ok, t))
+		case strings.HasPrefix(t.Value, "__has_"):
+			// __has_include、__has_attribute 等只支持 __has_include
+			end := skipBalanced(args, i+1)
+			ok := false
+			if t.Value == "__has_include" && i+2 < end-1 && pp.cfg.resolver != nil {
+				if name, system, found := pp.includeName(args[i+2:end-1], false); found {
+					_, _, ok = pp.cfg.resolver(name, system, pp.file)
+				}
+			}
+			replaced = append(replaced, boolToken(ok, t))
+			i = end - 1
+		default:
+			replaced = append(replaced, t)
+		}
+	}
+
+	expanded := pp.expand(replaced)
+	// 展开之后剩下的标识符都按照 0 处理
+	for i, t := range expanded {
+		if t.Kind == TokenIdent {
+			expanded[i] = boolToken(false, t)
+		}
+	}
+	if len(expanded) == 0 {
+		pp.errorf(hash.Start, "#if with no expression")
+		return false
+	}
+	expr, errs := parseTokens(append(expanded, Token{Kind: TokenEOF, Start: hash.End, End: hash.End}))
+	if len(errs) > 0 || expr == nil {
+		pp.errorf(hash.Start, "invalid expression in #if")
+		return false
+	}
+	value, ok := EvalInt(expr)
+	if !ok {
+		pp.errorf(hash.Start, "#if expression is not an integer constant")
+		return false
+	}
+	return value != 0
+}
+
+// skipBalanced 从 start 处的 ( 开始跳过一对括号，返回右括号之后的下标
+func skipBalanced(tokens []Token, start int) int {
+	if start >= len(tokens) || !tokens[start].is("(") {
+		return start
+	}
+	depth := 0
+	for i := start; i < len(tokens); i++ {
+		switch {
+		case tokens[i].is("("):
+			depth++
+		case tokens[i].is(")"):
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(tokens)
+}
+
+func boolToken(value bool, at Token) Token {
+	t := Token{Kind: TokenNumber, Value: "0", Start: at.Start, End: at.End, Space: at.Space}
+	if value {
+		t.Value = "1"
+	}
+	return t
+}
+
+// ===================== include =====================
+
+func (pp *preprocessor) include(hash Token, args []Token) {
+	if pp.cfg.resolver == nil {
+		return
+	}
+	name, system, ok := pp.includeName(args, true)
+	if !ok {
+		pp.errorf(hash.Start, "#include expects \"FILENAME\" or <FILENAME>")
+		return
+	}
+	if pp.depth >= maxIncludeDepth {
+		pp.errorf(hash.Start, "#include nested too deeply")
+		return
+	}
+	path, content, ok := pp.cfg.resolver(name, system, pp.file)
+	if !ok {
+		return
+	}
+	// 每个头文件只处理一次，相当于所有头文件都有 include guard
+	if _, ok := pp.included[path]; ok {
+		return
+	}
+	pp.included[path] = struct{}{}
+
+	savedFile := pp.file
+	pp.file = path
+	pp.depth++
+	tokens, _ := Tokenize(content)
+	out := pp.process(tokens)
+	pp.depth--
+	pp.file = savedFile
+
+	pp.headers = append(pp.headers, append(out, Token{Kind: TokenEOF, LineStart: true}))
+	pp.includes = append(pp.includes, path)
+}
+
+// includeName 解析 "file" 或 <file>，expandMacro 表示是否允许通过宏展开得到文件名
+func (pp *preprocessor) includeName(args []Token, expandMacro bool) (string, bool, bool) {
+	if len(args) == 0 {
+		return "", false, false
+	}
+	if args[0].Kind == TokenString && strings.HasPrefix(args[0].Value, "\"") {
+		return strings.Trim(args[0].Value, "\""), false, true
+	}
+	if args[0].is("<") {
+		var sb strings.Builder
+		for _, t := range args[1:] {
+			if t.is(">") {
+				return sb.String(), true, true
+			}
+			sb.WriteString(t.Value)
+		}
+		return "", false, false
+	}
+	if expandMacro {
+		return pp.includeName(pp.expand(args), false)
+	}
+	return "", false, false
+}
+
+// ===================== macro expansion =====================
+
+// tokenStream 是宏展开时的输入，展开的结果被压到栈顶重新扫描
+type tokenStream struct {
+	stack [][]Token
+}
+
+func (s *tokenStream) push(tokens []Token) {
+	if len(tokens) > 0 {
+		s.stack = append(s.stack, tokens)
+	}
+}
+
+func (s *tokenStream) peek() (Token, bool) {
+	for len(s.stack) > 0 {
+		top := s.stack[len(s.stack)-1]
+		if len(top) > 0 {
+			return top[0], true
+		}
+		s.stack = s.stack[:len(s.stack)-1]
+	}
+	return Token{}, false
+}
+
+func (s *tokenStream) next() (Token, bool) {
+	t, ok := s.peek()
+	if ok {
+		s.stack[len(s.stack)-1] = s.stack[len(s.stack)-1][1:]
+	}
+	return t, ok
+}
+
+// expand 展开 Token 序列中的宏，宏展开的结果会被重新扫描，hide set 保证同一个宏不会递归展开
+func (pp *preprocessor) expand(input []Token) []Token {
+	if len(input) == 0 {
+		return nil
+	}
+	s := &tokenStream{}
+	s.push(input)
+	var out []Token
+	for {
+		t, ok := s.next()
+		if !ok {
+			return out
+		}
+		if t.Kind != TokenIdent || t.hidden(t.Value) {
+			out = append(out, t)
+			continue
+		}
+		if builtin, ok := pp.builtinMacro(t); ok {
+			out = append(out, builtin)
+			continue
+		}
+		m, ok := pp.macros[t.Value]
+		if !ok {
+			out = append(out, t)
+			continue
+		}
+		if !m.FuncLike {
+			s.push(pp.substitute(m, nil, t, t.End))
+			continue
+		}
+		// 函数式宏的名字之后没有 ( 时不展开
+		if next, ok := s.peek(); !ok || !next.is("(") {
+			out = append(out, t)
+			continue
+		}
+		args, end, ok := pp.collectArgs(s, m, t)
+		if !ok {
+			out = append(out, t)
+			continue
+		}
+		s.push(pp.substitute(m, args, t, end))
+	}
+}
+
+func (pp *preprocessor) builtinMacro(t Token) (Token, bool) {
+	switch t.Value {
+	case "__FILE__":
+		return Token{Kind: TokenString, Value: strconv.Quote(pp.file), Start: t.Start, End: t.End, Space: t.Space}, true
+	case "__LINE__":
+		return Token{Kind: TokenNumber, Value: strconv.Itoa(t.Start.Line + 1), Start: t.Start, End: t.End, Space: t.Space}, true
+	case "__DATE__", "__TIME__", "__TIMESTAMP__":
+		return Token{Kind: TokenString, Value: `""`, Start: t.Start, End: t.End, Space: t.Space}, true
+	}
+	return Token{}, false
+}
+
+// collectArgs 收集函数式宏的实参，返回实参以及右括号的结束位置
+func (pp *preprocessor) collectArgs(s *tokenStream, m *Macro, name Token) ([][]Token, ast.Position, bool) {
+	s.next() // (
+	var args [][]Token
+	var current []Token
+	depth := 0
+	for {
+		t, ok := s.next()
+		if !ok {
+			pp.errorf(name.Start, "unterminated argument list invoking macro %q", m.Name)
+			return nil, name.End, false
+		}
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			if depth == 0 {
+				args = append(args, current)
+				if len(m.Params) == 0 && len(args) == 1 && len(args[0]) == 0 {
+					args = nil
+				}
+				if len(args) > len(m.Params) {
+					pp.errorf(name.Start, "macro %q passed %d arguments, but takes just %d", m.Name, len(args), len(m.Params))
+					args = args[:len(m.Params)]
+				}
+				for len(args) < len(m.Params) {
+					args = append(args, nil)
+				}
+				return args, t.End, true
+			}
+			depth--
+		case t.is(",") && depth == 0:
+			// 可变参数中的逗号属于 __VA_ARGS__
+			if !m.Variadic || len(args) < len(m.Params)-1 {
+				args = append(args, current)
+				current = nil
+				continue
+			}
+		}
+		current = append(current, t)
+	}
+}
+
+// substitute 用实参替换宏体中的形参，宏体中的 Token 使用宏调用处的位置
+func (pp *preprocessor) substitute(m *Macro, args [][]Token, name Token, end ast.Position) []Token {
+	paramIndex := func(t Token) int {
+		if !m.FuncLike || t.Kind != TokenIdent {
+			return -1
+		}
+		for i, param := range m.Params {
+			if param == t.Value {
+				return i
+			}
+		}
+		return -1
+	}
+	expanded := make(map[int][]Token)
+	expandedArg := func(i int) []Token {
+		if ret, ok := expanded[i]; ok {
+			return ret
+		}
+		ret := pp.expand(args[i])
+		expanded[i] = ret
+		return ret
+	}
+	relocate := func(t Token) Token {
+		t.Start, t.End = name.Start, end
+		return t
+	}
+
+	var out []Token
+	body := m.Body
+	for i := 0; i < len(body); i++ {
+		t := body[i]
+		if t.is("#") && i+1 < len(body) {
+			if idx := paramIndex(body[i+1]); idx >= 0 {
+				out = append(out, relocate(stringify(args[idx])))
+				i++
+				continue
+			}
+		}
+		if t.is("##") && i+1 < len(body) {
+			i++
+			var rhs []Token
+			if idx := paramIndex(body[i]); idx >= 0 {
+				rhs = args[idx]
+				// GNU 扩展：, ## __VA_ARGS__ 在可变参数为空时删除前面的逗号，否则不进行拼接
+				if m.Variadic && idx == len(m.Params)-1 && len(out) > 0 && out[len(out)-1].is(",") {
+					if len(rhs) == 0 {
+						out = out[:len(out)-1]
+					} else {
+						out = append(out, rhs...)
+					}
+					continue
+				}
+			} else {
+				rhs = []Token{relocate(body[i])}
+			}
+			if len(rhs) == 0 {
+				continue
+			}
+			if len(out) == 0 {
+				out = append(out, rhs...)
+				continue
+			}
+			pasted := pp.paste(out[len(out)-1], rhs[0])
+			out = append(out[:len(out)-1], pasted...)
+			out = append(out, rhs[1:]...)
+			continue
+		}
+		if idx := paramIndex(t); idx >= 0 {
+			// 作为 ## 操作数的实参不展开
+			if i+1 < len(body) && body[i+1].is("##") {
+				out = append(out, args[idx]...)
+			} else {
+				out = append(out, expandedArg(idx)...)
+			}
+			continue
+		}
+		out = append(out, relocate(t))
+	}
+
+	ret := make([]Token, len(out))
+	for i, t := range out {
+		t.hide = addHide(t.hide, name.hide, m.Name)
+		if i == 0 {
+			t.Space = name.Space
+		}
+		ret[i] = t
+	}
+	return ret
+}
+
+func addHide(hide []string, more []string, name string) []string {
+	ret := make([]string, 0, len(hide)+len(more)+1)
+	ret = append(ret, hide...)
+	for _, h := range append(more, name) {
+		found := false
+		for _, existed := range ret {
+			if existed == h {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, h)
+		}
+	}
+	return ret
+}
+
+// paste 实现 ## 运算，拼接结果不是单个 Token 时保留两个 Token
+func (pp *preprocessor) paste(left, right Token) []Token {
+	tokens, errs := Tokenize(left.Value + right.Value)
+	if len(errs) > 0 || len(tokens) != 2 {
+		pp.errorf(left.Start, "pasting %q and %q does not give a valid preprocessing token", left.Value, right.Value)
+		return []Token{left, right}
+	}
+	t := tokens[0]
+	t.Start, t.End = left.Start, right.End
+	t.Space = left.Space
+	t.hide = left.hide
+	return []Token{t}
+}
+
+// stringify 实现 # 运算
+func stringify(tokens []Token) Token {
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t.Space {
+			sb.WriteByte(' ')
+		}
+		if t.Kind == TokenString || t.Kind == TokenChar {
+			sb.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(t.Value))
+			continue
+		}
+		sb.WriteString(t.Value)
+	}
+	return Token{Kind: TokenString, Value: `"` + sb.String() + `"`}
+}
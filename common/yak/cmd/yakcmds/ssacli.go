@@ -13,11 +13,12 @@ import (
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 
 	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfbuildin"
 	"github.com/yaklang/yaklang/common/syntaxflow/sfcompletion"
 	"github.com/yaklang/yaklang/common/syntaxflow/sfdb"
+	"github.com/yaklang/yaklang/common/syntaxflow/sftest"
 	"github.com/yaklang/yaklang/common/utils/bizhelper"
 	"github.com/yaklang/yaklang/common/yak/ssaapi/sfreport"
-	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
 	"golang.org/x/exp/slices"
 	"io"
 	"io/fs"
@@ -420,56 +421,110 @@ var syntaxFlowCreate = &cli.Command{
 var syntaxFlowTest = &cli.Command{
 	Name:    "syntaxflow-test",
 	Aliases: []string{"sftest", "sf-test"},
+	Usage:   "run file:// samples declared in SyntaxFlow rule desc and check alert count",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "buildin",
+			Usage: "test all buildin rules (syntaxflow/sfbuildin)",
+		},
+		cli.StringFlag{
+			Name:  "filter",
+			Usage: "only test rule file which path contains this keyword",
+		},
+		cli.StringFlag{
+			Name:  "junit",
+			Usage: "write JUnit XML report to file",
+		},
+		cli.StringFlag{
+			Name:  "sarif",
+			Usage: "write SARIF report to file",
+		},
+	},
 	Action: func(c *cli.Context) error {
-		testingTInstance := utils.NewRequireTestT(func(msg string, args ...any) {
-			log.Errorf(msg, args...)
-		}, func() {})
-
-		fsi := filesys.NewLocalFs()
-
-		checkViaPath := func(pathRaw string) error {
-			err := filesys.Recursive(pathRaw, filesys.WithFileSystem(fsi), filesys.WithFileStat(func(s string, info fs.FileInfo) error {
-				if fsi.Ext(s) == ".sf" || fsi.Ext(s) == "sf" {
-					raw, err := fsi.ReadFile(s)
-					if err != nil {
-						return err
-					}
-					err = ssatest.EvaluateVerifyFilesystem(string(raw), testingTInstance)
-					if err != nil {
-						return err
-					}
-					return nil
+		opts := []sftest.Option{
+			sftest.WithResultCallback(func(result *sftest.RuleResult) {
+				switch {
+				case result.Skipped():
+					log.Debugf("[SKIP] %s: no sample", result.RuleName)
+				case result.Passed():
+					log.Infof("[PASS] %s (%v)", result.RuleName, result.Duration)
+				default:
+					log.Errorf("[FAIL] %s: %v", result.RuleName, result.Error())
 				}
-				return nil
+			}),
+		}
+		if filter := c.String("filter"); filter != "" {
+			opts = append(opts, sftest.WithRuleFileFilter(func(path string) bool {
+				return strings.Contains(path, filter)
 			}))
+		}
+
+		report := sftest.NewReport()
+		merge := func(r *sftest.Report) {
+			for _, result := range r.Rules {
+				report.AddRuleResult(result)
+			}
+		}
+		if c.Bool("buildin") {
+			// lib 规则通过 include 引用，需要先同步到数据库
+			SyncEmbedRule()
+			r, err := sftest.RunFileSystem(filesys.NewEmbedFS(*sfbuildin.GetRuleFS()), ".", opts...)
 			if err != nil {
 				return err
 			}
-			return nil
+			merge(r)
 		}
 
-		empty := len(c.Args()) <= 0
-		if empty {
-			return checkViaPath(".")
+		fsi := filesys.NewLocalFs()
+		targets := []string(c.Args())
+		if len(targets) == 0 && !c.Bool("buildin") {
+			targets = []string{"."}
 		}
-		for _, i := range c.Args() {
-			if utils.IsDir(i) {
-				err := checkViaPath(i)
+		for _, target := range targets {
+			if utils.IsDir(target) {
+				r, err := sftest.RunFileSystem(fsi, target, opts...)
 				if err != nil {
 					return err
 				}
+				merge(r)
+				continue
 			}
-			if ret := utils.GetFirstExistedFile(i); ret != "" {
-				raw, err := fsi.ReadFile(ret)
-				if err != nil {
-					return err
-				}
-				err = ssatest.EvaluateVerifyFilesystem(string(raw), testingTInstance)
-				if err != nil {
-					return err
-				}
+			ret := utils.GetFirstExistedFile(target)
+			if ret == "" {
+				return utils.Errorf("rule file %s not found", target)
 			}
+			raw, err := fsi.ReadFile(ret)
+			if err != nil {
+				return err
+			}
+			report.AddRuleResult(sftest.RunRuleContent(filepath.Base(ret), string(raw), opts...))
+		}
+
+		writeReport := func(filename string, write func(io.Writer) error) error {
+			if filename == "" {
+				return nil
+			}
+			fp, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o666)
+			if err != nil {
+				return err
+			}
+			defer fp.Close()
+			if err := write(fp); err != nil {
+				return err
+			}
+			log.Infof("write report to %s", filename)
+			return nil
+		}
+		if err := writeReport(c.String("junit"), report.WriteJUnit); err != nil {
+			return err
+		}
+		if err := writeReport(c.String("sarif"), report.WriteSARIF); err != nil {
+			return err
+		}
 
+		log.Info(report.String())
+		if !report.Passed() {
+			return utils.Error(report.String())
 		}
 		return nil
 	},
@@ -2,6 +2,8 @@ package yakcmds_test
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -45,3 +47,39 @@ func TestRecompileWarn(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "please use `re-compile` flag to re-compile or change program name")
 }
+
+func TestSyntaxFlowTestReport(t *testing.T) {
+	tmpDir := t.TempDir()
+	rule := `
+desc(
+	lang: yaklang,
+	alert_min: 1,
+	'file://a.yak': <<<CODE
+print("a")
+CODE
+)
+print(* as $target)
+alert $target
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "print.sf"), []byte(rule), 0o644))
+
+	app := cli.NewApp()
+	addCommands(app, yakcmds.SSACompilerCommands...)
+	junit := filepath.Join(tmpDir, "junit.xml")
+	sarif := filepath.Join(tmpDir, "report.sarif")
+	err := app.Run([]string{"yak", "sf-test", "--junit", junit, "--sarif", sarif, tmpDir})
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(junit)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `<testsuite name="print.sf"`)
+	raw, err = os.ReadFile(sarif)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"kind": "pass"`)
+
+	// 告警数量不满足要求时命令失败
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "print.sf"), []byte(strings.Replace(rule, "alert_min: 1", "alert_min: 2", 1)), 0o644))
+	err = app.Run([]string{"yak", "sf-test", tmpDir})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed 1")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/coreplugin"
 	"github.com/yaklang/yaklang/common/mcp"
+	"github.com/yaklang/yaklang/common/mq"
 	"github.com/yaklang/yaklang/common/syntaxflow/sfbuildin"
 	"github.com/yaklang/yaklang/common/twofa"
 	regexp_utils "github.com/yaklang/yaklang/common/utils/regexp-utils"
@@ -48,6 +49,7 @@ import (
 	"github.com/yaklang/yaklang/common/yak/yaklib"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 	"github.com/yaklang/yaklang/scannode"
+	"github.com/yaklang/yaklang/scannode/distscan"
 )
 
 var UtilsCommands = []*cli.Command{
@@ -784,6 +786,81 @@ var DistributionCommands = []*cli.Command{
 		},
 		Flags: spec.GetCliBasicConfig("scannode"),
 	},
+	{
+		Name:  "dist-scan",
+		Usage: "split port scan across scannode workers (yak mq), merge results into current project",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "hosts,t", Usage: "扫描目标"},
+			cli.StringFlag{Name: "ports,p", Usage: "扫描端口", Value: "22,80,443,3306,3389,6379,8080,8443"},
+			cli.StringFlag{Name: "broker", Usage: "启动内置的 AMQP 服务并监听该地址，节点使用 yak mq --server --mq-port 连接", Value: "127.0.0.1:5676"},
+			cli.StringFlag{Name: "amqp-url", Usage: "使用外部 AMQP 服务（例如 RabbitMQ）而不是内置服务"},
+			cli.IntFlag{Name: "nodes", Usage: "开始扫描前等待的节点数量", Value: 1},
+			cli.IntFlag{Name: "wait", Usage: "等待节点上线的时间（秒）", Value: 60},
+			cli.IntFlag{Name: "shard-hosts", Usage: "每个分片的主机数量", Value: 8},
+			cli.IntFlag{Name: "shard-ports", Usage: "每个分片的端口数量", Value: 1000},
+			cli.StringFlag{Name: "mode", Usage: "port / hybrid，hybrid 模式会在开放端口上执行插件", Value: scannode.PortScanMode_Port},
+			cli.StringSliceFlag{Name: "plugin", Usage: "hybrid 模式使用的插件名"},
+			cli.IntFlag{Name: "concurrent", Usage: "每个节点的扫描并发", Value: 50},
+			cli.IntFlag{Name: "timeout", Usage: "单个探测的超时时间（秒）", Value: 5},
+			cli.IntFlag{Name: "node-timeout", Usage: "分片多久没有进度即认为节点失效并重新下发（秒）", Value: 30},
+			cli.StringFlag{Name: "task-name", Usage: "保存端口时使用的任务名"},
+		},
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			amqpUrl := c.String("amqp-url")
+			if amqpUrl == "" {
+				broker, err := mq.NewLocalBroker(ctx, c.String("broker"))
+				if err != nil {
+					return err
+				}
+				defer broker.Close()
+				amqpUrl = broker.AMQPUrl()
+				log.Infof("in-process amqp broker listening on %v", broker.Addr())
+			}
+
+			controller, err := distscan.NewController(ctx, amqpUrl, distscan.WithNodeTimeout(time.Duration(c.Int("node-timeout"))*time.Second))
+			if err != nil {
+				return err
+			}
+			defer controller.Close()
+			if err := controller.Start(); err != nil {
+				return err
+			}
+
+			waitCtx, waitCancel := context.WithTimeout(ctx, time.Duration(c.Int("wait"))*time.Second)
+			err = controller.WaitNodes(waitCtx, c.Int("nodes"))
+			waitCancel()
+			if err != nil {
+				return err
+			}
+			for _, node := range controller.Nodes() {
+				log.Infof("scan node online: %v", node.NodeId)
+			}
+
+			status, err := controller.Scan(
+				ctx, c.String("hosts"), c.String("ports"),
+				distscan.WithShardHosts(c.Int("shard-hosts")),
+				distscan.WithShardPorts(c.Int("shard-ports")),
+				distscan.WithMode(c.String("mode")),
+				distscan.WithPlugins(c.StringSlice("plugin")...),
+				distscan.WithConcurrent(c.Int("concurrent")),
+				distscan.WithProbeTimeout(c.Int("timeout")),
+				distscan.WithTaskName(c.String("task-name")),
+				distscan.WithDatabase(consts.GetGormProjectDatabase()),
+				distscan.WithProgressHandler(func(status *distscan.JobStatus) {
+					log.Infof("progress: %.2f%% shards: %d/%d running: %d open ports: %d risks: %d",
+						status.Progress*100, status.Finished, status.Total, status.Running, status.OpenPorts, status.Risks)
+				}),
+			)
+			if status != nil {
+				log.Infof("distributed scan %v done: %d open ports, %d risks, %d requeued, %d/%d shards failed (runtime id: %v)",
+					status.TaskId, status.OpenPorts, status.Risks, status.Requeued, status.Failed, status.Total, status.RuntimeId)
+			}
+			return err
+		},
+	},
 	{
 		Name:  "tunnel",
 		Usage: "Create Tunnel For CyberTunnel Service",
@@ -0,0 +1,211 @@
+lexer grammar Python3Lexer;
+
+// INDENT/DEDENT 以及括号中换行的处理见 parser/python3_lexer_base.go
+options {
+    superClass = Python3LexerBase;
+}
+
+tokens {
+    INDENT,
+    DEDENT
+}
+
+STRING
+    : STRING_PREFIX? (SHORT_STRING | LONG_STRING)
+    ;
+
+INTEGER
+    : DECIMAL_INTEGER
+    | OCT_INTEGER
+    | HEX_INTEGER
+    | BIN_INTEGER
+    ;
+
+FLOAT_NUMBER
+    : POINT_FLOAT
+    | EXPONENT_FLOAT
+    ;
+
+IMAG_NUMBER
+    : (POINT_FLOAT | EXPONENT_FLOAT | DIGIT_PART) [jJ]
+    ;
+
+AND: 'and';
+AS: 'as';
+ASSERT: 'assert';
+ASYNC: 'async';
+AWAIT: 'await';
+BREAK: 'break';
+CLASS: 'class';
+CONTINUE: 'continue';
+DEF: 'def';
+DEL: 'del';
+ELIF: 'elif';
+ELSE: 'else';
+EXCEPT: 'except';
+FALSE: 'False';
+FINALLY: 'finally';
+FOR: 'for';
+FROM: 'from';
+GLOBAL: 'global';
+IF: 'if';
+IMPORT: 'import';
+IN: 'in';
+IS: 'is';
+LAMBDA: 'lambda';
+NONE: 'None';
+NONLOCAL: 'nonlocal';
+NOT: 'not';
+OR: 'or';
+PASS: 'pass';
+RAISE: 'raise';
+RETURN: 'return';
+TRUE: 'True';
+TRY: 'try';
+WHILE: 'while';
+WITH: 'with';
+YIELD: 'yield';
+
+// 行首缩进包含在 NEWLINE 中，由 Python3LexerBase 转换为 INDENT/DEDENT
+NEWLINE
+    : ('\r'? '\n' | '\r' | '\f') SPACES?
+    ;
+
+NAME
+    : ID_START ID_CONTINUE*
+    ;
+
+DOT: '.';
+ELLIPSIS: '...';
+STAR: '*';
+OPEN_PAREN: '(';
+CLOSE_PAREN: ')';
+COMMA: ',';
+COLON: ':';
+SEMI_COLON: ';';
+POWER: '**';
+ASSIGN: '=';
+OPEN_BRACK: '[';
+CLOSE_BRACK: ']';
+OR_OP: '|';
+XOR: '^';
+AND_OP: '&';
+LEFT_SHIFT: '<<';
+RIGHT_SHIFT: '>>';
+ADD: '+';
+MINUS: '-';
+DIV: '/';
+MOD: '%';
+IDIV: '//';
+NOT_OP: '~';
+OPEN_BRACE: '{';
+CLOSE_BRACE: '}';
+LESS_THAN: '<';
+GREATER_THAN: '>';
+EQUALS: '==';
+GT_EQ: '>=';
+LT_EQ: '<=';
+NOT_EQ_1: '<>';
+NOT_EQ_2: '!=';
+AT: '@';
+ARROW: '->';
+WALRUS: ':=';
+ADD_ASSIGN: '+=';
+SUB_ASSIGN: '-=';
+MULT_ASSIGN: '*=';
+AT_ASSIGN: '@=';
+DIV_ASSIGN: '/=';
+MOD_ASSIGN: '%=';
+AND_ASSIGN: '&=';
+OR_ASSIGN: '|=';
+XOR_ASSIGN: '^=';
+LEFT_SHIFT_ASSIGN: '<<=';
+RIGHT_SHIFT_ASSIGN: '>>=';
+POWER_ASSIGN: '**=';
+IDIV_ASSIGN: '//=';
+
+SKIP_
+    : (SPACES | LINE_JOINING) -> skip
+    ;
+
+// 注释放在 HIDDEN 通道而不是 skip，长注释逐字符匹配时才能复用 DFA 状态
+COMMENT
+    : '#' ~[\r\n\f]* -> channel(HIDDEN)
+    ;
+
+ERRORTOKEN
+    : .
+    ;
+
+fragment STRING_PREFIX
+    : [rRuUfFbB]
+    | [rR] [bBfF]
+    | [bBfF] [rR]
+    ;
+
+fragment SHORT_STRING
+    : '\'' (STRING_ESCAPE_SEQ | ~[\\\r\n\f'])* '\''
+    | '"' (STRING_ESCAPE_SEQ | ~[\\\r\n\f"])* '"'
+    ;
+
+fragment LONG_STRING
+    : '\'\'\'' LONG_STRING_ITEM*? '\'\'\''
+    | '"""' LONG_STRING_ITEM*? '"""'
+    ;
+
+fragment LONG_STRING_ITEM
+    : ~[\\]
+    | STRING_ESCAPE_SEQ
+    ;
+
+fragment STRING_ESCAPE_SEQ
+    : '\\' '\r' '\n'
+    | '\\' .
+    ;
+
+fragment DECIMAL_INTEGER
+    : [1-9] ('_'? [0-9])*
+    | '0'+ ('_'? '0')*
+    ;
+
+fragment OCT_INTEGER
+    : '0' [oO] ('_'? [0-7])+
+    ;
+
+fragment HEX_INTEGER
+    : '0' [xX] ('_'? [0-9a-fA-F])+
+    ;
+
+fragment BIN_INTEGER
+    : '0' [bB] ('_'? [01])+
+    ;
+
+fragment DIGIT_PART
+    : [0-9] ('_'? [0-9])*
+    ;
+
+fragment POINT_FLOAT
+    : DIGIT_PART? '.' DIGIT_PART
+    | DIGIT_PART '.'
+    ;
+
+fragment EXPONENT_FLOAT
+    : (DIGIT_PART | POINT_FLOAT) [eE] [+-]? DIGIT_PART
+    ;
+
+fragment SPACES
+    : [ \t]+
+    ;
+
+fragment LINE_JOINING
+    : '\\' SPACES? ('\r'? '\n' | '\r' | '\f')
+    ;
+
+// 非 ASCII 字符都允许出现在标识符中
+fragment ID_START
+    : [a-zA-Z_\u0080-\uFFFF]
+    ;
+
+fragment ID_CONTINUE
+    : [a-zA-Z0-9_\u0080-\uFFFF]
+    ;
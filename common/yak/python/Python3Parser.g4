@@ -0,0 +1,448 @@
+parser grammar Python3Parser;
+
+options {
+    tokenVocab = Python3Lexer;
+}
+
+fileInput
+    : (NEWLINE | stmt)* EOF
+    ;
+
+decorator
+    : AT namedexprTest NEWLINE
+    ;
+
+decorated
+    : decorator+ (classdef | funcdef | asyncFuncdef)
+    ;
+
+asyncFuncdef
+    : ASYNC funcdef
+    ;
+
+funcdef
+    : DEF NAME parameters (ARROW test)? COLON suite
+    ;
+
+parameters
+    : OPEN_PAREN typedargslist? CLOSE_PAREN
+    ;
+
+// 参数的顺序（位置参数、'/'、'*'、关键字参数、'**'）不在语法中检查
+typedargslist
+    : typedarg (COMMA typedarg)* COMMA?
+    ;
+
+typedarg
+    : tfpdef (ASSIGN test)?
+    | STAR tfpdef?
+    | POWER tfpdef
+    | DIV
+    ;
+
+tfpdef
+    : NAME (COLON test)?
+    ;
+
+varargslist
+    : vararg (COMMA vararg)* COMMA?
+    ;
+
+vararg
+    : NAME (ASSIGN test)?
+    | STAR NAME?
+    | POWER NAME
+    | DIV
+    ;
+
+stmt
+    : simpleStmts
+    | compoundStmt
+    ;
+
+simpleStmts
+    : smallStmt (SEMI_COLON smallStmt)* SEMI_COLON? NEWLINE
+    ;
+
+smallStmt
+    : exprStmt
+    | delStmt
+    | passStmt
+    | flowStmt
+    | importStmt
+    | globalStmt
+    | nonlocalStmt
+    | assertStmt
+    ;
+
+// a = b = 1 中除最后一个 assignValue 以外都是赋值目标
+exprStmt
+    : testlistStarExpr (annassign | augassign assignValue | (ASSIGN assignValue)*)
+    ;
+
+annassign
+    : COLON test (ASSIGN assignValue)?
+    ;
+
+assignValue
+    : yieldExpr
+    | testlistStarExpr
+    ;
+
+testlistStarExpr
+    : (test | starExpr) (COMMA (test | starExpr))* COMMA?
+    ;
+
+augassign
+    : ADD_ASSIGN
+    | SUB_ASSIGN
+    | MULT_ASSIGN
+    | AT_ASSIGN
+    | DIV_ASSIGN
+    | MOD_ASSIGN
+    | AND_ASSIGN
+    | OR_ASSIGN
+    | XOR_ASSIGN
+    | LEFT_SHIFT_ASSIGN
+    | RIGHT_SHIFT_ASSIGN
+    | POWER_ASSIGN
+    | IDIV_ASSIGN
+    ;
+
+delStmt
+    : DEL exprlist
+    ;
+
+passStmt
+    : PASS
+    ;
+
+flowStmt
+    : breakStmt
+    | continueStmt
+    | returnStmt
+    | raiseStmt
+    | yieldStmt
+    ;
+
+breakStmt
+    : BREAK
+    ;
+
+continueStmt
+    : CONTINUE
+    ;
+
+returnStmt
+    : RETURN testlistStarExpr?
+    ;
+
+yieldStmt
+    : yieldExpr
+    ;
+
+raiseStmt
+    : RAISE (test (FROM test)?)?
+    ;
+
+importStmt
+    : importName
+    | importFrom
+    ;
+
+importName
+    : IMPORT dottedAsNames
+    ;
+
+// from 之后的 '.' 表示相对导入的层级，'...' 是三层
+importFrom
+    : FROM (DOT | ELLIPSIS)* dottedName IMPORT importTargets
+    | FROM (DOT | ELLIPSIS)+ IMPORT importTargets
+    ;
+
+importTargets
+    : STAR
+    | OPEN_PAREN importAsNames COMMA? CLOSE_PAREN
+    | importAsNames
+    ;
+
+importAsName
+    : NAME (AS NAME)?
+    ;
+
+dottedAsName
+    : dottedName (AS NAME)?
+    ;
+
+importAsNames
+    : importAsName (COMMA importAsName)*
+    ;
+
+dottedAsNames
+    : dottedAsName (COMMA dottedAsName)*
+    ;
+
+dottedName
+    : NAME (DOT NAME)*
+    ;
+
+globalStmt
+    : GLOBAL NAME (COMMA NAME)*
+    ;
+
+nonlocalStmt
+    : NONLOCAL NAME (COMMA NAME)*
+    ;
+
+assertStmt
+    : ASSERT test (COMMA test)?
+    ;
+
+compoundStmt
+    : ifStmt
+    | whileStmt
+    | forStmt
+    | tryStmt
+    | withStmt
+    | funcdef
+    | classdef
+    | decorated
+    | asyncStmt
+    ;
+
+asyncStmt
+    : ASYNC (funcdef | withStmt | forStmt)
+    ;
+
+ifStmt
+    : IF namedexprTest COLON suite (ELIF namedexprTest COLON suite)* (ELSE COLON suite)?
+    ;
+
+whileStmt
+    : WHILE namedexprTest COLON suite (ELSE COLON suite)?
+    ;
+
+forStmt
+    : FOR exprlist IN testlist COLON suite (ELSE COLON suite)?
+    ;
+
+tryStmt
+    : TRY COLON suite (exceptClause+ elseClause? finallyClause? | finallyClause)
+    ;
+
+exceptClause
+    : EXCEPT (test (AS NAME)?)? COLON suite
+    ;
+
+elseClause
+    : ELSE COLON suite
+    ;
+
+finallyClause
+    : FINALLY COLON suite
+    ;
+
+withStmt
+    : WITH (OPEN_PAREN withItem (COMMA withItem)* COMMA? CLOSE_PAREN | withItem (COMMA withItem)*) COLON suite
+    ;
+
+withItem
+    : test (AS expr)?
+    ;
+
+suite
+    : simpleStmts
+    | NEWLINE INDENT stmt+ DEDENT
+    ;
+
+namedexprTest
+    : test (WALRUS test)?
+    ;
+
+test
+    : orTest (IF orTest ELSE test)?
+    | lambdef
+    ;
+
+testNocond
+    : orTest
+    | lambdefNocond
+    ;
+
+lambdef
+    : LAMBDA varargslist? COLON test
+    ;
+
+lambdefNocond
+    : LAMBDA varargslist? COLON testNocond
+    ;
+
+orTest
+    : andTest (OR andTest)*
+    ;
+
+andTest
+    : notTest (AND notTest)*
+    ;
+
+notTest
+    : NOT notTest
+    | comparison
+    ;
+
+comparison
+    : expr (compOp expr)*
+    ;
+
+compOp
+    : LESS_THAN
+    | GREATER_THAN
+    | EQUALS
+    | GT_EQ
+    | LT_EQ
+    | NOT_EQ_1
+    | NOT_EQ_2
+    | IN
+    | NOT IN
+    | IS
+    | IS NOT
+    ;
+
+starExpr
+    : STAR expr
+    ;
+
+expr
+    : xorExpr (OR_OP xorExpr)*
+    ;
+
+xorExpr
+    : andExpr (XOR andExpr)*
+    ;
+
+andExpr
+    : shiftExpr (AND_OP shiftExpr)*
+    ;
+
+shiftExpr
+    : arithExpr ((LEFT_SHIFT | RIGHT_SHIFT) arithExpr)*
+    ;
+
+arithExpr
+    : term ((ADD | MINUS) term)*
+    ;
+
+term
+    : factor ((STAR | AT | DIV | MOD | IDIV) factor)*
+    ;
+
+factor
+    : (ADD | MINUS | NOT_OP) factor
+    | power
+    ;
+
+power
+    : atomExpr (POWER factor)?
+    ;
+
+atomExpr
+    : AWAIT? atom trailer*
+    ;
+
+atom
+    : OPEN_PAREN (yieldExpr | testlistComp)? CLOSE_PAREN
+    | OPEN_BRACK testlistComp? CLOSE_BRACK
+    | OPEN_BRACE dictorsetmaker? CLOSE_BRACE
+    | NAME
+    | number
+    | STRING+
+    | ELLIPSIS
+    | NONE
+    | TRUE
+    | FALSE
+    ;
+
+number
+    : INTEGER
+    | FLOAT_NUMBER
+    | IMAG_NUMBER
+    ;
+
+testlistComp
+    : (namedexprTest | starExpr) (compFor | (COMMA (namedexprTest | starExpr))* COMMA?)
+    ;
+
+trailer
+    : OPEN_PAREN arglist? CLOSE_PAREN
+    | OPEN_BRACK subscriptlist CLOSE_BRACK
+    | DOT NAME
+    ;
+
+subscriptlist
+    : subscript (COMMA subscript)* COMMA?
+    ;
+
+subscript
+    : test
+    | lower=test? COLON upper=test? sliceop?
+    ;
+
+sliceop
+    : COLON test?
+    ;
+
+exprlist
+    : (expr | starExpr) (COMMA (expr | starExpr))* COMMA?
+    ;
+
+testlist
+    : test (COMMA test)* COMMA?
+    ;
+
+dictorsetmaker
+    : dictEntry (compFor | (COMMA dictEntry)* COMMA?)
+    | (test | starExpr) (compFor | (COMMA (test | starExpr))* COMMA?)
+    ;
+
+// '**' expr 是字典展开 {**other}
+dictEntry
+    : test COLON test
+    | POWER expr
+    ;
+
+classdef
+    : CLASS NAME (OPEN_PAREN arglist? CLOSE_PAREN)? COLON suite
+    ;
+
+arglist
+    : argument (COMMA argument)* COMMA?
+    ;
+
+argument
+    : namedexprTest compFor?
+    | NAME ASSIGN test
+    | POWER test
+    | STAR test
+    ;
+
+compIter
+    : compFor
+    | compIf
+    ;
+
+compFor
+    : ASYNC? FOR exprlist IN orTest compIter?
+    ;
+
+compIf
+    : IF testNocond compIter?
+    ;
+
+yieldExpr
+    : YIELD yieldArg?
+    ;
+
+yieldArg
+    : FROM test
+    | testlistStarExpr
+    ;
@@ -0,0 +1,5 @@
+#!/bin/sh
+
+rm ./parser/*.tokens
+rm ./parser/*.interp
+antlr -Dlanguage=Go -package pythonparser ./Python3Lexer.g4 ./Python3Parser.g4 -o parser -no-listener -visitor
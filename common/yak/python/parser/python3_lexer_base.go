@@ -0,0 +1,124 @@
+package pythonparser
+
+import "github.com/antlr/antlr4/runtime/Go/antlr/v4"
+
+// Python3LexerBase 处理 Python 的缩进：括号中的换行以及空行、只有注释的行会被忽略，
+// 行首缩进的变化转换为 INDENT/DEDENT，文件结束时补齐 NEWLINE 和 DEDENT
+type Python3LexerBase struct {
+	*antlr.BaseLexer
+
+	// pending 保存已经生成但还未返回的 Token
+	pending []antlr.Token
+	indents []int
+	// opened 是当前未闭合的括号数量
+	opened    int
+	lastToken antlr.Token
+}
+
+// NextToken from the character stream.
+func (l *Python3LexerBase) NextToken() antlr.Token {
+	for {
+		if len(l.pending) > 0 {
+			next := l.pending[0]
+			l.pending = l.pending[1:]
+			l.lastToken = next
+			return next
+		}
+
+		next := l.BaseLexer.NextToken()
+		switch next.GetTokenType() {
+		case Python3LexerOPEN_PAREN, Python3LexerOPEN_BRACK, Python3LexerOPEN_BRACE:
+			l.opened++
+		case Python3LexerCLOSE_PAREN, Python3LexerCLOSE_BRACK, Python3LexerCLOSE_BRACE:
+			if l.opened > 0 {
+				l.opened--
+			}
+		case Python3LexerNEWLINE:
+			// 括号中的换行不结束逻辑行
+			if l.opened == 0 && l.lastToken != nil {
+				l.onNewline(next)
+			}
+			continue
+		case antlr.TokenEOF:
+			l.onEOF(next)
+			continue
+		}
+		if next.GetChannel() == antlr.TokenDefaultChannel {
+			l.lastToken = next
+		}
+		return next
+	}
+}
+
+func (l *Python3LexerBase) onNewline(token antlr.Token) {
+	text := token.GetText()
+	lineBreak, indent := len(text), 0
+	for i, c := range text {
+		if c == ' ' || c == '\t' {
+			lineBreak = i
+			break
+		}
+	}
+	for _, c := range text[lineBreak:] {
+		if c == '\t' {
+			// tab 缩进到下一个 8 的倍数
+			indent += 8 - indent%8
+		} else {
+			indent++
+		}
+	}
+
+	// 逻辑行在第一个换行处结束，NEWLINE 只保留换行符
+	if l.lastToken.GetTokenType() != Python3LexerNEWLINE {
+		l.pending = append(l.pending, l.GetTokenFactory().Create(
+			l.GetTokenSourceCharStreamPair(), Python3LexerNEWLINE, text[:lineBreak], antlr.TokenDefaultChannel,
+			token.GetStart(), token.GetStart()+lineBreak-1,
+			token.GetLine(), token.GetColumn(),
+		))
+	}
+	// 空行或只有注释的行不影响缩进，缩进由下一个有内容的行决定
+	switch l.GetInputStream().LA(1) {
+	case '\r', '\n', '\f', '#', antlr.TokenEOF:
+		return
+	}
+
+	start, line := token.GetStop()+1, token.GetLine()+1
+	if indent > l.currentIndent() {
+		l.indents = append(l.indents, indent)
+		l.pending = append(l.pending, l.virtualToken(Python3LexerINDENT, start, line, indent))
+		return
+	}
+	for indent < l.currentIndent() {
+		l.indents = l.indents[:len(l.indents)-1]
+		l.pending = append(l.pending, l.virtualToken(Python3LexerDEDENT, start, line, indent))
+	}
+}
+
+func (l *Python3LexerBase) onEOF(eof antlr.Token) {
+	// 虚拟 Token 放在最后一个字符之后，GetText 返回空字符串
+	start := eof.GetStart() - 1
+	if l.lastToken != nil && l.lastToken.GetTokenType() != Python3LexerNEWLINE {
+		l.pending = append(l.pending, l.virtualToken(Python3LexerNEWLINE, start, eof.GetLine(), eof.GetColumn()))
+	}
+	for len(l.indents) > 0 {
+		l.indents = l.indents[:len(l.indents)-1]
+		l.pending = append(l.pending, l.virtualToken(Python3LexerDEDENT, start, eof.GetLine(), eof.GetColumn()))
+	}
+	l.pending = append(l.pending, eof)
+}
+
+func (l *Python3LexerBase) currentIndent() int {
+	if len(l.indents) == 0 {
+		return 0
+	}
+	return l.indents[len(l.indents)-1]
+}
+
+// virtualToken 创建一个不对应源码内容的 Token
+func (l *Python3LexerBase) virtualToken(ttype, start, line, column int) antlr.Token {
+	return l.GetTokenFactory().Create(
+		l.GetTokenSourceCharStreamPair(), ttype, "", antlr.TokenDefaultChannel,
+		start, start-1,
+		line, column,
+	)
+}
@@ -0,0 +1,171 @@
+package python2ssa
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+var binOpTbl = map[string]ssa.BinaryOpcode{
+	"+":  ssa.OpAdd,
+	"-":  ssa.OpSub,
+	"*":  ssa.OpMul,
+	"/":  ssa.OpDiv,
+	"//": ssa.OpDiv,
+	"%":  ssa.OpMod,
+	"**": ssa.OpPow,
+	"@":  ssa.OpMul,
+	"&":  ssa.OpAnd,
+	"|":  ssa.OpOr,
+	"^":  ssa.OpXor,
+	"<<": ssa.OpShl,
+	">>": ssa.OpShr,
+}
+
+var compareOpTbl = map[string]ssa.BinaryOpcode{
+	"<":      ssa.OpLt,
+	">":      ssa.OpGt,
+	"<=":     ssa.OpLtEq,
+	">=":     ssa.OpGtEq,
+	"==":     ssa.OpEq,
+	"!=":     ssa.OpNotEq,
+	"<>":     ssa.OpNotEq,
+	"is":     ssa.OpEq,
+	"is not": ssa.OpNotEq,
+	"in":     ssa.OpIn,
+}
+
+var unaryOpTbl = map[string]ssa.UnaryOpcode{
+	"not": ssa.OpNot,
+	"-":   ssa.OpNeg,
+	"+":   ssa.OpPlus,
+	"~":   ssa.OpBitwiseNot,
+}
+
+// functionScope 记录函数中通过 global/nonlocal 声明、赋值时需要写回外层的名字
+type functionScope struct {
+	parent    *functionScope
+	globals   map[string]struct{}
+	nonlocals map[string]struct{}
+}
+
+func newFunctionScope(parent *functionScope) *functionScope {
+	return &functionScope{
+		parent:    parent,
+		globals:   make(map[string]struct{}),
+		nonlocals: make(map[string]struct{}),
+	}
+}
+
+func (s *functionScope) isOuter(name string) bool {
+	if _, ok := s.globals[name]; ok {
+		return true
+	}
+	_, ok := s.nonlocals[name]
+	return ok
+}
+
+// SetRange 设置当前构建位置为 token 的范围，返回恢复函数
+func (b *builder) SetRange(token ssa.CanStartStopToken) func() {
+	if b.GetEditor() == nil {
+		return func() {}
+	}
+	return b.FunctionBuilder.SetRange(token)
+}
+
+// tokenRange 是从 start 到 stop 的一段 Token，用于没有对应语法节点的范围，例如 a.b(c) 中的 a.b
+type tokenRange struct {
+	start, stop antlr.Token
+}
+
+func (t tokenRange) GetStart() antlr.Token { return t.start }
+func (t tokenRange) GetStop() antlr.Token  { return t.stop }
+func (t tokenRange) GetText() string       { return "" }
+
+// elements 返回以逗号分隔的列表中的元素，isTuple 表示列表中是否出现了逗号，例如 a, b 或 a,
+func elements(ctx antlr.ParserRuleContext) (elts []antlr.ParserRuleContext, isTuple bool) {
+	for _, child := range ctx.GetChildren() {
+		switch child := child.(type) {
+		case antlr.ParserRuleContext:
+			elts = append(elts, child)
+		case antlr.TerminalNode:
+			if child.GetSymbol().GetTokenType() == pythonparser.Python3ParserCOMMA {
+				isTuple = true
+			}
+		}
+	}
+	return elts, isTuple
+}
+
+// atomExpr 返回只包含一个 atomExpr 的表达式，例如 test 中的 a.b，表达式中有运算时返回 nil
+func atomExpr(tree antlr.Tree) *pythonparser.AtomExprContext {
+	for tree != nil {
+		if ret, ok := tree.(*pythonparser.AtomExprContext); ok {
+			return ret
+		}
+		if tree.GetChildCount() != 1 {
+			return nil
+		}
+		tree = tree.GetChild(0)
+	}
+	return nil
+}
+
+// simpleName 返回 a 或 a.b.c 形式的表达式中的最后一个名字，其他表达式返回空字符串
+func simpleName(tree antlr.Tree) string {
+	expr := atomExpr(tree)
+	if expr == nil || expr.AWAIT() != nil {
+		return ""
+	}
+	trailers := expr.AllTrailer()
+	if len(trailers) == 0 {
+		if atom, _ := expr.Atom().(*pythonparser.AtomContext); atom != nil && atom.NAME() != nil {
+			return atom.NAME().GetText()
+		}
+		return ""
+	}
+	if trailer, _ := trailers[len(trailers)-1].(*pythonparser.TrailerContext); trailer != nil && trailer.NAME() != nil {
+		return trailer.NAME().GetText()
+	}
+	return ""
+}
+
+func (b *builder) SwitchFunctionBuilder(s *ssa.StoredFunctionBuilder) func() {
+	t := b.StoreFunctionBuilder()
+	b.LoadBuilder(s)
+	return func() {
+		b.LoadBuilder(t)
+	}
+}
+
+func (b *builder) LoadBuilder(s *ssa.StoredFunctionBuilder) {
+	b.FunctionBuilder = s.Current
+	b.LoadFunctionBuilder(s.Store)
+}
+
+// pushScope 进入新的函数作用域，返回恢复函数
+func (b *builder) pushScope() func() {
+	scope := b.scope
+	b.scope = newFunctionScope(scope)
+	return func() {
+		b.scope = scope
+	}
+}
+
+// AssignName 按照 Python 的作用域规则给名字赋值：
+// 函数中的赋值默认创建局部变量，只有 global/nonlocal 声明过的名字才会影响外层
+func (b *builder) AssignName(name string, value ssa.Value) {
+	if value == nil {
+		return
+	}
+	variable := b.CreateVariable(name)
+	if b.scope.isOuter(name) {
+		b.AddCaptureFreevalue(name)
+		b.AssignVariable(variable, value)
+		return
+	}
+	supportClosure := b.SupportClosure
+	b.SupportClosure = false
+	b.AssignVariable(variable, value)
+	b.SupportClosure = supportClosure
+}
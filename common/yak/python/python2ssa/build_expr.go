@@ -0,0 +1,554 @@
+package python2ssa
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+
+	"github.com/yaklang/yaklang/common/utils"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// VisitExpression 计算表达式的值，无法处理的表达式返回 undefined 而不是 nil
+func (b *builder) VisitExpression(tree antlr.Tree) ssa.Value {
+	ctx, ok := tree.(antlr.ParserRuleContext)
+	if !ok || b.IsStop() {
+		return nil
+	}
+	// 只有一个子节点的语法节点（例如 test -> orTest -> ... -> atomExpr）和子节点的范围相同
+	if ctx.GetChildCount() == 1 {
+		if child, ok := ctx.GetChild(0).(antlr.ParserRuleContext); ok {
+			return b.VisitExpression(child)
+		}
+	}
+	recoverRange := b.SetRange(ctx)
+	defer recoverRange()
+
+	value := b.visitExpression(ctx)
+	if utils.IsNil(value) {
+		return b.EmitUndefined("")
+	}
+	return value
+}
+
+func (b *builder) visitExpression(ctx antlr.ParserRuleContext) ssa.Value {
+	switch e := ctx.(type) {
+	case *pythonparser.NamedexprTestContext:
+		value := b.VisitExpression(e.Test(1))
+		b.AssignTarget(e.Test(0), value)
+		return value
+	case *pythonparser.TestContext:
+		if e.IF() == nil {
+			return b.VisitExpression(e.GetChild(0))
+		}
+		return b.handlerJumpExpression(
+			func() ssa.Value {
+				return b.VisitExpression(e.OrTest(1))
+			},
+			func() ssa.Value {
+				return b.VisitExpression(e.OrTest(0))
+			},
+			func() ssa.Value {
+				return b.VisitExpression(e.Test())
+			},
+			ssa.TernaryExpressionVariable,
+		)
+	case *pythonparser.LambdefContext:
+		return b.buildFunction("lambda", varParameters(e.Varargslist()), func() {
+			b.EmitReturn([]ssa.Value{b.VisitExpression(e.Test())})
+		})
+	case *pythonparser.LambdefNocondContext:
+		return b.buildFunction("lambda", varParameters(e.Varargslist()), func() {
+			b.EmitReturn([]ssa.Value{b.VisitExpression(e.TestNocond())})
+		})
+	case *pythonparser.OrTestContext:
+		return b.VisitBoolOp(ctx, false)
+	case *pythonparser.AndTestContext:
+		return b.VisitBoolOp(ctx, true)
+	case *pythonparser.NotTestContext:
+		return b.EmitUnOp(ssa.OpNot, b.VisitExpression(e.NotTest()))
+	case *pythonparser.ComparisonContext:
+		return b.VisitComparison(e)
+	case *pythonparser.StarExprContext:
+		return b.VisitExpression(e.Expr())
+	case *pythonparser.ExprContext, *pythonparser.XorExprContext, *pythonparser.AndExprContext,
+		*pythonparser.ShiftExprContext, *pythonparser.ArithExprContext, *pythonparser.TermContext:
+		return b.VisitBinOp(ctx)
+	case *pythonparser.FactorContext:
+		opText := e.GetChild(0).(antlr.TerminalNode).GetText()
+		op, ok := unaryOpTbl[opText]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedUnaryOP(opText))
+			return nil
+		}
+		return b.EmitUnOp(op, b.VisitExpression(e.Factor()))
+	case *pythonparser.PowerContext:
+		return b.EmitBinOp(ssa.OpPow, b.VisitExpression(e.AtomExpr()), b.VisitExpression(e.Factor()))
+	case *pythonparser.AtomExprContext:
+		return b.visitAtomExpr(e, len(e.AllTrailer()))
+	case *pythonparser.AtomContext:
+		return b.VisitAtom(e)
+	case *pythonparser.NumberContext:
+		return b.VisitNumber(e)
+	case *pythonparser.TestlistStarExprContext, *pythonparser.TestlistContext, *pythonparser.ExprlistContext:
+		return b.visitElements(ctx)
+	case *pythonparser.TestlistCompContext:
+		if compFor := e.CompFor(); compFor != nil {
+			// (x for x in y) 生成器表达式
+			return b.VisitComprehension(compFor, nil, e.GetChild(0))
+		}
+		return b.visitElements(ctx)
+	case *pythonparser.YieldExprContext:
+		arg, _ := e.YieldArg().(*pythonparser.YieldArgContext)
+		if arg == nil {
+			return b.EmitConstInstNil()
+		}
+		if arg.Test() != nil {
+			return b.VisitExpression(arg.Test())
+		}
+		return b.VisitExpression(arg.TestlistStarExpr())
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledExpression(ctx))
+		return nil
+	}
+}
+
+// visitElements 计算 a, b, c 形式的元组，没有逗号时就是其中唯一的元素
+func (b *builder) visitElements(ctx antlr.ParserRuleContext) ssa.Value {
+	elts, isTuple := elements(ctx)
+	if !isTuple && len(elts) == 1 {
+		return b.VisitExpression(elts[0])
+	}
+	return b.CreateObjectWithSlice(b.visitValues(elts))
+}
+
+func (b *builder) visitValues(elts []antlr.ParserRuleContext) []ssa.Value {
+	values := make([]ssa.Value, 0, len(elts))
+	for _, elt := range elts {
+		values = append(values, b.VisitExpression(elt))
+	}
+	return values
+}
+
+// visitAtomExpr 计算 atom 以及它之后的前 count 个 trailer，例如 a.b(c)[d]
+func (b *builder) visitAtomExpr(e *pythonparser.AtomExprContext, count int) ssa.Value {
+	trailers := e.AllTrailer()
+	atom, _ := e.Atom().(*pythonparser.AtomContext)
+	if atom == nil {
+		return nil
+	}
+
+	var value ssa.Value
+	start := 0
+	// 调用类即创建实例
+	if call := b.callTrailer(trailers, count); atom.NAME() != nil && call != nil {
+		if class := b.GetBluePrint(atom.NAME().GetText()); class != nil {
+			recoverRange := b.SetRange(tokenRange{start: atom.GetStart(), stop: call.GetStop()})
+			obj := b.EmitUndefined(atom.NAME().GetText())
+			obj.SetType(class)
+			value = b.ClassConstructor(class, append([]ssa.Value{obj}, b.VisitArglist(call.Arglist())...))
+			recoverRange()
+			start = 1
+		}
+	}
+	if value == nil {
+		value = b.VisitExpression(atom)
+	}
+
+	for _, raw := range trailers[start:count] {
+		trailer, _ := raw.(*pythonparser.TrailerContext)
+		if trailer == nil {
+			continue
+		}
+		recoverRange := b.SetRange(tokenRange{start: e.GetStart(), stop: trailer.GetStop()})
+		value = b.VisitTrailer(value, trailer)
+		recoverRange()
+	}
+	return value
+}
+
+// callTrailer 在第一个 trailer 是调用时返回它
+func (b *builder) callTrailer(trailers []pythonparser.ITrailerContext, count int) *pythonparser.TrailerContext {
+	if count == 0 {
+		return nil
+	}
+	trailer, _ := trailers[0].(*pythonparser.TrailerContext)
+	if trailer == nil || trailer.OPEN_PAREN() == nil {
+		return nil
+	}
+	return trailer
+}
+
+// VisitTrailer 计算 value 之后的调用、下标或属性
+func (b *builder) VisitTrailer(value ssa.Value, trailer *pythonparser.TrailerContext) ssa.Value {
+	switch {
+	case trailer.OPEN_PAREN() != nil:
+		args := b.VisitArglist(trailer.Arglist())
+		return b.EmitCall(b.NewCall(value, args))
+	case trailer.NAME() != nil:
+		return b.ReadMemberCallMethodOrValue(value, b.EmitConstInstPlaceholder(trailer.NAME().GetText()))
+	case trailer.Subscriptlist() != nil:
+		list, _ := trailer.Subscriptlist().(*pythonparser.SubscriptlistContext)
+		if list == nil {
+			return nil
+		}
+		// a[1:2] 切片
+		if elts, isTuple := elements(list); !isTuple && len(elts) == 1 {
+			if subscript, _ := elts[0].(*pythonparser.SubscriptContext); subscript != nil && subscript.COLON() != nil {
+				var step ssa.Value
+				if sliceop, _ := subscript.Sliceop().(*pythonparser.SliceopContext); sliceop != nil {
+					step = b.VisitExpression(sliceop.Test())
+				}
+				return b.EmitMakeSlice(value, b.VisitExpression(subscript.GetLower()), b.VisitExpression(subscript.GetUpper()), step)
+			}
+		}
+		return b.ReadMemberCallValue(value, b.VisitSubscriptlist(list))
+	}
+	return nil
+}
+
+// VisitSubscriptlist 计算下标，a[1, 2] 的下标是元组，切片暂时作为 undefined 处理
+func (b *builder) VisitSubscriptlist(raw pythonparser.ISubscriptlistContext) ssa.Value {
+	list, _ := raw.(*pythonparser.SubscriptlistContext)
+	if list == nil {
+		return nil
+	}
+	elts, isTuple := elements(list)
+	values := make([]ssa.Value, 0, len(elts))
+	for _, elt := range elts {
+		subscript, _ := elt.(*pythonparser.SubscriptContext)
+		if subscript == nil {
+			continue
+		}
+		if subscript.COLON() != nil {
+			values = append(values, b.EmitUndefined("slice"))
+			continue
+		}
+		values = append(values, b.VisitExpression(subscript.Test(0)))
+	}
+	if !isTuple && len(values) == 1 {
+		return values[0]
+	}
+	return b.CreateObjectWithSlice(values)
+}
+
+// VisitArglist 计算调用的实参，关键字参数按出现顺序追加到位置参数之后
+func (b *builder) VisitArglist(raw pythonparser.IArglistContext) []ssa.Value {
+	list, _ := raw.(*pythonparser.ArglistContext)
+	if list == nil {
+		return nil
+	}
+	var args, keywords []ssa.Value
+	for _, raw := range list.AllArgument() {
+		arg, _ := raw.(*pythonparser.ArgumentContext)
+		if arg == nil {
+			continue
+		}
+		switch {
+		case arg.CompFor() != nil:
+			// f(x for x in y)
+			recoverRange := b.SetRange(arg)
+			args = append(args, b.VisitComprehension(arg.CompFor(), nil, arg.NamedexprTest()))
+			recoverRange()
+		case arg.NamedexprTest() != nil:
+			args = append(args, b.VisitExpression(arg.NamedexprTest()))
+		case arg.STAR() != nil:
+			args = append(args, b.VisitExpression(arg.Test()))
+		default:
+			// name=value 和 **kwargs
+			keywords = append(keywords, b.VisitExpression(arg.Test()))
+		}
+	}
+	return append(args, keywords...)
+}
+
+func (b *builder) VisitAtom(atom *pythonparser.AtomContext) ssa.Value {
+	switch {
+	case atom.NAME() != nil:
+		return b.ReadValue(atom.NAME().GetText())
+	case atom.Number() != nil:
+		return b.VisitNumber(atom.Number())
+	case len(atom.AllSTRING()) > 0:
+		return b.VisitStrings(atom.AllSTRING())
+	case atom.NONE() != nil:
+		return b.EmitConstInstNil()
+	case atom.TRUE() != nil:
+		return b.EmitConstInst(true)
+	case atom.FALSE() != nil:
+		return b.EmitConstInst(false)
+	case atom.ELLIPSIS() != nil:
+		return b.EmitUndefined("...")
+	case atom.OPEN_PAREN() != nil:
+		if atom.YieldExpr() != nil {
+			return b.VisitExpression(atom.YieldExpr())
+		}
+		if atom.TestlistComp() == nil {
+			return b.CreateObjectWithSlice([]ssa.Value{})
+		}
+		return b.VisitExpression(atom.TestlistComp())
+	case atom.OPEN_BRACK() != nil:
+		list, _ := atom.TestlistComp().(*pythonparser.TestlistCompContext)
+		if list == nil {
+			return b.CreateObjectWithSlice([]ssa.Value{})
+		}
+		if compFor := list.CompFor(); compFor != nil {
+			return b.VisitComprehension(compFor, nil, list.GetChild(0))
+		}
+		elts, _ := elements(list)
+		return b.CreateObjectWithSlice(b.visitValues(elts))
+	case atom.OPEN_BRACE() != nil:
+		return b.VisitDictorsetmaker(atom.Dictorsetmaker())
+	}
+	b.NewError(ssa.Error, TAG, UnhandledExpression(atom))
+	return nil
+}
+
+// VisitDictorsetmaker 计算字典或集合，集合作为 slice 处理
+func (b *builder) VisitDictorsetmaker(raw pythonparser.IDictorsetmakerContext) ssa.Value {
+	maker, _ := raw.(*pythonparser.DictorsetmakerContext)
+	if maker == nil {
+		return b.CreateObjectWithMap(nil, nil)
+	}
+	entries := maker.AllDictEntry()
+	if len(entries) == 0 {
+		if compFor := maker.CompFor(); compFor != nil {
+			return b.VisitComprehension(compFor, nil, maker.GetChild(0))
+		}
+		elts, _ := elements(maker)
+		return b.CreateObjectWithSlice(b.visitValues(elts))
+	}
+
+	if compFor := maker.CompFor(); compFor != nil {
+		entry, _ := entries[0].(*pythonparser.DictEntryContext)
+		if entry == nil || entry.POWER() != nil {
+			return nil
+		}
+		return b.VisitComprehension(compFor, entry.Test(0), entry.Test(1))
+	}
+	var keys, values []ssa.Value
+	for _, raw := range entries {
+		entry, _ := raw.(*pythonparser.DictEntryContext)
+		if entry == nil {
+			continue
+		}
+		if entry.POWER() != nil {
+			// {**other} 展开，暂时只计算被展开的值
+			b.VisitExpression(entry.Expr())
+			continue
+		}
+		keys = append(keys, b.VisitExpression(entry.Test(0)))
+		values = append(values, b.VisitExpression(entry.Test(1)))
+	}
+	return b.CreateObjectWithMap(keys, values)
+}
+
+func (b *builder) VisitNumber(raw pythonparser.INumberContext) ssa.Value {
+	number, _ := raw.(*pythonparser.NumberContext)
+	if number == nil {
+		return nil
+	}
+	text := number.GetText()
+	switch {
+	case number.INTEGER() != nil:
+		intText := strings.ReplaceAll(text, "_", "")
+		if len(intText) > 1 && intText[0] == '0' && intText[1] >= '0' && intText[1] <= '9' {
+			// 00 这样的写法 strconv 会当作八进制
+			intText = strings.TrimLeft(intText, "0")
+			if intText == "" {
+				intText = "0"
+			}
+		}
+		if i, err := strconv.ParseInt(intText, 0, 64); err == nil {
+			return b.EmitConstInst(i)
+		}
+	case number.FLOAT_NUMBER() != nil:
+		if f, err := strconv.ParseFloat(strings.ReplaceAll(text, "_", ""), 64); err == nil {
+			return b.EmitConstInst(f)
+		}
+	}
+	return b.EmitConstInst(text)
+}
+
+// handlerJumpExpression 通过条件分支和 Phi 实现短路求值的表达式
+func (b *builder) handlerJumpExpression(
+	cond func() ssa.Value,
+	trueExpr, falseExpr func() ssa.Value,
+	valueName string,
+) ssa.Value {
+	// 为了聚合产生Phi指令
+	id := valueName + "_" + uuid.NewString()
+	variable := b.CreateLocalVariable(id)
+	b.AssignVariable(variable, b.EmitValueOnlyDeclare(id))
+	ifb := b.CreateIfBuilder()
+	ifb.AppendItem(
+		cond,
+		func() {
+			b.AssignVariable(b.CreateVariable(id), trueExpr())
+		},
+	)
+	ifb.SetElse(func() {
+		b.AssignVariable(b.CreateVariable(id), falseExpr())
+	})
+	ifb.Build()
+	// generator phi instruction
+	v := b.ReadValue(id)
+	if b.CurrentRange != nil {
+		v.SetName(b.CurrentRange.GetText())
+	}
+	return v
+}
+
+// VisitBoolOp 处理 and/or：a and b 在 a 为假时返回 a，a or b 在 a 为真时返回 a
+func (b *builder) VisitBoolOp(ctx antlr.ParserRuleContext, isAnd bool) ssa.Value {
+	operands, _ := elements(ctx)
+	result := b.VisitExpression(operands[0])
+	for _, next := range operands[1:] {
+		left := result
+		next := next
+		if isAnd {
+			result = b.handlerJumpExpression(
+				func() ssa.Value { return left },
+				func() ssa.Value { return b.VisitExpression(next) },
+				func() ssa.Value { return left },
+				ssa.AndExpressionVariable,
+			)
+		} else {
+			result = b.handlerJumpExpression(
+				func() ssa.Value { return left },
+				func() ssa.Value { return left },
+				func() ssa.Value { return b.VisitExpression(next) },
+				ssa.OrExpressionVariable,
+			)
+		}
+	}
+	return result
+}
+
+// VisitBinOp 按从左到右的顺序计算 a + b - c 形式的表达式
+func (b *builder) VisitBinOp(ctx antlr.ParserRuleContext) ssa.Value {
+	var result ssa.Value
+	var opText string
+	for _, child := range ctx.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			opText = child.GetText()
+		case antlr.ParserRuleContext:
+			value := b.VisitExpression(child)
+			if result == nil {
+				result = value
+				continue
+			}
+			op, ok := binOpTbl[opText]
+			if !ok {
+				b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(opText))
+				return nil
+			}
+			recoverRange := b.SetRange(tokenRange{start: ctx.GetStart(), stop: child.GetStop()})
+			result = b.EmitBinOp(op, result, value)
+			recoverRange()
+		}
+	}
+	return result
+}
+
+// VisitComparison 处理链式比较 a < b < c，等价于 a < b and b < c
+func (b *builder) VisitComparison(e *pythonparser.ComparisonContext) ssa.Value {
+	var result ssa.Value
+	left := b.VisitExpression(e.Expr(0))
+	for i, raw := range e.AllCompOp() {
+		// not in 和 is not 由两个 Token 组成
+		var words []string
+		for _, token := range raw.GetChildren() {
+			words = append(words, token.(antlr.TerminalNode).GetText())
+		}
+		op := strings.Join(words, " ")
+
+		right := b.VisitExpression(e.Expr(i + 1))
+		var value ssa.Value
+		switch op {
+		case "in":
+			value = b.EmitBinOp(ssa.OpIn, left, right)
+		case "not in":
+			value = b.EmitUnOp(ssa.OpNot, b.EmitBinOp(ssa.OpIn, left, right))
+		default:
+			opcode, ok := compareOpTbl[op]
+			if !ok {
+				b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(op))
+				return nil
+			}
+			value = b.EmitBinOp(opcode, left, right)
+		}
+		if result == nil {
+			result = value
+		} else {
+			result = b.EmitBinOp(ssa.OpLogicAnd, result, value)
+		}
+		left = right
+	}
+	return result
+}
+
+// VisitComprehension 将推导式展开为循环，结果保存在一个新建的容器中。
+// key 为 nil 时是列表、集合推导式或生成器表达式
+func (b *builder) VisitComprehension(compFor pythonparser.ICompForContext, key, value antlr.Tree) ssa.Value {
+	result := b.CreateObjectWithSlice([]ssa.Value{})
+
+	var index ssa.Value
+	var build func(iter antlr.Tree)
+	build = func(iter antlr.Tree) {
+		switch it := iter.(type) {
+		case *pythonparser.CompForContext:
+			loop := b.CreateLoopBuilder()
+			loop.SetCondition(func() ssa.Value {
+				k, field, ok := b.EmitNext(b.VisitExpression(it.OrTest()), true)
+				index = k
+				// 推导式中的循环变量不会泄露到外层作用域
+				b.assignTarget(it.Exprlist(), field, true)
+				return ok
+			})
+			loop.SetBody(func() {
+				build(compIterNext(it.CompIter()))
+			})
+			loop.Finish()
+		case *pythonparser.CompIfContext:
+			b.CreateIfBuilder().AppendItem(
+				func() ssa.Value {
+					return b.VisitExpression(it.TestNocond())
+				},
+				func() {
+					build(compIterNext(it.CompIter()))
+				},
+			).Build()
+		default:
+			memberKey := index
+			if key != nil {
+				memberKey = b.VisitExpression(key)
+			}
+			elt := b.VisitExpression(value)
+			if utils.IsNil(memberKey) {
+				memberKey = b.EmitConstInst(0)
+			}
+			b.AssignVariable(b.CreateMemberCallVariable(result, memberKey), elt)
+		}
+	}
+	build(compFor)
+	return result
+}
+
+// compIterNext 返回推导式中的下一个 for 或 if，没有时返回 nil
+func compIterNext(raw pythonparser.ICompIterContext) antlr.Tree {
+	iter, _ := raw.(*pythonparser.CompIterContext)
+	if iter == nil {
+		return nil
+	}
+	if compFor := iter.CompFor(); compFor != nil {
+		return compFor
+	}
+	if compIf := iter.CompIf(); compIf != nil {
+		return compIf
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+package python2ssa
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// parameter 是函数或 lambda 的一个形参
+type parameter struct {
+	node         antlr.ParserRuleContext
+	name         string
+	defaultValue antlr.Tree
+	// isVararg 表示 *args
+	isVararg bool
+}
+
+// typedParameters 返回 def 的形参，裸的 '*' 和 '/' 只用于分隔参数，不是形参
+func typedParameters(raw pythonparser.IParametersContext) []*parameter {
+	i, _ := raw.(*pythonparser.ParametersContext)
+	if i == nil {
+		return nil
+	}
+	list, _ := i.Typedargslist().(*pythonparser.TypedargslistContext)
+	if list == nil {
+		return nil
+	}
+	var params []*parameter
+	for _, raw := range list.AllTypedarg() {
+		arg, _ := raw.(*pythonparser.TypedargContext)
+		if arg == nil {
+			continue
+		}
+		tfpdef, _ := arg.Tfpdef().(*pythonparser.TfpdefContext)
+		if tfpdef == nil {
+			continue
+		}
+		params = append(params, &parameter{
+			node:         arg,
+			name:         tfpdef.NAME().GetText(),
+			defaultValue: arg.Test(),
+			isVararg:     arg.STAR() != nil,
+		})
+	}
+	return params
+}
+
+// varParameters 返回 lambda 的形参
+func varParameters(raw pythonparser.IVarargslistContext) []*parameter {
+	list, _ := raw.(*pythonparser.VarargslistContext)
+	if list == nil {
+		return nil
+	}
+	var params []*parameter
+	for _, raw := range list.AllVararg() {
+		arg, _ := raw.(*pythonparser.VarargContext)
+		if arg == nil || arg.NAME() == nil {
+			continue
+		}
+		params = append(params, &parameter{
+			node:         arg,
+			name:         arg.NAME().GetText(),
+			defaultValue: arg.Test(),
+			isVararg:     arg.STAR() != nil,
+		})
+	}
+	return params
+}
+
+// buildFunction 构建函数体，函数中的 global/nonlocal 声明只在该函数内有效
+func (b *builder) buildFunction(name string, params []*parameter, body func()) *ssa.Function {
+	newFunc := b.NewFunc(name)
+	b.FunctionBuilder = b.PushFunction(newFunc)
+	restoreScope := b.pushScope()
+
+	b.ProcessArguments(params, 0)
+	body()
+
+	restoreScope()
+	b.Finish()
+	b.FunctionBuilder = b.PopFunction()
+	return newFunc
+}
+
+// ProcessArguments 创建形参，skip 指定跳过前几个参数（例如方法的 self）
+func (b *builder) ProcessArguments(params []*parameter, skip int) {
+	for index, param := range params {
+		if index < skip {
+			continue
+		}
+		recoverRange := b.SetRange(param.node)
+		p := b.NewParam(param.name)
+		if param.defaultValue != nil {
+			p.SetDefault(b.VisitExpression(param.defaultValue))
+		}
+		// 只有 *args 是最后一个参数时才能作为可变参数处理
+		if param.isVararg && index == len(params)-1 {
+			b.HandlerEllipsis()
+		}
+		recoverRange()
+	}
+}
+
+// applyDecorators 按照从下到上的顺序应用装饰器：@a @b def f 等价于 f = a(b(f))
+func (b *builder) applyDecorators(decorators []pythonparser.IDecoratorContext, value ssa.Value) ssa.Value {
+	for i := len(decorators) - 1; i >= 0; i-- {
+		decorator, _ := decorators[i].(*pythonparser.DecoratorContext)
+		if decorator == nil {
+			continue
+		}
+		recoverRange := b.SetRange(decorator)
+		callee := b.VisitExpression(decorator.NamedexprTest())
+		value = b.EmitCall(b.NewCall(callee, []ssa.Value{value}))
+		recoverRange()
+	}
+	return value
+}
+
+func (b *builder) VisitFuncdef(raw pythonparser.IFuncdefContext, decorators []pythonparser.IDecoratorContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.FuncdefContext)
+	if i == nil {
+		return
+	}
+	name := i.NAME().GetText()
+	newFunc := b.buildFunction(name, typedParameters(i.Parameters()), func() {
+		b.VisitSuite(i.Suite())
+	})
+	b.AssignName(name, b.applyDecorators(decorators, newFunc))
+}
+
+func (b *builder) VisitClassdef(raw pythonparser.IClassdefContext, decorators []pythonparser.IDecoratorContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.ClassdefContext)
+	if i == nil {
+		return
+	}
+	name := i.NAME().GetText()
+	class := b.CreateBlueprint(name)
+	class.SetKind(ssa.BlueprintClass)
+
+	// class Foo(models.Model, metaclass=Meta) 中的位置参数是父类
+	var parents []string
+	if arglist, _ := i.Arglist().(*pythonparser.ArglistContext); arglist != nil {
+		for _, raw := range arglist.AllArgument() {
+			arg, _ := raw.(*pythonparser.ArgumentContext)
+			if arg == nil || arg.NamedexprTest() == nil || arg.CompFor() != nil {
+				continue
+			}
+			if parent := simpleName(arg.NamedexprTest()); parent != "" {
+				parents = append(parents, parent)
+			}
+		}
+	}
+	store := b.StoreFunctionBuilder()
+	class.AddLazyBuilder(func() {
+		switchHandler := b.SwitchFunctionBuilder(store)
+		defer switchHandler()
+		for _, name := range parents {
+			parent := b.GetBluePrint(name)
+			if parent == nil {
+				parent = b.CreateBlueprint(name)
+			}
+			parent.SetKind(ssa.BlueprintClass)
+			class.AddParentBlueprint(parent)
+		}
+	})
+
+	// 类体中的名字只在类的命名空间中可见
+	b.BuildSyntaxBlock(func() {
+		suite, _ := i.Suite().(*pythonparser.SuiteContext)
+		if suite == nil {
+			return
+		}
+		if simple := suite.SimpleStmts(); simple != nil {
+			b.visitClassSimpleStmts(simple, class)
+			return
+		}
+		for _, stmt := range suite.AllStmt() {
+			b.visitClassStmt(stmt, class)
+		}
+	})
+
+	b.AssignName(name, b.applyDecorators(decorators, class.Container()))
+}
+
+func (b *builder) visitClassStmt(raw pythonparser.IStmtContext, class *ssa.Blueprint) {
+	i, _ := raw.(*pythonparser.StmtContext)
+	if i == nil {
+		return
+	}
+	if simple := i.SimpleStmts(); simple != nil {
+		b.visitClassSimpleStmts(simple, class)
+		return
+	}
+
+	compound, _ := i.CompoundStmt().(*pythonparser.CompoundStmtContext)
+	if compound == nil {
+		return
+	}
+	var funcdef pythonparser.IFuncdefContext
+	var decorators []pythonparser.IDecoratorContext
+	switch stmt := compound.GetChild(0).(type) {
+	case *pythonparser.FuncdefContext:
+		funcdef = stmt
+	case *pythonparser.DecoratedContext:
+		decorators = stmt.AllDecorator()
+		funcdef = stmt.Funcdef()
+		if async, _ := stmt.AsyncFuncdef().(*pythonparser.AsyncFuncdefContext); async != nil {
+			funcdef = async.Funcdef()
+		}
+	case *pythonparser.AsyncStmtContext:
+		funcdef = stmt.Funcdef()
+	}
+	if funcdef == nil {
+		b.VisitCompoundStmt(compound)
+		return
+	}
+	recoverRange := b.SetRange(compound)
+	defer recoverRange()
+	b.VisitMethod(funcdef, decorators, class)
+}
+
+func (b *builder) visitClassSimpleStmts(raw pythonparser.ISimpleStmtsContext, class *ssa.Blueprint) {
+	i, _ := raw.(*pythonparser.SimpleStmtsContext)
+	if i == nil {
+		return
+	}
+	registerMember := func(target antlr.Tree, value ssa.Value) {
+		expr := atomExpr(target)
+		var atom *pythonparser.AtomContext
+		if expr != nil && len(expr.AllTrailer()) == 0 {
+			atom, _ = expr.Atom().(*pythonparser.AtomContext)
+		}
+		if atom == nil || atom.NAME() == nil {
+			b.AssignTarget(target, value)
+			return
+		}
+		name := atom.NAME().GetText()
+		b.AssignVariable(b.CreateLocalVariable(name), value)
+		class.RegisterStaticMember(name, value)
+		class.RegisterNormalMember(name, value)
+	}
+
+	for _, raw := range i.AllSmallStmt() {
+		small, _ := raw.(*pythonparser.SmallStmtContext)
+		if small == nil {
+			continue
+		}
+		stmt, _ := small.ExprStmt().(*pythonparser.ExprStmtContext)
+		if stmt == nil || stmt.Augassign() != nil || (stmt.Annassign() == nil && len(stmt.AllAssignValue()) == 0) {
+			b.VisitSmallStmt(small)
+			continue
+		}
+
+		recoverRange := b.SetRange(small)
+		target := stmt.TestlistStarExpr()
+		if ann, _ := stmt.Annassign().(*pythonparser.AnnassignContext); ann != nil {
+			// name: str 没有值时也是类的成员
+			var value ssa.Value
+			if ann.AssignValue() != nil {
+				value = b.VisitExpression(ann.AssignValue())
+			} else if name := simpleName(target); name != "" {
+				value = b.EmitUndefined(name)
+			}
+			registerMember(target, value)
+		} else {
+			values := stmt.AllAssignValue()
+			value := b.VisitExpression(values[len(values)-1])
+			registerMember(target, value)
+			for _, other := range values[:len(values)-1] {
+				registerMember(other, value)
+			}
+		}
+		recoverRange()
+	}
+}
+
+func decoratorName(raw pythonparser.IDecoratorContext) string {
+	decorator, _ := raw.(*pythonparser.DecoratorContext)
+	if decorator == nil {
+		return ""
+	}
+	return simpleName(decorator.NamedexprTest())
+}
+
+// VisitMethod 构建类中定义的方法，方法体延迟到使用时再构建
+func (b *builder) VisitMethod(raw pythonparser.IFuncdefContext, decorators []pythonparser.IDecoratorContext, class *ssa.Blueprint) {
+	def, _ := raw.(*pythonparser.FuncdefContext)
+	if def == nil {
+		return
+	}
+	methodName := def.NAME().GetText()
+	// staticmethod 没有隐式参数，classmethod 的第一个参数是类本身
+	isStatic, hasSelf := false, true
+	for _, decorator := range decorators {
+		switch decoratorName(decorator) {
+		case "staticmethod":
+			isStatic, hasSelf = true, false
+		case "classmethod":
+			isStatic = true
+		}
+	}
+
+	funcName := fmt.Sprintf("%s_%s", class.Name, methodName)
+	newFunc := b.NewFunc(funcName)
+	newFunc.SetMethodName(methodName)
+
+	store := b.StoreFunctionBuilder()
+	newFunc.AddLazyBuilder(func() {
+		switchHandler := b.SwitchFunctionBuilder(store)
+		defer switchHandler()
+		b.FunctionBuilder = b.PushFunction(newFunc)
+		restoreScope := b.pushScope()
+		defer restoreScope()
+
+		params := typedParameters(def.Parameters())
+		switch {
+		case methodName == "__init__":
+			b.NewParam("$this")
+			container := b.EmitEmptyContainer()
+			if len(params) > 0 {
+				b.AssignVariable(b.CreateVariable(params[0].name), container)
+			}
+			container.SetType(class)
+			b.ProcessArguments(params, 1)
+			b.VisitSuite(def.Suite())
+			b.EmitReturn([]ssa.Value{container})
+		case hasSelf && len(params) > 0:
+			self := b.NewParam(params[0].name)
+			self.SetType(class)
+			b.ProcessArguments(params, 1)
+			b.VisitSuite(def.Suite())
+		default:
+			b.ProcessArguments(params, 0)
+			b.VisitSuite(def.Suite())
+		}
+
+		b.Finish()
+		b.FunctionBuilder = b.PopFunction()
+	})
+
+	switch {
+	case methodName == "__init__":
+		newFunc.SetType(ssa.NewFunctionType(fmt.Sprintf("%s-__init__", class.Name), []ssa.Type{class}, class, true))
+		class.RegisterMagicMethod(ssa.Constructor, newFunc)
+	case methodName == "__del__":
+		class.RegisterMagicMethod(ssa.Destructor, newFunc)
+	case isStatic:
+		class.RegisterStaticMethod(methodName, newFunc)
+	default:
+		class.RegisterNormalMethod(methodName, newFunc)
+	}
+}
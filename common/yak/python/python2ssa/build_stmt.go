@@ -0,0 +1,566 @@
+package python2ssa
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitFileInput(raw pythonparser.IFileInputContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	// python 暂时不需要处理 prehandle 阶段
+	if b.PreHandler() {
+		return
+	}
+	i, _ := raw.(*pythonparser.FileInputContext)
+	if i == nil {
+		return
+	}
+	b.VisitStmts(i.AllStmt())
+}
+
+func (b *builder) VisitStmts(stmts []pythonparser.IStmtContext) {
+	for _, stmt := range stmts {
+		if b.IsStop() {
+			return
+		}
+		b.VisitStmt(stmt)
+	}
+}
+
+func (b *builder) VisitStmt(raw pythonparser.IStmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.StmtContext)
+	if i == nil {
+		return
+	}
+	if simple := i.SimpleStmts(); simple != nil {
+		b.VisitSimpleStmts(simple)
+		return
+	}
+	b.VisitCompoundStmt(i.CompoundStmt())
+}
+
+// VisitSimpleStmts 处理一行中以分号分隔的多条语句
+func (b *builder) VisitSimpleStmts(raw pythonparser.ISimpleStmtsContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.SimpleStmtsContext)
+	if i == nil {
+		return
+	}
+	for _, stmt := range i.AllSmallStmt() {
+		b.VisitSmallStmt(stmt)
+	}
+}
+
+// VisitSuite 处理冒号之后的语句块，可以是同一行的语句或者缩进的语句块
+func (b *builder) VisitSuite(raw pythonparser.ISuiteContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.SuiteContext)
+	if i == nil {
+		return
+	}
+	if simple := i.SimpleStmts(); simple != nil {
+		b.VisitSimpleStmts(simple)
+		return
+	}
+	b.VisitStmts(i.AllStmt())
+}
+
+func (b *builder) VisitSmallStmt(raw pythonparser.ISmallStmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	if b.IsBlockFinish() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	b.AppendBlockRange()
+
+	i, _ := raw.(*pythonparser.SmallStmtContext)
+	if i == nil {
+		return
+	}
+
+	switch stmt := i.GetChild(0).(type) {
+	case *pythonparser.ExprStmtContext:
+		b.VisitExprStmt(stmt)
+	case *pythonparser.DelStmtContext:
+		elts, _ := elements(stmt.Exprlist())
+		for _, target := range elts {
+			b.VisitExpression(target)
+		}
+	case *pythonparser.PassStmtContext:
+	case *pythonparser.FlowStmtContext:
+		b.VisitFlowStmt(stmt)
+	case *pythonparser.ImportStmtContext:
+		if name := stmt.ImportName(); name != nil {
+			b.VisitImportName(name)
+		} else {
+			b.VisitImportFrom(stmt.ImportFrom())
+		}
+	case *pythonparser.GlobalStmtContext:
+		for _, name := range stmt.AllNAME() {
+			b.scope.globals[name.GetText()] = struct{}{}
+		}
+	case *pythonparser.NonlocalStmtContext:
+		for _, name := range stmt.AllNAME() {
+			b.scope.nonlocals[name.GetText()] = struct{}{}
+		}
+	case *pythonparser.AssertStmtContext:
+		cond := b.VisitExpression(stmt.Test(0))
+		var msg ssa.Value
+		if stmt.Test(1) != nil {
+			msg = b.VisitExpression(stmt.Test(1))
+		}
+		b.EmitAssert(cond, msg, "")
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledStatement(stmt))
+	}
+}
+
+func (b *builder) VisitExprStmt(raw pythonparser.IExprStmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.ExprStmtContext)
+	if i == nil {
+		return
+	}
+
+	target := i.TestlistStarExpr()
+	if ann, _ := i.Annassign().(*pythonparser.AnnassignContext); ann != nil {
+		// a: int = 1 中的类型注解不影响数据流
+		if ann.AssignValue() != nil {
+			b.AssignTarget(target, b.VisitExpression(ann.AssignValue()))
+		}
+		return
+	}
+	if aug := i.Augassign(); aug != nil {
+		b.VisitAugAssign(target, aug, i.AssignValue(0))
+		return
+	}
+
+	values := i.AllAssignValue()
+	if len(values) == 0 {
+		b.VisitExpression(target)
+		return
+	}
+	// a = b = 1 中除最后一个以外都是赋值目标
+	value := b.VisitExpression(values[len(values)-1])
+	b.AssignTarget(target, value)
+	for _, other := range values[:len(values)-1] {
+		b.AssignTarget(other, value)
+	}
+}
+
+func (b *builder) VisitAugAssign(target pythonparser.ITestlistStarExprContext, aug pythonparser.IAugassignContext, raw pythonparser.IAssignValueContext) {
+	opText := strings.TrimSuffix(aug.GetText(), "=")
+	op, ok := binOpTbl[opText]
+	if !ok {
+		b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(opText))
+		return
+	}
+	left := b.VisitExpression(target)
+	right := b.VisitExpression(raw)
+	if left == nil || right == nil {
+		return
+	}
+	b.AssignTarget(target, b.EmitBinOp(op, left, right))
+}
+
+func (b *builder) VisitFlowStmt(raw pythonparser.IFlowStmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.FlowStmtContext)
+	if i == nil {
+		return
+	}
+
+	switch stmt := i.GetChild(0).(type) {
+	case *pythonparser.BreakStmtContext:
+		if !b.Break() {
+			b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+		}
+	case *pythonparser.ContinueStmtContext:
+		if !b.Continue() {
+			b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+		}
+	case *pythonparser.ReturnStmtContext:
+		if stmt.TestlistStarExpr() == nil {
+			b.EmitReturn(nil)
+			return
+		}
+		b.EmitReturn([]ssa.Value{b.VisitExpression(stmt.TestlistStarExpr())})
+	case *pythonparser.RaiseStmtContext:
+		var exc ssa.Value
+		if stmt.Test(0) != nil {
+			exc = b.VisitExpression(stmt.Test(0))
+		} else {
+			// 单独的 raise 重新抛出当前异常
+			exc = b.EmitUndefined("raise")
+		}
+		if stmt.Test(1) != nil {
+			b.VisitExpression(stmt.Test(1))
+		}
+		b.EmitPanic(exc)
+	case *pythonparser.YieldStmtContext:
+		b.VisitExpression(stmt.YieldExpr())
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledStatement(stmt))
+	}
+}
+
+func (b *builder) VisitCompoundStmt(raw pythonparser.ICompoundStmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	if b.IsBlockFinish() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	b.AppendBlockRange()
+
+	i, _ := raw.(*pythonparser.CompoundStmtContext)
+	if i == nil {
+		return
+	}
+
+	switch stmt := i.GetChild(0).(type) {
+	case *pythonparser.IfStmtContext:
+		b.VisitIfStmt(stmt, 0)
+	case *pythonparser.WhileStmtContext:
+		b.VisitWhileStmt(stmt)
+	case *pythonparser.ForStmtContext:
+		b.VisitForStmt(stmt)
+	case *pythonparser.TryStmtContext:
+		b.VisitTryStmt(stmt)
+	case *pythonparser.WithStmtContext:
+		b.VisitWithStmt(stmt)
+	case *pythonparser.FuncdefContext:
+		b.VisitFuncdef(stmt, nil)
+	case *pythonparser.ClassdefContext:
+		b.VisitClassdef(stmt, nil)
+	case *pythonparser.DecoratedContext:
+		decorators := stmt.AllDecorator()
+		if classdef := stmt.Classdef(); classdef != nil {
+			b.VisitClassdef(classdef, decorators)
+		} else if funcdef := stmt.Funcdef(); funcdef != nil {
+			b.VisitFuncdef(funcdef, decorators)
+		} else if async, _ := stmt.AsyncFuncdef().(*pythonparser.AsyncFuncdefContext); async != nil {
+			b.VisitFuncdef(async.Funcdef(), decorators)
+		}
+	case *pythonparser.AsyncStmtContext:
+		// async 不影响数据流，按照同步的语句处理
+		if funcdef := stmt.Funcdef(); funcdef != nil {
+			b.VisitFuncdef(funcdef, nil)
+		} else if with, _ := stmt.WithStmt().(*pythonparser.WithStmtContext); with != nil {
+			b.VisitWithStmt(with)
+		} else if forStmt, _ := stmt.ForStmt().(*pythonparser.ForStmtContext); forStmt != nil {
+			b.VisitForStmt(forStmt)
+		}
+	default:
+		b.NewError(ssa.Error, TAG, UnhandledStatement(stmt))
+	}
+}
+
+// VisitIfStmt 从第 index 个条件开始构建 if 语句，elif 作为 else 中嵌套的 if 处理
+func (b *builder) VisitIfStmt(stmt *pythonparser.IfStmtContext, index int) {
+	tests := stmt.AllNamedexprTest()
+	ifBuilder := b.CreateIfBuilder()
+	ifBuilder.AppendItem(
+		func() ssa.Value {
+			return b.VisitExpression(tests[index])
+		},
+		func() {
+			b.VisitSuite(stmt.Suite(index))
+		},
+	)
+	if index+1 < len(tests) {
+		ifBuilder.SetElse(func() {
+			b.VisitIfStmt(stmt, index+1)
+		})
+	} else if stmt.ELSE() != nil {
+		ifBuilder.SetElse(func() {
+			b.VisitSuite(stmt.Suite(len(tests)))
+		})
+	}
+	ifBuilder.Build()
+}
+
+func (b *builder) VisitWhileStmt(stmt *pythonparser.WhileStmtContext) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		if cond := b.VisitExpression(stmt.NamedexprTest()); cond != nil {
+			return cond
+		}
+		return b.EmitConstInst(true)
+	})
+	loop.SetBody(func() {
+		b.VisitSuite(stmt.Suite(0))
+	})
+	loop.Finish()
+	// while ... else 中的 else 块在循环正常结束后执行
+	b.VisitSuite(stmt.Suite(1))
+}
+
+func (b *builder) VisitForStmt(stmt *pythonparser.ForStmtContext) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		iter := b.VisitExpression(stmt.Testlist())
+		if iter == nil {
+			return b.EmitConstInst(false)
+		}
+		_, value, ok := b.EmitNext(iter, true)
+		b.AssignTarget(stmt.Exprlist(), value)
+		return ok
+	})
+	loop.SetBody(func() {
+		b.VisitSuite(stmt.Suite(0))
+	})
+	loop.Finish()
+	b.VisitSuite(stmt.Suite(1))
+}
+
+func (b *builder) VisitWithStmt(stmt *pythonparser.WithStmtContext) {
+	for _, raw := range stmt.AllWithItem() {
+		item, _ := raw.(*pythonparser.WithItemContext)
+		if item == nil {
+			continue
+		}
+		value := b.VisitExpression(item.Test())
+		if item.Expr() != nil {
+			// with open(path) as f: f 绑定 __enter__ 的返回值，这里近似为上下文对象本身
+			b.AssignTarget(item.Expr(), value)
+		}
+	}
+	b.VisitSuite(stmt.Suite())
+}
+
+func (b *builder) VisitTryStmt(stmt *pythonparser.TryStmtContext) {
+	tryBuilder := b.BuildTry()
+	tryBuilder.BuildTryBlock(func() {
+		b.VisitSuite(stmt.Suite())
+		// try ... else 中的 else 块在没有异常时执行
+		if elseClause, _ := stmt.ElseClause().(*pythonparser.ElseClauseContext); elseClause != nil {
+			b.VisitSuite(elseClause.Suite())
+		}
+	})
+	for _, raw := range stmt.AllExceptClause() {
+		handler, _ := raw.(*pythonparser.ExceptClauseContext)
+		if handler == nil {
+			continue
+		}
+		tryBuilder.BuildErrorCatch(func() string {
+			if handler.Test() != nil {
+				b.VisitExpression(handler.Test())
+			}
+			if handler.NAME() != nil {
+				return handler.NAME().GetText()
+			}
+			return ""
+		}, func() {
+			b.VisitSuite(handler.Suite())
+		})
+	}
+	if finally, _ := stmt.FinallyClause().(*pythonparser.FinallyClauseContext); finally != nil {
+		tryBuilder.BuildFinally(func() {
+			b.VisitSuite(finally.Suite())
+		})
+	}
+	tryBuilder.Finish()
+}
+
+// importModule 返回模块 a.b.c 对应的值：a 是一个外部值，后续部分是它的成员
+func (b *builder) importModule(name string) ssa.Value {
+	// 相对导入 from .utils import x 中忽略表示层级的 '.'
+	name = strings.TrimLeft(name, ".")
+	if name == "" {
+		return b.EmitUndefined(".")
+	}
+	parts := strings.Split(name, ".")
+	var value ssa.Value
+	// from a import b 不会绑定 a，但仍需要一个名为 a 的变量，规则才能通过 a.b 找到它
+	b.BuildSyntaxBlock(func() {
+		b.AssignVariable(b.CreateLocalVariable(parts[0]), b.EmitUndefined(parts[0]))
+		value = b.ReadValue(parts[0])
+		for _, part := range parts[1:] {
+			value = b.ReadMemberCallValue(value, b.EmitConstInstPlaceholder(part))
+		}
+	})
+	return value
+}
+
+func (b *builder) VisitImportName(raw pythonparser.IImportNameContext) {
+	i, _ := raw.(*pythonparser.ImportNameContext)
+	if i == nil {
+		return
+	}
+	names, _ := i.DottedAsNames().(*pythonparser.DottedAsNamesContext)
+	if names == nil {
+		return
+	}
+	for _, raw := range names.AllDottedAsName() {
+		alias, _ := raw.(*pythonparser.DottedAsNameContext)
+		if alias == nil {
+			continue
+		}
+		name := alias.DottedName().GetText()
+		if alias.NAME() != nil {
+			b.AssignName(alias.NAME().GetText(), b.importModule(name))
+			continue
+		}
+		// import a.b.c 只会绑定 a
+		top := strings.SplitN(name, ".", 2)[0]
+		b.AssignName(top, b.EmitUndefined(top))
+	}
+}
+
+func (b *builder) VisitImportFrom(raw pythonparser.IImportFromContext) {
+	i, _ := raw.(*pythonparser.ImportFromContext)
+	if i == nil {
+		return
+	}
+	var module string
+	if name := i.DottedName(); name != nil {
+		module = name.GetText()
+	}
+	targets, _ := i.ImportTargets().(*pythonparser.ImportTargetsContext)
+	// from a import * 无法知道导入了哪些名字
+	if targets == nil || targets.STAR() != nil {
+		return
+	}
+	names, _ := targets.ImportAsNames().(*pythonparser.ImportAsNamesContext)
+	if names == nil {
+		return
+	}
+	for _, raw := range names.AllImportAsName() {
+		alias, _ := raw.(*pythonparser.ImportAsNameContext)
+		if alias == nil {
+			continue
+		}
+		name, bindName := alias.NAME(0).GetText(), alias.NAME(0).GetText()
+		if alias.AS() != nil && alias.NAME(1) != nil {
+			bindName = alias.NAME(1).GetText()
+		}
+		b.AssignName(bindName, b.ReadMemberCallValue(b.importModule(module), b.EmitConstInstPlaceholder(name)))
+	}
+}
+
+// AssignTarget 将 value 赋给赋值语句左侧的目标，支持名字、属性、下标以及解包
+func (b *builder) AssignTarget(target antlr.Tree, value ssa.Value) {
+	b.assignTarget(target, value, false)
+}
+
+func (b *builder) assignTarget(target antlr.Tree, value ssa.Value, local bool) {
+	ctx, ok := target.(antlr.ParserRuleContext)
+	if !ok || value == nil {
+		return
+	}
+	recoverRange := b.SetRange(ctx)
+	defer recoverRange()
+
+	switch t := ctx.(type) {
+	case *pythonparser.TestlistStarExprContext, *pythonparser.ExprlistContext, *pythonparser.TestlistContext:
+		if elts, isTuple := elements(t); isTuple {
+			b.assignUnpack(elts, value, local)
+			return
+		}
+	case *pythonparser.TestlistCompContext:
+		if t.CompFor() != nil {
+			break
+		}
+		if elts, isTuple := elements(t); isTuple {
+			b.assignUnpack(elts, value, local)
+			return
+		}
+	case *pythonparser.StarExprContext:
+		b.assignTarget(t.Expr(), value, local)
+		return
+	case *pythonparser.AtomExprContext:
+		b.assignAtomExpr(t, value, local)
+		return
+	case *pythonparser.AtomContext:
+		switch {
+		case t.NAME() != nil:
+			name := t.NAME().GetText()
+			if local {
+				b.AssignVariable(b.CreateLocalVariable(name), value)
+				return
+			}
+			b.AssignName(name, value)
+			return
+		case t.OPEN_BRACK() != nil && t.TestlistComp() != nil:
+			// [a] = c 即使只有一个元素也是解包
+			elts, _ := elements(t.TestlistComp())
+			b.assignUnpack(elts, value, local)
+			return
+		case t.OPEN_PAREN() != nil && t.TestlistComp() != nil:
+			b.assignTarget(t.TestlistComp(), value, local)
+			return
+		}
+	}
+
+	// 其他只有一个子节点的语法节点，例如 test -> orTest -> ... -> atomExpr
+	if ctx.GetChildCount() == 1 {
+		if child, ok := ctx.GetChild(0).(antlr.ParserRuleContext); ok {
+			b.assignTarget(child, value, local)
+			return
+		}
+	}
+	b.NewError(ssa.Error, TAG, InvalidAssignTarget(ctx))
+}
+
+func (b *builder) assignAtomExpr(target *pythonparser.AtomExprContext, value ssa.Value, local bool) {
+	trailers := target.AllTrailer()
+	if len(trailers) == 0 {
+		b.assignTarget(target.Atom(), value, local)
+		return
+	}
+	obj := b.visitAtomExpr(target, len(trailers)-1)
+	if obj == nil {
+		return
+	}
+	last, _ := trailers[len(trailers)-1].(*pythonparser.TrailerContext)
+	switch {
+	case last == nil:
+	case last.NAME() != nil:
+		b.AssignVariable(b.CreateMemberCallVariable(obj, b.EmitConstInstPlaceholder(last.NAME().GetText())), value)
+		return
+	case last.Subscriptlist() != nil:
+		key := b.VisitSubscriptlist(last.Subscriptlist())
+		if key == nil {
+			return
+		}
+		b.AssignVariable(b.CreateMemberCallVariable(obj, key), value)
+		return
+	}
+	b.NewError(ssa.Error, TAG, InvalidAssignTarget(target))
+}
+
+func (b *builder) assignUnpack(elts []antlr.ParserRuleContext, value ssa.Value, local bool) {
+	for i, elt := range elts {
+		if starred, ok := elt.(*pythonparser.StarExprContext); ok {
+			// a, *b = c 中 b 获得剩余的元素，这里直接使用 c
+			b.assignTarget(starred.Expr(), value, local)
+			continue
+		}
+		b.assignTarget(elt, b.ReadMemberCallValue(value, b.EmitConstInst(i)), local)
+	}
+}
@@ -0,0 +1,333 @@
+package python2ssa
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// VisitStrings 计算一个或多个相邻的字符串字面量，相邻的字面量会被拼接，f-string 展开为字符串拼接
+func (b *builder) VisitStrings(tokens []antlr.TerminalNode) ssa.Value {
+	var result ssa.Value
+	var text strings.Builder
+	isFString := false
+	flush := func() {
+		if result == nil {
+			result = b.EmitConstInst("")
+		}
+		if text.Len() > 0 {
+			result = b.EmitBinOp(ssa.OpAdd, result, b.EmitConstInst(text.String()))
+			text.Reset()
+		}
+	}
+	appendValue := func(value ssa.Value) {
+		flush()
+		result = b.EmitBinOp(ssa.OpAdd, result, b.EmitTypeCast(value, ssa.BasicTypes[ssa.StringTypeKind]))
+	}
+
+	for _, token := range tokens {
+		lit := splitStringLiteral(token.GetText())
+		if !lit.format {
+			if lit.raw {
+				text.WriteString(lit.body)
+			} else {
+				text.WriteString(decodeEscapes(lit.body, lit.bytes))
+			}
+			continue
+		}
+		isFString = true
+		symbol := token.GetSymbol()
+		base := position{line: symbol.GetLine(), col: symbol.GetColumn() + lit.bodyOffset}
+		b.visitFStringParts([]rune(lit.body), base, lit.raw, &text, appendValue)
+	}
+
+	if !isFString {
+		return b.EmitConstInst(text.String())
+	}
+	flush()
+	return result
+}
+
+// position 是源码中的位置，行从 1 开始，列从 0 开始，和 antlr 的 Token 一致
+type position struct {
+	line, col int
+}
+
+// advance 计算从 p 开始经过 text 之后的位置
+func (p position) advance(text []rune) position {
+	for _, r := range text {
+		if r == '\n' {
+			p.line++
+			p.col = 0
+		} else {
+			p.col++
+		}
+	}
+	return p
+}
+
+type stringLiteral struct {
+	raw    bool
+	bytes  bool
+	format bool
+	// body 是去除前缀和引号后的内容
+	body string
+	// bodyOffset 是 body 相对于 Token 起始位置的字符偏移
+	bodyOffset int
+}
+
+func splitStringLiteral(text string) stringLiteral {
+	var lit stringLiteral
+	i := 0
+	for i < len(text) && text[i] != '"' && text[i] != '\'' {
+		switch text[i] {
+		case 'r', 'R':
+			lit.raw = true
+		case 'b', 'B':
+			lit.bytes = true
+		case 'f', 'F':
+			lit.format = true
+		}
+		i++
+	}
+	rest := text[i:]
+	quoteLen := 1
+	if len(rest) >= 6 && (strings.HasPrefix(rest, `"""`) || strings.HasPrefix(rest, `'''`)) {
+		quoteLen = 3
+	}
+	lit.bodyOffset = i + quoteLen
+	if len(rest) >= 2*quoteLen {
+		lit.body = rest[quoteLen : len(rest)-quoteLen]
+	}
+	return lit
+}
+
+// decodeEscapes 解码字符串中的转义序列，无法识别的转义按原样保留
+func decodeEscapes(s string, isBytes bool) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		switch e := s[i]; e {
+		case '\n':
+		case '\r':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				i++
+			}
+		case '\\', '\'', '"':
+			buf.WriteByte(e)
+		case 'a':
+			buf.WriteByte('\a')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'f':
+			buf.WriteByte('\f')
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 't':
+			buf.WriteByte('\t')
+		case 'v':
+			buf.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			n, _ := strconv.ParseUint(s[i:j], 8, 32)
+			writeCodePoint(&buf, rune(n), isBytes)
+			i = j - 1
+		case 'x', 'u', 'U':
+			width := 2
+			switch e {
+			case 'u':
+				width = 4
+			case 'U':
+				width = 8
+			}
+			if (!isBytes || e == 'x') && i+width < len(s) {
+				if n, err := strconv.ParseUint(s[i+1:i+1+width], 16, 32); err == nil {
+					writeCodePoint(&buf, rune(n), isBytes)
+					i += width
+					continue
+				}
+			}
+			buf.WriteByte('\\')
+			buf.WriteByte(e)
+		default:
+			buf.WriteByte('\\')
+			buf.WriteByte(e)
+		}
+	}
+	return buf.String()
+}
+
+func writeCodePoint(buf *strings.Builder, r rune, isBytes bool) {
+	if isBytes || r < utf8.RuneSelf {
+		buf.WriteByte(byte(r))
+		return
+	}
+	buf.WriteRune(r)
+}
+
+// visitFStringParts 将 f-string 的内容拆分为常量和替换字段，常量写入 text，替换字段的值交给 appendValue。
+// base 是 body 在源码中的起始位置
+func (b *builder) visitFStringParts(body []rune, base position, raw bool, text *strings.Builder, appendValue func(ssa.Value)) {
+	var buf strings.Builder
+	flush := func() {
+		if raw {
+			text.WriteString(buf.String())
+		} else {
+			text.WriteString(decodeEscapes(buf.String(), false))
+		}
+		buf.Reset()
+	}
+
+	for i := 0; i < len(body); i++ {
+		r := body[i]
+		switch {
+		case (r == '{' || r == '}') && i+1 < len(body) && body[i+1] == r:
+			buf.WriteRune(r)
+			i++
+		case r == '{':
+			flush()
+			i = b.visitReplacementField(body, i, base, raw, text, appendValue)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+}
+
+// visitReplacementField 处理从 body[open] 处 '{' 开始的替换字段，返回对应 '}' 的下标
+func (b *builder) visitReplacementField(body []rune, open int, base position, raw bool, text *strings.Builder, appendValue func(ssa.Value)) int {
+	exprStart := open + 1
+
+	// 找到表达式结束的位置：顶层的 '!'、':'、'=' 或 '}'
+	nesting := 0
+	var quote rune
+	i := exprStart
+	exprEnd := -1
+	for ; i < len(body); i++ {
+		r := body[i]
+		if quote != 0 {
+			if r == '\\' {
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '\'', '"':
+			quote = r
+		case '(', '[', '{':
+			nesting++
+		case ')', ']':
+			nesting--
+		case '}':
+			if nesting == 0 {
+				exprEnd = i
+			} else {
+				nesting--
+			}
+		case '!':
+			if nesting == 0 && !(i+1 < len(body) && body[i+1] == '=') {
+				exprEnd = i
+			}
+		case ':':
+			if nesting == 0 {
+				exprEnd = i
+			}
+		case '=':
+			if nesting == 0 && i+1 < len(body) && body[i+1] != '=' &&
+				i > exprStart && !strings.ContainsRune("=!<>", body[i-1]) {
+				exprEnd = i
+			}
+		}
+		if exprEnd >= 0 {
+			break
+		}
+	}
+	if exprEnd < 0 {
+		b.NewError(ssa.Error, TAG, UnexpectedFString("expecting '}'"))
+		return len(body)
+	}
+
+	value := b.visitFStringExpression(string(body[exprStart:exprEnd]), base.advance(body[:exprStart]))
+
+	i = exprEnd
+	if body[i] == '=' {
+		// f"{x=}" 会输出表达式文本本身
+		text.WriteString(string(body[exprStart : i+1]))
+		i++
+	}
+	if i < len(body) && body[i] == '!' {
+		// 转换 !r !s !a 不影响数据流
+		for i < len(body) && body[i] != ':' && body[i] != '}' {
+			i++
+		}
+	}
+	if i < len(body) && body[i] == ':' {
+		specStart := i + 1
+		nesting = 0
+		for i = specStart; i < len(body); i++ {
+			if body[i] == '{' {
+				nesting++
+			} else if body[i] == '}' {
+				if nesting == 0 {
+					break
+				}
+				nesting--
+			}
+		}
+		// 格式说明中也可以有替换字段，例如 {x:>{width}}，只计算其中的表达式
+		var spec strings.Builder
+		b.visitFStringParts(body[specStart:min(i, len(body))], base.advance(body[:specStart]), raw, &spec, func(ssa.Value) {})
+	}
+	if i >= len(body) || body[i] != '}' {
+		b.NewError(ssa.Error, TAG, UnexpectedFString("expecting '}'"))
+		return len(body)
+	}
+	appendValue(value)
+	return i
+}
+
+// visitFStringExpression 解析并计算 f-string 替换字段中的表达式，pos 是表达式在源码中的位置。
+// 表达式前用空行和空格补齐，使解析得到的 Token 位置和源码中的位置一致
+func (b *builder) visitFStringExpression(expr string, pos position) ssa.Value {
+	if strings.TrimSpace(expr) == "" {
+		b.NewError(ssa.Error, TAG, UnexpectedFString("empty expression not allowed"))
+		return b.EmitConstInst("")
+	}
+	// 表达式放在括号中解析，这样其中的换行不会被当作语句结束
+	src := strings.Repeat("\n", pos.line-1) + strings.Repeat(" ", max(pos.col-1, 0)) + "(" + expr + ")"
+
+	errListener := antlr4util.NewErrorListener()
+	lexer := pythonparser.NewPython3Lexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	parser := pythonparser.NewPython3Parser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+	atom := parser.Atom()
+	if len(errListener.GetErrors()) > 0 {
+		b.NewError(ssa.Error, TAG, UnexpectedFString(errListener.GetErrorString()))
+		return b.EmitUndefined(expr)
+	}
+	return b.VisitExpression(atom)
+}
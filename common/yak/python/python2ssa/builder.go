@@ -0,0 +1,66 @@
+package python2ssa
+
+import (
+	"path/filepath"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	*ssa.PreHandlerInit
+}
+
+type builder struct {
+	*ssa.FunctionBuilder
+	ast pythonparser.IFileInputContext
+
+	// scope 记录当前函数中 global/nonlocal 声明的名字
+	scope *functionScope
+}
+
+var Builder ssa.Builder = &SSABuilder{}
+
+func (*SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	ast, err := Frontend(src, force)
+	if err != nil {
+		return err
+	}
+	b.SupportClosure = true
+	build := &builder{
+		FunctionBuilder: b,
+		ast:             ast,
+		scope:           newFunctionScope(nil),
+	}
+	build.VisitFileInput(ast)
+	return nil
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return filepath.Ext(path) == ".py"
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.PYTHON
+}
+
+func Frontend(src string, force bool) (pythonparser.IFileInputContext, error) {
+	errListener := antlr4util.NewErrorListener()
+	lexer := pythonparser.NewPython3Lexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := pythonparser.NewPython3Parser(tokenStream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+	ast := parser.FileInput()
+	if force || len(errListener.GetErrors()) == 0 {
+		return ast, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error: %v", errListener.GetErrorString())
+}
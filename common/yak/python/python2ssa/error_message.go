@@ -0,0 +1,41 @@
+package python2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "Python"
+
+func UnhandledStatement(stmt any) string {
+	return fmt.Sprintf("unhandled statement: %T", stmt)
+}
+
+func UnhandledExpression(expr any) string {
+	return fmt.Sprintf("unhandled expression: %T", expr)
+}
+
+func UnexpectedBinaryOP(op string) string {
+	return fmt.Sprintf("unexpected binary operator: %s", op)
+}
+
+func UnexpectedUnaryOP(op string) string {
+	return fmt.Sprintf("unexpected unary operator: %s", op)
+}
+
+func InvalidAssignTarget(target any) string {
+	return fmt.Sprintf("invalid assignment target: %T", target)
+}
+
+func UnexpectedBreakStmt() string {
+	return "'break' outside loop"
+}
+
+func UnexpectedContinueStmt() string {
+	return "'continue' not properly in loop"
+}
+
+func UnexpectedFString(reason string) string {
+	return fmt.Sprintf("f-string: %s", reason)
+}
@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestBasic_Assign(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+a = 1
+b = a + 2
+println(b)
+`, []string{"3"}, t)
+}
+
+func TestBasic_If(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+a = 1
+if cond:
+    a = 2
+elif other:
+    a = 3
+println(a)
+`, []string{"phi(a)[2,phi(a)[3,1]]"}, t)
+}
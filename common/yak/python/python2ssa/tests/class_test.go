@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestClass_Member(t *testing.T) {
+	ssatest.CheckSyntaxFlow(t, `
+class Foo:
+    def __init__(self, name):
+        self.name = name
+
+    def get(self):
+        return self.name
+
+f = Foo("bar")
+println(f.name)
+`, `println(* #-> * as $target)`, map[string][]string{
+		"target": {`"bar"`},
+	}, ssaapi.WithLanguage(ssaapi.PYTHON))
+}
+
+func TestClass_Method(t *testing.T) {
+	ssatest.CheckSyntaxFlow(t, `
+class Foo:
+    def run(self, cmd):
+        os.system(cmd)
+
+Foo().run("ls")
+`, `system(* #-> * as $target)`, map[string][]string{
+		"target": {`"ls"`},
+	}, ssaapi.WithLanguage(ssaapi.PYTHON))
+}
+
+func TestClass_Decorator(t *testing.T) {
+	ssatest.CheckSyntaxFlow(t, `
+@app.route("/index")
+def index():
+    return "hello"
+`, `app.route(* as $path)`, map[string][]string{
+		"path": {`"/index"`},
+	}, ssaapi.WithLanguage(ssaapi.PYTHON))
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestImport_Sink(t *testing.T) {
+	t.Run("import module", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, `
+import os
+from flask import request
+
+def run():
+    cmd = request.args.get("cmd")
+    os.system("ping " + cmd)
+`, `os.system(* #-> * as $target)`, map[string][]string{
+			"target": {`"cmd"`, `"ping "`, `ParameterMember-parameterMember[0].get`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("import from with alias", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, `
+from subprocess import call as run_cmd
+run_cmd(["ls", path], shell=True)
+`, `subprocess.call(* as $args)`, map[string][]string{
+			"args": {`make(object{})`, `true`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
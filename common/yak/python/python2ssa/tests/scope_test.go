@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestScope_Function(t *testing.T) {
+	t.Run("local assign does not leak", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+a = 1
+def f():
+    a = 2
+f()
+println(a)
+`, []string{"1"}, t)
+	})
+
+	t.Run("global declaration", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+a = 1
+def f():
+    global a
+    a = 2
+f()
+println(a)
+`, []string{"side-effect(2, a)"}, t)
+	})
+
+	t.Run("closure read", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+a = 1
+def f():
+    println(a)
+`, []string{"FreeValue-a"}, t)
+	})
+}
+
+func TestScope_Comprehension(t *testing.T) {
+	ssatest.CheckPrintlnValue(`
+x = 1
+items = [x * 2 for x in data if x]
+println(x)
+`, []string{"1"}, t)
+}
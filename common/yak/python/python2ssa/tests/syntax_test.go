@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func validateSource(t *testing.T, filename string, src string) {
+	t.Run(fmt.Sprintf("syntax file: %v", filename), func(t *testing.T) {
+		_, err := python2ssa.Frontend(src, false)
+		require.Nil(t, err, "parse AST FrontEnd error : %v", err)
+	})
+}
+
+func TestSyntax_Statements(t *testing.T) {
+	validateSource(t, "statements", `import os, sys as system
+from .utils import (a, b as c,)
+from flask import *
+
+@app.route("/", methods=["GET"])
+def index(x, /, y: int = 1, *args, z, **kw) -> str:
+    global counter
+    counter += 1
+    if x:
+        return x
+    elif y:
+        pass
+    else:
+        raise ValueError("bad") from None
+    for i, j in items:
+        continue
+    else:
+        del i
+    while True:
+        break
+    with open(path) as f, lock:
+        data = f.read()
+    try:
+        yield data
+    except (IOError, OSError) as e:
+        assert e, "msg"
+    else:
+        x = y = 2
+    finally:
+        return
+
+class Foo(Base, metaclass=Meta):
+    name: str = "foo"
+    async def bar(self):
+        await self.baz()
+`)
+}
+
+func TestSyntax_Expressions(t *testing.T) {
+	for _, src := range []string{
+		"a if b else c",
+		"lambda x, *y, **z: x + 1",
+		"not a and b or c",
+		"a < b <= c != d is not e not in f",
+		"-a ** -b // c @ d",
+		"x[1:2, ::3, ...]",
+		"f(a, *b, c=1, **d)",
+		"f(x for x in y if x)",
+		"f(y := 10)",
+		"[x * y for x in a for y in b if x if y]",
+		"{k: v for k, v in d.items()}",
+		"{x for x in s}",
+		"{**a, 'b': 1}",
+		"{1, 2, *c}",
+		"(y := 10)",
+		"()",
+		"(1,)",
+		"a, *b = c",
+		"0x1F + 0o7 + 0b1 + 1_000 + 1.5e-3 + 2j",
+	} {
+		validateSource(t, src, src+"\n")
+	}
+}
+
+func TestSyntax_Error(t *testing.T) {
+	_, err := python2ssa.Frontend(`a = 1
+b = (
+def f():
+    return 1
+`, false)
+	require.Error(t, err)
+}
+
+func TestSyntax_Strings(t *testing.T) {
+	t.Run("concat and escape", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+a = "x\n" 'y' r"\d"
+b = b"\x41"
+println(a)
+println(b)
+`, []string{`"x\ny\\d"`, `"A"`}, t)
+	})
+
+	t.Run("multi line", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+a = """multi
+line"""
+println(a)
+`, []string{`"multi\nline"`}, t)
+	})
+
+	t.Run("f-string", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+name = "yak"
+println(f"hello {name!r:>{width}} {{ok}}")
+`, []string{`add(add("hello ", castType(string, "yak")), " {ok}")`}, t)
+	})
+}
@@ -0,0 +1,11 @@
+package tests
+
+import (
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func init() {
+	test.SetLanguage(consts.PYTHON, python2ssa.Builder)
+}
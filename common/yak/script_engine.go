@@ -26,6 +26,7 @@ import (
 
 	"github.com/yaklang/yaklang/common/openapi"
 
+	"github.com/yaklang/yaklang/common/bin-parser/binfuzz"
 	"github.com/yaklang/yaklang/common/binx"
 	"github.com/yaklang/yaklang/common/utils/yakgit"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
@@ -300,6 +301,7 @@ func initYaklangLib() {
 
 	// binx
 	yaklang.Import("bin", binx.Exports)
+	yaklang.Import("binfuzz", binfuzz.Exports)
 
 	// ssa
 	yaklang.Import("ssa", ssaapi.Exports)
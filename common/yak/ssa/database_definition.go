@@ -0,0 +1,117 @@
+package ssa
+
+import (
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// databaseDefinitions is the functions and blueprints defined in the files
+// not recompiled by incremental compile, they are only in database.
+type databaseDefinitions struct {
+	functions  map[string]int64 // function name -> IrCode id
+	blueprints map[string]int64 // blueprint name -> IrType id
+
+	loadedFunctions  *utils.SafeMap[*Function]
+	loadedBlueprints *utils.SafeMap[*Blueprint]
+}
+
+// SetDatabaseDefinitions set the functions and blueprints saved in database,
+// when the compiling code reference a function or blueprint not found in current program,
+// it will be loaded from database as lazy value.
+func (prog *Program) SetDatabaseDefinitions(functions, blueprints map[string]int64) {
+	if prog == nil || prog.Application == nil {
+		return
+	}
+	if len(functions) == 0 && len(blueprints) == 0 {
+		prog.Application.databaseDefinitions = nil
+		return
+	}
+	prog.Application.databaseDefinitions = &databaseDefinitions{
+		functions:        functions,
+		blueprints:       blueprints,
+		loadedFunctions:  utils.NewSafeMap[*Function](),
+		loadedBlueprints: utils.NewSafeMap[*Blueprint](),
+	}
+}
+
+func (prog *Program) getDatabaseDefinitions() *databaseDefinitions {
+	if prog == nil || prog.Application == nil || !prog.Application.EnableDatabase {
+		return nil
+	}
+	return prog.Application.databaseDefinitions
+}
+
+func (prog *Program) getFunctionFromDB(name string) *Function {
+	defs := prog.getDatabaseDefinitions()
+	if defs == nil {
+		return nil
+	}
+	if fun, ok := defs.loadedFunctions.Get(name); ok {
+		return fun
+	}
+	id, ok := defs.functions[name]
+	if !ok {
+		return nil
+	}
+	inst := prog.Application.Cache.GetInstruction(id)
+	fun, ok := ToFunction(inst)
+	if !ok {
+		log.Warnf("load function %s(%d) from database failed", name, id)
+		return nil
+	}
+	defs.loadedFunctions.Set(name, fun)
+	return fun
+}
+
+func (prog *Program) getBlueprintFromDB(name string) *Blueprint {
+	defs := prog.getDatabaseDefinitions()
+	if defs == nil {
+		return nil
+	}
+	if blueprint, ok := defs.loadedBlueprints.Get(name); ok {
+		return blueprint
+	}
+	id, ok := defs.blueprints[name]
+	if !ok {
+		return nil
+	}
+	blueprint, ok := ToClassBluePrintType(GetTypeFromDB(int(id)))
+	if !ok {
+		log.Warnf("load blueprint %s(%d) from database failed", name, id)
+		return nil
+	}
+	// set before load relation, avoid circular loading
+	defs.loadedBlueprints.Set(name, blueprint)
+
+	// the parent blueprint in database has no method, use the loaded one
+	for i, parent := range blueprint.ParentBlueprints {
+		if loaded := prog.getBlueprintFromDB(parent.Name); loaded != nil {
+			blueprint.ParentBlueprints[i] = loaded
+		}
+	}
+	for i, parent := range blueprint.InterfaceBlueprints {
+		if loaded := prog.getBlueprintFromDB(parent.Name); loaded != nil {
+			blueprint.InterfaceBlueprints[i] = loaded
+		}
+	}
+
+	container := blueprint.Container()
+	if utils.IsNil(container) {
+		return blueprint
+	}
+	// the container type is another blueprint instance from database
+	container.SetType(blueprint)
+	// methods are saved as member of container
+	container.ForEachMember(func(_ Value, member Value) bool {
+		fun, ok := ToFunction(member)
+		if !ok || !fun.isMethod || fun.methodName == "" {
+			return true
+		}
+		if fun.Type != nil && fun.Type.IsMethod {
+			blueprint.NormalMethod[fun.methodName] = fun
+		} else {
+			blueprint.StaticMethod[fun.methodName] = fun
+		}
+		return true
+	})
+	return blueprint
+}
@@ -99,6 +99,10 @@ func fetchIds(origin any) any {
 
 func marshalExtraInformation(raw Instruction) map[string]any {
 	params := make(map[string]any)
+	// instruction loaded from database is saved again when it is used by new instruction
+	if lz, ok := ToLazyInstruction(raw); ok {
+		raw = lz.Self()
+	}
 	switch ret := raw.(type) {
 	case *Function:
 		params["params"] = fetchIds(ret.Params)
@@ -115,6 +119,8 @@ func marshalExtraInformation(raw Instruction) map[string]any {
 		}
 		params["is_method"] = ret.isMethod
 		params["method_name"] = ret.methodName
+		// normal method bind the object as the first parameter, static method not
+		params["is_object_method"] = ret.Type != nil && ret.Type.IsMethod
 		params["parameter_members"] = fetchIds(ret.ParameterMembers)
 		var sideEffects []map[string]any
 		for _, se := range ret.SideEffects {
@@ -442,6 +448,13 @@ func unmarshalExtraInformation(inst Instruction, ir *ssadb.IrCode) {
 				ret.currentBlueprint = blueprint
 			}
 		}
+		if utils.MapGetBool(params, "is_object_method") {
+			if ret.currentBlueprint != nil {
+				ret.SetMethod(true, ret.currentBlueprint)
+			} else {
+				ret.SetMethod(true, nil)
+			}
+		}
 		if ses := params["side_effect"]; ses != nil && funk.IsIteratee(ses) {
 			var se []*FunctionSideEffect
 			funk.ForEach(params["side_effect"], func(a any) {
@@ -34,7 +34,12 @@ func NewProgramFromDB(p *ssadb.IrProgram) *Program {
 func (prog *Program) UpdateToDatabase() {
 	ir := prog.irProgram
 	if ir == nil {
-		ir = ssadb.CreateProgram(prog.Name, prog.Version, prog.ProgramKind)
+		// 增量编译时程序已经存在，直接更新
+		if exist, err := ssadb.GetProgram(prog.Name, prog.ProgramKind); err == nil {
+			ir = exist
+		} else {
+			ir = ssadb.CreateProgram(prog.Name, prog.Version, prog.ProgramKind)
+		}
 		prog.irProgram = ir
 	}
 	ir.Language = prog.Language
@@ -120,8 +120,7 @@ func GetTypeFromDB(id int) Type {
 		typ.Kind = TypeKind(kind)
 		return typ
 	case ClassBluePrintTypeKind:
-		typ := &Blueprint{}
-		typ.Name = getParamStr("name")
+		typ := NewBlueprint(getParamStr("name"))
 		typ.fullTypeName = utils.InterfaceToStringSlice(params["fullTypeName"])
 		typ.Kind = ValidBlueprintKind(getParamStr("kind"))
 		parents, ok := params["parentBlueprints"].([]interface{})
@@ -60,6 +60,11 @@ func (p *Program) GetClassBlueprintEx(name string, pkg string, token ...CanStart
 	//		return blueprint
 	//	}
 	//}
+
+	// defined in unchanged file when incremental compile
+	if blueprint := p.getBlueprintFromDB(name); blueprint != nil {
+		return blueprint
+	}
 	return nil
 
 }
@@ -88,6 +93,11 @@ func (prog *Program) GetFunctionEx(name, pkg string) *Function {
 			return fun
 		}
 	}
+
+	// defined in unchanged file when incremental compile
+	if fun := prog.getFunctionFromDB(name); fun != nil {
+		return fun
+	}
 	return nil
 }
 
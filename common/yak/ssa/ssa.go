@@ -299,6 +299,9 @@ type Program struct {
 	// Template Language
 	Template map[string]tl.TemplateGeneratedInfo
 
+	// definitions in unchanged files when incremental compile, load from database lazily
+	databaseDefinitions *databaseDefinitions
+
 	config *LanguageConfig
 }
 
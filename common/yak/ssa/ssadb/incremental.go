@@ -0,0 +1,402 @@
+package ssadb
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// 一次 IN 查询中的最大参数数量，避免超过 sqlite 的变量数量限制
+const incrementalQueryBatchSize = 500
+
+// GetIrSourceHashes 返回程序中所有已保存的源文件，key 为不包含程序名的文件路径
+func GetIrSourceHashes(db *gorm.DB, programName string) (map[string]*IrSource, error) {
+	var sources []*IrSource
+	if err := db.Model(&IrSource{}).Where("program_name = ?", programName).Find(&sources).Error; err != nil {
+		return nil, utils.Wrapf(err, "query source of program %s failed", programName)
+	}
+	ret := make(map[string]*IrSource, len(sources))
+	for _, source := range sources {
+		// 文件夹没有内容
+		if source.QuotedCode == "" {
+			continue
+		}
+		_, filePath := splitProjectPath(irSourceJoin(source.FolderPath, source.FileName))
+		ret[filePath] = source
+	}
+	return ret, nil
+}
+
+// GetCode 返回源文件的内容
+func (irSource *IrSource) GetCode() string {
+	if code, err := strconv.Unquote(irSource.QuotedCode); err == nil {
+		return code
+	}
+	return irSource.QuotedCode
+}
+
+// IrAffectedCode 是一组源文件变化后失效的 IR，
+// 除了这些文件中的 IR，使用了这些 IR 的其他文件也会失效
+type IrAffectedCode struct {
+	// 失效的源文件 hash，包含依赖变化文件的文件
+	SourceHashes []string
+	// 失效的 IrCode id
+	CodeIDs []int64
+	// 失效的函数名与类名
+	Functions  []string
+	Blueprints []string
+}
+
+type irBlueprintInfo struct {
+	id        int64
+	name      string
+	container int64
+	parents   []int64
+}
+
+// loadIrBlueprints 返回程序中定义的类以及类所在的 IrCode
+func loadIrBlueprints(db *gorm.DB, programName string) ([]*irBlueprintInfo, error) {
+	blueprints := make([]*irBlueprintInfo, 0)
+	// 类型按内容去重保存，记录的程序名不一定是当前程序，因此通过 IrCode 引用的类型来查找
+	var typeIDs []int64
+	if err := db.Model(&IrCode{}).Where("program_name = ?", programName).
+		Pluck("DISTINCT(type_id)", &typeIDs).Error; err != nil {
+		return nil, utils.Wrapf(err, "query type id of program %s failed", programName)
+	}
+	var types []*IrType
+	if err := db.Model(&IrType{}).Where("program_name = ?", programName).Find(&types).Error; err != nil {
+		return nil, utils.Wrapf(err, "query type of program %s failed", programName)
+	}
+	loaded := make(map[uint]struct{}, len(types))
+	for _, typ := range types {
+		loaded[typ.ID] = struct{}{}
+	}
+	for _, batch := range groupInt64(typeIDs, incrementalQueryBatchSize) {
+		var referenced []*IrType
+		if err := db.Model(&IrType{}).Where("id IN (?)", batch).Find(&referenced).Error; err != nil {
+			return nil, utils.Wrapf(err, "query type failed")
+		}
+		for _, typ := range referenced {
+			if _, ok := loaded[typ.ID]; ok {
+				continue
+			}
+			loaded[typ.ID] = struct{}{}
+			types = append(types, typ)
+		}
+	}
+	for _, typ := range types {
+		var extra struct {
+			Name       string  `json:"name"`
+			Container  *int64  `json:"container"`
+			Parents    []int64 `json:"parentBlueprints"`
+			Interfaces []int64 `json:"interfaceBlueprints"`
+		}
+		if err := json.Unmarshal([]byte(typ.ExtraInformation), &extra); err != nil || extra.Container == nil {
+			continue
+		}
+		blueprints = append(blueprints, &irBlueprintInfo{
+			id:        int64(typ.ID),
+			name:      extra.Name,
+			container: *extra.Container,
+			parents:   append(extra.Parents, extra.Interfaces...),
+		})
+	}
+	return blueprints, nil
+}
+
+// GetAffectedIrCode 计算 hashes 对应的源文件失效后需要一起失效的 IR，
+// 依赖关系来自 IrCode 的 Users 以及类的继承和类型引用，会一直传递到没有新的文件为止
+func GetAffectedIrCode(db *gorm.DB, programName string, hashes []string) (*IrAffectedCode, error) {
+	ret := &IrAffectedCode{}
+	visitedHash := make(map[string]struct{})
+	visitedCode := make(map[int64]struct{})
+	affectedBlueprint := make(map[int64]struct{})
+
+	var blueprints []*irBlueprintInfo
+	loadBlueprints := func() (err error) {
+		if blueprints == nil {
+			blueprints, err = loadIrBlueprints(db, programName)
+		}
+		return err
+	}
+
+	queue := hashes
+	for len(queue) > 0 {
+		current := make([]string, 0, len(queue))
+		for _, hash := range queue {
+			if _, ok := visitedHash[hash]; ok || hash == "" {
+				continue
+			}
+			visitedHash[hash] = struct{}{}
+			current = append(current, hash)
+			ret.SourceHashes = append(ret.SourceHashes, hash)
+		}
+		queue = nil
+		if len(current) == 0 {
+			break
+		}
+
+		var users []int64
+		newCodes := make(map[int64]struct{})
+		for _, batch := range utils.SliceGroup(current, incrementalQueryBatchSize) {
+			var codes []*IrCode
+			if err := db.Model(&IrCode{}).
+				Select("id, name, verbose_name, is_function, users").
+				Where("program_name = ? AND source_code_hash IN (?)", programName, batch).
+				Find(&codes).Error; err != nil {
+				return nil, utils.Wrapf(err, "query ir code failed")
+			}
+			for _, code := range codes {
+				id := int64(code.ID)
+				if _, ok := visitedCode[id]; ok {
+					continue
+				}
+				visitedCode[id] = struct{}{}
+				newCodes[id] = struct{}{}
+				ret.CodeIDs = append(ret.CodeIDs, id)
+				if code.IsFunction {
+					name := code.VerboseName
+					if name == "" {
+						name = code.Name
+					}
+					ret.Functions = append(ret.Functions, name)
+				}
+				users = append(users, code.Users...)
+			}
+		}
+
+		// 定义在失效代码中的类，以及继承了这些类的类都会失效
+		if err := loadBlueprints(); err != nil {
+			return nil, err
+		}
+		var blueprintIDs []int64
+		for changed := true; changed; {
+			changed = false
+			for _, blueprint := range blueprints {
+				if _, ok := affectedBlueprint[blueprint.id]; ok {
+					continue
+				}
+				_, inCode := newCodes[blueprint.container]
+				inherit := false
+				for _, parent := range blueprint.parents {
+					if _, ok := affectedBlueprint[parent]; ok {
+						inherit = true
+						break
+					}
+				}
+				if !inCode && !inherit {
+					continue
+				}
+				affectedBlueprint[blueprint.id] = struct{}{}
+				blueprintIDs = append(blueprintIDs, blueprint.id)
+				ret.Blueprints = append(ret.Blueprints, blueprint.name)
+				if inherit {
+					users = append(users, blueprint.container)
+				}
+				changed = true
+			}
+		}
+
+		// 使用者所在的文件
+		next, err := getSourceHashByCodeID(db, programName, users)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, next...)
+		// 引用了失效类的值所在的文件
+		for _, batch := range groupInt64(blueprintIDs, incrementalQueryBatchSize) {
+			var sourceHashes []string
+			if err := db.Model(&IrCode{}).
+				Where("program_name = ? AND type_id IN (?)", programName, batch).
+				Pluck("DISTINCT(source_code_hash)", &sourceHashes).Error; err != nil {
+				return nil, utils.Wrapf(err, "query ir code by type failed")
+			}
+			queue = append(queue, sourceHashes...)
+		}
+	}
+	return ret, nil
+}
+
+func getSourceHashByCodeID(db *gorm.DB, programName string, ids []int64) ([]string, error) {
+	var ret []string
+	for _, batch := range groupInt64(ids, incrementalQueryBatchSize) {
+		var sourceHashes []string
+		if err := db.Model(&IrCode{}).
+			Where("program_name = ? AND id IN (?)", programName, batch).
+			Pluck("DISTINCT(source_code_hash)", &sourceHashes).Error; err != nil {
+			return nil, utils.Wrapf(err, "query ir code source failed")
+		}
+		ret = append(ret, sourceHashes...)
+	}
+	return ret, nil
+}
+
+// DeleteAffectedIrCode 删除失效的 IR 以及对应的索引、偏移和源文件
+func DeleteAffectedIrCode(db *gorm.DB, programName string, affected *IrAffectedCode) {
+	if affected == nil {
+		return
+	}
+	for _, batch := range utils.SliceGroup(affected.SourceHashes, incrementalQueryBatchSize) {
+		db.Model(&IrCode{}).Where("program_name = ? AND source_code_hash IN (?)", programName, batch).Unscoped().Delete(&IrCode{})
+		db.Model(&IrOffset{}).Where("program_name = ? AND file_hash IN (?)", programName, batch).Unscoped().Delete(&IrOffset{})
+		db.Model(&IrSource{}).Where("program_name = ? AND source_code_hash IN (?)", programName, batch).Unscoped().Delete(&IrSource{})
+	}
+	for _, batch := range groupInt64(affected.CodeIDs, incrementalQueryBatchSize) {
+		db.Model(&IrIndex{}).Where("program_name = ? AND value_id IN (?)", programName, batch).Unscoped().Delete(&IrIndex{})
+		db.Model(&IrOffset{}).Where("program_name = ? AND value_id IN (?)", programName, batch).Unscoped().Delete(&IrOffset{})
+	}
+
+	deleted := make(map[int64]struct{}, len(affected.CodeIDs))
+	for _, id := range affected.CodeIDs {
+		deleted[id] = struct{}{}
+		// 缓存中删除的 IR 会在重新编译时被加载并再次保存
+		irCodeCache.Remove(id)
+	}
+	removeDeletedUsers(db, programName, deleted)
+}
+
+// removeDeletedUsers 从未失效的 IR 的使用者中移除删除的 IR，
+// 例如未变化文件中的函数仍记录着重新编译的文件中对它的调用
+func removeDeletedUsers(db *gorm.DB, programName string, deleted map[int64]struct{}) {
+	if len(deleted) == 0 {
+		return
+	}
+	var codes []*IrCode
+	if err := db.Model(&IrCode{}).Select("id, users").
+		Where("program_name = ?", programName).Find(&codes).Error; err != nil {
+		log.Errorf("query users of program %s failed: %v", programName, err)
+		return
+	}
+	for _, code := range codes {
+		users := make(Int64Slice, 0, len(code.Users))
+		for _, user := range code.Users {
+			if _, ok := deleted[user]; !ok {
+				users = append(users, user)
+			}
+		}
+		if len(users) == len(code.Users) {
+			continue
+		}
+		db.Model(&IrCode{}).Where("id = ?", code.ID).Update("users", users)
+		irCodeCache.Remove(int64(code.ID))
+	}
+}
+
+// GetAuditRuleNamesByIrCode 返回扫描结果中引用了失效 IR 的规则名
+func GetAuditRuleNamesByIrCode(db *gorm.DB, programName string, affected *IrAffectedCode) ([]string, error) {
+	if affected == nil {
+		return nil, nil
+	}
+	names := make(map[string]struct{})
+	add := func(query *gorm.DB) error {
+		var ruleNames []string
+		if err := query.Pluck("DISTINCT(rule_name)", &ruleNames).Error; err != nil {
+			return utils.Wrapf(err, "query audit node failed")
+		}
+		for _, name := range ruleNames {
+			names[name] = struct{}{}
+		}
+		return nil
+	}
+	for _, batch := range groupInt64(affected.CodeIDs, incrementalQueryBatchSize) {
+		if err := add(db.Model(&AuditNode{}).Where("program_name = ? AND ir_code_id IN (?)", programName, batch)); err != nil {
+			return nil, err
+		}
+	}
+	for _, batch := range utils.SliceGroup(affected.SourceHashes, incrementalQueryBatchSize) {
+		if err := add(db.Model(&AuditNode{}).Where("program_name = ? AND tmp_value_file_hash IN (?)", programName, batch)); err != nil {
+			return nil, err
+		}
+	}
+	return utils.GetSortedMapKeys(names), nil
+}
+
+// GetAuditRuleNamesByProgram 返回在程序上执行过的规则名
+func GetAuditRuleNamesByProgram(db *gorm.DB, programName string) ([]string, error) {
+	var ruleNames []string
+	if err := db.Model(&AuditResult{}).Where("program_name = ? AND rule_name != ?", programName, "").
+		Pluck("DISTINCT(rule_name)", &ruleNames).Error; err != nil {
+		return nil, utils.Wrapf(err, "query audit result failed")
+	}
+	return ruleNames, nil
+}
+
+func groupInt64(origin []int64, size int) [][]int64 {
+	var ret [][]int64
+	for start := 0; start < len(origin); start += size {
+		end := start + size
+		if end > len(origin) {
+			end = len(origin)
+		}
+		ret = append(ret, origin[start:end])
+	}
+	return ret
+}
+
+// IrDefinitions 是未失效的源文件中定义的函数与类，
+// 增量编译时重新编译的文件引用了这些定义，会从数据库中加载
+type IrDefinitions struct {
+	// 函数名到 IrCode id，不包含类的方法和闭包
+	Functions map[string]int64
+	// 类名到 IrType id
+	Blueprints map[string]int64
+}
+
+// GetIrDefinitions 返回定义在 hashes 以外的源文件中的函数与类
+func GetIrDefinitions(db *gorm.DB, programName string, hashes []string) (*IrDefinitions, error) {
+	excluded := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		excluded[hash] = struct{}{}
+	}
+	ret := &IrDefinitions{
+		Functions:  make(map[string]int64),
+		Blueprints: make(map[string]int64),
+	}
+	unchangedCodes := make(map[int64]struct{})
+
+	var codes []*IrCode
+	if err := db.Model(&IrCode{}).
+		Select("id, name, is_function, source_code_hash, extra_information").
+		Where("program_name = ?", programName).
+		Find(&codes).Error; err != nil {
+		return nil, utils.Wrapf(err, "query ir code of program %s failed", programName)
+	}
+	for _, code := range codes {
+		if _, ok := excluded[code.SourceCodeHash]; ok || code.SourceCodeHash == "" {
+			continue
+		}
+		id := int64(code.ID)
+		unchangedCodes[id] = struct{}{}
+		// @main 等编译器生成的函数
+		if !code.IsFunction || code.Name == "" || strings.HasPrefix(code.Name, "@") {
+			continue
+		}
+		extra := code.GetExtraInfo()
+		if utils.MapGetBool(extra, "is_method") {
+			continue
+		}
+		if _, ok := extra["parent"]; ok {
+			continue
+		}
+		if _, ok := ret.Functions[code.Name]; !ok {
+			ret.Functions[code.Name] = id
+		}
+	}
+
+	blueprints, err := loadIrBlueprints(db, programName)
+	if err != nil {
+		return nil, err
+	}
+	for _, blueprint := range blueprints {
+		if _, ok := unchangedCodes[blueprint.container]; !ok || blueprint.name == "" {
+			continue
+		}
+		if _, ok := ret.Blueprints[blueprint.name]; !ok {
+			ret.Blueprints[blueprint.name] = blueprint.id
+		}
+	}
+	return ret, nil
+}
@@ -75,10 +75,7 @@ func SaveFile(filename, content string, programName string, folderPaths []string
 	fullPathParts = append(fullPathParts, folderPaths...)
 	fullPath := irSourceJoin(fullPathParts...)
 	// calc file hash
-	folderPath := irSourceJoin(folderPaths...)
-	fileUrl := irSourceJoin(folderPath, filename)
-	editor := memedit.NewMemEditorWithFileUrl(content, fileUrl)
-	hash := editor.GetIrSourceHash(programName)
+	_, hash := CalcIrSourceHash(filename, content, programName, folderPaths)
 
 	irSource := &IrSource{
 		ProgramName:    programName,
@@ -92,6 +89,13 @@ func SaveFile(filename, content string, programName string, folderPaths []string
 	return irSource.SourceCodeHash
 }
 
+// CalcIrSourceHash 计算文件保存到数据库时使用的路径和 hash，路径不包含程序名
+func CalcIrSourceHash(filename, content string, programName string, folderPaths []string) (string, string) {
+	fileUrl := irSourceJoin(irSourceJoin(folderPaths...), filename)
+	editor := memedit.NewMemEditorWithFileUrl(content, fileUrl)
+	return fileUrl, editor.GetIrSourceHash(programName)
+}
+
 func SaveFolder(folderName string, folderPaths []string) error {
 	start := time.Now()
 	defer func() {
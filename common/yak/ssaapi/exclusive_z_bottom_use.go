@@ -203,6 +203,9 @@ func (v *Value) getBottomUses(actx *AnalyzeContext, opt ...OperationOption) (res
 				val := v.NewBottomUseValue(fun.GetValueById(fun.ParameterMembers[index]))
 				vals = append(vals, val.getBottomUses(actx, opt...)...)
 			})
+		} else {
+			// 前端发起的 HTTP 请求，进入关联的后端路由处理函数
+			vals = append(vals, v.getHTTPRouteBottomUses(actx, opt...)...)
 		}
 		if vals.Len() > 0 {
 			return vals
@@ -0,0 +1,565 @@
+package ssaapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// HTTPRoute 是后端程序中处理 HTTP 请求的路由，目前支持 Spring 的 *Mapping 注解和 @WebServlet 注解的 Servlet
+type HTTPRoute struct {
+	// Method 是大写的请求方法，为空表示接受任意方法
+	Method  string
+	Path    string
+	Handler *Value
+}
+
+func (r *HTTPRoute) String() string {
+	method := r.Method
+	if method == "" {
+		method = "*"
+	}
+	return fmt.Sprintf("%s %s", method, r.Path)
+}
+
+// GetRequestParameters 返回处理函数中接收请求数据的参数，不包括 this 和响应对象
+func (r *HTTPRoute) GetRequestParameters() Values {
+	return lo.Filter(r.Handler.GetParameters(), func(param *Value, _ int) bool {
+		if param.GetName() == "this" {
+			return false
+		}
+		if typ := param.GetType(); typ != nil && strings.Contains(typ.String(), "Response") {
+			return false
+		}
+		return true
+	})
+}
+
+// HTTPRequestSite 是前端程序中发起 HTTP 请求的调用点，目前支持 fetch 和 axios
+type HTTPRequestSite struct {
+	// Method 是大写的请求方法
+	Method string
+	Path   string
+	// Dynamic 表示 URL 只有前缀是常量，例如 "/api/user/" + id
+	Dynamic bool
+	Call    *Value
+}
+
+func (s *HTTPRequestSite) String() string {
+	if s.Dynamic {
+		return fmt.Sprintf("%s %s...", s.Method, s.Path)
+	}
+	return fmt.Sprintf("%s %s", s.Method, s.Path)
+}
+
+// HTTPRouteLink 把前端的请求调用点和后端的路由处理函数关联起来，
+// 数据流分析（-->）经过请求调用点时会进入处理函数的参数
+type HTTPRouteLink struct {
+	Request *HTTPRequestSite
+	Route   *HTTPRoute
+}
+
+func (l *HTTPRouteLink) String() string {
+	return fmt.Sprintf("http-route: %s", l.Route.String())
+}
+
+func (l *HTTPRouteLink) hash() string {
+	handler := l.Route.Handler
+	return fmt.Sprintf("%d|%s|%d|%s", l.Request.Call.GetId(), handler.GetProgramName(), handler.GetId(), l.Route.String())
+}
+
+type httpRouteState struct {
+	mu sync.Mutex
+
+	routes      []*HTTPRoute
+	routesReady bool
+	sites       []*HTTPRequestSite
+	sitesReady  bool
+
+	// call id -> links
+	links   map[int64][]*HTTPRouteLink
+	linkSet map[string]struct{}
+}
+
+// LinkHTTPRoutes 按照路径和请求方法把前端程序（JavaScript）中的 HTTP 请求调用点和后端程序（Java）中的路由处理函数关联起来，
+// 关联后从前端出发的数据流分析可以跨语言进入后端的路由处理函数。使用 Programs 执行 SyntaxFlow 查询时会自动关联。
+func (ps Programs) LinkHTTPRoutes() []*HTTPRouteLink {
+	var frontends, backends Programs
+	for _, p := range ps {
+		if p == nil || p.Program == nil {
+			continue
+		}
+		switch consts.Language(p.GetLanguage()) {
+		case consts.JS:
+			frontends = append(frontends, p)
+		case consts.JAVA:
+			backends = append(backends, p)
+		}
+	}
+	if len(frontends) == 0 || len(backends) == 0 {
+		return nil
+	}
+
+	var routes []*HTTPRoute
+	for _, p := range backends {
+		routes = append(routes, p.GetHTTPRoutes()...)
+	}
+	if len(routes) == 0 {
+		return nil
+	}
+
+	var links []*HTTPRouteLink
+	for _, p := range frontends {
+		for _, site := range p.GetHTTPRequestSites() {
+			for _, route := range routes {
+				if !matchHTTPRoute(route, site) {
+					continue
+				}
+				link := &HTTPRouteLink{Request: site, Route: route}
+				p.addHTTPRouteLink(link)
+				links = append(links, link)
+			}
+		}
+	}
+	return links
+}
+
+// GetHTTPRouteLinks 返回当前（前端）程序中已经关联到后端路由的请求调用点
+func (p *Program) GetHTTPRouteLinks() []*HTTPRouteLink {
+	state := &p.httpRoute
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	var ret []*HTTPRouteLink
+	for _, links := range state.links {
+		ret = append(ret, links...)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Request.Call.GetId() < ret[j].Request.Call.GetId()
+	})
+	return ret
+}
+
+func (p *Program) getHTTPRouteLinks(callId int64) []*HTTPRouteLink {
+	state := &p.httpRoute
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.links[callId]
+}
+
+func (p *Program) addHTTPRouteLink(link *HTTPRouteLink) {
+	state := &p.httpRoute
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.links == nil {
+		state.links = make(map[int64][]*HTTPRouteLink)
+		state.linkSet = make(map[string]struct{})
+	}
+	hash := link.hash()
+	if _, ok := state.linkSet[hash]; ok {
+		return
+	}
+	state.linkSet[hash] = struct{}{}
+	callId := link.Request.Call.GetId()
+	state.links[callId] = append(state.links[callId], link)
+}
+
+// GetHTTPRoutes 返回后端程序中的 HTTP 路由，结果会被缓存
+func (p *Program) GetHTTPRoutes() []*HTTPRoute {
+	state := &p.httpRoute
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if !state.routesReady {
+		if consts.Language(p.GetLanguage()) == consts.JAVA {
+			state.routes = p.extractJavaHTTPRoutes()
+		}
+		state.routesReady = true
+	}
+	return state.routes
+}
+
+// GetHTTPRequestSites 返回前端程序中发起 HTTP 请求的调用点，结果会被缓存
+func (p *Program) GetHTTPRequestSites() []*HTTPRequestSite {
+	state := &p.httpRoute
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if !state.sitesReady {
+		if consts.Language(p.GetLanguage()) == consts.JS {
+			state.sites = p.extractJSHTTPRequestSites()
+		}
+		state.sitesReady = true
+	}
+	return state.sites
+}
+
+// ====================== java route ======================
+
+var springMappingAnnotations = map[string]string{
+	"RequestMapping": "",
+	"GetMapping":     "GET",
+	"PostMapping":    "POST",
+	"PutMapping":     "PUT",
+	"DeleteMapping":  "DELETE",
+	"PatchMapping":   "PATCH",
+}
+
+var servletHandlerMethods = map[string]string{
+	"service":  "",
+	"doGet":    "GET",
+	"doPost":   "POST",
+	"doPut":    "PUT",
+	"doDelete": "DELETE",
+}
+
+/*
+注解在 SSA 中表示为以注解名命名的容器：
+
+	@PostMapping("/user") public String exec(...)
+
+means
+
+	PostMapping.value = "/user"
+	PostMapping.__ref__ = exec
+*/
+func (p *Program) extractJavaHTTPRoutes() []*HTTPRoute {
+	names := append(lo.Keys(springMappingAnnotations), "WebServlet")
+	sort.Strings(names)
+	rule := strings.Join(lo.Map(names, func(name string, _ int) string {
+		return fmt.Sprintf("%s as $%s", name, name)
+	}), "\n")
+	res, err := p.SyntaxFlowWithError(rule)
+	if err != nil {
+		log.Errorf("extract http route failed: %v", err)
+		return nil
+	}
+
+	type mapping struct {
+		annotation string
+		container  *Value
+		ref        *Value
+	}
+	var mappings []mapping
+	// class container id -> path prefix
+	prefixes := make(map[int64]string)
+	for _, name := range names {
+		for _, container := range res.GetValues(name) {
+			if !container.IsMake() {
+				continue
+			}
+			ref := getAnnotationMember(container, "__ref__")
+			if ref == nil {
+				continue
+			}
+			if name == "RequestMapping" && !ref.IsFunction() {
+				prefixes[ref.GetId()] = getAnnotationString(container, "value", "path")
+				continue
+			}
+			mappings = append(mappings, mapping{annotation: name, container: container, ref: ref})
+		}
+	}
+
+	var routes []*HTTPRoute
+	for _, m := range mappings {
+		if m.annotation == "WebServlet" {
+			// @WebServlet 注解在类上，类的容器中保存了 doGet/doPost 等方法
+			path := getAnnotationString(m.container, "value", "urlPatterns")
+			if path == "" {
+				continue
+			}
+			for _, member := range m.ref.GetMembers() {
+				method, ok := servletHandlerMethods[utils.InterfaceToString(member[0].GetConstValue())]
+				if !ok || !member[1].IsFunction() {
+					continue
+				}
+				routes = append(routes, &HTTPRoute{
+					Method:  method,
+					Path:    joinHTTPRoutePath(path),
+					Handler: member[1],
+				})
+			}
+			continue
+		}
+		if !m.ref.IsFunction() {
+			continue
+		}
+
+		method := springMappingAnnotations[m.annotation]
+		if method == "" {
+			method = getAnnotationMethod(m.container)
+		}
+		var prefix string
+		if fun, ok := ssa.ToFunction(m.ref.innerValue); ok {
+			if bp := fun.GetCurrentBlueprint(); bp != nil && bp.Container() != nil {
+				prefix = prefixes[bp.Container().GetId()]
+			}
+		}
+		routes = append(routes, &HTTPRoute{
+			Method:  method,
+			Path:    joinHTTPRoutePath(prefix, getAnnotationString(m.container, "value", "path")),
+			Handler: m.ref,
+		})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+func getAnnotationMember(container *Value, keys ...string) *Value {
+	for _, member := range container.GetMembers() {
+		key := utils.InterfaceToString(member[0].GetConstValue())
+		if utils.StringArrayContains(keys, key) {
+			return member[1]
+		}
+	}
+	return nil
+}
+
+func getAnnotationString(container *Value, keys ...string) string {
+	value := getAnnotationMember(container, keys...)
+	if value == nil || !value.IsConstInst() {
+		return ""
+	}
+	return utils.InterfaceToString(value.GetConstValue())
+}
+
+// getAnnotationMethod 获取 @RequestMapping(method = RequestMethod.GET) 中的请求方法
+func getAnnotationMethod(container *Value) string {
+	value := getAnnotationMember(container, "method")
+	if value == nil {
+		return ""
+	}
+	if value.IsConstInst() {
+		return strings.ToUpper(utils.InterfaceToString(value.GetConstValue()))
+	}
+	if key := value.GetKey(); key != nil && key.IsConstInst() {
+		return strings.ToUpper(utils.InterfaceToString(key.GetConstValue()))
+	}
+	return ""
+}
+
+// ====================== javascript request ======================
+
+var axiosMethods = map[string]string{
+	"get":     "GET",
+	"delete":  "DELETE",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+	"post":    "POST",
+	"put":     "PUT",
+	"patch":   "PATCH",
+	"request": "",
+}
+
+func (p *Program) extractJSHTTPRequestSites() []*HTTPRequestSite {
+	res, err := p.SyntaxFlowWithError(`
+fetch() as $fetch
+axios() as $axios
+axios.*() as $axiosMethod
+`)
+	if err != nil {
+		log.Errorf("extract http request site failed: %v", err)
+		return nil
+	}
+
+	var sites []*HTTPRequestSite
+	add := func(call *Value, url *Value, method string, config *Value) {
+		if url == nil && config != nil {
+			url = getAnnotationMember(config, "url")
+		}
+		if config != nil {
+			if m := getAnnotationString(config, "method"); m != "" {
+				method = m
+			}
+		}
+		path, dynamic, ok := resolveHTTPRequestPath(url)
+		if !ok {
+			return
+		}
+		if method == "" {
+			method = "GET"
+		}
+		sites = append(sites, &HTTPRequestSite{
+			Method:  strings.ToUpper(method),
+			Path:    path,
+			Dynamic: dynamic,
+			Call:    call,
+		})
+	}
+	argAt := func(args Values, i int) *Value {
+		if i < len(args) {
+			return args[i]
+		}
+		return nil
+	}
+
+	// fetch(url, {method: "POST", body: ...})
+	for _, call := range res.GetValues("fetch") {
+		args := call.GetCallArgs()
+		add(call, argAt(args, 0), "GET", argAt(args, 1))
+	}
+	// axios(config) or axios(url, config)
+	for _, call := range res.GetValues("axios") {
+		args := call.GetCallArgs()
+		if first := argAt(args, 0); first != nil && first.IsMake() {
+			add(call, nil, "GET", first)
+		} else {
+			add(call, first, "GET", argAt(args, 1))
+		}
+	}
+	// axios.get(url, config) / axios.post(url, data, config) / axios.request(config)
+	for _, call := range res.GetValues("axiosMethod") {
+		callee := call.GetCallee()
+		if callee == nil || callee.GetKey() == nil {
+			continue
+		}
+		name := strings.ToLower(utils.InterfaceToString(callee.GetKey().GetConstValue()))
+		method, ok := axiosMethods[name]
+		if !ok {
+			continue
+		}
+		args := call.GetCallArgs()
+		switch name {
+		case "request":
+			add(call, nil, method, argAt(args, 0))
+		case "post", "put", "patch":
+			add(call, argAt(args, 0), method, argAt(args, 2))
+		default:
+			add(call, argAt(args, 0), method, argAt(args, 1))
+		}
+	}
+	return sites
+}
+
+// resolveHTTPRequestPath 从请求的 URL 参数中获取路径，URL 由字符串拼接而成时只使用最左边的常量部分
+func resolveHTTPRequestPath(url *Value) (path string, dynamic bool, ok bool) {
+	for url != nil {
+		if url.IsConstInst() {
+			raw, isString := url.GetConstValue().(string)
+			if !isString {
+				return "", false, false
+			}
+			path = raw
+			break
+		}
+		binOp, isBinOp := ssa.ToBinOp(url.innerValue)
+		if !isBinOp || binOp.Op != ssa.OpAdd {
+			return "", false, false
+		}
+		dynamic = true
+		url = url.NewValue(binOp.GetValueById(binOp.X))
+	}
+	if url == nil {
+		return "", false, false
+	}
+
+	// 查询参数和锚点不影响路由匹配，其后拼接的内容也不影响路径
+	if idx := strings.IndexAny(path, "?#"); idx >= 0 {
+		path = path[:idx]
+		dynamic = false
+	}
+	if idx := strings.Index(path, "://"); idx >= 0 {
+		path = path[idx+3:]
+		if slash := strings.Index(path, "/"); slash >= 0 {
+			path = path[slash:]
+		} else {
+			path = "/"
+		}
+	} else if strings.HasPrefix(path, "//") {
+		path = strings.TrimPrefix(path, "//")
+		if slash := strings.Index(path, "/"); slash >= 0 {
+			path = path[slash:]
+		} else {
+			path = "/"
+		}
+	}
+	if dynamic {
+		// 保留结尾的 "/"，表示后面拼接的是新的一段路径
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		return path, true, true
+	}
+	return joinHTTPRoutePath(path), false, true
+}
+
+// ====================== match ======================
+
+func joinHTTPRoutePath(parts ...string) string {
+	var segments []string
+	for _, part := range parts {
+		for _, segment := range strings.Split(part, "/") {
+			if segment != "" {
+				segments = append(segments, segment)
+			}
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isHTTPRouteVariable(segment string) bool {
+	return (strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")) || segment == "*"
+}
+
+func matchHTTPRoute(route *HTTPRoute, site *HTTPRequestSite) bool {
+	if route.Method != "" && site.Method != "" && route.Method != site.Method {
+		return false
+	}
+
+	routeSegments := strings.Split(strings.TrimPrefix(route.Path, "/"), "/")
+	siteSegments := strings.Split(strings.TrimPrefix(site.Path, "/"), "/")
+	for i, segment := range siteSegments {
+		if i >= len(routeSegments) {
+			return false
+		}
+		routeSegment := routeSegments[i]
+		if routeSegment == "**" || (routeSegment == "*" && i == len(routeSegments)-1) {
+			// 通配后面的全部路径
+			return true
+		}
+		if site.Dynamic && i == len(siteSegments)-1 {
+			// 动态 URL 的最后一段只是前缀
+			return isHTTPRouteVariable(routeSegment) || strings.HasPrefix(routeSegment, segment)
+		}
+		if isHTTPRouteVariable(routeSegment) {
+			continue
+		}
+		if routeSegment != segment {
+			return false
+		}
+	}
+	return len(siteSegments) == len(routeSegments)
+}
+
+// ====================== dataflow ======================
+
+// getHTTPRouteBottomUses 数据流到达已关联的前端请求调用点后，继续进入后端路由处理函数的参数
+func (v *Value) getHTTPRouteBottomUses(actx *AnalyzeContext, opt ...OperationOption) Values {
+	if v.ParentProgram == nil {
+		return nil
+	}
+	var vals Values
+	for _, link := range v.ParentProgram.getHTTPRouteLinks(v.GetId()) {
+		for _, param := range link.Route.GetRequestParameters() {
+			param.httpRouteLink = link
+			// 不同程序的 id 可能相同，不能使用 AppendDependOn
+			param.DependOn = utils.AppendSliceItemWhenNotExists(param.DependOn, v)
+			v.EffectOn = utils.AppendSliceItemWhenNotExists(v.EffectOn, param)
+			vals = append(vals, param.getBottomUses(actx, opt...)...)
+		}
+	}
+	return vals
+}
+
+// GetHTTPRouteLink 返回数据流跨语言进入后端路由处理函数时经过的关联，只有处理函数的参数会有这个关联
+func (v *Value) GetHTTPRouteLink() *HTTPRouteLink {
+	return v.httpRouteLink
+}
@@ -29,6 +29,8 @@ type Program struct {
 	comeFromDatabase bool
 	//value cache
 	nodeId2ValueCache *utils.CacheWithKey[uint, *Value]
+	// http route link between frontend and backend program
+	httpRoute httpRouteState
 }
 
 type Programs []*Program
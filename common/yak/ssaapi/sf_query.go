@@ -189,6 +189,8 @@ func QueryWithProgram(program *Program) QueryOption {
 
 func QueryWithPrograms(programs Programs) QueryOption {
 	return func(c *queryConfig) {
+		// 关联前后端程序的 HTTP 路由，使数据流可以跨语言
+		programs.LinkHTTPRoutes()
 		c.value = sfvm.NewValues(lo.Map(programs, func(p *Program, _ int) sfvm.ValueOperator {
 			return p
 		}))
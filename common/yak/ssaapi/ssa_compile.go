@@ -7,9 +7,11 @@ import (
 	"github.com/yaklang/yaklang/common/yak/typescript/js2ssa"
 
 	//js2ssa "github.com/yaklang/yaklang/common/yak/JS2ssa"
+	"github.com/yaklang/yaklang/common/yak/c/c2ssa"
 	"github.com/yaklang/yaklang/common/yak/go2ssa"
 	"github.com/yaklang/yaklang/common/yak/java/java2ssa"
 	"github.com/yaklang/yaklang/common/yak/php/php2ssa"
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa4analyze"
 	"github.com/yaklang/yaklang/common/yak/ssaapi/ssareducer"
@@ -17,19 +19,23 @@ import (
 )
 
 const (
-	Yak  = consts.Yak
-	JS   = consts.JS
-	PHP  = consts.PHP
-	JAVA = consts.JAVA
-	GO   = consts.GO
+	Yak    = consts.Yak
+	JS     = consts.JS
+	PHP    = consts.PHP
+	JAVA   = consts.JAVA
+	GO     = consts.GO
+	PYTHON = consts.PYTHON
+	C      = consts.C
 )
 
 var LanguageBuilders = map[consts.Language]ssa.Builder{
-	Yak:  yak2ssa.Builder,
-	JS:   js2ssa.Builder,
-	PHP:  php2ssa.Builder,
-	JAVA: java2ssa.Builder,
-	GO:   go2ssa.Builder,
+	Yak:    yak2ssa.Builder,
+	JS:     js2ssa.Builder,
+	PHP:    php2ssa.Builder,
+	JAVA:   java2ssa.Builder,
+	GO:     go2ssa.Builder,
+	PYTHON: python2ssa.Builder,
+	C:      c2ssa.Builder,
 }
 
 var AllLanguageBuilders = []ssa.Builder{
@@ -39,6 +45,8 @@ var AllLanguageBuilders = []ssa.Builder{
 	yak2ssa.Builder,
 	js2ssa.Builder,
 	go2ssa.Builder,
+	python2ssa.Builder,
+	c2ssa.Builder,
 }
 
 func (c *config) isStop() bool {
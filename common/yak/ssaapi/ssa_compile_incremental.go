@@ -0,0 +1,367 @@
+package ssaapi
+
+import (
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+)
+
+// IncrementalCompileResult 记录一次增量编译的结果
+type IncrementalCompileResult struct {
+	ProgramName string
+
+	// 与上次编译相比新增、修改和删除的文件
+	AddedFiles    []string
+	ModifiedFiles []string
+	RemovedFiles  []string
+	// 重新编译的文件，包括变化的文件以及依赖这些文件的文件
+	RecompiledFiles []string
+	// 无法增量编译时会编译整个项目，所有规则都需要重新执行
+	FullCompile bool
+
+	// 失效后重新构建的函数和类
+	AffectedFunctions  []string
+	AffectedBlueprints []string
+	// 上次的扫描结果中引用了失效代码的规则
+	AffectedRules []string
+
+	// 变化前的文件内容以及重新编译的文件内容，用于判断没有结果的规则是否可能产生新的结果
+	changedSources []string
+}
+
+// HasChanged 判断文件是否有变化，没有变化时不会重新编译
+func (r *IncrementalCompileResult) HasChanged() bool {
+	if r == nil || r.FullCompile {
+		return true
+	}
+	return len(r.AddedFiles)+len(r.ModifiedFiles)+len(r.RemovedFiles) > 0
+}
+
+// NeedRerunRule 判断规则的结果是否可能因为这次的文件变化而改变：
+// 上次的结果引用了失效的代码，或者规则中搜索的名字出现在变化的文件中
+func (r *IncrementalCompileResult) NeedRerunRule(ruleName, content string) bool {
+	if r == nil || r.FullCompile {
+		return true
+	}
+	if !r.HasChanged() {
+		return false
+	}
+	for _, name := range r.AffectedRules {
+		if name == ruleName && ruleName != "" {
+			return true
+		}
+	}
+	frame, err := sfvm.CompileRule(content)
+	if err != nil {
+		// 无法分析的规则总是重新执行
+		return true
+	}
+	searched := false
+	for _, code := range frame.Codes {
+		var match func(string) bool
+		switch code.OpCode {
+		case sfvm.OpPushSearchExact, sfvm.OpRecursiveSearchExact:
+			name := code.UnaryStr
+			match = func(source string) bool {
+				return strings.Contains(source, name)
+			}
+		case sfvm.OpPushSearchGlob, sfvm.OpRecursiveSearchGlob:
+			parts := strings.FieldsFunc(code.UnaryStr, func(r rune) bool {
+				return strings.ContainsRune("*?[]{}", r)
+			})
+			match = func(source string) bool {
+				for _, part := range parts {
+					if !strings.Contains(source, part) {
+						return false
+					}
+				}
+				return true
+			}
+		case sfvm.OpPushSearchRegexp, sfvm.OpRecursiveSearchRegexp:
+			re, err := regexp.Compile(code.UnaryStr)
+			if err != nil {
+				return true
+			}
+			match = re.MatchString
+		case sfvm.OpNativeCall:
+			// include 等 native call 会引入其他规则，无法确定搜索的名字
+			if code.UnaryStr == "include" {
+				return true
+			}
+			continue
+		default:
+			continue
+		}
+		searched = true
+		for _, source := range r.changedSources {
+			if match(source) {
+				return true
+			}
+		}
+	}
+	return !searched
+}
+
+// FilterRules 返回需要重新执行的规则
+func (r *IncrementalCompileResult) FilterRules(rules []*schema.SyntaxFlowRule) []*schema.SyntaxFlowRule {
+	ret := make([]*schema.SyntaxFlowRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		if r.NeedRerunRule(rule.RuleName, rule.Content) {
+			ret = append(ret, rule)
+		}
+	}
+	return ret
+}
+
+// GetIncrementalCompileResult 返回增量编译的结果，不是增量编译得到的程序返回 nil
+func (p *Program) GetIncrementalCompileResult() *IncrementalCompileResult {
+	if p == nil || p.config == nil {
+		return nil
+	}
+	return p.config.incrementalResult
+}
+
+type incrementalFile struct {
+	path    string
+	content string
+	hash    string
+}
+
+// canIncrementalCompile 判断是否可以进行增量编译，
+// 增量编译需要数据库中存在上次完整编译的程序
+func (c *config) canIncrementalCompile() bool {
+	if !c.enableDatabase || c.ProgramName == "" || c.reCompile {
+		return false
+	}
+	if c.peepholeSize != 0 {
+		log.Infof("incremental compile is not supported in peephole mode, compile whole project")
+		return false
+	}
+	irProg, err := ssadb.GetProgram(c.ProgramName, ssa.Application)
+	if err != nil {
+		log.Infof("program %s not found in database, compile whole project", c.ProgramName)
+		return false
+	}
+	if irProg.PeepholeSize != 0 {
+		log.Infof("program %s is compiled in peephole mode, compile whole project", c.ProgramName)
+		return false
+	}
+	return true
+}
+
+// collectIncrementalFiles 收集文件系统中会被编译的文件，与完整编译时的过滤规则一致
+func (c *config) collectIncrementalFiles() (map[string]*incrementalFile, error) {
+	files := make(map[string]*incrementalFile)
+	err := filesys.Recursive(c.programPath,
+		filesys.WithFileSystem(c.fs),
+		filesys.WithContext(c.ctx),
+		filesys.WithDirStat(func(s string, fi fs.FileInfo) error {
+			_, name := c.fs.PathSplit(s)
+			if name == "test" || name == ".git" {
+				return filesys.SkipDir
+			}
+			return nil
+		}),
+		filesys.WithFileStat(func(filePath string, fi fs.FileInfo) error {
+			if fi.Size() == 0 {
+				return nil
+			}
+			folderName, fileName := c.fs.PathSplit(filePath)
+			if c.excludeFile(folderName, fileName) {
+				return nil
+			}
+			if c.checkLanguage(filePath) != nil {
+				return nil
+			}
+			raw, err := c.fs.ReadFile(filePath)
+			if err != nil {
+				return utils.Wrapf(err, "read file %s failed", filePath)
+			}
+			folders := strings.Split(folderName, string(c.fs.GetSeparators()))
+			key, hash := ssadb.CalcIrSourceHash(fileName, string(raw), c.ProgramName, folders)
+			files[key] = &incrementalFile{
+				path:    filePath,
+				content: string(raw),
+				hash:    hash,
+			}
+			return nil
+		}),
+	)
+	return files, err
+}
+
+// parseProjectIncremental 根据文件 hash 找出变化的文件，
+// 删除这些文件以及依赖这些文件的文件中的 IR，然后只编译这些文件。
+// 重新编译的文件对未变化文件中的函数和类的引用，会从数据库中加载为 lazy value。
+func (c *config) parseProjectIncremental() (Programs, error) {
+	db := ssadb.GetDB()
+	result := &IncrementalCompileResult{ProgramName: c.ProgramName}
+	c.incrementalResult = result
+
+	c.Processf(0, "incremental compile, calculate changed files...")
+	oldSources, err := ssadb.GetIrSourceHashes(db, c.ProgramName)
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := c.collectIncrementalFiles()
+	if err != nil {
+		return nil, err
+	}
+	if c.isStop() {
+		return nil, ErrContextCancel
+	}
+
+	var changedHashes []string
+	for filePath, file := range newFiles {
+		source, ok := oldSources[filePath]
+		switch {
+		case !ok:
+			result.AddedFiles = append(result.AddedFiles, filePath)
+		case source.SourceCodeHash != file.hash:
+			result.ModifiedFiles = append(result.ModifiedFiles, filePath)
+			changedHashes = append(changedHashes, source.SourceCodeHash)
+			result.changedSources = append(result.changedSources, source.GetCode())
+		}
+	}
+	for filePath, source := range oldSources {
+		if _, ok := newFiles[filePath]; ok {
+			continue
+		}
+		// 上次编译时的文件不一定都能被当前的语言处理，例如 prehandler 保存的配置文件
+		if c.checkLanguage(filePath) != nil {
+			continue
+		}
+		result.RemovedFiles = append(result.RemovedFiles, filePath)
+		changedHashes = append(changedHashes, source.SourceCodeHash)
+		result.changedSources = append(result.changedSources, source.GetCode())
+	}
+	sort.Strings(result.AddedFiles)
+	sort.Strings(result.ModifiedFiles)
+	sort.Strings(result.RemovedFiles)
+	c.Processf(0.05, "incremental compile, added: %d, modified: %d, removed: %d",
+		len(result.AddedFiles), len(result.ModifiedFiles), len(result.RemovedFiles))
+
+	if !result.HasChanged() {
+		c.Processf(1, "program %s has no changed file, skip compile", c.ProgramName)
+		prog, err := c.fromDatabase()
+		if err != nil {
+			return nil, err
+		}
+		return Programs{prog}, nil
+	}
+
+	// 失效的代码
+	affected, err := ssadb.GetAffectedIrCode(db, c.ProgramName, changedHashes)
+	if err != nil {
+		return nil, err
+	}
+	result.AffectedFunctions = utils.RemoveRepeatStringSlice(affected.Functions)
+	result.AffectedBlueprints = utils.RemoveRepeatStringSlice(affected.Blueprints)
+	result.AffectedRules, err = ssadb.GetAuditRuleNamesByIrCode(db, c.ProgramName, affected)
+	if err != nil {
+		return nil, err
+	}
+
+	hash2path := make(map[string]string, len(oldSources))
+	for filePath, source := range oldSources {
+		hash2path[source.SourceCodeHash] = filePath
+	}
+	recompile := make(map[string]*incrementalFile)
+	for _, filePath := range result.AddedFiles {
+		recompile[filePath] = newFiles[filePath]
+	}
+	for _, hash := range affected.SourceHashes {
+		filePath, ok := hash2path[hash]
+		if !ok {
+			continue
+		}
+		// 删除的文件只需要删除 IR
+		if file, ok := newFiles[filePath]; ok {
+			recompile[filePath] = file
+		}
+	}
+	result.RecompiledFiles = utils.GetSortedMapKeys(recompile)
+	for _, file := range recompile {
+		result.changedSources = append(result.changedSources, file.content)
+	}
+
+	if len(recompile) > 0 {
+		c.incrementalDefinitions, err = ssadb.GetIrDefinitions(db, c.ProgramName, affected.SourceHashes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.Processf(0.1, "incremental compile, delete invalid data of %d files", len(affected.SourceHashes))
+	ssadb.DeleteAffectedIrCode(db, c.ProgramName, affected)
+
+	fileList := make(map[string]string)
+	extraFile := make(map[string]string)
+	if irProg, err := ssadb.GetProgram(c.ProgramName, ssa.Application); err == nil {
+		for filePath, hash := range irProg.FileList {
+			fileList[filePath] = hash
+		}
+		for filePath, hash := range irProg.ExtraFile {
+			extraFile[filePath] = hash
+		}
+	}
+	for _, filePath := range result.RemovedFiles {
+		deleteFileListPath(fileList, filePath)
+	}
+
+	if len(recompile) > 0 {
+		vfs := filesys.NewVirtualFs()
+		for _, file := range recompile {
+			vfs.AddFile(file.path, file.content)
+		}
+		c.Processf(0.1, "incremental compile, start compile %d files", len(recompile))
+		prog, err := c.parseProjectWithFS(getUnifiedSeparatorFs(vfs), func(f float64, s string, a ...any) {
+			c.Processf(0.1+f*0.89, s, a...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for filePath, hash := range prog.Program.FileList {
+			deleteFileListPath(fileList, filePath)
+			fileList[filePath] = hash
+		}
+		for filePath, hash := range prog.Program.ExtraFile {
+			extraFile[filePath] = hash
+		}
+	}
+
+	if irProg, err := ssadb.GetProgram(c.ProgramName, ssa.Application); err == nil {
+		irProg.FileList = fileList
+		irProg.ExtraFile = extraFile
+		ssadb.UpdateProgram(irProg)
+	}
+	c.SaveConfig()
+	prog, err := c.fromDatabase()
+	if err != nil {
+		return nil, err
+	}
+	c.Processf(1, "program %s incremental compile finish", c.ProgramName)
+	return Programs{prog}, nil
+}
+
+// deleteFileListPath 删除 FileList 中的文件，FileList 中的路径可能带有 ./ 等前缀
+func deleteFileListPath(fileList map[string]string, filePath string) {
+	target := strings.TrimPrefix(filePath, "/")
+	for key := range fileList {
+		if strings.TrimPrefix(path.Clean(key), "/") == target {
+			delete(fileList, key)
+		}
+	}
+}
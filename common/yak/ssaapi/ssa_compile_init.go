@@ -17,6 +17,9 @@ func (c *config) init(filesystem filesys_interface.FileSystem) (*ssa.Program, *s
 	programName := c.ProgramName
 	application := ssa.NewProgram(programName, c.enableDatabase, ssa.Application, filesystem, c.programPath, c.cacheTTL...)
 	application.Language = string(c.language)
+	if defs := c.incrementalDefinitions; defs != nil {
+		application.SetDatabaseDefinitions(defs.Functions, defs.Blueprints)
+	}
 
 	application.ProcessInfof = func(s string, v ...any) {
 		msg := fmt.Sprintf(s, v...)
@@ -36,6 +36,10 @@ func ParseProject(opts ...Option) (Programs, error) {
 }
 
 func (c *config) parseProject() (Programs, error) {
+	if c.incrementalCompile && c.canIncrementalCompile() {
+		return c.parseProjectIncremental()
+	}
+
 	if c.reCompile {
 		c.Processf(0, "recompile project, delete old data...")
 		ssadb.DeleteProgramIrCode(ssadb.GetDB(), c.ProgramName)
@@ -65,6 +65,12 @@ type config struct {
 	// peephole
 	peepholeSize int
 
+	// incremental compile
+	incrementalCompile bool
+	incrementalResult  *IncrementalCompileResult
+	// 未重新编译的文件中定义的函数与类
+	incrementalDefinitions *ssadb.IrDefinitions
+
 	// other build options
 	DatabaseProgramCacheHitter func(any)
 	EnableCache                bool
@@ -181,6 +187,15 @@ func WithReCompile(b bool) Option {
 	}
 }
 
+// WithIncrementalCompile 只重新编译与数据库中已编译程序相比发生变化的文件以及依赖这些文件的文件，
+// 程序不存在时进行完整编译
+func WithIncrementalCompile(b bool) Option {
+	return func(c *config) error {
+		c.incrementalCompile = b
+		return nil
+	}
+}
+
 func WithStrictMode(b bool) Option {
 	return func(c *config) error {
 		c.strictMode = b
@@ -506,6 +521,7 @@ var Exports = map[string]any{
 	"withProcess":            WithProcess,
 	"withEntryFile":          WithFileSystemEntry,
 	"withReCompile":          WithReCompile,
+	"withIncrementalCompile": WithIncrementalCompile,
 	"withStrictMode":         WithStrictMode,
 	"withContext":            WithContext,
 	"withPeepholeSize":       WithPeepholeSize,
@@ -27,6 +27,44 @@ func (c *config) SaveConfig() {
 
 // recompile from Profile SSAProgram
 func (prog *Program) Recompile(opts ...Option) error {
+	opts, err := prog.recompileOptions(opts...)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, WithReCompile(true))
+
+	// parse
+	newProg, err := ParseProject(opts...)
+	_ = newProg
+
+	return err
+}
+
+// IncrementalRecompile 使用编译时的配置重新编译，只编译与上次编译相比发生变化的文件以及依赖这些文件的文件
+func (prog *Program) IncrementalRecompile(opts ...Option) (*IncrementalCompileResult, error) {
+	opts, err := prog.recompileOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, WithIncrementalCompile(true))
+
+	progs, err := ParseProject(opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range progs {
+		if result := p.GetIncrementalCompileResult(); result != nil {
+			return result, nil
+		}
+	}
+	// 无法增量编译时会进行完整编译
+	return &IncrementalCompileResult{
+		ProgramName: prog.GetProgramName(),
+		FullCompile: true,
+	}, nil
+}
+
+func (prog *Program) recompileOptions(opts ...Option) ([]Option, error) {
 	// get file system
 	hasFS := false
 	// recompile from info
@@ -41,18 +79,12 @@ func (prog *Program) Recompile(opts ...Option) error {
 
 	// check file system
 	if !hasFS {
-		return utils.Errorf("该项目编译时引擎版本过旧，无法重新编译。")
+		return nil, utils.Errorf("该项目编译时引擎版本过旧，无法重新编译。")
 		// return utils.Errorf("The project compilation engine version is too old to recompile.\n该项目编译时引擎版本过旧，无法重新编译。")
 	}
 
 	// append other options
 	opts = append(opts, WithProgramName(prog.Program.Name))
 	opts = append(opts, WithRawLanguage(prog.GetLanguage()))
-	opts = append(opts, WithReCompile(true))
-
-	// parse
-	newProg, err := ParseProject(opts...)
-	_ = newProg
-
-	return err
+	return opts, nil
 }
@@ -0,0 +1,142 @@
+package javascript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+const springController = `package com.example;
+
+import org.springframework.web.bind.annotation.*;
+
+@RestController
+@RequestMapping("/api")
+public class UserController {
+    @PostMapping("/user/exec")
+    public String exec(@RequestParam("cmd") String cmd, HttpServletResponse response) {
+        Runtime.getRuntime().exec(cmd);
+        return "ok";
+    }
+
+    @RequestMapping(value = "/user/{id}", method = RequestMethod.GET)
+    public String get(@PathVariable("id") String id) {
+        return id;
+    }
+}
+`
+
+const execServlet = `package com.example;
+
+import javax.servlet.http.*;
+import javax.servlet.annotation.WebServlet;
+
+@WebServlet(urlPatterns = "/servlet/exec")
+public class ExecServlet extends HttpServlet {
+    protected void doPost(HttpServletRequest req, HttpServletResponse resp) {
+        String cmd = req.getParameter("cmd");
+        Runtime.getRuntime().exec(cmd);
+    }
+}
+`
+
+const frontend = `
+var cmd = document.getElementById("cmd").value;
+fetch("/api/user/exec?cmd=" + cmd, {method: "POST"});
+
+var id = document.getElementById("id").value;
+axios.get("http://localhost:8080/api/user/" + id);
+
+var name = document.getElementById("name").value;
+axios.post("/servlet/exec", name);
+
+axios.get("/servlet/exec");
+`
+
+func parseCrossLanguagePrograms(t *testing.T) ssaapi.Programs {
+	backend := filesys.NewVirtualFs()
+	backend.AddFile("src/main/java/com/example/UserController.java", springController)
+	backend.AddFile("src/main/java/com/example/ExecServlet.java", execServlet)
+	java, err := ssaapi.ParseProjectWithFS(backend, ssaapi.WithLanguage(ssaapi.JAVA))
+	require.NoError(t, err)
+
+	web := filesys.NewVirtualFs()
+	web.AddFile("web/app.js", frontend)
+	js, err := ssaapi.ParseProjectWithFS(web, ssaapi.WithLanguage(ssaapi.JS))
+	require.NoError(t, err)
+
+	return append(js, java...)
+}
+
+func TestHTTPRoute_Link(t *testing.T) {
+	progs := parseCrossLanguagePrograms(t)
+
+	routes := progs[1].GetHTTPRoutes()
+	var routeNames []string
+	for _, route := range routes {
+		routeNames = append(routeNames, route.String())
+	}
+	require.Equal(t, []string{"POST /api/user/exec", "GET /api/user/{id}", "POST /servlet/exec"}, routeNames)
+
+	links := progs.LinkHTTPRoutes()
+	got := make(map[string]string)
+	for _, link := range links {
+		got[link.Request.String()] = link.Route.String()
+	}
+	require.Equal(t, map[string]string{
+		"POST /api/user/exec": "POST /api/user/exec",
+		"GET /api/user/...":   "GET /api/user/{id}",
+		"POST /servlet/exec":  "POST /servlet/exec",
+	}, got)
+
+	// 重复关联不会产生重复的边
+	progs.LinkHTTPRoutes()
+	require.Len(t, progs[0].GetHTTPRouteLinks(), 3)
+}
+
+func TestHTTPRoute_Dataflow(t *testing.T) {
+	progs := parseCrossLanguagePrograms(t)
+
+	res, err := progs.SyntaxFlowWithError(`
+document.getElementById().value as $source
+$source --> as $sink
+$sink?{opcode: call && have: exec} as $exec
+`)
+	require.NoError(t, err)
+
+	execs := res.GetValues("exec")
+	var files []string
+	for _, exec := range execs {
+		files = append(files, exec.GetRange().GetEditor().GetFilename())
+	}
+	require.Contains(t, files, "src/main/java/com/example/UserController.java")
+	require.Contains(t, files, "src/main/java/com/example/ExecServlet.java")
+
+	var dot string
+	for _, exec := range execs {
+		dot += exec.DotGraph()
+	}
+	require.True(t, strings.Contains(dot, "http-route: POST /api/user/exec"), dot)
+	require.True(t, strings.Contains(dot, "http-route: POST /servlet/exec"), dot)
+}
+
+func TestHTTPRoute_NoLinkWithoutBackend(t *testing.T) {
+	web := filesys.NewVirtualFs()
+	web.AddFile("web/app.js", frontend)
+	js, err := ssaapi.ParseProjectWithFS(web, ssaapi.WithLanguage(ssaapi.JS))
+	require.NoError(t, err)
+
+	res, err := js.SyntaxFlowWithError(`
+document.getElementById().value as $source
+$source --> as $sink
+`)
+	require.NoError(t, err)
+	for _, sink := range res.GetValues("sink") {
+		require.NotContains(t, sink.GetRange().GetEditor().GetFilename(), ".java")
+	}
+	require.Empty(t, js[0].GetHTTPRouteLinks())
+}
@@ -0,0 +1,222 @@
+package ssatest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+func TestIncrementalRecompile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeFile("com/example/A.java", `package com.example;
+public class A {
+    public static String source(String s) {
+        return s;
+    }
+}`)
+	writeFile("com/example/B.java", `package com.example;
+public class B {
+    public void run(String input) {
+        Runtime.getRuntime().exec(A.source(input));
+    }
+}`)
+	writeFile("com/example/C.java", `package com.example;
+public class C {
+    public int calc(int a, int b) {
+        return a + b;
+    }
+}`)
+
+	progName := uuid.NewString()
+	_, err := ssaapi.ParseProjectFromPath(dir,
+		ssaapi.WithRawLanguage("java"),
+		ssaapi.WithProgramName(progName),
+	)
+	defer ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	require.NoError(t, err)
+
+	recompile := func() *ssaapi.IncrementalCompileResult {
+		prog, err := ssaapi.FromDatabase(progName)
+		require.NoError(t, err)
+		result, err := prog.IncrementalRecompile()
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		return result
+	}
+	query := func(rule string) []string {
+		prog, err := ssaapi.FromDatabase(progName)
+		require.NoError(t, err)
+		res, err := prog.SyntaxFlowWithError(rule)
+		require.NoError(t, err)
+		var got []string
+		for _, v := range res.GetValues("target") {
+			got = append(got, v.String())
+		}
+		return got
+	}
+	check := func(rule string, want []string) {
+		require.ElementsMatch(t, want, query(rule))
+	}
+	execRule := `Runtime.getRuntime().exec(* as $target)`
+
+	t.Run("no change", func(t *testing.T) {
+		result := recompile()
+		require.False(t, result.HasChanged())
+		require.Empty(t, result.RecompiledFiles)
+		require.False(t, result.NeedRerunRule("exec", execRule))
+	})
+
+	t.Run("modify independent file", func(t *testing.T) {
+		writeFile("com/example/C.java", `package com.example;
+public class C {
+    public int calc(int a, int b) {
+        return a - b;
+    }
+}`)
+		result := recompile()
+		require.Equal(t, []string{"com/example/C.java"}, result.ModifiedFiles)
+		require.Equal(t, []string{"com/example/C.java"}, result.RecompiledFiles)
+		require.Contains(t, result.AffectedBlueprints, "C")
+		// C 中没有 exec，规则不需要重新执行
+		require.False(t, result.NeedRerunRule("exec", execRule))
+		require.True(t, result.NeedRerunRule("calc", `calc as $target`))
+
+		check(`calc<getReturns> as $target`, []string{"sub(Parameter-a, Parameter-b)"})
+		// 未变化文件中的 IR 不受影响
+		require.Contains(t, query(execRule), "Function-A.source(Parameter-input)")
+	})
+
+	t.Run("modify file with dependents", func(t *testing.T) {
+		writeFile("com/example/A.java", `package com.example;
+public class A {
+    public static String source(String s) {
+        return s + "!";
+    }
+}`)
+		result := recompile()
+		require.Equal(t, []string{"com/example/A.java"}, result.ModifiedFiles)
+		// B 调用了 A 中的函数，也需要重新编译
+		require.Equal(t, []string{"com/example/A.java", "com/example/B.java"}, result.RecompiledFiles)
+		require.Contains(t, result.AffectedFunctions, "A.source")
+		require.True(t, result.NeedRerunRule("exec", execRule))
+
+		check(`calc<getReturns> as $target`, []string{"sub(Parameter-a, Parameter-b)"})
+		check(`source<getReturns> as $target`, []string{`add(Parameter-s, "!")`})
+		require.Contains(t, query(execRule), "Function-A.source(Parameter-input)")
+	})
+
+	t.Run("modify caller of unchanged file", func(t *testing.T) {
+		writeFile("com/example/B.java", `package com.example;
+public class B {
+    public void run(String input) {
+        Runtime.getRuntime().exec(A.source(input + "-cmd"));
+    }
+}`)
+		result := recompile()
+		require.Equal(t, []string{"com/example/B.java"}, result.ModifiedFiles)
+		// B 引用了未变化的 A，只重新编译 B，A.source 从数据库中加载
+		require.False(t, result.FullCompile)
+		require.Equal(t, []string{"com/example/B.java"}, result.RecompiledFiles)
+		require.NotContains(t, result.AffectedFunctions, "A.source")
+
+		fullName := uuid.NewString()
+		_, err := ssaapi.ParseProjectFromPath(dir,
+			ssaapi.WithRawLanguage("java"),
+			ssaapi.WithProgramName(fullName),
+		)
+		defer ssadb.DeleteProgram(ssadb.GetDB(), fullName)
+		require.NoError(t, err)
+		fullProg, err := ssaapi.FromDatabase(fullName)
+		require.NoError(t, err)
+		// 与完整编译的结果一致，包括经过 A.source 的数据流
+		for _, rule := range []string{
+			execRule,
+			`Runtime.getRuntime().exec(* #-> as $target)`,
+			`source<getCall> as $target`,
+		} {
+			res, err := fullProg.SyntaxFlowWithError(rule)
+			require.NoError(t, err)
+			var want []string
+			for _, v := range res.GetValues("target") {
+				want = append(want, v.String())
+			}
+			require.NotEmpty(t, want, rule)
+			check(rule, want)
+		}
+		check(`source<getReturns> as $target`, []string{`add(Parameter-s, "!")`})
+	})
+
+	t.Run("add and remove file", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "com/example/C.java")))
+		writeFile("com/example/D.java", `package com.example;
+public class D {
+    public int calc(int a, int b) {
+        return a * b;
+    }
+}`)
+		result := recompile()
+		require.Equal(t, []string{"com/example/D.java"}, result.AddedFiles)
+		require.Equal(t, []string{"com/example/C.java"}, result.RemovedFiles)
+		require.Equal(t, []string{"com/example/D.java"}, result.RecompiledFiles)
+
+		check(`calc<getReturns> as $target`, []string{"mul(Parameter-a, Parameter-b)"})
+	})
+}
+
+func TestIncrementalRecompile_UnchangedFunction(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	writeFile("source.c", `char* source(char* s) {
+    return s;
+}`)
+	writeFile("main.c", `void run(char* input) {
+    system(source(input));
+}`)
+
+	progName := uuid.NewString()
+	_, err := ssaapi.ParseProjectFromPath(dir,
+		ssaapi.WithRawLanguage("c"),
+		ssaapi.WithProgramName(progName),
+	)
+	defer ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	require.NoError(t, err)
+
+	writeFile("main.c", `void run(char* input) {
+    char* cmd = source(input);
+    system(cmd);
+}`)
+	prog, err := ssaapi.FromDatabase(progName)
+	require.NoError(t, err)
+	result, err := prog.IncrementalRecompile()
+	require.NoError(t, err)
+	require.False(t, result.FullCompile)
+	require.Equal(t, []string{"main.c"}, result.RecompiledFiles)
+
+	prog, err = ssaapi.FromDatabase(progName)
+	require.NoError(t, err)
+	query := func(rule string) []string {
+		res, err := prog.SyntaxFlowWithError(rule)
+		require.NoError(t, err)
+		var got []string
+		for _, v := range res.GetValues("target") {
+			got = append(got, v.String())
+		}
+		return got
+	}
+	// main.c 中的调用使用数据库中 source.c 定义的函数
+	require.Equal(t, []string{"Function-source"}, query(`system(* as $arg); $arg<getCallee> as $target`))
+	require.Contains(t, query(`system(* #-> as $target)`), "Parameter-input")
+}
@@ -571,78 +571,23 @@ func checkResult(verifyFs *sfvm.VerifyFileSystem, rule *schema.SyntaxFlowRule, r
 			errs = utils.JoinErrors(errs, utils.Errorf("lib: %v is not exporting output in `alert` (empty result)", result.Name()))
 		}
 	}
-	var (
-		alertCount = 0
-		alert_high = 0
-		alert_mid  = 0
-		alert_info = 0
-	)
-
+	stat := &sfvm.VerifyAlertStatistics{}
 	for _, name := range result.GetAlertVariables() {
-		alertCount += len(result.GetValues(name))
-		count := len(result.GetValues(name))
+		var severity string
 		if info, b := result.GetAlertInfo(name); b {
-			switch info.Severity {
-			case "mid", "m", "middle":
-				alert_mid += count
-			case "high", "h":
-				alert_high += count
-			case "info", "low":
-				alert_info += count
-			}
+			severity = string(info.Severity)
 		}
+		stat.Add(severity, len(result.GetValues(name)))
 	}
-	if alertCount <= 0 {
-		errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is empty"))
-		return
+	if stat.Total > 0 {
+		result.Show()
 	}
-	result.Show()
-
-	ret := verifyFs.GetExtraInfoInt("alert_min", "vuln_min", "alertMin", "vulnMin")
-	if ret > 0 {
-		if alertCount < ret {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is less than alert_min config: %v actual got: %v", ret, alertCount))
-			return
-		}
-	}
-	maxNum := verifyFs.GetExtraInfoInt("alert_max", "vuln_max", "alertMax", "vulnMax")
-	if maxNum > 0 {
-		if alertCount > maxNum {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is more than alert_max config: %v actual got: %v", maxNum, alertCount))
-			return
-		}
-	}
-	num := verifyFs.GetExtraInfoInt("alert_exact", "alertExact", "vulnExact", "alert_num", "vulnNum")
-	if num > 0 {
-		if alertCount != num {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is not equal alert_exact config: %v, actual got: %v", num, alertCount))
-			return
-		}
+	if err := verifyFs.GetAlertExpectation().Check(stat); err != nil {
+		errs = utils.JoinErrors(errs, err)
 	}
-	high := verifyFs.GetExtraInfoInt("alert_high", "alertHigh", "vulnHigh")
-	if high > 0 {
-		if alert_high < high {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is less than alert_high config: %v, actual got: %v", high, alert_high))
-			return
-		}
-	}
-	mid := verifyFs.GetExtraInfoInt("alert_mid", "alertMid", "vulnMid")
-	if mid > 0 {
-		if alert_mid < mid {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is less than alert_mid config: %v, actual got: %v", mid, alert_mid))
-			return
-		}
-	}
-	low := verifyFs.GetExtraInfoInt("alert_low", "alertMid", "vulnMid", "alert_info")
-	if low > 0 {
-		if alert_info < low {
-			errs = utils.JoinErrors(errs, utils.Errorf("alert symbol table is less than alert_low config: %v, actual got: %v", low, alert_info))
-			return
-		}
-	}
-
 	return
 }
+
 func EvaluateVerifyFilesystemWithRule(rule *schema.SyntaxFlowRule, t *testing.T) error {
 	frame, err := sfvm.NewSyntaxFlowVirtualMachine().Compile(rule.Content)
 	if err != nil {
@@ -35,6 +35,8 @@ type Value struct {
 	DescInfo     map[string]string
 	// value from database
 	auditNode *ssadb.AuditNode
+	// cross-language dataflow, see http_route.go
+	httpRouteLink *HTTPRouteLink
 }
 
 type PredecessorValue struct {
@@ -17,6 +17,8 @@ const (
 	EdgeTypeDependOn    = "depend_on"
 	EdgeTypeEffectOn    = "effect_on"
 	EdgeTypePredecessor = "predecessor"
+	// EdgeTypeHTTPRoute 是前端请求调用点到后端路由处理函数参数的跨语言数据流
+	EdgeTypeHTTPRoute = "http_route"
 )
 
 func ValidEdgeType(edge string) EdgeType {
@@ -27,6 +29,8 @@ func ValidEdgeType(edge string) EdgeType {
 		return EdgeTypeEffectOn
 	case "predecessor":
 		return EdgeTypePredecessor
+	case "http_route":
+		return EdgeTypeHTTPRoute
 	}
 	return ""
 }
@@ -113,9 +117,21 @@ func (g *ValueGraph) getNeighbors(value *Value) []*graph.Neighbor[*Value] {
 
 	var res []*graph.Neighbor[*Value]
 	for _, v := range value.GetDependOn() {
+		if link := value.GetHTTPRouteLink(); link != nil {
+			neighbor := graph.NewNeighbor(v, EdgeTypeHTTPRoute)
+			neighbor.AddExtraMsg("label", link.String())
+			res = append(res, neighbor)
+			continue
+		}
 		res = append(res, graph.NewNeighbor(v, EdgeTypeDependOn))
 	}
 	for _, v := range value.GetEffectOn() {
+		if link := v.GetHTTPRouteLink(); link != nil {
+			neighbor := graph.NewNeighbor(v, EdgeTypeHTTPRoute)
+			neighbor.AddExtraMsg("label", link.String())
+			res = append(res, neighbor)
+			continue
+		}
 		res = append(res, graph.NewNeighbor(v, EdgeTypeEffectOn))
 	}
 
@@ -135,7 +151,7 @@ func (g *ValueGraph) getNeighbors(value *Value) []*graph.Neighbor[*Value] {
 }
 
 func IsDataFlowType(typ string) bool {
-	return typ == EdgeTypeDependOn || typ == EdgeTypeEffectOn
+	return typ == EdgeTypeDependOn || typ == EdgeTypeEffectOn || typ == EdgeTypeHTTPRoute
 }
 
 func (g *ValueGraph) handleEdge(fromNode int, toNode int, edgeType string, extraMsg map[string]any) {
@@ -153,6 +169,20 @@ func (g *ValueGraph) handleEdge(fromNode int, toNode int, edgeType string, extra
 		g.AddEdge(toNode, fromNode, edgeType)
 	case EdgeTypeEffectOn:
 		g.AddEdge(toNode, fromNode, edgeType)
+	case EdgeTypeHTTPRoute:
+		var label string
+		if extraMsg != nil {
+			label, _ = extraMsg["label"].(string)
+		}
+		// 边总是从前端的请求调用点指向后端处理函数的参数
+		request, handler := toNode, fromNode
+		if value, ok := g.Node2Value[toNode]; ok && value.GetHTTPRouteLink() != nil {
+			request, handler = fromNode, toNode
+		}
+		edgeId := g.AddEdge(request, handler, label)
+		g.EdgeAttribute(edgeId, "color", "blue")
+		g.EdgeAttribute(edgeId, "fontcolor", "blue")
+		g.EdgeAttribute(edgeId, "style", "dashed")
 	case EdgeTypePredecessor:
 		edges := g.GetEdges(toNode, fromNode)
 		var (
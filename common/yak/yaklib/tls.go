@@ -55,6 +55,7 @@ var TlsExports = map[string]interface{}{
 	"Inspect":                  netx.TLSInspect,
 	"InspectForceHttp2":        netx.TLSInspectForceHttp2,
 	"InspectForceHttp1_1":      netx.TLSInspectForceHttp1_1,
+	"JARM":                     netx.JARM,
 	"EncryptWithPkcs1v15":      tlsutils.Pkcs1v15Encrypt,
 	"DecryptWithPkcs1v15":      tlsutils.Pkcs1v15Decrypt,
 }
@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/fp/fingerprint"
+	"github.com/yaklang/yaklang/common/fp/webfingerprint"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+// LearnFromHTTPFlow 从当前项目的历史流量中学习 web 指纹，按 favicon、标题、响应头和静态资源路径对站点聚类，
+// 返回候选规则列表，每条候选规则都已经在历史流量上完成验证
+// @param {...LearnOption} [opts] 学习选项，例如 learnMinHosts、learnMaxRatio、learnHostKeyword
+// @return {[]*LearnCandidate} 候选规则
+// @return {error} 错误
+// Example:
+// ```
+// candidates, err = servicescan.LearnFromHTTPFlow(servicescan.learnMinHosts(3))
+// die(err)
+// for c in candidates {
+// println(c.Hosts, c.Validation.String())
+// println(c.YAML()~)
+// }
+// ```
+func _learnWebFingerprintFromHTTPFlow(opts ...webfingerprint.LearnOption) ([]*webfingerprint.LearnCandidate, error) {
+	candidates, _, err := webfingerprint.LearnFromHTTPFlow(consts.GetGormProjectDatabase(), opts...)
+	return candidates, err
+}
+
+// ValidateWebRuleOnHTTPFlow 使用当前项目的历史流量验证 webfingerprint YAML 规则，返回命中规则的站点
+// 第二个参数为期望命中的站点，传入时会统计误报与漏报
+// @param {string} rule webfingerprint YAML 规则
+// @param {[]string} hosts 期望命中的站点，可以为空
+// @param {...LearnOption} [opts] 读取流量的选项，例如 learnHostKeyword、learnMaxFlows
+// @return {*LearnValidation} 验证结果
+// @return {error} 错误
+// Example:
+// ```
+// result, err = servicescan.ValidateWebRuleOnHTTPFlow(candidate.YAML()~, candidate.Hosts)
+// die(err)
+// println(result.String(), result.UnexpectedHosts)
+// ```
+func _validateWebRuleOnHTTPFlow(rule string, hosts []string, opts ...webfingerprint.LearnOption) (*webfingerprint.LearnValidation, error) {
+	rules, err := webfingerprint.ParseWebFingerprintRules([]byte(rule))
+	if err != nil {
+		return nil, err
+	}
+	samples, err := webfingerprint.LoadFlowSamples(consts.GetGormProjectDatabase(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return webfingerprint.ValidateWebRules(rules, hosts, samples), nil
+}
+
+// SaveLearnedRule 将候选规则保存到指纹规则库（fingerprint_general_rule），保存前可以使用 SetProduct 设置厂商与产品名
+// 产品名作为规则名，favicon 特征会保存为名为 "产品名_favicon" 的规则，规则名已存在时返回错误
+// 保存前会编译将要保存的规则并在当前项目的历史流量上验证，命中的站点需要与候选规则一致
+// @param {*LearnCandidate} candidate 候选规则
+// @param {...LearnOption} [opts] 读取验证流量的选项，例如 learnHostKeyword、learnMaxFlows
+// @return {error} 错误
+// Example:
+// ```
+// candidate.SetProduct("acme", "acme_console")
+// die(servicescan.SaveLearnedRule(candidate))
+// ```
+func _saveLearnedWebFingerprint(candidate *webfingerprint.LearnCandidate, opts ...webfingerprint.LearnOption) error {
+	if candidate == nil {
+		return utils.Error("empty candidate")
+	}
+	rules := candidate.GeneralRules()
+	if len(rules) == 0 {
+		return utils.Error("candidate has no rule to save")
+	}
+	samples, err := webfingerprint.LoadFlowSamples(consts.GetGormProjectDatabase(), opts...)
+	if err != nil {
+		return err
+	}
+	if _, err := fingerprint.ValidateLearnCandidate(candidate, rules, samples); err != nil {
+		return err
+	}
+	db := consts.GetGormProfileDatabase()
+	for _, rule := range rules {
+		if _, err := yakit.GetGeneralRuleByRuleName(db, rule.RuleName); err == nil {
+			return utils.Errorf("fingerprint rule %s already exists", rule.RuleName)
+		}
+	}
+	for _, rule := range rules {
+		if err := yakit.CreateGeneralRule(db, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	filter2 "github.com/yaklang/yaklang/common/filter"
 	"github.com/yaklang/yaklang/common/fp"
+	"github.com/yaklang/yaklang/common/fp/webfingerprint"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/synscan"
 	"github.com/yaklang/yaklang/common/utils"
@@ -493,4 +494,15 @@ var FingerprintScanExports = map[string]interface{}{
 	"withRuleGroup":    fp.WithFingerprintRuleGroup,
 
 	"disableDefaultRule": _disableDefaultFingerprint,
+
+	// 从历史流量中学习 web 指纹
+	"LearnFromHTTPFlow":         _learnWebFingerprintFromHTTPFlow,
+	"ValidateWebRuleOnHTTPFlow": _validateWebRuleOnHTTPFlow,
+	"SaveLearnedRule":           _saveLearnedWebFingerprint,
+	"learnMinHosts":             webfingerprint.WithLearnMinHosts,
+	"learnMaxRatio":             webfingerprint.WithLearnMaxRatio,
+	"learnMinScore":             webfingerprint.WithLearnMinScore,
+	"learnMaxFeatures":          webfingerprint.WithLearnMaxFeatures,
+	"learnHostKeyword":          webfingerprint.WithLearnHostKeyword,
+	"learnMaxFlows":             webfingerprint.WithLearnMaxFlows,
 }
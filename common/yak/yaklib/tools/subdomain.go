@@ -109,9 +109,39 @@ func withRecursiveDict(i any) subdomain.ConfigOption {
 	return subdomain.WithSubDictionary(utils.StringAsFileParams(i))
 }
 
+// modes 是一个选项参数，设置子域名发现模式，默认使用爆破、搜索与域传送
+// Example:
+// ```
+// subdomain.Scan("example.com", subdomain.modes(subdomain.MODE_PASSIVE), subdomain.passive(index))
+// ```
+func withModes(modes ...int) subdomain.ConfigOption {
+	return subdomain.WithModes(modes...)
+}
+
+// passive 是一个选项参数，设置被动数据源并启用被动模式，数据源可以是 PassiveIndex 或者其他实现了 PassiveSource 的对象
+// 没有通过 modes 指定发现模式时只使用被动模式，不会发送任何探测
+// Example:
+// ```
+// index = subdomain.NewPassiveIndex()
+// index.ImportFDNSFile("/tmp/fdns_a.json.gz")~
+// subdomain.Scan("example.com", subdomain.passive(index))
+// ```
+func withPassive(sources ...subdomain.PassiveSource) subdomain.ConfigOption {
+	return subdomain.WithPassiveSources(sources...)
+}
+
 var SubDomainExports = map[string]interface{}{
 	"Scan": _subdomainScan,
 
+	// 被动数据源
+	"NewPassiveIndex":  subdomain.NewPassiveIndex,
+	"LoadPassiveIndex": subdomain.LoadPassiveIndex,
+
+	"MODE_BRUTE":         subdomain.BRUTE,
+	"MODE_SEARCH":        subdomain.SEARCH,
+	"MODE_ZONE_TRANSFER": subdomain.ZONE_TRANSFER,
+	"MODE_PASSIVE":       subdomain.PASSIVE,
+
 	// 选项
 	"wildcardToStop":    subdomain.WithWildCardToStop,
 	"recursive":         subdomain.WithAllowToRecursive,
@@ -125,4 +155,8 @@ var SubDomainExports = map[string]interface{}{
 
 	"mainDict":      withMainDict,
 	"recursiveDict": withRecursiveDict,
+
+	"modes":          withModes,
+	"passive":        withPassive,
+	"passiveResolve": subdomain.WithPassiveResolve,
 }
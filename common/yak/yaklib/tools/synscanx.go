@@ -135,7 +135,7 @@ func do(targets, ports string, config *synscanx.SynxConfig) (chan *synscan.SynSc
 	ctx := config.Ctx
 
 	log.Debugf("targets: %s", targets)
-	sample := utils.ParseStringToHosts(targets)[0]
+	sample := synscanx.ParseTargetHosts(targets)[0]
 	scanner, err := synscanx.NewScannerx(ctx, sample, config)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 	"github.com/yaklang/yaklang/common/ai"
 	"github.com/yaklang/yaklang/common/ai/aid"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/mcptools"
 	"github.com/yaklang/yaklang/common/ai/aispec"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
@@ -287,6 +288,10 @@ func buildAIDOption(startParams *ypb.AIStartParams) []aid.Option {
 		aidOption = append(aidOption, aid.WithAiToolsSearchTool())
 	}
 
+	if len(startParams.GetMcpServers()) > 0 {
+		aidOption = append(aidOption, aid.WithMCPServers(buildMCPServerConfigs(startParams.GetMcpServers())...))
+	}
+
 	if startParams.GetEnableAISearchInternet() {
 		aidOption = append(aidOption, aid.WithOmniSearchTool())
 	}
@@ -313,3 +318,29 @@ func buildAIDOption(startParams *ypb.AIStartParams) []aid.Option {
 
 	return aidOption
 }
+
+// buildMCPServerConfigs converts McpConfig of the start params, Type is stdio or sse,
+// Key is the name of the server and Url is the sse url or the command line of the stdio server
+func buildMCPServerConfigs(configs []*ypb.McpConfig) []*mcptools.ServerConfig {
+	var servers []*mcptools.ServerConfig
+	for _, config := range configs {
+		typ := strings.ToLower(config.GetType())
+		if typ == "" && utils.IsHttpOrHttpsUrl(config.GetUrl()) {
+			typ = mcptools.TypeSSE
+		}
+		switch typ {
+		case mcptools.TypeSSE, "url":
+			servers = append(servers, mcptools.NewSSEServerConfig(config.GetKey(), config.GetUrl()))
+		case mcptools.TypeStdio, "command", "":
+			server, err := mcptools.NewStdioServerConfig(config.GetKey(), config.GetUrl())
+			if err != nil {
+				log.Errorf("invalid mcp server %v: %v", config.GetKey(), err)
+				continue
+			}
+			servers = append(servers, server)
+		default:
+			log.Errorf("unsupported mcp server type: %v", config.GetType())
+		}
+	}
+	return servers
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/yaklang/yaklang/common/go-funk"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
@@ -356,6 +357,32 @@ func (s *Server) Codec(ctx context.Context, req *ypb.CodecRequest) (*ypb.CodecRe
 			return nil, utils.Errorf("codec[%v] failed: %s", "packet-from-url", err)
 		}
 		result = string(raw)
+	case "protobuf-packet-decode":
+		// 将 gRPC / grpc-web / protobuf 报文的消息解码为可编辑的文本，可选参数 proto 为 .proto 源码，message 为消息类型
+		opts, err := protobufCodecOptions(getParams("proto", false), getParams("message", false))
+		if err != nil {
+			return nil, utils.Errorf("codec[%v] failed: %s", req.Type, err)
+		}
+		body, err := protobufx.DecodeHTTPPacket([]byte(text), opts...)
+		if err != nil {
+			return nil, utils.Errorf("codec[%v] failed: %s", req.Type, err)
+		}
+		result = body.String()
+	case "protobuf-packet-encode":
+		// 将编辑后的文本重新编码并替换参数 packet 中报文的请求体
+		packet := getParams("packet", false)
+		if packet == "" {
+			return nil, utils.Errorf("codec[%v] failed: param packet is empty", req.Type)
+		}
+		opts, err := protobufCodecOptions(getParams("proto", false), getParams("message", false))
+		if err != nil {
+			return nil, utils.Errorf("codec[%v] failed: %s", req.Type, err)
+		}
+		raw, err = protobufx.EncodeHTTPPacket([]byte(packet), text, opts...)
+		if err != nil {
+			return nil, utils.Errorf("codec[%v] failed: %s", req.Type, err)
+		}
+		result = string(raw)
 	case "pretty-packet":
 		headers, bytes := lowhttp.SplitHTTPHeadersAndBodyFromPacket([]byte(text))
 		if bytes != nil && headers != "" {
@@ -1117,3 +1144,18 @@ func (s *Server) GetAllCodecFlow(ctx context.Context, req *ypb.Empty) (*ypb.GetC
 
 	return &ypb.GetCodecFlowResponse{Flows: res}, nil
 }
+
+func protobufCodecOptions(protoSource, messageType string) ([]protobufx.Option, error) {
+	if protoSource == "" {
+		return nil, nil
+	}
+	protoSchema, err := protobufx.ParseProto(protoSource)
+	if err != nil {
+		return nil, err
+	}
+	opts := []protobufx.Option{protobufx.WithSchema(protoSchema)}
+	if messageType != "" {
+		opts = append(opts, protobufx.WithMessageType(messageType))
+	}
+	return opts, nil
+}
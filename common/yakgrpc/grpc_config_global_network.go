@@ -197,6 +197,10 @@ func (s *Server) GetThirdPartyAppConfigTemplate(ctx context.Context, _ *ypb.Empt
 			verbose = "OpenRouter"
 			extTag["model"] = "default:qwen/qwq-32b:free"
 			extTag["domain"] = "default:openrouter.ai"
+		case "anthropic":
+			verbose = "Anthropic"
+			extTag["model"] = "default:claude-3-5-haiku-latest"
+			extTag["domain"] = "default:api.anthropic.com"
 		}
 		aiOptions, err := utils.ParseAppTagToOptions(&aispec.AIConfig{}, extTag)
 		if err != nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/yaklang/yaklang/common/go-funk"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
@@ -1315,7 +1316,10 @@ func (s *Server) MITM(stream ypb.Yak_MITMServer) error {
 					RemoteAddr:          httpctx.GetRemoteAddr(originReqIns),
 				}
 
-				if lowhttp.IsMultipartFormDataRequest(fixReq) || !utf8.Valid(fixReq) {
+				// gRPC / protobuf 请求体解码为 grpc:encode 等 fuzztag 包裹的文本，转发时渲染 fuzztag 重新编码
+				if tagged, err := protobufx.ConvertHTTPPacketToFuzzTag(fixReq); err == nil {
+					feedbackOrigin.Request = tagged
+				} else if lowhttp.IsMultipartFormDataRequest(fixReq) || !utf8.Valid(fixReq) {
 					feedbackOrigin.Request = lowhttp.ConvertHTTPRequestToFuzzTag(fixReq)
 				}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
 	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
@@ -297,7 +298,9 @@ func (s *Server) MITMV2(stream ypb.Yak_MITMV2Server) error {
 
 	hijackListFeedback := func(action string, resp ...*ypb.SingleManualHijackInfoMessage) {
 		for _, message := range resp { // utf8 check
-			if lowhttp.IsMultipartFormDataRequest(message.Request) || !utf8.Valid(message.Request) {
+			if tagged, err := protobufx.ConvertHTTPPacketToFuzzTag(message.Request); err == nil {
+				message.Request = tagged
+			} else if lowhttp.IsMultipartFormDataRequest(message.Request) || !utf8.Valid(message.Request) {
 				message.Request = lowhttp.ConvertHTTPRequestToFuzzTag(message.Request)
 			}
 
@@ -2,7 +2,13 @@ package yakgrpc
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfdb"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 )
@@ -50,3 +56,84 @@ func (s *Server) DeleteSSAPrograms(ctx context.Context, req *ypb.DeleteSSAProgra
 		ExtraMessage: "",
 	}, nil
 }
+
+func (s *Server) IncrementalCompileSSAProgram(req *ypb.IncrementalCompileSSAProgramRequest, stream ypb.Yak_IncrementalCompileSSAProgramServer) error {
+	programName := req.GetProgramName()
+	if programName == "" {
+		return utils.Error("program name is empty")
+	}
+	prog, err := ssaapi.FromDatabase(programName)
+	if err != nil {
+		return utils.Wrapf(err, "load program %s failed", programName)
+	}
+	result, err := prog.IncrementalRecompile(
+		ssaapi.WithContext(stream.Context()),
+		ssaapi.WithProcess(func(msg string, process float64) {
+			stream.Send(&ypb.IncrementalCompileSSAProgramResponse{
+				Process: process,
+				Message: msg,
+			})
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	ruleNames := req.GetRuleNames()
+	if len(ruleNames) == 0 {
+		ruleNames, err = ssadb.GetAuditRuleNamesByProgram(s.GetSSADatabase(), programName)
+		if err != nil {
+			return err
+		}
+	}
+	rules, err := sfdb.QueryRulesByName(s.GetProfileDatabase(), ruleNames)
+	if err != nil {
+		return utils.Wrapf(err, "query syntaxflow rules failed")
+	}
+
+	ret := &ypb.IncrementalCompileSSAProgramResult{
+		FullCompiled:       result.FullCompile,
+		Changed:            result.HasChanged(),
+		AddedFiles:         result.AddedFiles,
+		ModifiedFiles:      result.ModifiedFiles,
+		RemovedFiles:       result.RemovedFiles,
+		RecompiledFiles:    result.RecompiledFiles,
+		AffectedFunctions:  result.AffectedFunctions,
+		AffectedBlueprints: result.AffectedBlueprints,
+	}
+	rerunRules := result.FilterRules(rules)
+	for _, rule := range rerunRules {
+		ret.RerunRuleNames = append(ret.RerunRuleNames, rule.RuleName)
+	}
+
+	if req.GetRerunRules() && len(rerunRules) > 0 {
+		// 重新从数据库加载编译后的程序
+		prog, err = ssaapi.FromDatabase(programName)
+		if err != nil {
+			return utils.Wrapf(err, "load program %s failed", programName)
+		}
+		for i, rule := range rerunRules {
+			stream.Send(&ypb.IncrementalCompileSSAProgramResponse{
+				Process: float64(i) / float64(len(rerunRules)),
+				Message: fmt.Sprintf("rerun rule %s", rule.RuleName),
+			})
+			res, err := prog.SyntaxFlowRule(rule,
+				ssaapi.QueryWithContext(stream.Context()),
+				ssaapi.QueryWithSave(schema.SFResultKindScan),
+			)
+			if err != nil {
+				stream.Send(&ypb.IncrementalCompileSSAProgramResponse{
+					Process: float64(i+1) / float64(len(rerunRules)),
+					Message: fmt.Sprintf("rerun rule %s failed: %v", rule.RuleName, err),
+				})
+				continue
+			}
+			ret.RerunResultIDs = append(ret.RerunResultIDs, uint64(res.GetResultID()))
+		}
+	}
+	return stream.Send(&ypb.IncrementalCompileSSAProgramResponse{
+		Process: 1,
+		Message: "incremental compile finished",
+		Result:  ret,
+	})
+}
@@ -2,11 +2,14 @@ package yakgrpc
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfdb"
 	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
 	"github.com/yaklang/yaklang/common/yak/ssaapi"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
@@ -174,3 +177,104 @@ func TestGRPCMUSTPASS_SyntaxFlow_Program_Update(t *testing.T) {
 	require.NotNil(t, prog)
 	require.Equal(t, prog.Description, newDesc)
 }
+
+func TestGRPCMUSTPASS_SyntaxFlow_Program_IncrementalCompile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	writeFile("A.java", `public class A {
+    public void run(String cmd) {
+        Runtime.getRuntime().exec(cmd);
+    }
+}`)
+	writeFile("B.java", `public class B {
+    public int calc(int a, int b) {
+        return a + b;
+    }
+}`)
+
+	name := uuid.NewString()
+	_, err := ssaapi.ParseProjectFromPath(dir,
+		ssaapi.WithRawLanguage("java"),
+		ssaapi.WithProgramName(name),
+	)
+	defer ssadb.DeleteProgram(ssadb.GetDB(), name)
+	require.NoError(t, err)
+
+	createRule := func(content string) string {
+		rule, err := sfdb.CheckSyntaxFlowRuleContent(content)
+		require.NoError(t, err)
+		rule.RuleName = uuid.NewString()
+		_, err = sfdb.CreateRule(rule)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			sfdb.DeleteRuleByRuleName(rule.RuleName)
+		})
+		return rule.RuleName
+	}
+	execRule := createRule(`Runtime.getRuntime().exec(* as $target)`)
+	calcRule := createRule(`calc as $target`)
+
+	local, err := NewLocalClient(true)
+	require.NoError(t, err)
+	compile := func(rerun ...bool) *ypb.IncrementalCompileSSAProgramResult {
+		stream, err := local.IncrementalCompileSSAProgram(context.Background(), &ypb.IncrementalCompileSSAProgramRequest{
+			ProgramName: name,
+			RuleNames:   []string{execRule, calcRule},
+			RerunRules:  len(rerun) > 0 && rerun[0],
+		})
+		require.NoError(t, err)
+		var result *ypb.IncrementalCompileSSAProgramResult
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			if msg.GetResult() != nil {
+				result = msg.GetResult()
+			}
+		}
+		require.NotNil(t, result)
+		return result
+	}
+
+	t.Run("no change", func(t *testing.T) {
+		result := compile()
+		require.False(t, result.FullCompiled)
+		require.False(t, result.Changed)
+		require.Empty(t, result.RerunRuleNames)
+	})
+
+	t.Run("modify file", func(t *testing.T) {
+		writeFile("B.java", `public class B {
+    public int calc(int a, int b) {
+        return a * b;
+    }
+}`)
+		result := compile()
+		require.False(t, result.FullCompiled)
+		require.True(t, result.Changed)
+		require.Equal(t, []string{"B.java"}, result.ModifiedFiles)
+		require.Equal(t, []string{"B.java"}, result.RecompiledFiles)
+		require.Equal(t, []string{calcRule}, result.RerunRuleNames)
+		require.Empty(t, result.RerunResultIDs)
+	})
+
+	t.Run("rerun rules", func(t *testing.T) {
+		writeFile("B.java", `public class B {
+    public int calc(int a, int b) {
+        return a - b;
+    }
+}`)
+		result := compile(true)
+		require.False(t, result.FullCompiled)
+		require.Equal(t, []string{calcRule}, result.RerunRuleNames)
+		require.Len(t, result.RerunResultIDs, 1)
+
+		res, err := ssaapi.LoadResultByID(uint(result.RerunResultIDs[0]))
+		require.NoError(t, err)
+		require.Equal(t, calcRule, res.GetRule().RuleName)
+		require.NotEmpty(t, res.GetValues("target"))
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/yaklang/yaklang/common/jsonextractor"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
+	"github.com/yaklang/yaklang/common/protobufx"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
@@ -308,6 +309,18 @@ func toHTTPFlowGRPCModel(f *schema.HTTPFlow, full bool) (*ypb.HTTPFlow, error) {
 
 	// 提取数据 - 完整详细的数据的时候，才应该提取
 	if full {
+		// gRPC / grpc-web / protobuf 的消息是二进制，解码为文本便于查看
+		if haveRequest && requireRequest {
+			if body, err := protobufx.DecodeHTTPPacket(flow.Request); err == nil && len(body.Messages()) > 0 {
+				flow.RequestProtobufText = utf8safe(body.String())
+			}
+		}
+		if haveResponse && requireResponse {
+			if body, err := protobufx.DecodeHTTPPacket(flow.Response); err == nil && len(body.Messages()) > 0 {
+				flow.ResponseProtobufText = utf8safe(body.String())
+			}
+		}
+
 		domains, rootDomains := domainextractor.ExtractDomainsEx(string(flow.Response))
 		var jsonObjects []string
 		if !utils.MatchAnyOfSubString(strings.ToLower(f.ContentType), "json") {
@@ -375,7 +388,10 @@ func FuzzParamsToGRPCFuzzableParam(r *mutate.FuzzHTTPRequestParam, isHttps bool)
 		}
 	}
 	if raw != nil {
-		if bytes.Contains(raw, []byte(flag)) {
+		if r.Position() == string(lowhttp.PosPostProtobuf) && !bytes.Contains(raw, []byte(strconv.Quote(flag))) {
+			// protobuf 请求体为 grpc:encode / protobuf:encode 包裹的文本，数值字段的值没有引号，使用数字保持字段类型
+			p.AutoTemplate = bytes.ReplaceAll(raw, []byte(flag), []byte("{{randint(1,65535)}}"))
+		} else if bytes.Contains(raw, []byte(flag)) {
 			p.AutoTemplate = bytes.ReplaceAll(raw, []byte(flag), []byte("{{randstr(10,10,1)}}"))
 		} else if bytes.Contains(raw, []byte(codec.EncodeBase64(flag))) {
 			p.AutoTemplate = bytes.ReplaceAll(raw, []byte(codec.EncodeBase64(flag)), []byte("{{base64({{randstr(10,10,1)}})}}"))
@@ -0,0 +1,58 @@
+package yakit
+
+import (
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bizhelper"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+type MCPToolAuditLogFilter struct {
+	TokenName string
+	SessionID string
+	ToolName  []string
+	// OnlyFailed queries the failed and denied invocations
+	OnlyFailed bool
+}
+
+func CreateMCPToolAuditLog(db *gorm.DB, log *schema.MCPToolAuditLog) error {
+	if db := db.Create(log); db.Error != nil {
+		return utils.Errorf("create MCPToolAuditLog failed: %s", db.Error)
+	}
+	return nil
+}
+
+func FilterMCPToolAuditLog(db *gorm.DB, filter *MCPToolAuditLogFilter) *gorm.DB {
+	if filter == nil {
+		return db
+	}
+	db = bizhelper.ExactQueryString(db, "token_name", filter.TokenName)
+	db = bizhelper.ExactQueryString(db, "session_id", filter.SessionID)
+	db = bizhelper.ExactQueryStringArrayOr(db, "tool_name", filter.ToolName)
+	if filter.OnlyFailed {
+		db = db.Where("success = ?", false)
+	}
+	return db
+}
+
+func QueryMCPToolAuditLog(db *gorm.DB, filter *MCPToolAuditLogFilter, paging *ypb.Paging) (*bizhelper.Paginator, []*schema.MCPToolAuditLog, error) {
+	var logs []*schema.MCPToolAuditLog
+	db = db.Model(&schema.MCPToolAuditLog{})
+	db = FilterMCPToolAuditLog(db, filter)
+	db = bizhelper.QueryOrder(db, paging.GetOrderBy(), paging.GetOrder())
+	p, db := bizhelper.Paging(db, int(paging.GetPage()), int(paging.GetLimit()), &logs)
+	if db.Error != nil {
+		return nil, nil, utils.Errorf("query MCPToolAuditLog failed: %s", db.Error)
+	}
+	return p, logs, nil
+}
+
+func DeleteMCPToolAuditLog(db *gorm.DB, filter *MCPToolAuditLogFilter) (int64, error) {
+	db = db.Model(&schema.MCPToolAuditLog{})
+	db = FilterMCPToolAuditLog(db, filter).Unscoped().Delete(&schema.MCPToolAuditLog{})
+	if db.Error != nil {
+		return 0, utils.Errorf("delete MCPToolAuditLog failed: %s", db.Error)
+	}
+	return db.RowsAffected, nil
+}
@@ -38307,13 +38307,16 @@ type HTTPFlow struct {
 	TooLargeResponseBodyFile   string   `protobuf:"bytes,45,opt,name=TooLargeResponseBodyFile,proto3" json:"TooLargeResponseBodyFile,omitempty"`
 	DisableRenderStyles        bool     `protobuf:"varint,46,opt,name=DisableRenderStyles,proto3" json:"DisableRenderStyles,omitempty"`
 	// payloads (web fuzzer)
-	Payloads      []string `protobuf:"bytes,47,rep,name=Payloads,proto3" json:"Payloads,omitempty"`
-	DurationMs    int64    `protobuf:"varint,48,opt,name=DurationMs,proto3" json:"DurationMs,omitempty"`
-	HiddenIndex   string   `protobuf:"bytes,49,opt,name=HiddenIndex,proto3" json:"HiddenIndex,omitempty"`
-	FromPlugin    string   `protobuf:"bytes,50,opt,name=FromPlugin,proto3" json:"FromPlugin,omitempty"`
-	Host          string   `protobuf:"bytes,52,opt,name=Host,proto3" json:"Host,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Payloads    []string `protobuf:"bytes,47,rep,name=Payloads,proto3" json:"Payloads,omitempty"`
+	DurationMs  int64    `protobuf:"varint,48,opt,name=DurationMs,proto3" json:"DurationMs,omitempty"`
+	HiddenIndex string   `protobuf:"bytes,49,opt,name=HiddenIndex,proto3" json:"HiddenIndex,omitempty"`
+	FromPlugin  string   `protobuf:"bytes,50,opt,name=FromPlugin,proto3" json:"FromPlugin,omitempty"`
+	Host        string   `protobuf:"bytes,52,opt,name=Host,proto3" json:"Host,omitempty"`
+	// gRPC / grpc-web / protobuf 报文中消息解码后的文本（完全数据下）
+	RequestProtobufText  string `protobuf:"bytes,53,opt,name=RequestProtobufText,proto3" json:"RequestProtobufText,omitempty"`
+	ResponseProtobufText string `protobuf:"bytes,54,opt,name=ResponseProtobufText,proto3" json:"ResponseProtobufText,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *HTTPFlow) Reset() {
@@ -38696,6 +38699,20 @@ func (x *HTTPFlow) GetHost() string {
 	return ""
 }
 
+func (x *HTTPFlow) GetRequestProtobufText() string {
+	if x != nil {
+		return x.RequestProtobufText
+	}
+	return ""
+}
+
+func (x *HTTPFlow) GetResponseProtobufText() string {
+	if x != nil {
+		return x.ResponseProtobufText
+	}
+	return ""
+}
+
 type FuzzableParam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Position      string                 `protobuf:"bytes,1,opt,name=Position,proto3" json:"Position,omitempty"`
@@ -45992,6 +46009,242 @@ func (x *QuerySSAProgramResponse) GetTotal() int64 {
 	return 0
 }
 
+type IncrementalCompileSSAProgramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramName   string                 `protobuf:"bytes,1,opt,name=ProgramName,proto3" json:"ProgramName,omitempty"`
+	RuleNames     []string               `protobuf:"bytes,2,rep,name=RuleNames,proto3" json:"RuleNames,omitempty"`
+	RerunRules    bool                   `protobuf:"varint,3,opt,name=RerunRules,proto3" json:"RerunRules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementalCompileSSAProgramRequest) Reset() {
+	*x = IncrementalCompileSSAProgramRequest{}
+	mi := &file_yakgrpc_proto_msgTypes[633]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementalCompileSSAProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementalCompileSSAProgramRequest) ProtoMessage() {}
+
+func (x *IncrementalCompileSSAProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_yakgrpc_proto_msgTypes[633]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementalCompileSSAProgramRequest.ProtoReflect.Descriptor instead.
+func (*IncrementalCompileSSAProgramRequest) Descriptor() ([]byte, []int) {
+	return file_yakgrpc_proto_rawDescGZIP(), []int{633}
+}
+
+func (x *IncrementalCompileSSAProgramRequest) GetProgramName() string {
+	if x != nil {
+		return x.ProgramName
+	}
+	return ""
+}
+
+func (x *IncrementalCompileSSAProgramRequest) GetRuleNames() []string {
+	if x != nil {
+		return x.RuleNames
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramRequest) GetRerunRules() bool {
+	if x != nil {
+		return x.RerunRules
+	}
+	return false
+}
+
+type IncrementalCompileSSAProgramResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FullCompiled       bool                   `protobuf:"varint,1,opt,name=FullCompiled,proto3" json:"FullCompiled,omitempty"`
+	Changed            bool                   `protobuf:"varint,2,opt,name=Changed,proto3" json:"Changed,omitempty"`
+	AddedFiles         []string               `protobuf:"bytes,3,rep,name=AddedFiles,proto3" json:"AddedFiles,omitempty"`
+	ModifiedFiles      []string               `protobuf:"bytes,4,rep,name=ModifiedFiles,proto3" json:"ModifiedFiles,omitempty"`
+	RemovedFiles       []string               `protobuf:"bytes,5,rep,name=RemovedFiles,proto3" json:"RemovedFiles,omitempty"`
+	RecompiledFiles    []string               `protobuf:"bytes,6,rep,name=RecompiledFiles,proto3" json:"RecompiledFiles,omitempty"`
+	AffectedFunctions  []string               `protobuf:"bytes,7,rep,name=AffectedFunctions,proto3" json:"AffectedFunctions,omitempty"`
+	AffectedBlueprints []string               `protobuf:"bytes,8,rep,name=AffectedBlueprints,proto3" json:"AffectedBlueprints,omitempty"`
+	RerunRuleNames     []string               `protobuf:"bytes,9,rep,name=RerunRuleNames,proto3" json:"RerunRuleNames,omitempty"`
+	RerunResultIDs     []uint64               `protobuf:"varint,10,rep,packed,name=RerunResultIDs,proto3" json:"RerunResultIDs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IncrementalCompileSSAProgramResult) Reset() {
+	*x = IncrementalCompileSSAProgramResult{}
+	mi := &file_yakgrpc_proto_msgTypes[634]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementalCompileSSAProgramResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementalCompileSSAProgramResult) ProtoMessage() {}
+
+func (x *IncrementalCompileSSAProgramResult) ProtoReflect() protoreflect.Message {
+	mi := &file_yakgrpc_proto_msgTypes[634]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementalCompileSSAProgramResult.ProtoReflect.Descriptor instead.
+func (*IncrementalCompileSSAProgramResult) Descriptor() ([]byte, []int) {
+	return file_yakgrpc_proto_rawDescGZIP(), []int{634}
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetFullCompiled() bool {
+	if x != nil {
+		return x.FullCompiled
+	}
+	return false
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetAddedFiles() []string {
+	if x != nil {
+		return x.AddedFiles
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetModifiedFiles() []string {
+	if x != nil {
+		return x.ModifiedFiles
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetRemovedFiles() []string {
+	if x != nil {
+		return x.RemovedFiles
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetRecompiledFiles() []string {
+	if x != nil {
+		return x.RecompiledFiles
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetAffectedFunctions() []string {
+	if x != nil {
+		return x.AffectedFunctions
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetAffectedBlueprints() []string {
+	if x != nil {
+		return x.AffectedBlueprints
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetRerunRuleNames() []string {
+	if x != nil {
+		return x.RerunRuleNames
+	}
+	return nil
+}
+
+func (x *IncrementalCompileSSAProgramResult) GetRerunResultIDs() []uint64 {
+	if x != nil {
+		return x.RerunResultIDs
+	}
+	return nil
+}
+
+type IncrementalCompileSSAProgramResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Process       float64                             `protobuf:"fixed64,1,opt,name=Process,proto3" json:"Process,omitempty"`
+	Message       string                              `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Result        *IncrementalCompileSSAProgramResult `protobuf:"bytes,3,opt,name=Result,proto3" json:"Result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementalCompileSSAProgramResponse) Reset() {
+	*x = IncrementalCompileSSAProgramResponse{}
+	mi := &file_yakgrpc_proto_msgTypes[635]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementalCompileSSAProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementalCompileSSAProgramResponse) ProtoMessage() {}
+
+func (x *IncrementalCompileSSAProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_yakgrpc_proto_msgTypes[635]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementalCompileSSAProgramResponse.ProtoReflect.Descriptor instead.
+func (*IncrementalCompileSSAProgramResponse) Descriptor() ([]byte, []int) {
+	return file_yakgrpc_proto_rawDescGZIP(), []int{635}
+}
+
+func (x *IncrementalCompileSSAProgramResponse) GetProcess() float64 {
+	if x != nil {
+		return x.Process
+	}
+	return 0
+}
+
+func (x *IncrementalCompileSSAProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IncrementalCompileSSAProgramResponse) GetResult() *IncrementalCompileSSAProgramResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type CreateSyntaxFlowRuleRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	SyntaxFlowInput *SyntaxFlowRuleInput   `protobuf:"bytes,1,opt,name=SyntaxFlowInput,proto3" json:"SyntaxFlowInput,omitempty"`
@@ -46001,7 +46254,7 @@ type CreateSyntaxFlowRuleRequest struct {
 
 func (x *CreateSyntaxFlowRuleRequest) Reset() {
 	*x = CreateSyntaxFlowRuleRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[633]
+	mi := &file_yakgrpc_proto_msgTypes[636]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46013,7 +46266,7 @@ func (x *CreateSyntaxFlowRuleRequest) String() string {
 func (*CreateSyntaxFlowRuleRequest) ProtoMessage() {}
 
 func (x *CreateSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[633]
+	mi := &file_yakgrpc_proto_msgTypes[636]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46026,7 +46279,7 @@ func (x *CreateSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSyntaxFlowRuleRequest.ProtoReflect.Descriptor instead.
 func (*CreateSyntaxFlowRuleRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{633}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{636}
 }
 
 func (x *CreateSyntaxFlowRuleRequest) GetSyntaxFlowInput() *SyntaxFlowRuleInput {
@@ -46046,7 +46299,7 @@ type CreateSyntaxFlowRuleResponse struct {
 
 func (x *CreateSyntaxFlowRuleResponse) Reset() {
 	*x = CreateSyntaxFlowRuleResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[634]
+	mi := &file_yakgrpc_proto_msgTypes[637]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46058,7 +46311,7 @@ func (x *CreateSyntaxFlowRuleResponse) String() string {
 func (*CreateSyntaxFlowRuleResponse) ProtoMessage() {}
 
 func (x *CreateSyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[634]
+	mi := &file_yakgrpc_proto_msgTypes[637]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46071,7 +46324,7 @@ func (x *CreateSyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSyntaxFlowRuleResponse.ProtoReflect.Descriptor instead.
 func (*CreateSyntaxFlowRuleResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{634}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{637}
 }
 
 func (x *CreateSyntaxFlowRuleResponse) GetMessage() *DbOperateMessage {
@@ -46097,7 +46350,7 @@ type UpdateSyntaxFlowRuleRequest struct {
 
 func (x *UpdateSyntaxFlowRuleRequest) Reset() {
 	*x = UpdateSyntaxFlowRuleRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[635]
+	mi := &file_yakgrpc_proto_msgTypes[638]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46109,7 +46362,7 @@ func (x *UpdateSyntaxFlowRuleRequest) String() string {
 func (*UpdateSyntaxFlowRuleRequest) ProtoMessage() {}
 
 func (x *UpdateSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[635]
+	mi := &file_yakgrpc_proto_msgTypes[638]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46122,7 +46375,7 @@ func (x *UpdateSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSyntaxFlowRuleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSyntaxFlowRuleRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{635}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{638}
 }
 
 func (x *UpdateSyntaxFlowRuleRequest) GetSyntaxFlowInput() *SyntaxFlowRuleInput {
@@ -46142,7 +46395,7 @@ type UpdateSyntaxFlowRuleResponse struct {
 
 func (x *UpdateSyntaxFlowRuleResponse) Reset() {
 	*x = UpdateSyntaxFlowRuleResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[636]
+	mi := &file_yakgrpc_proto_msgTypes[639]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46154,7 +46407,7 @@ func (x *UpdateSyntaxFlowRuleResponse) String() string {
 func (*UpdateSyntaxFlowRuleResponse) ProtoMessage() {}
 
 func (x *UpdateSyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[636]
+	mi := &file_yakgrpc_proto_msgTypes[639]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46167,7 +46420,7 @@ func (x *UpdateSyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSyntaxFlowRuleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSyntaxFlowRuleResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{636}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{639}
 }
 
 func (x *UpdateSyntaxFlowRuleResponse) GetMessage() *DbOperateMessage {
@@ -46196,7 +46449,7 @@ type QuerySyntaxFlowRuleResponse struct {
 
 func (x *QuerySyntaxFlowRuleResponse) Reset() {
 	*x = QuerySyntaxFlowRuleResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[637]
+	mi := &file_yakgrpc_proto_msgTypes[640]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46208,7 +46461,7 @@ func (x *QuerySyntaxFlowRuleResponse) String() string {
 func (*QuerySyntaxFlowRuleResponse) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[637]
+	mi := &file_yakgrpc_proto_msgTypes[640]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46221,7 +46474,7 @@ func (x *QuerySyntaxFlowRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowRuleResponse.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowRuleResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{637}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{640}
 }
 
 func (x *QuerySyntaxFlowRuleResponse) GetPagination() *Paging {
@@ -46261,7 +46514,7 @@ type DeleteSyntaxFlowRuleRequest struct {
 
 func (x *DeleteSyntaxFlowRuleRequest) Reset() {
 	*x = DeleteSyntaxFlowRuleRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[638]
+	mi := &file_yakgrpc_proto_msgTypes[641]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46273,7 +46526,7 @@ func (x *DeleteSyntaxFlowRuleRequest) String() string {
 func (*DeleteSyntaxFlowRuleRequest) ProtoMessage() {}
 
 func (x *DeleteSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[638]
+	mi := &file_yakgrpc_proto_msgTypes[641]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46286,7 +46539,7 @@ func (x *DeleteSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyntaxFlowRuleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSyntaxFlowRuleRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{638}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{641}
 }
 
 func (x *DeleteSyntaxFlowRuleRequest) GetFilter() *SyntaxFlowRuleFilter {
@@ -46304,7 +46557,7 @@ type CheckSyntaxFlowRuleUpdateRequest struct {
 
 func (x *CheckSyntaxFlowRuleUpdateRequest) Reset() {
 	*x = CheckSyntaxFlowRuleUpdateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[639]
+	mi := &file_yakgrpc_proto_msgTypes[642]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46316,7 +46569,7 @@ func (x *CheckSyntaxFlowRuleUpdateRequest) String() string {
 func (*CheckSyntaxFlowRuleUpdateRequest) ProtoMessage() {}
 
 func (x *CheckSyntaxFlowRuleUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[639]
+	mi := &file_yakgrpc_proto_msgTypes[642]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46329,7 +46582,7 @@ func (x *CheckSyntaxFlowRuleUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckSyntaxFlowRuleUpdateRequest.ProtoReflect.Descriptor instead.
 func (*CheckSyntaxFlowRuleUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{639}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{642}
 }
 
 type CheckSyntaxFlowRuleUpdateResponse struct {
@@ -46342,7 +46595,7 @@ type CheckSyntaxFlowRuleUpdateResponse struct {
 
 func (x *CheckSyntaxFlowRuleUpdateResponse) Reset() {
 	*x = CheckSyntaxFlowRuleUpdateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[640]
+	mi := &file_yakgrpc_proto_msgTypes[643]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46354,7 +46607,7 @@ func (x *CheckSyntaxFlowRuleUpdateResponse) String() string {
 func (*CheckSyntaxFlowRuleUpdateResponse) ProtoMessage() {}
 
 func (x *CheckSyntaxFlowRuleUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[640]
+	mi := &file_yakgrpc_proto_msgTypes[643]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46367,7 +46620,7 @@ func (x *CheckSyntaxFlowRuleUpdateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CheckSyntaxFlowRuleUpdateResponse.ProtoReflect.Descriptor instead.
 func (*CheckSyntaxFlowRuleUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{640}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{643}
 }
 
 func (x *CheckSyntaxFlowRuleUpdateResponse) GetNeedUpdate() bool {
@@ -46392,7 +46645,7 @@ type ApplySyntaxFlowRuleUpdateRequest struct {
 
 func (x *ApplySyntaxFlowRuleUpdateRequest) Reset() {
 	*x = ApplySyntaxFlowRuleUpdateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[641]
+	mi := &file_yakgrpc_proto_msgTypes[644]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46404,7 +46657,7 @@ func (x *ApplySyntaxFlowRuleUpdateRequest) String() string {
 func (*ApplySyntaxFlowRuleUpdateRequest) ProtoMessage() {}
 
 func (x *ApplySyntaxFlowRuleUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[641]
+	mi := &file_yakgrpc_proto_msgTypes[644]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46417,7 +46670,7 @@ func (x *ApplySyntaxFlowRuleUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplySyntaxFlowRuleUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ApplySyntaxFlowRuleUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{641}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{644}
 }
 
 type ApplySyntaxFlowRuleUpdateResponse struct {
@@ -46430,7 +46683,7 @@ type ApplySyntaxFlowRuleUpdateResponse struct {
 
 func (x *ApplySyntaxFlowRuleUpdateResponse) Reset() {
 	*x = ApplySyntaxFlowRuleUpdateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[642]
+	mi := &file_yakgrpc_proto_msgTypes[645]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46442,7 +46695,7 @@ func (x *ApplySyntaxFlowRuleUpdateResponse) String() string {
 func (*ApplySyntaxFlowRuleUpdateResponse) ProtoMessage() {}
 
 func (x *ApplySyntaxFlowRuleUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[642]
+	mi := &file_yakgrpc_proto_msgTypes[645]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46455,7 +46708,7 @@ func (x *ApplySyntaxFlowRuleUpdateResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApplySyntaxFlowRuleUpdateResponse.ProtoReflect.Descriptor instead.
 func (*ApplySyntaxFlowRuleUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{642}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{645}
 }
 
 func (x *ApplySyntaxFlowRuleUpdateResponse) GetPercent() float64 {
@@ -46484,7 +46737,7 @@ type SyntaxFlowRuleGroupFilter struct {
 
 func (x *SyntaxFlowRuleGroupFilter) Reset() {
 	*x = SyntaxFlowRuleGroupFilter{}
-	mi := &file_yakgrpc_proto_msgTypes[643]
+	mi := &file_yakgrpc_proto_msgTypes[646]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46496,7 +46749,7 @@ func (x *SyntaxFlowRuleGroupFilter) String() string {
 func (*SyntaxFlowRuleGroupFilter) ProtoMessage() {}
 
 func (x *SyntaxFlowRuleGroupFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[643]
+	mi := &file_yakgrpc_proto_msgTypes[646]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46509,7 +46762,7 @@ func (x *SyntaxFlowRuleGroupFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowRuleGroupFilter.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowRuleGroupFilter) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{643}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{646}
 }
 
 func (x *SyntaxFlowRuleGroupFilter) GetGroupNames() []string {
@@ -46544,7 +46797,7 @@ type SyntaxFlowGroup struct {
 
 func (x *SyntaxFlowGroup) Reset() {
 	*x = SyntaxFlowGroup{}
-	mi := &file_yakgrpc_proto_msgTypes[644]
+	mi := &file_yakgrpc_proto_msgTypes[647]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46556,7 +46809,7 @@ func (x *SyntaxFlowGroup) String() string {
 func (*SyntaxFlowGroup) ProtoMessage() {}
 
 func (x *SyntaxFlowGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[644]
+	mi := &file_yakgrpc_proto_msgTypes[647]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46569,7 +46822,7 @@ func (x *SyntaxFlowGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowGroup.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowGroup) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{644}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{647}
 }
 
 func (x *SyntaxFlowGroup) GetGroupName() string {
@@ -46603,7 +46856,7 @@ type QuerySyntaxFlowRuleGroupRequest struct {
 
 func (x *QuerySyntaxFlowRuleGroupRequest) Reset() {
 	*x = QuerySyntaxFlowRuleGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[645]
+	mi := &file_yakgrpc_proto_msgTypes[648]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46615,7 +46868,7 @@ func (x *QuerySyntaxFlowRuleGroupRequest) String() string {
 func (*QuerySyntaxFlowRuleGroupRequest) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[645]
+	mi := &file_yakgrpc_proto_msgTypes[648]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46628,7 +46881,7 @@ func (x *QuerySyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowRuleGroupRequest.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowRuleGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{645}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{648}
 }
 
 func (x *QuerySyntaxFlowRuleGroupRequest) GetFilter() *SyntaxFlowRuleGroupFilter {
@@ -46655,7 +46908,7 @@ type QuerySyntaxFlowRuleGroupResponse struct {
 
 func (x *QuerySyntaxFlowRuleGroupResponse) Reset() {
 	*x = QuerySyntaxFlowRuleGroupResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[646]
+	mi := &file_yakgrpc_proto_msgTypes[649]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46667,7 +46920,7 @@ func (x *QuerySyntaxFlowRuleGroupResponse) String() string {
 func (*QuerySyntaxFlowRuleGroupResponse) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowRuleGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[646]
+	mi := &file_yakgrpc_proto_msgTypes[649]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46680,7 +46933,7 @@ func (x *QuerySyntaxFlowRuleGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowRuleGroupResponse.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowRuleGroupResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{646}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{649}
 }
 
 func (x *QuerySyntaxFlowRuleGroupResponse) GetGroup() []*SyntaxFlowGroup {
@@ -46706,7 +46959,7 @@ type CreateSyntaxFlowGroupRequest struct {
 
 func (x *CreateSyntaxFlowGroupRequest) Reset() {
 	*x = CreateSyntaxFlowGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[647]
+	mi := &file_yakgrpc_proto_msgTypes[650]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46718,7 +46971,7 @@ func (x *CreateSyntaxFlowGroupRequest) String() string {
 func (*CreateSyntaxFlowGroupRequest) ProtoMessage() {}
 
 func (x *CreateSyntaxFlowGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[647]
+	mi := &file_yakgrpc_proto_msgTypes[650]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46731,7 +46984,7 @@ func (x *CreateSyntaxFlowGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSyntaxFlowGroupRequest.ProtoReflect.Descriptor instead.
 func (*CreateSyntaxFlowGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{647}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{650}
 }
 
 func (x *CreateSyntaxFlowGroupRequest) GetGroupName() string {
@@ -46751,7 +47004,7 @@ type UpdateSyntaxFlowRuleGroupRequest struct {
 
 func (x *UpdateSyntaxFlowRuleGroupRequest) Reset() {
 	*x = UpdateSyntaxFlowRuleGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[648]
+	mi := &file_yakgrpc_proto_msgTypes[651]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46763,7 +47016,7 @@ func (x *UpdateSyntaxFlowRuleGroupRequest) String() string {
 func (*UpdateSyntaxFlowRuleGroupRequest) ProtoMessage() {}
 
 func (x *UpdateSyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[648]
+	mi := &file_yakgrpc_proto_msgTypes[651]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46776,7 +47029,7 @@ func (x *UpdateSyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSyntaxFlowRuleGroupRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSyntaxFlowRuleGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{648}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{651}
 }
 
 func (x *UpdateSyntaxFlowRuleGroupRequest) GetOldGroupName() string {
@@ -46805,7 +47058,7 @@ type UpdateSyntaxFlowRuleAndGroupRequest struct {
 
 func (x *UpdateSyntaxFlowRuleAndGroupRequest) Reset() {
 	*x = UpdateSyntaxFlowRuleAndGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[649]
+	mi := &file_yakgrpc_proto_msgTypes[652]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46817,7 +47070,7 @@ func (x *UpdateSyntaxFlowRuleAndGroupRequest) String() string {
 func (*UpdateSyntaxFlowRuleAndGroupRequest) ProtoMessage() {}
 
 func (x *UpdateSyntaxFlowRuleAndGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[649]
+	mi := &file_yakgrpc_proto_msgTypes[652]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46830,7 +47083,7 @@ func (x *UpdateSyntaxFlowRuleAndGroupRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateSyntaxFlowRuleAndGroupRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSyntaxFlowRuleAndGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{649}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{652}
 }
 
 func (x *UpdateSyntaxFlowRuleAndGroupRequest) GetFilter() *SyntaxFlowRuleFilter {
@@ -46870,7 +47123,7 @@ type QuerySyntaxFlowSameGroupRequest struct {
 
 func (x *QuerySyntaxFlowSameGroupRequest) Reset() {
 	*x = QuerySyntaxFlowSameGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[650]
+	mi := &file_yakgrpc_proto_msgTypes[653]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46882,7 +47135,7 @@ func (x *QuerySyntaxFlowSameGroupRequest) String() string {
 func (*QuerySyntaxFlowSameGroupRequest) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowSameGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[650]
+	mi := &file_yakgrpc_proto_msgTypes[653]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46895,7 +47148,7 @@ func (x *QuerySyntaxFlowSameGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowSameGroupRequest.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowSameGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{650}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{653}
 }
 
 func (x *QuerySyntaxFlowSameGroupRequest) GetFilter() *SyntaxFlowRuleFilter {
@@ -46914,7 +47167,7 @@ type QuerySyntaxFlowSameGroupResponse struct {
 
 func (x *QuerySyntaxFlowSameGroupResponse) Reset() {
 	*x = QuerySyntaxFlowSameGroupResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[651]
+	mi := &file_yakgrpc_proto_msgTypes[654]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46926,7 +47179,7 @@ func (x *QuerySyntaxFlowSameGroupResponse) String() string {
 func (*QuerySyntaxFlowSameGroupResponse) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowSameGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[651]
+	mi := &file_yakgrpc_proto_msgTypes[654]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46939,7 +47192,7 @@ func (x *QuerySyntaxFlowSameGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowSameGroupResponse.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowSameGroupResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{651}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{654}
 }
 
 func (x *QuerySyntaxFlowSameGroupResponse) GetGroup() []*SyntaxFlowGroup {
@@ -46958,7 +47211,7 @@ type DeleteSyntaxFlowRuleGroupRequest struct {
 
 func (x *DeleteSyntaxFlowRuleGroupRequest) Reset() {
 	*x = DeleteSyntaxFlowRuleGroupRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[652]
+	mi := &file_yakgrpc_proto_msgTypes[655]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46970,7 +47223,7 @@ func (x *DeleteSyntaxFlowRuleGroupRequest) String() string {
 func (*DeleteSyntaxFlowRuleGroupRequest) ProtoMessage() {}
 
 func (x *DeleteSyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[652]
+	mi := &file_yakgrpc_proto_msgTypes[655]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -46983,7 +47236,7 @@ func (x *DeleteSyntaxFlowRuleGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyntaxFlowRuleGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSyntaxFlowRuleGroupRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{652}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{655}
 }
 
 func (x *DeleteSyntaxFlowRuleGroupRequest) GetFilter() *SyntaxFlowRuleGroupFilter {
@@ -47004,7 +47257,7 @@ type SyntaxFlowRuleToOnlineRequest struct {
 
 func (x *SyntaxFlowRuleToOnlineRequest) Reset() {
 	*x = SyntaxFlowRuleToOnlineRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[653]
+	mi := &file_yakgrpc_proto_msgTypes[656]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47016,7 +47269,7 @@ func (x *SyntaxFlowRuleToOnlineRequest) String() string {
 func (*SyntaxFlowRuleToOnlineRequest) ProtoMessage() {}
 
 func (x *SyntaxFlowRuleToOnlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[653]
+	mi := &file_yakgrpc_proto_msgTypes[656]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47029,7 +47282,7 @@ func (x *SyntaxFlowRuleToOnlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowRuleToOnlineRequest.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowRuleToOnlineRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{653}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{656}
 }
 
 func (x *SyntaxFlowRuleToOnlineRequest) GetPagination() *Paging {
@@ -47065,7 +47318,7 @@ type SyntaxFlowRuleOnlineProgress struct {
 
 func (x *SyntaxFlowRuleOnlineProgress) Reset() {
 	*x = SyntaxFlowRuleOnlineProgress{}
-	mi := &file_yakgrpc_proto_msgTypes[654]
+	mi := &file_yakgrpc_proto_msgTypes[657]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47077,7 +47330,7 @@ func (x *SyntaxFlowRuleOnlineProgress) String() string {
 func (*SyntaxFlowRuleOnlineProgress) ProtoMessage() {}
 
 func (x *SyntaxFlowRuleOnlineProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[654]
+	mi := &file_yakgrpc_proto_msgTypes[657]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47090,7 +47343,7 @@ func (x *SyntaxFlowRuleOnlineProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowRuleOnlineProgress.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowRuleOnlineProgress) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{654}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{657}
 }
 
 func (x *SyntaxFlowRuleOnlineProgress) GetProgress() float64 {
@@ -47124,7 +47377,7 @@ type DownloadSyntaxFlowRuleRequest struct {
 
 func (x *DownloadSyntaxFlowRuleRequest) Reset() {
 	*x = DownloadSyntaxFlowRuleRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[655]
+	mi := &file_yakgrpc_proto_msgTypes[658]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47136,7 +47389,7 @@ func (x *DownloadSyntaxFlowRuleRequest) String() string {
 func (*DownloadSyntaxFlowRuleRequest) ProtoMessage() {}
 
 func (x *DownloadSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[655]
+	mi := &file_yakgrpc_proto_msgTypes[658]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47149,7 +47402,7 @@ func (x *DownloadSyntaxFlowRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadSyntaxFlowRuleRequest.ProtoReflect.Descriptor instead.
 func (*DownloadSyntaxFlowRuleRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{655}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{658}
 }
 
 func (x *DownloadSyntaxFlowRuleRequest) GetToken() string {
@@ -47184,7 +47437,7 @@ type SyntaxFlowScanRequest struct {
 
 func (x *SyntaxFlowScanRequest) Reset() {
 	*x = SyntaxFlowScanRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[656]
+	mi := &file_yakgrpc_proto_msgTypes[659]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47196,7 +47449,7 @@ func (x *SyntaxFlowScanRequest) String() string {
 func (*SyntaxFlowScanRequest) ProtoMessage() {}
 
 func (x *SyntaxFlowScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[656]
+	mi := &file_yakgrpc_proto_msgTypes[659]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47209,7 +47462,7 @@ func (x *SyntaxFlowScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowScanRequest.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowScanRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{656}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{659}
 }
 
 func (x *SyntaxFlowScanRequest) GetControlMode() string {
@@ -47264,7 +47517,7 @@ type QuerySyntaxFlowScanTaskRequest struct {
 
 func (x *QuerySyntaxFlowScanTaskRequest) Reset() {
 	*x = QuerySyntaxFlowScanTaskRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[657]
+	mi := &file_yakgrpc_proto_msgTypes[660]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47276,7 +47529,7 @@ func (x *QuerySyntaxFlowScanTaskRequest) String() string {
 func (*QuerySyntaxFlowScanTaskRequest) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowScanTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[657]
+	mi := &file_yakgrpc_proto_msgTypes[660]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47289,7 +47542,7 @@ func (x *QuerySyntaxFlowScanTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowScanTaskRequest.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowScanTaskRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{657}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{660}
 }
 
 func (x *QuerySyntaxFlowScanTaskRequest) GetPagination() *Paging {
@@ -47322,7 +47575,7 @@ type SyntaxFlowScanTaskFilter struct {
 
 func (x *SyntaxFlowScanTaskFilter) Reset() {
 	*x = SyntaxFlowScanTaskFilter{}
-	mi := &file_yakgrpc_proto_msgTypes[658]
+	mi := &file_yakgrpc_proto_msgTypes[661]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47334,7 +47587,7 @@ func (x *SyntaxFlowScanTaskFilter) String() string {
 func (*SyntaxFlowScanTaskFilter) ProtoMessage() {}
 
 func (x *SyntaxFlowScanTaskFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[658]
+	mi := &file_yakgrpc_proto_msgTypes[661]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47347,7 +47600,7 @@ func (x *SyntaxFlowScanTaskFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowScanTaskFilter.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowScanTaskFilter) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{658}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{661}
 }
 
 func (x *SyntaxFlowScanTaskFilter) GetPrograms() []string {
@@ -47417,7 +47670,7 @@ type QuerySyntaxFlowScanTaskResponse struct {
 
 func (x *QuerySyntaxFlowScanTaskResponse) Reset() {
 	*x = QuerySyntaxFlowScanTaskResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[659]
+	mi := &file_yakgrpc_proto_msgTypes[662]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47429,7 +47682,7 @@ func (x *QuerySyntaxFlowScanTaskResponse) String() string {
 func (*QuerySyntaxFlowScanTaskResponse) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowScanTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[659]
+	mi := &file_yakgrpc_proto_msgTypes[662]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47442,7 +47695,7 @@ func (x *QuerySyntaxFlowScanTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowScanTaskResponse.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowScanTaskResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{659}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{662}
 }
 
 func (x *QuerySyntaxFlowScanTaskResponse) GetPagination() *Paging {
@@ -47492,7 +47745,7 @@ type SyntaxFlowScanTask struct {
 
 func (x *SyntaxFlowScanTask) Reset() {
 	*x = SyntaxFlowScanTask{}
-	mi := &file_yakgrpc_proto_msgTypes[660]
+	mi := &file_yakgrpc_proto_msgTypes[663]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47504,7 +47757,7 @@ func (x *SyntaxFlowScanTask) String() string {
 func (*SyntaxFlowScanTask) ProtoMessage() {}
 
 func (x *SyntaxFlowScanTask) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[660]
+	mi := &file_yakgrpc_proto_msgTypes[663]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47517,7 +47770,7 @@ func (x *SyntaxFlowScanTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowScanTask.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowScanTask) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{660}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{663}
 }
 
 func (x *SyntaxFlowScanTask) GetId() uint64 {
@@ -47635,7 +47888,7 @@ type DeleteSyntaxFlowScanTaskRequest struct {
 
 func (x *DeleteSyntaxFlowScanTaskRequest) Reset() {
 	*x = DeleteSyntaxFlowScanTaskRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[661]
+	mi := &file_yakgrpc_proto_msgTypes[664]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47647,7 +47900,7 @@ func (x *DeleteSyntaxFlowScanTaskRequest) String() string {
 func (*DeleteSyntaxFlowScanTaskRequest) ProtoMessage() {}
 
 func (x *DeleteSyntaxFlowScanTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[661]
+	mi := &file_yakgrpc_proto_msgTypes[664]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47660,7 +47913,7 @@ func (x *DeleteSyntaxFlowScanTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyntaxFlowScanTaskRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSyntaxFlowScanTaskRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{661}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{664}
 }
 
 func (x *DeleteSyntaxFlowScanTaskRequest) GetDeleteAll() bool {
@@ -47693,7 +47946,7 @@ type SyntaxFlowScanResponse struct {
 
 func (x *SyntaxFlowScanResponse) Reset() {
 	*x = SyntaxFlowScanResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[662]
+	mi := &file_yakgrpc_proto_msgTypes[665]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47705,7 +47958,7 @@ func (x *SyntaxFlowScanResponse) String() string {
 func (*SyntaxFlowScanResponse) ProtoMessage() {}
 
 func (x *SyntaxFlowScanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[662]
+	mi := &file_yakgrpc_proto_msgTypes[665]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47718,7 +47971,7 @@ func (x *SyntaxFlowScanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowScanResponse.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowScanResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{662}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{665}
 }
 
 func (x *SyntaxFlowScanResponse) GetTaskID() string {
@@ -47781,7 +48034,7 @@ type SyntaxFlowResultFilter struct {
 
 func (x *SyntaxFlowResultFilter) Reset() {
 	*x = SyntaxFlowResultFilter{}
-	mi := &file_yakgrpc_proto_msgTypes[663]
+	mi := &file_yakgrpc_proto_msgTypes[666]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47793,7 +48046,7 @@ func (x *SyntaxFlowResultFilter) String() string {
 func (*SyntaxFlowResultFilter) ProtoMessage() {}
 
 func (x *SyntaxFlowResultFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[663]
+	mi := &file_yakgrpc_proto_msgTypes[666]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47806,7 +48059,7 @@ func (x *SyntaxFlowResultFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowResultFilter.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowResultFilter) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{663}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{666}
 }
 
 func (x *SyntaxFlowResultFilter) GetTaskIDs() []string {
@@ -47889,7 +48142,7 @@ type QuerySyntaxFlowResultRequest struct {
 
 func (x *QuerySyntaxFlowResultRequest) Reset() {
 	*x = QuerySyntaxFlowResultRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[664]
+	mi := &file_yakgrpc_proto_msgTypes[667]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47901,7 +48154,7 @@ func (x *QuerySyntaxFlowResultRequest) String() string {
 func (*QuerySyntaxFlowResultRequest) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[664]
+	mi := &file_yakgrpc_proto_msgTypes[667]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47914,7 +48167,7 @@ func (x *QuerySyntaxFlowResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowResultRequest.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowResultRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{664}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{667}
 }
 
 func (x *QuerySyntaxFlowResultRequest) GetPagination() *Paging {
@@ -47943,7 +48196,7 @@ type QuerySyntaxFlowResultResponse struct {
 
 func (x *QuerySyntaxFlowResultResponse) Reset() {
 	*x = QuerySyntaxFlowResultResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[665]
+	mi := &file_yakgrpc_proto_msgTypes[668]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47955,7 +48208,7 @@ func (x *QuerySyntaxFlowResultResponse) String() string {
 func (*QuerySyntaxFlowResultResponse) ProtoMessage() {}
 
 func (x *QuerySyntaxFlowResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[665]
+	mi := &file_yakgrpc_proto_msgTypes[668]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -47968,7 +48221,7 @@ func (x *QuerySyntaxFlowResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySyntaxFlowResultResponse.ProtoReflect.Descriptor instead.
 func (*QuerySyntaxFlowResultResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{665}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{668}
 }
 
 func (x *QuerySyntaxFlowResultResponse) GetPagination() *Paging {
@@ -48024,7 +48277,7 @@ type SyntaxFlowResult struct {
 
 func (x *SyntaxFlowResult) Reset() {
 	*x = SyntaxFlowResult{}
-	mi := &file_yakgrpc_proto_msgTypes[666]
+	mi := &file_yakgrpc_proto_msgTypes[669]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48036,7 +48289,7 @@ func (x *SyntaxFlowResult) String() string {
 func (*SyntaxFlowResult) ProtoMessage() {}
 
 func (x *SyntaxFlowResult) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[666]
+	mi := &file_yakgrpc_proto_msgTypes[669]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48049,7 +48302,7 @@ func (x *SyntaxFlowResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxFlowResult.ProtoReflect.Descriptor instead.
 func (*SyntaxFlowResult) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{666}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{669}
 }
 
 func (x *SyntaxFlowResult) GetResultID() uint64 {
@@ -48154,7 +48407,7 @@ type DeleteSyntaxFlowResultRequest struct {
 
 func (x *DeleteSyntaxFlowResultRequest) Reset() {
 	*x = DeleteSyntaxFlowResultRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[667]
+	mi := &file_yakgrpc_proto_msgTypes[670]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48166,7 +48419,7 @@ func (x *DeleteSyntaxFlowResultRequest) String() string {
 func (*DeleteSyntaxFlowResultRequest) ProtoMessage() {}
 
 func (x *DeleteSyntaxFlowResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[667]
+	mi := &file_yakgrpc_proto_msgTypes[670]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48179,7 +48432,7 @@ func (x *DeleteSyntaxFlowResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyntaxFlowResultRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSyntaxFlowResultRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{667}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{670}
 }
 
 func (x *DeleteSyntaxFlowResultRequest) GetDeleteContainRisk() bool {
@@ -48212,7 +48465,7 @@ type DeleteSyntaxFlowResultResponse struct {
 
 func (x *DeleteSyntaxFlowResultResponse) Reset() {
 	*x = DeleteSyntaxFlowResultResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[668]
+	mi := &file_yakgrpc_proto_msgTypes[671]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48224,7 +48477,7 @@ func (x *DeleteSyntaxFlowResultResponse) String() string {
 func (*DeleteSyntaxFlowResultResponse) ProtoMessage() {}
 
 func (x *DeleteSyntaxFlowResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[668]
+	mi := &file_yakgrpc_proto_msgTypes[671]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48237,7 +48490,7 @@ func (x *DeleteSyntaxFlowResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSyntaxFlowResultResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSyntaxFlowResultResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{668}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{671}
 }
 
 func (x *DeleteSyntaxFlowResultResponse) GetMessage() *DbOperateMessage {
@@ -48256,7 +48509,7 @@ type QueryPluginEnvRequest struct {
 
 func (x *QueryPluginEnvRequest) Reset() {
 	*x = QueryPluginEnvRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[669]
+	mi := &file_yakgrpc_proto_msgTypes[672]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48268,7 +48521,7 @@ func (x *QueryPluginEnvRequest) String() string {
 func (*QueryPluginEnvRequest) ProtoMessage() {}
 
 func (x *QueryPluginEnvRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[669]
+	mi := &file_yakgrpc_proto_msgTypes[672]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48281,7 +48534,7 @@ func (x *QueryPluginEnvRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryPluginEnvRequest.ProtoReflect.Descriptor instead.
 func (*QueryPluginEnvRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{669}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{672}
 }
 
 func (x *QueryPluginEnvRequest) GetKey() []string {
@@ -48300,7 +48553,7 @@ type PluginEnvData struct {
 
 func (x *PluginEnvData) Reset() {
 	*x = PluginEnvData{}
-	mi := &file_yakgrpc_proto_msgTypes[670]
+	mi := &file_yakgrpc_proto_msgTypes[673]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48312,7 +48565,7 @@ func (x *PluginEnvData) String() string {
 func (*PluginEnvData) ProtoMessage() {}
 
 func (x *PluginEnvData) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[670]
+	mi := &file_yakgrpc_proto_msgTypes[673]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48325,7 +48578,7 @@ func (x *PluginEnvData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginEnvData.ProtoReflect.Descriptor instead.
 func (*PluginEnvData) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{670}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{673}
 }
 
 func (x *PluginEnvData) GetEnv() []*KVPair {
@@ -48345,7 +48598,7 @@ type DeletePluginEnvRequest struct {
 
 func (x *DeletePluginEnvRequest) Reset() {
 	*x = DeletePluginEnvRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[671]
+	mi := &file_yakgrpc_proto_msgTypes[674]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48357,7 +48610,7 @@ func (x *DeletePluginEnvRequest) String() string {
 func (*DeletePluginEnvRequest) ProtoMessage() {}
 
 func (x *DeletePluginEnvRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[671]
+	mi := &file_yakgrpc_proto_msgTypes[674]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48370,7 +48623,7 @@ func (x *DeletePluginEnvRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePluginEnvRequest.ProtoReflect.Descriptor instead.
 func (*DeletePluginEnvRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{671}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{674}
 }
 
 func (x *DeletePluginEnvRequest) GetKey() string {
@@ -48396,7 +48649,7 @@ type GetAllFuzztagInfoRequest struct {
 
 func (x *GetAllFuzztagInfoRequest) Reset() {
 	*x = GetAllFuzztagInfoRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[672]
+	mi := &file_yakgrpc_proto_msgTypes[675]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48408,7 +48661,7 @@ func (x *GetAllFuzztagInfoRequest) String() string {
 func (*GetAllFuzztagInfoRequest) ProtoMessage() {}
 
 func (x *GetAllFuzztagInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[672]
+	mi := &file_yakgrpc_proto_msgTypes[675]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48421,7 +48674,7 @@ func (x *GetAllFuzztagInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFuzztagInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetAllFuzztagInfoRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{672}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{675}
 }
 
 func (x *GetAllFuzztagInfoRequest) GetKey() string {
@@ -48440,7 +48693,7 @@ type GetAllFuzztagInfoResponse struct {
 
 func (x *GetAllFuzztagInfoResponse) Reset() {
 	*x = GetAllFuzztagInfoResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[673]
+	mi := &file_yakgrpc_proto_msgTypes[676]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48452,7 +48705,7 @@ func (x *GetAllFuzztagInfoResponse) String() string {
 func (*GetAllFuzztagInfoResponse) ProtoMessage() {}
 
 func (x *GetAllFuzztagInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[673]
+	mi := &file_yakgrpc_proto_msgTypes[676]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48465,7 +48718,7 @@ func (x *GetAllFuzztagInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFuzztagInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetAllFuzztagInfoResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{673}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{676}
 }
 
 func (x *GetAllFuzztagInfoResponse) GetData() []*FuzztagInfo {
@@ -48489,7 +48742,7 @@ type FuzztagArgumentType struct {
 
 func (x *FuzztagArgumentType) Reset() {
 	*x = FuzztagArgumentType{}
-	mi := &file_yakgrpc_proto_msgTypes[674]
+	mi := &file_yakgrpc_proto_msgTypes[677]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48501,7 +48754,7 @@ func (x *FuzztagArgumentType) String() string {
 func (*FuzztagArgumentType) ProtoMessage() {}
 
 func (x *FuzztagArgumentType) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[674]
+	mi := &file_yakgrpc_proto_msgTypes[677]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48514,7 +48767,7 @@ func (x *FuzztagArgumentType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuzztagArgumentType.ProtoReflect.Descriptor instead.
 func (*FuzztagArgumentType) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{674}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{677}
 }
 
 func (x *FuzztagArgumentType) GetName() string {
@@ -48572,7 +48825,7 @@ type FuzztagInfo struct {
 
 func (x *FuzztagInfo) Reset() {
 	*x = FuzztagInfo{}
-	mi := &file_yakgrpc_proto_msgTypes[675]
+	mi := &file_yakgrpc_proto_msgTypes[678]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48584,7 +48837,7 @@ func (x *FuzztagInfo) String() string {
 func (*FuzztagInfo) ProtoMessage() {}
 
 func (x *FuzztagInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[675]
+	mi := &file_yakgrpc_proto_msgTypes[678]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48597,7 +48850,7 @@ func (x *FuzztagInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuzztagInfo.ProtoReflect.Descriptor instead.
 func (*FuzztagInfo) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{675}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{678}
 }
 
 func (x *FuzztagInfo) GetName() string {
@@ -48646,7 +48899,7 @@ type GenerateFuzztagRequest struct {
 
 func (x *GenerateFuzztagRequest) Reset() {
 	*x = GenerateFuzztagRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[676]
+	mi := &file_yakgrpc_proto_msgTypes[679]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48658,7 +48911,7 @@ func (x *GenerateFuzztagRequest) String() string {
 func (*GenerateFuzztagRequest) ProtoMessage() {}
 
 func (x *GenerateFuzztagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[676]
+	mi := &file_yakgrpc_proto_msgTypes[679]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48671,7 +48924,7 @@ func (x *GenerateFuzztagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateFuzztagRequest.ProtoReflect.Descriptor instead.
 func (*GenerateFuzztagRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{676}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{679}
 }
 
 func (x *GenerateFuzztagRequest) GetName() string {
@@ -48705,7 +48958,7 @@ type GenerateFuzztagResponse struct {
 
 func (x *GenerateFuzztagResponse) Reset() {
 	*x = GenerateFuzztagResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[677]
+	mi := &file_yakgrpc_proto_msgTypes[680]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48717,7 +48970,7 @@ func (x *GenerateFuzztagResponse) String() string {
 func (*GenerateFuzztagResponse) ProtoMessage() {}
 
 func (x *GenerateFuzztagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[677]
+	mi := &file_yakgrpc_proto_msgTypes[680]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48730,7 +48983,7 @@ func (x *GenerateFuzztagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateFuzztagResponse.ProtoReflect.Descriptor instead.
 func (*GenerateFuzztagResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{677}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{680}
 }
 
 func (x *GenerateFuzztagResponse) GetStatus() *GeneralResponse {
@@ -48758,7 +49011,7 @@ type FuzzTagSuggestionRequest struct {
 
 func (x *FuzzTagSuggestionRequest) Reset() {
 	*x = FuzzTagSuggestionRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[678]
+	mi := &file_yakgrpc_proto_msgTypes[681]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48770,7 +49023,7 @@ func (x *FuzzTagSuggestionRequest) String() string {
 func (*FuzzTagSuggestionRequest) ProtoMessage() {}
 
 func (x *FuzzTagSuggestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[678]
+	mi := &file_yakgrpc_proto_msgTypes[681]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48783,7 +49036,7 @@ func (x *FuzzTagSuggestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuzzTagSuggestionRequest.ProtoReflect.Descriptor instead.
 func (*FuzzTagSuggestionRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{678}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{681}
 }
 
 func (x *FuzzTagSuggestionRequest) GetHotPatchCode() string {
@@ -48845,7 +49098,7 @@ type SSARisk struct {
 
 func (x *SSARisk) Reset() {
 	*x = SSARisk{}
-	mi := &file_yakgrpc_proto_msgTypes[679]
+	mi := &file_yakgrpc_proto_msgTypes[682]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48857,7 +49110,7 @@ func (x *SSARisk) String() string {
 func (*SSARisk) ProtoMessage() {}
 
 func (x *SSARisk) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[679]
+	mi := &file_yakgrpc_proto_msgTypes[682]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -48870,7 +49123,7 @@ func (x *SSARisk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSARisk.ProtoReflect.Descriptor instead.
 func (*SSARisk) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{679}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{682}
 }
 
 func (x *SSARisk) GetId() int64 {
@@ -49100,7 +49353,7 @@ type SSARisksFilter struct {
 
 func (x *SSARisksFilter) Reset() {
 	*x = SSARisksFilter{}
-	mi := &file_yakgrpc_proto_msgTypes[680]
+	mi := &file_yakgrpc_proto_msgTypes[683]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49112,7 +49365,7 @@ func (x *SSARisksFilter) String() string {
 func (*SSARisksFilter) ProtoMessage() {}
 
 func (x *SSARisksFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[680]
+	mi := &file_yakgrpc_proto_msgTypes[683]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49125,7 +49378,7 @@ func (x *SSARisksFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSARisksFilter.ProtoReflect.Descriptor instead.
 func (*SSARisksFilter) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{680}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{683}
 }
 
 func (x *SSARisksFilter) GetID() []int64 {
@@ -49250,7 +49503,7 @@ type QuerySSARisksRequest struct {
 
 func (x *QuerySSARisksRequest) Reset() {
 	*x = QuerySSARisksRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[681]
+	mi := &file_yakgrpc_proto_msgTypes[684]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49262,7 +49515,7 @@ func (x *QuerySSARisksRequest) String() string {
 func (*QuerySSARisksRequest) ProtoMessage() {}
 
 func (x *QuerySSARisksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[681]
+	mi := &file_yakgrpc_proto_msgTypes[684]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49275,7 +49528,7 @@ func (x *QuerySSARisksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySSARisksRequest.ProtoReflect.Descriptor instead.
 func (*QuerySSARisksRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{681}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{684}
 }
 
 func (x *QuerySSARisksRequest) GetPagination() *Paging {
@@ -49303,7 +49556,7 @@ type QuerySSARisksResponse struct {
 
 func (x *QuerySSARisksResponse) Reset() {
 	*x = QuerySSARisksResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[682]
+	mi := &file_yakgrpc_proto_msgTypes[685]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49315,7 +49568,7 @@ func (x *QuerySSARisksResponse) String() string {
 func (*QuerySSARisksResponse) ProtoMessage() {}
 
 func (x *QuerySSARisksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[682]
+	mi := &file_yakgrpc_proto_msgTypes[685]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49328,7 +49581,7 @@ func (x *QuerySSARisksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySSARisksResponse.ProtoReflect.Descriptor instead.
 func (*QuerySSARisksResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{682}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{685}
 }
 
 func (x *QuerySSARisksResponse) GetPagination() *Paging {
@@ -49361,7 +49614,7 @@ type QueryNewSSARisksRequest struct {
 
 func (x *QueryNewSSARisksRequest) Reset() {
 	*x = QueryNewSSARisksRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[683]
+	mi := &file_yakgrpc_proto_msgTypes[686]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49373,7 +49626,7 @@ func (x *QueryNewSSARisksRequest) String() string {
 func (*QueryNewSSARisksRequest) ProtoMessage() {}
 
 func (x *QueryNewSSARisksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[683]
+	mi := &file_yakgrpc_proto_msgTypes[686]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49386,7 +49639,7 @@ func (x *QueryNewSSARisksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNewSSARisksRequest.ProtoReflect.Descriptor instead.
 func (*QueryNewSSARisksRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{683}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{686}
 }
 
 func (x *QueryNewSSARisksRequest) GetAfterID() int64 {
@@ -49408,7 +49661,7 @@ type QueryNewSSARisksResponse struct {
 
 func (x *QueryNewSSARisksResponse) Reset() {
 	*x = QueryNewSSARisksResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[684]
+	mi := &file_yakgrpc_proto_msgTypes[687]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49420,7 +49673,7 @@ func (x *QueryNewSSARisksResponse) String() string {
 func (*QueryNewSSARisksResponse) ProtoMessage() {}
 
 func (x *QueryNewSSARisksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[684]
+	mi := &file_yakgrpc_proto_msgTypes[687]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49433,7 +49686,7 @@ func (x *QueryNewSSARisksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNewSSARisksResponse.ProtoReflect.Descriptor instead.
 func (*QueryNewSSARisksResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{684}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{687}
 }
 
 func (x *QueryNewSSARisksResponse) GetData() []*SSARisk {
@@ -49473,7 +49726,7 @@ type DeleteSSARisksRequest struct {
 
 func (x *DeleteSSARisksRequest) Reset() {
 	*x = DeleteSSARisksRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[685]
+	mi := &file_yakgrpc_proto_msgTypes[688]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49485,7 +49738,7 @@ func (x *DeleteSSARisksRequest) String() string {
 func (*DeleteSSARisksRequest) ProtoMessage() {}
 
 func (x *DeleteSSARisksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[685]
+	mi := &file_yakgrpc_proto_msgTypes[688]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49498,7 +49751,7 @@ func (x *DeleteSSARisksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSSARisksRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSSARisksRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{685}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{688}
 }
 
 func (x *DeleteSSARisksRequest) GetFilter() *SSARisksFilter {
@@ -49518,7 +49771,7 @@ type UpdateSSARiskTagsRequest struct {
 
 func (x *UpdateSSARiskTagsRequest) Reset() {
 	*x = UpdateSSARiskTagsRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[686]
+	mi := &file_yakgrpc_proto_msgTypes[689]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49530,7 +49783,7 @@ func (x *UpdateSSARiskTagsRequest) String() string {
 func (*UpdateSSARiskTagsRequest) ProtoMessage() {}
 
 func (x *UpdateSSARiskTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[686]
+	mi := &file_yakgrpc_proto_msgTypes[689]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49543,7 +49796,7 @@ func (x *UpdateSSARiskTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSSARiskTagsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSSARiskTagsRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{686}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{689}
 }
 
 func (x *UpdateSSARiskTagsRequest) GetID() int64 {
@@ -49571,7 +49824,7 @@ type SSARiskFieldGroupResponse struct {
 
 func (x *SSARiskFieldGroupResponse) Reset() {
 	*x = SSARiskFieldGroupResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[687]
+	mi := &file_yakgrpc_proto_msgTypes[690]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49583,7 +49836,7 @@ func (x *SSARiskFieldGroupResponse) String() string {
 func (*SSARiskFieldGroupResponse) ProtoMessage() {}
 
 func (x *SSARiskFieldGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[687]
+	mi := &file_yakgrpc_proto_msgTypes[690]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49596,7 +49849,7 @@ func (x *SSARiskFieldGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSARiskFieldGroupResponse.ProtoReflect.Descriptor instead.
 func (*SSARiskFieldGroupResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{687}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{690}
 }
 
 func (x *SSARiskFieldGroupResponse) GetFileField() []*FieldGroup {
@@ -49629,7 +49882,7 @@ type NewSSARiskReadRequest struct {
 
 func (x *NewSSARiskReadRequest) Reset() {
 	*x = NewSSARiskReadRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[688]
+	mi := &file_yakgrpc_proto_msgTypes[691]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49641,7 +49894,7 @@ func (x *NewSSARiskReadRequest) String() string {
 func (*NewSSARiskReadRequest) ProtoMessage() {}
 
 func (x *NewSSARiskReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[688]
+	mi := &file_yakgrpc_proto_msgTypes[691]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49654,7 +49907,7 @@ func (x *NewSSARiskReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewSSARiskReadRequest.ProtoReflect.Descriptor instead.
 func (*NewSSARiskReadRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{688}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{691}
 }
 
 func (x *NewSSARiskReadRequest) GetFilter() *SSARisksFilter {
@@ -49672,7 +49925,7 @@ type NewSSARiskReadResponse struct {
 
 func (x *NewSSARiskReadResponse) Reset() {
 	*x = NewSSARiskReadResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[689]
+	mi := &file_yakgrpc_proto_msgTypes[692]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49684,7 +49937,7 @@ func (x *NewSSARiskReadResponse) String() string {
 func (*NewSSARiskReadResponse) ProtoMessage() {}
 
 func (x *NewSSARiskReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[689]
+	mi := &file_yakgrpc_proto_msgTypes[692]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49697,7 +49950,7 @@ func (x *NewSSARiskReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewSSARiskReadResponse.ProtoReflect.Descriptor instead.
 func (*NewSSARiskReadResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{689}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{692}
 }
 
 type SSARiskFeedbackToOnlineRequest struct {
@@ -49710,7 +49963,7 @@ type SSARiskFeedbackToOnlineRequest struct {
 
 func (x *SSARiskFeedbackToOnlineRequest) Reset() {
 	*x = SSARiskFeedbackToOnlineRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[690]
+	mi := &file_yakgrpc_proto_msgTypes[693]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49722,7 +49975,7 @@ func (x *SSARiskFeedbackToOnlineRequest) String() string {
 func (*SSARiskFeedbackToOnlineRequest) ProtoMessage() {}
 
 func (x *SSARiskFeedbackToOnlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[690]
+	mi := &file_yakgrpc_proto_msgTypes[693]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49735,7 +49988,7 @@ func (x *SSARiskFeedbackToOnlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSARiskFeedbackToOnlineRequest.ProtoReflect.Descriptor instead.
 func (*SSARiskFeedbackToOnlineRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{690}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{693}
 }
 
 func (x *SSARiskFeedbackToOnlineRequest) GetToken() string {
@@ -49763,7 +50016,7 @@ type ExportSyntaxFlowsRequest struct {
 
 func (x *ExportSyntaxFlowsRequest) Reset() {
 	*x = ExportSyntaxFlowsRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[691]
+	mi := &file_yakgrpc_proto_msgTypes[694]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49775,7 +50028,7 @@ func (x *ExportSyntaxFlowsRequest) String() string {
 func (*ExportSyntaxFlowsRequest) ProtoMessage() {}
 
 func (x *ExportSyntaxFlowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[691]
+	mi := &file_yakgrpc_proto_msgTypes[694]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49788,7 +50041,7 @@ func (x *ExportSyntaxFlowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportSyntaxFlowsRequest.ProtoReflect.Descriptor instead.
 func (*ExportSyntaxFlowsRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{691}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{694}
 }
 
 func (x *ExportSyntaxFlowsRequest) GetFilter() *SyntaxFlowRuleFilter {
@@ -49822,7 +50075,7 @@ type ImportSyntaxFlowsRequest struct {
 
 func (x *ImportSyntaxFlowsRequest) Reset() {
 	*x = ImportSyntaxFlowsRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[692]
+	mi := &file_yakgrpc_proto_msgTypes[695]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49834,7 +50087,7 @@ func (x *ImportSyntaxFlowsRequest) String() string {
 func (*ImportSyntaxFlowsRequest) ProtoMessage() {}
 
 func (x *ImportSyntaxFlowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[692]
+	mi := &file_yakgrpc_proto_msgTypes[695]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49847,7 +50100,7 @@ func (x *ImportSyntaxFlowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportSyntaxFlowsRequest.ProtoReflect.Descriptor instead.
 func (*ImportSyntaxFlowsRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{692}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{695}
 }
 
 func (x *ImportSyntaxFlowsRequest) GetInputPath() string {
@@ -49876,7 +50129,7 @@ type SyntaxflowsProgress struct {
 
 func (x *SyntaxflowsProgress) Reset() {
 	*x = SyntaxflowsProgress{}
-	mi := &file_yakgrpc_proto_msgTypes[693]
+	mi := &file_yakgrpc_proto_msgTypes[696]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49888,7 +50141,7 @@ func (x *SyntaxflowsProgress) String() string {
 func (*SyntaxflowsProgress) ProtoMessage() {}
 
 func (x *SyntaxflowsProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[693]
+	mi := &file_yakgrpc_proto_msgTypes[696]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49901,7 +50154,7 @@ func (x *SyntaxflowsProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyntaxflowsProgress.ProtoReflect.Descriptor instead.
 func (*SyntaxflowsProgress) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{693}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{696}
 }
 
 func (x *SyntaxflowsProgress) GetProgress() float64 {
@@ -49930,7 +50183,7 @@ type HotPatchTemplate struct {
 
 func (x *HotPatchTemplate) Reset() {
 	*x = HotPatchTemplate{}
-	mi := &file_yakgrpc_proto_msgTypes[694]
+	mi := &file_yakgrpc_proto_msgTypes[697]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49942,7 +50195,7 @@ func (x *HotPatchTemplate) String() string {
 func (*HotPatchTemplate) ProtoMessage() {}
 
 func (x *HotPatchTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[694]
+	mi := &file_yakgrpc_proto_msgTypes[697]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49955,7 +50208,7 @@ func (x *HotPatchTemplate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotPatchTemplate.ProtoReflect.Descriptor instead.
 func (*HotPatchTemplate) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{694}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{697}
 }
 
 func (x *HotPatchTemplate) GetName() string {
@@ -49991,7 +50244,7 @@ type HotPatchTemplateRequest struct {
 
 func (x *HotPatchTemplateRequest) Reset() {
 	*x = HotPatchTemplateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[695]
+	mi := &file_yakgrpc_proto_msgTypes[698]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50003,7 +50256,7 @@ func (x *HotPatchTemplateRequest) String() string {
 func (*HotPatchTemplateRequest) ProtoMessage() {}
 
 func (x *HotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[695]
+	mi := &file_yakgrpc_proto_msgTypes[698]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50016,7 +50269,7 @@ func (x *HotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotPatchTemplateRequest.ProtoReflect.Descriptor instead.
 func (*HotPatchTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{695}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{698}
 }
 
 func (x *HotPatchTemplateRequest) GetId() []int64 {
@@ -50057,7 +50310,7 @@ type UpdateHotPatchTemplateRequest struct {
 
 func (x *UpdateHotPatchTemplateRequest) Reset() {
 	*x = UpdateHotPatchTemplateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[696]
+	mi := &file_yakgrpc_proto_msgTypes[699]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50069,7 +50322,7 @@ func (x *UpdateHotPatchTemplateRequest) String() string {
 func (*UpdateHotPatchTemplateRequest) ProtoMessage() {}
 
 func (x *UpdateHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[696]
+	mi := &file_yakgrpc_proto_msgTypes[699]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50082,7 +50335,7 @@ func (x *UpdateHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHotPatchTemplateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHotPatchTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{696}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{699}
 }
 
 func (x *UpdateHotPatchTemplateRequest) GetCondition() *HotPatchTemplateRequest {
@@ -50109,7 +50362,7 @@ type DeleteHotPatchTemplateRequest struct {
 
 func (x *DeleteHotPatchTemplateRequest) Reset() {
 	*x = DeleteHotPatchTemplateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[697]
+	mi := &file_yakgrpc_proto_msgTypes[700]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50121,7 +50374,7 @@ func (x *DeleteHotPatchTemplateRequest) String() string {
 func (*DeleteHotPatchTemplateRequest) ProtoMessage() {}
 
 func (x *DeleteHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[697]
+	mi := &file_yakgrpc_proto_msgTypes[700]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50134,7 +50387,7 @@ func (x *DeleteHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHotPatchTemplateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteHotPatchTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{697}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{700}
 }
 
 func (x *DeleteHotPatchTemplateRequest) GetCondition() *HotPatchTemplateRequest {
@@ -50160,7 +50413,7 @@ type CreateHotPatchTemplateResponse struct {
 
 func (x *CreateHotPatchTemplateResponse) Reset() {
 	*x = CreateHotPatchTemplateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[698]
+	mi := &file_yakgrpc_proto_msgTypes[701]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50172,7 +50425,7 @@ func (x *CreateHotPatchTemplateResponse) String() string {
 func (*CreateHotPatchTemplateResponse) ProtoMessage() {}
 
 func (x *CreateHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[698]
+	mi := &file_yakgrpc_proto_msgTypes[701]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50185,7 +50438,7 @@ func (x *CreateHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHotPatchTemplateResponse.ProtoReflect.Descriptor instead.
 func (*CreateHotPatchTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{698}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{701}
 }
 
 func (x *CreateHotPatchTemplateResponse) GetMessage() *DbOperateMessage {
@@ -50204,7 +50457,7 @@ type DeleteHotPatchTemplateResponse struct {
 
 func (x *DeleteHotPatchTemplateResponse) Reset() {
 	*x = DeleteHotPatchTemplateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[699]
+	mi := &file_yakgrpc_proto_msgTypes[702]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50216,7 +50469,7 @@ func (x *DeleteHotPatchTemplateResponse) String() string {
 func (*DeleteHotPatchTemplateResponse) ProtoMessage() {}
 
 func (x *DeleteHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[699]
+	mi := &file_yakgrpc_proto_msgTypes[702]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50229,7 +50482,7 @@ func (x *DeleteHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHotPatchTemplateResponse.ProtoReflect.Descriptor instead.
 func (*DeleteHotPatchTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{699}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{702}
 }
 
 func (x *DeleteHotPatchTemplateResponse) GetMessage() *DbOperateMessage {
@@ -50248,7 +50501,7 @@ type UpdateHotPatchTemplateResponse struct {
 
 func (x *UpdateHotPatchTemplateResponse) Reset() {
 	*x = UpdateHotPatchTemplateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[700]
+	mi := &file_yakgrpc_proto_msgTypes[703]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50260,7 +50513,7 @@ func (x *UpdateHotPatchTemplateResponse) String() string {
 func (*UpdateHotPatchTemplateResponse) ProtoMessage() {}
 
 func (x *UpdateHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[700]
+	mi := &file_yakgrpc_proto_msgTypes[703]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50273,7 +50526,7 @@ func (x *UpdateHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHotPatchTemplateResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHotPatchTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{700}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{703}
 }
 
 func (x *UpdateHotPatchTemplateResponse) GetMessage() *DbOperateMessage {
@@ -50293,7 +50546,7 @@ type QueryHotPatchTemplateResponse struct {
 
 func (x *QueryHotPatchTemplateResponse) Reset() {
 	*x = QueryHotPatchTemplateResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[701]
+	mi := &file_yakgrpc_proto_msgTypes[704]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50305,7 +50558,7 @@ func (x *QueryHotPatchTemplateResponse) String() string {
 func (*QueryHotPatchTemplateResponse) ProtoMessage() {}
 
 func (x *QueryHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[701]
+	mi := &file_yakgrpc_proto_msgTypes[704]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50318,7 +50571,7 @@ func (x *QueryHotPatchTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHotPatchTemplateResponse.ProtoReflect.Descriptor instead.
 func (*QueryHotPatchTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{701}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{704}
 }
 
 func (x *QueryHotPatchTemplateResponse) GetMessage() *DbOperateMessage {
@@ -50344,7 +50597,7 @@ type QueryHotPatchTemplateListRequest struct {
 
 func (x *QueryHotPatchTemplateListRequest) Reset() {
 	*x = QueryHotPatchTemplateListRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[702]
+	mi := &file_yakgrpc_proto_msgTypes[705]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50356,7 +50609,7 @@ func (x *QueryHotPatchTemplateListRequest) String() string {
 func (*QueryHotPatchTemplateListRequest) ProtoMessage() {}
 
 func (x *QueryHotPatchTemplateListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[702]
+	mi := &file_yakgrpc_proto_msgTypes[705]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50369,7 +50622,7 @@ func (x *QueryHotPatchTemplateListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHotPatchTemplateListRequest.ProtoReflect.Descriptor instead.
 func (*QueryHotPatchTemplateListRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{702}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{705}
 }
 
 func (x *QueryHotPatchTemplateListRequest) GetType() string {
@@ -50390,7 +50643,7 @@ type QueryHotPatchTemplateListResponse struct {
 
 func (x *QueryHotPatchTemplateListResponse) Reset() {
 	*x = QueryHotPatchTemplateListResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[703]
+	mi := &file_yakgrpc_proto_msgTypes[706]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50402,7 +50655,7 @@ func (x *QueryHotPatchTemplateListResponse) String() string {
 func (*QueryHotPatchTemplateListResponse) ProtoMessage() {}
 
 func (x *QueryHotPatchTemplateListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[703]
+	mi := &file_yakgrpc_proto_msgTypes[706]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50415,7 +50668,7 @@ func (x *QueryHotPatchTemplateListResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryHotPatchTemplateListResponse.ProtoReflect.Descriptor instead.
 func (*QueryHotPatchTemplateListResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{703}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{706}
 }
 
 func (x *QueryHotPatchTemplateListResponse) GetPagination() *Paging {
@@ -50450,7 +50703,7 @@ type GroupTableColumnRequest struct {
 
 func (x *GroupTableColumnRequest) Reset() {
 	*x = GroupTableColumnRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[704]
+	mi := &file_yakgrpc_proto_msgTypes[707]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50462,7 +50715,7 @@ func (x *GroupTableColumnRequest) String() string {
 func (*GroupTableColumnRequest) ProtoMessage() {}
 
 func (x *GroupTableColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[704]
+	mi := &file_yakgrpc_proto_msgTypes[707]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50475,7 +50728,7 @@ func (x *GroupTableColumnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupTableColumnRequest.ProtoReflect.Descriptor instead.
 func (*GroupTableColumnRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{704}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{707}
 }
 
 func (x *GroupTableColumnRequest) GetDatabaseName() string {
@@ -50508,7 +50761,7 @@ type GroupTableColumnResponse struct {
 
 func (x *GroupTableColumnResponse) Reset() {
 	*x = GroupTableColumnResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[705]
+	mi := &file_yakgrpc_proto_msgTypes[708]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50520,7 +50773,7 @@ func (x *GroupTableColumnResponse) String() string {
 func (*GroupTableColumnResponse) ProtoMessage() {}
 
 func (x *GroupTableColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[705]
+	mi := &file_yakgrpc_proto_msgTypes[708]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50533,7 +50786,7 @@ func (x *GroupTableColumnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupTableColumnResponse.ProtoReflect.Descriptor instead.
 func (*GroupTableColumnResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{705}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{708}
 }
 
 func (x *GroupTableColumnResponse) GetData() []string {
@@ -50554,7 +50807,7 @@ type UploadHotPatchTemplateToOnlineRequest struct {
 
 func (x *UploadHotPatchTemplateToOnlineRequest) Reset() {
 	*x = UploadHotPatchTemplateToOnlineRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[706]
+	mi := &file_yakgrpc_proto_msgTypes[709]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50566,7 +50819,7 @@ func (x *UploadHotPatchTemplateToOnlineRequest) String() string {
 func (*UploadHotPatchTemplateToOnlineRequest) ProtoMessage() {}
 
 func (x *UploadHotPatchTemplateToOnlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[706]
+	mi := &file_yakgrpc_proto_msgTypes[709]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50579,7 +50832,7 @@ func (x *UploadHotPatchTemplateToOnlineRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UploadHotPatchTemplateToOnlineRequest.ProtoReflect.Descriptor instead.
 func (*UploadHotPatchTemplateToOnlineRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{706}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{709}
 }
 
 func (x *UploadHotPatchTemplateToOnlineRequest) GetToken() string {
@@ -50613,7 +50866,7 @@ type DownloadHotPatchTemplateRequest struct {
 
 func (x *DownloadHotPatchTemplateRequest) Reset() {
 	*x = DownloadHotPatchTemplateRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[707]
+	mi := &file_yakgrpc_proto_msgTypes[710]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50625,7 +50878,7 @@ func (x *DownloadHotPatchTemplateRequest) String() string {
 func (*DownloadHotPatchTemplateRequest) ProtoMessage() {}
 
 func (x *DownloadHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[707]
+	mi := &file_yakgrpc_proto_msgTypes[710]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50638,7 +50891,7 @@ func (x *DownloadHotPatchTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadHotPatchTemplateRequest.ProtoReflect.Descriptor instead.
 func (*DownloadHotPatchTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{707}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{710}
 }
 
 func (x *DownloadHotPatchTemplateRequest) GetName() string {
@@ -50667,7 +50920,7 @@ type ExportHTTPFlowStreamRequest struct {
 
 func (x *ExportHTTPFlowStreamRequest) Reset() {
 	*x = ExportHTTPFlowStreamRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[708]
+	mi := &file_yakgrpc_proto_msgTypes[711]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50679,7 +50932,7 @@ func (x *ExportHTTPFlowStreamRequest) String() string {
 func (*ExportHTTPFlowStreamRequest) ProtoMessage() {}
 
 func (x *ExportHTTPFlowStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[708]
+	mi := &file_yakgrpc_proto_msgTypes[711]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50692,7 +50945,7 @@ func (x *ExportHTTPFlowStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportHTTPFlowStreamRequest.ProtoReflect.Descriptor instead.
 func (*ExportHTTPFlowStreamRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{708}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{711}
 }
 
 func (x *ExportHTTPFlowStreamRequest) GetFilter() *QueryHTTPFlowRequest {
@@ -50733,7 +50986,7 @@ type ExportHTTPFlowStreamResponse struct {
 
 func (x *ExportHTTPFlowStreamResponse) Reset() {
 	*x = ExportHTTPFlowStreamResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[709]
+	mi := &file_yakgrpc_proto_msgTypes[712]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50745,7 +50998,7 @@ func (x *ExportHTTPFlowStreamResponse) String() string {
 func (*ExportHTTPFlowStreamResponse) ProtoMessage() {}
 
 func (x *ExportHTTPFlowStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[709]
+	mi := &file_yakgrpc_proto_msgTypes[712]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50758,7 +51011,7 @@ func (x *ExportHTTPFlowStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportHTTPFlowStreamResponse.ProtoReflect.Descriptor instead.
 func (*ExportHTTPFlowStreamResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{709}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{712}
 }
 
 func (x *ExportHTTPFlowStreamResponse) GetPercent() float64 {
@@ -50784,7 +51037,7 @@ type ImportHTTPFlowStreamRequest struct {
 
 func (x *ImportHTTPFlowStreamRequest) Reset() {
 	*x = ImportHTTPFlowStreamRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[710]
+	mi := &file_yakgrpc_proto_msgTypes[713]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50796,7 +51049,7 @@ func (x *ImportHTTPFlowStreamRequest) String() string {
 func (*ImportHTTPFlowStreamRequest) ProtoMessage() {}
 
 func (x *ImportHTTPFlowStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[710]
+	mi := &file_yakgrpc_proto_msgTypes[713]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50809,7 +51062,7 @@ func (x *ImportHTTPFlowStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportHTTPFlowStreamRequest.ProtoReflect.Descriptor instead.
 func (*ImportHTTPFlowStreamRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{710}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{713}
 }
 
 func (x *ImportHTTPFlowStreamRequest) GetInputPath() string {
@@ -50829,7 +51082,7 @@ type ImportHTTPFlowStreamResponse struct {
 
 func (x *ImportHTTPFlowStreamResponse) Reset() {
 	*x = ImportHTTPFlowStreamResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[711]
+	mi := &file_yakgrpc_proto_msgTypes[714]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50841,7 +51094,7 @@ func (x *ImportHTTPFlowStreamResponse) String() string {
 func (*ImportHTTPFlowStreamResponse) ProtoMessage() {}
 
 func (x *ImportHTTPFlowStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[711]
+	mi := &file_yakgrpc_proto_msgTypes[714]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50854,7 +51107,7 @@ func (x *ImportHTTPFlowStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportHTTPFlowStreamResponse.ProtoReflect.Descriptor instead.
 func (*ImportHTTPFlowStreamResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{711}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{714}
 }
 
 func (x *ImportHTTPFlowStreamResponse) GetPercent() float64 {
@@ -50884,7 +51137,7 @@ type Note struct {
 
 func (x *Note) Reset() {
 	*x = Note{}
-	mi := &file_yakgrpc_proto_msgTypes[712]
+	mi := &file_yakgrpc_proto_msgTypes[715]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50896,7 +51149,7 @@ func (x *Note) String() string {
 func (*Note) ProtoMessage() {}
 
 func (x *Note) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[712]
+	mi := &file_yakgrpc_proto_msgTypes[715]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50909,7 +51162,7 @@ func (x *Note) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Note.ProtoReflect.Descriptor instead.
 func (*Note) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{712}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{715}
 }
 
 func (x *Note) GetId() uint64 {
@@ -50959,7 +51212,7 @@ type NoteContent struct {
 
 func (x *NoteContent) Reset() {
 	*x = NoteContent{}
-	mi := &file_yakgrpc_proto_msgTypes[713]
+	mi := &file_yakgrpc_proto_msgTypes[716]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50971,7 +51224,7 @@ func (x *NoteContent) String() string {
 func (*NoteContent) ProtoMessage() {}
 
 func (x *NoteContent) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[713]
+	mi := &file_yakgrpc_proto_msgTypes[716]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -50984,7 +51237,7 @@ func (x *NoteContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteContent.ProtoReflect.Descriptor instead.
 func (*NoteContent) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{713}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{716}
 }
 
 func (x *NoteContent) GetNote() *Note {
@@ -51026,7 +51279,7 @@ type NoteFilter struct {
 
 func (x *NoteFilter) Reset() {
 	*x = NoteFilter{}
-	mi := &file_yakgrpc_proto_msgTypes[714]
+	mi := &file_yakgrpc_proto_msgTypes[717]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51038,7 +51291,7 @@ func (x *NoteFilter) String() string {
 func (*NoteFilter) ProtoMessage() {}
 
 func (x *NoteFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[714]
+	mi := &file_yakgrpc_proto_msgTypes[717]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51051,7 +51304,7 @@ func (x *NoteFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteFilter.ProtoReflect.Descriptor instead.
 func (*NoteFilter) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{714}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{717}
 }
 
 func (x *NoteFilter) GetId() []uint64 {
@@ -51085,7 +51338,7 @@ type CreateNoteRequest struct {
 
 func (x *CreateNoteRequest) Reset() {
 	*x = CreateNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[715]
+	mi := &file_yakgrpc_proto_msgTypes[718]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51097,7 +51350,7 @@ func (x *CreateNoteRequest) String() string {
 func (*CreateNoteRequest) ProtoMessage() {}
 
 func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[715]
+	mi := &file_yakgrpc_proto_msgTypes[718]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51110,7 +51363,7 @@ func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
 func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{715}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{718}
 }
 
 func (x *CreateNoteRequest) GetTitle() string {
@@ -51137,7 +51390,7 @@ type CreateNoteResponse struct {
 
 func (x *CreateNoteResponse) Reset() {
 	*x = CreateNoteResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[716]
+	mi := &file_yakgrpc_proto_msgTypes[719]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51149,7 +51402,7 @@ func (x *CreateNoteResponse) String() string {
 func (*CreateNoteResponse) ProtoMessage() {}
 
 func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[716]
+	mi := &file_yakgrpc_proto_msgTypes[719]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51162,7 +51415,7 @@ func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
 func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{716}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{719}
 }
 
 func (x *CreateNoteResponse) GetMessage() *DbOperateMessage {
@@ -51192,7 +51445,7 @@ type UpdateNoteRequest struct {
 
 func (x *UpdateNoteRequest) Reset() {
 	*x = UpdateNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[717]
+	mi := &file_yakgrpc_proto_msgTypes[720]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51204,7 +51457,7 @@ func (x *UpdateNoteRequest) String() string {
 func (*UpdateNoteRequest) ProtoMessage() {}
 
 func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[717]
+	mi := &file_yakgrpc_proto_msgTypes[720]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51217,7 +51470,7 @@ func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{717}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{720}
 }
 
 func (x *UpdateNoteRequest) GetFilter() *NoteFilter {
@@ -51264,7 +51517,7 @@ type DeleteNoteRequest struct {
 
 func (x *DeleteNoteRequest) Reset() {
 	*x = DeleteNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[718]
+	mi := &file_yakgrpc_proto_msgTypes[721]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51276,7 +51529,7 @@ func (x *DeleteNoteRequest) String() string {
 func (*DeleteNoteRequest) ProtoMessage() {}
 
 func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[718]
+	mi := &file_yakgrpc_proto_msgTypes[721]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51289,7 +51542,7 @@ func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{718}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{721}
 }
 
 func (x *DeleteNoteRequest) GetFilter() *NoteFilter {
@@ -51309,7 +51562,7 @@ type QueryNoteRequest struct {
 
 func (x *QueryNoteRequest) Reset() {
 	*x = QueryNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[719]
+	mi := &file_yakgrpc_proto_msgTypes[722]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51321,7 +51574,7 @@ func (x *QueryNoteRequest) String() string {
 func (*QueryNoteRequest) ProtoMessage() {}
 
 func (x *QueryNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[719]
+	mi := &file_yakgrpc_proto_msgTypes[722]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51334,7 +51587,7 @@ func (x *QueryNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNoteRequest.ProtoReflect.Descriptor instead.
 func (*QueryNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{719}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{722}
 }
 
 func (x *QueryNoteRequest) GetFilter() *NoteFilter {
@@ -51362,7 +51615,7 @@ type QueryNoteResponse struct {
 
 func (x *QueryNoteResponse) Reset() {
 	*x = QueryNoteResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[720]
+	mi := &file_yakgrpc_proto_msgTypes[723]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51374,7 +51627,7 @@ func (x *QueryNoteResponse) String() string {
 func (*QueryNoteResponse) ProtoMessage() {}
 
 func (x *QueryNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[720]
+	mi := &file_yakgrpc_proto_msgTypes[723]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51387,7 +51640,7 @@ func (x *QueryNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNoteResponse.ProtoReflect.Descriptor instead.
 func (*QueryNoteResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{720}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{723}
 }
 
 func (x *QueryNoteResponse) GetPagination() *Paging {
@@ -51421,7 +51674,7 @@ type SearchNoteContentRequest struct {
 
 func (x *SearchNoteContentRequest) Reset() {
 	*x = SearchNoteContentRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[721]
+	mi := &file_yakgrpc_proto_msgTypes[724]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51433,7 +51686,7 @@ func (x *SearchNoteContentRequest) String() string {
 func (*SearchNoteContentRequest) ProtoMessage() {}
 
 func (x *SearchNoteContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[721]
+	mi := &file_yakgrpc_proto_msgTypes[724]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51446,7 +51699,7 @@ func (x *SearchNoteContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNoteContentRequest.ProtoReflect.Descriptor instead.
 func (*SearchNoteContentRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{721}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{724}
 }
 
 func (x *SearchNoteContentRequest) GetKeyword() string {
@@ -51474,7 +51727,7 @@ type SearchNoteContentResponse struct {
 
 func (x *SearchNoteContentResponse) Reset() {
 	*x = SearchNoteContentResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[722]
+	mi := &file_yakgrpc_proto_msgTypes[725]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51486,7 +51739,7 @@ func (x *SearchNoteContentResponse) String() string {
 func (*SearchNoteContentResponse) ProtoMessage() {}
 
 func (x *SearchNoteContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[722]
+	mi := &file_yakgrpc_proto_msgTypes[725]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51499,7 +51752,7 @@ func (x *SearchNoteContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNoteContentResponse.ProtoReflect.Descriptor instead.
 func (*SearchNoteContentResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{722}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{725}
 }
 
 func (x *SearchNoteContentResponse) GetPagination() *Paging {
@@ -51532,7 +51785,7 @@ type ImportNoteRequest struct {
 
 func (x *ImportNoteRequest) Reset() {
 	*x = ImportNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[723]
+	mi := &file_yakgrpc_proto_msgTypes[726]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51544,7 +51797,7 @@ func (x *ImportNoteRequest) String() string {
 func (*ImportNoteRequest) ProtoMessage() {}
 
 func (x *ImportNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[723]
+	mi := &file_yakgrpc_proto_msgTypes[726]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51557,7 +51810,7 @@ func (x *ImportNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportNoteRequest.ProtoReflect.Descriptor instead.
 func (*ImportNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{723}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{726}
 }
 
 func (x *ImportNoteRequest) GetTargetPath() string {
@@ -51578,7 +51831,7 @@ type ImportNoteResponse struct {
 
 func (x *ImportNoteResponse) Reset() {
 	*x = ImportNoteResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[724]
+	mi := &file_yakgrpc_proto_msgTypes[727]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51590,7 +51843,7 @@ func (x *ImportNoteResponse) String() string {
 func (*ImportNoteResponse) ProtoMessage() {}
 
 func (x *ImportNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[724]
+	mi := &file_yakgrpc_proto_msgTypes[727]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51603,7 +51856,7 @@ func (x *ImportNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportNoteResponse.ProtoReflect.Descriptor instead.
 func (*ImportNoteResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{724}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{727}
 }
 
 func (x *ImportNoteResponse) GetPercent() float64 {
@@ -51637,7 +51890,7 @@ type ExportNoteRequest struct {
 
 func (x *ExportNoteRequest) Reset() {
 	*x = ExportNoteRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[725]
+	mi := &file_yakgrpc_proto_msgTypes[728]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51649,7 +51902,7 @@ func (x *ExportNoteRequest) String() string {
 func (*ExportNoteRequest) ProtoMessage() {}
 
 func (x *ExportNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[725]
+	mi := &file_yakgrpc_proto_msgTypes[728]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51662,7 +51915,7 @@ func (x *ExportNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportNoteRequest.ProtoReflect.Descriptor instead.
 func (*ExportNoteRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{725}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{728}
 }
 
 func (x *ExportNoteRequest) GetFilter() *NoteFilter {
@@ -51689,7 +51942,7 @@ type ExportNoteResponse struct {
 
 func (x *ExportNoteResponse) Reset() {
 	*x = ExportNoteResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[726]
+	mi := &file_yakgrpc_proto_msgTypes[729]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51701,7 +51954,7 @@ func (x *ExportNoteResponse) String() string {
 func (*ExportNoteResponse) ProtoMessage() {}
 
 func (x *ExportNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[726]
+	mi := &file_yakgrpc_proto_msgTypes[729]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51714,7 +51967,7 @@ func (x *ExportNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportNoteResponse.ProtoReflect.Descriptor instead.
 func (*ExportNoteResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{726}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{729}
 }
 
 func (x *ExportNoteResponse) GetPercent() float64 {
@@ -51740,7 +51993,7 @@ type ListAiModelRequest struct {
 
 func (x *ListAiModelRequest) Reset() {
 	*x = ListAiModelRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[727]
+	mi := &file_yakgrpc_proto_msgTypes[730]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51752,7 +52005,7 @@ func (x *ListAiModelRequest) String() string {
 func (*ListAiModelRequest) ProtoMessage() {}
 
 func (x *ListAiModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[727]
+	mi := &file_yakgrpc_proto_msgTypes[730]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51765,7 +52018,7 @@ func (x *ListAiModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAiModelRequest.ProtoReflect.Descriptor instead.
 func (*ListAiModelRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{727}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{730}
 }
 
 func (x *ListAiModelRequest) GetConfig() string {
@@ -51784,7 +52037,7 @@ type ListAiModelResponse struct {
 
 func (x *ListAiModelResponse) Reset() {
 	*x = ListAiModelResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[728]
+	mi := &file_yakgrpc_proto_msgTypes[731]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51796,7 +52049,7 @@ func (x *ListAiModelResponse) String() string {
 func (*ListAiModelResponse) ProtoMessage() {}
 
 func (x *ListAiModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[728]
+	mi := &file_yakgrpc_proto_msgTypes[731]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51809,7 +52062,7 @@ func (x *ListAiModelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAiModelResponse.ProtoReflect.Descriptor instead.
 func (*ListAiModelResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{728}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{731}
 }
 
 func (x *ListAiModelResponse) GetModelName() []string {
@@ -51830,7 +52083,7 @@ type IsLlamaServerReadyResponse struct {
 
 func (x *IsLlamaServerReadyResponse) Reset() {
 	*x = IsLlamaServerReadyResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[729]
+	mi := &file_yakgrpc_proto_msgTypes[732]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51842,7 +52095,7 @@ func (x *IsLlamaServerReadyResponse) String() string {
 func (*IsLlamaServerReadyResponse) ProtoMessage() {}
 
 func (x *IsLlamaServerReadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[729]
+	mi := &file_yakgrpc_proto_msgTypes[732]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51855,7 +52108,7 @@ func (x *IsLlamaServerReadyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsLlamaServerReadyResponse.ProtoReflect.Descriptor instead.
 func (*IsLlamaServerReadyResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{729}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{732}
 }
 
 func (x *IsLlamaServerReadyResponse) GetOk() bool {
@@ -51881,7 +52134,7 @@ type IsLocalModelReadyRequest struct {
 
 func (x *IsLocalModelReadyRequest) Reset() {
 	*x = IsLocalModelReadyRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[730]
+	mi := &file_yakgrpc_proto_msgTypes[733]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51893,7 +52146,7 @@ func (x *IsLocalModelReadyRequest) String() string {
 func (*IsLocalModelReadyRequest) ProtoMessage() {}
 
 func (x *IsLocalModelReadyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[730]
+	mi := &file_yakgrpc_proto_msgTypes[733]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51906,7 +52159,7 @@ func (x *IsLocalModelReadyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsLocalModelReadyRequest.ProtoReflect.Descriptor instead.
 func (*IsLocalModelReadyRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{730}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{733}
 }
 
 func (x *IsLocalModelReadyRequest) GetModelName() string {
@@ -51926,7 +52179,7 @@ type IsLocalModelReadyResponse struct {
 
 func (x *IsLocalModelReadyResponse) Reset() {
 	*x = IsLocalModelReadyResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[731]
+	mi := &file_yakgrpc_proto_msgTypes[734]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51938,7 +52191,7 @@ func (x *IsLocalModelReadyResponse) String() string {
 func (*IsLocalModelReadyResponse) ProtoMessage() {}
 
 func (x *IsLocalModelReadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[731]
+	mi := &file_yakgrpc_proto_msgTypes[734]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -51951,7 +52204,7 @@ func (x *IsLocalModelReadyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsLocalModelReadyResponse.ProtoReflect.Descriptor instead.
 func (*IsLocalModelReadyResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{731}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{734}
 }
 
 func (x *IsLocalModelReadyResponse) GetOk() bool {
@@ -51977,7 +52230,7 @@ type InstallLlamaServerRequest struct {
 
 func (x *InstallLlamaServerRequest) Reset() {
 	*x = InstallLlamaServerRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[732]
+	mi := &file_yakgrpc_proto_msgTypes[735]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -51989,7 +52242,7 @@ func (x *InstallLlamaServerRequest) String() string {
 func (*InstallLlamaServerRequest) ProtoMessage() {}
 
 func (x *InstallLlamaServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[732]
+	mi := &file_yakgrpc_proto_msgTypes[735]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52002,7 +52255,7 @@ func (x *InstallLlamaServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallLlamaServerRequest.ProtoReflect.Descriptor instead.
 func (*InstallLlamaServerRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{732}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{735}
 }
 
 func (x *InstallLlamaServerRequest) GetProxy() string {
@@ -52023,7 +52276,7 @@ type StartLocalModelRequest struct {
 
 func (x *StartLocalModelRequest) Reset() {
 	*x = StartLocalModelRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[733]
+	mi := &file_yakgrpc_proto_msgTypes[736]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52035,7 +52288,7 @@ func (x *StartLocalModelRequest) String() string {
 func (*StartLocalModelRequest) ProtoMessage() {}
 
 func (x *StartLocalModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[733]
+	mi := &file_yakgrpc_proto_msgTypes[736]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52048,7 +52301,7 @@ func (x *StartLocalModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartLocalModelRequest.ProtoReflect.Descriptor instead.
 func (*StartLocalModelRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{733}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{736}
 }
 
 func (x *StartLocalModelRequest) GetModelName() string {
@@ -52082,7 +52335,7 @@ type DownloadLocalModelRequest struct {
 
 func (x *DownloadLocalModelRequest) Reset() {
 	*x = DownloadLocalModelRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[734]
+	mi := &file_yakgrpc_proto_msgTypes[737]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52094,7 +52347,7 @@ func (x *DownloadLocalModelRequest) String() string {
 func (*DownloadLocalModelRequest) ProtoMessage() {}
 
 func (x *DownloadLocalModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[734]
+	mi := &file_yakgrpc_proto_msgTypes[737]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52107,7 +52360,7 @@ func (x *DownloadLocalModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadLocalModelRequest.ProtoReflect.Descriptor instead.
 func (*DownloadLocalModelRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{734}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{737}
 }
 
 func (x *DownloadLocalModelRequest) GetModelName() string {
@@ -52138,7 +52391,7 @@ type LocalModelConfig struct {
 
 func (x *LocalModelConfig) Reset() {
 	*x = LocalModelConfig{}
-	mi := &file_yakgrpc_proto_msgTypes[735]
+	mi := &file_yakgrpc_proto_msgTypes[738]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52150,7 +52403,7 @@ func (x *LocalModelConfig) String() string {
 func (*LocalModelConfig) ProtoMessage() {}
 
 func (x *LocalModelConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[735]
+	mi := &file_yakgrpc_proto_msgTypes[738]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52163,7 +52416,7 @@ func (x *LocalModelConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalModelConfig.ProtoReflect.Descriptor instead.
 func (*LocalModelConfig) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{735}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{738}
 }
 
 func (x *LocalModelConfig) GetName() string {
@@ -52217,7 +52470,7 @@ type GetSupportedLocalModelsResponse struct {
 
 func (x *GetSupportedLocalModelsResponse) Reset() {
 	*x = GetSupportedLocalModelsResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[736]
+	mi := &file_yakgrpc_proto_msgTypes[739]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52229,7 +52482,7 @@ func (x *GetSupportedLocalModelsResponse) String() string {
 func (*GetSupportedLocalModelsResponse) ProtoMessage() {}
 
 func (x *GetSupportedLocalModelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[736]
+	mi := &file_yakgrpc_proto_msgTypes[739]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52242,7 +52495,7 @@ func (x *GetSupportedLocalModelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSupportedLocalModelsResponse.ProtoReflect.Descriptor instead.
 func (*GetSupportedLocalModelsResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{736}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{739}
 }
 
 func (x *GetSupportedLocalModelsResponse) GetModels() []*LocalModelConfig {
@@ -52322,7 +52575,7 @@ type MITMV2Request struct {
 
 func (x *MITMV2Request) Reset() {
 	*x = MITMV2Request{}
-	mi := &file_yakgrpc_proto_msgTypes[737]
+	mi := &file_yakgrpc_proto_msgTypes[740]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52334,7 +52587,7 @@ func (x *MITMV2Request) String() string {
 func (*MITMV2Request) ProtoMessage() {}
 
 func (x *MITMV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[737]
+	mi := &file_yakgrpc_proto_msgTypes[740]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52347,7 +52600,7 @@ func (x *MITMV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MITMV2Request.ProtoReflect.Descriptor instead.
 func (*MITMV2Request) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{737}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{740}
 }
 
 func (x *MITMV2Request) GetHost() string {
@@ -52692,7 +52945,7 @@ type MITMV2Response struct {
 
 func (x *MITMV2Response) Reset() {
 	*x = MITMV2Response{}
-	mi := &file_yakgrpc_proto_msgTypes[738]
+	mi := &file_yakgrpc_proto_msgTypes[741]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52704,7 +52957,7 @@ func (x *MITMV2Response) String() string {
 func (*MITMV2Response) ProtoMessage() {}
 
 func (x *MITMV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[738]
+	mi := &file_yakgrpc_proto_msgTypes[741]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52717,7 +52970,7 @@ func (x *MITMV2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MITMV2Response.ProtoReflect.Descriptor instead.
 func (*MITMV2Response) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{738}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{741}
 }
 
 func (x *MITMV2Response) GetJustFilter() bool {
@@ -52839,7 +53092,7 @@ type SingleManualHijackControlMessage struct {
 
 func (x *SingleManualHijackControlMessage) Reset() {
 	*x = SingleManualHijackControlMessage{}
-	mi := &file_yakgrpc_proto_msgTypes[739]
+	mi := &file_yakgrpc_proto_msgTypes[742]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52851,7 +53104,7 @@ func (x *SingleManualHijackControlMessage) String() string {
 func (*SingleManualHijackControlMessage) ProtoMessage() {}
 
 func (x *SingleManualHijackControlMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[739]
+	mi := &file_yakgrpc_proto_msgTypes[742]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52864,7 +53117,7 @@ func (x *SingleManualHijackControlMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleManualHijackControlMessage.ProtoReflect.Descriptor instead.
 func (*SingleManualHijackControlMessage) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{739}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{742}
 }
 
 func (x *SingleManualHijackControlMessage) GetTaskID() string {
@@ -52967,7 +53220,7 @@ type SingleManualHijackInfoMessage struct {
 
 func (x *SingleManualHijackInfoMessage) Reset() {
 	*x = SingleManualHijackInfoMessage{}
-	mi := &file_yakgrpc_proto_msgTypes[740]
+	mi := &file_yakgrpc_proto_msgTypes[743]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -52979,7 +53232,7 @@ func (x *SingleManualHijackInfoMessage) String() string {
 func (*SingleManualHijackInfoMessage) ProtoMessage() {}
 
 func (x *SingleManualHijackInfoMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[740]
+	mi := &file_yakgrpc_proto_msgTypes[743]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -52992,7 +53245,7 @@ func (x *SingleManualHijackInfoMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleManualHijackInfoMessage.ProtoReflect.Descriptor instead.
 func (*SingleManualHijackInfoMessage) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{740}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{743}
 }
 
 func (x *SingleManualHijackInfoMessage) GetTaskID() string {
@@ -53102,7 +53355,7 @@ type QueryMITMReplacerRulesRequest struct {
 
 func (x *QueryMITMReplacerRulesRequest) Reset() {
 	*x = QueryMITMReplacerRulesRequest{}
-	mi := &file_yakgrpc_proto_msgTypes[741]
+	mi := &file_yakgrpc_proto_msgTypes[744]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -53114,7 +53367,7 @@ func (x *QueryMITMReplacerRulesRequest) String() string {
 func (*QueryMITMReplacerRulesRequest) ProtoMessage() {}
 
 func (x *QueryMITMReplacerRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[741]
+	mi := &file_yakgrpc_proto_msgTypes[744]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53127,7 +53380,7 @@ func (x *QueryMITMReplacerRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMITMReplacerRulesRequest.ProtoReflect.Descriptor instead.
 func (*QueryMITMReplacerRulesRequest) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{741}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{744}
 }
 
 func (x *QueryMITMReplacerRulesRequest) GetKeyWord() string {
@@ -53146,7 +53399,7 @@ type QueryMITMReplacerRulesResponse struct {
 
 func (x *QueryMITMReplacerRulesResponse) Reset() {
 	*x = QueryMITMReplacerRulesResponse{}
-	mi := &file_yakgrpc_proto_msgTypes[742]
+	mi := &file_yakgrpc_proto_msgTypes[745]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -53158,7 +53411,7 @@ func (x *QueryMITMReplacerRulesResponse) String() string {
 func (*QueryMITMReplacerRulesResponse) ProtoMessage() {}
 
 func (x *QueryMITMReplacerRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_yakgrpc_proto_msgTypes[742]
+	mi := &file_yakgrpc_proto_msgTypes[745]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53171,7 +53424,7 @@ func (x *QueryMITMReplacerRulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMITMReplacerRulesResponse.ProtoReflect.Descriptor instead.
 func (*QueryMITMReplacerRulesResponse) Descriptor() ([]byte, []int) {
-	return file_yakgrpc_proto_rawDescGZIP(), []int{742}
+	return file_yakgrpc_proto_rawDescGZIP(), []int{745}
 }
 
 func (x *QueryMITMReplacerRulesResponse) GetRules() *MITMContentReplacers {
@@ -56499,7 +56752,7 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\x06Header\x18\x01 \x01(\tR\x06Header\x12\x14\n" +
 	"\x05Value\x18\x02 \x01(\tR\x05Value\".\n" +
 	"\tHTTPFlows\x12!\n" +
-	"\x04Data\x18\x01 \x03(\v2\r.ypb.HTTPFlowR\x04Data\"\xf2\x0e\n" +
+	"\x04Data\x18\x01 \x03(\v2\r.ypb.HTTPFlowR\x04Data\"\xd8\x0f\n" +
 	"\bHTTPFlow\x12\x18\n" +
 	"\aIsHTTPS\x18\x02 \x01(\bR\aIsHTTPS\x12\x10\n" +
 	"\x03Url\x18\x03 \x01(\tR\x03Url\x125\n" +
@@ -56563,7 +56816,9 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\n" +
 	"FromPlugin\x182 \x01(\tR\n" +
 	"FromPlugin\x12\x12\n" +
-	"\x04Host\x184 \x01(\tR\x04Host\"\xa9\x01\n" +
+	"\x04Host\x184 \x01(\tR\x04Host\x120\n" +
+	"\x13RequestProtobufText\x185 \x01(\tR\x13RequestProtobufText\x122\n" +
+	"\x14ResponseProtobufText\x186 \x01(\tR\x14ResponseProtobufText\"\xa9\x01\n" +
 	"\rFuzzableParam\x12\x1a\n" +
 	"\bPosition\x18\x01 \x01(\tR\bPosition\x12\x1c\n" +
 	"\tParamName\x18\x02 \x01(\tR\tParamName\x12 \n" +
@@ -57217,7 +57472,31 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"Pagination\x12+\n" +
 	"\bPrograms\x18\x02 \x03(\v2\x0f.ypb.SSAProgramR\bPrograms\x12#\n" +
 	"\x04Data\x18\x04 \x03(\v2\x0f.ypb.SSAProgramR\x04Data\x12\x14\n" +
-	"\x05Total\x18\x03 \x01(\x03R\x05Total\"a\n" +
+	"\x05Total\x18\x03 \x01(\x03R\x05Total\"\x85\x01\n" +
+	"#IncrementalCompileSSAProgramRequest\x12 \n" +
+	"\vProgramName\x18\x01 \x01(\tR\vProgramName\x12\x1c\n" +
+	"\tRuleNames\x18\x02 \x03(\tR\tRuleNames\x12\x1e\n" +
+	"\n" +
+	"RerunRules\x18\x03 \x01(\bR\n" +
+	"RerunRules\"\xa4\x03\n" +
+	"\"IncrementalCompileSSAProgramResult\x12\"\n" +
+	"\fFullCompiled\x18\x01 \x01(\bR\fFullCompiled\x12\x18\n" +
+	"\aChanged\x18\x02 \x01(\bR\aChanged\x12\x1e\n" +
+	"\n" +
+	"AddedFiles\x18\x03 \x03(\tR\n" +
+	"AddedFiles\x12$\n" +
+	"\rModifiedFiles\x18\x04 \x03(\tR\rModifiedFiles\x12\"\n" +
+	"\fRemovedFiles\x18\x05 \x03(\tR\fRemovedFiles\x12(\n" +
+	"\x0fRecompiledFiles\x18\x06 \x03(\tR\x0fRecompiledFiles\x12,\n" +
+	"\x11AffectedFunctions\x18\a \x03(\tR\x11AffectedFunctions\x12.\n" +
+	"\x12AffectedBlueprints\x18\b \x03(\tR\x12AffectedBlueprints\x12&\n" +
+	"\x0eRerunRuleNames\x18\t \x03(\tR\x0eRerunRuleNames\x12&\n" +
+	"\x0eRerunResultIDs\x18\n" +
+	" \x03(\x04R\x0eRerunResultIDs\"\x9b\x01\n" +
+	"$IncrementalCompileSSAProgramResponse\x12\x18\n" +
+	"\aProcess\x18\x01 \x01(\x01R\aProcess\x12\x18\n" +
+	"\aMessage\x18\x02 \x01(\tR\aMessage\x12?\n" +
+	"\x06Result\x18\x03 \x01(\v2'.ypb.IncrementalCompileSSAProgramResultR\x06Result\"a\n" +
 	"\x1bCreateSyntaxFlowRuleRequest\x12B\n" +
 	"\x0fSyntaxFlowInput\x18\x01 \x01(\v2\x18.ypb.SyntaxFlowRuleInputR\x0fSyntaxFlowInput\"x\n" +
 	"\x1cCreateSyntaxFlowRuleResponse\x12/\n" +
@@ -57826,7 +58105,7 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\tAesBase64\x10\x03\x12\n" +
 	"\n" +
 	"\x06XorRaw\x10\x04\x12\r\n" +
-	"\tXorBase64\x10\x052\xb4\x9d\x02\n" +
+	"\tXorBase64\x10\x052\xab\x9e\x02\n" +
 	"\x03Yak\x12+\n" +
 	"\aVersion\x12\n" +
 	".ypb.Empty\x1a\x14.ypb.VersionResponse\x12H\n" +
@@ -58440,7 +58719,8 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\x16DeleteSyntaxFlowResult\x12\".ypb.DeleteSyntaxFlowResultRequest\x1a#.ypb.DeleteSyntaxFlowResultResponse\x12M\n" +
 	"\x10QuerySSAPrograms\x12\x1b.ypb.QuerySSAProgramRequest\x1a\x1c.ypb.QuerySSAProgramResponse\x12G\n" +
 	"\x10UpdateSSAProgram\x12\x1c.ypb.UpdateSSAProgramRequest\x1a\x15.ypb.DbOperateMessage\x12H\n" +
-	"\x11DeleteSSAPrograms\x12\x1c.ypb.DeleteSSAProgramRequest\x1a\x15.ypb.DbOperateMessage\x12F\n" +
+	"\x11DeleteSSAPrograms\x12\x1c.ypb.DeleteSSAProgramRequest\x1a\x15.ypb.DbOperateMessage\x12u\n" +
+	"\x1cIncrementalCompileSSAProgram\x12(.ypb.IncrementalCompileSSAProgramRequest\x1a).ypb.IncrementalCompileSSAProgramResponse0\x01\x12F\n" +
 	"\rQuerySSARisks\x12\x19.ypb.QuerySSARisksRequest\x1a\x1a.ypb.QuerySSARisksResponse\x12O\n" +
 	"\x10QueryNewSSARisks\x12\x1c.ypb.QueryNewSSARisksRequest\x1a\x1d.ypb.QueryNewSSARisksResponse\x12C\n" +
 	"\x0eDeleteSSARisks\x12\x1a.ypb.DeleteSSARisksRequest\x1a\x15.ypb.DbOperateMessage\x12I\n" +
@@ -58530,7 +58810,7 @@ func file_yakgrpc_proto_rawDescGZIP() []byte {
 }
 
 var file_yakgrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_yakgrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 752)
+var file_yakgrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 755)
 var file_yakgrpc_proto_goTypes = []any{
 	(ShellType)(0),   // 0: ypb.ShellType
 	(ShellScript)(0), // 1: ypb.ShellScript
@@ -59169,125 +59449,128 @@ var file_yakgrpc_proto_goTypes = []any{
 	(*UpdateSSAProgramRequest)(nil),                           // 634: ypb.UpdateSSAProgramRequest
 	(*DeleteSSAProgramRequest)(nil),                           // 635: ypb.DeleteSSAProgramRequest
 	(*QuerySSAProgramResponse)(nil),                           // 636: ypb.QuerySSAProgramResponse
-	(*CreateSyntaxFlowRuleRequest)(nil),                       // 637: ypb.CreateSyntaxFlowRuleRequest
-	(*CreateSyntaxFlowRuleResponse)(nil),                      // 638: ypb.CreateSyntaxFlowRuleResponse
-	(*UpdateSyntaxFlowRuleRequest)(nil),                       // 639: ypb.UpdateSyntaxFlowRuleRequest
-	(*UpdateSyntaxFlowRuleResponse)(nil),                      // 640: ypb.UpdateSyntaxFlowRuleResponse
-	(*QuerySyntaxFlowRuleResponse)(nil),                       // 641: ypb.QuerySyntaxFlowRuleResponse
-	(*DeleteSyntaxFlowRuleRequest)(nil),                       // 642: ypb.DeleteSyntaxFlowRuleRequest
-	(*CheckSyntaxFlowRuleUpdateRequest)(nil),                  // 643: ypb.CheckSyntaxFlowRuleUpdateRequest
-	(*CheckSyntaxFlowRuleUpdateResponse)(nil),                 // 644: ypb.CheckSyntaxFlowRuleUpdateResponse
-	(*ApplySyntaxFlowRuleUpdateRequest)(nil),                  // 645: ypb.ApplySyntaxFlowRuleUpdateRequest
-	(*ApplySyntaxFlowRuleUpdateResponse)(nil),                 // 646: ypb.ApplySyntaxFlowRuleUpdateResponse
-	(*SyntaxFlowRuleGroupFilter)(nil),                         // 647: ypb.SyntaxFlowRuleGroupFilter
-	(*SyntaxFlowGroup)(nil),                                   // 648: ypb.SyntaxFlowGroup
-	(*QuerySyntaxFlowRuleGroupRequest)(nil),                   // 649: ypb.QuerySyntaxFlowRuleGroupRequest
-	(*QuerySyntaxFlowRuleGroupResponse)(nil),                  // 650: ypb.QuerySyntaxFlowRuleGroupResponse
-	(*CreateSyntaxFlowGroupRequest)(nil),                      // 651: ypb.CreateSyntaxFlowGroupRequest
-	(*UpdateSyntaxFlowRuleGroupRequest)(nil),                  // 652: ypb.UpdateSyntaxFlowRuleGroupRequest
-	(*UpdateSyntaxFlowRuleAndGroupRequest)(nil),               // 653: ypb.UpdateSyntaxFlowRuleAndGroupRequest
-	(*QuerySyntaxFlowSameGroupRequest)(nil),                   // 654: ypb.QuerySyntaxFlowSameGroupRequest
-	(*QuerySyntaxFlowSameGroupResponse)(nil),                  // 655: ypb.QuerySyntaxFlowSameGroupResponse
-	(*DeleteSyntaxFlowRuleGroupRequest)(nil),                  // 656: ypb.DeleteSyntaxFlowRuleGroupRequest
-	(*SyntaxFlowRuleToOnlineRequest)(nil),                     // 657: ypb.SyntaxFlowRuleToOnlineRequest
-	(*SyntaxFlowRuleOnlineProgress)(nil),                      // 658: ypb.SyntaxFlowRuleOnlineProgress
-	(*DownloadSyntaxFlowRuleRequest)(nil),                     // 659: ypb.DownloadSyntaxFlowRuleRequest
-	(*SyntaxFlowScanRequest)(nil),                             // 660: ypb.SyntaxFlowScanRequest
-	(*QuerySyntaxFlowScanTaskRequest)(nil),                    // 661: ypb.QuerySyntaxFlowScanTaskRequest
-	(*SyntaxFlowScanTaskFilter)(nil),                          // 662: ypb.SyntaxFlowScanTaskFilter
-	(*QuerySyntaxFlowScanTaskResponse)(nil),                   // 663: ypb.QuerySyntaxFlowScanTaskResponse
-	(*SyntaxFlowScanTask)(nil),                                // 664: ypb.SyntaxFlowScanTask
-	(*DeleteSyntaxFlowScanTaskRequest)(nil),                   // 665: ypb.DeleteSyntaxFlowScanTaskRequest
-	(*SyntaxFlowScanResponse)(nil),                            // 666: ypb.SyntaxFlowScanResponse
-	(*SyntaxFlowResultFilter)(nil),                            // 667: ypb.SyntaxFlowResultFilter
-	(*QuerySyntaxFlowResultRequest)(nil),                      // 668: ypb.QuerySyntaxFlowResultRequest
-	(*QuerySyntaxFlowResultResponse)(nil),                     // 669: ypb.QuerySyntaxFlowResultResponse
-	(*SyntaxFlowResult)(nil),                                  // 670: ypb.SyntaxFlowResult
-	(*DeleteSyntaxFlowResultRequest)(nil),                     // 671: ypb.DeleteSyntaxFlowResultRequest
-	(*DeleteSyntaxFlowResultResponse)(nil),                    // 672: ypb.DeleteSyntaxFlowResultResponse
-	(*QueryPluginEnvRequest)(nil),                             // 673: ypb.QueryPluginEnvRequest
-	(*PluginEnvData)(nil),                                     // 674: ypb.PluginEnvData
-	(*DeletePluginEnvRequest)(nil),                            // 675: ypb.DeletePluginEnvRequest
-	(*GetAllFuzztagInfoRequest)(nil),                          // 676: ypb.GetAllFuzztagInfoRequest
-	(*GetAllFuzztagInfoResponse)(nil),                         // 677: ypb.GetAllFuzztagInfoResponse
-	(*FuzztagArgumentType)(nil),                               // 678: ypb.FuzztagArgumentType
-	(*FuzztagInfo)(nil),                                       // 679: ypb.FuzztagInfo
-	(*GenerateFuzztagRequest)(nil),                            // 680: ypb.GenerateFuzztagRequest
-	(*GenerateFuzztagResponse)(nil),                           // 681: ypb.GenerateFuzztagResponse
-	(*FuzzTagSuggestionRequest)(nil),                          // 682: ypb.FuzzTagSuggestionRequest
-	(*SSARisk)(nil),                                           // 683: ypb.SSARisk
-	(*SSARisksFilter)(nil),                                    // 684: ypb.SSARisksFilter
-	(*QuerySSARisksRequest)(nil),                              // 685: ypb.QuerySSARisksRequest
-	(*QuerySSARisksResponse)(nil),                             // 686: ypb.QuerySSARisksResponse
-	(*QueryNewSSARisksRequest)(nil),                           // 687: ypb.QueryNewSSARisksRequest
-	(*QueryNewSSARisksResponse)(nil),                          // 688: ypb.QueryNewSSARisksResponse
-	(*DeleteSSARisksRequest)(nil),                             // 689: ypb.DeleteSSARisksRequest
-	(*UpdateSSARiskTagsRequest)(nil),                          // 690: ypb.UpdateSSARiskTagsRequest
-	(*SSARiskFieldGroupResponse)(nil),                         // 691: ypb.SSARiskFieldGroupResponse
-	(*NewSSARiskReadRequest)(nil),                             // 692: ypb.NewSSARiskReadRequest
-	(*NewSSARiskReadResponse)(nil),                            // 693: ypb.NewSSARiskReadResponse
-	(*SSARiskFeedbackToOnlineRequest)(nil),                    // 694: ypb.SSARiskFeedbackToOnlineRequest
-	(*ExportSyntaxFlowsRequest)(nil),                          // 695: ypb.ExportSyntaxFlowsRequest
-	(*ImportSyntaxFlowsRequest)(nil),                          // 696: ypb.ImportSyntaxFlowsRequest
-	(*SyntaxflowsProgress)(nil),                               // 697: ypb.SyntaxflowsProgress
-	(*HotPatchTemplate)(nil),                                  // 698: ypb.HotPatchTemplate
-	(*HotPatchTemplateRequest)(nil),                           // 699: ypb.HotPatchTemplateRequest
-	(*UpdateHotPatchTemplateRequest)(nil),                     // 700: ypb.UpdateHotPatchTemplateRequest
-	(*DeleteHotPatchTemplateRequest)(nil),                     // 701: ypb.DeleteHotPatchTemplateRequest
-	(*CreateHotPatchTemplateResponse)(nil),                    // 702: ypb.CreateHotPatchTemplateResponse
-	(*DeleteHotPatchTemplateResponse)(nil),                    // 703: ypb.DeleteHotPatchTemplateResponse
-	(*UpdateHotPatchTemplateResponse)(nil),                    // 704: ypb.UpdateHotPatchTemplateResponse
-	(*QueryHotPatchTemplateResponse)(nil),                     // 705: ypb.QueryHotPatchTemplateResponse
-	(*QueryHotPatchTemplateListRequest)(nil),                  // 706: ypb.QueryHotPatchTemplateListRequest
-	(*QueryHotPatchTemplateListResponse)(nil),                 // 707: ypb.QueryHotPatchTemplateListResponse
-	(*GroupTableColumnRequest)(nil),                           // 708: ypb.GroupTableColumnRequest
-	(*GroupTableColumnResponse)(nil),                          // 709: ypb.GroupTableColumnResponse
-	(*UploadHotPatchTemplateToOnlineRequest)(nil),             // 710: ypb.UploadHotPatchTemplateToOnlineRequest
-	(*DownloadHotPatchTemplateRequest)(nil),                   // 711: ypb.DownloadHotPatchTemplateRequest
-	(*ExportHTTPFlowStreamRequest)(nil),                       // 712: ypb.ExportHTTPFlowStreamRequest
-	(*ExportHTTPFlowStreamResponse)(nil),                      // 713: ypb.ExportHTTPFlowStreamResponse
-	(*ImportHTTPFlowStreamRequest)(nil),                       // 714: ypb.ImportHTTPFlowStreamRequest
-	(*ImportHTTPFlowStreamResponse)(nil),                      // 715: ypb.ImportHTTPFlowStreamResponse
-	(*Note)(nil),                                              // 716: ypb.Note
-	(*NoteContent)(nil),                                       // 717: ypb.NoteContent
-	(*NoteFilter)(nil),                                        // 718: ypb.NoteFilter
-	(*CreateNoteRequest)(nil),                                 // 719: ypb.CreateNoteRequest
-	(*CreateNoteResponse)(nil),                                // 720: ypb.CreateNoteResponse
-	(*UpdateNoteRequest)(nil),                                 // 721: ypb.UpdateNoteRequest
-	(*DeleteNoteRequest)(nil),                                 // 722: ypb.DeleteNoteRequest
-	(*QueryNoteRequest)(nil),                                  // 723: ypb.QueryNoteRequest
-	(*QueryNoteResponse)(nil),                                 // 724: ypb.QueryNoteResponse
-	(*SearchNoteContentRequest)(nil),                          // 725: ypb.SearchNoteContentRequest
-	(*SearchNoteContentResponse)(nil),                         // 726: ypb.SearchNoteContentResponse
-	(*ImportNoteRequest)(nil),                                 // 727: ypb.ImportNoteRequest
-	(*ImportNoteResponse)(nil),                                // 728: ypb.ImportNoteResponse
-	(*ExportNoteRequest)(nil),                                 // 729: ypb.ExportNoteRequest
-	(*ExportNoteResponse)(nil),                                // 730: ypb.ExportNoteResponse
-	(*ListAiModelRequest)(nil),                                // 731: ypb.ListAiModelRequest
-	(*ListAiModelResponse)(nil),                               // 732: ypb.ListAiModelResponse
-	(*IsLlamaServerReadyResponse)(nil),                        // 733: ypb.IsLlamaServerReadyResponse
-	(*IsLocalModelReadyRequest)(nil),                          // 734: ypb.IsLocalModelReadyRequest
-	(*IsLocalModelReadyResponse)(nil),                         // 735: ypb.IsLocalModelReadyResponse
-	(*InstallLlamaServerRequest)(nil),                         // 736: ypb.InstallLlamaServerRequest
-	(*StartLocalModelRequest)(nil),                            // 737: ypb.StartLocalModelRequest
-	(*DownloadLocalModelRequest)(nil),                         // 738: ypb.DownloadLocalModelRequest
-	(*LocalModelConfig)(nil),                                  // 739: ypb.LocalModelConfig
-	(*GetSupportedLocalModelsResponse)(nil),                   // 740: ypb.GetSupportedLocalModelsResponse
-	(*MITMV2Request)(nil),                                     // 741: ypb.MITMV2Request
-	(*MITMV2Response)(nil),                                    // 742: ypb.MITMV2Response
-	(*SingleManualHijackControlMessage)(nil),                  // 743: ypb.SingleManualHijackControlMessage
-	(*SingleManualHijackInfoMessage)(nil),                     // 744: ypb.SingleManualHijackInfoMessage
-	(*QueryMITMReplacerRulesRequest)(nil),                     // 745: ypb.QueryMITMReplacerRulesRequest
-	(*QueryMITMReplacerRulesResponse)(nil),                    // 746: ypb.QueryMITMReplacerRulesResponse
-	nil,                                                       // 747: ypb.ExtractDataToFileRequest.DataEntry
-	nil,                                                       // 748: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry
-	nil,                                                       // 749: ypb.WebShell.HeadersEntry
-	nil,                                                       // 750: ypb.WebShell.PostsEntry
-	nil,                                                       // 751: ypb.UpdateWebShellRequest.HeadersEntry
-	nil,                                                       // 752: ypb.UpdateWebShellRequest.PostsEntry
-	nil,                                                       // 753: ypb.SyntaxFlowRule.AlertMsgEntry
-	nil,                                                       // 754: ypb.AlertMessage.ExtraEntry
-	nil,                                                       // 755: ypb.SyntaxFlowRuleInput.AlertMsgEntry
+	(*IncrementalCompileSSAProgramRequest)(nil),               // 637: ypb.IncrementalCompileSSAProgramRequest
+	(*IncrementalCompileSSAProgramResult)(nil),                // 638: ypb.IncrementalCompileSSAProgramResult
+	(*IncrementalCompileSSAProgramResponse)(nil),              // 639: ypb.IncrementalCompileSSAProgramResponse
+	(*CreateSyntaxFlowRuleRequest)(nil),                       // 640: ypb.CreateSyntaxFlowRuleRequest
+	(*CreateSyntaxFlowRuleResponse)(nil),                      // 641: ypb.CreateSyntaxFlowRuleResponse
+	(*UpdateSyntaxFlowRuleRequest)(nil),                       // 642: ypb.UpdateSyntaxFlowRuleRequest
+	(*UpdateSyntaxFlowRuleResponse)(nil),                      // 643: ypb.UpdateSyntaxFlowRuleResponse
+	(*QuerySyntaxFlowRuleResponse)(nil),                       // 644: ypb.QuerySyntaxFlowRuleResponse
+	(*DeleteSyntaxFlowRuleRequest)(nil),                       // 645: ypb.DeleteSyntaxFlowRuleRequest
+	(*CheckSyntaxFlowRuleUpdateRequest)(nil),                  // 646: ypb.CheckSyntaxFlowRuleUpdateRequest
+	(*CheckSyntaxFlowRuleUpdateResponse)(nil),                 // 647: ypb.CheckSyntaxFlowRuleUpdateResponse
+	(*ApplySyntaxFlowRuleUpdateRequest)(nil),                  // 648: ypb.ApplySyntaxFlowRuleUpdateRequest
+	(*ApplySyntaxFlowRuleUpdateResponse)(nil),                 // 649: ypb.ApplySyntaxFlowRuleUpdateResponse
+	(*SyntaxFlowRuleGroupFilter)(nil),                         // 650: ypb.SyntaxFlowRuleGroupFilter
+	(*SyntaxFlowGroup)(nil),                                   // 651: ypb.SyntaxFlowGroup
+	(*QuerySyntaxFlowRuleGroupRequest)(nil),                   // 652: ypb.QuerySyntaxFlowRuleGroupRequest
+	(*QuerySyntaxFlowRuleGroupResponse)(nil),                  // 653: ypb.QuerySyntaxFlowRuleGroupResponse
+	(*CreateSyntaxFlowGroupRequest)(nil),                      // 654: ypb.CreateSyntaxFlowGroupRequest
+	(*UpdateSyntaxFlowRuleGroupRequest)(nil),                  // 655: ypb.UpdateSyntaxFlowRuleGroupRequest
+	(*UpdateSyntaxFlowRuleAndGroupRequest)(nil),               // 656: ypb.UpdateSyntaxFlowRuleAndGroupRequest
+	(*QuerySyntaxFlowSameGroupRequest)(nil),                   // 657: ypb.QuerySyntaxFlowSameGroupRequest
+	(*QuerySyntaxFlowSameGroupResponse)(nil),                  // 658: ypb.QuerySyntaxFlowSameGroupResponse
+	(*DeleteSyntaxFlowRuleGroupRequest)(nil),                  // 659: ypb.DeleteSyntaxFlowRuleGroupRequest
+	(*SyntaxFlowRuleToOnlineRequest)(nil),                     // 660: ypb.SyntaxFlowRuleToOnlineRequest
+	(*SyntaxFlowRuleOnlineProgress)(nil),                      // 661: ypb.SyntaxFlowRuleOnlineProgress
+	(*DownloadSyntaxFlowRuleRequest)(nil),                     // 662: ypb.DownloadSyntaxFlowRuleRequest
+	(*SyntaxFlowScanRequest)(nil),                             // 663: ypb.SyntaxFlowScanRequest
+	(*QuerySyntaxFlowScanTaskRequest)(nil),                    // 664: ypb.QuerySyntaxFlowScanTaskRequest
+	(*SyntaxFlowScanTaskFilter)(nil),                          // 665: ypb.SyntaxFlowScanTaskFilter
+	(*QuerySyntaxFlowScanTaskResponse)(nil),                   // 666: ypb.QuerySyntaxFlowScanTaskResponse
+	(*SyntaxFlowScanTask)(nil),                                // 667: ypb.SyntaxFlowScanTask
+	(*DeleteSyntaxFlowScanTaskRequest)(nil),                   // 668: ypb.DeleteSyntaxFlowScanTaskRequest
+	(*SyntaxFlowScanResponse)(nil),                            // 669: ypb.SyntaxFlowScanResponse
+	(*SyntaxFlowResultFilter)(nil),                            // 670: ypb.SyntaxFlowResultFilter
+	(*QuerySyntaxFlowResultRequest)(nil),                      // 671: ypb.QuerySyntaxFlowResultRequest
+	(*QuerySyntaxFlowResultResponse)(nil),                     // 672: ypb.QuerySyntaxFlowResultResponse
+	(*SyntaxFlowResult)(nil),                                  // 673: ypb.SyntaxFlowResult
+	(*DeleteSyntaxFlowResultRequest)(nil),                     // 674: ypb.DeleteSyntaxFlowResultRequest
+	(*DeleteSyntaxFlowResultResponse)(nil),                    // 675: ypb.DeleteSyntaxFlowResultResponse
+	(*QueryPluginEnvRequest)(nil),                             // 676: ypb.QueryPluginEnvRequest
+	(*PluginEnvData)(nil),                                     // 677: ypb.PluginEnvData
+	(*DeletePluginEnvRequest)(nil),                            // 678: ypb.DeletePluginEnvRequest
+	(*GetAllFuzztagInfoRequest)(nil),                          // 679: ypb.GetAllFuzztagInfoRequest
+	(*GetAllFuzztagInfoResponse)(nil),                         // 680: ypb.GetAllFuzztagInfoResponse
+	(*FuzztagArgumentType)(nil),                               // 681: ypb.FuzztagArgumentType
+	(*FuzztagInfo)(nil),                                       // 682: ypb.FuzztagInfo
+	(*GenerateFuzztagRequest)(nil),                            // 683: ypb.GenerateFuzztagRequest
+	(*GenerateFuzztagResponse)(nil),                           // 684: ypb.GenerateFuzztagResponse
+	(*FuzzTagSuggestionRequest)(nil),                          // 685: ypb.FuzzTagSuggestionRequest
+	(*SSARisk)(nil),                                           // 686: ypb.SSARisk
+	(*SSARisksFilter)(nil),                                    // 687: ypb.SSARisksFilter
+	(*QuerySSARisksRequest)(nil),                              // 688: ypb.QuerySSARisksRequest
+	(*QuerySSARisksResponse)(nil),                             // 689: ypb.QuerySSARisksResponse
+	(*QueryNewSSARisksRequest)(nil),                           // 690: ypb.QueryNewSSARisksRequest
+	(*QueryNewSSARisksResponse)(nil),                          // 691: ypb.QueryNewSSARisksResponse
+	(*DeleteSSARisksRequest)(nil),                             // 692: ypb.DeleteSSARisksRequest
+	(*UpdateSSARiskTagsRequest)(nil),                          // 693: ypb.UpdateSSARiskTagsRequest
+	(*SSARiskFieldGroupResponse)(nil),                         // 694: ypb.SSARiskFieldGroupResponse
+	(*NewSSARiskReadRequest)(nil),                             // 695: ypb.NewSSARiskReadRequest
+	(*NewSSARiskReadResponse)(nil),                            // 696: ypb.NewSSARiskReadResponse
+	(*SSARiskFeedbackToOnlineRequest)(nil),                    // 697: ypb.SSARiskFeedbackToOnlineRequest
+	(*ExportSyntaxFlowsRequest)(nil),                          // 698: ypb.ExportSyntaxFlowsRequest
+	(*ImportSyntaxFlowsRequest)(nil),                          // 699: ypb.ImportSyntaxFlowsRequest
+	(*SyntaxflowsProgress)(nil),                               // 700: ypb.SyntaxflowsProgress
+	(*HotPatchTemplate)(nil),                                  // 701: ypb.HotPatchTemplate
+	(*HotPatchTemplateRequest)(nil),                           // 702: ypb.HotPatchTemplateRequest
+	(*UpdateHotPatchTemplateRequest)(nil),                     // 703: ypb.UpdateHotPatchTemplateRequest
+	(*DeleteHotPatchTemplateRequest)(nil),                     // 704: ypb.DeleteHotPatchTemplateRequest
+	(*CreateHotPatchTemplateResponse)(nil),                    // 705: ypb.CreateHotPatchTemplateResponse
+	(*DeleteHotPatchTemplateResponse)(nil),                    // 706: ypb.DeleteHotPatchTemplateResponse
+	(*UpdateHotPatchTemplateResponse)(nil),                    // 707: ypb.UpdateHotPatchTemplateResponse
+	(*QueryHotPatchTemplateResponse)(nil),                     // 708: ypb.QueryHotPatchTemplateResponse
+	(*QueryHotPatchTemplateListRequest)(nil),                  // 709: ypb.QueryHotPatchTemplateListRequest
+	(*QueryHotPatchTemplateListResponse)(nil),                 // 710: ypb.QueryHotPatchTemplateListResponse
+	(*GroupTableColumnRequest)(nil),                           // 711: ypb.GroupTableColumnRequest
+	(*GroupTableColumnResponse)(nil),                          // 712: ypb.GroupTableColumnResponse
+	(*UploadHotPatchTemplateToOnlineRequest)(nil),             // 713: ypb.UploadHotPatchTemplateToOnlineRequest
+	(*DownloadHotPatchTemplateRequest)(nil),                   // 714: ypb.DownloadHotPatchTemplateRequest
+	(*ExportHTTPFlowStreamRequest)(nil),                       // 715: ypb.ExportHTTPFlowStreamRequest
+	(*ExportHTTPFlowStreamResponse)(nil),                      // 716: ypb.ExportHTTPFlowStreamResponse
+	(*ImportHTTPFlowStreamRequest)(nil),                       // 717: ypb.ImportHTTPFlowStreamRequest
+	(*ImportHTTPFlowStreamResponse)(nil),                      // 718: ypb.ImportHTTPFlowStreamResponse
+	(*Note)(nil),                                              // 719: ypb.Note
+	(*NoteContent)(nil),                                       // 720: ypb.NoteContent
+	(*NoteFilter)(nil),                                        // 721: ypb.NoteFilter
+	(*CreateNoteRequest)(nil),                                 // 722: ypb.CreateNoteRequest
+	(*CreateNoteResponse)(nil),                                // 723: ypb.CreateNoteResponse
+	(*UpdateNoteRequest)(nil),                                 // 724: ypb.UpdateNoteRequest
+	(*DeleteNoteRequest)(nil),                                 // 725: ypb.DeleteNoteRequest
+	(*QueryNoteRequest)(nil),                                  // 726: ypb.QueryNoteRequest
+	(*QueryNoteResponse)(nil),                                 // 727: ypb.QueryNoteResponse
+	(*SearchNoteContentRequest)(nil),                          // 728: ypb.SearchNoteContentRequest
+	(*SearchNoteContentResponse)(nil),                         // 729: ypb.SearchNoteContentResponse
+	(*ImportNoteRequest)(nil),                                 // 730: ypb.ImportNoteRequest
+	(*ImportNoteResponse)(nil),                                // 731: ypb.ImportNoteResponse
+	(*ExportNoteRequest)(nil),                                 // 732: ypb.ExportNoteRequest
+	(*ExportNoteResponse)(nil),                                // 733: ypb.ExportNoteResponse
+	(*ListAiModelRequest)(nil),                                // 734: ypb.ListAiModelRequest
+	(*ListAiModelResponse)(nil),                               // 735: ypb.ListAiModelResponse
+	(*IsLlamaServerReadyResponse)(nil),                        // 736: ypb.IsLlamaServerReadyResponse
+	(*IsLocalModelReadyRequest)(nil),                          // 737: ypb.IsLocalModelReadyRequest
+	(*IsLocalModelReadyResponse)(nil),                         // 738: ypb.IsLocalModelReadyResponse
+	(*InstallLlamaServerRequest)(nil),                         // 739: ypb.InstallLlamaServerRequest
+	(*StartLocalModelRequest)(nil),                            // 740: ypb.StartLocalModelRequest
+	(*DownloadLocalModelRequest)(nil),                         // 741: ypb.DownloadLocalModelRequest
+	(*LocalModelConfig)(nil),                                  // 742: ypb.LocalModelConfig
+	(*GetSupportedLocalModelsResponse)(nil),                   // 743: ypb.GetSupportedLocalModelsResponse
+	(*MITMV2Request)(nil),                                     // 744: ypb.MITMV2Request
+	(*MITMV2Response)(nil),                                    // 745: ypb.MITMV2Response
+	(*SingleManualHijackControlMessage)(nil),                  // 746: ypb.SingleManualHijackControlMessage
+	(*SingleManualHijackInfoMessage)(nil),                     // 747: ypb.SingleManualHijackInfoMessage
+	(*QueryMITMReplacerRulesRequest)(nil),                     // 748: ypb.QueryMITMReplacerRulesRequest
+	(*QueryMITMReplacerRulesResponse)(nil),                    // 749: ypb.QueryMITMReplacerRulesResponse
+	nil,                                                       // 750: ypb.ExtractDataToFileRequest.DataEntry
+	nil,                                                       // 751: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry
+	nil,                                                       // 752: ypb.WebShell.HeadersEntry
+	nil,                                                       // 753: ypb.WebShell.PostsEntry
+	nil,                                                       // 754: ypb.UpdateWebShellRequest.HeadersEntry
+	nil,                                                       // 755: ypb.UpdateWebShellRequest.PostsEntry
+	nil,                                                       // 756: ypb.SyntaxFlowRule.AlertMsgEntry
+	nil,                                                       // 757: ypb.AlertMessage.ExtraEntry
+	nil,                                                       // 758: ypb.SyntaxFlowRuleInput.AlertMsgEntry
 }
 var file_yakgrpc_proto_depIdxs = []int32{
 	565, // 0: ypb.ExecBatchYakScriptRequest.ExtraParams:type_name -> ypb.ExecParamItem
@@ -59429,7 +59712,7 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	451, // 136: ypb.QueryYakScriptByNamesResponse.Data:type_name -> ypb.YakScript
 	451, // 137: ypb.QueryYakScriptByIsCoreResponse.Data:type_name -> ypb.YakScript
 	262, // 138: ypb.YakScriptRiskTypeListResponse.Data:type_name -> ypb.RiskTypeLists
-	747, // 139: ypb.ExtractDataToFileRequest.Data:type_name -> ypb.ExtractDataToFileRequest.DataEntry
+	750, // 139: ypb.ExtractDataToFileRequest.Data:type_name -> ypb.ExtractDataToFileRequest.DataEntry
 	553, // 140: ypb.MITMContentReplacers.Rules:type_name -> ypb.MITMContentReplacer
 	447, // 141: ypb.ExecYakitPluginsByYakScriptFilterRequest.Filter:type_name -> ypb.QueryYakScriptRequest
 	565, // 142: ypb.ExecYakitPluginsByYakScriptFilterRequest.ExtraParams:type_name -> ypb.ExecParamItem
@@ -59443,7 +59726,7 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	288, // 150: ypb.RiskTableStats.RiskLevelStats:type_name -> ypb.Fields
 	287, // 151: ypb.Fields.Values:type_name -> ypb.FieldName
 	289, // 152: ypb.YsoOptionsWithVerbose.Options:type_name -> ypb.YsoOption
-	748, // 153: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptions:type_name -> ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry
+	751, // 153: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptions:type_name -> ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry
 	292, // 154: ypb.YsoClassOptionsResponseWithVerbose.Options:type_name -> ypb.YsoClassGeneraterOptionsWithVerbose
 	294, // 155: ypb.YsoClassOptionsResponse.Options:type_name -> ypb.YsoClassGeneraterOptions
 	292, // 156: ypb.YsoOptionsRequerstWithVerbose.Options:type_name -> ypb.YsoClassGeneraterOptionsWithVerbose
@@ -59455,8 +59738,8 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	309, // 162: ypb.HistoryHTTPFuzzerTasksResponse.Data:type_name -> ypb.HistoryHTTPFuzzerTaskDetail
 	515, // 163: ypb.HistoryHTTPFuzzerTasksResponse.Pagination:type_name -> ypb.Paging
 	515, // 164: ypb.QueryHistoryHTTPFuzzerTaskExParams.Pagination:type_name -> ypb.Paging
-	749, // 165: ypb.WebShell.Headers:type_name -> ypb.WebShell.HeadersEntry
-	750, // 166: ypb.WebShell.Posts:type_name -> ypb.WebShell.PostsEntry
+	752, // 165: ypb.WebShell.Headers:type_name -> ypb.WebShell.HeadersEntry
+	753, // 166: ypb.WebShell.Posts:type_name -> ypb.WebShell.PostsEntry
 	318, // 167: ypb.WebShell.ShellOptions:type_name -> ypb.ShellOptions
 	2,   // 168: ypb.ShellGenerate.EncMode:type_name -> ypb.EncMode
 	1,   // 169: ypb.ShellGenerate.Script:type_name -> ypb.ShellScript
@@ -59464,8 +59747,8 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	515, // 171: ypb.QueryWebShellsResponse.Pagination:type_name -> ypb.Paging
 	316, // 172: ypb.QueryWebShellsResponse.Data:type_name -> ypb.WebShell
 	318, // 173: ypb.UpdateWebShellRequest.ShellOptions:type_name -> ypb.ShellOptions
-	751, // 174: ypb.UpdateWebShellRequest.Headers:type_name -> ypb.UpdateWebShellRequest.HeadersEntry
-	752, // 175: ypb.UpdateWebShellRequest.Posts:type_name -> ypb.UpdateWebShellRequest.PostsEntry
+	754, // 174: ypb.UpdateWebShellRequest.Headers:type_name -> ypb.UpdateWebShellRequest.HeadersEntry
+	755, // 175: ypb.UpdateWebShellRequest.Posts:type_name -> ypb.UpdateWebShellRequest.PostsEntry
 	329, // 176: ypb.QueryDNSLogByTokenResponse.Events:type_name -> ypb.DNSLogEvent
 	333, // 177: ypb.AvailableLocalAddrResponse.Interfaces:type_name -> ypb.NetInterface
 	351, // 178: ypb.ConfigGlobalReverseParams.ConnectParams:type_name -> ypb.GetTunnelServerExternalIPParams
@@ -59655,9 +59938,9 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	610, // 362: ypb.ExportFingerprintRequest.Filter:type_name -> ypb.FingerprintFilter
 	515, // 363: ypb.QuerySyntaxFlowRuleRequest.Pagination:type_name -> ypb.Paging
 	629, // 364: ypb.QuerySyntaxFlowRuleRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	753, // 365: ypb.SyntaxFlowRule.AlertMsg:type_name -> ypb.SyntaxFlowRule.AlertMsgEntry
-	754, // 366: ypb.AlertMessage.Extra:type_name -> ypb.AlertMessage.ExtraEntry
-	755, // 367: ypb.SyntaxFlowRuleInput.AlertMsg:type_name -> ypb.SyntaxFlowRuleInput.AlertMsgEntry
+	756, // 365: ypb.SyntaxFlowRule.AlertMsg:type_name -> ypb.SyntaxFlowRule.AlertMsgEntry
+	757, // 366: ypb.AlertMessage.Extra:type_name -> ypb.AlertMessage.ExtraEntry
+	758, // 367: ypb.SyntaxFlowRuleInput.AlertMsg:type_name -> ypb.SyntaxFlowRuleInput.AlertMsgEntry
 	515, // 368: ypb.QuerySSAProgramRequest.Paging:type_name -> ypb.Paging
 	515, // 369: ypb.QuerySSAProgramRequest.Pagination:type_name -> ypb.Paging
 	632, // 370: ypb.QuerySSAProgramRequest.Filter:type_name -> ypb.SSAProgramFilter
@@ -59667,1080 +59950,1083 @@ var file_yakgrpc_proto_depIdxs = []int32{
 	515, // 374: ypb.QuerySSAProgramResponse.Pagination:type_name -> ypb.Paging
 	630, // 375: ypb.QuerySSAProgramResponse.Programs:type_name -> ypb.SSAProgram
 	630, // 376: ypb.QuerySSAProgramResponse.Data:type_name -> ypb.SSAProgram
-	628, // 377: ypb.CreateSyntaxFlowRuleRequest.SyntaxFlowInput:type_name -> ypb.SyntaxFlowRuleInput
-	607, // 378: ypb.CreateSyntaxFlowRuleResponse.Message:type_name -> ypb.DbOperateMessage
-	626, // 379: ypb.CreateSyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
-	628, // 380: ypb.UpdateSyntaxFlowRuleRequest.SyntaxFlowInput:type_name -> ypb.SyntaxFlowRuleInput
-	607, // 381: ypb.UpdateSyntaxFlowRuleResponse.Message:type_name -> ypb.DbOperateMessage
-	626, // 382: ypb.UpdateSyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
-	515, // 383: ypb.QuerySyntaxFlowRuleResponse.Pagination:type_name -> ypb.Paging
-	607, // 384: ypb.QuerySyntaxFlowRuleResponse.DbMessage:type_name -> ypb.DbOperateMessage
-	626, // 385: ypb.QuerySyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
-	629, // 386: ypb.DeleteSyntaxFlowRuleRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	647, // 387: ypb.QuerySyntaxFlowRuleGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleGroupFilter
-	515, // 388: ypb.QuerySyntaxFlowRuleGroupRequest.Pagination:type_name -> ypb.Paging
-	648, // 389: ypb.QuerySyntaxFlowRuleGroupResponse.Group:type_name -> ypb.SyntaxFlowGroup
-	515, // 390: ypb.QuerySyntaxFlowRuleGroupResponse.Pagination:type_name -> ypb.Paging
-	629, // 391: ypb.UpdateSyntaxFlowRuleAndGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	629, // 392: ypb.QuerySyntaxFlowSameGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	648, // 393: ypb.QuerySyntaxFlowSameGroupResponse.Group:type_name -> ypb.SyntaxFlowGroup
-	647, // 394: ypb.DeleteSyntaxFlowRuleGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleGroupFilter
-	515, // 395: ypb.SyntaxFlowRuleToOnlineRequest.Pagination:type_name -> ypb.Paging
-	629, // 396: ypb.SyntaxFlowRuleToOnlineRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	629, // 397: ypb.DownloadSyntaxFlowRuleRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	629, // 398: ypb.SyntaxFlowScanRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	628, // 399: ypb.SyntaxFlowScanRequest.RuleInput:type_name -> ypb.SyntaxFlowRuleInput
-	515, // 400: ypb.QuerySyntaxFlowScanTaskRequest.Pagination:type_name -> ypb.Paging
-	662, // 401: ypb.QuerySyntaxFlowScanTaskRequest.Filter:type_name -> ypb.SyntaxFlowScanTaskFilter
-	515, // 402: ypb.QuerySyntaxFlowScanTaskResponse.Pagination:type_name -> ypb.Paging
-	664, // 403: ypb.QuerySyntaxFlowScanTaskResponse.Data:type_name -> ypb.SyntaxFlowScanTask
-	660, // 404: ypb.SyntaxFlowScanTask.Config:type_name -> ypb.SyntaxFlowScanRequest
-	662, // 405: ypb.DeleteSyntaxFlowScanTaskRequest.Filter:type_name -> ypb.SyntaxFlowScanTaskFilter
-	567, // 406: ypb.SyntaxFlowScanResponse.ExecResult:type_name -> ypb.ExecResult
-	670, // 407: ypb.SyntaxFlowScanResponse.Result:type_name -> ypb.SyntaxFlowResult
-	337, // 408: ypb.SyntaxFlowScanResponse.risks:type_name -> ypb.Risk
-	683, // 409: ypb.SyntaxFlowScanResponse.SSARisks:type_name -> ypb.SSARisk
-	515, // 410: ypb.QuerySyntaxFlowResultRequest.Pagination:type_name -> ypb.Paging
-	667, // 411: ypb.QuerySyntaxFlowResultRequest.Filter:type_name -> ypb.SyntaxFlowResultFilter
-	515, // 412: ypb.QuerySyntaxFlowResultResponse.Pagination:type_name -> ypb.Paging
-	607, // 413: ypb.QuerySyntaxFlowResultResponse.DbMessage:type_name -> ypb.DbOperateMessage
-	670, // 414: ypb.QuerySyntaxFlowResultResponse.Results:type_name -> ypb.SyntaxFlowResult
-	667, // 415: ypb.DeleteSyntaxFlowResultRequest.Filter:type_name -> ypb.SyntaxFlowResultFilter
-	607, // 416: ypb.DeleteSyntaxFlowResultResponse.Message:type_name -> ypb.DbOperateMessage
-	507, // 417: ypb.PluginEnvData.Env:type_name -> ypb.KVPair
-	679, // 418: ypb.GetAllFuzztagInfoResponse.Data:type_name -> ypb.FuzztagInfo
-	678, // 419: ypb.FuzztagInfo.ArgumentTypes:type_name -> ypb.FuzztagArgumentType
-	189, // 420: ypb.GenerateFuzztagRequest.Range:type_name -> ypb.Range
-	6,   // 421: ypb.GenerateFuzztagResponse.Status:type_name -> ypb.GeneralResponse
-	515, // 422: ypb.QuerySSARisksRequest.Pagination:type_name -> ypb.Paging
-	684, // 423: ypb.QuerySSARisksRequest.Filter:type_name -> ypb.SSARisksFilter
-	515, // 424: ypb.QuerySSARisksResponse.Pagination:type_name -> ypb.Paging
-	683, // 425: ypb.QuerySSARisksResponse.Data:type_name -> ypb.SSARisk
-	683, // 426: ypb.QueryNewSSARisksResponse.Data:type_name -> ypb.SSARisk
-	684, // 427: ypb.DeleteSSARisksRequest.Filter:type_name -> ypb.SSARisksFilter
-	344, // 428: ypb.SSARiskFieldGroupResponse.FileField:type_name -> ypb.FieldGroup
-	287, // 429: ypb.SSARiskFieldGroupResponse.SeverityField:type_name -> ypb.FieldName
-	287, // 430: ypb.SSARiskFieldGroupResponse.RiskTypeField:type_name -> ypb.FieldName
-	684, // 431: ypb.NewSSARiskReadRequest.Filter:type_name -> ypb.SSARisksFilter
-	684, // 432: ypb.SSARiskFeedbackToOnlineRequest.Filter:type_name -> ypb.SSARisksFilter
-	629, // 433: ypb.ExportSyntaxFlowsRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
-	699, // 434: ypb.UpdateHotPatchTemplateRequest.Condition:type_name -> ypb.HotPatchTemplateRequest
-	698, // 435: ypb.UpdateHotPatchTemplateRequest.Data:type_name -> ypb.HotPatchTemplate
-	699, // 436: ypb.DeleteHotPatchTemplateRequest.Condition:type_name -> ypb.HotPatchTemplateRequest
-	607, // 437: ypb.CreateHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
-	607, // 438: ypb.DeleteHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
-	607, // 439: ypb.UpdateHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
-	607, // 440: ypb.QueryHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
-	698, // 441: ypb.QueryHotPatchTemplateResponse.Data:type_name -> ypb.HotPatchTemplate
-	515, // 442: ypb.QueryHotPatchTemplateListResponse.Pagination:type_name -> ypb.Paging
-	520, // 443: ypb.ExportHTTPFlowStreamRequest.Filter:type_name -> ypb.QueryHTTPFlowRequest
-	716, // 444: ypb.NoteContent.Note:type_name -> ypb.Note
-	607, // 445: ypb.CreateNoteResponse.Message:type_name -> ypb.DbOperateMessage
-	718, // 446: ypb.UpdateNoteRequest.Filter:type_name -> ypb.NoteFilter
-	718, // 447: ypb.DeleteNoteRequest.Filter:type_name -> ypb.NoteFilter
-	718, // 448: ypb.QueryNoteRequest.Filter:type_name -> ypb.NoteFilter
-	515, // 449: ypb.QueryNoteRequest.Pagination:type_name -> ypb.Paging
-	515, // 450: ypb.QueryNoteResponse.Pagination:type_name -> ypb.Paging
-	716, // 451: ypb.QueryNoteResponse.Data:type_name -> ypb.Note
-	515, // 452: ypb.SearchNoteContentRequest.Pagination:type_name -> ypb.Paging
-	515, // 453: ypb.SearchNoteContentResponse.Pagination:type_name -> ypb.Paging
-	717, // 454: ypb.SearchNoteContentResponse.Data:type_name -> ypb.NoteContent
-	718, // 455: ypb.ExportNoteRequest.Filter:type_name -> ypb.NoteFilter
-	739, // 456: ypb.GetSupportedLocalModelsResponse.Models:type_name -> ypb.LocalModelConfig
-	552, // 457: ypb.MITMV2Request.Certificates:type_name -> ypb.Certificate
-	507, // 458: ypb.MITMV2Request.hosts:type_name -> ypb.KVPair
-	551, // 459: ypb.MITMV2Request.FilterData:type_name -> ypb.MITMFilterData
-	551, // 460: ypb.MITMV2Request.HijackFilterData:type_name -> ypb.MITMFilterData
-	553, // 461: ypb.MITMV2Request.Replacers:type_name -> ypb.MITMContentReplacer
-	565, // 462: ypb.MITMV2Request.YakScriptParams:type_name -> ypb.ExecParamItem
-	554, // 463: ypb.MITMV2Request.RemoveHookParams:type_name -> ypb.RemoveHookParams
-	743, // 464: ypb.MITMV2Request.ManualHijackMessage:type_name -> ypb.SingleManualHijackControlMessage
-	551, // 465: ypb.MITMV2Response.FilterData:type_name -> ypb.MITMFilterData
-	553, // 466: ypb.MITMV2Response.Replacers:type_name -> ypb.MITMContentReplacer
-	567, // 467: ypb.MITMV2Response.Message:type_name -> ypb.ExecResult
-	557, // 468: ypb.MITMV2Response.Hooks:type_name -> ypb.YakScriptHooks
-	744, // 469: ypb.MITMV2Response.ManualHijackList:type_name -> ypb.SingleManualHijackInfoMessage
-	556, // 470: ypb.SingleManualHijackInfoMessage.TraceInfo:type_name -> ypb.TraceInfo
-	265, // 471: ypb.QueryMITMReplacerRulesResponse.Rules:type_name -> ypb.MITMContentReplacers
-	264, // 472: ypb.ExtractDataToFileRequest.DataEntry.value:type_name -> ypb.ExtractableData
-	293, // 473: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry.value:type_name -> ypb.YsoClassOptionsResponseWithVerbose
-	627, // 474: ypb.SyntaxFlowRule.AlertMsgEntry.value:type_name -> ypb.AlertMessage
-	627, // 475: ypb.SyntaxFlowRuleInput.AlertMsgEntry.value:type_name -> ypb.AlertMessage
-	4,   // 476: ypb.Yak.Version:input_type -> ypb.Empty
-	583, // 477: ypb.Yak.YakVersionAtLeast:input_type -> ypb.YakVersionAtLeastRequest
-	559, // 478: ypb.Yak.Echo:input_type -> ypb.EchoRequest
-	561, // 479: ypb.Yak.Handshake:input_type -> ypb.HandshakeRequest
-	4,   // 480: ypb.Yak.VerifySystemCertificate:input_type -> ypb.Empty
-	549, // 481: ypb.Yak.MITM:input_type -> ypb.MITMRequest
-	547, // 482: ypb.Yak.SetMITMFilter:input_type -> ypb.SetMITMFilterRequest
-	4,   // 483: ypb.Yak.GetMITMFilter:input_type -> ypb.Empty
-	4,   // 484: ypb.Yak.ResetMITMFilter:input_type -> ypb.Empty
-	4,   // 485: ypb.Yak.DownloadMITMCert:input_type -> ypb.Empty
-	741, // 486: ypb.Yak.MITMV2:input_type -> ypb.MITMV2Request
-	563, // 487: ypb.Yak.OpenPort:input_type -> ypb.Input
-	566, // 488: ypb.Yak.Exec:input_type -> ypb.ExecRequest
-	485, // 489: ypb.Yak.QueryExecHistory:input_type -> ypb.ExecHistoryRequest
-	4,   // 490: ypb.Yak.RemoveExecHistory:input_type -> ypb.Empty
-	4,   // 491: ypb.Yak.LoadNucleiTemplates:input_type -> ypb.Empty
-	4,   // 492: ypb.Yak.AutoUpdateYakModule:input_type -> ypb.Empty
-	566, // 493: ypb.Yak.ExecYakScript:input_type -> ypb.ExecRequest
-	8,   // 494: ypb.Yak.ExecBatchYakScript:input_type -> ypb.ExecBatchYakScriptRequest
-	4,   // 495: ypb.Yak.GetExecBatchYakScriptUnfinishedTask:input_type -> ypb.Empty
-	230, // 496: ypb.Yak.GetExecBatchYakScriptUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
-	230, // 497: ypb.Yak.PopExecBatchYakScriptUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
-	231, // 498: ypb.Yak.RecoverExecBatchYakScriptUnfinishedTask:input_type -> ypb.RecoverExecBatchYakScriptUnfinishedTaskRequest
-	447, // 499: ypb.Yak.QueryYakScript:input_type -> ypb.QueryYakScriptRequest
-	447, // 500: ypb.Yak.QueryYakScriptByYakScriptName:input_type -> ypb.QueryYakScriptRequest
-	451, // 501: ypb.Yak.SaveYakScript:input_type -> ypb.YakScript
-	7,   // 502: ypb.Yak.DeleteYakScript:input_type -> ypb.DeleteYakScriptRequest
-	10,  // 503: ypb.Yak.GetYakScriptById:input_type -> ypb.GetYakScriptByIdRequest
-	11,  // 504: ypb.Yak.GetYakScriptByName:input_type -> ypb.GetYakScriptByNameRequest
-	12,  // 505: ypb.Yak.GetYakScriptByOnlineID:input_type -> ypb.GetYakScriptByOnlineIDRequest
-	7,   // 506: ypb.Yak.IgnoreYakScript:input_type -> ypb.DeleteYakScriptRequest
-	7,   // 507: ypb.Yak.UnIgnoreYakScript:input_type -> ypb.DeleteYakScriptRequest
-	384, // 508: ypb.Yak.ExportYakScript:input_type -> ypb.ExportYakScriptRequest
-	385, // 509: ypb.Yak.ExportYakScriptStream:input_type -> ypb.ExportYakScriptStreamRequest
-	386, // 510: ypb.Yak.ImportYakScriptStream:input_type -> ypb.ImportYakScriptStreamRequest
-	314, // 511: ypb.Yak.ExecutePacketYakScript:input_type -> ypb.ExecutePacketYakScriptParams
-	315, // 512: ypb.Yak.ExecuteBatchPacketYakScript:input_type -> ypb.ExecuteBatchPacketYakScriptParams
-	4,   // 513: ypb.Yak.GetYakScriptTags:input_type -> ypb.Empty
-	252, // 514: ypb.Yak.QueryYakScriptLocalAndUser:input_type -> ypb.QueryYakScriptLocalAndUserRequest
-	254, // 515: ypb.Yak.QueryYakScriptByOnlineGroup:input_type -> ypb.QueryYakScriptByOnlineGroupRequest
-	4,   // 516: ypb.Yak.QueryYakScriptLocalAll:input_type -> ypb.Empty
-	255, // 517: ypb.Yak.QueryYakScriptByNames:input_type -> ypb.QueryYakScriptByNamesRequest
-	256, // 518: ypb.Yak.QueryYakScriptByIsCore:input_type -> ypb.QueryYakScriptByIsCoreRequest
-	259, // 519: ypb.Yak.QueryYakScriptRiskDetailByCWE:input_type -> ypb.QueryYakScriptRiskDetailByCWERequest
-	4,   // 520: ypb.Yak.YakScriptRiskTypeList:input_type -> ypb.Empty
-	453, // 521: ypb.Yak.SaveNewYakScript:input_type -> ypb.SaveNewYakScriptRequest
-	454, // 522: ypb.Yak.SaveYakScriptToOnline:input_type -> ypb.SaveYakScriptToOnlineRequest
-	457, // 523: ypb.Yak.ExportLocalYakScript:input_type -> ypb.ExportLocalYakScriptRequest
-	457, // 524: ypb.Yak.ExportLocalYakScriptStream:input_type -> ypb.ExportLocalYakScriptRequest
-	460, // 525: ypb.Yak.ImportYakScript:input_type -> ypb.ImportYakScriptRequest
-	462, // 526: ypb.Yak.SetYakScriptSkipUpdate:input_type -> ypb.SetYakScriptSkipUpdateRequest
-	447, // 527: ypb.Yak.QueryYakScriptSkipUpdate:input_type -> ypb.QueryYakScriptRequest
-	464, // 528: ypb.Yak.QueryYakScriptGroup:input_type -> ypb.QueryYakScriptGroupRequest
-	467, // 529: ypb.Yak.SaveYakScriptGroup:input_type -> ypb.SaveYakScriptGroupRequest
-	468, // 530: ypb.Yak.RenameYakScriptGroup:input_type -> ypb.RenameYakScriptGroupRequest
-	469, // 531: ypb.Yak.DeleteYakScriptGroup:input_type -> ypb.DeleteYakScriptGroupRequest
-	447, // 532: ypb.Yak.GetYakScriptGroup:input_type -> ypb.QueryYakScriptRequest
-	471, // 533: ypb.Yak.ResetYakScriptGroup:input_type -> ypb.ResetYakScriptGroupRequest
-	472, // 534: ypb.Yak.SetGroup:input_type -> ypb.SetGroupRequest
-	516, // 535: ypb.Yak.GetHTTPFlowByHash:input_type -> ypb.GetHTTPFlowByHashRequest
-	517, // 536: ypb.Yak.GetHTTPFlowById:input_type -> ypb.GetHTTPFlowByIdRequest
-	519, // 537: ypb.Yak.GetHTTPFlowBodyById:input_type -> ypb.GetHTTPFlowBodyByIdRequest
-	518, // 538: ypb.Yak.GetHTTPFlowByIds:input_type -> ypb.GetHTTPFlowByIdsRequest
-	520, // 539: ypb.Yak.QueryHTTPFlows:input_type -> ypb.QueryHTTPFlowRequest
-	530, // 540: ypb.Yak.DeleteHTTPFlows:input_type -> ypb.DeleteHTTPFlowRequest
-	278, // 541: ypb.Yak.SetTagForHTTPFlow:input_type -> ypb.SetTagForHTTPFlowRequest
-	531, // 542: ypb.Yak.QueryHTTPFlowsIds:input_type -> ypb.QueryHTTPFlowsIdsRequest
-	539, // 543: ypb.Yak.HTTPFlowsFieldGroup:input_type -> ypb.HTTPFlowsFieldGroupRequest
-	541, // 544: ypb.Yak.HTTPFlowsShare:input_type -> ypb.HTTPFlowsShareRequest
-	543, // 545: ypb.Yak.HTTPFlowsExtract:input_type -> ypb.HTTPFlowsExtractRequest
-	571, // 546: ypb.Yak.GetHTTPFlowBare:input_type -> ypb.HTTPFlowBareRequest
-	528, // 547: ypb.Yak.ExportHTTPFlows:input_type -> ypb.ExportHTTPFlowsRequest
-	521, // 548: ypb.Yak.HTTPFlowsToOnline:input_type -> ypb.HTTPFlowsToOnlineRequest
-	520, // 549: ypb.Yak.QueryHTTPFlowsProcessNames:input_type -> ypb.QueryHTTPFlowRequest
-	522, // 550: ypb.Yak.AnalyzeHTTPFlow:input_type -> ypb.AnalyzeHTTPFlowRequest
-	505, // 551: ypb.Yak.ExtractUrl:input_type -> ypb.FuzzerRequest
-	308, // 552: ypb.Yak.GetHistoryHTTPFuzzerTask:input_type -> ypb.GetHistoryHTTPFuzzerTaskRequest
-	4,   // 553: ypb.Yak.QueryHistoryHTTPFuzzerTask:input_type -> ypb.Empty
-	313, // 554: ypb.Yak.QueryHistoryHTTPFuzzerTaskEx:input_type -> ypb.QueryHistoryHTTPFuzzerTaskExParams
-	284, // 555: ypb.Yak.DeleteHistoryHTTPFuzzerTask:input_type -> ypb.DeleteHistoryHTTPFuzzerTaskRequest
-	505, // 556: ypb.Yak.HTTPFuzzer:input_type -> ypb.FuzzerRequest
-	504, // 557: ypb.Yak.HTTPFuzzerSequence:input_type -> ypb.FuzzerRequests
-	501, // 558: ypb.Yak.PreloadHTTPFuzzerParams:input_type -> ypb.PreloadHTTPFuzzerParamsRequest
-	494, // 559: ypb.Yak.RenderVariables:input_type -> ypb.RenderVariablesRequest
-	496, // 560: ypb.Yak.MatchHTTPResponse:input_type -> ypb.MatchHTTPResponseParams
-	500, // 561: ypb.Yak.ExtractHTTPResponse:input_type -> ypb.ExtractHTTPResponseParams
-	509, // 562: ypb.Yak.RedirectRequest:input_type -> ypb.RedirectRequestParams
-	360, // 563: ypb.Yak.HTTPRequestMutate:input_type -> ypb.HTTPRequestMutateParams
-	361, // 564: ypb.Yak.HTTPResponseMutate:input_type -> ypb.HTTPResponseMutateParams
-	243, // 565: ypb.Yak.FixUploadPacket:input_type -> ypb.FixUploadPacketRequest
-	243, // 566: ypb.Yak.IsMultipartFormDataRequest:input_type -> ypb.FixUploadPacketRequest
-	178, // 567: ypb.Yak.GenerateExtractRule:input_type -> ypb.GenerateExtractRuleRequest
-	177, // 568: ypb.Yak.ExtractData:input_type -> ypb.ExtractDataRequest
-	573, // 569: ypb.Yak.ImportHTTPFuzzerTaskFromYaml:input_type -> ypb.ImportHTTPFuzzerTaskFromYamlRequest
-	575, // 570: ypb.Yak.ExportHTTPFuzzerTaskToYaml:input_type -> ypb.ExportHTTPFuzzerTaskToYamlRequest
-	577, // 571: ypb.Yak.RenderHTTPFuzzerPacket:input_type -> ypb.RenderHTTPFuzzerPacketRequest
-	168, // 572: ypb.Yak.SaveFuzzerLabel:input_type -> ypb.SaveFuzzerLabelRequest
-	4,   // 573: ypb.Yak.QueryFuzzerLabel:input_type -> ypb.Empty
-	171, // 574: ypb.Yak.DeleteFuzzerLabel:input_type -> ypb.DeleteFuzzerLabelRequest
-	172, // 575: ypb.Yak.SaveFuzzerConfig:input_type -> ypb.SaveFuzzerConfigRequest
-	173, // 576: ypb.Yak.QueryFuzzerConfig:input_type -> ypb.QueryFuzzerConfigRequest
-	176, // 577: ypb.Yak.DeleteFuzzerConfig:input_type -> ypb.DeleteFuzzerConfigRequest
-	181, // 578: ypb.Yak.QueryHTTPFuzzerResponseByTaskId:input_type -> ypb.QueryHTTPFuzzerResponseByTaskIdRequest
-	185, // 579: ypb.Yak.CreateWebsocketFuzzer:input_type -> ypb.ClientWebsocketRequest
-	183, // 580: ypb.Yak.QueryWebsocketFlowByHTTPFlowWebsocketHash:input_type -> ypb.QueryWebsocketFlowByHTTPFlowWebsocketHashRequest
-	184, // 581: ypb.Yak.DeleteWebsocketFlowByHTTPFlowWebsocketHash:input_type -> ypb.DeleteWebsocketFlowByHTTPFlowWebsocketHashRequest
-	4,   // 582: ypb.Yak.DeleteWebsocketFlowAll:input_type -> ypb.Empty
-	512, // 583: ypb.Yak.ConvertFuzzerResponseToHTTPFlow:input_type -> ypb.FuzzerResponse
-	488, // 584: ypb.Yak.StringFuzzer:input_type -> ypb.StringFuzzerRequest
-	490, // 585: ypb.Yak.HTTPRequestAnalyzer:input_type -> ypb.HTTPRequestAnalysisMaterial
-	475, // 586: ypb.Yak.Codec:input_type -> ypb.CodecRequest
-	477, // 587: ypb.Yak.NewCodec:input_type -> ypb.CodecRequestFlow
-	4,   // 588: ypb.Yak.GetAllCodecMethods:input_type -> ypb.Empty
-	478, // 589: ypb.Yak.SaveCodecFlow:input_type -> ypb.CustomizeCodecFlow
-	479, // 590: ypb.Yak.DeleteCodecFlow:input_type -> ypb.DeleteCodecFlowRequest
-	4,   // 591: ypb.Yak.GetAllCodecFlow:input_type -> ypb.Empty
-	75,  // 592: ypb.Yak.PacketPrettifyHelper:input_type -> ypb.PacketPrettifyHelperRequest
-	441, // 593: ypb.Yak.QueryPayload:input_type -> ypb.QueryPayloadRequest
-	439, // 594: ypb.Yak.QueryPayloadFromFile:input_type -> ypb.QueryPayloadFromFileRequest
-	429, // 595: ypb.Yak.DeletePayloadByFolder:input_type -> ypb.NameRequest
-	437, // 596: ypb.Yak.DeletePayloadByGroup:input_type -> ypb.DeletePayloadByGroupRequest
-	438, // 597: ypb.Yak.DeletePayload:input_type -> ypb.DeletePayloadRequest
-	433, // 598: ypb.Yak.SavePayload:input_type -> ypb.SavePayloadRequest
-	433, // 599: ypb.Yak.SavePayloadStream:input_type -> ypb.SavePayloadRequest
-	433, // 600: ypb.Yak.SavePayloadToFileStream:input_type -> ypb.SavePayloadRequest
-	433, // 601: ypb.Yak.SaveLargePayloadToFileStream:input_type -> ypb.SavePayloadRequest
-	428, // 602: ypb.Yak.RenamePayloadFolder:input_type -> ypb.RenameRequest
-	428, // 603: ypb.Yak.RenamePayloadGroup:input_type -> ypb.RenameRequest
-	434, // 604: ypb.Yak.UpdatePayload:input_type -> ypb.UpdatePayloadRequest
-	435, // 605: ypb.Yak.UpdatePayloadToFile:input_type -> ypb.UpdatePayloadToFileRequest
-	436, // 606: ypb.Yak.BackUpOrCopyPayloads:input_type -> ypb.BackUpOrCopyPayloadsRequest
-	4,   // 607: ypb.Yak.GetAllPayloadGroup:input_type -> ypb.Empty
-	432, // 608: ypb.Yak.UpdateAllPayloadGroup:input_type -> ypb.UpdateAllPayloadGroupRequest
-	444, // 609: ypb.Yak.GetAllPayload:input_type -> ypb.GetAllPayloadRequest
-	444, // 610: ypb.Yak.GetAllPayloadFromFile:input_type -> ypb.GetAllPayloadRequest
-	444, // 611: ypb.Yak.ExportAllPayload:input_type -> ypb.GetAllPayloadRequest
-	444, // 612: ypb.Yak.ExportAllPayloadFromFile:input_type -> ypb.GetAllPayloadRequest
-	429, // 613: ypb.Yak.CreatePayloadFolder:input_type -> ypb.NameRequest
-	429, // 614: ypb.Yak.RemoveDuplicatePayloads:input_type -> ypb.NameRequest
-	429, // 615: ypb.Yak.CoverPayloadGroupToDatabase:input_type -> ypb.NameRequest
-	429, // 616: ypb.Yak.ConvertPayloadGroupToDatabase:input_type -> ypb.NameRequest
-	4,   // 617: ypb.Yak.MigratePayloads:input_type -> ypb.Empty
-	4,   // 618: ypb.Yak.GetYakitCompletionRaw:input_type -> ypb.Empty
-	424, // 619: ypb.Yak.GetYakVMBuildInMethodCompletion:input_type -> ypb.GetYakVMBuildInMethodCompletionRequest
-	201, // 620: ypb.Yak.StaticAnalyzeError:input_type -> ypb.StaticAnalyzeErrorRequest
-	202, // 621: ypb.Yak.YaklangCompileAndFormat:input_type -> ypb.YaklangCompileAndFormatRequest
-	191, // 622: ypb.Yak.YaklangLanguageSuggestion:input_type -> ypb.YaklangLanguageSuggestionRequest
-	191, // 623: ypb.Yak.YaklangLanguageFind:input_type -> ypb.YaklangLanguageSuggestionRequest
-	682, // 624: ypb.Yak.FuzzTagSuggestion:input_type -> ypb.FuzzTagSuggestionRequest
-	190, // 625: ypb.Yak.YaklangInspectInformation:input_type -> ypb.YaklangInspectInformationRequest
-	200, // 626: ypb.Yak.YaklangGetCliCodeFromDatabase:input_type -> ypb.YaklangGetCliCodeFromDatabaseRequest
-	563, // 627: ypb.Yak.YaklangTerminal:input_type -> ypb.Input
-	418, // 628: ypb.Yak.PortScan:input_type -> ypb.PortScanRequest
-	4,   // 629: ypb.Yak.ViewPortScanCode:input_type -> ypb.Empty
-	416, // 630: ypb.Yak.SimpleDetect:input_type -> ypb.RecordPortScanRequest
-	416, // 631: ypb.Yak.SaveCancelSimpleDetect:input_type -> ypb.RecordPortScanRequest
-	417, // 632: ypb.Yak.SimpleDetectCreatReport:input_type -> ypb.CreatReportRequest
-	237, // 633: ypb.Yak.QuerySimpleDetectUnfinishedTask:input_type -> ypb.QueryUnfinishedTaskRequest
-	241, // 634: ypb.Yak.GetSimpleDetectRecordRequestById:input_type -> ypb.GetUnfinishedTaskDetailByIdRequest
-	238, // 635: ypb.Yak.DeleteSimpleDetectUnfinishedTask:input_type -> ypb.DeleteUnfinishedTaskRequest
-	242, // 636: ypb.Yak.RecoverSimpleDetectTask:input_type -> ypb.RecoverUnfinishedTaskRequest
-	4,   // 637: ypb.Yak.GetSimpleDetectUnfinishedTask:input_type -> ypb.Empty
-	230, // 638: ypb.Yak.GetSimpleDetectUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
-	230, // 639: ypb.Yak.PopSimpleDetectUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
-	231, // 640: ypb.Yak.RecoverSimpleDetectUnfinishedTask:input_type -> ypb.RecoverExecBatchYakScriptUnfinishedTaskRequest
-	420, // 641: ypb.Yak.QueryPorts:input_type -> ypb.QueryPortsRequest
-	419, // 642: ypb.Yak.DeletePorts:input_type -> ypb.DeletePortsRequest
-	363, // 643: ypb.Yak.QueryHosts:input_type -> ypb.QueryHostsRequest
-	364, // 644: ypb.Yak.DeleteHosts:input_type -> ypb.DeleteHostsRequest
-	366, // 645: ypb.Yak.QueryDomains:input_type -> ypb.QueryDomainsRequest
-	367, // 646: ypb.Yak.DeleteDomains:input_type -> ypb.DeleteDomainsRequest
-	4,   // 647: ypb.Yak.QueryPortsGroup:input_type -> ypb.Empty
-	412, // 648: ypb.Yak.UpdateFromYakitResource:input_type -> ypb.UpdateFromYakitResourceRequest
-	413, // 649: ypb.Yak.UpdateFromGithub:input_type -> ypb.UpdateFromGithubRequest
-	400, // 650: ypb.Yak.AddToMenu:input_type -> ypb.AddToMenuRequest
-	399, // 651: ypb.Yak.RemoveFromMenu:input_type -> ypb.RemoveFromMenuRequest
-	398, // 652: ypb.Yak.YakScriptIsInMenu:input_type -> ypb.YakScriptIsInMenuRequest
-	4,   // 653: ypb.Yak.GetAllMenuItem:input_type -> ypb.Empty
-	4,   // 654: ypb.Yak.DeleteAllMenuItem:input_type -> ypb.Empty
-	403, // 655: ypb.Yak.ImportMenuItem:input_type -> ypb.ImportMenuItemRequest
-	4,   // 656: ypb.Yak.ExportMenuItem:input_type -> ypb.Empty
-	396, // 657: ypb.Yak.GetMenuItemById:input_type -> ypb.GetMenuItemByIdRequest
-	392, // 658: ypb.Yak.QueryGroupsByYakScriptId:input_type -> ypb.QueryGroupsByYakScriptIdRequest
-	401, // 659: ypb.Yak.AddMenus:input_type -> ypb.AddMenuRequest
-	402, // 660: ypb.Yak.QueryAllMenuItem:input_type -> ypb.QueryAllMenuItemRequest
-	402, // 661: ypb.Yak.DeleteAllMenu:input_type -> ypb.QueryAllMenuItemRequest
-	405, // 662: ypb.Yak.AddToNavigation:input_type -> ypb.AddToNavigationRequest
-	408, // 663: ypb.Yak.GetAllNavigationItem:input_type -> ypb.GetAllNavigationRequest
-	408, // 664: ypb.Yak.DeleteAllNavigation:input_type -> ypb.GetAllNavigationRequest
-	410, // 665: ypb.Yak.AddOneNavigation:input_type -> ypb.AddOneNavigationRequest
-	411, // 666: ypb.Yak.QueryNavigationGroups:input_type -> ypb.QueryNavigationGroupsRequest
-	390, // 667: ypb.Yak.SaveMarkdownDocument:input_type -> ypb.SaveMarkdownDocumentRequest
-	389, // 668: ypb.Yak.GetMarkdownDocument:input_type -> ypb.GetMarkdownDocumentRequest
-	389, // 669: ypb.Yak.DeleteMarkdownDocument:input_type -> ypb.GetMarkdownDocumentRequest
-	381, // 670: ypb.Yak.StartBasicCrawler:input_type -> ypb.StartBasicCrawlerRequest
-	4,   // 671: ypb.Yak.ViewBasicCrawlerCode:input_type -> ypb.Empty
-	380, // 672: ypb.Yak.GenerateWebsiteTree:input_type -> ypb.GenerateWebsiteTreeRequest
-	377, // 673: ypb.Yak.QueryYakScriptExecResult:input_type -> ypb.QueryYakScriptExecResultRequest
-	4,   // 674: ypb.Yak.QueryYakScriptNameInExecResult:input_type -> ypb.Empty
-	375, // 675: ypb.Yak.DeleteYakScriptExecResult:input_type -> ypb.DeleteYakScriptExecResultRequest
-	4,   // 676: ypb.Yak.DeleteYakScriptExec:input_type -> ypb.Empty
-	359, // 677: ypb.Yak.StartBrute:input_type -> ypb.StartBruteParams
-	4,   // 678: ypb.Yak.GetAvailableBruteTypes:input_type -> ypb.Empty
-	351, // 679: ypb.Yak.GetTunnelServerExternalIP:input_type -> ypb.GetTunnelServerExternalIPParams
-	349, // 680: ypb.Yak.VerifyTunnelServerDomain:input_type -> ypb.VerifyTunnelServerDomainParams
-	353, // 681: ypb.Yak.StartFacades:input_type -> ypb.StartFacadesParams
-	356, // 682: ypb.Yak.StartFacadesWithYsoObject:input_type -> ypb.StartFacadesWithYsoParams
-	354, // 683: ypb.Yak.ApplyClassToFacades:input_type -> ypb.ApplyClassToFacadesParamsWithVerbose
-	302, // 684: ypb.Yak.BytesToBase64:input_type -> ypb.BytesToBase64Request
-	334, // 685: ypb.Yak.ConfigGlobalReverse:input_type -> ypb.ConfigGlobalReverseParams
-	4,   // 686: ypb.Yak.AvailableLocalAddr:input_type -> ypb.Empty
-	4,   // 687: ypb.Yak.GetGlobalReverseServer:input_type -> ypb.Empty
-	338, // 688: ypb.Yak.QueryRisks:input_type -> ypb.QueryRisksRequest
-	336, // 689: ypb.Yak.QueryRisk:input_type -> ypb.QueryRiskRequest
-	335, // 690: ypb.Yak.DeleteRisk:input_type -> ypb.DeleteRiskRequest
-	4,   // 691: ypb.Yak.QueryAvailableRiskType:input_type -> ypb.Empty
-	4,   // 692: ypb.Yak.QueryAvailableRiskLevel:input_type -> ypb.Empty
-	4,   // 693: ypb.Yak.QueryRiskTableStats:input_type -> ypb.Empty
-	4,   // 694: ypb.Yak.ResetRiskTableStats:input_type -> ypb.Empty
-	4,   // 695: ypb.Yak.QueryAvailableTarget:input_type -> ypb.Empty
-	340, // 696: ypb.Yak.QueryNewRisk:input_type -> ypb.QueryNewRiskRequest
-	346, // 697: ypb.Yak.NewRiskRead:input_type -> ypb.NewRiskReadRequest
-	347, // 698: ypb.Yak.UploadRiskToOnline:input_type -> ypb.UploadRiskToOnlineRequest
-	348, // 699: ypb.Yak.SetTagForRisk:input_type -> ypb.SetTagForRiskRequest
-	4,   // 700: ypb.Yak.QueryRiskTags:input_type -> ypb.Empty
-	4,   // 701: ypb.Yak.RiskFieldGroup:input_type -> ypb.Empty
-	347, // 702: ypb.Yak.RiskFeedbackToOnline:input_type -> ypb.UploadRiskToOnlineRequest
-	276, // 703: ypb.Yak.QueryReports:input_type -> ypb.QueryReportsRequest
-	273, // 704: ypb.Yak.QueryReport:input_type -> ypb.QueryReportRequest
-	274, // 705: ypb.Yak.DeleteReport:input_type -> ypb.DeleteReportRequest
-	4,   // 706: ypb.Yak.QueryAvailableReportFrom:input_type -> ypb.Empty
-	374, // 707: ypb.Yak.DownloadReport:input_type -> ypb.DownloadReportRequest
-	4,   // 708: ypb.Yak.GetAllYsoGadgetOptions:input_type -> ypb.Empty
-	296, // 709: ypb.Yak.GetAllYsoClassOptions:input_type -> ypb.YsoOptionsRequerstWithVerbose
-	296, // 710: ypb.Yak.GetAllYsoClassGeneraterOptions:input_type -> ypb.YsoOptionsRequerstWithVerbose
-	296, // 711: ypb.Yak.GenerateYsoCode:input_type -> ypb.YsoOptionsRequerstWithVerbose
-	296, // 712: ypb.Yak.GenerateYsoBytes:input_type -> ypb.YsoOptionsRequerstWithVerbose
-	298, // 713: ypb.Yak.YsoDump:input_type -> ypb.YsoBytesObject
-	316, // 714: ypb.Yak.CreateWebShell:input_type -> ypb.WebShell
-	324, // 715: ypb.Yak.DeleteWebShell:input_type -> ypb.DeleteWebShellRequest
-	316, // 716: ypb.Yak.UpdateWebShell:input_type -> ypb.WebShell
-	321, // 717: ypb.Yak.QueryWebShells:input_type -> ypb.QueryWebShellsRequest
-	319, // 718: ypb.Yak.Ping:input_type -> ypb.WebShellRequest
-	319, // 719: ypb.Yak.GetBasicInfo:input_type -> ypb.WebShellRequest
-	317, // 720: ypb.Yak.GenerateWebShell:input_type -> ypb.ShellGenerate
-	325, // 721: ypb.Yak.SetYakBridgeLogServer:input_type -> ypb.YakDNSLogBridgeAddr
-	4,   // 722: ypb.Yak.GetCurrentYakBridgeLogServer:input_type -> ypb.Empty
-	325, // 723: ypb.Yak.RequireDNSLogDomain:input_type -> ypb.YakDNSLogBridgeAddr
-	326, // 724: ypb.Yak.RequireDNSLogDomainByScript:input_type -> ypb.RequireDNSLogDomainByScriptRequest
-	327, // 725: ypb.Yak.QueryDNSLogByToken:input_type -> ypb.QueryDNSLogByTokenRequest
-	326, // 726: ypb.Yak.QueryDNSLogTokenByScript:input_type -> ypb.RequireDNSLogDomainByScriptRequest
-	4,   // 727: ypb.Yak.RequireICMPRandomLength:input_type -> ypb.Empty
-	304, // 728: ypb.Yak.QueryICMPTrigger:input_type -> ypb.QueryICMPTriggerRequest
-	4,   // 729: ypb.Yak.RequireRandomPortToken:input_type -> ypb.Empty
-	282, // 730: ypb.Yak.QueryRandomPortTrigger:input_type -> ypb.QueryRandomPortTriggerRequest
-	4,   // 731: ypb.Yak.QuerySupportedDnsLogPlatforms:input_type -> ypb.Empty
-	4,   // 732: ypb.Yak.GetAvailableYakScriptTags:input_type -> ypb.Empty
-	4,   // 733: ypb.Yak.ForceUpdateAvailableYakScriptTags:input_type -> ypb.Empty
-	268, // 734: ypb.Yak.ExecYakitPluginsByYakScriptFilter:input_type -> ypb.ExecYakitPluginsByYakScriptFilterRequest
-	269, // 735: ypb.Yak.GenerateYakCodeByPacket:input_type -> ypb.GenerateYakCodeByPacketRequest
-	270, // 736: ypb.Yak.GenerateCSRFPocByPacket:input_type -> ypb.GenerateCSRFPocByPacketRequest
-	4,   // 737: ypb.Yak.ExportMITMReplacerRules:input_type -> ypb.Empty
-	266, // 738: ypb.Yak.ImportMITMReplacerRules:input_type -> ypb.ImportMITMReplacerRulesRequest
-	4,   // 739: ypb.Yak.GetCurrentRules:input_type -> ypb.Empty
-	265, // 740: ypb.Yak.SetCurrentRules:input_type -> ypb.MITMContentReplacers
-	745, // 741: ypb.Yak.QueryMITMReplacerRules:input_type -> ypb.QueryMITMReplacerRulesRequest
-	581, // 742: ypb.Yak.GenerateURL:input_type -> ypb.GenerateURLRequest
-	263, // 743: ypb.Yak.ExtractDataToFile:input_type -> ypb.ExtractDataToFileRequest
-	246, // 744: ypb.Yak.AutoDecode:input_type -> ypb.AutoDecodeRequest
-	4,   // 745: ypb.Yak.GetSystemProxy:input_type -> ypb.Empty
-	228, // 746: ypb.Yak.SetSystemProxy:input_type -> ypb.SetSystemProxyRequest
-	224, // 747: ypb.Yak.GetKey:input_type -> ypb.GetKeyRequest
-	223, // 748: ypb.Yak.SetKey:input_type -> ypb.SetKeyRequest
-	224, // 749: ypb.Yak.DelKey:input_type -> ypb.GetKeyRequest
-	4,   // 750: ypb.Yak.GetAllProcessEnvKey:input_type -> ypb.Empty
-	223, // 751: ypb.Yak.SetProcessEnvKey:input_type -> ypb.SetKeyRequest
-	224, // 752: ypb.Yak.GetProjectKey:input_type -> ypb.GetKeyRequest
-	223, // 753: ypb.Yak.SetProjectKey:input_type -> ypb.SetKeyRequest
-	4,   // 754: ypb.Yak.GetOnlineProfile:input_type -> ypb.Empty
-	222, // 755: ypb.Yak.SetOnlineProfile:input_type -> ypb.OnlineProfile
-	211, // 756: ypb.Yak.DownloadOnlinePluginById:input_type -> ypb.DownloadOnlinePluginByIdRequest
-	212, // 757: ypb.Yak.DownloadOnlinePluginByIds:input_type -> ypb.DownloadOnlinePluginByIdsRequest
-	210, // 758: ypb.Yak.DownloadOnlinePluginAll:input_type -> ypb.DownloadOnlinePluginByTokenRequest
-	207, // 759: ypb.Yak.DeletePluginByUserID:input_type -> ypb.DeletePluginByUserIDRequest
-	4,   // 760: ypb.Yak.DeleteAllLocalPlugins:input_type -> ypb.Empty
-	4,   // 761: ypb.Yak.GetYakScriptTagsAndType:input_type -> ypb.Empty
-	208, // 762: ypb.Yak.DeleteLocalPluginsByWhere:input_type -> ypb.DeleteLocalPluginsByWhereRequest
-	215, // 763: ypb.Yak.DownloadOnlinePluginByScriptNames:input_type -> ypb.DownloadOnlinePluginByScriptNamesRequest
-	213, // 764: ypb.Yak.DownloadOnlinePlugins:input_type -> ypb.DownloadOnlinePluginsRequest
-	213, // 765: ypb.Yak.DownloadOnlinePluginBatch:input_type -> ypb.DownloadOnlinePluginsRequest
-	215, // 766: ypb.Yak.DownloadOnlinePluginByPluginName:input_type -> ypb.DownloadOnlinePluginByScriptNamesRequest
-	218, // 767: ypb.Yak.DownloadOnlinePluginByUUID:input_type -> ypb.DownloadOnlinePluginByUUIDRequest
-	219, // 768: ypb.Yak.QueryOnlinePlugins:input_type -> ypb.QueryOnlinePluginsRequest
-	188, // 769: ypb.Yak.ExecPacketScan:input_type -> ypb.ExecPacketScanRequest
-	4,   // 770: ypb.Yak.GetEngineDefaultProxy:input_type -> ypb.Empty
-	187, // 771: ypb.Yak.SetEngineDefaultProxy:input_type -> ypb.DefaultProxyResult
-	4,   // 772: ypb.Yak.GetMachineID:input_type -> ypb.Empty
-	4,   // 773: ypb.Yak.GetLicense:input_type -> ypb.Empty
-	569, // 774: ypb.Yak.CheckLicense:input_type -> ypb.CheckLicenseRequest
-	165, // 775: ypb.Yak.GetRequestBodyByHTTPFlowID:input_type -> ypb.DownloadBodyByHTTPFlowIDRequest
-	165, // 776: ypb.Yak.GetResponseBodyByHTTPFlowID:input_type -> ypb.DownloadBodyByHTTPFlowIDRequest
-	164, // 777: ypb.Yak.GetHTTPPacketBody:input_type -> ypb.GetHTTPPacketBodyRequest
-	162, // 778: ypb.Yak.RegisterFacadesHTTP:input_type -> ypb.RegisterFacadesHTTPRequest
-	161, // 779: ypb.Yak.ResetAndInvalidUserData:input_type -> ypb.ResetAndInvalidUserDataRequest
-	158, // 780: ypb.Yak.CreateYaklangShell:input_type -> ypb.YaklangShellRequest
-	157, // 781: ypb.Yak.AttachCombinedOutput:input_type -> ypb.AttachCombinedOutputRequest
-	4,   // 782: ypb.Yak.IsPrivilegedForNetRaw:input_type -> ypb.Empty
-	4,   // 783: ypb.Yak.PromotePermissionForUserPcap:input_type -> ypb.Empty
-	151, // 784: ypb.Yak.SetCurrentProject:input_type -> ypb.SetCurrentProjectRequest
-	4,   // 785: ypb.Yak.GetCurrentProject:input_type -> ypb.Empty
-	152, // 786: ypb.Yak.GetCurrentProjectEx:input_type -> ypb.GetCurrentProjectExRequest
-	148, // 787: ypb.Yak.GetProjects:input_type -> ypb.GetProjectsRequest
-	146, // 788: ypb.Yak.NewProject:input_type -> ypb.NewProjectRequest
-	146, // 789: ypb.Yak.UpdateProject:input_type -> ypb.NewProjectRequest
-	145, // 790: ypb.Yak.IsProjectNameValid:input_type -> ypb.IsProjectNameValidRequest
-	144, // 791: ypb.Yak.RemoveProject:input_type -> ypb.RemoveProjectRequest
-	153, // 792: ypb.Yak.DeleteProject:input_type -> ypb.DeleteProjectRequest
-	4,   // 793: ypb.Yak.GetDefaultProject:input_type -> ypb.Empty
-	154, // 794: ypb.Yak.GetDefaultProjectEx:input_type -> ypb.GetDefaultProjectExRequest
-	155, // 795: ypb.Yak.QueryProjectDetail:input_type -> ypb.QueryProjectDetailRequest
-	4,   // 796: ypb.Yak.GetTemporaryProject:input_type -> ypb.Empty
-	156, // 797: ypb.Yak.GetTemporaryProjectEx:input_type -> ypb.GetTemporaryProjectExRequest
-	140, // 798: ypb.Yak.ExportProject:input_type -> ypb.ExportProjectRequest
-	142, // 799: ypb.Yak.ImportProject:input_type -> ypb.ImportProjectRequest
-	4,   // 800: ypb.Yak.MigrateLegacyDatabase:input_type -> ypb.Empty
-	136, // 801: ypb.Yak.QueryMITMRuleExtractedData:input_type -> ypb.QueryMITMRuleExtractedDataRequest
-	138, // 802: ypb.Yak.ExportMITMRuleExtractedData:input_type -> ypb.ExportMITMRuleExtractedDataRequest
-	120, // 803: ypb.Yak.ImportChaosMakerRules:input_type -> ypb.ImportChaosMakerRulesRequest
-	128, // 804: ypb.Yak.QueryChaosMakerRule:input_type -> ypb.QueryChaosMakerRuleRequest
-	127, // 805: ypb.Yak.DeleteChaosMakerRuleByID:input_type -> ypb.DeleteChaosMakerRuleByIDRequest
-	124, // 806: ypb.Yak.ExecuteChaosMakerRule:input_type -> ypb.ExecuteChaosMakerRuleRequest
-	122, // 807: ypb.Yak.IsRemoteAddrAvailable:input_type -> ypb.IsRemoteAddrAvailableRequest
-	122, // 808: ypb.Yak.ConnectVulinboxAgent:input_type -> ypb.IsRemoteAddrAvailableRequest
-	88,  // 809: ypb.Yak.GetRegisteredVulinboxAgent:input_type -> ypb.GetRegisteredAgentRequest
-	87,  // 810: ypb.Yak.DisconnectVulinboxAgent:input_type -> ypb.DisconnectVulinboxAgentRequest
-	133, // 811: ypb.Yak.IsCVEDatabaseReady:input_type -> ypb.IsCVEDatabaseReadyRequest
-	131, // 812: ypb.Yak.UpdateCVEDatabase:input_type -> ypb.UpdateCVEDatabaseRequest
-	130, // 813: ypb.Yak.ExportsProfileDatabase:input_type -> ypb.ExportsProfileDatabaseRequest
-	129, // 814: ypb.Yak.ImportsProfileDatabase:input_type -> ypb.ImportsProfileDatabaseRequest
-	113, // 815: ypb.Yak.QueryCVE:input_type -> ypb.QueryCVERequest
-	112, // 816: ypb.Yak.GetCVE:input_type -> ypb.GetCVERequest
-	118, // 817: ypb.Yak.SaveTextToTemporalFile:input_type -> ypb.SaveTextToTemporalFileRequest
-	110, // 818: ypb.Yak.IsScrecorderReady:input_type -> ypb.IsScrecorderReadyRequest
-	109, // 819: ypb.Yak.InstallScrecorder:input_type -> ypb.InstallScrecorderRequest
-	108, // 820: ypb.Yak.StartScrecorder:input_type -> ypb.StartScrecorderRequest
-	103, // 821: ypb.Yak.QueryScreenRecorders:input_type -> ypb.QueryScreenRecorderRequest
-	103, // 822: ypb.Yak.DeleteScreenRecorders:input_type -> ypb.QueryScreenRecorderRequest
-	104, // 823: ypb.Yak.UploadScreenRecorders:input_type -> ypb.UploadScreenRecorderRequest
-	105, // 824: ypb.Yak.GetOneScreenRecorders:input_type -> ypb.GetOneScreenRecorderRequest
-	106, // 825: ypb.Yak.UpdateScreenRecorders:input_type -> ypb.UpdateScreenRecorderRequest
-	93,  // 826: ypb.Yak.IsVulinboxReady:input_type -> ypb.IsVulinboxReadyRequest
-	95,  // 827: ypb.Yak.InstallVulinbox:input_type -> ypb.InstallVulinboxRequest
-	96,  // 828: ypb.Yak.StartVulinbox:input_type -> ypb.StartVulinboxRequest
-	97,  // 829: ypb.Yak.GenQualityInspectionReport:input_type -> ypb.GenQualityInspectionReportRequest
-	101, // 830: ypb.Yak.HTTPRequestBuilder:input_type -> ypb.HTTPRequestBuilderParams
-	98,  // 831: ypb.Yak.DebugPlugin:input_type -> ypb.DebugPluginRequest
-	90,  // 832: ypb.Yak.SmokingEvaluatePlugin:input_type -> ypb.SmokingEvaluatePluginRequest
-	579, // 833: ypb.Yak.SmokingEvaluatePluginBatch:input_type -> ypb.SmokingEvaluatePluginBatchRequest
-	4,   // 834: ypb.Yak.GetSystemDefaultDnsServers:input_type -> ypb.Empty
-	85,  // 835: ypb.Yak.DiagnoseNetwork:input_type -> ypb.DiagnoseNetworkRequest
-	77,  // 836: ypb.Yak.DiagnoseNetworkDNS:input_type -> ypb.DiagnoseNetworkDNSRequest
-	586, // 837: ypb.Yak.TraceRoute:input_type -> ypb.TraceRouteRequest
-	79,  // 838: ypb.Yak.GetGlobalNetworkConfig:input_type -> ypb.GetGlobalNetworkConfigRequest
-	82,  // 839: ypb.Yak.SetGlobalNetworkConfig:input_type -> ypb.GlobalNetworkConfig
-	78,  // 840: ypb.Yak.ResetGlobalNetworkConfig:input_type -> ypb.ResetGlobalNetworkConfigRequest
-	80,  // 841: ypb.Yak.ValidP12PassWord:input_type -> ypb.ValidP12PassWordRequest
-	71,  // 842: ypb.Yak.RequestYakURL:input_type -> ypb.RequestYakURLParams
-	601, // 843: ypb.Yak.ReadFile:input_type -> ypb.ReadFileRequest
-	57,  // 844: ypb.Yak.GetPcapMetadata:input_type -> ypb.PcapMetadataRequest
-	68,  // 845: ypb.Yak.PcapX:input_type -> ypb.PcapXRequest
-	67,  // 846: ypb.Yak.QueryTrafficSession:input_type -> ypb.QueryTrafficSessionRequest
-	59,  // 847: ypb.Yak.QueryTrafficPacket:input_type -> ypb.QueryTrafficPacketRequest
-	60,  // 848: ypb.Yak.QueryTrafficTCPReassembled:input_type -> ypb.QueryTrafficTCPReassembledRequest
-	584, // 849: ypb.Yak.ParseTraffic:input_type -> ypb.ParseTrafficRequest
-	55,  // 850: ypb.Yak.DuplexConnection:input_type -> ypb.DuplexConnectionRequest
-	54,  // 851: ypb.Yak.HybridScan:input_type -> ypb.HybridScanRequest
-	48,  // 852: ypb.Yak.QueryHybridScanTask:input_type -> ypb.QueryHybridScanTaskRequest
-	45,  // 853: ypb.Yak.DeleteHybridScanTask:input_type -> ypb.DeleteHybridScanTaskRequest
-	42,  // 854: ypb.Yak.GetSpaceEngineStatus:input_type -> ypb.GetSpaceEngineStatusRequest
-	41,  // 855: ypb.Yak.GetSpaceEngineAccountStatus:input_type -> ypb.GetSpaceEngineAccountStatusRequest
-	84,  // 856: ypb.Yak.GetSpaceEngineAccountStatusV2:input_type -> ypb.ThirdPartyApplicationConfig
-	44,  // 857: ypb.Yak.FetchPortAssetFromSpaceEngine:input_type -> ypb.FetchPortAssetFromSpaceEngineRequest
-	588, // 858: ypb.Yak.EvaluateExpression:input_type -> ypb.EvaluateExpressionRequest
-	590, // 859: ypb.Yak.EvaluateMultiExpression:input_type -> ypb.EvaluateMultiExpressionRequest
-	4,   // 860: ypb.Yak.GetThirdPartyAppConfigTemplate:input_type -> ypb.Empty
-	4,   // 861: ypb.Yak.CheckHahValidAiConfig:input_type -> ypb.Empty
-	731, // 862: ypb.Yak.ListAiModel:input_type -> ypb.ListAiModelRequest
-	595, // 863: ypb.Yak.GetFingerprint:input_type -> ypb.GetFingerprintRequest
-	597, // 864: ypb.Yak.AddFingerprint:input_type -> ypb.AddFingerprintRequest
-	599, // 865: ypb.Yak.ModifyFingerprint:input_type -> ypb.ModifyFingerprintRequest
-	611, // 866: ypb.Yak.QueryFingerprint:input_type -> ypb.QueryFingerprintRequest
-	613, // 867: ypb.Yak.DeleteFingerprint:input_type -> ypb.DeleteFingerprintRequest
-	615, // 868: ypb.Yak.UpdateFingerprint:input_type -> ypb.UpdateFingerprintRequest
-	614, // 869: ypb.Yak.CreateFingerprint:input_type -> ypb.CreateFingerprintRequest
-	4,   // 870: ypb.Yak.RecoverBuiltinFingerprint:input_type -> ypb.Empty
-	616, // 871: ypb.Yak.CreateFingerprintGroup:input_type -> ypb.FingerprintGroup
-	4,   // 872: ypb.Yak.GetAllFingerprintGroup:input_type -> ypb.Empty
-	618, // 873: ypb.Yak.RenameFingerprintGroup:input_type -> ypb.RenameFingerprintGroupRequest
-	619, // 874: ypb.Yak.DeleteFingerprintGroup:input_type -> ypb.DeleteFingerprintGroupRequest
-	620, // 875: ypb.Yak.BatchUpdateFingerprintToGroup:input_type -> ypb.BatchUpdateFingerprintToGroupRequest
-	621, // 876: ypb.Yak.GetFingerprintGroupSetByFilter:input_type -> ypb.GetFingerprintGroupSetRequest
-	622, // 877: ypb.Yak.ExportFingerprint:input_type -> ypb.ExportFingerprintRequest
-	623, // 878: ypb.Yak.ImportFingerprint:input_type -> ypb.ImportFingerprintRequest
-	603, // 879: ypb.Yak.GetReverseShellProgramList:input_type -> ypb.GetReverseShellProgramListRequest
-	605, // 880: ypb.Yak.GenerateReverseShellCommand:input_type -> ypb.GenerateReverseShellCommandRequest
-	625, // 881: ypb.Yak.QuerySyntaxFlowRule:input_type -> ypb.QuerySyntaxFlowRuleRequest
-	637, // 882: ypb.Yak.CreateSyntaxFlowRule:input_type -> ypb.CreateSyntaxFlowRuleRequest
-	637, // 883: ypb.Yak.CreateSyntaxFlowRuleEx:input_type -> ypb.CreateSyntaxFlowRuleRequest
-	639, // 884: ypb.Yak.UpdateSyntaxFlowRule:input_type -> ypb.UpdateSyntaxFlowRuleRequest
-	639, // 885: ypb.Yak.UpdateSyntaxFlowRuleEx:input_type -> ypb.UpdateSyntaxFlowRuleRequest
-	642, // 886: ypb.Yak.DeleteSyntaxFlowRule:input_type -> ypb.DeleteSyntaxFlowRuleRequest
-	643, // 887: ypb.Yak.CheckSyntaxFlowRuleUpdate:input_type -> ypb.CheckSyntaxFlowRuleUpdateRequest
-	645, // 888: ypb.Yak.ApplySyntaxFlowRuleUpdate:input_type -> ypb.ApplySyntaxFlowRuleUpdateRequest
-	649, // 889: ypb.Yak.QuerySyntaxFlowRuleGroup:input_type -> ypb.QuerySyntaxFlowRuleGroupRequest
-	656, // 890: ypb.Yak.DeleteSyntaxFlowRuleGroup:input_type -> ypb.DeleteSyntaxFlowRuleGroupRequest
-	651, // 891: ypb.Yak.CreateSyntaxFlowRuleGroup:input_type -> ypb.CreateSyntaxFlowGroupRequest
-	652, // 892: ypb.Yak.UpdateSyntaxFlowRuleGroup:input_type -> ypb.UpdateSyntaxFlowRuleGroupRequest
-	653, // 893: ypb.Yak.UpdateSyntaxFlowRuleAndGroup:input_type -> ypb.UpdateSyntaxFlowRuleAndGroupRequest
-	654, // 894: ypb.Yak.QuerySyntaxFlowSameGroup:input_type -> ypb.QuerySyntaxFlowSameGroupRequest
-	657, // 895: ypb.Yak.SyntaxFlowRuleToOnline:input_type -> ypb.SyntaxFlowRuleToOnlineRequest
-	659, // 896: ypb.Yak.DownloadSyntaxFlowRule:input_type -> ypb.DownloadSyntaxFlowRuleRequest
-	660, // 897: ypb.Yak.SyntaxFlowScan:input_type -> ypb.SyntaxFlowScanRequest
-	661, // 898: ypb.Yak.QuerySyntaxFlowScanTask:input_type -> ypb.QuerySyntaxFlowScanTaskRequest
-	665, // 899: ypb.Yak.DeleteSyntaxFlowScanTask:input_type -> ypb.DeleteSyntaxFlowScanTaskRequest
-	668, // 900: ypb.Yak.QuerySyntaxFlowResult:input_type -> ypb.QuerySyntaxFlowResultRequest
-	671, // 901: ypb.Yak.DeleteSyntaxFlowResult:input_type -> ypb.DeleteSyntaxFlowResultRequest
-	633, // 902: ypb.Yak.QuerySSAPrograms:input_type -> ypb.QuerySSAProgramRequest
-	634, // 903: ypb.Yak.UpdateSSAProgram:input_type -> ypb.UpdateSSAProgramRequest
-	635, // 904: ypb.Yak.DeleteSSAPrograms:input_type -> ypb.DeleteSSAProgramRequest
-	685, // 905: ypb.Yak.QuerySSARisks:input_type -> ypb.QuerySSARisksRequest
-	687, // 906: ypb.Yak.QueryNewSSARisks:input_type -> ypb.QueryNewSSARisksRequest
-	689, // 907: ypb.Yak.DeleteSSARisks:input_type -> ypb.DeleteSSARisksRequest
-	690, // 908: ypb.Yak.UpdateSSARiskTags:input_type -> ypb.UpdateSSARiskTagsRequest
-	4,   // 909: ypb.Yak.GetSSARiskFieldGroup:input_type -> ypb.Empty
-	692, // 910: ypb.Yak.NewSSARiskRead:input_type -> ypb.NewSSARiskReadRequest
-	694, // 911: ypb.Yak.SSARiskFeedbackToOnline:input_type -> ypb.SSARiskFeedbackToOnlineRequest
-	4,   // 912: ypb.Yak.GetAllPluginEnv:input_type -> ypb.Empty
-	673, // 913: ypb.Yak.QueryPluginEnv:input_type -> ypb.QueryPluginEnvRequest
-	674, // 914: ypb.Yak.CreatePluginEnv:input_type -> ypb.PluginEnvData
-	674, // 915: ypb.Yak.SetPluginEnv:input_type -> ypb.PluginEnvData
-	675, // 916: ypb.Yak.DeletePluginEnv:input_type -> ypb.DeletePluginEnvRequest
-	676, // 917: ypb.Yak.GetAllFuzztagInfo:input_type -> ypb.GetAllFuzztagInfoRequest
-	680, // 918: ypb.Yak.GenerateFuzztag:input_type -> ypb.GenerateFuzztagRequest
-	695, // 919: ypb.Yak.ExportSyntaxFlows:input_type -> ypb.ExportSyntaxFlowsRequest
-	696, // 920: ypb.Yak.ImportSyntaxFlows:input_type -> ypb.ImportSyntaxFlowsRequest
-	698, // 921: ypb.Yak.CreateHotPatchTemplate:input_type -> ypb.HotPatchTemplate
-	701, // 922: ypb.Yak.DeleteHotPatchTemplate:input_type -> ypb.DeleteHotPatchTemplateRequest
-	700, // 923: ypb.Yak.UpdateHotPatchTemplate:input_type -> ypb.UpdateHotPatchTemplateRequest
-	699, // 924: ypb.Yak.QueryHotPatchTemplate:input_type -> ypb.HotPatchTemplateRequest
-	706, // 925: ypb.Yak.QueryHotPatchTemplateList:input_type -> ypb.QueryHotPatchTemplateListRequest
-	708, // 926: ypb.Yak.GroupTableColumn:input_type -> ypb.GroupTableColumnRequest
-	710, // 927: ypb.Yak.UploadHotPatchTemplateToOnline:input_type -> ypb.UploadHotPatchTemplateToOnlineRequest
-	711, // 928: ypb.Yak.DownloadHotPatchTemplate:input_type -> ypb.DownloadHotPatchTemplateRequest
-	547, // 929: ypb.Yak.SetMITMHijackFilter:input_type -> ypb.SetMITMFilterRequest
-	4,   // 930: ypb.Yak.GetMITMHijackFilter:input_type -> ypb.Empty
-	4,   // 931: ypb.Yak.ResetMITMHijackFilter:input_type -> ypb.Empty
-	712, // 932: ypb.Yak.ExportHTTPFlowStream:input_type -> ypb.ExportHTTPFlowStreamRequest
-	714, // 933: ypb.Yak.ImportHTTPFlowStream:input_type -> ypb.ImportHTTPFlowStreamRequest
-	719, // 934: ypb.Yak.CreateNote:input_type -> ypb.CreateNoteRequest
-	721, // 935: ypb.Yak.UpdateNote:input_type -> ypb.UpdateNoteRequest
-	722, // 936: ypb.Yak.DeleteNote:input_type -> ypb.DeleteNoteRequest
-	723, // 937: ypb.Yak.QueryNote:input_type -> ypb.QueryNoteRequest
-	725, // 938: ypb.Yak.SearchNoteContent:input_type -> ypb.SearchNoteContentRequest
-	727, // 939: ypb.Yak.ImportNote:input_type -> ypb.ImportNoteRequest
-	729, // 940: ypb.Yak.ExportNote:input_type -> ypb.ExportNoteRequest
-	27,  // 941: ypb.Yak.StartAITask:input_type -> ypb.AIInputEvent
-	32,  // 942: ypb.Yak.QueryAITask:input_type -> ypb.AITaskQueryRequest
-	28,  // 943: ypb.Yak.StartAITriage:input_type -> ypb.AITriageInputEvent
-	36,  // 944: ypb.Yak.CreateAIForge:input_type -> ypb.AIForge
-	36,  // 945: ypb.Yak.UpdateAIForge:input_type -> ypb.AIForge
-	35,  // 946: ypb.Yak.DeleteAIForge:input_type -> ypb.AIForgeFilter
-	37,  // 947: ypb.Yak.QueryAIForge:input_type -> ypb.QueryAIForgeRequest
-	39,  // 948: ypb.Yak.StartMcpServer:input_type -> ypb.StartMcpServerRequest
-	4,   // 949: ypb.Yak.GetToolSetList:input_type -> ypb.Empty
-	25,  // 950: ypb.Yak.GetAIToolList:input_type -> ypb.GetAIToolListRequest
-	20,  // 951: ypb.Yak.DeleteAITool:input_type -> ypb.DeleteAIToolRequest
-	19,  // 952: ypb.Yak.SaveAITool:input_type -> ypb.SaveAIToolRequest
-	21,  // 953: ypb.Yak.ToggleAIToolFavorite:input_type -> ypb.ToggleAIToolFavoriteRequest
-	17,  // 954: ypb.Yak.AIToolGenerateMetadata:input_type -> ypb.AIToolGenerateMetadataRequest
-	4,   // 955: ypb.Yak.IsLlamaServerReady:input_type -> ypb.Empty
-	734, // 956: ypb.Yak.IsLocalModelReady:input_type -> ypb.IsLocalModelReadyRequest
-	736, // 957: ypb.Yak.InstallLlamaServer:input_type -> ypb.InstallLlamaServerRequest
-	737, // 958: ypb.Yak.StartLocalModel:input_type -> ypb.StartLocalModelRequest
-	738, // 959: ypb.Yak.DownloadLocalModel:input_type -> ypb.DownloadLocalModelRequest
-	4,   // 960: ypb.Yak.GetSupportedLocalModels:input_type -> ypb.Empty
-	5,   // 961: ypb.Yak.Version:output_type -> ypb.VersionResponse
-	6,   // 962: ypb.Yak.YakVersionAtLeast:output_type -> ypb.GeneralResponse
-	560, // 963: ypb.Yak.Echo:output_type -> ypb.EchoResposne
-	562, // 964: ypb.Yak.Handshake:output_type -> ypb.HandshakeResponse
-	13,  // 965: ypb.Yak.VerifySystemCertificate:output_type -> ypb.VerifySystemCertificateResponse
-	555, // 966: ypb.Yak.MITM:output_type -> ypb.MITMResponse
-	548, // 967: ypb.Yak.SetMITMFilter:output_type -> ypb.SetMITMFilterResponse
-	547, // 968: ypb.Yak.GetMITMFilter:output_type -> ypb.SetMITMFilterRequest
-	547, // 969: ypb.Yak.ResetMITMFilter:output_type -> ypb.SetMITMFilterRequest
-	286, // 970: ypb.Yak.DownloadMITMCert:output_type -> ypb.MITMCert
-	742, // 971: ypb.Yak.MITMV2:output_type -> ypb.MITMV2Response
-	564, // 972: ypb.Yak.OpenPort:output_type -> ypb.Output
-	567, // 973: ypb.Yak.Exec:output_type -> ypb.ExecResult
-	486, // 974: ypb.Yak.QueryExecHistory:output_type -> ypb.ExecHistoryRecordResponse
-	4,   // 975: ypb.Yak.RemoveExecHistory:output_type -> ypb.Empty
-	4,   // 976: ypb.Yak.LoadNucleiTemplates:output_type -> ypb.Empty
-	567, // 977: ypb.Yak.AutoUpdateYakModule:output_type -> ypb.ExecResult
-	567, // 978: ypb.Yak.ExecYakScript:output_type -> ypb.ExecResult
-	9,   // 979: ypb.Yak.ExecBatchYakScript:output_type -> ypb.ExecBatchYakScriptResult
-	234, // 980: ypb.Yak.GetExecBatchYakScriptUnfinishedTask:output_type -> ypb.GetExecBatchYakScriptUnfinishedTaskResponse
-	8,   // 981: ypb.Yak.GetExecBatchYakScriptUnfinishedTaskByUid:output_type -> ypb.ExecBatchYakScriptRequest
-	8,   // 982: ypb.Yak.PopExecBatchYakScriptUnfinishedTaskByUid:output_type -> ypb.ExecBatchYakScriptRequest
-	9,   // 983: ypb.Yak.RecoverExecBatchYakScriptUnfinishedTask:output_type -> ypb.ExecBatchYakScriptResult
-	449, // 984: ypb.Yak.QueryYakScript:output_type -> ypb.QueryYakScriptResponse
-	451, // 985: ypb.Yak.QueryYakScriptByYakScriptName:output_type -> ypb.YakScript
-	451, // 986: ypb.Yak.SaveYakScript:output_type -> ypb.YakScript
-	4,   // 987: ypb.Yak.DeleteYakScript:output_type -> ypb.Empty
-	451, // 988: ypb.Yak.GetYakScriptById:output_type -> ypb.YakScript
-	451, // 989: ypb.Yak.GetYakScriptByName:output_type -> ypb.YakScript
-	451, // 990: ypb.Yak.GetYakScriptByOnlineID:output_type -> ypb.YakScript
-	4,   // 991: ypb.Yak.IgnoreYakScript:output_type -> ypb.Empty
-	4,   // 992: ypb.Yak.UnIgnoreYakScript:output_type -> ypb.Empty
-	387, // 993: ypb.Yak.ExportYakScript:output_type -> ypb.ExportYakScriptResponse
-	567, // 994: ypb.Yak.ExportYakScriptStream:output_type -> ypb.ExecResult
-	567, // 995: ypb.Yak.ImportYakScriptStream:output_type -> ypb.ExecResult
-	567, // 996: ypb.Yak.ExecutePacketYakScript:output_type -> ypb.ExecResult
-	9,   // 997: ypb.Yak.ExecuteBatchPacketYakScript:output_type -> ypb.ExecBatchYakScriptResult
-	250, // 998: ypb.Yak.GetYakScriptTags:output_type -> ypb.GetYakScriptTagsResponse
-	253, // 999: ypb.Yak.QueryYakScriptLocalAndUser:output_type -> ypb.QueryYakScriptLocalAndUserResponse
-	253, // 1000: ypb.Yak.QueryYakScriptByOnlineGroup:output_type -> ypb.QueryYakScriptLocalAndUserResponse
-	253, // 1001: ypb.Yak.QueryYakScriptLocalAll:output_type -> ypb.QueryYakScriptLocalAndUserResponse
-	257, // 1002: ypb.Yak.QueryYakScriptByNames:output_type -> ypb.QueryYakScriptByNamesResponse
-	258, // 1003: ypb.Yak.QueryYakScriptByIsCore:output_type -> ypb.QueryYakScriptByIsCoreResponse
-	260, // 1004: ypb.Yak.QueryYakScriptRiskDetailByCWE:output_type -> ypb.QueryYakScriptRiskDetailByCWEResponse
-	261, // 1005: ypb.Yak.YakScriptRiskTypeList:output_type -> ypb.YakScriptRiskTypeListResponse
-	451, // 1006: ypb.Yak.SaveNewYakScript:output_type -> ypb.YakScript
-	455, // 1007: ypb.Yak.SaveYakScriptToOnline:output_type -> ypb.SaveYakScriptToOnlineResponse
-	458, // 1008: ypb.Yak.ExportLocalYakScript:output_type -> ypb.ExportLocalYakScriptResponse
-	459, // 1009: ypb.Yak.ExportLocalYakScriptStream:output_type -> ypb.ExportYakScriptLocalResponse
-	461, // 1010: ypb.Yak.ImportYakScript:output_type -> ypb.ImportYakScriptResult
-	4,   // 1011: ypb.Yak.SetYakScriptSkipUpdate:output_type -> ypb.Empty
-	463, // 1012: ypb.Yak.QueryYakScriptSkipUpdate:output_type -> ypb.QueryYakScriptSkipUpdateResponse
-	465, // 1013: ypb.Yak.QueryYakScriptGroup:output_type -> ypb.QueryYakScriptGroupResponse
-	4,   // 1014: ypb.Yak.SaveYakScriptGroup:output_type -> ypb.Empty
-	4,   // 1015: ypb.Yak.RenameYakScriptGroup:output_type -> ypb.Empty
-	4,   // 1016: ypb.Yak.DeleteYakScriptGroup:output_type -> ypb.Empty
-	470, // 1017: ypb.Yak.GetYakScriptGroup:output_type -> ypb.GetYakScriptGroupResponse
-	4,   // 1018: ypb.Yak.ResetYakScriptGroup:output_type -> ypb.Empty
-	4,   // 1019: ypb.Yak.SetGroup:output_type -> ypb.Empty
-	535, // 1020: ypb.Yak.GetHTTPFlowByHash:output_type -> ypb.HTTPFlow
-	535, // 1021: ypb.Yak.GetHTTPFlowById:output_type -> ypb.HTTPFlow
-	537, // 1022: ypb.Yak.GetHTTPFlowBodyById:output_type -> ypb.GetHTTPFlowBodyByIdResponse
-	534, // 1023: ypb.Yak.GetHTTPFlowByIds:output_type -> ypb.HTTPFlows
-	538, // 1024: ypb.Yak.QueryHTTPFlows:output_type -> ypb.QueryHTTPFlowResponse
-	4,   // 1025: ypb.Yak.DeleteHTTPFlows:output_type -> ypb.Empty
-	4,   // 1026: ypb.Yak.SetTagForHTTPFlow:output_type -> ypb.Empty
-	532, // 1027: ypb.Yak.QueryHTTPFlowsIds:output_type -> ypb.QueryHTTPFlowsIdsResponse
-	540, // 1028: ypb.Yak.HTTPFlowsFieldGroup:output_type -> ypb.HTTPFlowsFieldGroupResponse
-	542, // 1029: ypb.Yak.HTTPFlowsShare:output_type -> ypb.HTTPFlowsShareResponse
-	4,   // 1030: ypb.Yak.HTTPFlowsExtract:output_type -> ypb.Empty
-	572, // 1031: ypb.Yak.GetHTTPFlowBare:output_type -> ypb.HTTPFlowBareResponse
-	538, // 1032: ypb.Yak.ExportHTTPFlows:output_type -> ypb.QueryHTTPFlowResponse
-	4,   // 1033: ypb.Yak.HTTPFlowsToOnline:output_type -> ypb.Empty
-	529, // 1034: ypb.Yak.QueryHTTPFlowsProcessNames:output_type -> ypb.QueryHTTPFlowsProcessNamesResponse
-	525, // 1035: ypb.Yak.AnalyzeHTTPFlow:output_type -> ypb.AnalyzeHTTPFlowResponse
-	510, // 1036: ypb.Yak.ExtractUrl:output_type -> ypb.ExtractedUrl
-	309, // 1037: ypb.Yak.GetHistoryHTTPFuzzerTask:output_type -> ypb.HistoryHTTPFuzzerTaskDetail
-	311, // 1038: ypb.Yak.QueryHistoryHTTPFuzzerTask:output_type -> ypb.HistoryHTTPFuzzerTasks
-	312, // 1039: ypb.Yak.QueryHistoryHTTPFuzzerTaskEx:output_type -> ypb.HistoryHTTPFuzzerTasksResponse
-	4,   // 1040: ypb.Yak.DeleteHistoryHTTPFuzzerTask:output_type -> ypb.Empty
-	512, // 1041: ypb.Yak.HTTPFuzzer:output_type -> ypb.FuzzerResponse
-	511, // 1042: ypb.Yak.HTTPFuzzerSequence:output_type -> ypb.FuzzerSequenceResponse
-	502, // 1043: ypb.Yak.PreloadHTTPFuzzerParams:output_type -> ypb.PreloadHTTPFuzzerParamsResponse
-	495, // 1044: ypb.Yak.RenderVariables:output_type -> ypb.RenderVariablesResponse
-	497, // 1045: ypb.Yak.MatchHTTPResponse:output_type -> ypb.MatchHTTPResponseResult
-	499, // 1046: ypb.Yak.ExtractHTTPResponse:output_type -> ypb.ExtractHTTPResponseResult
-	512, // 1047: ypb.Yak.RedirectRequest:output_type -> ypb.FuzzerResponse
-	362, // 1048: ypb.Yak.HTTPRequestMutate:output_type -> ypb.MutateResult
-	362, // 1049: ypb.Yak.HTTPResponseMutate:output_type -> ypb.MutateResult
-	244, // 1050: ypb.Yak.FixUploadPacket:output_type -> ypb.FixUploadPacketResponse
-	245, // 1051: ypb.Yak.IsMultipartFormDataRequest:output_type -> ypb.IsMultipartFormDataRequestResult
-	179, // 1052: ypb.Yak.GenerateExtractRule:output_type -> ypb.GenerateExtractRuleResponse
-	167, // 1053: ypb.Yak.ExtractData:output_type -> ypb.ExtractDataResponse
-	574, // 1054: ypb.Yak.ImportHTTPFuzzerTaskFromYaml:output_type -> ypb.ImportHTTPFuzzerTaskFromYamlResponse
-	576, // 1055: ypb.Yak.ExportHTTPFuzzerTaskToYaml:output_type -> ypb.ExportHTTPFuzzerTaskToYamlResponse
-	578, // 1056: ypb.Yak.RenderHTTPFuzzerPacket:output_type -> ypb.RenderHTTPFuzzerPacketResponse
-	4,   // 1057: ypb.Yak.SaveFuzzerLabel:output_type -> ypb.Empty
-	169, // 1058: ypb.Yak.QueryFuzzerLabel:output_type -> ypb.QueryFuzzerLabelResponse
-	4,   // 1059: ypb.Yak.DeleteFuzzerLabel:output_type -> ypb.Empty
-	607, // 1060: ypb.Yak.SaveFuzzerConfig:output_type -> ypb.DbOperateMessage
-	174, // 1061: ypb.Yak.QueryFuzzerConfig:output_type -> ypb.QueryFuzzerConfigResponse
-	607, // 1062: ypb.Yak.DeleteFuzzerConfig:output_type -> ypb.DbOperateMessage
-	182, // 1063: ypb.Yak.QueryHTTPFuzzerResponseByTaskId:output_type -> ypb.QueryHTTPFuzzerResponseByTaskIdResponse
-	186, // 1064: ypb.Yak.CreateWebsocketFuzzer:output_type -> ypb.ClientWebsocketResponse
-	545, // 1065: ypb.Yak.QueryWebsocketFlowByHTTPFlowWebsocketHash:output_type -> ypb.WebsocketFlows
-	4,   // 1066: ypb.Yak.DeleteWebsocketFlowByHTTPFlowWebsocketHash:output_type -> ypb.Empty
-	4,   // 1067: ypb.Yak.DeleteWebsocketFlowAll:output_type -> ypb.Empty
-	535, // 1068: ypb.Yak.ConvertFuzzerResponseToHTTPFlow:output_type -> ypb.HTTPFlow
-	489, // 1069: ypb.Yak.StringFuzzer:output_type -> ypb.StringFuzzerResponse
-	492, // 1070: ypb.Yak.HTTPRequestAnalyzer:output_type -> ypb.HTTPRequestAnalysis
-	481, // 1071: ypb.Yak.Codec:output_type -> ypb.CodecResponse
-	481, // 1072: ypb.Yak.NewCodec:output_type -> ypb.CodecResponse
-	482, // 1073: ypb.Yak.GetAllCodecMethods:output_type -> ypb.CodecMethods
-	4,   // 1074: ypb.Yak.SaveCodecFlow:output_type -> ypb.Empty
-	4,   // 1075: ypb.Yak.DeleteCodecFlow:output_type -> ypb.Empty
-	480, // 1076: ypb.Yak.GetAllCodecFlow:output_type -> ypb.GetCodecFlowResponse
-	76,  // 1077: ypb.Yak.PacketPrettifyHelper:output_type -> ypb.PacketPrettifyHelperResponse
-	442, // 1078: ypb.Yak.QueryPayload:output_type -> ypb.QueryPayloadResponse
-	440, // 1079: ypb.Yak.QueryPayloadFromFile:output_type -> ypb.QueryPayloadFromFileResponse
-	4,   // 1080: ypb.Yak.DeletePayloadByFolder:output_type -> ypb.Empty
-	4,   // 1081: ypb.Yak.DeletePayloadByGroup:output_type -> ypb.Empty
-	4,   // 1082: ypb.Yak.DeletePayload:output_type -> ypb.Empty
-	4,   // 1083: ypb.Yak.SavePayload:output_type -> ypb.Empty
-	206, // 1084: ypb.Yak.SavePayloadStream:output_type -> ypb.SavePayloadProgress
-	206, // 1085: ypb.Yak.SavePayloadToFileStream:output_type -> ypb.SavePayloadProgress
-	206, // 1086: ypb.Yak.SaveLargePayloadToFileStream:output_type -> ypb.SavePayloadProgress
-	4,   // 1087: ypb.Yak.RenamePayloadFolder:output_type -> ypb.Empty
-	4,   // 1088: ypb.Yak.RenamePayloadGroup:output_type -> ypb.Empty
-	4,   // 1089: ypb.Yak.UpdatePayload:output_type -> ypb.Empty
-	4,   // 1090: ypb.Yak.UpdatePayloadToFile:output_type -> ypb.Empty
-	4,   // 1091: ypb.Yak.BackUpOrCopyPayloads:output_type -> ypb.Empty
-	431, // 1092: ypb.Yak.GetAllPayloadGroup:output_type -> ypb.GetAllPayloadGroupResponse
-	4,   // 1093: ypb.Yak.UpdateAllPayloadGroup:output_type -> ypb.Empty
-	445, // 1094: ypb.Yak.GetAllPayload:output_type -> ypb.GetAllPayloadResponse
-	446, // 1095: ypb.Yak.GetAllPayloadFromFile:output_type -> ypb.GetAllPayloadFromFileResponse
-	445, // 1096: ypb.Yak.ExportAllPayload:output_type -> ypb.GetAllPayloadResponse
-	445, // 1097: ypb.Yak.ExportAllPayloadFromFile:output_type -> ypb.GetAllPayloadResponse
-	4,   // 1098: ypb.Yak.CreatePayloadFolder:output_type -> ypb.Empty
-	206, // 1099: ypb.Yak.RemoveDuplicatePayloads:output_type -> ypb.SavePayloadProgress
-	206, // 1100: ypb.Yak.CoverPayloadGroupToDatabase:output_type -> ypb.SavePayloadProgress
-	206, // 1101: ypb.Yak.ConvertPayloadGroupToDatabase:output_type -> ypb.SavePayloadProgress
-	206, // 1102: ypb.Yak.MigratePayloads:output_type -> ypb.SavePayloadProgress
-	423, // 1103: ypb.Yak.GetYakitCompletionRaw:output_type -> ypb.YakitCompletionRawResponse
-	427, // 1104: ypb.Yak.GetYakVMBuildInMethodCompletion:output_type -> ypb.GetYakVMBuildInMethodCompletionResponse
-	205, // 1105: ypb.Yak.StaticAnalyzeError:output_type -> ypb.StaticAnalyzeErrorResponse
-	203, // 1106: ypb.Yak.YaklangCompileAndFormat:output_type -> ypb.YaklangCompileAndFormatResponse
-	194, // 1107: ypb.Yak.YaklangLanguageSuggestion:output_type -> ypb.YaklangLanguageSuggestionResponse
-	195, // 1108: ypb.Yak.YaklangLanguageFind:output_type -> ypb.YaklangLanguageFindResponse
-	194, // 1109: ypb.Yak.FuzzTagSuggestion:output_type -> ypb.YaklangLanguageSuggestionResponse
-	196, // 1110: ypb.Yak.YaklangInspectInformation:output_type -> ypb.YaklangInspectInformationResponse
-	199, // 1111: ypb.Yak.YaklangGetCliCodeFromDatabase:output_type -> ypb.YaklangGetCliCodeFromDatabaseResponse
-	564, // 1112: ypb.Yak.YaklangTerminal:output_type -> ypb.Output
-	567, // 1113: ypb.Yak.PortScan:output_type -> ypb.ExecResult
-	414, // 1114: ypb.Yak.ViewPortScanCode:output_type -> ypb.SimpleScript
-	567, // 1115: ypb.Yak.SimpleDetect:output_type -> ypb.ExecResult
-	4,   // 1116: ypb.Yak.SaveCancelSimpleDetect:output_type -> ypb.Empty
-	567, // 1117: ypb.Yak.SimpleDetectCreatReport:output_type -> ypb.ExecResult
-	240, // 1118: ypb.Yak.QuerySimpleDetectUnfinishedTask:output_type -> ypb.QueryUnfinishedTaskResponse
-	416, // 1119: ypb.Yak.GetSimpleDetectRecordRequestById:output_type -> ypb.RecordPortScanRequest
-	4,   // 1120: ypb.Yak.DeleteSimpleDetectUnfinishedTask:output_type -> ypb.Empty
-	567, // 1121: ypb.Yak.RecoverSimpleDetectTask:output_type -> ypb.ExecResult
-	235, // 1122: ypb.Yak.GetSimpleDetectUnfinishedTask:output_type -> ypb.GetSimpleDetectUnfinishedTaskResponse
-	416, // 1123: ypb.Yak.GetSimpleDetectUnfinishedTaskByUid:output_type -> ypb.RecordPortScanRequest
-	416, // 1124: ypb.Yak.PopSimpleDetectUnfinishedTaskByUid:output_type -> ypb.RecordPortScanRequest
-	567, // 1125: ypb.Yak.RecoverSimpleDetectUnfinishedTask:output_type -> ypb.ExecResult
-	421, // 1126: ypb.Yak.QueryPorts:output_type -> ypb.QueryPortsResponse
-	4,   // 1127: ypb.Yak.DeletePorts:output_type -> ypb.Empty
-	365, // 1128: ypb.Yak.QueryHosts:output_type -> ypb.QueryHostsResponse
-	4,   // 1129: ypb.Yak.DeleteHosts:output_type -> ypb.Empty
-	368, // 1130: ypb.Yak.QueryDomains:output_type -> ypb.QueryDomainsResponse
-	4,   // 1131: ypb.Yak.DeleteDomains:output_type -> ypb.Empty
-	370, // 1132: ypb.Yak.QueryPortsGroup:output_type -> ypb.QueryPortsGroupResponse
-	4,   // 1133: ypb.Yak.UpdateFromYakitResource:output_type -> ypb.Empty
-	4,   // 1134: ypb.Yak.UpdateFromGithub:output_type -> ypb.Empty
-	4,   // 1135: ypb.Yak.AddToMenu:output_type -> ypb.Empty
-	4,   // 1136: ypb.Yak.RemoveFromMenu:output_type -> ypb.Empty
-	4,   // 1137: ypb.Yak.YakScriptIsInMenu:output_type -> ypb.Empty
-	397, // 1138: ypb.Yak.GetAllMenuItem:output_type -> ypb.MenuByGroup
-	4,   // 1139: ypb.Yak.DeleteAllMenuItem:output_type -> ypb.Empty
-	4,   // 1140: ypb.Yak.ImportMenuItem:output_type -> ypb.Empty
-	404, // 1141: ypb.Yak.ExportMenuItem:output_type -> ypb.ExportMenuItemResult
-	393, // 1142: ypb.Yak.GetMenuItemById:output_type -> ypb.MenuItem
-	391, // 1143: ypb.Yak.QueryGroupsByYakScriptId:output_type -> ypb.GroupNames
-	4,   // 1144: ypb.Yak.AddMenus:output_type -> ypb.Empty
-	397, // 1145: ypb.Yak.QueryAllMenuItem:output_type -> ypb.MenuByGroup
-	4,   // 1146: ypb.Yak.DeleteAllMenu:output_type -> ypb.Empty
-	4,   // 1147: ypb.Yak.AddToNavigation:output_type -> ypb.Empty
-	409, // 1148: ypb.Yak.GetAllNavigationItem:output_type -> ypb.GetAllNavigationItemResponse
-	4,   // 1149: ypb.Yak.DeleteAllNavigation:output_type -> ypb.Empty
-	4,   // 1150: ypb.Yak.AddOneNavigation:output_type -> ypb.Empty
-	391, // 1151: ypb.Yak.QueryNavigationGroups:output_type -> ypb.GroupNames
-	4,   // 1152: ypb.Yak.SaveMarkdownDocument:output_type -> ypb.Empty
-	388, // 1153: ypb.Yak.GetMarkdownDocument:output_type -> ypb.GetMarkdownDocumentResponse
-	4,   // 1154: ypb.Yak.DeleteMarkdownDocument:output_type -> ypb.Empty
-	567, // 1155: ypb.Yak.StartBasicCrawler:output_type -> ypb.ExecResult
-	414, // 1156: ypb.Yak.ViewBasicCrawlerCode:output_type -> ypb.SimpleScript
-	379, // 1157: ypb.Yak.GenerateWebsiteTree:output_type -> ypb.GenerateWebsiteTreeResponse
-	378, // 1158: ypb.Yak.QueryYakScriptExecResult:output_type -> ypb.QueryYakScriptExecResultResponse
-	376, // 1159: ypb.Yak.QueryYakScriptNameInExecResult:output_type -> ypb.YakScriptNames
-	4,   // 1160: ypb.Yak.DeleteYakScriptExecResult:output_type -> ypb.Empty
-	4,   // 1161: ypb.Yak.DeleteYakScriptExec:output_type -> ypb.Empty
-	567, // 1162: ypb.Yak.StartBrute:output_type -> ypb.ExecResult
-	358, // 1163: ypb.Yak.GetAvailableBruteTypes:output_type -> ypb.GetAvailableBruteTypesResponse
-	352, // 1164: ypb.Yak.GetTunnelServerExternalIP:output_type -> ypb.GetTunnelServerExternalIPResponse
-	350, // 1165: ypb.Yak.VerifyTunnelServerDomain:output_type -> ypb.VerifyTunnelServerDomainResponse
-	567, // 1166: ypb.Yak.StartFacades:output_type -> ypb.ExecResult
-	567, // 1167: ypb.Yak.StartFacadesWithYsoObject:output_type -> ypb.ExecResult
-	4,   // 1168: ypb.Yak.ApplyClassToFacades:output_type -> ypb.Empty
-	303, // 1169: ypb.Yak.BytesToBase64:output_type -> ypb.BytesToBase64Response
-	4,   // 1170: ypb.Yak.ConfigGlobalReverse:output_type -> ypb.Empty
-	332, // 1171: ypb.Yak.AvailableLocalAddr:output_type -> ypb.AvailableLocalAddrResponse
-	331, // 1172: ypb.Yak.GetGlobalReverseServer:output_type -> ypb.GetGlobalReverseServerResponse
-	339, // 1173: ypb.Yak.QueryRisks:output_type -> ypb.QueryRisksResponse
-	337, // 1174: ypb.Yak.QueryRisk:output_type -> ypb.Risk
-	4,   // 1175: ypb.Yak.DeleteRisk:output_type -> ypb.Empty
-	288, // 1176: ypb.Yak.QueryAvailableRiskType:output_type -> ypb.Fields
-	288, // 1177: ypb.Yak.QueryAvailableRiskLevel:output_type -> ypb.Fields
-	285, // 1178: ypb.Yak.QueryRiskTableStats:output_type -> ypb.RiskTableStats
-	4,   // 1179: ypb.Yak.ResetRiskTableStats:output_type -> ypb.Empty
-	288, // 1180: ypb.Yak.QueryAvailableTarget:output_type -> ypb.Fields
-	341, // 1181: ypb.Yak.QueryNewRisk:output_type -> ypb.QueryNewRiskResponse
-	4,   // 1182: ypb.Yak.NewRiskRead:output_type -> ypb.Empty
-	4,   // 1183: ypb.Yak.UploadRiskToOnline:output_type -> ypb.Empty
-	4,   // 1184: ypb.Yak.SetTagForRisk:output_type -> ypb.Empty
-	342, // 1185: ypb.Yak.QueryRiskTags:output_type -> ypb.QueryRiskTagsResponse
-	343, // 1186: ypb.Yak.RiskFieldGroup:output_type -> ypb.RiskFieldGroupResponse
-	4,   // 1187: ypb.Yak.RiskFeedbackToOnline:output_type -> ypb.Empty
-	275, // 1188: ypb.Yak.QueryReports:output_type -> ypb.QueryReportsResponse
-	277, // 1189: ypb.Yak.QueryReport:output_type -> ypb.Report
-	4,   // 1190: ypb.Yak.DeleteReport:output_type -> ypb.Empty
-	288, // 1191: ypb.Yak.QueryAvailableReportFrom:output_type -> ypb.Fields
-	4,   // 1192: ypb.Yak.DownloadReport:output_type -> ypb.Empty
-	290, // 1193: ypb.Yak.GetAllYsoGadgetOptions:output_type -> ypb.YsoOptionsWithVerbose
-	290, // 1194: ypb.Yak.GetAllYsoClassOptions:output_type -> ypb.YsoOptionsWithVerbose
-	293, // 1195: ypb.Yak.GetAllYsoClassGeneraterOptions:output_type -> ypb.YsoClassOptionsResponseWithVerbose
-	300, // 1196: ypb.Yak.GenerateYsoCode:output_type -> ypb.YsoCodeResponse
-	301, // 1197: ypb.Yak.GenerateYsoBytes:output_type -> ypb.YsoBytesResponse
-	299, // 1198: ypb.Yak.YsoDump:output_type -> ypb.YsoDumpResponse
-	316, // 1199: ypb.Yak.CreateWebShell:output_type -> ypb.WebShell
-	4,   // 1200: ypb.Yak.DeleteWebShell:output_type -> ypb.Empty
-	316, // 1201: ypb.Yak.UpdateWebShell:output_type -> ypb.WebShell
-	322, // 1202: ypb.Yak.QueryWebShells:output_type -> ypb.QueryWebShellsResponse
-	320, // 1203: ypb.Yak.Ping:output_type -> ypb.WebShellResponse
-	320, // 1204: ypb.Yak.GetBasicInfo:output_type -> ypb.WebShellResponse
-	320, // 1205: ypb.Yak.GenerateWebShell:output_type -> ypb.WebShellResponse
-	4,   // 1206: ypb.Yak.SetYakBridgeLogServer:output_type -> ypb.Empty
-	325, // 1207: ypb.Yak.GetCurrentYakBridgeLogServer:output_type -> ypb.YakDNSLogBridgeAddr
-	330, // 1208: ypb.Yak.RequireDNSLogDomain:output_type -> ypb.DNSLogRootDomain
-	330, // 1209: ypb.Yak.RequireDNSLogDomainByScript:output_type -> ypb.DNSLogRootDomain
-	328, // 1210: ypb.Yak.QueryDNSLogByToken:output_type -> ypb.QueryDNSLogByTokenResponse
-	328, // 1211: ypb.Yak.QueryDNSLogTokenByScript:output_type -> ypb.QueryDNSLogByTokenResponse
-	280, // 1212: ypb.Yak.RequireICMPRandomLength:output_type -> ypb.RequireICMPRandomLengthResponse
-	305, // 1213: ypb.Yak.QueryICMPTrigger:output_type -> ypb.QueryICMPTriggerResponse
-	283, // 1214: ypb.Yak.RequireRandomPortToken:output_type -> ypb.RandomPortInfo
-	281, // 1215: ypb.Yak.QueryRandomPortTrigger:output_type -> ypb.RandomPortTriggerNotification
-	306, // 1216: ypb.Yak.QuerySupportedDnsLogPlatforms:output_type -> ypb.QuerySupportedDnsLogPlatformsResponse
-	288, // 1217: ypb.Yak.GetAvailableYakScriptTags:output_type -> ypb.Fields
-	4,   // 1218: ypb.Yak.ForceUpdateAvailableYakScriptTags:output_type -> ypb.Empty
-	567, // 1219: ypb.Yak.ExecYakitPluginsByYakScriptFilter:output_type -> ypb.ExecResult
-	272, // 1220: ypb.Yak.GenerateYakCodeByPacket:output_type -> ypb.GenerateYakCodeByPacketResponse
-	271, // 1221: ypb.Yak.GenerateCSRFPocByPacket:output_type -> ypb.GenerateCSRFPocByPacketResponse
-	267, // 1222: ypb.Yak.ExportMITMReplacerRules:output_type -> ypb.ExportMITMReplacerRulesResponse
-	4,   // 1223: ypb.Yak.ImportMITMReplacerRules:output_type -> ypb.Empty
-	265, // 1224: ypb.Yak.GetCurrentRules:output_type -> ypb.MITMContentReplacers
-	4,   // 1225: ypb.Yak.SetCurrentRules:output_type -> ypb.Empty
-	746, // 1226: ypb.Yak.QueryMITMReplacerRules:output_type -> ypb.QueryMITMReplacerRulesResponse
-	582, // 1227: ypb.Yak.GenerateURL:output_type -> ypb.GenerateURLResponse
-	249, // 1228: ypb.Yak.ExtractDataToFile:output_type -> ypb.ExtractDataToFileResult
-	248, // 1229: ypb.Yak.AutoDecode:output_type -> ypb.AutoDecodeResponse
-	229, // 1230: ypb.Yak.GetSystemProxy:output_type -> ypb.GetSystemProxyResult
-	4,   // 1231: ypb.Yak.SetSystemProxy:output_type -> ypb.Empty
-	225, // 1232: ypb.Yak.GetKey:output_type -> ypb.GetKeyResult
-	4,   // 1233: ypb.Yak.SetKey:output_type -> ypb.Empty
-	4,   // 1234: ypb.Yak.DelKey:output_type -> ypb.Empty
-	227, // 1235: ypb.Yak.GetAllProcessEnvKey:output_type -> ypb.GetProcessEnvKeyResult
-	4,   // 1236: ypb.Yak.SetProcessEnvKey:output_type -> ypb.Empty
-	225, // 1237: ypb.Yak.GetProjectKey:output_type -> ypb.GetKeyResult
-	4,   // 1238: ypb.Yak.SetProjectKey:output_type -> ypb.Empty
-	222, // 1239: ypb.Yak.GetOnlineProfile:output_type -> ypb.OnlineProfile
-	4,   // 1240: ypb.Yak.SetOnlineProfile:output_type -> ypb.Empty
-	4,   // 1241: ypb.Yak.DownloadOnlinePluginById:output_type -> ypb.Empty
-	4,   // 1242: ypb.Yak.DownloadOnlinePluginByIds:output_type -> ypb.Empty
-	209, // 1243: ypb.Yak.DownloadOnlinePluginAll:output_type -> ypb.DownloadOnlinePluginProgress
-	4,   // 1244: ypb.Yak.DeletePluginByUserID:output_type -> ypb.Empty
-	4,   // 1245: ypb.Yak.DeleteAllLocalPlugins:output_type -> ypb.Empty
-	473, // 1246: ypb.Yak.GetYakScriptTagsAndType:output_type -> ypb.GetYakScriptTagsAndTypeResponse
-	4,   // 1247: ypb.Yak.DeleteLocalPluginsByWhere:output_type -> ypb.Empty
-	216, // 1248: ypb.Yak.DownloadOnlinePluginByScriptNames:output_type -> ypb.DownloadOnlinePluginByScriptNamesResponse
-	209, // 1249: ypb.Yak.DownloadOnlinePlugins:output_type -> ypb.DownloadOnlinePluginProgress
-	4,   // 1250: ypb.Yak.DownloadOnlinePluginBatch:output_type -> ypb.Empty
-	216, // 1251: ypb.Yak.DownloadOnlinePluginByPluginName:output_type -> ypb.DownloadOnlinePluginByScriptNamesResponse
-	451, // 1252: ypb.Yak.DownloadOnlinePluginByUUID:output_type -> ypb.YakScript
-	220, // 1253: ypb.Yak.QueryOnlinePlugins:output_type -> ypb.QueryOnlinePluginsResponse
-	567, // 1254: ypb.Yak.ExecPacketScan:output_type -> ypb.ExecResult
-	187, // 1255: ypb.Yak.GetEngineDefaultProxy:output_type -> ypb.DefaultProxyResult
-	4,   // 1256: ypb.Yak.SetEngineDefaultProxy:output_type -> ypb.Empty
-	180, // 1257: ypb.Yak.GetMachineID:output_type -> ypb.GetMachineIDResponse
-	568, // 1258: ypb.Yak.GetLicense:output_type -> ypb.GetLicenseResponse
-	4,   // 1259: ypb.Yak.CheckLicense:output_type -> ypb.Empty
-	166, // 1260: ypb.Yak.GetRequestBodyByHTTPFlowID:output_type -> ypb.Bytes
-	166, // 1261: ypb.Yak.GetResponseBodyByHTTPFlowID:output_type -> ypb.Bytes
-	166, // 1262: ypb.Yak.GetHTTPPacketBody:output_type -> ypb.Bytes
-	163, // 1263: ypb.Yak.RegisterFacadesHTTP:output_type -> ypb.RegisterFacadesHTTPResponse
-	4,   // 1264: ypb.Yak.ResetAndInvalidUserData:output_type -> ypb.Empty
-	160, // 1265: ypb.Yak.CreateYaklangShell:output_type -> ypb.YaklangShellResponse
-	567, // 1266: ypb.Yak.AttachCombinedOutput:output_type -> ypb.ExecResult
-	143, // 1267: ypb.Yak.IsPrivilegedForNetRaw:output_type -> ypb.IsPrivilegedForNetRawResponse
-	4,   // 1268: ypb.Yak.PromotePermissionForUserPcap:output_type -> ypb.Empty
-	4,   // 1269: ypb.Yak.SetCurrentProject:output_type -> ypb.Empty
-	149, // 1270: ypb.Yak.GetCurrentProject:output_type -> ypb.ProjectDescription
-	149, // 1271: ypb.Yak.GetCurrentProjectEx:output_type -> ypb.ProjectDescription
-	150, // 1272: ypb.Yak.GetProjects:output_type -> ypb.GetProjectsResponse
-	147, // 1273: ypb.Yak.NewProject:output_type -> ypb.NewProjectResponse
-	147, // 1274: ypb.Yak.UpdateProject:output_type -> ypb.NewProjectResponse
-	4,   // 1275: ypb.Yak.IsProjectNameValid:output_type -> ypb.Empty
-	4,   // 1276: ypb.Yak.RemoveProject:output_type -> ypb.Empty
-	4,   // 1277: ypb.Yak.DeleteProject:output_type -> ypb.Empty
-	149, // 1278: ypb.Yak.GetDefaultProject:output_type -> ypb.ProjectDescription
-	149, // 1279: ypb.Yak.GetDefaultProjectEx:output_type -> ypb.ProjectDescription
-	149, // 1280: ypb.Yak.QueryProjectDetail:output_type -> ypb.ProjectDescription
-	149, // 1281: ypb.Yak.GetTemporaryProject:output_type -> ypb.ProjectDescription
-	149, // 1282: ypb.Yak.GetTemporaryProjectEx:output_type -> ypb.ProjectDescription
-	141, // 1283: ypb.Yak.ExportProject:output_type -> ypb.ProjectIOProgress
-	141, // 1284: ypb.Yak.ImportProject:output_type -> ypb.ProjectIOProgress
-	4,   // 1285: ypb.Yak.MigrateLegacyDatabase:output_type -> ypb.Empty
-	135, // 1286: ypb.Yak.QueryMITMRuleExtractedData:output_type -> ypb.QueryMITMRuleExtractedDataResponse
-	139, // 1287: ypb.Yak.ExportMITMRuleExtractedData:output_type -> ypb.ExportMITMRuleExtractedDataResponse
-	4,   // 1288: ypb.Yak.ImportChaosMakerRules:output_type -> ypb.Empty
-	126, // 1289: ypb.Yak.QueryChaosMakerRule:output_type -> ypb.QueryChaosMakerRuleResponse
-	4,   // 1290: ypb.Yak.DeleteChaosMakerRuleByID:output_type -> ypb.Empty
-	567, // 1291: ypb.Yak.ExecuteChaosMakerRule:output_type -> ypb.ExecResult
-	123, // 1292: ypb.Yak.IsRemoteAddrAvailable:output_type -> ypb.IsRemoteAddrAvailableResponse
-	123, // 1293: ypb.Yak.ConnectVulinboxAgent:output_type -> ypb.IsRemoteAddrAvailableResponse
-	89,  // 1294: ypb.Yak.GetRegisteredVulinboxAgent:output_type -> ypb.GetRegisteredAgentResponse
-	4,   // 1295: ypb.Yak.DisconnectVulinboxAgent:output_type -> ypb.Empty
-	132, // 1296: ypb.Yak.IsCVEDatabaseReady:output_type -> ypb.IsCVEDatabaseReadyResponse
-	567, // 1297: ypb.Yak.UpdateCVEDatabase:output_type -> ypb.ExecResult
-	567, // 1298: ypb.Yak.ExportsProfileDatabase:output_type -> ypb.ExecResult
-	567, // 1299: ypb.Yak.ImportsProfileDatabase:output_type -> ypb.ExecResult
-	117, // 1300: ypb.Yak.QueryCVE:output_type -> ypb.QueryCVEResponse
-	115, // 1301: ypb.Yak.GetCVE:output_type -> ypb.CVEDetailEx
-	119, // 1302: ypb.Yak.SaveTextToTemporalFile:output_type -> ypb.SaveTextToTemporalFileResponse
-	111, // 1303: ypb.Yak.IsScrecorderReady:output_type -> ypb.IsScrecorderReadyResponse
-	567, // 1304: ypb.Yak.InstallScrecorder:output_type -> ypb.ExecResult
-	567, // 1305: ypb.Yak.StartScrecorder:output_type -> ypb.ExecResult
-	107, // 1306: ypb.Yak.QueryScreenRecorders:output_type -> ypb.QueryScreenRecorderResponse
-	4,   // 1307: ypb.Yak.DeleteScreenRecorders:output_type -> ypb.Empty
-	4,   // 1308: ypb.Yak.UploadScreenRecorders:output_type -> ypb.Empty
-	102, // 1309: ypb.Yak.GetOneScreenRecorders:output_type -> ypb.ScreenRecorder
-	4,   // 1310: ypb.Yak.UpdateScreenRecorders:output_type -> ypb.Empty
-	94,  // 1311: ypb.Yak.IsVulinboxReady:output_type -> ypb.IsVulinboxReadyResponse
-	567, // 1312: ypb.Yak.InstallVulinbox:output_type -> ypb.ExecResult
-	567, // 1313: ypb.Yak.StartVulinbox:output_type -> ypb.ExecResult
-	567, // 1314: ypb.Yak.GenQualityInspectionReport:output_type -> ypb.ExecResult
-	100, // 1315: ypb.Yak.HTTPRequestBuilder:output_type -> ypb.HTTPRequestBuilderResponse
-	567, // 1316: ypb.Yak.DebugPlugin:output_type -> ypb.ExecResult
-	92,  // 1317: ypb.Yak.SmokingEvaluatePlugin:output_type -> ypb.SmokingEvaluatePluginResponse
-	580, // 1318: ypb.Yak.SmokingEvaluatePluginBatch:output_type -> ypb.SmokingEvaluatePluginBatchResponse
-	570, // 1319: ypb.Yak.GetSystemDefaultDnsServers:output_type -> ypb.DefaultDnsServerResponse
-	86,  // 1320: ypb.Yak.DiagnoseNetwork:output_type -> ypb.DiagnoseNetworkResponse
-	86,  // 1321: ypb.Yak.DiagnoseNetworkDNS:output_type -> ypb.DiagnoseNetworkResponse
-	587, // 1322: ypb.Yak.TraceRoute:output_type -> ypb.TraceRouteResponse
-	82,  // 1323: ypb.Yak.GetGlobalNetworkConfig:output_type -> ypb.GlobalNetworkConfig
-	4,   // 1324: ypb.Yak.SetGlobalNetworkConfig:output_type -> ypb.Empty
-	4,   // 1325: ypb.Yak.ResetGlobalNetworkConfig:output_type -> ypb.Empty
-	81,  // 1326: ypb.Yak.ValidP12PassWord:output_type -> ypb.ValidP12PassWordResponse
-	74,  // 1327: ypb.Yak.RequestYakURL:output_type -> ypb.RequestYakURLResponse
-	602, // 1328: ypb.Yak.ReadFile:output_type -> ypb.ReadFileResponse
-	58,  // 1329: ypb.Yak.GetPcapMetadata:output_type -> ypb.PcapMetadata
-	70,  // 1330: ypb.Yak.PcapX:output_type -> ypb.PcapXResponse
-	62,  // 1331: ypb.Yak.QueryTrafficSession:output_type -> ypb.QueryTrafficSessionResponse
-	64,  // 1332: ypb.Yak.QueryTrafficPacket:output_type -> ypb.QueryTrafficPacketResponse
-	66,  // 1333: ypb.Yak.QueryTrafficTCPReassembled:output_type -> ypb.QueryTrafficTCPReassembledResponse
-	585, // 1334: ypb.Yak.ParseTraffic:output_type -> ypb.ParseTrafficResponse
-	56,  // 1335: ypb.Yak.DuplexConnection:output_type -> ypb.DuplexConnectionResponse
-	50,  // 1336: ypb.Yak.HybridScan:output_type -> ypb.HybridScanResponse
-	47,  // 1337: ypb.Yak.QueryHybridScanTask:output_type -> ypb.QueryHybridScanTaskResponse
-	4,   // 1338: ypb.Yak.DeleteHybridScanTask:output_type -> ypb.Empty
-	43,  // 1339: ypb.Yak.GetSpaceEngineStatus:output_type -> ypb.SpaceEngineStatus
-	43,  // 1340: ypb.Yak.GetSpaceEngineAccountStatus:output_type -> ypb.SpaceEngineStatus
-	43,  // 1341: ypb.Yak.GetSpaceEngineAccountStatusV2:output_type -> ypb.SpaceEngineStatus
-	567, // 1342: ypb.Yak.FetchPortAssetFromSpaceEngine:output_type -> ypb.ExecResult
-	589, // 1343: ypb.Yak.EvaluateExpression:output_type -> ypb.EvaluateExpressionResponse
-	591, // 1344: ypb.Yak.EvaluateMultiExpression:output_type -> ypb.EvaluateMultiExpressionResponse
-	594, // 1345: ypb.Yak.GetThirdPartyAppConfigTemplate:output_type -> ypb.GetThirdPartyAppConfigTemplateResponse
-	6,   // 1346: ypb.Yak.CheckHahValidAiConfig:output_type -> ypb.GeneralResponse
-	732, // 1347: ypb.Yak.ListAiModel:output_type -> ypb.ListAiModelResponse
-	596, // 1348: ypb.Yak.GetFingerprint:output_type -> ypb.GetFingerprintResponse
-	598, // 1349: ypb.Yak.AddFingerprint:output_type -> ypb.AddFingerprintResponse
-	600, // 1350: ypb.Yak.ModifyFingerprint:output_type -> ypb.ModifyFingerprintResponse
-	612, // 1351: ypb.Yak.QueryFingerprint:output_type -> ypb.QueryFingerprintResponse
-	607, // 1352: ypb.Yak.DeleteFingerprint:output_type -> ypb.DbOperateMessage
-	607, // 1353: ypb.Yak.UpdateFingerprint:output_type -> ypb.DbOperateMessage
-	607, // 1354: ypb.Yak.CreateFingerprint:output_type -> ypb.DbOperateMessage
-	607, // 1355: ypb.Yak.RecoverBuiltinFingerprint:output_type -> ypb.DbOperateMessage
-	607, // 1356: ypb.Yak.CreateFingerprintGroup:output_type -> ypb.DbOperateMessage
-	617, // 1357: ypb.Yak.GetAllFingerprintGroup:output_type -> ypb.FingerprintGroups
-	607, // 1358: ypb.Yak.RenameFingerprintGroup:output_type -> ypb.DbOperateMessage
-	607, // 1359: ypb.Yak.DeleteFingerprintGroup:output_type -> ypb.DbOperateMessage
-	607, // 1360: ypb.Yak.BatchUpdateFingerprintToGroup:output_type -> ypb.DbOperateMessage
-	617, // 1361: ypb.Yak.GetFingerprintGroupSetByFilter:output_type -> ypb.FingerprintGroups
-	624, // 1362: ypb.Yak.ExportFingerprint:output_type -> ypb.DataTransferProgress
-	624, // 1363: ypb.Yak.ImportFingerprint:output_type -> ypb.DataTransferProgress
-	604, // 1364: ypb.Yak.GetReverseShellProgramList:output_type -> ypb.GetReverseShellProgramListResponse
-	606, // 1365: ypb.Yak.GenerateReverseShellCommand:output_type -> ypb.GenerateReverseShellCommandResponse
-	641, // 1366: ypb.Yak.QuerySyntaxFlowRule:output_type -> ypb.QuerySyntaxFlowRuleResponse
-	607, // 1367: ypb.Yak.CreateSyntaxFlowRule:output_type -> ypb.DbOperateMessage
-	638, // 1368: ypb.Yak.CreateSyntaxFlowRuleEx:output_type -> ypb.CreateSyntaxFlowRuleResponse
-	607, // 1369: ypb.Yak.UpdateSyntaxFlowRule:output_type -> ypb.DbOperateMessage
-	640, // 1370: ypb.Yak.UpdateSyntaxFlowRuleEx:output_type -> ypb.UpdateSyntaxFlowRuleResponse
-	607, // 1371: ypb.Yak.DeleteSyntaxFlowRule:output_type -> ypb.DbOperateMessage
-	644, // 1372: ypb.Yak.CheckSyntaxFlowRuleUpdate:output_type -> ypb.CheckSyntaxFlowRuleUpdateResponse
-	646, // 1373: ypb.Yak.ApplySyntaxFlowRuleUpdate:output_type -> ypb.ApplySyntaxFlowRuleUpdateResponse
-	650, // 1374: ypb.Yak.QuerySyntaxFlowRuleGroup:output_type -> ypb.QuerySyntaxFlowRuleGroupResponse
-	607, // 1375: ypb.Yak.DeleteSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
-	607, // 1376: ypb.Yak.CreateSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
-	607, // 1377: ypb.Yak.UpdateSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
-	607, // 1378: ypb.Yak.UpdateSyntaxFlowRuleAndGroup:output_type -> ypb.DbOperateMessage
-	655, // 1379: ypb.Yak.QuerySyntaxFlowSameGroup:output_type -> ypb.QuerySyntaxFlowSameGroupResponse
-	658, // 1380: ypb.Yak.SyntaxFlowRuleToOnline:output_type -> ypb.SyntaxFlowRuleOnlineProgress
-	658, // 1381: ypb.Yak.DownloadSyntaxFlowRule:output_type -> ypb.SyntaxFlowRuleOnlineProgress
-	666, // 1382: ypb.Yak.SyntaxFlowScan:output_type -> ypb.SyntaxFlowScanResponse
-	663, // 1383: ypb.Yak.QuerySyntaxFlowScanTask:output_type -> ypb.QuerySyntaxFlowScanTaskResponse
-	607, // 1384: ypb.Yak.DeleteSyntaxFlowScanTask:output_type -> ypb.DbOperateMessage
-	669, // 1385: ypb.Yak.QuerySyntaxFlowResult:output_type -> ypb.QuerySyntaxFlowResultResponse
-	672, // 1386: ypb.Yak.DeleteSyntaxFlowResult:output_type -> ypb.DeleteSyntaxFlowResultResponse
-	636, // 1387: ypb.Yak.QuerySSAPrograms:output_type -> ypb.QuerySSAProgramResponse
-	607, // 1388: ypb.Yak.UpdateSSAProgram:output_type -> ypb.DbOperateMessage
-	607, // 1389: ypb.Yak.DeleteSSAPrograms:output_type -> ypb.DbOperateMessage
-	686, // 1390: ypb.Yak.QuerySSARisks:output_type -> ypb.QuerySSARisksResponse
-	688, // 1391: ypb.Yak.QueryNewSSARisks:output_type -> ypb.QueryNewSSARisksResponse
-	607, // 1392: ypb.Yak.DeleteSSARisks:output_type -> ypb.DbOperateMessage
-	607, // 1393: ypb.Yak.UpdateSSARiskTags:output_type -> ypb.DbOperateMessage
-	691, // 1394: ypb.Yak.GetSSARiskFieldGroup:output_type -> ypb.SSARiskFieldGroupResponse
-	693, // 1395: ypb.Yak.NewSSARiskRead:output_type -> ypb.NewSSARiskReadResponse
-	4,   // 1396: ypb.Yak.SSARiskFeedbackToOnline:output_type -> ypb.Empty
-	674, // 1397: ypb.Yak.GetAllPluginEnv:output_type -> ypb.PluginEnvData
-	674, // 1398: ypb.Yak.QueryPluginEnv:output_type -> ypb.PluginEnvData
-	4,   // 1399: ypb.Yak.CreatePluginEnv:output_type -> ypb.Empty
-	4,   // 1400: ypb.Yak.SetPluginEnv:output_type -> ypb.Empty
-	4,   // 1401: ypb.Yak.DeletePluginEnv:output_type -> ypb.Empty
-	677, // 1402: ypb.Yak.GetAllFuzztagInfo:output_type -> ypb.GetAllFuzztagInfoResponse
-	681, // 1403: ypb.Yak.GenerateFuzztag:output_type -> ypb.GenerateFuzztagResponse
-	697, // 1404: ypb.Yak.ExportSyntaxFlows:output_type -> ypb.SyntaxflowsProgress
-	697, // 1405: ypb.Yak.ImportSyntaxFlows:output_type -> ypb.SyntaxflowsProgress
-	702, // 1406: ypb.Yak.CreateHotPatchTemplate:output_type -> ypb.CreateHotPatchTemplateResponse
-	703, // 1407: ypb.Yak.DeleteHotPatchTemplate:output_type -> ypb.DeleteHotPatchTemplateResponse
-	704, // 1408: ypb.Yak.UpdateHotPatchTemplate:output_type -> ypb.UpdateHotPatchTemplateResponse
-	705, // 1409: ypb.Yak.QueryHotPatchTemplate:output_type -> ypb.QueryHotPatchTemplateResponse
-	707, // 1410: ypb.Yak.QueryHotPatchTemplateList:output_type -> ypb.QueryHotPatchTemplateListResponse
-	709, // 1411: ypb.Yak.GroupTableColumn:output_type -> ypb.GroupTableColumnResponse
-	4,   // 1412: ypb.Yak.UploadHotPatchTemplateToOnline:output_type -> ypb.Empty
-	4,   // 1413: ypb.Yak.DownloadHotPatchTemplate:output_type -> ypb.Empty
-	548, // 1414: ypb.Yak.SetMITMHijackFilter:output_type -> ypb.SetMITMFilterResponse
-	547, // 1415: ypb.Yak.GetMITMHijackFilter:output_type -> ypb.SetMITMFilterRequest
-	547, // 1416: ypb.Yak.ResetMITMHijackFilter:output_type -> ypb.SetMITMFilterRequest
-	713, // 1417: ypb.Yak.ExportHTTPFlowStream:output_type -> ypb.ExportHTTPFlowStreamResponse
-	715, // 1418: ypb.Yak.ImportHTTPFlowStream:output_type -> ypb.ImportHTTPFlowStreamResponse
-	720, // 1419: ypb.Yak.CreateNote:output_type -> ypb.CreateNoteResponse
-	607, // 1420: ypb.Yak.UpdateNote:output_type -> ypb.DbOperateMessage
-	607, // 1421: ypb.Yak.DeleteNote:output_type -> ypb.DbOperateMessage
-	724, // 1422: ypb.Yak.QueryNote:output_type -> ypb.QueryNoteResponse
-	726, // 1423: ypb.Yak.SearchNoteContent:output_type -> ypb.SearchNoteContentResponse
-	728, // 1424: ypb.Yak.ImportNote:output_type -> ypb.ImportNoteResponse
-	730, // 1425: ypb.Yak.ExportNote:output_type -> ypb.ExportNoteResponse
-	26,  // 1426: ypb.Yak.StartAITask:output_type -> ypb.AIOutputEvent
-	33,  // 1427: ypb.Yak.QueryAITask:output_type -> ypb.AITaskQueryResponse
-	26,  // 1428: ypb.Yak.StartAITriage:output_type -> ypb.AIOutputEvent
-	607, // 1429: ypb.Yak.CreateAIForge:output_type -> ypb.DbOperateMessage
-	607, // 1430: ypb.Yak.UpdateAIForge:output_type -> ypb.DbOperateMessage
-	607, // 1431: ypb.Yak.DeleteAIForge:output_type -> ypb.DbOperateMessage
-	38,  // 1432: ypb.Yak.QueryAIForge:output_type -> ypb.QueryAIForgeResponse
-	40,  // 1433: ypb.Yak.StartMcpServer:output_type -> ypb.StartMcpServerResponse
-	14,  // 1434: ypb.Yak.GetToolSetList:output_type -> ypb.GetToolSetListResponse
-	24,  // 1435: ypb.Yak.GetAIToolList:output_type -> ypb.GetAIToolListResponse
-	607, // 1436: ypb.Yak.DeleteAITool:output_type -> ypb.DbOperateMessage
-	607, // 1437: ypb.Yak.SaveAITool:output_type -> ypb.DbOperateMessage
-	22,  // 1438: ypb.Yak.ToggleAIToolFavorite:output_type -> ypb.ToggleAIToolFavoriteResponse
-	18,  // 1439: ypb.Yak.AIToolGenerateMetadata:output_type -> ypb.AIToolGenerateMetadataResponse
-	733, // 1440: ypb.Yak.IsLlamaServerReady:output_type -> ypb.IsLlamaServerReadyResponse
-	735, // 1441: ypb.Yak.IsLocalModelReady:output_type -> ypb.IsLocalModelReadyResponse
-	567, // 1442: ypb.Yak.InstallLlamaServer:output_type -> ypb.ExecResult
-	567, // 1443: ypb.Yak.StartLocalModel:output_type -> ypb.ExecResult
-	567, // 1444: ypb.Yak.DownloadLocalModel:output_type -> ypb.ExecResult
-	740, // 1445: ypb.Yak.GetSupportedLocalModels:output_type -> ypb.GetSupportedLocalModelsResponse
-	961, // [961:1446] is the sub-list for method output_type
-	476, // [476:961] is the sub-list for method input_type
-	476, // [476:476] is the sub-list for extension type_name
-	476, // [476:476] is the sub-list for extension extendee
-	0,   // [0:476] is the sub-list for field type_name
+	638, // 377: ypb.IncrementalCompileSSAProgramResponse.Result:type_name -> ypb.IncrementalCompileSSAProgramResult
+	628, // 378: ypb.CreateSyntaxFlowRuleRequest.SyntaxFlowInput:type_name -> ypb.SyntaxFlowRuleInput
+	607, // 379: ypb.CreateSyntaxFlowRuleResponse.Message:type_name -> ypb.DbOperateMessage
+	626, // 380: ypb.CreateSyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
+	628, // 381: ypb.UpdateSyntaxFlowRuleRequest.SyntaxFlowInput:type_name -> ypb.SyntaxFlowRuleInput
+	607, // 382: ypb.UpdateSyntaxFlowRuleResponse.Message:type_name -> ypb.DbOperateMessage
+	626, // 383: ypb.UpdateSyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
+	515, // 384: ypb.QuerySyntaxFlowRuleResponse.Pagination:type_name -> ypb.Paging
+	607, // 385: ypb.QuerySyntaxFlowRuleResponse.DbMessage:type_name -> ypb.DbOperateMessage
+	626, // 386: ypb.QuerySyntaxFlowRuleResponse.Rule:type_name -> ypb.SyntaxFlowRule
+	629, // 387: ypb.DeleteSyntaxFlowRuleRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	650, // 388: ypb.QuerySyntaxFlowRuleGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleGroupFilter
+	515, // 389: ypb.QuerySyntaxFlowRuleGroupRequest.Pagination:type_name -> ypb.Paging
+	651, // 390: ypb.QuerySyntaxFlowRuleGroupResponse.Group:type_name -> ypb.SyntaxFlowGroup
+	515, // 391: ypb.QuerySyntaxFlowRuleGroupResponse.Pagination:type_name -> ypb.Paging
+	629, // 392: ypb.UpdateSyntaxFlowRuleAndGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	629, // 393: ypb.QuerySyntaxFlowSameGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	651, // 394: ypb.QuerySyntaxFlowSameGroupResponse.Group:type_name -> ypb.SyntaxFlowGroup
+	650, // 395: ypb.DeleteSyntaxFlowRuleGroupRequest.Filter:type_name -> ypb.SyntaxFlowRuleGroupFilter
+	515, // 396: ypb.SyntaxFlowRuleToOnlineRequest.Pagination:type_name -> ypb.Paging
+	629, // 397: ypb.SyntaxFlowRuleToOnlineRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	629, // 398: ypb.DownloadSyntaxFlowRuleRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	629, // 399: ypb.SyntaxFlowScanRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	628, // 400: ypb.SyntaxFlowScanRequest.RuleInput:type_name -> ypb.SyntaxFlowRuleInput
+	515, // 401: ypb.QuerySyntaxFlowScanTaskRequest.Pagination:type_name -> ypb.Paging
+	665, // 402: ypb.QuerySyntaxFlowScanTaskRequest.Filter:type_name -> ypb.SyntaxFlowScanTaskFilter
+	515, // 403: ypb.QuerySyntaxFlowScanTaskResponse.Pagination:type_name -> ypb.Paging
+	667, // 404: ypb.QuerySyntaxFlowScanTaskResponse.Data:type_name -> ypb.SyntaxFlowScanTask
+	663, // 405: ypb.SyntaxFlowScanTask.Config:type_name -> ypb.SyntaxFlowScanRequest
+	665, // 406: ypb.DeleteSyntaxFlowScanTaskRequest.Filter:type_name -> ypb.SyntaxFlowScanTaskFilter
+	567, // 407: ypb.SyntaxFlowScanResponse.ExecResult:type_name -> ypb.ExecResult
+	673, // 408: ypb.SyntaxFlowScanResponse.Result:type_name -> ypb.SyntaxFlowResult
+	337, // 409: ypb.SyntaxFlowScanResponse.risks:type_name -> ypb.Risk
+	686, // 410: ypb.SyntaxFlowScanResponse.SSARisks:type_name -> ypb.SSARisk
+	515, // 411: ypb.QuerySyntaxFlowResultRequest.Pagination:type_name -> ypb.Paging
+	670, // 412: ypb.QuerySyntaxFlowResultRequest.Filter:type_name -> ypb.SyntaxFlowResultFilter
+	515, // 413: ypb.QuerySyntaxFlowResultResponse.Pagination:type_name -> ypb.Paging
+	607, // 414: ypb.QuerySyntaxFlowResultResponse.DbMessage:type_name -> ypb.DbOperateMessage
+	673, // 415: ypb.QuerySyntaxFlowResultResponse.Results:type_name -> ypb.SyntaxFlowResult
+	670, // 416: ypb.DeleteSyntaxFlowResultRequest.Filter:type_name -> ypb.SyntaxFlowResultFilter
+	607, // 417: ypb.DeleteSyntaxFlowResultResponse.Message:type_name -> ypb.DbOperateMessage
+	507, // 418: ypb.PluginEnvData.Env:type_name -> ypb.KVPair
+	682, // 419: ypb.GetAllFuzztagInfoResponse.Data:type_name -> ypb.FuzztagInfo
+	681, // 420: ypb.FuzztagInfo.ArgumentTypes:type_name -> ypb.FuzztagArgumentType
+	189, // 421: ypb.GenerateFuzztagRequest.Range:type_name -> ypb.Range
+	6,   // 422: ypb.GenerateFuzztagResponse.Status:type_name -> ypb.GeneralResponse
+	515, // 423: ypb.QuerySSARisksRequest.Pagination:type_name -> ypb.Paging
+	687, // 424: ypb.QuerySSARisksRequest.Filter:type_name -> ypb.SSARisksFilter
+	515, // 425: ypb.QuerySSARisksResponse.Pagination:type_name -> ypb.Paging
+	686, // 426: ypb.QuerySSARisksResponse.Data:type_name -> ypb.SSARisk
+	686, // 427: ypb.QueryNewSSARisksResponse.Data:type_name -> ypb.SSARisk
+	687, // 428: ypb.DeleteSSARisksRequest.Filter:type_name -> ypb.SSARisksFilter
+	344, // 429: ypb.SSARiskFieldGroupResponse.FileField:type_name -> ypb.FieldGroup
+	287, // 430: ypb.SSARiskFieldGroupResponse.SeverityField:type_name -> ypb.FieldName
+	287, // 431: ypb.SSARiskFieldGroupResponse.RiskTypeField:type_name -> ypb.FieldName
+	687, // 432: ypb.NewSSARiskReadRequest.Filter:type_name -> ypb.SSARisksFilter
+	687, // 433: ypb.SSARiskFeedbackToOnlineRequest.Filter:type_name -> ypb.SSARisksFilter
+	629, // 434: ypb.ExportSyntaxFlowsRequest.Filter:type_name -> ypb.SyntaxFlowRuleFilter
+	702, // 435: ypb.UpdateHotPatchTemplateRequest.Condition:type_name -> ypb.HotPatchTemplateRequest
+	701, // 436: ypb.UpdateHotPatchTemplateRequest.Data:type_name -> ypb.HotPatchTemplate
+	702, // 437: ypb.DeleteHotPatchTemplateRequest.Condition:type_name -> ypb.HotPatchTemplateRequest
+	607, // 438: ypb.CreateHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
+	607, // 439: ypb.DeleteHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
+	607, // 440: ypb.UpdateHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
+	607, // 441: ypb.QueryHotPatchTemplateResponse.Message:type_name -> ypb.DbOperateMessage
+	701, // 442: ypb.QueryHotPatchTemplateResponse.Data:type_name -> ypb.HotPatchTemplate
+	515, // 443: ypb.QueryHotPatchTemplateListResponse.Pagination:type_name -> ypb.Paging
+	520, // 444: ypb.ExportHTTPFlowStreamRequest.Filter:type_name -> ypb.QueryHTTPFlowRequest
+	719, // 445: ypb.NoteContent.Note:type_name -> ypb.Note
+	607, // 446: ypb.CreateNoteResponse.Message:type_name -> ypb.DbOperateMessage
+	721, // 447: ypb.UpdateNoteRequest.Filter:type_name -> ypb.NoteFilter
+	721, // 448: ypb.DeleteNoteRequest.Filter:type_name -> ypb.NoteFilter
+	721, // 449: ypb.QueryNoteRequest.Filter:type_name -> ypb.NoteFilter
+	515, // 450: ypb.QueryNoteRequest.Pagination:type_name -> ypb.Paging
+	515, // 451: ypb.QueryNoteResponse.Pagination:type_name -> ypb.Paging
+	719, // 452: ypb.QueryNoteResponse.Data:type_name -> ypb.Note
+	515, // 453: ypb.SearchNoteContentRequest.Pagination:type_name -> ypb.Paging
+	515, // 454: ypb.SearchNoteContentResponse.Pagination:type_name -> ypb.Paging
+	720, // 455: ypb.SearchNoteContentResponse.Data:type_name -> ypb.NoteContent
+	721, // 456: ypb.ExportNoteRequest.Filter:type_name -> ypb.NoteFilter
+	742, // 457: ypb.GetSupportedLocalModelsResponse.Models:type_name -> ypb.LocalModelConfig
+	552, // 458: ypb.MITMV2Request.Certificates:type_name -> ypb.Certificate
+	507, // 459: ypb.MITMV2Request.hosts:type_name -> ypb.KVPair
+	551, // 460: ypb.MITMV2Request.FilterData:type_name -> ypb.MITMFilterData
+	551, // 461: ypb.MITMV2Request.HijackFilterData:type_name -> ypb.MITMFilterData
+	553, // 462: ypb.MITMV2Request.Replacers:type_name -> ypb.MITMContentReplacer
+	565, // 463: ypb.MITMV2Request.YakScriptParams:type_name -> ypb.ExecParamItem
+	554, // 464: ypb.MITMV2Request.RemoveHookParams:type_name -> ypb.RemoveHookParams
+	746, // 465: ypb.MITMV2Request.ManualHijackMessage:type_name -> ypb.SingleManualHijackControlMessage
+	551, // 466: ypb.MITMV2Response.FilterData:type_name -> ypb.MITMFilterData
+	553, // 467: ypb.MITMV2Response.Replacers:type_name -> ypb.MITMContentReplacer
+	567, // 468: ypb.MITMV2Response.Message:type_name -> ypb.ExecResult
+	557, // 469: ypb.MITMV2Response.Hooks:type_name -> ypb.YakScriptHooks
+	747, // 470: ypb.MITMV2Response.ManualHijackList:type_name -> ypb.SingleManualHijackInfoMessage
+	556, // 471: ypb.SingleManualHijackInfoMessage.TraceInfo:type_name -> ypb.TraceInfo
+	265, // 472: ypb.QueryMITMReplacerRulesResponse.Rules:type_name -> ypb.MITMContentReplacers
+	264, // 473: ypb.ExtractDataToFileRequest.DataEntry.value:type_name -> ypb.ExtractableData
+	293, // 474: ypb.YsoClassGeneraterOptionsWithVerbose.BindOptionsEntry.value:type_name -> ypb.YsoClassOptionsResponseWithVerbose
+	627, // 475: ypb.SyntaxFlowRule.AlertMsgEntry.value:type_name -> ypb.AlertMessage
+	627, // 476: ypb.SyntaxFlowRuleInput.AlertMsgEntry.value:type_name -> ypb.AlertMessage
+	4,   // 477: ypb.Yak.Version:input_type -> ypb.Empty
+	583, // 478: ypb.Yak.YakVersionAtLeast:input_type -> ypb.YakVersionAtLeastRequest
+	559, // 479: ypb.Yak.Echo:input_type -> ypb.EchoRequest
+	561, // 480: ypb.Yak.Handshake:input_type -> ypb.HandshakeRequest
+	4,   // 481: ypb.Yak.VerifySystemCertificate:input_type -> ypb.Empty
+	549, // 482: ypb.Yak.MITM:input_type -> ypb.MITMRequest
+	547, // 483: ypb.Yak.SetMITMFilter:input_type -> ypb.SetMITMFilterRequest
+	4,   // 484: ypb.Yak.GetMITMFilter:input_type -> ypb.Empty
+	4,   // 485: ypb.Yak.ResetMITMFilter:input_type -> ypb.Empty
+	4,   // 486: ypb.Yak.DownloadMITMCert:input_type -> ypb.Empty
+	744, // 487: ypb.Yak.MITMV2:input_type -> ypb.MITMV2Request
+	563, // 488: ypb.Yak.OpenPort:input_type -> ypb.Input
+	566, // 489: ypb.Yak.Exec:input_type -> ypb.ExecRequest
+	485, // 490: ypb.Yak.QueryExecHistory:input_type -> ypb.ExecHistoryRequest
+	4,   // 491: ypb.Yak.RemoveExecHistory:input_type -> ypb.Empty
+	4,   // 492: ypb.Yak.LoadNucleiTemplates:input_type -> ypb.Empty
+	4,   // 493: ypb.Yak.AutoUpdateYakModule:input_type -> ypb.Empty
+	566, // 494: ypb.Yak.ExecYakScript:input_type -> ypb.ExecRequest
+	8,   // 495: ypb.Yak.ExecBatchYakScript:input_type -> ypb.ExecBatchYakScriptRequest
+	4,   // 496: ypb.Yak.GetExecBatchYakScriptUnfinishedTask:input_type -> ypb.Empty
+	230, // 497: ypb.Yak.GetExecBatchYakScriptUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
+	230, // 498: ypb.Yak.PopExecBatchYakScriptUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
+	231, // 499: ypb.Yak.RecoverExecBatchYakScriptUnfinishedTask:input_type -> ypb.RecoverExecBatchYakScriptUnfinishedTaskRequest
+	447, // 500: ypb.Yak.QueryYakScript:input_type -> ypb.QueryYakScriptRequest
+	447, // 501: ypb.Yak.QueryYakScriptByYakScriptName:input_type -> ypb.QueryYakScriptRequest
+	451, // 502: ypb.Yak.SaveYakScript:input_type -> ypb.YakScript
+	7,   // 503: ypb.Yak.DeleteYakScript:input_type -> ypb.DeleteYakScriptRequest
+	10,  // 504: ypb.Yak.GetYakScriptById:input_type -> ypb.GetYakScriptByIdRequest
+	11,  // 505: ypb.Yak.GetYakScriptByName:input_type -> ypb.GetYakScriptByNameRequest
+	12,  // 506: ypb.Yak.GetYakScriptByOnlineID:input_type -> ypb.GetYakScriptByOnlineIDRequest
+	7,   // 507: ypb.Yak.IgnoreYakScript:input_type -> ypb.DeleteYakScriptRequest
+	7,   // 508: ypb.Yak.UnIgnoreYakScript:input_type -> ypb.DeleteYakScriptRequest
+	384, // 509: ypb.Yak.ExportYakScript:input_type -> ypb.ExportYakScriptRequest
+	385, // 510: ypb.Yak.ExportYakScriptStream:input_type -> ypb.ExportYakScriptStreamRequest
+	386, // 511: ypb.Yak.ImportYakScriptStream:input_type -> ypb.ImportYakScriptStreamRequest
+	314, // 512: ypb.Yak.ExecutePacketYakScript:input_type -> ypb.ExecutePacketYakScriptParams
+	315, // 513: ypb.Yak.ExecuteBatchPacketYakScript:input_type -> ypb.ExecuteBatchPacketYakScriptParams
+	4,   // 514: ypb.Yak.GetYakScriptTags:input_type -> ypb.Empty
+	252, // 515: ypb.Yak.QueryYakScriptLocalAndUser:input_type -> ypb.QueryYakScriptLocalAndUserRequest
+	254, // 516: ypb.Yak.QueryYakScriptByOnlineGroup:input_type -> ypb.QueryYakScriptByOnlineGroupRequest
+	4,   // 517: ypb.Yak.QueryYakScriptLocalAll:input_type -> ypb.Empty
+	255, // 518: ypb.Yak.QueryYakScriptByNames:input_type -> ypb.QueryYakScriptByNamesRequest
+	256, // 519: ypb.Yak.QueryYakScriptByIsCore:input_type -> ypb.QueryYakScriptByIsCoreRequest
+	259, // 520: ypb.Yak.QueryYakScriptRiskDetailByCWE:input_type -> ypb.QueryYakScriptRiskDetailByCWERequest
+	4,   // 521: ypb.Yak.YakScriptRiskTypeList:input_type -> ypb.Empty
+	453, // 522: ypb.Yak.SaveNewYakScript:input_type -> ypb.SaveNewYakScriptRequest
+	454, // 523: ypb.Yak.SaveYakScriptToOnline:input_type -> ypb.SaveYakScriptToOnlineRequest
+	457, // 524: ypb.Yak.ExportLocalYakScript:input_type -> ypb.ExportLocalYakScriptRequest
+	457, // 525: ypb.Yak.ExportLocalYakScriptStream:input_type -> ypb.ExportLocalYakScriptRequest
+	460, // 526: ypb.Yak.ImportYakScript:input_type -> ypb.ImportYakScriptRequest
+	462, // 527: ypb.Yak.SetYakScriptSkipUpdate:input_type -> ypb.SetYakScriptSkipUpdateRequest
+	447, // 528: ypb.Yak.QueryYakScriptSkipUpdate:input_type -> ypb.QueryYakScriptRequest
+	464, // 529: ypb.Yak.QueryYakScriptGroup:input_type -> ypb.QueryYakScriptGroupRequest
+	467, // 530: ypb.Yak.SaveYakScriptGroup:input_type -> ypb.SaveYakScriptGroupRequest
+	468, // 531: ypb.Yak.RenameYakScriptGroup:input_type -> ypb.RenameYakScriptGroupRequest
+	469, // 532: ypb.Yak.DeleteYakScriptGroup:input_type -> ypb.DeleteYakScriptGroupRequest
+	447, // 533: ypb.Yak.GetYakScriptGroup:input_type -> ypb.QueryYakScriptRequest
+	471, // 534: ypb.Yak.ResetYakScriptGroup:input_type -> ypb.ResetYakScriptGroupRequest
+	472, // 535: ypb.Yak.SetGroup:input_type -> ypb.SetGroupRequest
+	516, // 536: ypb.Yak.GetHTTPFlowByHash:input_type -> ypb.GetHTTPFlowByHashRequest
+	517, // 537: ypb.Yak.GetHTTPFlowById:input_type -> ypb.GetHTTPFlowByIdRequest
+	519, // 538: ypb.Yak.GetHTTPFlowBodyById:input_type -> ypb.GetHTTPFlowBodyByIdRequest
+	518, // 539: ypb.Yak.GetHTTPFlowByIds:input_type -> ypb.GetHTTPFlowByIdsRequest
+	520, // 540: ypb.Yak.QueryHTTPFlows:input_type -> ypb.QueryHTTPFlowRequest
+	530, // 541: ypb.Yak.DeleteHTTPFlows:input_type -> ypb.DeleteHTTPFlowRequest
+	278, // 542: ypb.Yak.SetTagForHTTPFlow:input_type -> ypb.SetTagForHTTPFlowRequest
+	531, // 543: ypb.Yak.QueryHTTPFlowsIds:input_type -> ypb.QueryHTTPFlowsIdsRequest
+	539, // 544: ypb.Yak.HTTPFlowsFieldGroup:input_type -> ypb.HTTPFlowsFieldGroupRequest
+	541, // 545: ypb.Yak.HTTPFlowsShare:input_type -> ypb.HTTPFlowsShareRequest
+	543, // 546: ypb.Yak.HTTPFlowsExtract:input_type -> ypb.HTTPFlowsExtractRequest
+	571, // 547: ypb.Yak.GetHTTPFlowBare:input_type -> ypb.HTTPFlowBareRequest
+	528, // 548: ypb.Yak.ExportHTTPFlows:input_type -> ypb.ExportHTTPFlowsRequest
+	521, // 549: ypb.Yak.HTTPFlowsToOnline:input_type -> ypb.HTTPFlowsToOnlineRequest
+	520, // 550: ypb.Yak.QueryHTTPFlowsProcessNames:input_type -> ypb.QueryHTTPFlowRequest
+	522, // 551: ypb.Yak.AnalyzeHTTPFlow:input_type -> ypb.AnalyzeHTTPFlowRequest
+	505, // 552: ypb.Yak.ExtractUrl:input_type -> ypb.FuzzerRequest
+	308, // 553: ypb.Yak.GetHistoryHTTPFuzzerTask:input_type -> ypb.GetHistoryHTTPFuzzerTaskRequest
+	4,   // 554: ypb.Yak.QueryHistoryHTTPFuzzerTask:input_type -> ypb.Empty
+	313, // 555: ypb.Yak.QueryHistoryHTTPFuzzerTaskEx:input_type -> ypb.QueryHistoryHTTPFuzzerTaskExParams
+	284, // 556: ypb.Yak.DeleteHistoryHTTPFuzzerTask:input_type -> ypb.DeleteHistoryHTTPFuzzerTaskRequest
+	505, // 557: ypb.Yak.HTTPFuzzer:input_type -> ypb.FuzzerRequest
+	504, // 558: ypb.Yak.HTTPFuzzerSequence:input_type -> ypb.FuzzerRequests
+	501, // 559: ypb.Yak.PreloadHTTPFuzzerParams:input_type -> ypb.PreloadHTTPFuzzerParamsRequest
+	494, // 560: ypb.Yak.RenderVariables:input_type -> ypb.RenderVariablesRequest
+	496, // 561: ypb.Yak.MatchHTTPResponse:input_type -> ypb.MatchHTTPResponseParams
+	500, // 562: ypb.Yak.ExtractHTTPResponse:input_type -> ypb.ExtractHTTPResponseParams
+	509, // 563: ypb.Yak.RedirectRequest:input_type -> ypb.RedirectRequestParams
+	360, // 564: ypb.Yak.HTTPRequestMutate:input_type -> ypb.HTTPRequestMutateParams
+	361, // 565: ypb.Yak.HTTPResponseMutate:input_type -> ypb.HTTPResponseMutateParams
+	243, // 566: ypb.Yak.FixUploadPacket:input_type -> ypb.FixUploadPacketRequest
+	243, // 567: ypb.Yak.IsMultipartFormDataRequest:input_type -> ypb.FixUploadPacketRequest
+	178, // 568: ypb.Yak.GenerateExtractRule:input_type -> ypb.GenerateExtractRuleRequest
+	177, // 569: ypb.Yak.ExtractData:input_type -> ypb.ExtractDataRequest
+	573, // 570: ypb.Yak.ImportHTTPFuzzerTaskFromYaml:input_type -> ypb.ImportHTTPFuzzerTaskFromYamlRequest
+	575, // 571: ypb.Yak.ExportHTTPFuzzerTaskToYaml:input_type -> ypb.ExportHTTPFuzzerTaskToYamlRequest
+	577, // 572: ypb.Yak.RenderHTTPFuzzerPacket:input_type -> ypb.RenderHTTPFuzzerPacketRequest
+	168, // 573: ypb.Yak.SaveFuzzerLabel:input_type -> ypb.SaveFuzzerLabelRequest
+	4,   // 574: ypb.Yak.QueryFuzzerLabel:input_type -> ypb.Empty
+	171, // 575: ypb.Yak.DeleteFuzzerLabel:input_type -> ypb.DeleteFuzzerLabelRequest
+	172, // 576: ypb.Yak.SaveFuzzerConfig:input_type -> ypb.SaveFuzzerConfigRequest
+	173, // 577: ypb.Yak.QueryFuzzerConfig:input_type -> ypb.QueryFuzzerConfigRequest
+	176, // 578: ypb.Yak.DeleteFuzzerConfig:input_type -> ypb.DeleteFuzzerConfigRequest
+	181, // 579: ypb.Yak.QueryHTTPFuzzerResponseByTaskId:input_type -> ypb.QueryHTTPFuzzerResponseByTaskIdRequest
+	185, // 580: ypb.Yak.CreateWebsocketFuzzer:input_type -> ypb.ClientWebsocketRequest
+	183, // 581: ypb.Yak.QueryWebsocketFlowByHTTPFlowWebsocketHash:input_type -> ypb.QueryWebsocketFlowByHTTPFlowWebsocketHashRequest
+	184, // 582: ypb.Yak.DeleteWebsocketFlowByHTTPFlowWebsocketHash:input_type -> ypb.DeleteWebsocketFlowByHTTPFlowWebsocketHashRequest
+	4,   // 583: ypb.Yak.DeleteWebsocketFlowAll:input_type -> ypb.Empty
+	512, // 584: ypb.Yak.ConvertFuzzerResponseToHTTPFlow:input_type -> ypb.FuzzerResponse
+	488, // 585: ypb.Yak.StringFuzzer:input_type -> ypb.StringFuzzerRequest
+	490, // 586: ypb.Yak.HTTPRequestAnalyzer:input_type -> ypb.HTTPRequestAnalysisMaterial
+	475, // 587: ypb.Yak.Codec:input_type -> ypb.CodecRequest
+	477, // 588: ypb.Yak.NewCodec:input_type -> ypb.CodecRequestFlow
+	4,   // 589: ypb.Yak.GetAllCodecMethods:input_type -> ypb.Empty
+	478, // 590: ypb.Yak.SaveCodecFlow:input_type -> ypb.CustomizeCodecFlow
+	479, // 591: ypb.Yak.DeleteCodecFlow:input_type -> ypb.DeleteCodecFlowRequest
+	4,   // 592: ypb.Yak.GetAllCodecFlow:input_type -> ypb.Empty
+	75,  // 593: ypb.Yak.PacketPrettifyHelper:input_type -> ypb.PacketPrettifyHelperRequest
+	441, // 594: ypb.Yak.QueryPayload:input_type -> ypb.QueryPayloadRequest
+	439, // 595: ypb.Yak.QueryPayloadFromFile:input_type -> ypb.QueryPayloadFromFileRequest
+	429, // 596: ypb.Yak.DeletePayloadByFolder:input_type -> ypb.NameRequest
+	437, // 597: ypb.Yak.DeletePayloadByGroup:input_type -> ypb.DeletePayloadByGroupRequest
+	438, // 598: ypb.Yak.DeletePayload:input_type -> ypb.DeletePayloadRequest
+	433, // 599: ypb.Yak.SavePayload:input_type -> ypb.SavePayloadRequest
+	433, // 600: ypb.Yak.SavePayloadStream:input_type -> ypb.SavePayloadRequest
+	433, // 601: ypb.Yak.SavePayloadToFileStream:input_type -> ypb.SavePayloadRequest
+	433, // 602: ypb.Yak.SaveLargePayloadToFileStream:input_type -> ypb.SavePayloadRequest
+	428, // 603: ypb.Yak.RenamePayloadFolder:input_type -> ypb.RenameRequest
+	428, // 604: ypb.Yak.RenamePayloadGroup:input_type -> ypb.RenameRequest
+	434, // 605: ypb.Yak.UpdatePayload:input_type -> ypb.UpdatePayloadRequest
+	435, // 606: ypb.Yak.UpdatePayloadToFile:input_type -> ypb.UpdatePayloadToFileRequest
+	436, // 607: ypb.Yak.BackUpOrCopyPayloads:input_type -> ypb.BackUpOrCopyPayloadsRequest
+	4,   // 608: ypb.Yak.GetAllPayloadGroup:input_type -> ypb.Empty
+	432, // 609: ypb.Yak.UpdateAllPayloadGroup:input_type -> ypb.UpdateAllPayloadGroupRequest
+	444, // 610: ypb.Yak.GetAllPayload:input_type -> ypb.GetAllPayloadRequest
+	444, // 611: ypb.Yak.GetAllPayloadFromFile:input_type -> ypb.GetAllPayloadRequest
+	444, // 612: ypb.Yak.ExportAllPayload:input_type -> ypb.GetAllPayloadRequest
+	444, // 613: ypb.Yak.ExportAllPayloadFromFile:input_type -> ypb.GetAllPayloadRequest
+	429, // 614: ypb.Yak.CreatePayloadFolder:input_type -> ypb.NameRequest
+	429, // 615: ypb.Yak.RemoveDuplicatePayloads:input_type -> ypb.NameRequest
+	429, // 616: ypb.Yak.CoverPayloadGroupToDatabase:input_type -> ypb.NameRequest
+	429, // 617: ypb.Yak.ConvertPayloadGroupToDatabase:input_type -> ypb.NameRequest
+	4,   // 618: ypb.Yak.MigratePayloads:input_type -> ypb.Empty
+	4,   // 619: ypb.Yak.GetYakitCompletionRaw:input_type -> ypb.Empty
+	424, // 620: ypb.Yak.GetYakVMBuildInMethodCompletion:input_type -> ypb.GetYakVMBuildInMethodCompletionRequest
+	201, // 621: ypb.Yak.StaticAnalyzeError:input_type -> ypb.StaticAnalyzeErrorRequest
+	202, // 622: ypb.Yak.YaklangCompileAndFormat:input_type -> ypb.YaklangCompileAndFormatRequest
+	191, // 623: ypb.Yak.YaklangLanguageSuggestion:input_type -> ypb.YaklangLanguageSuggestionRequest
+	191, // 624: ypb.Yak.YaklangLanguageFind:input_type -> ypb.YaklangLanguageSuggestionRequest
+	685, // 625: ypb.Yak.FuzzTagSuggestion:input_type -> ypb.FuzzTagSuggestionRequest
+	190, // 626: ypb.Yak.YaklangInspectInformation:input_type -> ypb.YaklangInspectInformationRequest
+	200, // 627: ypb.Yak.YaklangGetCliCodeFromDatabase:input_type -> ypb.YaklangGetCliCodeFromDatabaseRequest
+	563, // 628: ypb.Yak.YaklangTerminal:input_type -> ypb.Input
+	418, // 629: ypb.Yak.PortScan:input_type -> ypb.PortScanRequest
+	4,   // 630: ypb.Yak.ViewPortScanCode:input_type -> ypb.Empty
+	416, // 631: ypb.Yak.SimpleDetect:input_type -> ypb.RecordPortScanRequest
+	416, // 632: ypb.Yak.SaveCancelSimpleDetect:input_type -> ypb.RecordPortScanRequest
+	417, // 633: ypb.Yak.SimpleDetectCreatReport:input_type -> ypb.CreatReportRequest
+	237, // 634: ypb.Yak.QuerySimpleDetectUnfinishedTask:input_type -> ypb.QueryUnfinishedTaskRequest
+	241, // 635: ypb.Yak.GetSimpleDetectRecordRequestById:input_type -> ypb.GetUnfinishedTaskDetailByIdRequest
+	238, // 636: ypb.Yak.DeleteSimpleDetectUnfinishedTask:input_type -> ypb.DeleteUnfinishedTaskRequest
+	242, // 637: ypb.Yak.RecoverSimpleDetectTask:input_type -> ypb.RecoverUnfinishedTaskRequest
+	4,   // 638: ypb.Yak.GetSimpleDetectUnfinishedTask:input_type -> ypb.Empty
+	230, // 639: ypb.Yak.GetSimpleDetectUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
+	230, // 640: ypb.Yak.PopSimpleDetectUnfinishedTaskByUid:input_type -> ypb.GetExecBatchYakScriptUnfinishedTaskByUidRequest
+	231, // 641: ypb.Yak.RecoverSimpleDetectUnfinishedTask:input_type -> ypb.RecoverExecBatchYakScriptUnfinishedTaskRequest
+	420, // 642: ypb.Yak.QueryPorts:input_type -> ypb.QueryPortsRequest
+	419, // 643: ypb.Yak.DeletePorts:input_type -> ypb.DeletePortsRequest
+	363, // 644: ypb.Yak.QueryHosts:input_type -> ypb.QueryHostsRequest
+	364, // 645: ypb.Yak.DeleteHosts:input_type -> ypb.DeleteHostsRequest
+	366, // 646: ypb.Yak.QueryDomains:input_type -> ypb.QueryDomainsRequest
+	367, // 647: ypb.Yak.DeleteDomains:input_type -> ypb.DeleteDomainsRequest
+	4,   // 648: ypb.Yak.QueryPortsGroup:input_type -> ypb.Empty
+	412, // 649: ypb.Yak.UpdateFromYakitResource:input_type -> ypb.UpdateFromYakitResourceRequest
+	413, // 650: ypb.Yak.UpdateFromGithub:input_type -> ypb.UpdateFromGithubRequest
+	400, // 651: ypb.Yak.AddToMenu:input_type -> ypb.AddToMenuRequest
+	399, // 652: ypb.Yak.RemoveFromMenu:input_type -> ypb.RemoveFromMenuRequest
+	398, // 653: ypb.Yak.YakScriptIsInMenu:input_type -> ypb.YakScriptIsInMenuRequest
+	4,   // 654: ypb.Yak.GetAllMenuItem:input_type -> ypb.Empty
+	4,   // 655: ypb.Yak.DeleteAllMenuItem:input_type -> ypb.Empty
+	403, // 656: ypb.Yak.ImportMenuItem:input_type -> ypb.ImportMenuItemRequest
+	4,   // 657: ypb.Yak.ExportMenuItem:input_type -> ypb.Empty
+	396, // 658: ypb.Yak.GetMenuItemById:input_type -> ypb.GetMenuItemByIdRequest
+	392, // 659: ypb.Yak.QueryGroupsByYakScriptId:input_type -> ypb.QueryGroupsByYakScriptIdRequest
+	401, // 660: ypb.Yak.AddMenus:input_type -> ypb.AddMenuRequest
+	402, // 661: ypb.Yak.QueryAllMenuItem:input_type -> ypb.QueryAllMenuItemRequest
+	402, // 662: ypb.Yak.DeleteAllMenu:input_type -> ypb.QueryAllMenuItemRequest
+	405, // 663: ypb.Yak.AddToNavigation:input_type -> ypb.AddToNavigationRequest
+	408, // 664: ypb.Yak.GetAllNavigationItem:input_type -> ypb.GetAllNavigationRequest
+	408, // 665: ypb.Yak.DeleteAllNavigation:input_type -> ypb.GetAllNavigationRequest
+	410, // 666: ypb.Yak.AddOneNavigation:input_type -> ypb.AddOneNavigationRequest
+	411, // 667: ypb.Yak.QueryNavigationGroups:input_type -> ypb.QueryNavigationGroupsRequest
+	390, // 668: ypb.Yak.SaveMarkdownDocument:input_type -> ypb.SaveMarkdownDocumentRequest
+	389, // 669: ypb.Yak.GetMarkdownDocument:input_type -> ypb.GetMarkdownDocumentRequest
+	389, // 670: ypb.Yak.DeleteMarkdownDocument:input_type -> ypb.GetMarkdownDocumentRequest
+	381, // 671: ypb.Yak.StartBasicCrawler:input_type -> ypb.StartBasicCrawlerRequest
+	4,   // 672: ypb.Yak.ViewBasicCrawlerCode:input_type -> ypb.Empty
+	380, // 673: ypb.Yak.GenerateWebsiteTree:input_type -> ypb.GenerateWebsiteTreeRequest
+	377, // 674: ypb.Yak.QueryYakScriptExecResult:input_type -> ypb.QueryYakScriptExecResultRequest
+	4,   // 675: ypb.Yak.QueryYakScriptNameInExecResult:input_type -> ypb.Empty
+	375, // 676: ypb.Yak.DeleteYakScriptExecResult:input_type -> ypb.DeleteYakScriptExecResultRequest
+	4,   // 677: ypb.Yak.DeleteYakScriptExec:input_type -> ypb.Empty
+	359, // 678: ypb.Yak.StartBrute:input_type -> ypb.StartBruteParams
+	4,   // 679: ypb.Yak.GetAvailableBruteTypes:input_type -> ypb.Empty
+	351, // 680: ypb.Yak.GetTunnelServerExternalIP:input_type -> ypb.GetTunnelServerExternalIPParams
+	349, // 681: ypb.Yak.VerifyTunnelServerDomain:input_type -> ypb.VerifyTunnelServerDomainParams
+	353, // 682: ypb.Yak.StartFacades:input_type -> ypb.StartFacadesParams
+	356, // 683: ypb.Yak.StartFacadesWithYsoObject:input_type -> ypb.StartFacadesWithYsoParams
+	354, // 684: ypb.Yak.ApplyClassToFacades:input_type -> ypb.ApplyClassToFacadesParamsWithVerbose
+	302, // 685: ypb.Yak.BytesToBase64:input_type -> ypb.BytesToBase64Request
+	334, // 686: ypb.Yak.ConfigGlobalReverse:input_type -> ypb.ConfigGlobalReverseParams
+	4,   // 687: ypb.Yak.AvailableLocalAddr:input_type -> ypb.Empty
+	4,   // 688: ypb.Yak.GetGlobalReverseServer:input_type -> ypb.Empty
+	338, // 689: ypb.Yak.QueryRisks:input_type -> ypb.QueryRisksRequest
+	336, // 690: ypb.Yak.QueryRisk:input_type -> ypb.QueryRiskRequest
+	335, // 691: ypb.Yak.DeleteRisk:input_type -> ypb.DeleteRiskRequest
+	4,   // 692: ypb.Yak.QueryAvailableRiskType:input_type -> ypb.Empty
+	4,   // 693: ypb.Yak.QueryAvailableRiskLevel:input_type -> ypb.Empty
+	4,   // 694: ypb.Yak.QueryRiskTableStats:input_type -> ypb.Empty
+	4,   // 695: ypb.Yak.ResetRiskTableStats:input_type -> ypb.Empty
+	4,   // 696: ypb.Yak.QueryAvailableTarget:input_type -> ypb.Empty
+	340, // 697: ypb.Yak.QueryNewRisk:input_type -> ypb.QueryNewRiskRequest
+	346, // 698: ypb.Yak.NewRiskRead:input_type -> ypb.NewRiskReadRequest
+	347, // 699: ypb.Yak.UploadRiskToOnline:input_type -> ypb.UploadRiskToOnlineRequest
+	348, // 700: ypb.Yak.SetTagForRisk:input_type -> ypb.SetTagForRiskRequest
+	4,   // 701: ypb.Yak.QueryRiskTags:input_type -> ypb.Empty
+	4,   // 702: ypb.Yak.RiskFieldGroup:input_type -> ypb.Empty
+	347, // 703: ypb.Yak.RiskFeedbackToOnline:input_type -> ypb.UploadRiskToOnlineRequest
+	276, // 704: ypb.Yak.QueryReports:input_type -> ypb.QueryReportsRequest
+	273, // 705: ypb.Yak.QueryReport:input_type -> ypb.QueryReportRequest
+	274, // 706: ypb.Yak.DeleteReport:input_type -> ypb.DeleteReportRequest
+	4,   // 707: ypb.Yak.QueryAvailableReportFrom:input_type -> ypb.Empty
+	374, // 708: ypb.Yak.DownloadReport:input_type -> ypb.DownloadReportRequest
+	4,   // 709: ypb.Yak.GetAllYsoGadgetOptions:input_type -> ypb.Empty
+	296, // 710: ypb.Yak.GetAllYsoClassOptions:input_type -> ypb.YsoOptionsRequerstWithVerbose
+	296, // 711: ypb.Yak.GetAllYsoClassGeneraterOptions:input_type -> ypb.YsoOptionsRequerstWithVerbose
+	296, // 712: ypb.Yak.GenerateYsoCode:input_type -> ypb.YsoOptionsRequerstWithVerbose
+	296, // 713: ypb.Yak.GenerateYsoBytes:input_type -> ypb.YsoOptionsRequerstWithVerbose
+	298, // 714: ypb.Yak.YsoDump:input_type -> ypb.YsoBytesObject
+	316, // 715: ypb.Yak.CreateWebShell:input_type -> ypb.WebShell
+	324, // 716: ypb.Yak.DeleteWebShell:input_type -> ypb.DeleteWebShellRequest
+	316, // 717: ypb.Yak.UpdateWebShell:input_type -> ypb.WebShell
+	321, // 718: ypb.Yak.QueryWebShells:input_type -> ypb.QueryWebShellsRequest
+	319, // 719: ypb.Yak.Ping:input_type -> ypb.WebShellRequest
+	319, // 720: ypb.Yak.GetBasicInfo:input_type -> ypb.WebShellRequest
+	317, // 721: ypb.Yak.GenerateWebShell:input_type -> ypb.ShellGenerate
+	325, // 722: ypb.Yak.SetYakBridgeLogServer:input_type -> ypb.YakDNSLogBridgeAddr
+	4,   // 723: ypb.Yak.GetCurrentYakBridgeLogServer:input_type -> ypb.Empty
+	325, // 724: ypb.Yak.RequireDNSLogDomain:input_type -> ypb.YakDNSLogBridgeAddr
+	326, // 725: ypb.Yak.RequireDNSLogDomainByScript:input_type -> ypb.RequireDNSLogDomainByScriptRequest
+	327, // 726: ypb.Yak.QueryDNSLogByToken:input_type -> ypb.QueryDNSLogByTokenRequest
+	326, // 727: ypb.Yak.QueryDNSLogTokenByScript:input_type -> ypb.RequireDNSLogDomainByScriptRequest
+	4,   // 728: ypb.Yak.RequireICMPRandomLength:input_type -> ypb.Empty
+	304, // 729: ypb.Yak.QueryICMPTrigger:input_type -> ypb.QueryICMPTriggerRequest
+	4,   // 730: ypb.Yak.RequireRandomPortToken:input_type -> ypb.Empty
+	282, // 731: ypb.Yak.QueryRandomPortTrigger:input_type -> ypb.QueryRandomPortTriggerRequest
+	4,   // 732: ypb.Yak.QuerySupportedDnsLogPlatforms:input_type -> ypb.Empty
+	4,   // 733: ypb.Yak.GetAvailableYakScriptTags:input_type -> ypb.Empty
+	4,   // 734: ypb.Yak.ForceUpdateAvailableYakScriptTags:input_type -> ypb.Empty
+	268, // 735: ypb.Yak.ExecYakitPluginsByYakScriptFilter:input_type -> ypb.ExecYakitPluginsByYakScriptFilterRequest
+	269, // 736: ypb.Yak.GenerateYakCodeByPacket:input_type -> ypb.GenerateYakCodeByPacketRequest
+	270, // 737: ypb.Yak.GenerateCSRFPocByPacket:input_type -> ypb.GenerateCSRFPocByPacketRequest
+	4,   // 738: ypb.Yak.ExportMITMReplacerRules:input_type -> ypb.Empty
+	266, // 739: ypb.Yak.ImportMITMReplacerRules:input_type -> ypb.ImportMITMReplacerRulesRequest
+	4,   // 740: ypb.Yak.GetCurrentRules:input_type -> ypb.Empty
+	265, // 741: ypb.Yak.SetCurrentRules:input_type -> ypb.MITMContentReplacers
+	748, // 742: ypb.Yak.QueryMITMReplacerRules:input_type -> ypb.QueryMITMReplacerRulesRequest
+	581, // 743: ypb.Yak.GenerateURL:input_type -> ypb.GenerateURLRequest
+	263, // 744: ypb.Yak.ExtractDataToFile:input_type -> ypb.ExtractDataToFileRequest
+	246, // 745: ypb.Yak.AutoDecode:input_type -> ypb.AutoDecodeRequest
+	4,   // 746: ypb.Yak.GetSystemProxy:input_type -> ypb.Empty
+	228, // 747: ypb.Yak.SetSystemProxy:input_type -> ypb.SetSystemProxyRequest
+	224, // 748: ypb.Yak.GetKey:input_type -> ypb.GetKeyRequest
+	223, // 749: ypb.Yak.SetKey:input_type -> ypb.SetKeyRequest
+	224, // 750: ypb.Yak.DelKey:input_type -> ypb.GetKeyRequest
+	4,   // 751: ypb.Yak.GetAllProcessEnvKey:input_type -> ypb.Empty
+	223, // 752: ypb.Yak.SetProcessEnvKey:input_type -> ypb.SetKeyRequest
+	224, // 753: ypb.Yak.GetProjectKey:input_type -> ypb.GetKeyRequest
+	223, // 754: ypb.Yak.SetProjectKey:input_type -> ypb.SetKeyRequest
+	4,   // 755: ypb.Yak.GetOnlineProfile:input_type -> ypb.Empty
+	222, // 756: ypb.Yak.SetOnlineProfile:input_type -> ypb.OnlineProfile
+	211, // 757: ypb.Yak.DownloadOnlinePluginById:input_type -> ypb.DownloadOnlinePluginByIdRequest
+	212, // 758: ypb.Yak.DownloadOnlinePluginByIds:input_type -> ypb.DownloadOnlinePluginByIdsRequest
+	210, // 759: ypb.Yak.DownloadOnlinePluginAll:input_type -> ypb.DownloadOnlinePluginByTokenRequest
+	207, // 760: ypb.Yak.DeletePluginByUserID:input_type -> ypb.DeletePluginByUserIDRequest
+	4,   // 761: ypb.Yak.DeleteAllLocalPlugins:input_type -> ypb.Empty
+	4,   // 762: ypb.Yak.GetYakScriptTagsAndType:input_type -> ypb.Empty
+	208, // 763: ypb.Yak.DeleteLocalPluginsByWhere:input_type -> ypb.DeleteLocalPluginsByWhereRequest
+	215, // 764: ypb.Yak.DownloadOnlinePluginByScriptNames:input_type -> ypb.DownloadOnlinePluginByScriptNamesRequest
+	213, // 765: ypb.Yak.DownloadOnlinePlugins:input_type -> ypb.DownloadOnlinePluginsRequest
+	213, // 766: ypb.Yak.DownloadOnlinePluginBatch:input_type -> ypb.DownloadOnlinePluginsRequest
+	215, // 767: ypb.Yak.DownloadOnlinePluginByPluginName:input_type -> ypb.DownloadOnlinePluginByScriptNamesRequest
+	218, // 768: ypb.Yak.DownloadOnlinePluginByUUID:input_type -> ypb.DownloadOnlinePluginByUUIDRequest
+	219, // 769: ypb.Yak.QueryOnlinePlugins:input_type -> ypb.QueryOnlinePluginsRequest
+	188, // 770: ypb.Yak.ExecPacketScan:input_type -> ypb.ExecPacketScanRequest
+	4,   // 771: ypb.Yak.GetEngineDefaultProxy:input_type -> ypb.Empty
+	187, // 772: ypb.Yak.SetEngineDefaultProxy:input_type -> ypb.DefaultProxyResult
+	4,   // 773: ypb.Yak.GetMachineID:input_type -> ypb.Empty
+	4,   // 774: ypb.Yak.GetLicense:input_type -> ypb.Empty
+	569, // 775: ypb.Yak.CheckLicense:input_type -> ypb.CheckLicenseRequest
+	165, // 776: ypb.Yak.GetRequestBodyByHTTPFlowID:input_type -> ypb.DownloadBodyByHTTPFlowIDRequest
+	165, // 777: ypb.Yak.GetResponseBodyByHTTPFlowID:input_type -> ypb.DownloadBodyByHTTPFlowIDRequest
+	164, // 778: ypb.Yak.GetHTTPPacketBody:input_type -> ypb.GetHTTPPacketBodyRequest
+	162, // 779: ypb.Yak.RegisterFacadesHTTP:input_type -> ypb.RegisterFacadesHTTPRequest
+	161, // 780: ypb.Yak.ResetAndInvalidUserData:input_type -> ypb.ResetAndInvalidUserDataRequest
+	158, // 781: ypb.Yak.CreateYaklangShell:input_type -> ypb.YaklangShellRequest
+	157, // 782: ypb.Yak.AttachCombinedOutput:input_type -> ypb.AttachCombinedOutputRequest
+	4,   // 783: ypb.Yak.IsPrivilegedForNetRaw:input_type -> ypb.Empty
+	4,   // 784: ypb.Yak.PromotePermissionForUserPcap:input_type -> ypb.Empty
+	151, // 785: ypb.Yak.SetCurrentProject:input_type -> ypb.SetCurrentProjectRequest
+	4,   // 786: ypb.Yak.GetCurrentProject:input_type -> ypb.Empty
+	152, // 787: ypb.Yak.GetCurrentProjectEx:input_type -> ypb.GetCurrentProjectExRequest
+	148, // 788: ypb.Yak.GetProjects:input_type -> ypb.GetProjectsRequest
+	146, // 789: ypb.Yak.NewProject:input_type -> ypb.NewProjectRequest
+	146, // 790: ypb.Yak.UpdateProject:input_type -> ypb.NewProjectRequest
+	145, // 791: ypb.Yak.IsProjectNameValid:input_type -> ypb.IsProjectNameValidRequest
+	144, // 792: ypb.Yak.RemoveProject:input_type -> ypb.RemoveProjectRequest
+	153, // 793: ypb.Yak.DeleteProject:input_type -> ypb.DeleteProjectRequest
+	4,   // 794: ypb.Yak.GetDefaultProject:input_type -> ypb.Empty
+	154, // 795: ypb.Yak.GetDefaultProjectEx:input_type -> ypb.GetDefaultProjectExRequest
+	155, // 796: ypb.Yak.QueryProjectDetail:input_type -> ypb.QueryProjectDetailRequest
+	4,   // 797: ypb.Yak.GetTemporaryProject:input_type -> ypb.Empty
+	156, // 798: ypb.Yak.GetTemporaryProjectEx:input_type -> ypb.GetTemporaryProjectExRequest
+	140, // 799: ypb.Yak.ExportProject:input_type -> ypb.ExportProjectRequest
+	142, // 800: ypb.Yak.ImportProject:input_type -> ypb.ImportProjectRequest
+	4,   // 801: ypb.Yak.MigrateLegacyDatabase:input_type -> ypb.Empty
+	136, // 802: ypb.Yak.QueryMITMRuleExtractedData:input_type -> ypb.QueryMITMRuleExtractedDataRequest
+	138, // 803: ypb.Yak.ExportMITMRuleExtractedData:input_type -> ypb.ExportMITMRuleExtractedDataRequest
+	120, // 804: ypb.Yak.ImportChaosMakerRules:input_type -> ypb.ImportChaosMakerRulesRequest
+	128, // 805: ypb.Yak.QueryChaosMakerRule:input_type -> ypb.QueryChaosMakerRuleRequest
+	127, // 806: ypb.Yak.DeleteChaosMakerRuleByID:input_type -> ypb.DeleteChaosMakerRuleByIDRequest
+	124, // 807: ypb.Yak.ExecuteChaosMakerRule:input_type -> ypb.ExecuteChaosMakerRuleRequest
+	122, // 808: ypb.Yak.IsRemoteAddrAvailable:input_type -> ypb.IsRemoteAddrAvailableRequest
+	122, // 809: ypb.Yak.ConnectVulinboxAgent:input_type -> ypb.IsRemoteAddrAvailableRequest
+	88,  // 810: ypb.Yak.GetRegisteredVulinboxAgent:input_type -> ypb.GetRegisteredAgentRequest
+	87,  // 811: ypb.Yak.DisconnectVulinboxAgent:input_type -> ypb.DisconnectVulinboxAgentRequest
+	133, // 812: ypb.Yak.IsCVEDatabaseReady:input_type -> ypb.IsCVEDatabaseReadyRequest
+	131, // 813: ypb.Yak.UpdateCVEDatabase:input_type -> ypb.UpdateCVEDatabaseRequest
+	130, // 814: ypb.Yak.ExportsProfileDatabase:input_type -> ypb.ExportsProfileDatabaseRequest
+	129, // 815: ypb.Yak.ImportsProfileDatabase:input_type -> ypb.ImportsProfileDatabaseRequest
+	113, // 816: ypb.Yak.QueryCVE:input_type -> ypb.QueryCVERequest
+	112, // 817: ypb.Yak.GetCVE:input_type -> ypb.GetCVERequest
+	118, // 818: ypb.Yak.SaveTextToTemporalFile:input_type -> ypb.SaveTextToTemporalFileRequest
+	110, // 819: ypb.Yak.IsScrecorderReady:input_type -> ypb.IsScrecorderReadyRequest
+	109, // 820: ypb.Yak.InstallScrecorder:input_type -> ypb.InstallScrecorderRequest
+	108, // 821: ypb.Yak.StartScrecorder:input_type -> ypb.StartScrecorderRequest
+	103, // 822: ypb.Yak.QueryScreenRecorders:input_type -> ypb.QueryScreenRecorderRequest
+	103, // 823: ypb.Yak.DeleteScreenRecorders:input_type -> ypb.QueryScreenRecorderRequest
+	104, // 824: ypb.Yak.UploadScreenRecorders:input_type -> ypb.UploadScreenRecorderRequest
+	105, // 825: ypb.Yak.GetOneScreenRecorders:input_type -> ypb.GetOneScreenRecorderRequest
+	106, // 826: ypb.Yak.UpdateScreenRecorders:input_type -> ypb.UpdateScreenRecorderRequest
+	93,  // 827: ypb.Yak.IsVulinboxReady:input_type -> ypb.IsVulinboxReadyRequest
+	95,  // 828: ypb.Yak.InstallVulinbox:input_type -> ypb.InstallVulinboxRequest
+	96,  // 829: ypb.Yak.StartVulinbox:input_type -> ypb.StartVulinboxRequest
+	97,  // 830: ypb.Yak.GenQualityInspectionReport:input_type -> ypb.GenQualityInspectionReportRequest
+	101, // 831: ypb.Yak.HTTPRequestBuilder:input_type -> ypb.HTTPRequestBuilderParams
+	98,  // 832: ypb.Yak.DebugPlugin:input_type -> ypb.DebugPluginRequest
+	90,  // 833: ypb.Yak.SmokingEvaluatePlugin:input_type -> ypb.SmokingEvaluatePluginRequest
+	579, // 834: ypb.Yak.SmokingEvaluatePluginBatch:input_type -> ypb.SmokingEvaluatePluginBatchRequest
+	4,   // 835: ypb.Yak.GetSystemDefaultDnsServers:input_type -> ypb.Empty
+	85,  // 836: ypb.Yak.DiagnoseNetwork:input_type -> ypb.DiagnoseNetworkRequest
+	77,  // 837: ypb.Yak.DiagnoseNetworkDNS:input_type -> ypb.DiagnoseNetworkDNSRequest
+	586, // 838: ypb.Yak.TraceRoute:input_type -> ypb.TraceRouteRequest
+	79,  // 839: ypb.Yak.GetGlobalNetworkConfig:input_type -> ypb.GetGlobalNetworkConfigRequest
+	82,  // 840: ypb.Yak.SetGlobalNetworkConfig:input_type -> ypb.GlobalNetworkConfig
+	78,  // 841: ypb.Yak.ResetGlobalNetworkConfig:input_type -> ypb.ResetGlobalNetworkConfigRequest
+	80,  // 842: ypb.Yak.ValidP12PassWord:input_type -> ypb.ValidP12PassWordRequest
+	71,  // 843: ypb.Yak.RequestYakURL:input_type -> ypb.RequestYakURLParams
+	601, // 844: ypb.Yak.ReadFile:input_type -> ypb.ReadFileRequest
+	57,  // 845: ypb.Yak.GetPcapMetadata:input_type -> ypb.PcapMetadataRequest
+	68,  // 846: ypb.Yak.PcapX:input_type -> ypb.PcapXRequest
+	67,  // 847: ypb.Yak.QueryTrafficSession:input_type -> ypb.QueryTrafficSessionRequest
+	59,  // 848: ypb.Yak.QueryTrafficPacket:input_type -> ypb.QueryTrafficPacketRequest
+	60,  // 849: ypb.Yak.QueryTrafficTCPReassembled:input_type -> ypb.QueryTrafficTCPReassembledRequest
+	584, // 850: ypb.Yak.ParseTraffic:input_type -> ypb.ParseTrafficRequest
+	55,  // 851: ypb.Yak.DuplexConnection:input_type -> ypb.DuplexConnectionRequest
+	54,  // 852: ypb.Yak.HybridScan:input_type -> ypb.HybridScanRequest
+	48,  // 853: ypb.Yak.QueryHybridScanTask:input_type -> ypb.QueryHybridScanTaskRequest
+	45,  // 854: ypb.Yak.DeleteHybridScanTask:input_type -> ypb.DeleteHybridScanTaskRequest
+	42,  // 855: ypb.Yak.GetSpaceEngineStatus:input_type -> ypb.GetSpaceEngineStatusRequest
+	41,  // 856: ypb.Yak.GetSpaceEngineAccountStatus:input_type -> ypb.GetSpaceEngineAccountStatusRequest
+	84,  // 857: ypb.Yak.GetSpaceEngineAccountStatusV2:input_type -> ypb.ThirdPartyApplicationConfig
+	44,  // 858: ypb.Yak.FetchPortAssetFromSpaceEngine:input_type -> ypb.FetchPortAssetFromSpaceEngineRequest
+	588, // 859: ypb.Yak.EvaluateExpression:input_type -> ypb.EvaluateExpressionRequest
+	590, // 860: ypb.Yak.EvaluateMultiExpression:input_type -> ypb.EvaluateMultiExpressionRequest
+	4,   // 861: ypb.Yak.GetThirdPartyAppConfigTemplate:input_type -> ypb.Empty
+	4,   // 862: ypb.Yak.CheckHahValidAiConfig:input_type -> ypb.Empty
+	734, // 863: ypb.Yak.ListAiModel:input_type -> ypb.ListAiModelRequest
+	595, // 864: ypb.Yak.GetFingerprint:input_type -> ypb.GetFingerprintRequest
+	597, // 865: ypb.Yak.AddFingerprint:input_type -> ypb.AddFingerprintRequest
+	599, // 866: ypb.Yak.ModifyFingerprint:input_type -> ypb.ModifyFingerprintRequest
+	611, // 867: ypb.Yak.QueryFingerprint:input_type -> ypb.QueryFingerprintRequest
+	613, // 868: ypb.Yak.DeleteFingerprint:input_type -> ypb.DeleteFingerprintRequest
+	615, // 869: ypb.Yak.UpdateFingerprint:input_type -> ypb.UpdateFingerprintRequest
+	614, // 870: ypb.Yak.CreateFingerprint:input_type -> ypb.CreateFingerprintRequest
+	4,   // 871: ypb.Yak.RecoverBuiltinFingerprint:input_type -> ypb.Empty
+	616, // 872: ypb.Yak.CreateFingerprintGroup:input_type -> ypb.FingerprintGroup
+	4,   // 873: ypb.Yak.GetAllFingerprintGroup:input_type -> ypb.Empty
+	618, // 874: ypb.Yak.RenameFingerprintGroup:input_type -> ypb.RenameFingerprintGroupRequest
+	619, // 875: ypb.Yak.DeleteFingerprintGroup:input_type -> ypb.DeleteFingerprintGroupRequest
+	620, // 876: ypb.Yak.BatchUpdateFingerprintToGroup:input_type -> ypb.BatchUpdateFingerprintToGroupRequest
+	621, // 877: ypb.Yak.GetFingerprintGroupSetByFilter:input_type -> ypb.GetFingerprintGroupSetRequest
+	622, // 878: ypb.Yak.ExportFingerprint:input_type -> ypb.ExportFingerprintRequest
+	623, // 879: ypb.Yak.ImportFingerprint:input_type -> ypb.ImportFingerprintRequest
+	603, // 880: ypb.Yak.GetReverseShellProgramList:input_type -> ypb.GetReverseShellProgramListRequest
+	605, // 881: ypb.Yak.GenerateReverseShellCommand:input_type -> ypb.GenerateReverseShellCommandRequest
+	625, // 882: ypb.Yak.QuerySyntaxFlowRule:input_type -> ypb.QuerySyntaxFlowRuleRequest
+	640, // 883: ypb.Yak.CreateSyntaxFlowRule:input_type -> ypb.CreateSyntaxFlowRuleRequest
+	640, // 884: ypb.Yak.CreateSyntaxFlowRuleEx:input_type -> ypb.CreateSyntaxFlowRuleRequest
+	642, // 885: ypb.Yak.UpdateSyntaxFlowRule:input_type -> ypb.UpdateSyntaxFlowRuleRequest
+	642, // 886: ypb.Yak.UpdateSyntaxFlowRuleEx:input_type -> ypb.UpdateSyntaxFlowRuleRequest
+	645, // 887: ypb.Yak.DeleteSyntaxFlowRule:input_type -> ypb.DeleteSyntaxFlowRuleRequest
+	646, // 888: ypb.Yak.CheckSyntaxFlowRuleUpdate:input_type -> ypb.CheckSyntaxFlowRuleUpdateRequest
+	648, // 889: ypb.Yak.ApplySyntaxFlowRuleUpdate:input_type -> ypb.ApplySyntaxFlowRuleUpdateRequest
+	652, // 890: ypb.Yak.QuerySyntaxFlowRuleGroup:input_type -> ypb.QuerySyntaxFlowRuleGroupRequest
+	659, // 891: ypb.Yak.DeleteSyntaxFlowRuleGroup:input_type -> ypb.DeleteSyntaxFlowRuleGroupRequest
+	654, // 892: ypb.Yak.CreateSyntaxFlowRuleGroup:input_type -> ypb.CreateSyntaxFlowGroupRequest
+	655, // 893: ypb.Yak.UpdateSyntaxFlowRuleGroup:input_type -> ypb.UpdateSyntaxFlowRuleGroupRequest
+	656, // 894: ypb.Yak.UpdateSyntaxFlowRuleAndGroup:input_type -> ypb.UpdateSyntaxFlowRuleAndGroupRequest
+	657, // 895: ypb.Yak.QuerySyntaxFlowSameGroup:input_type -> ypb.QuerySyntaxFlowSameGroupRequest
+	660, // 896: ypb.Yak.SyntaxFlowRuleToOnline:input_type -> ypb.SyntaxFlowRuleToOnlineRequest
+	662, // 897: ypb.Yak.DownloadSyntaxFlowRule:input_type -> ypb.DownloadSyntaxFlowRuleRequest
+	663, // 898: ypb.Yak.SyntaxFlowScan:input_type -> ypb.SyntaxFlowScanRequest
+	664, // 899: ypb.Yak.QuerySyntaxFlowScanTask:input_type -> ypb.QuerySyntaxFlowScanTaskRequest
+	668, // 900: ypb.Yak.DeleteSyntaxFlowScanTask:input_type -> ypb.DeleteSyntaxFlowScanTaskRequest
+	671, // 901: ypb.Yak.QuerySyntaxFlowResult:input_type -> ypb.QuerySyntaxFlowResultRequest
+	674, // 902: ypb.Yak.DeleteSyntaxFlowResult:input_type -> ypb.DeleteSyntaxFlowResultRequest
+	633, // 903: ypb.Yak.QuerySSAPrograms:input_type -> ypb.QuerySSAProgramRequest
+	634, // 904: ypb.Yak.UpdateSSAProgram:input_type -> ypb.UpdateSSAProgramRequest
+	635, // 905: ypb.Yak.DeleteSSAPrograms:input_type -> ypb.DeleteSSAProgramRequest
+	637, // 906: ypb.Yak.IncrementalCompileSSAProgram:input_type -> ypb.IncrementalCompileSSAProgramRequest
+	688, // 907: ypb.Yak.QuerySSARisks:input_type -> ypb.QuerySSARisksRequest
+	690, // 908: ypb.Yak.QueryNewSSARisks:input_type -> ypb.QueryNewSSARisksRequest
+	692, // 909: ypb.Yak.DeleteSSARisks:input_type -> ypb.DeleteSSARisksRequest
+	693, // 910: ypb.Yak.UpdateSSARiskTags:input_type -> ypb.UpdateSSARiskTagsRequest
+	4,   // 911: ypb.Yak.GetSSARiskFieldGroup:input_type -> ypb.Empty
+	695, // 912: ypb.Yak.NewSSARiskRead:input_type -> ypb.NewSSARiskReadRequest
+	697, // 913: ypb.Yak.SSARiskFeedbackToOnline:input_type -> ypb.SSARiskFeedbackToOnlineRequest
+	4,   // 914: ypb.Yak.GetAllPluginEnv:input_type -> ypb.Empty
+	676, // 915: ypb.Yak.QueryPluginEnv:input_type -> ypb.QueryPluginEnvRequest
+	677, // 916: ypb.Yak.CreatePluginEnv:input_type -> ypb.PluginEnvData
+	677, // 917: ypb.Yak.SetPluginEnv:input_type -> ypb.PluginEnvData
+	678, // 918: ypb.Yak.DeletePluginEnv:input_type -> ypb.DeletePluginEnvRequest
+	679, // 919: ypb.Yak.GetAllFuzztagInfo:input_type -> ypb.GetAllFuzztagInfoRequest
+	683, // 920: ypb.Yak.GenerateFuzztag:input_type -> ypb.GenerateFuzztagRequest
+	698, // 921: ypb.Yak.ExportSyntaxFlows:input_type -> ypb.ExportSyntaxFlowsRequest
+	699, // 922: ypb.Yak.ImportSyntaxFlows:input_type -> ypb.ImportSyntaxFlowsRequest
+	701, // 923: ypb.Yak.CreateHotPatchTemplate:input_type -> ypb.HotPatchTemplate
+	704, // 924: ypb.Yak.DeleteHotPatchTemplate:input_type -> ypb.DeleteHotPatchTemplateRequest
+	703, // 925: ypb.Yak.UpdateHotPatchTemplate:input_type -> ypb.UpdateHotPatchTemplateRequest
+	702, // 926: ypb.Yak.QueryHotPatchTemplate:input_type -> ypb.HotPatchTemplateRequest
+	709, // 927: ypb.Yak.QueryHotPatchTemplateList:input_type -> ypb.QueryHotPatchTemplateListRequest
+	711, // 928: ypb.Yak.GroupTableColumn:input_type -> ypb.GroupTableColumnRequest
+	713, // 929: ypb.Yak.UploadHotPatchTemplateToOnline:input_type -> ypb.UploadHotPatchTemplateToOnlineRequest
+	714, // 930: ypb.Yak.DownloadHotPatchTemplate:input_type -> ypb.DownloadHotPatchTemplateRequest
+	547, // 931: ypb.Yak.SetMITMHijackFilter:input_type -> ypb.SetMITMFilterRequest
+	4,   // 932: ypb.Yak.GetMITMHijackFilter:input_type -> ypb.Empty
+	4,   // 933: ypb.Yak.ResetMITMHijackFilter:input_type -> ypb.Empty
+	715, // 934: ypb.Yak.ExportHTTPFlowStream:input_type -> ypb.ExportHTTPFlowStreamRequest
+	717, // 935: ypb.Yak.ImportHTTPFlowStream:input_type -> ypb.ImportHTTPFlowStreamRequest
+	722, // 936: ypb.Yak.CreateNote:input_type -> ypb.CreateNoteRequest
+	724, // 937: ypb.Yak.UpdateNote:input_type -> ypb.UpdateNoteRequest
+	725, // 938: ypb.Yak.DeleteNote:input_type -> ypb.DeleteNoteRequest
+	726, // 939: ypb.Yak.QueryNote:input_type -> ypb.QueryNoteRequest
+	728, // 940: ypb.Yak.SearchNoteContent:input_type -> ypb.SearchNoteContentRequest
+	730, // 941: ypb.Yak.ImportNote:input_type -> ypb.ImportNoteRequest
+	732, // 942: ypb.Yak.ExportNote:input_type -> ypb.ExportNoteRequest
+	27,  // 943: ypb.Yak.StartAITask:input_type -> ypb.AIInputEvent
+	32,  // 944: ypb.Yak.QueryAITask:input_type -> ypb.AITaskQueryRequest
+	28,  // 945: ypb.Yak.StartAITriage:input_type -> ypb.AITriageInputEvent
+	36,  // 946: ypb.Yak.CreateAIForge:input_type -> ypb.AIForge
+	36,  // 947: ypb.Yak.UpdateAIForge:input_type -> ypb.AIForge
+	35,  // 948: ypb.Yak.DeleteAIForge:input_type -> ypb.AIForgeFilter
+	37,  // 949: ypb.Yak.QueryAIForge:input_type -> ypb.QueryAIForgeRequest
+	39,  // 950: ypb.Yak.StartMcpServer:input_type -> ypb.StartMcpServerRequest
+	4,   // 951: ypb.Yak.GetToolSetList:input_type -> ypb.Empty
+	25,  // 952: ypb.Yak.GetAIToolList:input_type -> ypb.GetAIToolListRequest
+	20,  // 953: ypb.Yak.DeleteAITool:input_type -> ypb.DeleteAIToolRequest
+	19,  // 954: ypb.Yak.SaveAITool:input_type -> ypb.SaveAIToolRequest
+	21,  // 955: ypb.Yak.ToggleAIToolFavorite:input_type -> ypb.ToggleAIToolFavoriteRequest
+	17,  // 956: ypb.Yak.AIToolGenerateMetadata:input_type -> ypb.AIToolGenerateMetadataRequest
+	4,   // 957: ypb.Yak.IsLlamaServerReady:input_type -> ypb.Empty
+	737, // 958: ypb.Yak.IsLocalModelReady:input_type -> ypb.IsLocalModelReadyRequest
+	739, // 959: ypb.Yak.InstallLlamaServer:input_type -> ypb.InstallLlamaServerRequest
+	740, // 960: ypb.Yak.StartLocalModel:input_type -> ypb.StartLocalModelRequest
+	741, // 961: ypb.Yak.DownloadLocalModel:input_type -> ypb.DownloadLocalModelRequest
+	4,   // 962: ypb.Yak.GetSupportedLocalModels:input_type -> ypb.Empty
+	5,   // 963: ypb.Yak.Version:output_type -> ypb.VersionResponse
+	6,   // 964: ypb.Yak.YakVersionAtLeast:output_type -> ypb.GeneralResponse
+	560, // 965: ypb.Yak.Echo:output_type -> ypb.EchoResposne
+	562, // 966: ypb.Yak.Handshake:output_type -> ypb.HandshakeResponse
+	13,  // 967: ypb.Yak.VerifySystemCertificate:output_type -> ypb.VerifySystemCertificateResponse
+	555, // 968: ypb.Yak.MITM:output_type -> ypb.MITMResponse
+	548, // 969: ypb.Yak.SetMITMFilter:output_type -> ypb.SetMITMFilterResponse
+	547, // 970: ypb.Yak.GetMITMFilter:output_type -> ypb.SetMITMFilterRequest
+	547, // 971: ypb.Yak.ResetMITMFilter:output_type -> ypb.SetMITMFilterRequest
+	286, // 972: ypb.Yak.DownloadMITMCert:output_type -> ypb.MITMCert
+	745, // 973: ypb.Yak.MITMV2:output_type -> ypb.MITMV2Response
+	564, // 974: ypb.Yak.OpenPort:output_type -> ypb.Output
+	567, // 975: ypb.Yak.Exec:output_type -> ypb.ExecResult
+	486, // 976: ypb.Yak.QueryExecHistory:output_type -> ypb.ExecHistoryRecordResponse
+	4,   // 977: ypb.Yak.RemoveExecHistory:output_type -> ypb.Empty
+	4,   // 978: ypb.Yak.LoadNucleiTemplates:output_type -> ypb.Empty
+	567, // 979: ypb.Yak.AutoUpdateYakModule:output_type -> ypb.ExecResult
+	567, // 980: ypb.Yak.ExecYakScript:output_type -> ypb.ExecResult
+	9,   // 981: ypb.Yak.ExecBatchYakScript:output_type -> ypb.ExecBatchYakScriptResult
+	234, // 982: ypb.Yak.GetExecBatchYakScriptUnfinishedTask:output_type -> ypb.GetExecBatchYakScriptUnfinishedTaskResponse
+	8,   // 983: ypb.Yak.GetExecBatchYakScriptUnfinishedTaskByUid:output_type -> ypb.ExecBatchYakScriptRequest
+	8,   // 984: ypb.Yak.PopExecBatchYakScriptUnfinishedTaskByUid:output_type -> ypb.ExecBatchYakScriptRequest
+	9,   // 985: ypb.Yak.RecoverExecBatchYakScriptUnfinishedTask:output_type -> ypb.ExecBatchYakScriptResult
+	449, // 986: ypb.Yak.QueryYakScript:output_type -> ypb.QueryYakScriptResponse
+	451, // 987: ypb.Yak.QueryYakScriptByYakScriptName:output_type -> ypb.YakScript
+	451, // 988: ypb.Yak.SaveYakScript:output_type -> ypb.YakScript
+	4,   // 989: ypb.Yak.DeleteYakScript:output_type -> ypb.Empty
+	451, // 990: ypb.Yak.GetYakScriptById:output_type -> ypb.YakScript
+	451, // 991: ypb.Yak.GetYakScriptByName:output_type -> ypb.YakScript
+	451, // 992: ypb.Yak.GetYakScriptByOnlineID:output_type -> ypb.YakScript
+	4,   // 993: ypb.Yak.IgnoreYakScript:output_type -> ypb.Empty
+	4,   // 994: ypb.Yak.UnIgnoreYakScript:output_type -> ypb.Empty
+	387, // 995: ypb.Yak.ExportYakScript:output_type -> ypb.ExportYakScriptResponse
+	567, // 996: ypb.Yak.ExportYakScriptStream:output_type -> ypb.ExecResult
+	567, // 997: ypb.Yak.ImportYakScriptStream:output_type -> ypb.ExecResult
+	567, // 998: ypb.Yak.ExecutePacketYakScript:output_type -> ypb.ExecResult
+	9,   // 999: ypb.Yak.ExecuteBatchPacketYakScript:output_type -> ypb.ExecBatchYakScriptResult
+	250, // 1000: ypb.Yak.GetYakScriptTags:output_type -> ypb.GetYakScriptTagsResponse
+	253, // 1001: ypb.Yak.QueryYakScriptLocalAndUser:output_type -> ypb.QueryYakScriptLocalAndUserResponse
+	253, // 1002: ypb.Yak.QueryYakScriptByOnlineGroup:output_type -> ypb.QueryYakScriptLocalAndUserResponse
+	253, // 1003: ypb.Yak.QueryYakScriptLocalAll:output_type -> ypb.QueryYakScriptLocalAndUserResponse
+	257, // 1004: ypb.Yak.QueryYakScriptByNames:output_type -> ypb.QueryYakScriptByNamesResponse
+	258, // 1005: ypb.Yak.QueryYakScriptByIsCore:output_type -> ypb.QueryYakScriptByIsCoreResponse
+	260, // 1006: ypb.Yak.QueryYakScriptRiskDetailByCWE:output_type -> ypb.QueryYakScriptRiskDetailByCWEResponse
+	261, // 1007: ypb.Yak.YakScriptRiskTypeList:output_type -> ypb.YakScriptRiskTypeListResponse
+	451, // 1008: ypb.Yak.SaveNewYakScript:output_type -> ypb.YakScript
+	455, // 1009: ypb.Yak.SaveYakScriptToOnline:output_type -> ypb.SaveYakScriptToOnlineResponse
+	458, // 1010: ypb.Yak.ExportLocalYakScript:output_type -> ypb.ExportLocalYakScriptResponse
+	459, // 1011: ypb.Yak.ExportLocalYakScriptStream:output_type -> ypb.ExportYakScriptLocalResponse
+	461, // 1012: ypb.Yak.ImportYakScript:output_type -> ypb.ImportYakScriptResult
+	4,   // 1013: ypb.Yak.SetYakScriptSkipUpdate:output_type -> ypb.Empty
+	463, // 1014: ypb.Yak.QueryYakScriptSkipUpdate:output_type -> ypb.QueryYakScriptSkipUpdateResponse
+	465, // 1015: ypb.Yak.QueryYakScriptGroup:output_type -> ypb.QueryYakScriptGroupResponse
+	4,   // 1016: ypb.Yak.SaveYakScriptGroup:output_type -> ypb.Empty
+	4,   // 1017: ypb.Yak.RenameYakScriptGroup:output_type -> ypb.Empty
+	4,   // 1018: ypb.Yak.DeleteYakScriptGroup:output_type -> ypb.Empty
+	470, // 1019: ypb.Yak.GetYakScriptGroup:output_type -> ypb.GetYakScriptGroupResponse
+	4,   // 1020: ypb.Yak.ResetYakScriptGroup:output_type -> ypb.Empty
+	4,   // 1021: ypb.Yak.SetGroup:output_type -> ypb.Empty
+	535, // 1022: ypb.Yak.GetHTTPFlowByHash:output_type -> ypb.HTTPFlow
+	535, // 1023: ypb.Yak.GetHTTPFlowById:output_type -> ypb.HTTPFlow
+	537, // 1024: ypb.Yak.GetHTTPFlowBodyById:output_type -> ypb.GetHTTPFlowBodyByIdResponse
+	534, // 1025: ypb.Yak.GetHTTPFlowByIds:output_type -> ypb.HTTPFlows
+	538, // 1026: ypb.Yak.QueryHTTPFlows:output_type -> ypb.QueryHTTPFlowResponse
+	4,   // 1027: ypb.Yak.DeleteHTTPFlows:output_type -> ypb.Empty
+	4,   // 1028: ypb.Yak.SetTagForHTTPFlow:output_type -> ypb.Empty
+	532, // 1029: ypb.Yak.QueryHTTPFlowsIds:output_type -> ypb.QueryHTTPFlowsIdsResponse
+	540, // 1030: ypb.Yak.HTTPFlowsFieldGroup:output_type -> ypb.HTTPFlowsFieldGroupResponse
+	542, // 1031: ypb.Yak.HTTPFlowsShare:output_type -> ypb.HTTPFlowsShareResponse
+	4,   // 1032: ypb.Yak.HTTPFlowsExtract:output_type -> ypb.Empty
+	572, // 1033: ypb.Yak.GetHTTPFlowBare:output_type -> ypb.HTTPFlowBareResponse
+	538, // 1034: ypb.Yak.ExportHTTPFlows:output_type -> ypb.QueryHTTPFlowResponse
+	4,   // 1035: ypb.Yak.HTTPFlowsToOnline:output_type -> ypb.Empty
+	529, // 1036: ypb.Yak.QueryHTTPFlowsProcessNames:output_type -> ypb.QueryHTTPFlowsProcessNamesResponse
+	525, // 1037: ypb.Yak.AnalyzeHTTPFlow:output_type -> ypb.AnalyzeHTTPFlowResponse
+	510, // 1038: ypb.Yak.ExtractUrl:output_type -> ypb.ExtractedUrl
+	309, // 1039: ypb.Yak.GetHistoryHTTPFuzzerTask:output_type -> ypb.HistoryHTTPFuzzerTaskDetail
+	311, // 1040: ypb.Yak.QueryHistoryHTTPFuzzerTask:output_type -> ypb.HistoryHTTPFuzzerTasks
+	312, // 1041: ypb.Yak.QueryHistoryHTTPFuzzerTaskEx:output_type -> ypb.HistoryHTTPFuzzerTasksResponse
+	4,   // 1042: ypb.Yak.DeleteHistoryHTTPFuzzerTask:output_type -> ypb.Empty
+	512, // 1043: ypb.Yak.HTTPFuzzer:output_type -> ypb.FuzzerResponse
+	511, // 1044: ypb.Yak.HTTPFuzzerSequence:output_type -> ypb.FuzzerSequenceResponse
+	502, // 1045: ypb.Yak.PreloadHTTPFuzzerParams:output_type -> ypb.PreloadHTTPFuzzerParamsResponse
+	495, // 1046: ypb.Yak.RenderVariables:output_type -> ypb.RenderVariablesResponse
+	497, // 1047: ypb.Yak.MatchHTTPResponse:output_type -> ypb.MatchHTTPResponseResult
+	499, // 1048: ypb.Yak.ExtractHTTPResponse:output_type -> ypb.ExtractHTTPResponseResult
+	512, // 1049: ypb.Yak.RedirectRequest:output_type -> ypb.FuzzerResponse
+	362, // 1050: ypb.Yak.HTTPRequestMutate:output_type -> ypb.MutateResult
+	362, // 1051: ypb.Yak.HTTPResponseMutate:output_type -> ypb.MutateResult
+	244, // 1052: ypb.Yak.FixUploadPacket:output_type -> ypb.FixUploadPacketResponse
+	245, // 1053: ypb.Yak.IsMultipartFormDataRequest:output_type -> ypb.IsMultipartFormDataRequestResult
+	179, // 1054: ypb.Yak.GenerateExtractRule:output_type -> ypb.GenerateExtractRuleResponse
+	167, // 1055: ypb.Yak.ExtractData:output_type -> ypb.ExtractDataResponse
+	574, // 1056: ypb.Yak.ImportHTTPFuzzerTaskFromYaml:output_type -> ypb.ImportHTTPFuzzerTaskFromYamlResponse
+	576, // 1057: ypb.Yak.ExportHTTPFuzzerTaskToYaml:output_type -> ypb.ExportHTTPFuzzerTaskToYamlResponse
+	578, // 1058: ypb.Yak.RenderHTTPFuzzerPacket:output_type -> ypb.RenderHTTPFuzzerPacketResponse
+	4,   // 1059: ypb.Yak.SaveFuzzerLabel:output_type -> ypb.Empty
+	169, // 1060: ypb.Yak.QueryFuzzerLabel:output_type -> ypb.QueryFuzzerLabelResponse
+	4,   // 1061: ypb.Yak.DeleteFuzzerLabel:output_type -> ypb.Empty
+	607, // 1062: ypb.Yak.SaveFuzzerConfig:output_type -> ypb.DbOperateMessage
+	174, // 1063: ypb.Yak.QueryFuzzerConfig:output_type -> ypb.QueryFuzzerConfigResponse
+	607, // 1064: ypb.Yak.DeleteFuzzerConfig:output_type -> ypb.DbOperateMessage
+	182, // 1065: ypb.Yak.QueryHTTPFuzzerResponseByTaskId:output_type -> ypb.QueryHTTPFuzzerResponseByTaskIdResponse
+	186, // 1066: ypb.Yak.CreateWebsocketFuzzer:output_type -> ypb.ClientWebsocketResponse
+	545, // 1067: ypb.Yak.QueryWebsocketFlowByHTTPFlowWebsocketHash:output_type -> ypb.WebsocketFlows
+	4,   // 1068: ypb.Yak.DeleteWebsocketFlowByHTTPFlowWebsocketHash:output_type -> ypb.Empty
+	4,   // 1069: ypb.Yak.DeleteWebsocketFlowAll:output_type -> ypb.Empty
+	535, // 1070: ypb.Yak.ConvertFuzzerResponseToHTTPFlow:output_type -> ypb.HTTPFlow
+	489, // 1071: ypb.Yak.StringFuzzer:output_type -> ypb.StringFuzzerResponse
+	492, // 1072: ypb.Yak.HTTPRequestAnalyzer:output_type -> ypb.HTTPRequestAnalysis
+	481, // 1073: ypb.Yak.Codec:output_type -> ypb.CodecResponse
+	481, // 1074: ypb.Yak.NewCodec:output_type -> ypb.CodecResponse
+	482, // 1075: ypb.Yak.GetAllCodecMethods:output_type -> ypb.CodecMethods
+	4,   // 1076: ypb.Yak.SaveCodecFlow:output_type -> ypb.Empty
+	4,   // 1077: ypb.Yak.DeleteCodecFlow:output_type -> ypb.Empty
+	480, // 1078: ypb.Yak.GetAllCodecFlow:output_type -> ypb.GetCodecFlowResponse
+	76,  // 1079: ypb.Yak.PacketPrettifyHelper:output_type -> ypb.PacketPrettifyHelperResponse
+	442, // 1080: ypb.Yak.QueryPayload:output_type -> ypb.QueryPayloadResponse
+	440, // 1081: ypb.Yak.QueryPayloadFromFile:output_type -> ypb.QueryPayloadFromFileResponse
+	4,   // 1082: ypb.Yak.DeletePayloadByFolder:output_type -> ypb.Empty
+	4,   // 1083: ypb.Yak.DeletePayloadByGroup:output_type -> ypb.Empty
+	4,   // 1084: ypb.Yak.DeletePayload:output_type -> ypb.Empty
+	4,   // 1085: ypb.Yak.SavePayload:output_type -> ypb.Empty
+	206, // 1086: ypb.Yak.SavePayloadStream:output_type -> ypb.SavePayloadProgress
+	206, // 1087: ypb.Yak.SavePayloadToFileStream:output_type -> ypb.SavePayloadProgress
+	206, // 1088: ypb.Yak.SaveLargePayloadToFileStream:output_type -> ypb.SavePayloadProgress
+	4,   // 1089: ypb.Yak.RenamePayloadFolder:output_type -> ypb.Empty
+	4,   // 1090: ypb.Yak.RenamePayloadGroup:output_type -> ypb.Empty
+	4,   // 1091: ypb.Yak.UpdatePayload:output_type -> ypb.Empty
+	4,   // 1092: ypb.Yak.UpdatePayloadToFile:output_type -> ypb.Empty
+	4,   // 1093: ypb.Yak.BackUpOrCopyPayloads:output_type -> ypb.Empty
+	431, // 1094: ypb.Yak.GetAllPayloadGroup:output_type -> ypb.GetAllPayloadGroupResponse
+	4,   // 1095: ypb.Yak.UpdateAllPayloadGroup:output_type -> ypb.Empty
+	445, // 1096: ypb.Yak.GetAllPayload:output_type -> ypb.GetAllPayloadResponse
+	446, // 1097: ypb.Yak.GetAllPayloadFromFile:output_type -> ypb.GetAllPayloadFromFileResponse
+	445, // 1098: ypb.Yak.ExportAllPayload:output_type -> ypb.GetAllPayloadResponse
+	445, // 1099: ypb.Yak.ExportAllPayloadFromFile:output_type -> ypb.GetAllPayloadResponse
+	4,   // 1100: ypb.Yak.CreatePayloadFolder:output_type -> ypb.Empty
+	206, // 1101: ypb.Yak.RemoveDuplicatePayloads:output_type -> ypb.SavePayloadProgress
+	206, // 1102: ypb.Yak.CoverPayloadGroupToDatabase:output_type -> ypb.SavePayloadProgress
+	206, // 1103: ypb.Yak.ConvertPayloadGroupToDatabase:output_type -> ypb.SavePayloadProgress
+	206, // 1104: ypb.Yak.MigratePayloads:output_type -> ypb.SavePayloadProgress
+	423, // 1105: ypb.Yak.GetYakitCompletionRaw:output_type -> ypb.YakitCompletionRawResponse
+	427, // 1106: ypb.Yak.GetYakVMBuildInMethodCompletion:output_type -> ypb.GetYakVMBuildInMethodCompletionResponse
+	205, // 1107: ypb.Yak.StaticAnalyzeError:output_type -> ypb.StaticAnalyzeErrorResponse
+	203, // 1108: ypb.Yak.YaklangCompileAndFormat:output_type -> ypb.YaklangCompileAndFormatResponse
+	194, // 1109: ypb.Yak.YaklangLanguageSuggestion:output_type -> ypb.YaklangLanguageSuggestionResponse
+	195, // 1110: ypb.Yak.YaklangLanguageFind:output_type -> ypb.YaklangLanguageFindResponse
+	194, // 1111: ypb.Yak.FuzzTagSuggestion:output_type -> ypb.YaklangLanguageSuggestionResponse
+	196, // 1112: ypb.Yak.YaklangInspectInformation:output_type -> ypb.YaklangInspectInformationResponse
+	199, // 1113: ypb.Yak.YaklangGetCliCodeFromDatabase:output_type -> ypb.YaklangGetCliCodeFromDatabaseResponse
+	564, // 1114: ypb.Yak.YaklangTerminal:output_type -> ypb.Output
+	567, // 1115: ypb.Yak.PortScan:output_type -> ypb.ExecResult
+	414, // 1116: ypb.Yak.ViewPortScanCode:output_type -> ypb.SimpleScript
+	567, // 1117: ypb.Yak.SimpleDetect:output_type -> ypb.ExecResult
+	4,   // 1118: ypb.Yak.SaveCancelSimpleDetect:output_type -> ypb.Empty
+	567, // 1119: ypb.Yak.SimpleDetectCreatReport:output_type -> ypb.ExecResult
+	240, // 1120: ypb.Yak.QuerySimpleDetectUnfinishedTask:output_type -> ypb.QueryUnfinishedTaskResponse
+	416, // 1121: ypb.Yak.GetSimpleDetectRecordRequestById:output_type -> ypb.RecordPortScanRequest
+	4,   // 1122: ypb.Yak.DeleteSimpleDetectUnfinishedTask:output_type -> ypb.Empty
+	567, // 1123: ypb.Yak.RecoverSimpleDetectTask:output_type -> ypb.ExecResult
+	235, // 1124: ypb.Yak.GetSimpleDetectUnfinishedTask:output_type -> ypb.GetSimpleDetectUnfinishedTaskResponse
+	416, // 1125: ypb.Yak.GetSimpleDetectUnfinishedTaskByUid:output_type -> ypb.RecordPortScanRequest
+	416, // 1126: ypb.Yak.PopSimpleDetectUnfinishedTaskByUid:output_type -> ypb.RecordPortScanRequest
+	567, // 1127: ypb.Yak.RecoverSimpleDetectUnfinishedTask:output_type -> ypb.ExecResult
+	421, // 1128: ypb.Yak.QueryPorts:output_type -> ypb.QueryPortsResponse
+	4,   // 1129: ypb.Yak.DeletePorts:output_type -> ypb.Empty
+	365, // 1130: ypb.Yak.QueryHosts:output_type -> ypb.QueryHostsResponse
+	4,   // 1131: ypb.Yak.DeleteHosts:output_type -> ypb.Empty
+	368, // 1132: ypb.Yak.QueryDomains:output_type -> ypb.QueryDomainsResponse
+	4,   // 1133: ypb.Yak.DeleteDomains:output_type -> ypb.Empty
+	370, // 1134: ypb.Yak.QueryPortsGroup:output_type -> ypb.QueryPortsGroupResponse
+	4,   // 1135: ypb.Yak.UpdateFromYakitResource:output_type -> ypb.Empty
+	4,   // 1136: ypb.Yak.UpdateFromGithub:output_type -> ypb.Empty
+	4,   // 1137: ypb.Yak.AddToMenu:output_type -> ypb.Empty
+	4,   // 1138: ypb.Yak.RemoveFromMenu:output_type -> ypb.Empty
+	4,   // 1139: ypb.Yak.YakScriptIsInMenu:output_type -> ypb.Empty
+	397, // 1140: ypb.Yak.GetAllMenuItem:output_type -> ypb.MenuByGroup
+	4,   // 1141: ypb.Yak.DeleteAllMenuItem:output_type -> ypb.Empty
+	4,   // 1142: ypb.Yak.ImportMenuItem:output_type -> ypb.Empty
+	404, // 1143: ypb.Yak.ExportMenuItem:output_type -> ypb.ExportMenuItemResult
+	393, // 1144: ypb.Yak.GetMenuItemById:output_type -> ypb.MenuItem
+	391, // 1145: ypb.Yak.QueryGroupsByYakScriptId:output_type -> ypb.GroupNames
+	4,   // 1146: ypb.Yak.AddMenus:output_type -> ypb.Empty
+	397, // 1147: ypb.Yak.QueryAllMenuItem:output_type -> ypb.MenuByGroup
+	4,   // 1148: ypb.Yak.DeleteAllMenu:output_type -> ypb.Empty
+	4,   // 1149: ypb.Yak.AddToNavigation:output_type -> ypb.Empty
+	409, // 1150: ypb.Yak.GetAllNavigationItem:output_type -> ypb.GetAllNavigationItemResponse
+	4,   // 1151: ypb.Yak.DeleteAllNavigation:output_type -> ypb.Empty
+	4,   // 1152: ypb.Yak.AddOneNavigation:output_type -> ypb.Empty
+	391, // 1153: ypb.Yak.QueryNavigationGroups:output_type -> ypb.GroupNames
+	4,   // 1154: ypb.Yak.SaveMarkdownDocument:output_type -> ypb.Empty
+	388, // 1155: ypb.Yak.GetMarkdownDocument:output_type -> ypb.GetMarkdownDocumentResponse
+	4,   // 1156: ypb.Yak.DeleteMarkdownDocument:output_type -> ypb.Empty
+	567, // 1157: ypb.Yak.StartBasicCrawler:output_type -> ypb.ExecResult
+	414, // 1158: ypb.Yak.ViewBasicCrawlerCode:output_type -> ypb.SimpleScript
+	379, // 1159: ypb.Yak.GenerateWebsiteTree:output_type -> ypb.GenerateWebsiteTreeResponse
+	378, // 1160: ypb.Yak.QueryYakScriptExecResult:output_type -> ypb.QueryYakScriptExecResultResponse
+	376, // 1161: ypb.Yak.QueryYakScriptNameInExecResult:output_type -> ypb.YakScriptNames
+	4,   // 1162: ypb.Yak.DeleteYakScriptExecResult:output_type -> ypb.Empty
+	4,   // 1163: ypb.Yak.DeleteYakScriptExec:output_type -> ypb.Empty
+	567, // 1164: ypb.Yak.StartBrute:output_type -> ypb.ExecResult
+	358, // 1165: ypb.Yak.GetAvailableBruteTypes:output_type -> ypb.GetAvailableBruteTypesResponse
+	352, // 1166: ypb.Yak.GetTunnelServerExternalIP:output_type -> ypb.GetTunnelServerExternalIPResponse
+	350, // 1167: ypb.Yak.VerifyTunnelServerDomain:output_type -> ypb.VerifyTunnelServerDomainResponse
+	567, // 1168: ypb.Yak.StartFacades:output_type -> ypb.ExecResult
+	567, // 1169: ypb.Yak.StartFacadesWithYsoObject:output_type -> ypb.ExecResult
+	4,   // 1170: ypb.Yak.ApplyClassToFacades:output_type -> ypb.Empty
+	303, // 1171: ypb.Yak.BytesToBase64:output_type -> ypb.BytesToBase64Response
+	4,   // 1172: ypb.Yak.ConfigGlobalReverse:output_type -> ypb.Empty
+	332, // 1173: ypb.Yak.AvailableLocalAddr:output_type -> ypb.AvailableLocalAddrResponse
+	331, // 1174: ypb.Yak.GetGlobalReverseServer:output_type -> ypb.GetGlobalReverseServerResponse
+	339, // 1175: ypb.Yak.QueryRisks:output_type -> ypb.QueryRisksResponse
+	337, // 1176: ypb.Yak.QueryRisk:output_type -> ypb.Risk
+	4,   // 1177: ypb.Yak.DeleteRisk:output_type -> ypb.Empty
+	288, // 1178: ypb.Yak.QueryAvailableRiskType:output_type -> ypb.Fields
+	288, // 1179: ypb.Yak.QueryAvailableRiskLevel:output_type -> ypb.Fields
+	285, // 1180: ypb.Yak.QueryRiskTableStats:output_type -> ypb.RiskTableStats
+	4,   // 1181: ypb.Yak.ResetRiskTableStats:output_type -> ypb.Empty
+	288, // 1182: ypb.Yak.QueryAvailableTarget:output_type -> ypb.Fields
+	341, // 1183: ypb.Yak.QueryNewRisk:output_type -> ypb.QueryNewRiskResponse
+	4,   // 1184: ypb.Yak.NewRiskRead:output_type -> ypb.Empty
+	4,   // 1185: ypb.Yak.UploadRiskToOnline:output_type -> ypb.Empty
+	4,   // 1186: ypb.Yak.SetTagForRisk:output_type -> ypb.Empty
+	342, // 1187: ypb.Yak.QueryRiskTags:output_type -> ypb.QueryRiskTagsResponse
+	343, // 1188: ypb.Yak.RiskFieldGroup:output_type -> ypb.RiskFieldGroupResponse
+	4,   // 1189: ypb.Yak.RiskFeedbackToOnline:output_type -> ypb.Empty
+	275, // 1190: ypb.Yak.QueryReports:output_type -> ypb.QueryReportsResponse
+	277, // 1191: ypb.Yak.QueryReport:output_type -> ypb.Report
+	4,   // 1192: ypb.Yak.DeleteReport:output_type -> ypb.Empty
+	288, // 1193: ypb.Yak.QueryAvailableReportFrom:output_type -> ypb.Fields
+	4,   // 1194: ypb.Yak.DownloadReport:output_type -> ypb.Empty
+	290, // 1195: ypb.Yak.GetAllYsoGadgetOptions:output_type -> ypb.YsoOptionsWithVerbose
+	290, // 1196: ypb.Yak.GetAllYsoClassOptions:output_type -> ypb.YsoOptionsWithVerbose
+	293, // 1197: ypb.Yak.GetAllYsoClassGeneraterOptions:output_type -> ypb.YsoClassOptionsResponseWithVerbose
+	300, // 1198: ypb.Yak.GenerateYsoCode:output_type -> ypb.YsoCodeResponse
+	301, // 1199: ypb.Yak.GenerateYsoBytes:output_type -> ypb.YsoBytesResponse
+	299, // 1200: ypb.Yak.YsoDump:output_type -> ypb.YsoDumpResponse
+	316, // 1201: ypb.Yak.CreateWebShell:output_type -> ypb.WebShell
+	4,   // 1202: ypb.Yak.DeleteWebShell:output_type -> ypb.Empty
+	316, // 1203: ypb.Yak.UpdateWebShell:output_type -> ypb.WebShell
+	322, // 1204: ypb.Yak.QueryWebShells:output_type -> ypb.QueryWebShellsResponse
+	320, // 1205: ypb.Yak.Ping:output_type -> ypb.WebShellResponse
+	320, // 1206: ypb.Yak.GetBasicInfo:output_type -> ypb.WebShellResponse
+	320, // 1207: ypb.Yak.GenerateWebShell:output_type -> ypb.WebShellResponse
+	4,   // 1208: ypb.Yak.SetYakBridgeLogServer:output_type -> ypb.Empty
+	325, // 1209: ypb.Yak.GetCurrentYakBridgeLogServer:output_type -> ypb.YakDNSLogBridgeAddr
+	330, // 1210: ypb.Yak.RequireDNSLogDomain:output_type -> ypb.DNSLogRootDomain
+	330, // 1211: ypb.Yak.RequireDNSLogDomainByScript:output_type -> ypb.DNSLogRootDomain
+	328, // 1212: ypb.Yak.QueryDNSLogByToken:output_type -> ypb.QueryDNSLogByTokenResponse
+	328, // 1213: ypb.Yak.QueryDNSLogTokenByScript:output_type -> ypb.QueryDNSLogByTokenResponse
+	280, // 1214: ypb.Yak.RequireICMPRandomLength:output_type -> ypb.RequireICMPRandomLengthResponse
+	305, // 1215: ypb.Yak.QueryICMPTrigger:output_type -> ypb.QueryICMPTriggerResponse
+	283, // 1216: ypb.Yak.RequireRandomPortToken:output_type -> ypb.RandomPortInfo
+	281, // 1217: ypb.Yak.QueryRandomPortTrigger:output_type -> ypb.RandomPortTriggerNotification
+	306, // 1218: ypb.Yak.QuerySupportedDnsLogPlatforms:output_type -> ypb.QuerySupportedDnsLogPlatformsResponse
+	288, // 1219: ypb.Yak.GetAvailableYakScriptTags:output_type -> ypb.Fields
+	4,   // 1220: ypb.Yak.ForceUpdateAvailableYakScriptTags:output_type -> ypb.Empty
+	567, // 1221: ypb.Yak.ExecYakitPluginsByYakScriptFilter:output_type -> ypb.ExecResult
+	272, // 1222: ypb.Yak.GenerateYakCodeByPacket:output_type -> ypb.GenerateYakCodeByPacketResponse
+	271, // 1223: ypb.Yak.GenerateCSRFPocByPacket:output_type -> ypb.GenerateCSRFPocByPacketResponse
+	267, // 1224: ypb.Yak.ExportMITMReplacerRules:output_type -> ypb.ExportMITMReplacerRulesResponse
+	4,   // 1225: ypb.Yak.ImportMITMReplacerRules:output_type -> ypb.Empty
+	265, // 1226: ypb.Yak.GetCurrentRules:output_type -> ypb.MITMContentReplacers
+	4,   // 1227: ypb.Yak.SetCurrentRules:output_type -> ypb.Empty
+	749, // 1228: ypb.Yak.QueryMITMReplacerRules:output_type -> ypb.QueryMITMReplacerRulesResponse
+	582, // 1229: ypb.Yak.GenerateURL:output_type -> ypb.GenerateURLResponse
+	249, // 1230: ypb.Yak.ExtractDataToFile:output_type -> ypb.ExtractDataToFileResult
+	248, // 1231: ypb.Yak.AutoDecode:output_type -> ypb.AutoDecodeResponse
+	229, // 1232: ypb.Yak.GetSystemProxy:output_type -> ypb.GetSystemProxyResult
+	4,   // 1233: ypb.Yak.SetSystemProxy:output_type -> ypb.Empty
+	225, // 1234: ypb.Yak.GetKey:output_type -> ypb.GetKeyResult
+	4,   // 1235: ypb.Yak.SetKey:output_type -> ypb.Empty
+	4,   // 1236: ypb.Yak.DelKey:output_type -> ypb.Empty
+	227, // 1237: ypb.Yak.GetAllProcessEnvKey:output_type -> ypb.GetProcessEnvKeyResult
+	4,   // 1238: ypb.Yak.SetProcessEnvKey:output_type -> ypb.Empty
+	225, // 1239: ypb.Yak.GetProjectKey:output_type -> ypb.GetKeyResult
+	4,   // 1240: ypb.Yak.SetProjectKey:output_type -> ypb.Empty
+	222, // 1241: ypb.Yak.GetOnlineProfile:output_type -> ypb.OnlineProfile
+	4,   // 1242: ypb.Yak.SetOnlineProfile:output_type -> ypb.Empty
+	4,   // 1243: ypb.Yak.DownloadOnlinePluginById:output_type -> ypb.Empty
+	4,   // 1244: ypb.Yak.DownloadOnlinePluginByIds:output_type -> ypb.Empty
+	209, // 1245: ypb.Yak.DownloadOnlinePluginAll:output_type -> ypb.DownloadOnlinePluginProgress
+	4,   // 1246: ypb.Yak.DeletePluginByUserID:output_type -> ypb.Empty
+	4,   // 1247: ypb.Yak.DeleteAllLocalPlugins:output_type -> ypb.Empty
+	473, // 1248: ypb.Yak.GetYakScriptTagsAndType:output_type -> ypb.GetYakScriptTagsAndTypeResponse
+	4,   // 1249: ypb.Yak.DeleteLocalPluginsByWhere:output_type -> ypb.Empty
+	216, // 1250: ypb.Yak.DownloadOnlinePluginByScriptNames:output_type -> ypb.DownloadOnlinePluginByScriptNamesResponse
+	209, // 1251: ypb.Yak.DownloadOnlinePlugins:output_type -> ypb.DownloadOnlinePluginProgress
+	4,   // 1252: ypb.Yak.DownloadOnlinePluginBatch:output_type -> ypb.Empty
+	216, // 1253: ypb.Yak.DownloadOnlinePluginByPluginName:output_type -> ypb.DownloadOnlinePluginByScriptNamesResponse
+	451, // 1254: ypb.Yak.DownloadOnlinePluginByUUID:output_type -> ypb.YakScript
+	220, // 1255: ypb.Yak.QueryOnlinePlugins:output_type -> ypb.QueryOnlinePluginsResponse
+	567, // 1256: ypb.Yak.ExecPacketScan:output_type -> ypb.ExecResult
+	187, // 1257: ypb.Yak.GetEngineDefaultProxy:output_type -> ypb.DefaultProxyResult
+	4,   // 1258: ypb.Yak.SetEngineDefaultProxy:output_type -> ypb.Empty
+	180, // 1259: ypb.Yak.GetMachineID:output_type -> ypb.GetMachineIDResponse
+	568, // 1260: ypb.Yak.GetLicense:output_type -> ypb.GetLicenseResponse
+	4,   // 1261: ypb.Yak.CheckLicense:output_type -> ypb.Empty
+	166, // 1262: ypb.Yak.GetRequestBodyByHTTPFlowID:output_type -> ypb.Bytes
+	166, // 1263: ypb.Yak.GetResponseBodyByHTTPFlowID:output_type -> ypb.Bytes
+	166, // 1264: ypb.Yak.GetHTTPPacketBody:output_type -> ypb.Bytes
+	163, // 1265: ypb.Yak.RegisterFacadesHTTP:output_type -> ypb.RegisterFacadesHTTPResponse
+	4,   // 1266: ypb.Yak.ResetAndInvalidUserData:output_type -> ypb.Empty
+	160, // 1267: ypb.Yak.CreateYaklangShell:output_type -> ypb.YaklangShellResponse
+	567, // 1268: ypb.Yak.AttachCombinedOutput:output_type -> ypb.ExecResult
+	143, // 1269: ypb.Yak.IsPrivilegedForNetRaw:output_type -> ypb.IsPrivilegedForNetRawResponse
+	4,   // 1270: ypb.Yak.PromotePermissionForUserPcap:output_type -> ypb.Empty
+	4,   // 1271: ypb.Yak.SetCurrentProject:output_type -> ypb.Empty
+	149, // 1272: ypb.Yak.GetCurrentProject:output_type -> ypb.ProjectDescription
+	149, // 1273: ypb.Yak.GetCurrentProjectEx:output_type -> ypb.ProjectDescription
+	150, // 1274: ypb.Yak.GetProjects:output_type -> ypb.GetProjectsResponse
+	147, // 1275: ypb.Yak.NewProject:output_type -> ypb.NewProjectResponse
+	147, // 1276: ypb.Yak.UpdateProject:output_type -> ypb.NewProjectResponse
+	4,   // 1277: ypb.Yak.IsProjectNameValid:output_type -> ypb.Empty
+	4,   // 1278: ypb.Yak.RemoveProject:output_type -> ypb.Empty
+	4,   // 1279: ypb.Yak.DeleteProject:output_type -> ypb.Empty
+	149, // 1280: ypb.Yak.GetDefaultProject:output_type -> ypb.ProjectDescription
+	149, // 1281: ypb.Yak.GetDefaultProjectEx:output_type -> ypb.ProjectDescription
+	149, // 1282: ypb.Yak.QueryProjectDetail:output_type -> ypb.ProjectDescription
+	149, // 1283: ypb.Yak.GetTemporaryProject:output_type -> ypb.ProjectDescription
+	149, // 1284: ypb.Yak.GetTemporaryProjectEx:output_type -> ypb.ProjectDescription
+	141, // 1285: ypb.Yak.ExportProject:output_type -> ypb.ProjectIOProgress
+	141, // 1286: ypb.Yak.ImportProject:output_type -> ypb.ProjectIOProgress
+	4,   // 1287: ypb.Yak.MigrateLegacyDatabase:output_type -> ypb.Empty
+	135, // 1288: ypb.Yak.QueryMITMRuleExtractedData:output_type -> ypb.QueryMITMRuleExtractedDataResponse
+	139, // 1289: ypb.Yak.ExportMITMRuleExtractedData:output_type -> ypb.ExportMITMRuleExtractedDataResponse
+	4,   // 1290: ypb.Yak.ImportChaosMakerRules:output_type -> ypb.Empty
+	126, // 1291: ypb.Yak.QueryChaosMakerRule:output_type -> ypb.QueryChaosMakerRuleResponse
+	4,   // 1292: ypb.Yak.DeleteChaosMakerRuleByID:output_type -> ypb.Empty
+	567, // 1293: ypb.Yak.ExecuteChaosMakerRule:output_type -> ypb.ExecResult
+	123, // 1294: ypb.Yak.IsRemoteAddrAvailable:output_type -> ypb.IsRemoteAddrAvailableResponse
+	123, // 1295: ypb.Yak.ConnectVulinboxAgent:output_type -> ypb.IsRemoteAddrAvailableResponse
+	89,  // 1296: ypb.Yak.GetRegisteredVulinboxAgent:output_type -> ypb.GetRegisteredAgentResponse
+	4,   // 1297: ypb.Yak.DisconnectVulinboxAgent:output_type -> ypb.Empty
+	132, // 1298: ypb.Yak.IsCVEDatabaseReady:output_type -> ypb.IsCVEDatabaseReadyResponse
+	567, // 1299: ypb.Yak.UpdateCVEDatabase:output_type -> ypb.ExecResult
+	567, // 1300: ypb.Yak.ExportsProfileDatabase:output_type -> ypb.ExecResult
+	567, // 1301: ypb.Yak.ImportsProfileDatabase:output_type -> ypb.ExecResult
+	117, // 1302: ypb.Yak.QueryCVE:output_type -> ypb.QueryCVEResponse
+	115, // 1303: ypb.Yak.GetCVE:output_type -> ypb.CVEDetailEx
+	119, // 1304: ypb.Yak.SaveTextToTemporalFile:output_type -> ypb.SaveTextToTemporalFileResponse
+	111, // 1305: ypb.Yak.IsScrecorderReady:output_type -> ypb.IsScrecorderReadyResponse
+	567, // 1306: ypb.Yak.InstallScrecorder:output_type -> ypb.ExecResult
+	567, // 1307: ypb.Yak.StartScrecorder:output_type -> ypb.ExecResult
+	107, // 1308: ypb.Yak.QueryScreenRecorders:output_type -> ypb.QueryScreenRecorderResponse
+	4,   // 1309: ypb.Yak.DeleteScreenRecorders:output_type -> ypb.Empty
+	4,   // 1310: ypb.Yak.UploadScreenRecorders:output_type -> ypb.Empty
+	102, // 1311: ypb.Yak.GetOneScreenRecorders:output_type -> ypb.ScreenRecorder
+	4,   // 1312: ypb.Yak.UpdateScreenRecorders:output_type -> ypb.Empty
+	94,  // 1313: ypb.Yak.IsVulinboxReady:output_type -> ypb.IsVulinboxReadyResponse
+	567, // 1314: ypb.Yak.InstallVulinbox:output_type -> ypb.ExecResult
+	567, // 1315: ypb.Yak.StartVulinbox:output_type -> ypb.ExecResult
+	567, // 1316: ypb.Yak.GenQualityInspectionReport:output_type -> ypb.ExecResult
+	100, // 1317: ypb.Yak.HTTPRequestBuilder:output_type -> ypb.HTTPRequestBuilderResponse
+	567, // 1318: ypb.Yak.DebugPlugin:output_type -> ypb.ExecResult
+	92,  // 1319: ypb.Yak.SmokingEvaluatePlugin:output_type -> ypb.SmokingEvaluatePluginResponse
+	580, // 1320: ypb.Yak.SmokingEvaluatePluginBatch:output_type -> ypb.SmokingEvaluatePluginBatchResponse
+	570, // 1321: ypb.Yak.GetSystemDefaultDnsServers:output_type -> ypb.DefaultDnsServerResponse
+	86,  // 1322: ypb.Yak.DiagnoseNetwork:output_type -> ypb.DiagnoseNetworkResponse
+	86,  // 1323: ypb.Yak.DiagnoseNetworkDNS:output_type -> ypb.DiagnoseNetworkResponse
+	587, // 1324: ypb.Yak.TraceRoute:output_type -> ypb.TraceRouteResponse
+	82,  // 1325: ypb.Yak.GetGlobalNetworkConfig:output_type -> ypb.GlobalNetworkConfig
+	4,   // 1326: ypb.Yak.SetGlobalNetworkConfig:output_type -> ypb.Empty
+	4,   // 1327: ypb.Yak.ResetGlobalNetworkConfig:output_type -> ypb.Empty
+	81,  // 1328: ypb.Yak.ValidP12PassWord:output_type -> ypb.ValidP12PassWordResponse
+	74,  // 1329: ypb.Yak.RequestYakURL:output_type -> ypb.RequestYakURLResponse
+	602, // 1330: ypb.Yak.ReadFile:output_type -> ypb.ReadFileResponse
+	58,  // 1331: ypb.Yak.GetPcapMetadata:output_type -> ypb.PcapMetadata
+	70,  // 1332: ypb.Yak.PcapX:output_type -> ypb.PcapXResponse
+	62,  // 1333: ypb.Yak.QueryTrafficSession:output_type -> ypb.QueryTrafficSessionResponse
+	64,  // 1334: ypb.Yak.QueryTrafficPacket:output_type -> ypb.QueryTrafficPacketResponse
+	66,  // 1335: ypb.Yak.QueryTrafficTCPReassembled:output_type -> ypb.QueryTrafficTCPReassembledResponse
+	585, // 1336: ypb.Yak.ParseTraffic:output_type -> ypb.ParseTrafficResponse
+	56,  // 1337: ypb.Yak.DuplexConnection:output_type -> ypb.DuplexConnectionResponse
+	50,  // 1338: ypb.Yak.HybridScan:output_type -> ypb.HybridScanResponse
+	47,  // 1339: ypb.Yak.QueryHybridScanTask:output_type -> ypb.QueryHybridScanTaskResponse
+	4,   // 1340: ypb.Yak.DeleteHybridScanTask:output_type -> ypb.Empty
+	43,  // 1341: ypb.Yak.GetSpaceEngineStatus:output_type -> ypb.SpaceEngineStatus
+	43,  // 1342: ypb.Yak.GetSpaceEngineAccountStatus:output_type -> ypb.SpaceEngineStatus
+	43,  // 1343: ypb.Yak.GetSpaceEngineAccountStatusV2:output_type -> ypb.SpaceEngineStatus
+	567, // 1344: ypb.Yak.FetchPortAssetFromSpaceEngine:output_type -> ypb.ExecResult
+	589, // 1345: ypb.Yak.EvaluateExpression:output_type -> ypb.EvaluateExpressionResponse
+	591, // 1346: ypb.Yak.EvaluateMultiExpression:output_type -> ypb.EvaluateMultiExpressionResponse
+	594, // 1347: ypb.Yak.GetThirdPartyAppConfigTemplate:output_type -> ypb.GetThirdPartyAppConfigTemplateResponse
+	6,   // 1348: ypb.Yak.CheckHahValidAiConfig:output_type -> ypb.GeneralResponse
+	735, // 1349: ypb.Yak.ListAiModel:output_type -> ypb.ListAiModelResponse
+	596, // 1350: ypb.Yak.GetFingerprint:output_type -> ypb.GetFingerprintResponse
+	598, // 1351: ypb.Yak.AddFingerprint:output_type -> ypb.AddFingerprintResponse
+	600, // 1352: ypb.Yak.ModifyFingerprint:output_type -> ypb.ModifyFingerprintResponse
+	612, // 1353: ypb.Yak.QueryFingerprint:output_type -> ypb.QueryFingerprintResponse
+	607, // 1354: ypb.Yak.DeleteFingerprint:output_type -> ypb.DbOperateMessage
+	607, // 1355: ypb.Yak.UpdateFingerprint:output_type -> ypb.DbOperateMessage
+	607, // 1356: ypb.Yak.CreateFingerprint:output_type -> ypb.DbOperateMessage
+	607, // 1357: ypb.Yak.RecoverBuiltinFingerprint:output_type -> ypb.DbOperateMessage
+	607, // 1358: ypb.Yak.CreateFingerprintGroup:output_type -> ypb.DbOperateMessage
+	617, // 1359: ypb.Yak.GetAllFingerprintGroup:output_type -> ypb.FingerprintGroups
+	607, // 1360: ypb.Yak.RenameFingerprintGroup:output_type -> ypb.DbOperateMessage
+	607, // 1361: ypb.Yak.DeleteFingerprintGroup:output_type -> ypb.DbOperateMessage
+	607, // 1362: ypb.Yak.BatchUpdateFingerprintToGroup:output_type -> ypb.DbOperateMessage
+	617, // 1363: ypb.Yak.GetFingerprintGroupSetByFilter:output_type -> ypb.FingerprintGroups
+	624, // 1364: ypb.Yak.ExportFingerprint:output_type -> ypb.DataTransferProgress
+	624, // 1365: ypb.Yak.ImportFingerprint:output_type -> ypb.DataTransferProgress
+	604, // 1366: ypb.Yak.GetReverseShellProgramList:output_type -> ypb.GetReverseShellProgramListResponse
+	606, // 1367: ypb.Yak.GenerateReverseShellCommand:output_type -> ypb.GenerateReverseShellCommandResponse
+	644, // 1368: ypb.Yak.QuerySyntaxFlowRule:output_type -> ypb.QuerySyntaxFlowRuleResponse
+	607, // 1369: ypb.Yak.CreateSyntaxFlowRule:output_type -> ypb.DbOperateMessage
+	641, // 1370: ypb.Yak.CreateSyntaxFlowRuleEx:output_type -> ypb.CreateSyntaxFlowRuleResponse
+	607, // 1371: ypb.Yak.UpdateSyntaxFlowRule:output_type -> ypb.DbOperateMessage
+	643, // 1372: ypb.Yak.UpdateSyntaxFlowRuleEx:output_type -> ypb.UpdateSyntaxFlowRuleResponse
+	607, // 1373: ypb.Yak.DeleteSyntaxFlowRule:output_type -> ypb.DbOperateMessage
+	647, // 1374: ypb.Yak.CheckSyntaxFlowRuleUpdate:output_type -> ypb.CheckSyntaxFlowRuleUpdateResponse
+	649, // 1375: ypb.Yak.ApplySyntaxFlowRuleUpdate:output_type -> ypb.ApplySyntaxFlowRuleUpdateResponse
+	653, // 1376: ypb.Yak.QuerySyntaxFlowRuleGroup:output_type -> ypb.QuerySyntaxFlowRuleGroupResponse
+	607, // 1377: ypb.Yak.DeleteSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
+	607, // 1378: ypb.Yak.CreateSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
+	607, // 1379: ypb.Yak.UpdateSyntaxFlowRuleGroup:output_type -> ypb.DbOperateMessage
+	607, // 1380: ypb.Yak.UpdateSyntaxFlowRuleAndGroup:output_type -> ypb.DbOperateMessage
+	658, // 1381: ypb.Yak.QuerySyntaxFlowSameGroup:output_type -> ypb.QuerySyntaxFlowSameGroupResponse
+	661, // 1382: ypb.Yak.SyntaxFlowRuleToOnline:output_type -> ypb.SyntaxFlowRuleOnlineProgress
+	661, // 1383: ypb.Yak.DownloadSyntaxFlowRule:output_type -> ypb.SyntaxFlowRuleOnlineProgress
+	669, // 1384: ypb.Yak.SyntaxFlowScan:output_type -> ypb.SyntaxFlowScanResponse
+	666, // 1385: ypb.Yak.QuerySyntaxFlowScanTask:output_type -> ypb.QuerySyntaxFlowScanTaskResponse
+	607, // 1386: ypb.Yak.DeleteSyntaxFlowScanTask:output_type -> ypb.DbOperateMessage
+	672, // 1387: ypb.Yak.QuerySyntaxFlowResult:output_type -> ypb.QuerySyntaxFlowResultResponse
+	675, // 1388: ypb.Yak.DeleteSyntaxFlowResult:output_type -> ypb.DeleteSyntaxFlowResultResponse
+	636, // 1389: ypb.Yak.QuerySSAPrograms:output_type -> ypb.QuerySSAProgramResponse
+	607, // 1390: ypb.Yak.UpdateSSAProgram:output_type -> ypb.DbOperateMessage
+	607, // 1391: ypb.Yak.DeleteSSAPrograms:output_type -> ypb.DbOperateMessage
+	639, // 1392: ypb.Yak.IncrementalCompileSSAProgram:output_type -> ypb.IncrementalCompileSSAProgramResponse
+	689, // 1393: ypb.Yak.QuerySSARisks:output_type -> ypb.QuerySSARisksResponse
+	691, // 1394: ypb.Yak.QueryNewSSARisks:output_type -> ypb.QueryNewSSARisksResponse
+	607, // 1395: ypb.Yak.DeleteSSARisks:output_type -> ypb.DbOperateMessage
+	607, // 1396: ypb.Yak.UpdateSSARiskTags:output_type -> ypb.DbOperateMessage
+	694, // 1397: ypb.Yak.GetSSARiskFieldGroup:output_type -> ypb.SSARiskFieldGroupResponse
+	696, // 1398: ypb.Yak.NewSSARiskRead:output_type -> ypb.NewSSARiskReadResponse
+	4,   // 1399: ypb.Yak.SSARiskFeedbackToOnline:output_type -> ypb.Empty
+	677, // 1400: ypb.Yak.GetAllPluginEnv:output_type -> ypb.PluginEnvData
+	677, // 1401: ypb.Yak.QueryPluginEnv:output_type -> ypb.PluginEnvData
+	4,   // 1402: ypb.Yak.CreatePluginEnv:output_type -> ypb.Empty
+	4,   // 1403: ypb.Yak.SetPluginEnv:output_type -> ypb.Empty
+	4,   // 1404: ypb.Yak.DeletePluginEnv:output_type -> ypb.Empty
+	680, // 1405: ypb.Yak.GetAllFuzztagInfo:output_type -> ypb.GetAllFuzztagInfoResponse
+	684, // 1406: ypb.Yak.GenerateFuzztag:output_type -> ypb.GenerateFuzztagResponse
+	700, // 1407: ypb.Yak.ExportSyntaxFlows:output_type -> ypb.SyntaxflowsProgress
+	700, // 1408: ypb.Yak.ImportSyntaxFlows:output_type -> ypb.SyntaxflowsProgress
+	705, // 1409: ypb.Yak.CreateHotPatchTemplate:output_type -> ypb.CreateHotPatchTemplateResponse
+	706, // 1410: ypb.Yak.DeleteHotPatchTemplate:output_type -> ypb.DeleteHotPatchTemplateResponse
+	707, // 1411: ypb.Yak.UpdateHotPatchTemplate:output_type -> ypb.UpdateHotPatchTemplateResponse
+	708, // 1412: ypb.Yak.QueryHotPatchTemplate:output_type -> ypb.QueryHotPatchTemplateResponse
+	710, // 1413: ypb.Yak.QueryHotPatchTemplateList:output_type -> ypb.QueryHotPatchTemplateListResponse
+	712, // 1414: ypb.Yak.GroupTableColumn:output_type -> ypb.GroupTableColumnResponse
+	4,   // 1415: ypb.Yak.UploadHotPatchTemplateToOnline:output_type -> ypb.Empty
+	4,   // 1416: ypb.Yak.DownloadHotPatchTemplate:output_type -> ypb.Empty
+	548, // 1417: ypb.Yak.SetMITMHijackFilter:output_type -> ypb.SetMITMFilterResponse
+	547, // 1418: ypb.Yak.GetMITMHijackFilter:output_type -> ypb.SetMITMFilterRequest
+	547, // 1419: ypb.Yak.ResetMITMHijackFilter:output_type -> ypb.SetMITMFilterRequest
+	716, // 1420: ypb.Yak.ExportHTTPFlowStream:output_type -> ypb.ExportHTTPFlowStreamResponse
+	718, // 1421: ypb.Yak.ImportHTTPFlowStream:output_type -> ypb.ImportHTTPFlowStreamResponse
+	723, // 1422: ypb.Yak.CreateNote:output_type -> ypb.CreateNoteResponse
+	607, // 1423: ypb.Yak.UpdateNote:output_type -> ypb.DbOperateMessage
+	607, // 1424: ypb.Yak.DeleteNote:output_type -> ypb.DbOperateMessage
+	727, // 1425: ypb.Yak.QueryNote:output_type -> ypb.QueryNoteResponse
+	729, // 1426: ypb.Yak.SearchNoteContent:output_type -> ypb.SearchNoteContentResponse
+	731, // 1427: ypb.Yak.ImportNote:output_type -> ypb.ImportNoteResponse
+	733, // 1428: ypb.Yak.ExportNote:output_type -> ypb.ExportNoteResponse
+	26,  // 1429: ypb.Yak.StartAITask:output_type -> ypb.AIOutputEvent
+	33,  // 1430: ypb.Yak.QueryAITask:output_type -> ypb.AITaskQueryResponse
+	26,  // 1431: ypb.Yak.StartAITriage:output_type -> ypb.AIOutputEvent
+	607, // 1432: ypb.Yak.CreateAIForge:output_type -> ypb.DbOperateMessage
+	607, // 1433: ypb.Yak.UpdateAIForge:output_type -> ypb.DbOperateMessage
+	607, // 1434: ypb.Yak.DeleteAIForge:output_type -> ypb.DbOperateMessage
+	38,  // 1435: ypb.Yak.QueryAIForge:output_type -> ypb.QueryAIForgeResponse
+	40,  // 1436: ypb.Yak.StartMcpServer:output_type -> ypb.StartMcpServerResponse
+	14,  // 1437: ypb.Yak.GetToolSetList:output_type -> ypb.GetToolSetListResponse
+	24,  // 1438: ypb.Yak.GetAIToolList:output_type -> ypb.GetAIToolListResponse
+	607, // 1439: ypb.Yak.DeleteAITool:output_type -> ypb.DbOperateMessage
+	607, // 1440: ypb.Yak.SaveAITool:output_type -> ypb.DbOperateMessage
+	22,  // 1441: ypb.Yak.ToggleAIToolFavorite:output_type -> ypb.ToggleAIToolFavoriteResponse
+	18,  // 1442: ypb.Yak.AIToolGenerateMetadata:output_type -> ypb.AIToolGenerateMetadataResponse
+	736, // 1443: ypb.Yak.IsLlamaServerReady:output_type -> ypb.IsLlamaServerReadyResponse
+	738, // 1444: ypb.Yak.IsLocalModelReady:output_type -> ypb.IsLocalModelReadyResponse
+	567, // 1445: ypb.Yak.InstallLlamaServer:output_type -> ypb.ExecResult
+	567, // 1446: ypb.Yak.StartLocalModel:output_type -> ypb.ExecResult
+	567, // 1447: ypb.Yak.DownloadLocalModel:output_type -> ypb.ExecResult
+	743, // 1448: ypb.Yak.GetSupportedLocalModels:output_type -> ypb.GetSupportedLocalModelsResponse
+	963, // [963:1449] is the sub-list for method output_type
+	477, // [477:963] is the sub-list for method input_type
+	477, // [477:477] is the sub-list for extension type_name
+	477, // [477:477] is the sub-list for extension extendee
+	0,   // [0:477] is the sub-list for field type_name
 }
 
 func init() { file_yakgrpc_proto_init() }
@@ -60754,7 +61040,7 @@ func file_yakgrpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_yakgrpc_proto_rawDesc), len(file_yakgrpc_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   752,
+			NumMessages:   755,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,253 @@
+package distscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	uuid "github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mq"
+	"github.com/yaklang/yaklang/common/spec"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/scannode/scanrpc"
+)
+
+const (
+	backendExchange = "palm-backend"
+
+	// 节点每 10s 发送一次心跳，超过三个心跳周期没有消息视为离线
+	nodeAliveTimeout   = 30 * time.Second
+	defaultNodeTimeout = 30 * time.Second
+)
+
+type NodeInfo struct {
+	NodeId   string
+	NodeType spec.NodeType
+	Token    string
+	LastSeen time.Time
+}
+
+// Controller 作为分布式扫描的控制端，接收扫描节点的注册与心跳，
+// 把扫描任务切分为分片下发给节点，并把节点汇报的结果合并到本地数据库
+type Controller struct {
+	id          string
+	nodeTimeout time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	server *mq.RPCServer
+	client *scanrpc.SCANClientHelper
+
+	lock  sync.Mutex
+	nodes map[string]*NodeInfo
+	jobs  *sync.Map
+}
+
+type ControllerOption func(c *Controller)
+
+// WithControllerId 设置控制端的节点 id，扫描节点默认向 spec.ServerNodeId 注册
+func WithControllerId(id string) ControllerOption {
+	return func(c *Controller) {
+		c.id = id
+	}
+}
+
+// WithNodeTimeout 设置分片多久没有收到汇报即认为节点已经失效，失效节点上的分片会被重新下发
+func WithNodeTimeout(d time.Duration) ControllerOption {
+	return func(c *Controller) {
+		c.nodeTimeout = d
+	}
+}
+
+func NewController(ctx context.Context, amqpUrl string, opts ...ControllerOption) (*Controller, error) {
+	c := &Controller{
+		id:          spec.ServerNodeId,
+		nodeTimeout: defaultNodeTimeout,
+		nodes:       make(map[string]*NodeInfo),
+		jobs:        new(sync.Map),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.nodeTimeout <= 0 {
+		c.nodeTimeout = defaultNodeTimeout
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+
+	heartbeatQueue := fmt.Sprintf("queue.heartbeat.%v", c.id)
+	server, err := mq.NewRPCServer(
+		c.ctx, spec.CommonRPCExchange, c.id,
+		mq.WithAMQPUrl(amqpUrl),
+		mq.WithExchangeDeclare(&mq.ExchangeDeclaringParam{Name: spec.CommonRPCExchange, Kind: "direct"}),
+		mq.WithExchangeDeclare(&mq.ExchangeDeclaringParam{Name: spec.CommonServerPushExchange, Kind: "topic"}),
+		mq.WithExchangeDeclare(&mq.ExchangeDeclaringParam{Name: backendExchange, Kind: "topic"}),
+		mq.WithQueueDeclare(&mq.QueueDeclaringParam{Name: heartbeatQueue, AutoDelete: true, Exclusive: true}),
+		mq.WithQueueBind(&mq.QueueBindingParam{Name: heartbeatQueue, Key: "heartbeat.#", Exchange: backendExchange}),
+		mq.WithConsumingParam(&mq.ConsumingParam{
+			Queue:   heartbeatQueue,
+			Handler: c.onHeartbeat,
+		}),
+	)
+	if err != nil {
+		c.cancel()
+		return nil, utils.Errorf("create controller rpc server failed: %s", err)
+	}
+	server.RegisterServices([]string{
+		spec.API_RegisterNode,
+		spec.API_UnregisterNode,
+		spec.API_ReportScanShard,
+	}, c.handleRPC)
+
+	client, err := server.GetRPCClient(c.id)
+	if err != nil {
+		c.cancel()
+		return nil, utils.Errorf("create controller rpc client failed: %s", err)
+	}
+	c.server = server
+	c.client = scanrpc.GenerateSCANClientHelper(client.Call)
+	return c, nil
+}
+
+func (c *Controller) Start() error {
+	return c.server.RunBackground()
+}
+
+func (c *Controller) Close() {
+	c.cancel()
+}
+
+func (c *Controller) GetId() string {
+	return c.id
+}
+
+func (c *Controller) onHeartbeat(b *mq.Broker, conn *amqp.Connection, channel *amqp.Channel, msg amqp.Delivery) {
+	defer func() {
+		_ = channel.Ack(msg.DeliveryTag, false)
+	}()
+
+	var m spec.Message
+	if err := json.Unmarshal(msg.Body, &m); err != nil {
+		log.Errorf("unmarshal heartbeat failed: %s", err)
+		return
+	}
+	if m.NodeId == "" {
+		return
+	}
+	// 控制端重启后节点不会重新注册，收到心跳时同样记录该节点
+	c.touchNode(m.NodeId, "", "")
+}
+
+func (c *Controller) handleRPC(broker *mq.Broker, ctx context.Context, f, node string, delivery *amqp.Delivery) (interface{}, error) {
+	switch f {
+	case spec.API_RegisterNode:
+		var req spec.NodeRegisterRequest
+		if err := json.Unmarshal(delivery.Body, &req); err != nil {
+			return nil, utils.Errorf("unmarshal register request failed: %s", err)
+		}
+		if req.NodeId == "" {
+			return &spec.NodeRegisterResponse{Ok: false, Reason: "empty node id"}, nil
+		}
+		token := uuid.New().String()
+		c.touchNode(req.NodeId, req.NodeType, token)
+		log.Infof("scan node %v(%v) registered", req.NodeId, req.NodeType)
+		return &spec.NodeRegisterResponse{OriginNodeId: req.NodeId, Token: token, Ok: true}, nil
+	case spec.API_UnregisterNode:
+		var req spec.NodeUnregisterRequest
+		if err := json.Unmarshal(delivery.Body, &req); err != nil {
+			return nil, utils.Errorf("unmarshal unregister request failed: %s", err)
+		}
+		c.lock.Lock()
+		delete(c.nodes, req.NodeId)
+		c.lock.Unlock()
+		return &spec.NodeUnregisterResponse{Ok: true}, nil
+	case spec.API_ReportScanShard:
+		var report spec.ScanShardReport
+		if err := json.Unmarshal(delivery.Body, &report); err != nil {
+			return nil, utils.Errorf("unmarshal shard report failed: %s", err)
+		}
+		c.touchNode(report.NodeId, "", "")
+		raw, ok := c.jobs.Load(report.TaskId)
+		if !ok {
+			return &spec.ScanShardReportResponse{Ok: false, Reason: "no such task"}, nil
+		}
+		if err := raw.(*Job).onReport(&report); err != nil {
+			return &spec.ScanShardReportResponse{Ok: false, Reason: err.Error()}, nil
+		}
+		return &spec.ScanShardReportResponse{Ok: true}, nil
+	default:
+		return nil, utils.Errorf("unsupported function: %v", f)
+	}
+}
+
+func (c *Controller) touchNode(id string, nodeType spec.NodeType, token string) {
+	if id == "" {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	info, ok := c.nodes[id]
+	if !ok {
+		info = &NodeInfo{NodeId: id}
+		c.nodes[id] = info
+	}
+	if nodeType != "" {
+		info.NodeType = nodeType
+	}
+	if token != "" {
+		info.Token = token
+	}
+	info.LastSeen = time.Now()
+}
+
+// Nodes 返回当前存活的扫描节点，按照节点 id 排序
+func (c *Controller) Nodes() []*NodeInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var nodes []*NodeInfo
+	for _, info := range c.nodes {
+		if info.NodeType != "" && info.NodeType != spec.NodeType_Scanner {
+			continue
+		}
+		if time.Since(info.LastSeen) > nodeAliveTimeout {
+			continue
+		}
+		copied := *info
+		nodes = append(nodes, &copied)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeId < nodes[j].NodeId
+	})
+	return nodes
+}
+
+// WaitNodes 等待至少 n 个扫描节点上线
+func (c *Controller) WaitNodes(ctx context.Context, n int) error {
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if len(c.Nodes()) >= n {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return utils.Errorf("wait for %v scan nodes failed: %d online", n, len(c.Nodes()))
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan 把 hosts x ports 切分为分片，分发到在线的扫描节点并等待全部分片完成
+func (c *Controller) Scan(ctx context.Context, hosts, ports string, opts ...JobOption) (*JobStatus, error) {
+	job := newJob(c, hosts, ports, opts...)
+	if len(job.shards) == 0 {
+		return nil, utils.Errorf("no targets for hosts: %v ports: %v", hosts, ports)
+	}
+	c.jobs.Store(job.TaskId, job)
+	defer c.jobs.Delete(job.TaskId)
+	return job.run(ctx)
+}
@@ -0,0 +1,170 @@
+package distscan
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/mq"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/spec"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/scannode"
+	"github.com/yaklang/yaklang/scannode/scanrpc"
+)
+
+// startTestNode 启动一个使用 handler 处理分片的节点，并注册到控制端
+func startTestNode(t *testing.T, ctx context.Context, amqpUrl, id string, handler func(ctx context.Context) (interface{}, error)) {
+	server, err := mq.NewRPCServer(ctx, spec.CommonRPCExchange, id, mq.WithAMQPUrl(amqpUrl))
+	require.NoError(t, err)
+	server.RegisterService("SCAN_PortScanShard", func(broker *mq.Broker, ctx context.Context, f, node string, delivery *amqp.Delivery) (interface{}, error) {
+		return handler(ctx)
+	})
+	client, err := server.GetRPCClient(id)
+	require.NoError(t, err)
+	require.NoError(t, server.RunBackground())
+
+	require.Eventually(t, func() bool {
+		callCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+		_, err := client.Call(callCtx, spec.API_RegisterNode, spec.ServerNodeId, &spec.NodeRegisterRequest{
+			NodeId:   id,
+			NodeType: spec.NodeType_Scanner,
+		})
+		return err == nil
+	}, 20*time.Second, 500*time.Millisecond)
+}
+
+// startStuckNode 启动一个接收分片后既不汇报也不返回的节点，模拟扫描过程中失效的节点
+func startStuckNode(t *testing.T, ctx context.Context, amqpUrl, id string) {
+	startTestNode(t, ctx, amqpUrl, id, func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+}
+
+func TestController_Scan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	dbPath, db, err := consts.GetTempTestDatabase()
+	require.NoError(t, err)
+	defer func() {
+		db.Close()
+		os.Remove(dbPath)
+	}()
+	db.AutoMigrate(&schema.Port{}, &schema.Risk{})
+
+	broker, err := mq.NewLocalBroker(ctx, "")
+	require.NoError(t, err)
+	defer broker.Close()
+
+	controller, err := NewController(ctx, broker.AMQPUrl(), WithNodeTimeout(3*time.Second))
+	require.NoError(t, err)
+	require.NoError(t, controller.Start())
+	defer controller.Close()
+
+	startStuckNode(t, ctx, broker.AMQPUrl(), "dist-node-0")
+	for i := 1; i <= 2; i++ {
+		node, err := scannode.NewScanNodeWithAMQPUrl(fmt.Sprintf("dist-node-%d", i), "", broker.AMQPUrl(), "127.0.0.1")
+		require.NoError(t, err)
+		go node.Run()
+	}
+	waitCtx, waitCancel := context.WithTimeout(ctx, 30*time.Second)
+	defer waitCancel()
+	require.NoError(t, controller.WaitNodes(waitCtx, 3))
+
+	var ports []int
+	for i := 0; i < 3; i++ {
+		_, port := utils.DebugMockHTTP([]byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok"))
+		ports = append(ports, port)
+	}
+	closedPort := utils.GetRandomAvailableTCPPort()
+
+	var progressCalled atomic.Bool
+	status, err := controller.Scan(ctx, "127.0.0.1", utils.ConcatPorts(append([]int{closedPort}, ports...)),
+		WithShardPorts(1),
+		WithProbeTimeout(2),
+		WithDatabase(db),
+		WithTaskName("dist-scan-test"),
+		WithProgressHandler(func(status *JobStatus) {
+			progressCalled.Store(true)
+		}),
+	)
+	require.NoError(t, err)
+	assert.True(t, progressCalled.Load())
+	assert.Equal(t, 4, status.Total)
+	assert.Equal(t, 4, status.Finished)
+	assert.Equal(t, 3, status.OpenPorts)
+	assert.Equal(t, 1.0, status.Progress)
+	// 第一个分片被分配到失效的节点，超时后重新下发
+	assert.GreaterOrEqual(t, status.Requeued, 1)
+	for _, shard := range status.Shards {
+		assert.NotEqual(t, "dist-node-0", shard.NodeId)
+	}
+
+	var saved []*schema.Port
+	require.NoError(t, db.Where("runtime_id = ?", status.RuntimeId).Find(&saved).Error)
+	require.Len(t, saved, 3)
+	for _, port := range saved {
+		assert.Contains(t, ports, port.Port)
+		assert.Equal(t, "dist-scan-test", port.TaskName)
+	}
+}
+
+func TestController_ScanRetrySingleNode(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	broker, err := mq.NewLocalBroker(ctx, "")
+	require.NoError(t, err)
+	defer broker.Close()
+
+	controller, err := NewController(ctx, broker.AMQPUrl(), WithNodeTimeout(3*time.Second))
+	require.NoError(t, err)
+	require.NoError(t, controller.Start())
+	defer controller.Close()
+
+	// 唯一的节点第一次执行失败，之后成功，分片应当重新下发到这个节点而不是整个任务失败
+	var calls atomic.Int32
+	startTestNode(t, ctx, broker.AMQPUrl(), "flaky-node", func(ctx context.Context) (interface{}, error) {
+		if calls.Add(1) == 1 {
+			return nil, utils.Error("temporary failure")
+		}
+		return &scanrpc.SCAN_PortScanShardResponse{}, nil
+	})
+	waitCtx, waitCancel := context.WithTimeout(ctx, 30*time.Second)
+	defer waitCancel()
+	require.NoError(t, controller.WaitNodes(waitCtx, 1))
+
+	status, err := controller.Scan(ctx, "127.0.0.1", "80", WithMaxRetry(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Finished)
+	assert.Equal(t, 1, status.Requeued)
+	assert.Equal(t, 2, status.Shards[0].Attempt)
+	assert.Equal(t, "flaky-node", status.Shards[0].NodeId)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestSplitShards(t *testing.T) {
+	shards := splitShards("192.168.1.1-5", "80,443,8080", 2, 2)
+	require.Len(t, shards, 6)
+	assert.Equal(t, "192.168.1.1,192.168.1.2", shards[0].Hosts)
+	assert.Equal(t, "80,443", shards[0].Ports)
+	assert.Equal(t, 4, shards[0].Targets)
+	assert.Equal(t, "192.168.1.5", shards[5].Hosts)
+	assert.Equal(t, "8080", shards[5].Ports)
+
+	var total int
+	for _, shard := range shards {
+		total += shard.Targets
+	}
+	assert.Equal(t, 15, total)
+}
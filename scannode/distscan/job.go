@@ -0,0 +1,576 @@
+package distscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/spec"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/scannode"
+	"github.com/yaklang/yaklang/scannode/scanrpc"
+)
+
+const (
+	ShardState_Pending  = "pending"
+	ShardState_Running  = "running"
+	ShardState_Finished = "finished"
+	ShardState_Failed   = "failed"
+
+	portFrom = "distributed-scan"
+)
+
+type Shard struct {
+	Id      string
+	Hosts   string
+	Ports   string
+	Targets int
+
+	NodeId   string
+	Attempt  int
+	Progress float64
+	State    string
+	Error    string
+
+	lastReport time.Time
+	cancel     context.CancelFunc
+	// triedNodes 记录执行本分片失败或超时的节点，重新下发时优先选择其他节点
+	triedNodes map[string]bool
+}
+
+type JobStatus struct {
+	TaskId    string
+	RuntimeId string
+	Progress  float64
+
+	Total     int
+	Running   int
+	Finished  int
+	Failed    int
+	Requeued  int
+	OpenPorts int
+	Risks     int
+
+	Shards []Shard
+}
+
+type Job struct {
+	TaskId    string
+	RuntimeId string
+	TaskName  string
+
+	controller *Controller
+	db         *gorm.DB
+
+	shardHosts int
+	shardPorts int
+	maxRetry   int
+	mode       string
+	plugins    []string
+	isUDP      bool
+	concurrent int
+	timeout    int
+	proxies    []string
+	onProgress func(status *JobStatus)
+
+	lock      sync.Mutex
+	shards    []*Shard
+	shardMap  map[string]*Shard
+	seenPorts map[string]bool
+	seenRisks map[string]bool
+	requeued  int
+	openPorts int
+	risks     int
+	events    chan struct{}
+}
+
+type JobOption func(j *Job)
+
+// WithShardHosts 设置每个分片包含的主机数量
+func WithShardHosts(n int) JobOption {
+	return func(j *Job) {
+		j.shardHosts = n
+	}
+}
+
+// WithShardPorts 设置每个分片包含的端口数量
+func WithShardPorts(n int) JobOption {
+	return func(j *Job) {
+		j.shardPorts = n
+	}
+}
+
+// WithMode 设置扫描模式，scannode.PortScanMode_Hybrid 会在开放端口上执行插件
+func WithMode(mode string) JobOption {
+	return func(j *Job) {
+		j.mode = mode
+	}
+}
+
+func WithPlugins(plugins ...string) JobOption {
+	return func(j *Job) {
+		j.plugins = append(j.plugins, plugins...)
+	}
+}
+
+func WithUDP(b bool) JobOption {
+	return func(j *Job) {
+		j.isUDP = b
+	}
+}
+
+// WithConcurrent 设置每个节点扫描单个分片的并发
+func WithConcurrent(n int) JobOption {
+	return func(j *Job) {
+		j.concurrent = n
+	}
+}
+
+// WithProbeTimeout 设置单个探测的超时时间，单位为秒
+func WithProbeTimeout(seconds int) JobOption {
+	return func(j *Job) {
+		j.timeout = seconds
+	}
+}
+
+func WithProxies(proxies ...string) JobOption {
+	return func(j *Job) {
+		j.proxies = append(j.proxies, proxies...)
+	}
+}
+
+// WithMaxRetry 设置分片失败后最多重新下发的次数
+func WithMaxRetry(n int) JobOption {
+	return func(j *Job) {
+		j.maxRetry = n
+	}
+}
+
+func WithTaskName(name string) JobOption {
+	return func(j *Job) {
+		j.TaskName = name
+	}
+}
+
+func WithRuntimeId(id string) JobOption {
+	return func(j *Job) {
+		j.RuntimeId = id
+	}
+}
+
+// WithDatabase 设置合并结果使用的数据库，默认为当前项目数据库
+func WithDatabase(db *gorm.DB) JobOption {
+	return func(j *Job) {
+		j.db = db
+	}
+}
+
+func WithProgressHandler(h func(status *JobStatus)) JobOption {
+	return func(j *Job) {
+		j.onProgress = h
+	}
+}
+
+func newJob(c *Controller, hosts, ports string, opts ...JobOption) *Job {
+	j := &Job{
+		TaskId:     uuid.New().String(),
+		controller: c,
+		shardHosts: 8,
+		shardPorts: 1000,
+		maxRetry:   3,
+		mode:       scannode.PortScanMode_Port,
+		shardMap:   make(map[string]*Shard),
+		seenPorts:  make(map[string]bool),
+		seenRisks:  make(map[string]bool),
+		events:     make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	if j.RuntimeId == "" {
+		j.RuntimeId = j.TaskId
+	}
+	if j.TaskName == "" {
+		j.TaskName = fmt.Sprintf("distributed-scan-%v", j.TaskId)
+	}
+	if j.db == nil {
+		j.db = consts.GetGormProjectDatabase()
+	}
+	j.shards = splitShards(hosts, ports, j.shardHosts, j.shardPorts)
+	for _, shard := range j.shards {
+		j.shardMap[shard.Id] = shard
+	}
+	return j
+}
+
+// splitShards 按照主机与端口两个维度切分任务
+func splitShards(hosts, ports string, shardHosts, shardPorts int) []*Shard {
+	if shardHosts <= 0 {
+		shardHosts = 8
+	}
+	if shardPorts <= 0 {
+		shardPorts = 1000
+	}
+	hostList := utils.ParseStringToHosts(hosts)
+	portList := utils.ParseStringToPorts(ports)
+
+	var shards []*Shard
+	for i := 0; i < len(hostList); i += shardHosts {
+		hostChunk := hostList[i:min(i+shardHosts, len(hostList))]
+		for k := 0; k < len(portList); k += shardPorts {
+			portChunk := portList[k:min(k+shardPorts, len(portList))]
+			portStrs := make([]string, len(portChunk))
+			for index, port := range portChunk {
+				portStrs[index] = strconv.Itoa(port)
+			}
+			shards = append(shards, &Shard{
+				Id:      fmt.Sprintf("shard-%d", len(shards)),
+				Hosts:   strings.Join(hostChunk, ","),
+				Ports:   strings.Join(portStrs, ","),
+				Targets: len(hostChunk) * len(portChunk),
+				State:   ShardState_Pending,
+			})
+		}
+	}
+	return shards
+}
+
+func (j *Job) notify() {
+	select {
+	case j.events <- struct{}{}:
+	default:
+	}
+}
+
+func (j *Job) run(ctx context.Context) (*JobStatus, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		j.lock.Lock()
+		j.checkTimeout()
+		pending, running, busy := j.summary()
+		if pending == 0 && running == 0 {
+			j.lock.Unlock()
+			break
+		}
+
+		var dispatched int
+		if pending > 0 {
+			nodes := j.controller.Nodes()
+			for _, node := range nodes {
+				if busy[node.NodeId] {
+					continue
+				}
+				shard := j.nextPending(node.NodeId, nodes)
+				if shard == nil {
+					continue
+				}
+				shard.State = ShardState_Running
+				shard.NodeId = node.NodeId
+				shard.Attempt++
+				shard.Progress = 0
+				shard.lastReport = time.Now()
+				shardCtx, shardCancel := context.WithCancel(ctx)
+				shard.cancel = shardCancel
+				req := j.newRequest(shard)
+				dispatched++
+
+				wg.Add(1)
+				go func(shard *Shard, nodeId string) {
+					defer wg.Done()
+					defer shardCancel()
+					rsp, err := j.controller.client.SCAN_PortScanShard(shardCtx, nodeId, req)
+					j.finishShard(shard, nodeId, req.Attempt, rsp, err)
+				}(shard, node.NodeId)
+			}
+			if dispatched == 0 && running == 0 {
+				// 没有可用的节点，剩余的分片无法完成
+				for _, shard := range j.shards {
+					if shard.State == ShardState_Pending {
+						shard.State = ShardState_Failed
+						shard.Error = "no available scan node"
+					}
+				}
+				j.lock.Unlock()
+				continue
+			}
+		}
+		j.lock.Unlock()
+		if dispatched > 0 {
+			j.emitProgress()
+		}
+
+		select {
+		case <-ctx.Done():
+			j.lock.Lock()
+			for _, shard := range j.shards {
+				if shard.State == ShardState_Running && shard.cancel != nil {
+					shard.cancel()
+				}
+			}
+			j.lock.Unlock()
+			return j.Status(), utils.Errorf("distributed scan %v canceled", j.TaskId)
+		case <-j.events:
+		case <-ticker.C:
+		}
+	}
+
+	status := j.Status()
+	j.emitProgress()
+	if status.Failed > 0 {
+		return status, utils.Errorf("%d/%d shards failed", status.Failed, status.Total)
+	}
+	return status, nil
+}
+
+func (j *Job) newRequest(shard *Shard) *scanrpc.SCAN_PortScanShardRequest {
+	return &scanrpc.SCAN_PortScanShardRequest{
+		TaskId:         j.TaskId,
+		ShardId:        shard.Id,
+		Attempt:        shard.Attempt,
+		ReportTo:       j.controller.id,
+		Hosts:          shard.Hosts,
+		Ports:          shard.Ports,
+		Mode:           j.mode,
+		Plugins:        j.plugins,
+		IsUDP:          j.isUDP,
+		TimeoutSeconds: j.timeout,
+		Concurrent:     j.concurrent,
+		Proxies:        j.proxies,
+	}
+}
+
+// summary 统计分片状态，需要持有锁
+func (j *Job) summary() (pending int, running int, busy map[string]bool) {
+	busy = make(map[string]bool)
+	for _, shard := range j.shards {
+		switch shard.State {
+		case ShardState_Pending:
+			pending++
+		case ShardState_Running:
+			running++
+			busy[shard.NodeId] = true
+		}
+	}
+	return
+}
+
+// nextPending 返回可以下发到 nodeId 的分片，分片不会回到失败过的节点，
+// 除非所有在线节点都已经失败过，这样只有一个节点时依然会按照 maxRetry 重试
+func (j *Job) nextPending(nodeId string, nodes []*NodeInfo) *Shard {
+	for _, shard := range j.shards {
+		if shard.State != ShardState_Pending {
+			continue
+		}
+		if !shard.triedNodes[nodeId] {
+			return shard
+		}
+		untried := false
+		for _, node := range nodes {
+			if !shard.triedNodes[node.NodeId] {
+				untried = true
+				break
+			}
+		}
+		if !untried {
+			return shard
+		}
+	}
+	return nil
+}
+
+// markTried 记录分片在节点上失败，需要持有锁
+func (shard *Shard) markTried(nodeId string) {
+	if shard.triedNodes == nil {
+		shard.triedNodes = make(map[string]bool)
+	}
+	shard.triedNodes[nodeId] = true
+}
+
+// checkTimeout 取消长时间没有汇报的分片，分片会在 finishShard 中重新排队，需要持有锁
+func (j *Job) checkTimeout() {
+	for _, shard := range j.shards {
+		if shard.State != ShardState_Running {
+			continue
+		}
+		if time.Since(shard.lastReport) <= j.controller.nodeTimeout {
+			continue
+		}
+		log.Warnf("shard %v on node %v has no report for %v, requeue it", shard.Id, shard.NodeId, j.controller.nodeTimeout)
+		shard.markTried(shard.NodeId)
+		if shard.cancel != nil {
+			shard.cancel()
+			shard.cancel = nil
+		}
+	}
+}
+
+func (j *Job) finishShard(shard *Shard, nodeId string, attempt int, rsp *scanrpc.SCAN_PortScanShardResponse, err error) {
+	defer j.notify()
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if shard.Attempt != attempt || shard.State != ShardState_Running {
+		return
+	}
+	shard.cancel = nil
+	if err == nil {
+		shard.State = ShardState_Finished
+		shard.Progress = 1
+		shard.Error = ""
+		log.Infof("shard %v finished on node %v: %d open ports, %d risks", shard.Id, nodeId, rsp.OpenPorts, rsp.Risks)
+		return
+	}
+
+	// 失败的节点不再优先接收这个分片
+	shard.markTried(nodeId)
+	shard.Error = err.Error()
+	if shard.Attempt > j.maxRetry {
+		shard.State = ShardState_Failed
+		log.Errorf("shard %v failed on node %v: %s", shard.Id, nodeId, err)
+		return
+	}
+	shard.State = ShardState_Pending
+	shard.NodeId = ""
+	shard.Progress = 0
+	j.requeued++
+	log.Warnf("shard %v failed on node %v: %s, requeue it", shard.Id, nodeId, err)
+}
+
+func (j *Job) onReport(report *spec.ScanShardReport) error {
+	j.lock.Lock()
+	shard, ok := j.shardMap[report.ShardId]
+	if !ok {
+		j.lock.Unlock()
+		return utils.Errorf("no such shard: %v", report.ShardId)
+	}
+	if shard.State == ShardState_Running && shard.Attempt == report.Attempt && shard.NodeId == report.NodeId {
+		shard.lastReport = time.Now()
+		shard.Progress = report.Progress
+	}
+	// 过期的汇报同样合并结果，重复的结果会被去重
+	for _, result := range report.Results {
+		if err := j.mergeResult(result); err != nil {
+			log.Errorf("merge result from %v failed: %s", report.NodeId, err)
+		}
+	}
+	j.lock.Unlock()
+
+	j.emitProgress()
+	return nil
+}
+
+// mergeResult 去重后把结果保存到数据库，需要持有锁
+func (j *Job) mergeResult(result *spec.ScanResult) error {
+	switch result.Type {
+	case spec.ScanResult_Fingerprint:
+		var f spec.PortFingerprint
+		if err := json.Unmarshal(result.Content, &f); err != nil {
+			return err
+		}
+		if f.State != spec.PortStateType_Open {
+			return nil
+		}
+		key := utils.CalcSha1(f.Host, f.Port, f.Proto)
+		if j.seenPorts[key] {
+			return nil
+		}
+		port := &schema.Port{
+			Host:        f.Host,
+			Port:        f.Port,
+			Proto:       string(f.Proto),
+			ServiceType: f.ServiceName,
+			State:       "open",
+			Fingerprint: f.Banner,
+			CPE:         strings.Join(f.CPEs, "|"),
+			HtmlTitle:   f.Title,
+			From:        portFrom,
+			TaskName:    j.TaskName,
+			RuntimeId:   j.RuntimeId,
+		}
+		port.Hash = port.CalcHash()
+		if err := yakit.CreateOrUpdatePort(j.db, port.Hash, port); err != nil {
+			return err
+		}
+		j.seenPorts[key] = true
+		j.openPorts++
+	case spec.ScanResult_Risk:
+		var risk schema.Risk
+		if err := json.Unmarshal(result.Content, &risk); err != nil {
+			return err
+		}
+		key := utils.CalcSha1(risk.Title, risk.RiskType, risk.IP, risk.Port, risk.Url, risk.FromYakScript)
+		if j.seenRisks[key] {
+			return nil
+		}
+		risk.Model = gorm.Model{}
+		risk.RuntimeId = j.RuntimeId
+		risk.TaskName = j.TaskName
+		risk.Hash = utils.CalcSha1(key, j.RuntimeId)
+		if err := yakit.CreateOrUpdateRisk(j.db, risk.Hash, &risk); err != nil {
+			return err
+		}
+		j.seenRisks[key] = true
+		j.risks++
+	}
+	return nil
+}
+
+func (j *Job) emitProgress() {
+	if j.onProgress == nil {
+		return
+	}
+	j.onProgress(j.Status())
+}
+
+// Status 返回任务当前的状态快照
+func (j *Job) Status() *JobStatus {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	status := &JobStatus{
+		TaskId:    j.TaskId,
+		RuntimeId: j.RuntimeId,
+		Total:     len(j.shards),
+		Requeued:  j.requeued,
+		OpenPorts: j.openPorts,
+		Risks:     j.risks,
+	}
+	var targets, done float64
+	for _, shard := range j.shards {
+		switch shard.State {
+		case ShardState_Running:
+			status.Running++
+		case ShardState_Finished:
+			status.Finished++
+		case ShardState_Failed:
+			status.Failed++
+		}
+		targets += float64(shard.Targets)
+		done += float64(shard.Targets) * shard.Progress
+		copied := *shard
+		copied.cancel = nil
+		copied.triedNodes = nil
+		status.Shards = append(status.Shards, copied)
+	}
+	if targets > 0 {
+		status.Progress = done / targets
+	}
+	return status
+}
@@ -208,6 +208,7 @@ func (s *ScanNode) initScanRPC() {
 	scanHelper.DoSCAN_InvokeScript = s.rpc_invokeScript
 	scanHelper.DoSCAN_StartScript = s.rpc_startScript
 	scanHelper.DoSCAN_QueryYakScript = s.rpcQueryYakScript
+	scanHelper.DoSCAN_PortScanShard = s.rpcPortScanShard
 
 	s.node.GetRPCServer().RegisterServices(scanrpc.MethodList, scanHelper.Do)
 }
@@ -0,0 +1,244 @@
+package scannode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/fp"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mq"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/spec"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+	"github.com/yaklang/yaklang/scannode/scanrpc"
+)
+
+const (
+	PortScanMode_Port   = "port"
+	PortScanMode_Hybrid = "hybrid"
+)
+
+// shardReporter 缓存分片的扫描结果，定期通过 API_ReportScanShard 汇报给控制端，
+// 没有新结果时也会汇报进度，控制端据此判断节点是否存活
+type shardReporter struct {
+	node *ScanNode
+	req  *scanrpc.SCAN_PortScanShardRequest
+
+	lock     sync.Mutex
+	pending  []*spec.ScanResult
+	total    int
+	finished int
+}
+
+func (r *shardReporter) add(result *spec.ScanResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	result.TaskId = r.req.TaskId
+	result.SubTaskId = r.req.ShardId
+	r.pending = append(r.pending, result)
+}
+
+func (r *shardReporter) done() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.finished++
+}
+
+func (r *shardReporter) flush(ctx context.Context, finished bool) error {
+	r.lock.Lock()
+	results := r.pending
+	r.pending = nil
+	progress := 1.0
+	if r.total > 0 {
+		progress = float64(r.finished) / float64(r.total)
+	}
+	r.lock.Unlock()
+
+	report := &spec.ScanShardReport{
+		TaskId:   r.req.TaskId,
+		ShardId:  r.req.ShardId,
+		NodeId:   r.node.node.NodeId,
+		Attempt:  r.req.Attempt,
+		Finished: finished,
+		Progress: progress,
+		Results:  results,
+	}
+	rsp, err := r.node.node.GetRPCClient().Call(ctx, spec.API_ReportScanShard, r.req.ReportTo, report)
+	if err == nil {
+		var ret spec.ScanShardReportResponse
+		if err = json.Unmarshal(rsp, &ret); err == nil && !ret.Ok {
+			err = utils.Errorf("report rejected: %v", ret.Reason)
+		}
+	}
+	if err != nil {
+		// 汇报失败的结果保留到下一次汇报
+		r.lock.Lock()
+		r.pending = append(results, r.pending...)
+		r.lock.Unlock()
+		return utils.Errorf("report shard %v failed: %s", r.req.ShardId, err)
+	}
+	return nil
+}
+
+func NewRiskResult(r *schema.Risk) (*spec.ScanResult, error) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return &spec.ScanResult{
+		Type:    spec.ScanResult_Risk,
+		Content: raw,
+	}, nil
+}
+
+func (s *ScanNode) rpcPortScanShard(ctx context.Context, node string, req *scanrpc.SCAN_PortScanShardRequest, broker *mq.Broker) (*scanrpc.SCAN_PortScanShardResponse, error) {
+	if req.ReportTo == "" {
+		req.ReportTo = spec.ServerNodeId
+	}
+	if req.Concurrent <= 0 {
+		req.Concurrent = 50
+	}
+	if req.TimeoutSeconds <= 0 {
+		req.TimeoutSeconds = 5
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	taskId := fmt.Sprintf("port-scan-shard-[%v]-[%v]", req.TaskId, req.ShardId)
+	s.manager.Add(taskId, &Task{
+		TaskType: "port-scan-shard",
+		TaskId:   taskId,
+		Ctx:      ctx,
+		Cancel:   cancel,
+	})
+	defer s.manager.Remove(taskId)
+	log.Infof("create port-scan-shard task: %s", taskId)
+
+	hosts := utils.ParseStringToHosts(req.Hosts)
+	ports := utils.ParseStringToPorts(req.Ports)
+	reporter := &shardReporter{node: s, req: req, total: len(hosts) * len(ports)}
+
+	matcher, err := fp.NewDefaultFingerprintMatcher(nil)
+	if err != nil {
+		return nil, err
+	}
+	extraOptions := []fp.ConfigOption{
+		fp.WithProbeTimeout(time.Duration(req.TimeoutSeconds) * time.Second),
+		fp.WithProxy(req.Proxies...),
+	}
+	if req.IsUDP {
+		extraOptions = append(extraOptions, fp.WithTransportProtos(fp.UDP))
+	} else {
+		extraOptions = append(extraOptions, fp.WithTransportProtos(fp.TCP))
+	}
+
+	// 插件产生的风险保存在节点本地数据库中，使用单独的 runtime id 在分片结束后取出汇报
+	runtimeId := uuid.New().String()
+	var caller *yak.MixPluginCaller
+	if req.Mode == PortScanMode_Hybrid && len(req.Plugins) > 0 {
+		caller, err = yak.NewMixPluginCaller()
+		if err != nil {
+			return nil, utils.Errorf("create plugin caller failed: %s", err)
+		}
+		caller.SetCtx(ctx)
+		caller.SetRuntimeId(runtimeId)
+		caller.SetProxy(req.Proxies...)
+		caller.SetFeedback(func(i *ypb.ExecResult) error {
+			return nil
+		})
+		for _, plugin := range req.Plugins {
+			if err := caller.LoadPluginByName(ctx, plugin, nil); err != nil {
+				log.Errorf("load plugin %v failed: %s", plugin, err)
+			}
+		}
+	}
+
+	reportDone := make(chan struct{})
+	go func() {
+		defer close(reportDone)
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := reporter.flush(ctx, false); err != nil {
+					log.Warn(err)
+				}
+			}
+		}
+	}()
+
+	var openPorts int64
+	swg := utils.NewSizedWaitGroup(req.Concurrent)
+	for _, host := range hosts {
+		for _, port := range ports {
+			if err := swg.AddWithContext(ctx); err != nil {
+				return nil, utils.Errorf("context done")
+			}
+			host, port := host, port
+			go func() {
+				defer swg.Done()
+				defer reporter.done()
+
+				result, err := matcher.Match(host, port, extraOptions...)
+				if err != nil {
+					log.Errorf("match %v failed: %s", utils.HostPort(host, port), err)
+					return
+				}
+				if result.State != fp.OPEN {
+					return
+				}
+				ret, err := spec.NewScanFingerprintResult(result)
+				if err != nil {
+					return
+				}
+				reporter.add(ret)
+				atomic.AddInt64(&openPorts, 1)
+				if caller != nil {
+					caller.HandleServiceScanResult(result)
+				}
+			}()
+		}
+	}
+	swg.Wait()
+
+	var risks int
+	if caller != nil {
+		for risk := range yakit.YieldRisksByRuntimeId(consts.GetGormProjectDatabase(), ctx, runtimeId) {
+			ret, err := NewRiskResult(risk)
+			if err != nil {
+				continue
+			}
+			reporter.add(ret)
+			risks++
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, utils.Errorf("shard %v canceled", req.ShardId)
+	default:
+	}
+	cancel()
+	<-reportDone
+
+	// 最后一次汇报必须成功，控制端以此确认分片的结果已经全部收到
+	finishCtx, finishCancel := context.WithTimeout(s.node.GetRootContext(), 30*time.Second)
+	defer finishCancel()
+	if err := reporter.flush(finishCtx, true); err != nil {
+		return nil, err
+	}
+	return &scanrpc.SCAN_PortScanShardResponse{OpenPorts: int(openPorts), Risks: risks}, nil
+}
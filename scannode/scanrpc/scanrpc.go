@@ -67,6 +67,25 @@ type SCAN_InvokeScriptResponse struct {
 	Data interface{}
 }
 
+type SCAN_PortScanShardRequest struct {
+	TaskId         string
+	ShardId        string
+	Attempt        int
+	ReportTo       string
+	Hosts          string
+	Ports          string
+	Mode           string
+	Plugins        []string
+	IsUDP          bool
+	TimeoutSeconds int
+	Concurrent     int
+	Proxies        []string
+}
+type SCAN_PortScanShardResponse struct {
+	OpenPorts int
+	Risks     int
+}
+
 type SCAN_QueryYakScriptResponse struct {
 	Pagination *ypb.Paging
 	Total      int64
@@ -79,7 +98,7 @@ var (
 		"SCAN_StartScript", "SCAN_GetRunningTasks", "SCAN_StopTask",
 		"SCAN_RadCrawler", "SCAN_DownloadXrayAndRad", "SCAN_IsXrayAndRadAvailable",
 		"SCAN_ScanFingerprint", "SCAN_BasicCrawler", "SCAN_ProxyCollector",
-		"SCAN_InvokeScript", "SCAN_QueryYakScript",
+		"SCAN_InvokeScript", "SCAN_QueryYakScript", "SCAN_PortScanShard",
 	}
 )
 
@@ -95,6 +114,7 @@ type SCANServerHelper struct {
 	DoSCAN_ProxyCollector        func(ctx context.Context, node string, req *SCAN_ProxyCollectorRequest, broker *mq.Broker) (*SCAN_ProxyCollectorResponse, error)
 	DoSCAN_InvokeScript          func(ctx context.Context, node string, req *SCAN_InvokeScriptRequest, broker *mq.Broker) (*SCAN_InvokeScriptResponse, error)
 	DoSCAN_QueryYakScript        func(ctx context.Context, node string, req *ypb.QueryYakScriptRequest, broker *mq.Broker) (*SCAN_QueryYakScriptResponse, error)
+	DoSCAN_PortScanShard         func(ctx context.Context, node string, req *SCAN_PortScanShardRequest, broker *mq.Broker) (*SCAN_PortScanShardResponse, error)
 }
 
 func (h *SCANServerHelper) Do(broker *mq.Broker, ctx context.Context, f, node string, delivery *amqp.Delivery) (message interface{}, e error) {
@@ -209,6 +229,16 @@ func (h *SCANServerHelper) Do(broker *mq.Broker, ctx context.Context, f, node st
 			return nil, utils.Errorf("not implemented")
 		}
 		return h.DoSCAN_QueryYakScript(ctx, node, &req, broker)
+	case "SCAN_PortScanShard":
+		var req SCAN_PortScanShardRequest
+		err := json.Unmarshal(delivery.Body, &req)
+		if err != nil {
+			return nil, err
+		}
+		if h.DoSCAN_PortScanShard == nil {
+			return nil, utils.Errorf("not implemented")
+		}
+		return h.DoSCAN_PortScanShard(ctx, node, &req, broker)
 	default:
 		return nil, utils.Errorf("unknown func: %v", f)
 	}
@@ -342,6 +372,18 @@ func (h *SCANClientHelper) SCAN_InvokeScript(ctx context.Context, node string, r
 	}
 	return &rspIns, nil
 }
+func (h *SCANClientHelper) SCAN_PortScanShard(ctx context.Context, node string, req *SCAN_PortScanShardRequest) (*SCAN_PortScanShardResponse, error) {
+	rsp, err := h.callRpc(ctx, "SCAN_PortScanShard", node, req)
+	if err != nil {
+		return nil, err
+	}
+	var rspIns SCAN_PortScanShardResponse
+	err = json.Unmarshal(rsp, &rspIns)
+	if err != nil {
+		return nil, err
+	}
+	return &rspIns, nil
+}
 func GenerateSCANClientHelper(callRpc callRpcHandler) *SCANClientHelper {
 	return &SCANClientHelper{callRpc: callRpc}
 }